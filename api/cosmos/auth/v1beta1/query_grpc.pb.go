@@ -29,6 +29,10 @@ const (
 	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
 	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
 	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_MsgFees_FullMethodName              = "/cosmos.auth.v1beta1.Query/MsgFees"
+	Query_MsgFee_FullMethodName               = "/cosmos.auth.v1beta1.Query/MsgFee"
+	Query_PubKeyRotations_FullMethodName      = "/cosmos.auth.v1beta1.Query/PubKeyRotations"
+	Query_Authenticators_FullMethodName       = "/cosmos.auth.v1beta1.Query/Authenticators"
 )
 
 // QueryClient is the client API for Query service.
@@ -60,6 +64,18 @@ type QueryClient interface {
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// MsgFees returns the gas multipliers and minimum fees of all the message
+	// types that have one configured.
+	MsgFees(ctx context.Context, in *QueryMsgFeesRequest, opts ...grpc.CallOption) (*QueryMsgFeesResponse, error)
+	// MsgFee returns the gas multiplier and minimum fee of a message type. Message
+	// types without a configured fee report a gas multiplier of 1 and no minimum
+	// fee.
+	MsgFee(ctx context.Context, in *QueryMsgFeeRequest, opts ...grpc.CallOption) (*QueryMsgFeeResponse, error)
+	// PubKeyRotations returns the history of the rotations of the public key of
+	// an account, oldest first.
+	PubKeyRotations(ctx context.Context, in *QueryPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPubKeyRotationsResponse, error)
+	// Authenticators returns the authenticators registered by an account.
+	Authenticators(ctx context.Context, in *QueryAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAuthenticatorsResponse, error)
 }
 
 type queryClient struct {
@@ -170,6 +186,46 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) MsgFees(ctx context.Context, in *QueryMsgFeesRequest, opts ...grpc.CallOption) (*QueryMsgFeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMsgFeesResponse)
+	err := c.cc.Invoke(ctx, Query_MsgFees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgFee(ctx context.Context, in *QueryMsgFeeRequest, opts ...grpc.CallOption) (*QueryMsgFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMsgFeeResponse)
+	err := c.cc.Invoke(ctx, Query_MsgFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PubKeyRotations(ctx context.Context, in *QueryPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPubKeyRotationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPubKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, Query_PubKeyRotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Authenticators(ctx context.Context, in *QueryAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAuthenticatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAuthenticatorsResponse)
+	err := c.cc.Invoke(ctx, Query_Authenticators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -199,6 +255,18 @@ type QueryServer interface {
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// MsgFees returns the gas multipliers and minimum fees of all the message
+	// types that have one configured.
+	MsgFees(context.Context, *QueryMsgFeesRequest) (*QueryMsgFeesResponse, error)
+	// MsgFee returns the gas multiplier and minimum fee of a message type. Message
+	// types without a configured fee report a gas multiplier of 1 and no minimum
+	// fee.
+	MsgFee(context.Context, *QueryMsgFeeRequest) (*QueryMsgFeeResponse, error)
+	// PubKeyRotations returns the history of the rotations of the public key of
+	// an account, oldest first.
+	PubKeyRotations(context.Context, *QueryPubKeyRotationsRequest) (*QueryPubKeyRotationsResponse, error)
+	// Authenticators returns the authenticators registered by an account.
+	Authenticators(context.Context, *QueryAuthenticatorsRequest) (*QueryAuthenticatorsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -239,6 +307,18 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) MsgFees(context.Context, *QueryMsgFeesRequest) (*QueryMsgFeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MsgFees not implemented")
+}
+func (UnimplementedQueryServer) MsgFee(context.Context, *QueryMsgFeeRequest) (*QueryMsgFeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MsgFee not implemented")
+}
+func (UnimplementedQueryServer) PubKeyRotations(context.Context, *QueryPubKeyRotationsRequest) (*QueryPubKeyRotationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PubKeyRotations not implemented")
+}
+func (UnimplementedQueryServer) Authenticators(context.Context, *QueryAuthenticatorsRequest) (*QueryAuthenticatorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Authenticators not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -440,6 +520,78 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MsgFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MsgFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgFees(ctx, req.(*QueryMsgFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MsgFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgFee(ctx, req.(*QueryMsgFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PubKeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PubKeyRotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyRotations(ctx, req.(*QueryPubKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Authenticators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthenticatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Authenticators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Authenticators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Authenticators(ctx, req.(*QueryAuthenticatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -487,6 +639,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "MsgFees",
+			Handler:    _Query_MsgFees_Handler,
+		},
+		{
+			MethodName: "MsgFee",
+			Handler:    _Query_MsgFee_Handler,
+		},
+		{
+			MethodName: "PubKeyRotations",
+			Handler:    _Query_PubKeyRotations_Handler,
+		},
+		{
+			MethodName: "Authenticators",
+			Handler:    _Query_Authenticators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
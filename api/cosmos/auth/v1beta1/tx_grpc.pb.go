@@ -19,7 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName        = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateMsgFees_FullMethodName       = "/cosmos.auth.v1beta1.Msg/UpdateMsgFees"
+	Msg_RotatePubKey_FullMethodName        = "/cosmos.auth.v1beta1.Msg/RotatePubKey"
+	Msg_AddAuthenticator_FullMethodName    = "/cosmos.auth.v1beta1.Msg/AddAuthenticator"
+	Msg_RemoveAuthenticator_FullMethodName = "/cosmos.auth.v1beta1.Msg/RemoveAuthenticator"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +35,19 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateMsgFees defines a (governance) operation for replacing the per message
+	// type gas multipliers and minimum fees. The authority defaults to the x/gov
+	// module account.
+	UpdateMsgFees(ctx context.Context, in *MsgUpdateMsgFees, opts ...grpc.CallOption) (*MsgUpdateMsgFeesResponse, error)
+	// RotatePubKey defines an operation for replacing the public key of an
+	// account, keeping its address, e.g. when its key is compromised.
+	RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error)
+	// AddAuthenticator defines an operation for registering an authenticator for
+	// an account.
+	AddAuthenticator(ctx context.Context, in *MsgAddAuthenticator, opts ...grpc.CallOption) (*MsgAddAuthenticatorResponse, error)
+	// RemoveAuthenticator defines an operation for removing an authenticator of
+	// an account.
+	RemoveAuthenticator(ctx context.Context, in *MsgRemoveAuthenticator, opts ...grpc.CallOption) (*MsgRemoveAuthenticatorResponse, error)
 }
 
 type msgClient struct {
@@ -51,6 +68,46 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateMsgFees(ctx context.Context, in *MsgUpdateMsgFees, opts ...grpc.CallOption) (*MsgUpdateMsgFeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateMsgFeesResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateMsgFees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRotatePubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RotatePubKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAuthenticator(ctx context.Context, in *MsgAddAuthenticator, opts ...grpc.CallOption) (*MsgAddAuthenticatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, Msg_AddAuthenticator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAuthenticator(ctx context.Context, in *MsgRemoveAuthenticator, opts ...grpc.CallOption) (*MsgRemoveAuthenticatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAuthenticator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -60,6 +117,19 @@ type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateMsgFees defines a (governance) operation for replacing the per message
+	// type gas multipliers and minimum fees. The authority defaults to the x/gov
+	// module account.
+	UpdateMsgFees(context.Context, *MsgUpdateMsgFees) (*MsgUpdateMsgFeesResponse, error)
+	// RotatePubKey defines an operation for replacing the public key of an
+	// account, keeping its address, e.g. when its key is compromised.
+	RotatePubKey(context.Context, *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error)
+	// AddAuthenticator defines an operation for registering an authenticator for
+	// an account.
+	AddAuthenticator(context.Context, *MsgAddAuthenticator) (*MsgAddAuthenticatorResponse, error)
+	// RemoveAuthenticator defines an operation for removing an authenticator of
+	// an account.
+	RemoveAuthenticator(context.Context, *MsgRemoveAuthenticator) (*MsgRemoveAuthenticatorResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -73,6 +143,18 @@ type UnimplementedMsgServer struct{}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateMsgFees(context.Context, *MsgUpdateMsgFees) (*MsgUpdateMsgFeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMsgFees not implemented")
+}
+func (UnimplementedMsgServer) RotatePubKey(context.Context, *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotatePubKey not implemented")
+}
+func (UnimplementedMsgServer) AddAuthenticator(context.Context, *MsgAddAuthenticator) (*MsgAddAuthenticatorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddAuthenticator not implemented")
+}
+func (UnimplementedMsgServer) RemoveAuthenticator(context.Context, *MsgRemoveAuthenticator) (*MsgRemoveAuthenticatorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveAuthenticator not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -112,6 +194,78 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateMsgFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMsgFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMsgFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateMsgFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMsgFees(ctx, req.(*MsgUpdateMsgFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotatePubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotatePubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotatePubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RotatePubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotatePubKey(ctx, req.(*MsgRotatePubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddAuthenticator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAuthenticator(ctx, req.(*MsgAddAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAuthenticator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAuthenticator(ctx, req.(*MsgRemoveAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -123,6 +277,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateMsgFees",
+			Handler:    _Msg_UpdateMsgFees_Handler,
+		},
+		{
+			MethodName: "RotatePubKey",
+			Handler:    _Msg_RotatePubKey_Handler,
+		},
+		{
+			MethodName: "AddAuthenticator",
+			Handler:    _Msg_AddAuthenticator_Handler,
+		},
+		{
+			MethodName: "RemoveAuthenticator",
+			Handler:    _Msg_RemoveAuthenticator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
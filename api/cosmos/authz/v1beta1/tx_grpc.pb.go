@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Grant_FullMethodName  = "/cosmos.authz.v1beta1.Msg/Grant"
-	Msg_Exec_FullMethodName   = "/cosmos.authz.v1beta1.Msg/Exec"
-	Msg_Revoke_FullMethodName = "/cosmos.authz.v1beta1.Msg/Revoke"
+	Msg_Grant_FullMethodName           = "/cosmos.authz.v1beta1.Msg/Grant"
+	Msg_Exec_FullMethodName            = "/cosmos.authz.v1beta1.Msg/Exec"
+	Msg_Revoke_FullMethodName          = "/cosmos.authz.v1beta1.Msg/Revoke"
+	Msg_GrantSessionKey_FullMethodName = "/cosmos.authz.v1beta1.Msg/GrantSessionKey"
 )
 
 // MsgClient is the client API for Msg service.
@@ -42,6 +43,12 @@ type MsgClient interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// GrantSessionKey grants a short-lived session key the generic authorization
+	// of the given message types and a fee allowance of the given budget, both
+	// expiring at the given expiration. The session key can then sign
+	// transactions of these messages as the granter directly, without wrapping
+	// them in a MsgExec.
+	GrantSessionKey(ctx context.Context, in *MsgGrantSessionKey, opts ...grpc.CallOption) (*MsgGrantSessionKeyResponse, error)
 }
 
 type msgClient struct {
@@ -82,6 +89,16 @@ func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) GrantSessionKey(ctx context.Context, in *MsgGrantSessionKey, opts ...grpc.CallOption) (*MsgGrantSessionKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantSessionKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantSessionKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -100,6 +117,12 @@ type MsgServer interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// GrantSessionKey grants a short-lived session key the generic authorization
+	// of the given message types and a fee allowance of the given budget, both
+	// expiring at the given expiration. The session key can then sign
+	// transactions of these messages as the granter directly, without wrapping
+	// them in a MsgExec.
+	GrantSessionKey(context.Context, *MsgGrantSessionKey) (*MsgGrantSessionKeyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -119,6 +142,9 @@ func (UnimplementedMsgServer) Exec(context.Context, *MsgExec) (*MsgExecResponse,
 func (UnimplementedMsgServer) Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Revoke not implemented")
 }
+func (UnimplementedMsgServer) GrantSessionKey(context.Context, *MsgGrantSessionKey) (*MsgGrantSessionKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantSessionKey not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -194,6 +220,24 @@ func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantSessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantSessionKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantSessionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantSessionKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantSessionKey(ctx, req.(*MsgGrantSessionKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -213,6 +257,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _Msg_Revoke_Handler,
 		},
+		{
+			MethodName: "GrantSessionKey",
+			Handler:    _Msg_GrantSessionKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -32,6 +32,15 @@ const (
 	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_DenomOwnersByQuery_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
 	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_DenomAuthorityMetadata_FullMethodName     = "/cosmos.bank.v1beta1.Query/DenomAuthorityMetadata"
+	Query_DenomsFromCreator_FullMethodName          = "/cosmos.bank.v1beta1.Query/DenomsFromCreator"
+	Query_BalanceAtHeight_FullMethodName            = "/cosmos.bank.v1beta1.Query/BalanceAtHeight"
+	Query_BalanceHistory_FullMethodName             = "/cosmos.bank.v1beta1.Query/BalanceHistory"
+	Query_DenomCompliance_FullMethodName            = "/cosmos.bank.v1beta1.Query/DenomCompliance"
+	Query_FrozenAddresses_FullMethodName            = "/cosmos.bank.v1beta1.Query/FrozenAddresses"
+	Query_AllowlistedAddresses_FullMethodName       = "/cosmos.bank.v1beta1.Query/AllowlistedAddresses"
+	Query_ScheduledSend_FullMethodName              = "/cosmos.bank.v1beta1.Query/ScheduledSend"
+	Query_ScheduledSendsBySender_FullMethodName     = "/cosmos.bank.v1beta1.Query/ScheduledSendsBySender"
 )
 
 // QueryClient is the client API for Query service.
@@ -93,6 +102,30 @@ type QueryClient interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// DenomAuthorityMetadata queries the authority of a factory denom.
+	DenomAuthorityMetadata(ctx context.Context, in *QueryDenomAuthorityMetadataRequest, opts ...grpc.CallOption) (*QueryDenomAuthorityMetadataResponse, error)
+	// DenomsFromCreator queries the factory denoms created by an account.
+	DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error)
+	// BalanceAtHeight queries the balance of a single coin of an account at a
+	// given height from the balance index of the node.
+	//
+	// The balance index is off-consensus and must be enabled on the queried node.
+	BalanceAtHeight(ctx context.Context, in *QueryBalanceAtHeightRequest, opts ...grpc.CallOption) (*QueryBalanceAtHeightResponse, error)
+	// BalanceHistory queries the changes of the balance of a single coin of an
+	// account, ordered by height, from the balance index of the node.
+	//
+	// The balance index is off-consensus and must be enabled on the queried node.
+	BalanceHistory(ctx context.Context, in *QueryBalanceHistoryRequest, opts ...grpc.CallOption) (*QueryBalanceHistoryResponse, error)
+	// DenomCompliance queries the compliance controls of a denom.
+	DenomCompliance(ctx context.Context, in *QueryDenomComplianceRequest, opts ...grpc.CallOption) (*QueryDenomComplianceResponse, error)
+	// FrozenAddresses queries the addresses frozen for a denom.
+	FrozenAddresses(ctx context.Context, in *QueryFrozenAddressesRequest, opts ...grpc.CallOption) (*QueryFrozenAddressesResponse, error)
+	// AllowlistedAddresses queries the addresses allowlisted for a denom.
+	AllowlistedAddresses(ctx context.Context, in *QueryAllowlistedAddressesRequest, opts ...grpc.CallOption) (*QueryAllowlistedAddressesResponse, error)
+	// ScheduledSend queries a scheduled send by its identifier.
+	ScheduledSend(ctx context.Context, in *QueryScheduledSendRequest, opts ...grpc.CallOption) (*QueryScheduledSendResponse, error)
+	// ScheduledSendsBySender queries the scheduled sends of a sender.
+	ScheduledSendsBySender(ctx context.Context, in *QueryScheduledSendsBySenderRequest, opts ...grpc.CallOption) (*QueryScheduledSendsBySenderResponse, error)
 }
 
 type queryClient struct {
@@ -233,6 +266,96 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) DenomAuthorityMetadata(ctx context.Context, in *QueryDenomAuthorityMetadataRequest, opts ...grpc.CallOption) (*QueryDenomAuthorityMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomAuthorityMetadataResponse)
+	err := c.cc.Invoke(ctx, Query_DenomAuthorityMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomsFromCreatorResponse)
+	err := c.cc.Invoke(ctx, Query_DenomsFromCreator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalanceAtHeight(ctx context.Context, in *QueryBalanceAtHeightRequest, opts ...grpc.CallOption) (*QueryBalanceAtHeightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBalanceAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_BalanceAtHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalanceHistory(ctx context.Context, in *QueryBalanceHistoryRequest, opts ...grpc.CallOption) (*QueryBalanceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_BalanceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomCompliance(ctx context.Context, in *QueryDenomComplianceRequest, opts ...grpc.CallOption) (*QueryDenomComplianceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomComplianceResponse)
+	err := c.cc.Invoke(ctx, Query_DenomCompliance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAddresses(ctx context.Context, in *QueryFrozenAddressesRequest, opts ...grpc.CallOption) (*QueryFrozenAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFrozenAddressesResponse)
+	err := c.cc.Invoke(ctx, Query_FrozenAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowlistedAddresses(ctx context.Context, in *QueryAllowlistedAddressesRequest, opts ...grpc.CallOption) (*QueryAllowlistedAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllowlistedAddressesResponse)
+	err := c.cc.Invoke(ctx, Query_AllowlistedAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledSend(ctx context.Context, in *QueryScheduledSendRequest, opts ...grpc.CallOption) (*QueryScheduledSendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryScheduledSendResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledSend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledSendsBySender(ctx context.Context, in *QueryScheduledSendsBySenderRequest, opts ...grpc.CallOption) (*QueryScheduledSendsBySenderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryScheduledSendsBySenderResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledSendsBySender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -292,6 +415,30 @@ type QueryServer interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// DenomAuthorityMetadata queries the authority of a factory denom.
+	DenomAuthorityMetadata(context.Context, *QueryDenomAuthorityMetadataRequest) (*QueryDenomAuthorityMetadataResponse, error)
+	// DenomsFromCreator queries the factory denoms created by an account.
+	DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error)
+	// BalanceAtHeight queries the balance of a single coin of an account at a
+	// given height from the balance index of the node.
+	//
+	// The balance index is off-consensus and must be enabled on the queried node.
+	BalanceAtHeight(context.Context, *QueryBalanceAtHeightRequest) (*QueryBalanceAtHeightResponse, error)
+	// BalanceHistory queries the changes of the balance of a single coin of an
+	// account, ordered by height, from the balance index of the node.
+	//
+	// The balance index is off-consensus and must be enabled on the queried node.
+	BalanceHistory(context.Context, *QueryBalanceHistoryRequest) (*QueryBalanceHistoryResponse, error)
+	// DenomCompliance queries the compliance controls of a denom.
+	DenomCompliance(context.Context, *QueryDenomComplianceRequest) (*QueryDenomComplianceResponse, error)
+	// FrozenAddresses queries the addresses frozen for a denom.
+	FrozenAddresses(context.Context, *QueryFrozenAddressesRequest) (*QueryFrozenAddressesResponse, error)
+	// AllowlistedAddresses queries the addresses allowlisted for a denom.
+	AllowlistedAddresses(context.Context, *QueryAllowlistedAddressesRequest) (*QueryAllowlistedAddressesResponse, error)
+	// ScheduledSend queries a scheduled send by its identifier.
+	ScheduledSend(context.Context, *QueryScheduledSendRequest) (*QueryScheduledSendResponse, error)
+	// ScheduledSendsBySender queries the scheduled sends of a sender.
+	ScheduledSendsBySender(context.Context, *QueryScheduledSendsBySenderRequest) (*QueryScheduledSendsBySenderResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -341,6 +488,33 @@ func (UnimplementedQueryServer) DenomOwnersByQuery(context.Context, *QueryDenomO
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) DenomAuthorityMetadata(context.Context, *QueryDenomAuthorityMetadataRequest) (*QueryDenomAuthorityMetadataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DenomAuthorityMetadata not implemented")
+}
+func (UnimplementedQueryServer) DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DenomsFromCreator not implemented")
+}
+func (UnimplementedQueryServer) BalanceAtHeight(context.Context, *QueryBalanceAtHeightRequest) (*QueryBalanceAtHeightResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BalanceAtHeight not implemented")
+}
+func (UnimplementedQueryServer) BalanceHistory(context.Context, *QueryBalanceHistoryRequest) (*QueryBalanceHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BalanceHistory not implemented")
+}
+func (UnimplementedQueryServer) DenomCompliance(context.Context, *QueryDenomComplianceRequest) (*QueryDenomComplianceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DenomCompliance not implemented")
+}
+func (UnimplementedQueryServer) FrozenAddresses(context.Context, *QueryFrozenAddressesRequest) (*QueryFrozenAddressesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FrozenAddresses not implemented")
+}
+func (UnimplementedQueryServer) AllowlistedAddresses(context.Context, *QueryAllowlistedAddressesRequest) (*QueryAllowlistedAddressesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AllowlistedAddresses not implemented")
+}
+func (UnimplementedQueryServer) ScheduledSend(context.Context, *QueryScheduledSendRequest) (*QueryScheduledSendResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScheduledSend not implemented")
+}
+func (UnimplementedQueryServer) ScheduledSendsBySender(context.Context, *QueryScheduledSendsBySenderRequest) (*QueryScheduledSendsBySenderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScheduledSendsBySender not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -596,6 +770,168 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomAuthorityMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAuthorityMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAuthorityMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomAuthorityMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAuthorityMetadata(ctx, req.(*QueryDenomAuthorityMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomsFromCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsFromCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsFromCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomsFromCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsFromCreator(ctx, req.(*QueryDenomsFromCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalanceAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalanceAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceAtHeight(ctx, req.(*QueryBalanceAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalanceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceHistory(ctx, req.(*QueryBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomCompliance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomComplianceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomCompliance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomCompliance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomCompliance(ctx, req.(*QueryDenomComplianceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FrozenAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAddresses(ctx, req.(*QueryFrozenAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowlistedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowlistedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowlistedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllowlistedAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowlistedAddresses(ctx, req.(*QueryAllowlistedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledSend(ctx, req.(*QueryScheduledSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledSendsBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledSendsBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledSendsBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledSendsBySender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledSendsBySender(ctx, req.(*QueryScheduledSendsBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -655,6 +991,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "DenomAuthorityMetadata",
+			Handler:    _Query_DenomAuthorityMetadata_Handler,
+		},
+		{
+			MethodName: "DenomsFromCreator",
+			Handler:    _Query_DenomsFromCreator_Handler,
+		},
+		{
+			MethodName: "BalanceAtHeight",
+			Handler:    _Query_BalanceAtHeight_Handler,
+		},
+		{
+			MethodName: "BalanceHistory",
+			Handler:    _Query_BalanceHistory_Handler,
+		},
+		{
+			MethodName: "DenomCompliance",
+			Handler:    _Query_DenomCompliance_Handler,
+		},
+		{
+			MethodName: "FrozenAddresses",
+			Handler:    _Query_FrozenAddresses_Handler,
+		},
+		{
+			MethodName: "AllowlistedAddresses",
+			Handler:    _Query_AllowlistedAddresses_Handler,
+		},
+		{
+			MethodName: "ScheduledSend",
+			Handler:    _Query_ScheduledSend_Handler,
+		},
+		{
+			MethodName: "ScheduledSendsBySender",
+			Handler:    _Query_ScheduledSendsBySender_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
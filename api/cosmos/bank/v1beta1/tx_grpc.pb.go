@@ -19,10 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName                = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName           = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_UpdateParams_FullMethodName        = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName      = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_CreateDenom_FullMethodName         = "/cosmos.bank.v1beta1.Msg/CreateDenom"
+	Msg_Mint_FullMethodName                = "/cosmos.bank.v1beta1.Msg/Mint"
+	Msg_Burn_FullMethodName                = "/cosmos.bank.v1beta1.Msg/Burn"
+	Msg_ChangeAdmin_FullMethodName         = "/cosmos.bank.v1beta1.Msg/ChangeAdmin"
+	Msg_SetDenomMetadata_FullMethodName    = "/cosmos.bank.v1beta1.Msg/SetDenomMetadata"
+	Msg_SetDenomSendHook_FullMethodName    = "/cosmos.bank.v1beta1.Msg/SetDenomSendHook"
+	Msg_SetDenomCompliance_FullMethodName  = "/cosmos.bank.v1beta1.Msg/SetDenomCompliance"
+	Msg_Freeze_FullMethodName              = "/cosmos.bank.v1beta1.Msg/Freeze"
+	Msg_Unfreeze_FullMethodName            = "/cosmos.bank.v1beta1.Msg/Unfreeze"
+	Msg_Clawback_FullMethodName            = "/cosmos.bank.v1beta1.Msg/Clawback"
+	Msg_SetAllowlisted_FullMethodName      = "/cosmos.bank.v1beta1.Msg/SetAllowlisted"
+	Msg_ScheduleSend_FullMethodName        = "/cosmos.bank.v1beta1.Msg/ScheduleSend"
+	Msg_CancelScheduledSend_FullMethodName = "/cosmos.bank.v1beta1.Msg/CancelScheduledSend"
 )
 
 // MsgClient is the client API for Msg service.
@@ -43,6 +56,39 @@ type MsgClient interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// CreateDenom creates a factory denom, factory/{sender}/{subdenom}, of which
+	// the sender is the admin. The denom creation fee is burned from the sender.
+	CreateDenom(ctx context.Context, in *MsgCreateDenom, opts ...grpc.CallOption) (*MsgCreateDenomResponse, error)
+	// Mint mints coins of a factory denom. Only the admin of the denom can mint.
+	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
+	// Burn burns coins of a factory denom held by its admin.
+	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	// ChangeAdmin changes the admin of a factory denom.
+	ChangeAdmin(ctx context.Context, in *MsgChangeAdmin, opts ...grpc.CallOption) (*MsgChangeAdminResponse, error)
+	// SetDenomMetadata sets the metadata of a factory denom.
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	// SetDenomSendHook sets the send hook run on every transfer of a factory denom.
+	SetDenomSendHook(ctx context.Context, in *MsgSetDenomSendHook, opts ...grpc.CallOption) (*MsgSetDenomSendHookResponse, error)
+	// SetDenomCompliance sets the compliance controls of a denom, including its
+	// issuer. Only the authority, the current issuer of the denom or, for a
+	// factory denom, its admin can set them.
+	SetDenomCompliance(ctx context.Context, in *MsgSetDenomCompliance, opts ...grpc.CallOption) (*MsgSetDenomComplianceResponse, error)
+	// Freeze freezes an address for a denom. Only the issuer of the denom can freeze.
+	Freeze(ctx context.Context, in *MsgFreeze, opts ...grpc.CallOption) (*MsgFreezeResponse, error)
+	// Unfreeze unfreezes an address for a denom. Only the issuer of the denom can unfreeze.
+	Unfreeze(ctx context.Context, in *MsgUnfreeze, opts ...grpc.CallOption) (*MsgUnfreezeResponse, error)
+	// Clawback transfers coins of a denom from an address to the treasury of the
+	// denom. Only the issuer of the denom can claw back.
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
+	// SetAllowlisted adds an address to, or removes it from, the allowlist of a
+	// denom. Only the issuer of the denom can update the allowlist.
+	SetAllowlisted(ctx context.Context, in *MsgSetAllowlisted, opts ...grpc.CallOption) (*MsgSetAllowlistedResponse, error)
+	// ScheduleSend schedules a transfer from the sender, executed once or
+	// periodically at the end of the blocks.
+	ScheduleSend(ctx context.Context, in *MsgScheduleSend, opts ...grpc.CallOption) (*MsgScheduleSendResponse, error)
+	// CancelScheduledSend cancels the remaining executions of a scheduled send.
+	// Only the sender of the scheduled send can cancel it.
+	CancelScheduledSend(ctx context.Context, in *MsgCancelScheduledSend, opts ...grpc.CallOption) (*MsgCancelScheduledSendResponse, error)
 }
 
 type msgClient struct {
@@ -93,6 +139,136 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) CreateDenom(ctx context.Context, in *MsgCreateDenom, opts ...grpc.CallOption) (*MsgCreateDenomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgMintResponse)
+	err := c.cc.Invoke(ctx, Msg_Mint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, Msg_Burn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangeAdmin(ctx context.Context, in *MsgChangeAdmin, opts ...grpc.CallOption) (*MsgChangeAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgChangeAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_ChangeAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomSendHook(ctx context.Context, in *MsgSetDenomSendHook, opts ...grpc.CallOption) (*MsgSetDenomSendHookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomSendHookResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomSendHook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomCompliance(ctx context.Context, in *MsgSetDenomCompliance, opts ...grpc.CallOption) (*MsgSetDenomComplianceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomComplianceResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomCompliance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Freeze(ctx context.Context, in *MsgFreeze, opts ...grpc.CallOption) (*MsgFreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgFreezeResponse)
+	err := c.cc.Invoke(ctx, Msg_Freeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unfreeze(ctx context.Context, in *MsgUnfreeze, opts ...grpc.CallOption) (*MsgUnfreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnfreezeResponse)
+	err := c.cc.Invoke(ctx, Msg_Unfreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, Msg_Clawback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAllowlisted(ctx context.Context, in *MsgSetAllowlisted, opts ...grpc.CallOption) (*MsgSetAllowlistedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetAllowlistedResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAllowlisted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleSend(ctx context.Context, in *MsgScheduleSend, opts ...grpc.CallOption) (*MsgScheduleSendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgScheduleSendResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleSend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledSend(ctx context.Context, in *MsgCancelScheduledSend, opts ...grpc.CallOption) (*MsgCancelScheduledSendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelScheduledSendResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledSend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -111,6 +287,39 @@ type MsgServer interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// CreateDenom creates a factory denom, factory/{sender}/{subdenom}, of which
+	// the sender is the admin. The denom creation fee is burned from the sender.
+	CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error)
+	// Mint mints coins of a factory denom. Only the admin of the denom can mint.
+	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
+	// Burn burns coins of a factory denom held by its admin.
+	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
+	// ChangeAdmin changes the admin of a factory denom.
+	ChangeAdmin(context.Context, *MsgChangeAdmin) (*MsgChangeAdminResponse, error)
+	// SetDenomMetadata sets the metadata of a factory denom.
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
+	// SetDenomSendHook sets the send hook run on every transfer of a factory denom.
+	SetDenomSendHook(context.Context, *MsgSetDenomSendHook) (*MsgSetDenomSendHookResponse, error)
+	// SetDenomCompliance sets the compliance controls of a denom, including its
+	// issuer. Only the authority, the current issuer of the denom or, for a
+	// factory denom, its admin can set them.
+	SetDenomCompliance(context.Context, *MsgSetDenomCompliance) (*MsgSetDenomComplianceResponse, error)
+	// Freeze freezes an address for a denom. Only the issuer of the denom can freeze.
+	Freeze(context.Context, *MsgFreeze) (*MsgFreezeResponse, error)
+	// Unfreeze unfreezes an address for a denom. Only the issuer of the denom can unfreeze.
+	Unfreeze(context.Context, *MsgUnfreeze) (*MsgUnfreezeResponse, error)
+	// Clawback transfers coins of a denom from an address to the treasury of the
+	// denom. Only the issuer of the denom can claw back.
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
+	// SetAllowlisted adds an address to, or removes it from, the allowlist of a
+	// denom. Only the issuer of the denom can update the allowlist.
+	SetAllowlisted(context.Context, *MsgSetAllowlisted) (*MsgSetAllowlistedResponse, error)
+	// ScheduleSend schedules a transfer from the sender, executed once or
+	// periodically at the end of the blocks.
+	ScheduleSend(context.Context, *MsgScheduleSend) (*MsgScheduleSendResponse, error)
+	// CancelScheduledSend cancels the remaining executions of a scheduled send.
+	// Only the sender of the scheduled send can cancel it.
+	CancelScheduledSend(context.Context, *MsgCancelScheduledSend) (*MsgCancelScheduledSendResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -133,6 +342,45 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateDenom not implemented")
+}
+func (UnimplementedMsgServer) Mint(context.Context, *MsgMint) (*MsgMintResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Mint not implemented")
+}
+func (UnimplementedMsgServer) Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Burn not implemented")
+}
+func (UnimplementedMsgServer) ChangeAdmin(context.Context, *MsgChangeAdmin) (*MsgChangeAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeAdmin not implemented")
+}
+func (UnimplementedMsgServer) SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (UnimplementedMsgServer) SetDenomSendHook(context.Context, *MsgSetDenomSendHook) (*MsgSetDenomSendHookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDenomSendHook not implemented")
+}
+func (UnimplementedMsgServer) SetDenomCompliance(context.Context, *MsgSetDenomCompliance) (*MsgSetDenomComplianceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDenomCompliance not implemented")
+}
+func (UnimplementedMsgServer) Freeze(context.Context, *MsgFreeze) (*MsgFreezeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Freeze not implemented")
+}
+func (UnimplementedMsgServer) Unfreeze(context.Context, *MsgUnfreeze) (*MsgUnfreezeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Unfreeze not implemented")
+}
+func (UnimplementedMsgServer) Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Clawback not implemented")
+}
+func (UnimplementedMsgServer) SetAllowlisted(context.Context, *MsgSetAllowlisted) (*MsgSetAllowlistedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAllowlisted not implemented")
+}
+func (UnimplementedMsgServer) ScheduleSend(context.Context, *MsgScheduleSend) (*MsgScheduleSendResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScheduleSend not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledSend(context.Context, *MsgCancelScheduledSend) (*MsgCancelScheduledSendResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledSend not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -226,6 +474,240 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDenom(ctx, req.(*MsgCreateDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Mint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Mint(ctx, req.(*MsgMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Burn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ChangeAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeAdmin(ctx, req.(*MsgChangeAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomSendHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomSendHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomSendHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomSendHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomSendHook(ctx, req.(*MsgSetDenomSendHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomCompliance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomCompliance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomCompliance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomCompliance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomCompliance(ctx, req.(*MsgSetDenomCompliance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Freeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Freeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Freeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Freeze(ctx, req.(*MsgFreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unfreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unfreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unfreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unfreeze(ctx, req.(*MsgUnfreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Clawback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAllowlisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAllowlisted)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAllowlisted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAllowlisted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAllowlisted(ctx, req.(*MsgSetAllowlisted))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleSend(ctx, req.(*MsgScheduleSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledSend(ctx, req.(*MsgCancelScheduledSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -249,6 +731,58 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "CreateDenom",
+			Handler:    _Msg_CreateDenom_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Msg_Mint_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "ChangeAdmin",
+			Handler:    _Msg_ChangeAdmin_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "SetDenomSendHook",
+			Handler:    _Msg_SetDenomSendHook_Handler,
+		},
+		{
+			MethodName: "SetDenomCompliance",
+			Handler:    _Msg_SetDenomCompliance_Handler,
+		},
+		{
+			MethodName: "Freeze",
+			Handler:    _Msg_Freeze_Handler,
+		},
+		{
+			MethodName: "Unfreeze",
+			Handler:    _Msg_Unfreeze_Handler,
+		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
+		{
+			MethodName: "SetAllowlisted",
+			Handler:    _Msg_SetAllowlisted_Handler,
+		},
+		{
+			MethodName: "ScheduleSend",
+			Handler:    _Msg_ScheduleSend_Handler,
+		},
+		{
+			MethodName: "CancelScheduledSend",
+			Handler:    _Msg_CancelScheduledSend_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
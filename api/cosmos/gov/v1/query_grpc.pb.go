@@ -19,15 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Constitution_FullMethodName = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName     = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName    = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName         = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName        = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName       = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName      = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName     = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName  = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Constitution_FullMethodName        = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName            = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName           = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName               = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName              = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName             = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName            = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Governor_FullMethodName            = "/cosmos.gov.v1.Query/Governor"
+	Query_Governors_FullMethodName           = "/cosmos.gov.v1.Query/Governors"
+	Query_GovernorDelegations_FullMethodName = "/cosmos.gov.v1.Query/GovernorDelegations"
+	Query_DelegatorGovernor_FullMethodName   = "/cosmos.gov.v1.Query/DelegatorGovernor"
 )
 
 // QueryClient is the client API for Query service.
@@ -54,6 +58,15 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// Governor queries a governor by its address.
+	Governor(ctx context.Context, in *QueryGovernorRequest, opts ...grpc.CallOption) (*QueryGovernorResponse, error)
+	// Governors queries all the governors.
+	Governors(ctx context.Context, in *QueryGovernorsRequest, opts ...grpc.CallOption) (*QueryGovernorsResponse, error)
+	// GovernorDelegations queries the delegations assigned to a governor.
+	GovernorDelegations(ctx context.Context, in *QueryGovernorDelegationsRequest, opts ...grpc.CallOption) (*QueryGovernorDelegationsResponse, error)
+	// DelegatorGovernor queries the governor a delegator assigned its voting
+	// power to.
+	DelegatorGovernor(ctx context.Context, in *QueryDelegatorGovernorRequest, opts ...grpc.CallOption) (*QueryDelegatorGovernorResponse, error)
 }
 
 type queryClient struct {
@@ -154,6 +167,46 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) Governor(ctx context.Context, in *QueryGovernorRequest, opts ...grpc.CallOption) (*QueryGovernorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGovernorResponse)
+	err := c.cc.Invoke(ctx, Query_Governor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Governors(ctx context.Context, in *QueryGovernorsRequest, opts ...grpc.CallOption) (*QueryGovernorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGovernorsResponse)
+	err := c.cc.Invoke(ctx, Query_Governors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovernorDelegations(ctx context.Context, in *QueryGovernorDelegationsRequest, opts ...grpc.CallOption) (*QueryGovernorDelegationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGovernorDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_GovernorDelegations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorGovernor(ctx context.Context, in *QueryDelegatorGovernorRequest, opts ...grpc.CallOption) (*QueryDelegatorGovernorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDelegatorGovernorResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorGovernor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -178,6 +231,15 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// Governor queries a governor by its address.
+	Governor(context.Context, *QueryGovernorRequest) (*QueryGovernorResponse, error)
+	// Governors queries all the governors.
+	Governors(context.Context, *QueryGovernorsRequest) (*QueryGovernorsResponse, error)
+	// GovernorDelegations queries the delegations assigned to a governor.
+	GovernorDelegations(context.Context, *QueryGovernorDelegationsRequest) (*QueryGovernorDelegationsResponse, error)
+	// DelegatorGovernor queries the governor a delegator assigned its voting
+	// power to.
+	DelegatorGovernor(context.Context, *QueryDelegatorGovernorRequest) (*QueryDelegatorGovernorResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -215,6 +277,18 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) Governor(context.Context, *QueryGovernorRequest) (*QueryGovernorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Governor not implemented")
+}
+func (UnimplementedQueryServer) Governors(context.Context, *QueryGovernorsRequest) (*QueryGovernorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Governors not implemented")
+}
+func (UnimplementedQueryServer) GovernorDelegations(context.Context, *QueryGovernorDelegationsRequest) (*QueryGovernorDelegationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GovernorDelegations not implemented")
+}
+func (UnimplementedQueryServer) DelegatorGovernor(context.Context, *QueryDelegatorGovernorRequest) (*QueryDelegatorGovernorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DelegatorGovernor not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -398,6 +472,78 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Governor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Governor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Governor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Governor(ctx, req.(*QueryGovernorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Governors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Governors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Governors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Governors(ctx, req.(*QueryGovernorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovernorDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernorDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovernorDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernorDelegations(ctx, req.(*QueryGovernorDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorGovernorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorGovernor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorGovernor(ctx, req.(*QueryDelegatorGovernorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -441,6 +587,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "Governor",
+			Handler:    _Query_Governor_Handler,
+		},
+		{
+			MethodName: "Governors",
+			Handler:    _Query_Governors_Handler,
+		},
+		{
+			MethodName: "GovernorDelegations",
+			Handler:    _Query_GovernorDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatorGovernor",
+			Handler:    _Query_DelegatorGovernor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
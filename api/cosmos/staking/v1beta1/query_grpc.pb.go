@@ -33,6 +33,14 @@ const (
 	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
 	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
 	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_TokenizeShareRecord_FullMethodName           = "/cosmos.staking.v1beta1.Query/TokenizeShareRecord"
+	Query_TokenizeShareRecordsOwned_FullMethodName     = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordsOwned"
+	Query_TotalLiquidStaked_FullMethodName             = "/cosmos.staking.v1beta1.Query/TotalLiquidStaked"
+	Query_ValidatorLiquidShares_FullMethodName         = "/cosmos.staking.v1beta1.Query/ValidatorLiquidShares"
+	Query_UnbondingQueue_FullMethodName                = "/cosmos.staking.v1beta1.Query/UnbondingQueue"
+	Query_RedelegationQueue_FullMethodName             = "/cosmos.staking.v1beta1.Query/RedelegationQueue"
+	Query_DelegatorPendingEntries_FullMethodName       = "/cosmos.staking.v1beta1.Query/DelegatorPendingEntries"
+	Query_ValidatorCommissionSchedule_FullMethodName   = "/cosmos.staking.v1beta1.Query/ValidatorCommissionSchedule"
 )
 
 // QueryClient is the client API for Query service.
@@ -94,6 +102,34 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by its id.
+	TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records of an owner.
+	TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// TotalLiquidStaked queries the amount of tokens staked through tokenized
+	// shares.
+	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
+	// ValidatorLiquidShares queries the tokenized delegator shares of a
+	// validator.
+	ValidatorLiquidShares(ctx context.Context, in *QueryValidatorLiquidSharesRequest, opts ...grpc.CallOption) (*QueryValidatorLiquidSharesResponse, error)
+	// UnbondingQueue queries the time slices of the unbonding queue completing
+	// within a time range.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	UnbondingQueue(ctx context.Context, in *QueryUnbondingQueueRequest, opts ...grpc.CallOption) (*QueryUnbondingQueueResponse, error)
+	// RedelegationQueue queries the time slices of the redelegation queue
+	// completing within a time range.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	RedelegationQueue(ctx context.Context, in *QueryRedelegationQueueRequest, opts ...grpc.CallOption) (*QueryRedelegationQueueResponse, error)
+	// DelegatorPendingEntries queries the pending unbonding delegation and
+	// redelegation entries of a delegator, ordered by completion time.
+	DelegatorPendingEntries(ctx context.Context, in *QueryDelegatorPendingEntriesRequest, opts ...grpc.CallOption) (*QueryDelegatorPendingEntriesResponse, error)
+	// ValidatorCommissionSchedule queries the commission rate change scheduled
+	// by a validator.
+	ValidatorCommissionSchedule(ctx context.Context, in *QueryValidatorCommissionScheduleRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -244,6 +280,86 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTokenizeShareRecordsOwnedResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordsOwned_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTotalLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, Query_TotalLiquidStaked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorLiquidShares(ctx context.Context, in *QueryValidatorLiquidSharesRequest, opts ...grpc.CallOption) (*QueryValidatorLiquidSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorLiquidSharesResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorLiquidShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingQueue(ctx context.Context, in *QueryUnbondingQueueRequest, opts ...grpc.CallOption) (*QueryUnbondingQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUnbondingQueueResponse)
+	err := c.cc.Invoke(ctx, Query_UnbondingQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedelegationQueue(ctx context.Context, in *QueryRedelegationQueueRequest, opts ...grpc.CallOption) (*QueryRedelegationQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRedelegationQueueResponse)
+	err := c.cc.Invoke(ctx, Query_RedelegationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorPendingEntries(ctx context.Context, in *QueryDelegatorPendingEntriesRequest, opts ...grpc.CallOption) (*QueryDelegatorPendingEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDelegatorPendingEntriesResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorPendingEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorCommissionSchedule(ctx context.Context, in *QueryValidatorCommissionScheduleRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorCommissionScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorCommissionSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -303,6 +419,34 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by its id.
+	TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records of an owner.
+	TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// TotalLiquidStaked queries the amount of tokens staked through tokenized
+	// shares.
+	TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error)
+	// ValidatorLiquidShares queries the tokenized delegator shares of a
+	// validator.
+	ValidatorLiquidShares(context.Context, *QueryValidatorLiquidSharesRequest) (*QueryValidatorLiquidSharesResponse, error)
+	// UnbondingQueue queries the time slices of the unbonding queue completing
+	// within a time range.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	UnbondingQueue(context.Context, *QueryUnbondingQueueRequest) (*QueryUnbondingQueueResponse, error)
+	// RedelegationQueue queries the time slices of the redelegation queue
+	// completing within a time range.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	RedelegationQueue(context.Context, *QueryRedelegationQueueRequest) (*QueryRedelegationQueueResponse, error)
+	// DelegatorPendingEntries queries the pending unbonding delegation and
+	// redelegation entries of a delegator, ordered by completion time.
+	DelegatorPendingEntries(context.Context, *QueryDelegatorPendingEntriesRequest) (*QueryDelegatorPendingEntriesResponse, error)
+	// ValidatorCommissionSchedule queries the commission rate change scheduled
+	// by a validator.
+	ValidatorCommissionSchedule(context.Context, *QueryValidatorCommissionScheduleRequest) (*QueryValidatorCommissionScheduleResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -355,6 +499,30 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TokenizeShareRecord not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TokenizeShareRecordsOwned not implemented")
+}
+func (UnimplementedQueryServer) TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TotalLiquidStaked not implemented")
+}
+func (UnimplementedQueryServer) ValidatorLiquidShares(context.Context, *QueryValidatorLiquidSharesRequest) (*QueryValidatorLiquidSharesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidatorLiquidShares not implemented")
+}
+func (UnimplementedQueryServer) UnbondingQueue(context.Context, *QueryUnbondingQueueRequest) (*QueryUnbondingQueueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnbondingQueue not implemented")
+}
+func (UnimplementedQueryServer) RedelegationQueue(context.Context, *QueryRedelegationQueueRequest) (*QueryRedelegationQueueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RedelegationQueue not implemented")
+}
+func (UnimplementedQueryServer) DelegatorPendingEntries(context.Context, *QueryDelegatorPendingEntriesRequest) (*QueryDelegatorPendingEntriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DelegatorPendingEntries not implemented")
+}
+func (UnimplementedQueryServer) ValidatorCommissionSchedule(context.Context, *QueryValidatorCommissionScheduleRequest) (*QueryValidatorCommissionScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidatorCommissionSchedule not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -628,6 +796,150 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenizeShareRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, req.(*QueryTokenizeShareRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordsOwned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordsOwnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordsOwned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, req.(*QueryTokenizeShareRecordsOwnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalLiquidStaked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, req.(*QueryTotalLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorLiquidShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorLiquidSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorLiquidShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorLiquidShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorLiquidShares(ctx, req.(*QueryValidatorLiquidSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnbondingQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingQueue(ctx, req.(*QueryUnbondingQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedelegationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedelegationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RedelegationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedelegationQueue(ctx, req.(*QueryRedelegationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorPendingEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorPendingEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorPendingEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorPendingEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorPendingEntries(ctx, req.(*QueryDelegatorPendingEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorCommissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCommissionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorCommissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorCommissionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorCommissionSchedule(ctx, req.(*QueryValidatorCommissionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -691,6 +1003,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TokenizeShareRecord",
+			Handler:    _Query_TokenizeShareRecord_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordsOwned",
+			Handler:    _Query_TokenizeShareRecordsOwned_Handler,
+		},
+		{
+			MethodName: "TotalLiquidStaked",
+			Handler:    _Query_TotalLiquidStaked_Handler,
+		},
+		{
+			MethodName: "ValidatorLiquidShares",
+			Handler:    _Query_ValidatorLiquidShares_Handler,
+		},
+		{
+			MethodName: "UnbondingQueue",
+			Handler:    _Query_UnbondingQueue_Handler,
+		},
+		{
+			MethodName: "RedelegationQueue",
+			Handler:    _Query_RedelegationQueue_Handler,
+		},
+		{
+			MethodName: "DelegatorPendingEntries",
+			Handler:    _Query_DelegatorPendingEntries_Handler,
+		},
+		{
+			MethodName: "ValidatorCommissionSchedule",
+			Handler:    _Query_ValidatorCommissionSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
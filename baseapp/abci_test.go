@@ -883,6 +883,78 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+type gasProfileAnteDecorator struct{}
+
+func (gasProfileAnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	ctx.KVStore(capKey1).Set([]byte("ante"), []byte("value"))
+	return next(ctx, tx, simulate)
+}
+
+func TestABCI_SimulateWithGasProfile(t *testing.T) {
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(sdk.ChainAnteDecorators(gasProfileAnteDecorator{}))
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), mockCounterServer{
+		incrementCounterFn: func(ctx context.Context, _ *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+			sdkCtx := sdk.UnwrapSDKContext(ctx)
+			sdkCtx.KVStore(capKey2).Get([]byte("msg"))
+			sdkCtx.GasMeter().ConsumeGas(7, "test")
+			return &baseapptestutil.MsgCreateCounterResponse{}, nil
+		},
+	})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+
+	gInfo, result, profile, err := suite.baseApp.SimulateWithGasProfile(txBytes)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotNil(t, profile)
+	require.Equal(t, gInfo.GasUsed, profile.GasUsed)
+
+	kvGasConfig := storetypes.KVGasConfig()
+	require.Equal(t, kvGasConfig.WriteCostFlat, profile.KvGasConfig.WriteCostFlat)
+
+	// ante decorator wrote 4 + 5 bytes to key1, the message read the 3 bytes
+	// key "msg" from key2 and charged 7 gas outside of any store
+	anteGas := kvGasConfig.WriteCostFlat + 9*kvGasConfig.WriteCostPerByte
+	msgGas := kvGasConfig.ReadCostFlat + 3*kvGasConfig.ReadCostPerByte + 7
+	require.Equal(t, anteGas+msgGas, gInfo.GasUsed)
+
+	require.Len(t, profile.Scopes, 2)
+	require.Equal(t, "ante/baseapp_test.gasProfileAnteDecorator", profile.Scopes[0].Name)
+	require.Equal(t, anteGas, profile.Scopes[0].GasUsed)
+	require.Equal(t, "msg/0/"+sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}), profile.Scopes[1].Name)
+	require.Equal(t, msgGas, profile.Scopes[1].GasUsed)
+
+	require.Equal(t, []sdk.StoreGasProfile{
+		{Name: "", GasUsed: 7},
+		{Name: "key1", GasUsed: anteGas, Operations: []sdk.OperationGasProfile{
+			{Operation: sdk.GasOperationWrite, Count: 1, FlatGas: kvGasConfig.WriteCostFlat, PerByteGas: 9 * kvGasConfig.WriteCostPerByte},
+		}},
+		{Name: "key2", GasUsed: msgGas - 7, Operations: []sdk.OperationGasProfile{
+			{Operation: sdk.GasOperationRead, Count: 1, FlatGas: kvGasConfig.ReadCostFlat, PerByteGas: 3 * kvGasConfig.ReadCostPerByte},
+		}},
+	}, profile.Stores)
+
+	require.Equal(t, []sdk.OperationGasProfile{
+		{Operation: sdk.GasOperationRead, Count: 1, FlatGas: kvGasConfig.ReadCostFlat, PerByteGas: 3 * kvGasConfig.ReadCostPerByte},
+		{Operation: sdk.GasOperationWrite, Count: 1, FlatGas: kvGasConfig.WriteCostFlat, PerByteGas: 9 * kvGasConfig.WriteCostPerByte},
+	}, profile.Operations)
+
+	// plain simulation reports the same gas usage
+	plainInfo, _, err := suite.baseApp.Simulate(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, gInfo, plainInfo)
+}
+
 func TestABCI_AnteHandlerContextValuesReachMsgServer(t *testing.T) {
 	type sdkCtxKey struct{}
 	type goCtxKey struct{}
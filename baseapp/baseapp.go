@@ -139,6 +139,10 @@ type BaseApp struct {
 	// trace set will return full stack traces for errors in ABCI Log field
 	trace bool
 
+	// gasProfiling enables the gas profiling of simulated transactions, which
+	// is meant for debugging only.
+	gasProfiling bool
+
 	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
@@ -268,6 +272,12 @@ func (app *BaseApp) Trace() bool {
 	return app.trace
 }
 
+// GasProfiling returns whether the gas profiling of simulated transactions is
+// enabled.
+func (app *BaseApp) GasProfiling() bool {
+	return app.gasProfiling
+}
+
 // MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgServiceRouter }
 
@@ -294,6 +304,9 @@ func (app *BaseApp) MountStores(keys ...storetypes.StoreKey) {
 		case *storetypes.MemoryStoreKey:
 			app.MountStore(key, storetypes.StoreTypeMemory)
 
+		case *storetypes.ObjectStoreKey:
+			app.MountStore(key, storetypes.StoreTypeObject)
+
 		default:
 			panic(fmt.Sprintf("Unrecognized store key type :%T", key))
 		}
@@ -332,6 +345,14 @@ func (app *BaseApp) MountMemoryStores(keys map[string]*storetypes.MemoryStoreKey
 	}
 }
 
+// MountObjectStores mounts all object stores to the provided keys in the
+// BaseApp multistore.
+func (app *BaseApp) MountObjectStores(keys map[string]*storetypes.ObjectStoreKey) {
+	for _, key := range keys {
+		app.MountStore(key, storetypes.StoreTypeObject)
+	}
+}
+
 // MountStore mounts a store to the provided key in the BaseApp multistore,
 // using the default DB.
 func (app *BaseApp) MountStore(key storetypes.StoreKey, typ storetypes.StoreType) {
@@ -454,6 +475,10 @@ func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
 
+func (app *BaseApp) setGasProfiling(gasProfiling bool) {
+	app.gasProfiling = gasProfiling
+}
+
 func (app *BaseApp) setIndexEvents(ie []string) {
 	app.indexEvents = make(map[string]struct{}, len(ie))
 
@@ -769,7 +794,16 @@ func (app *BaseApp) endBlock() (sdk.EndBlock, error) {
 // both txbytes and the decoded tx are passed to runTx to avoid the state machine encoding the tx and decoding the transaction twice
 // passing the decoded tx to runTX is optional, it will be decoded if the tx is nil
 func (app *BaseApp) RunTx(mode sdk.ExecMode, txBytes []byte, tx sdk.Tx, txIndex int, txMultiStore storetypes.MultiStore, incarnationCache map[string]any) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTx(mode, txBytes, tx, txIndex, txMultiStore, incarnationCache, nil)
+}
+
+// runTx implements RunTx. If gasProfiler is not nil, it records the gas
+// consumed by the transaction.
+func (app *BaseApp) runTx(mode sdk.ExecMode, txBytes []byte, tx sdk.Tx, txIndex int, txMultiStore storetypes.MultiStore, incarnationCache map[string]any, gasProfiler *sdk.GasProfiler) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	ctx := app.getContextForTx(mode, txBytes, txIndex)
+	if gasProfiler != nil {
+		ctx = ctx.WithGasProfiler(gasProfiler)
+	}
 	ctx, span := ctx.StartSpan(tracer, "runTx")
 	defer span.End()
 
@@ -1012,8 +1046,16 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 				attribute.Int("msg_index", i),
 			),
 		)
+		var exitScope func()
+		if profiler := ctx.GasProfiler(); profiler != nil {
+			exitScope = profiler.EnterScope(fmt.Sprintf("msg/%d/%s", i, sdk.MsgTypeURL(msg)))
+		}
+
 		// ADR 031 request type routing
 		msgResult, err := handler(ctx, msg)
+		if exitScope != nil {
+			exitScope()
+		}
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
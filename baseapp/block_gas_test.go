@@ -176,7 +176,14 @@ func TestBaseApp_BlockGas(t *testing.T) {
 			}
 			// check block gas is always consumed
 			baseGas := uint64(58386) // baseGas is the gas consumed before tx msg
-			expGasConsumed := min(addUint64Saturating(tc.gasToConsume, baseGas), uint64(simtestutil.DefaultConsensusParams.Block.MaxGas))
+			// msgFeeGas is the gas consumed by the MsgFeeDecorator reading the
+			// consensus minimum gas prices from the x/auth params and the fee
+			// of the tx message type
+			msgFeeGas := uint64(2135)
+			// pubKeyRotationGas is the gas consumed reading the public key
+			// rotation fields added to the x/auth params
+			pubKeyRotationGas := uint64(135)
+			expGasConsumed := min(addUint64Saturating(tc.gasToConsume, baseGas+msgFeeGas+pubKeyRotationGas), uint64(simtestutil.DefaultConsensusParams.Block.MaxGas))
 			require.Equal(t, int(expGasConsumed), int(ctx.BlockGasMeter().GasConsumed()))
 			// tx fee is always deducted
 			require.Equal(t, int64(0), bankKeeper.GetBalance(ctx, addr1, feeCoin.Denom).Amount.Int64())
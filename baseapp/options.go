@@ -69,6 +69,12 @@ func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
 }
 
+// SetGasProfiling will turn on or off the gas profiling of simulated
+// transactions. It is meant for debugging and must stay off on public nodes.
+func SetGasProfiling(gasProfiling bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.setGasProfiling(gasProfiling) }
+}
+
 // SetIndexEvents provides a BaseApp option function that sets the events to index.
 func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
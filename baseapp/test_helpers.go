@@ -29,6 +29,15 @@ func (app *BaseApp) Simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
 	return gasInfo, result, err
 }
 
+// SimulateWithGasProfile executes a tx in simulate mode like Simulate and
+// additionally returns a breakdown of the gas consumed per ante decorator,
+// message handler, post decorator, store and store operation.
+func (app *BaseApp) SimulateWithGasProfile(txBytes []byte) (sdk.GasInfo, *sdk.Result, *sdk.GasProfile, error) {
+	profiler := sdk.NewGasProfiler()
+	gasInfo, result, _, err := app.runTx(execModeSimulate, txBytes, nil, -1, nil, nil, profiler)
+	return gasInfo, result, profiler.GasProfile(gasInfo.GasUsed), err
+}
+
 func (app *BaseApp) SimDeliver(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
 	// See comment for Check().
 	bz, err := txEncoder(tx)
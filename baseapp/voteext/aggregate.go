@@ -0,0 +1,114 @@
+package voteext
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// ErrNoVotes is returned when aggregating an empty set of votes.
+	ErrNoVotes = errors.New("no vote extensions to aggregate")
+	// ErrThresholdNotReached is returned when no value reached the required
+	// share of the voting power.
+	ErrThresholdNotReached = errors.New("no vote extension value reached the voting power threshold")
+)
+
+// Vote defines the decoded vote extension data of a single validator.
+type Vote[T any] struct {
+	Validator sdk.ConsAddress
+	Power     int64
+	Value     T
+}
+
+// StakeWeightedMedian returns the stake-weighted median of the votes, using cmp
+// to order the values. The median is the smallest value for which the voting
+// power of the votes lower or equal to it reaches half of the total voting
+// power of the votes. Ties between equal values are broken by validator
+// address so the result is deterministic. Votes with a non-positive power are
+// ignored.
+func StakeWeightedMedian[T any](votes []Vote[T], cmp func(a, b T) int) (T, error) {
+	var (
+		zero       T
+		totalPower int64
+		sorted     = make([]Vote[T], 0, len(votes))
+	)
+
+	for _, vote := range votes {
+		if vote.Power <= 0 {
+			continue
+		}
+
+		totalPower += vote.Power
+		sorted = append(sorted, vote)
+	}
+
+	if len(sorted) == 0 {
+		return zero, ErrNoVotes
+	}
+
+	slices.SortFunc(sorted, func(a, b Vote[T]) int {
+		if c := cmp(a.Value, b.Value); c != 0 {
+			return c
+		}
+		return bytes.Compare(a.Validator, b.Validator)
+	})
+
+	var cumulativePower int64
+	for _, vote := range sorted {
+		cumulativePower += vote.Power
+		if cumulativePower*2 >= totalPower {
+			return vote.Value, nil
+		}
+	}
+
+	// unreachable, the cumulative power of all votes equals the total power
+	return sorted[len(sorted)-1].Value, nil
+}
+
+// StakeWeightedThreshold returns the value voted for by validators holding at
+// least the given share of the total voting power, e.g. 2/3. Values are
+// considered equal when key returns the same result for them. If several
+// values reach the threshold, which is only possible for a threshold of at most
+// 1/2, the one voted first in the order of the votes is returned.
+func StakeWeightedThreshold[T any, K comparable](
+	votes []Vote[T],
+	totalPower int64,
+	threshold math.LegacyDec,
+	key func(T) K,
+) (T, error) {
+	var zero T
+
+	if threshold.IsNil() || !threshold.IsPositive() || threshold.GT(math.LegacyOneDec()) {
+		return zero, fmt.Errorf("threshold must be in (0, 1], got %s", threshold)
+	}
+
+	if totalPower <= 0 {
+		return zero, fmt.Errorf("total voting power must be positive, got %d", totalPower)
+	}
+
+	if len(votes) == 0 {
+		return zero, ErrNoVotes
+	}
+
+	powerByKey := make(map[K]int64, len(votes))
+	for _, vote := range votes {
+		if vote.Power > 0 {
+			powerByKey[key(vote.Value)] += vote.Power
+		}
+	}
+
+	requiredPower := threshold.MulInt64(totalPower)
+	for _, vote := range votes {
+		if math.LegacyNewDec(powerByKey[key(vote.Value)]).GTE(requiredPower) {
+			return vote.Value, nil
+		}
+	}
+
+	return zero, ErrThresholdNotReached
+}
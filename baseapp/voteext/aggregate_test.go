@@ -0,0 +1,156 @@
+package voteext_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/voteext"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func vote(addr byte, power int64, value int64) voteext.Vote[int64] {
+	return voteext.Vote[int64]{
+		Validator: sdk.ConsAddress{addr},
+		Power:     power,
+		Value:     value,
+	}
+}
+
+func TestStakeWeightedMedian(t *testing.T) {
+	testCases := []struct {
+		name   string
+		votes  []voteext.Vote[int64]
+		expect int64
+		expErr error
+	}{
+		{
+			name:   "no votes",
+			expErr: voteext.ErrNoVotes,
+		},
+		{
+			name:   "only non-positive power",
+			votes:  []voteext.Vote[int64]{vote(1, 0, 10), vote(2, -1, 20)},
+			expErr: voteext.ErrNoVotes,
+		},
+		{
+			name:   "single vote",
+			votes:  []voteext.Vote[int64]{vote(1, 10, 42)},
+			expect: 42,
+		},
+		{
+			name:   "equal power",
+			votes:  []voteext.Vote[int64]{vote(1, 10, 30), vote(2, 10, 10), vote(3, 10, 20)},
+			expect: 20,
+		},
+		{
+			name:   "weighted by power",
+			votes:  []voteext.Vote[int64]{vote(1, 10, 10), vote(2, 10, 20), vote(3, 60, 100)},
+			expect: 100,
+		},
+		{
+			name:   "exactly half",
+			votes:  []voteext.Vote[int64]{vote(1, 50, 10), vote(2, 50, 20)},
+			expect: 10,
+		},
+		{
+			name:   "ignores non-positive power",
+			votes:  []voteext.Vote[int64]{vote(1, 10, 10), vote(2, 0, 1000), vote(3, 30, 20)},
+			expect: 20,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			median, err := voteext.StakeWeightedMedian(tc.votes, cmp.Compare[int64])
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, median)
+		})
+	}
+}
+
+func TestStakeWeightedThreshold(t *testing.T) {
+	twoThirds := math.LegacyNewDecWithPrec(667, 3)
+	identity := func(v int64) int64 { return v }
+
+	testCases := []struct {
+		name       string
+		votes      []voteext.Vote[int64]
+		totalPower int64
+		threshold  math.LegacyDec
+		expect     int64
+		expErr     error
+		expErrStr  string
+	}{
+		{
+			name:       "no votes",
+			totalPower: 100,
+			threshold:  twoThirds,
+			expErr:     voteext.ErrNoVotes,
+		},
+		{
+			name:       "threshold reached",
+			votes:      []voteext.Vote[int64]{vote(1, 40, 7), vote(2, 30, 7), vote(3, 30, 8)},
+			totalPower: 100,
+			threshold:  twoThirds,
+			expect:     7,
+		},
+		{
+			name:       "threshold not reached",
+			votes:      []voteext.Vote[int64]{vote(1, 40, 7), vote(2, 30, 8), vote(3, 30, 7)},
+			totalPower: 100,
+			threshold:  math.LegacyNewDecWithPrec(75, 2),
+			expErr:     voteext.ErrThresholdNotReached,
+		},
+		{
+			name:       "absent validators count towards total power",
+			votes:      []voteext.Vote[int64]{vote(1, 40, 7), vote(2, 20, 7)},
+			totalPower: 100,
+			threshold:  twoThirds,
+			expErr:     voteext.ErrThresholdNotReached,
+		},
+		{
+			name:       "first value in vote order wins",
+			votes:      []voteext.Vote[int64]{vote(1, 50, 8), vote(2, 50, 7)},
+			totalPower: 100,
+			threshold:  math.LegacyNewDecWithPrec(5, 1),
+			expect:     8,
+		},
+		{
+			name:       "invalid threshold",
+			votes:      []voteext.Vote[int64]{vote(1, 50, 8)},
+			totalPower: 100,
+			threshold:  math.LegacyNewDec(2),
+			expErrStr:  "threshold must be in (0, 1]",
+		},
+		{
+			name:      "invalid total power",
+			votes:     []voteext.Vote[int64]{vote(1, 50, 8)},
+			threshold: twoThirds,
+			expErrStr: "total voting power must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := voteext.StakeWeightedThreshold(tc.votes, tc.totalPower, tc.threshold, identity)
+			switch {
+			case tc.expErr != nil:
+				require.ErrorIs(t, err, tc.expErr)
+			case tc.expErrStr != "":
+				require.ErrorContains(t, err, tc.expErrStr)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, tc.expect, value)
+			}
+		})
+	}
+}
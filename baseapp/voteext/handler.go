@@ -0,0 +1,164 @@
+// Package voteext provides a framework for modules to contribute typed data to
+// CometBFT vote extensions.
+//
+// Modules register a Handler producing and verifying their own vote extension
+// data. The Manager multiplexes the data of every registered handler into a
+// single vote extension, injects the extended commit of the previous height as
+// the first transaction of a block proposal in PrepareProposal and verifies the
+// injected data in ProcessProposal. Modules can then decode the votes of their
+// handler from the injected commit, e.g. in a PreBlocker, and aggregate them
+// using the stake-weighted helpers of this package.
+package voteext
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	collcodec "cosmossdk.io/collections/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Handler defines the interface a module implements in order to contribute
+// data to the vote extension of a validator.
+type Handler interface {
+	// Name returns the unique name of the handler, typically the module name.
+	Name() string
+
+	// ExtendVote returns the encoded vote extension data of the handler.
+	ExtendVote(ctx sdk.Context, req *abci.RequestExtendVote) ([]byte, error)
+
+	// VerifyVoteExtension verifies the encoded vote extension data of the
+	// handler received from another validator.
+	VerifyVoteExtension(ctx sdk.Context, req *abci.RequestVerifyVoteExtension, data []byte) error
+
+	// ValidateData performs stateless validation of the encoded vote extension
+	// data of the handler. It is used in ProcessProposal to verify the vote
+	// extensions injected by the proposer.
+	ValidateData(data []byte) error
+}
+
+// HasVoteExtensionHandler is the extension interface that app modules
+// contributing to vote extensions must implement.
+type HasVoteExtensionHandler interface {
+	VoteExtensionHandler() Handler
+}
+
+// ExtendVoteFn defines the function producing the typed vote extension data of
+// a handler.
+type ExtendVoteFn[T any] func(ctx sdk.Context, req *abci.RequestExtendVote) (T, error)
+
+// VerifyVoteExtensionFn defines the function verifying the typed vote
+// extension data of a handler received from another validator.
+type VerifyVoteExtensionFn[T any] func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension, ext T) error
+
+var _ Handler = (*TypedHandler[any])(nil)
+
+// TypedHandler is a Handler which encodes and decodes its vote extension data
+// of type T using a collections ValueCodec.
+type TypedHandler[T any] struct {
+	name       string
+	valueCodec collcodec.ValueCodec[T]
+	extendFn   ExtendVoteFn[T]
+	verifyFn   VerifyVoteExtensionFn[T]
+}
+
+// NewHandler returns a new TypedHandler. The verify function is optional, if
+// nil the vote extension data is only checked to be decodable.
+func NewHandler[T any](
+	name string,
+	valueCodec collcodec.ValueCodec[T],
+	extendFn ExtendVoteFn[T],
+	verifyFn VerifyVoteExtensionFn[T],
+) *TypedHandler[T] {
+	return &TypedHandler[T]{
+		name:       name,
+		valueCodec: valueCodec,
+		extendFn:   extendFn,
+		verifyFn:   verifyFn,
+	}
+}
+
+// Name implements Handler.
+func (h *TypedHandler[T]) Name() string {
+	return h.name
+}
+
+// ExtendVote implements Handler.
+func (h *TypedHandler[T]) ExtendVote(ctx sdk.Context, req *abci.RequestExtendVote) ([]byte, error) {
+	ext, err := h.extendFn(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.valueCodec.Encode(ext)
+}
+
+// VerifyVoteExtension implements Handler.
+func (h *TypedHandler[T]) VerifyVoteExtension(ctx sdk.Context, req *abci.RequestVerifyVoteExtension, data []byte) error {
+	ext, err := h.Decode(data)
+	if err != nil {
+		return err
+	}
+
+	if h.verifyFn == nil {
+		return nil
+	}
+
+	return h.verifyFn(ctx, req, ext)
+}
+
+// ValidateData implements Handler.
+func (h *TypedHandler[T]) ValidateData(data []byte) error {
+	_, err := h.Decode(data)
+	return err
+}
+
+// Decode decodes the vote extension data of the handler.
+func (h *TypedHandler[T]) Decode(data []byte) (T, error) {
+	ext, err := h.valueCodec.Decode(data)
+	if err != nil {
+		return ext, fmt.Errorf("failed to decode %s vote extension: %w", h.name, err)
+	}
+
+	return ext, nil
+}
+
+// Votes decodes the vote extension data of the handler from every validator
+// that committed the block in the given extended commit. Validators which did
+// not provide data for the handler are skipped. The total voting power of the
+// commit is returned alongside the votes, so it can be used as the denominator
+// of threshold based aggregations.
+func (h *TypedHandler[T]) Votes(commit abci.ExtendedCommitInfo) ([]Vote[T], int64, error) {
+	var (
+		votes      []Vote[T]
+		totalPower int64
+	)
+
+	for _, vote := range commit.Votes {
+		totalPower += vote.Validator.Power
+
+		data, ok, err := moduleData(vote, h.name)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		value, err := h.Decode(data)
+		if err != nil {
+			return nil, 0, fmt.Errorf("validator %X: %w", vote.Validator.Address, err)
+		}
+
+		votes = append(votes, Vote[T]{
+			Validator: sdk.ConsAddress(vote.Validator.Address),
+			Power:     vote.Validator.Power,
+			Value:     value,
+		})
+	}
+
+	return votes, totalPower, nil
+}
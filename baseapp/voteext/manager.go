@@ -0,0 +1,316 @@
+package voteext
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Manager multiplexes the vote extensions of a set of handlers into a single
+// vote extension and manages the injection of the extended commit into block
+// proposals.
+type Manager struct {
+	valStore baseapp.ValidatorStore
+	handlers []Handler
+}
+
+// NewManager returns a new Manager for the given handlers. Handler names must
+// be unique and non-empty.
+func NewManager(valStore baseapp.ValidatorStore, handlers ...Handler) (*Manager, error) {
+	if valStore == nil {
+		return nil, errors.New("validator store cannot be nil")
+	}
+
+	sorted := slices.Clone(handlers)
+	slices.SortStableFunc(sorted, func(a, b Handler) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	for i, h := range sorted {
+		if strings.TrimSpace(h.Name()) == "" {
+			return nil, errors.New("vote extension handler name cannot be empty")
+		}
+
+		if i > 0 && sorted[i-1].Name() == h.Name() {
+			return nil, fmt.Errorf("duplicate vote extension handler %s", h.Name())
+		}
+	}
+
+	return &Manager{
+		valStore: valStore,
+		handlers: sorted,
+	}, nil
+}
+
+// HandlersFromModules returns the vote extension handlers of the modules
+// implementing HasVoteExtensionHandler, e.g. the modules of a module manager.
+func HandlersFromModules(modules map[string]any) []Handler {
+	var handlers []Handler
+	for _, mod := range modules {
+		if m, ok := mod.(HasVoteExtensionHandler); ok {
+			handlers = append(handlers, m.VoteExtensionHandler())
+		}
+	}
+
+	return handlers
+}
+
+// ExtendVoteHandler returns an ExtendVoteHandler which multiplexes the vote
+// extensions of all handlers. A handler returning an error is logged and
+// omitted from the vote extension, so it does not prevent other handlers from
+// extending the vote.
+func (m *Manager) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		ve := MultiplexedVoteExtension{
+			Extensions: make([]ModuleVoteExtension, 0, len(m.handlers)),
+		}
+
+		for _, h := range m.handlers {
+			data, err := h.ExtendVote(ctx, req)
+			if err != nil {
+				ctx.Logger().Error("failed to extend vote", "handler", h.Name(), "height", req.Height, "err", err)
+				continue
+			}
+
+			ve.Extensions = append(ve.Extensions, ModuleVoteExtension{Name: h.Name(), Data: data})
+		}
+
+		bz, err := ve.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode vote extension: %w", err)
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns a VerifyVoteExtensionHandler which
+// demultiplexes the vote extension and verifies the data of every handler. The
+// vote extension is rejected if it contains data for unknown handlers, the
+// same handler twice or if any handler fails verification.
+func (m *Manager) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		err := m.forEachExtension(req.VoteExtension, func(h Handler, data []byte) error {
+			return h.VerifyVoteExtension(ctx, req, data)
+		})
+		if err != nil {
+			ctx.Logger().Error(
+				"rejecting vote extension",
+				"validator", fmt.Sprintf("%X", req.ValidatorAddress),
+				"height", req.Height,
+				"err", err,
+			)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// PrepareProposalHandler returns a PrepareProposalHandler which injects the
+// extended commit of the previous height as the first transaction of the
+// proposal when vote extensions are enabled. The remaining transactions are
+// selected by the next handler, which is called with a MaxTxBytes reduced by
+// the size of the injected commit and with the validated commit available via
+// ExtendedCommitFromContext.
+func (m *Manager) PrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !ExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, m.valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, fmt.Errorf("invalid vote extensions in local last commit: %w", err)
+		}
+
+		bz, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode extended commit: %w", err)
+		}
+
+		injectedSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz})
+		if injectedSize > req.MaxTxBytes {
+			return nil, fmt.Errorf("injected vote extensions size %d exceeds max tx bytes %d", injectedSize, req.MaxTxBytes)
+		}
+
+		nextReq := *req
+		nextReq.MaxTxBytes -= injectedSize
+
+		resp, err := next(WithExtendedCommit(ctx, req.LocalLastCommit), &nextReq)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Txs = append([][]byte{bz}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposalHandler which verifies the
+// extended commit injected as the first transaction of the proposal when vote
+// extensions are enabled. The proposal is rejected if the injected commit is
+// missing, its signatures or voting power are invalid or if any handler data
+// fails validation. The remaining transactions are passed to the next handler,
+// with the verified commit available via ExtendedCommitFromContext.
+func (m *Manager) ProcessProposalHandler(next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !ExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		commit, err := m.verifyInjectedCommit(ctx, req.Txs)
+		if err != nil {
+			ctx.Logger().Error("rejecting proposal with invalid vote extensions", "height", req.Height, "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		nextReq := *req
+		nextReq.Txs = req.Txs[1:]
+
+		return next(WithExtendedCommit(ctx, commit), &nextReq)
+	}
+}
+
+// extendedCommitKey is the context key under which the proposal handlers of
+// the Manager store the extended commit for the next handler.
+type extendedCommitKey struct{}
+
+// WithExtendedCommit returns a copy of the context holding the given extended
+// commit, as the proposal handlers of a Manager do for their next handler.
+func WithExtendedCommit(ctx sdk.Context, commit abci.ExtendedCommitInfo) sdk.Context {
+	return ctx.WithValue(extendedCommitKey{}, commit)
+}
+
+// ExtendedCommitFromContext returns the extended commit stored in the context
+// by the PrepareProposalHandler or ProcessProposalHandler of a Manager. The
+// boolean is false if no commit is available, e.g. because vote extensions are
+// not enabled at the proposal height.
+func ExtendedCommitFromContext(ctx sdk.Context) (abci.ExtendedCommitInfo, bool) {
+	commit, ok := ctx.Value(extendedCommitKey{}).(abci.ExtendedCommitInfo)
+	return commit, ok
+}
+
+// ExtendedCommit returns the extended commit injected in the given block
+// transactions by the PrepareProposalHandler. The boolean is false if vote
+// extensions are not enabled at the current block height, in which case no
+// commit was injected. It is meant to be used in a PreBlocker, where the
+// transactions were already verified by the ProcessProposalHandler.
+func ExtendedCommit(ctx sdk.Context, txs [][]byte) (abci.ExtendedCommitInfo, bool, error) {
+	if !ExtensionsEnabled(ctx, ctx.HeaderInfo().Height) {
+		return abci.ExtendedCommitInfo{}, false, nil
+	}
+
+	commit, err := decodeInjectedCommit(txs)
+	if err != nil {
+		return abci.ExtendedCommitInfo{}, false, err
+	}
+
+	return commit, true, nil
+}
+
+// ExtensionsEnabled returns true if the block at the given height must contain
+// the vote extensions of the previous height, i.e. if vote extensions were
+// enabled at the previous height.
+func ExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
+
+func (m *Manager) verifyInjectedCommit(ctx sdk.Context, txs [][]byte) (abci.ExtendedCommitInfo, error) {
+	commit, err := decodeInjectedCommit(txs)
+	if err != nil {
+		return commit, err
+	}
+
+	if err := baseapp.ValidateVoteExtensions(ctx, m.valStore, ctx.HeaderInfo().Height, ctx.ChainID(), commit); err != nil {
+		return commit, err
+	}
+
+	for _, vote := range commit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+
+		err := m.forEachExtension(vote.VoteExtension, func(h Handler, data []byte) error {
+			return h.ValidateData(data)
+		})
+		if err != nil {
+			return commit, fmt.Errorf("validator %X: %w", vote.Validator.Address, err)
+		}
+	}
+
+	return commit, nil
+}
+
+// forEachExtension decodes the multiplexed vote extension and calls fn with
+// the data of every handler, in handler name order.
+func (m *Manager) forEachExtension(bz []byte, fn func(h Handler, data []byte) error) error {
+	var ve MultiplexedVoteExtension
+	if err := ve.Unmarshal(bz); err != nil {
+		return fmt.Errorf("failed to decode vote extension: %w", err)
+	}
+
+	i := 0
+	for j, ext := range ve.Extensions {
+		if j > 0 && ve.Extensions[j-1].Name >= ext.Name {
+			return fmt.Errorf("vote extensions are not sorted or contain duplicates: %s", ext.Name)
+		}
+
+		for i < len(m.handlers) && m.handlers[i].Name() < ext.Name {
+			i++
+		}
+
+		if i == len(m.handlers) || m.handlers[i].Name() != ext.Name {
+			return fmt.Errorf("unknown vote extension handler %s", ext.Name)
+		}
+
+		if err := fn(m.handlers[i], ext.Data); err != nil {
+			return fmt.Errorf("%s: %w", ext.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func decodeInjectedCommit(txs [][]byte) (abci.ExtendedCommitInfo, error) {
+	var commit abci.ExtendedCommitInfo
+	if len(txs) == 0 {
+		return commit, errors.New("missing injected vote extensions")
+	}
+
+	if err := commit.Unmarshal(txs[0]); err != nil {
+		return commit, fmt.Errorf("failed to decode injected vote extensions: %w", err)
+	}
+
+	return commit, nil
+}
+
+// moduleData returns the data of the named handler in the vote extension of
+// the given vote. The boolean is false if the validator did not commit the
+// block or did not provide data for the handler.
+func moduleData(vote abci.ExtendedVoteInfo, name string) ([]byte, bool, error) {
+	if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+		return nil, false, nil
+	}
+
+	var ve MultiplexedVoteExtension
+	if err := ve.Unmarshal(vote.VoteExtension); err != nil {
+		return nil, false, fmt.Errorf("validator %X: failed to decode vote extension: %w", vote.Validator.Address, err)
+	}
+
+	for _, ext := range ve.Extensions {
+		if ext.Name == name {
+			return ext.Data, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
@@ -0,0 +1,378 @@
+package voteext_test
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtsecp256k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	"github.com/cosmos/cosmos-sdk/baseapp/voteext"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const chainID = "chain-id"
+
+type testValidator struct {
+	privKey cmtsecp256k1.PrivKey
+	power   int64
+}
+
+func (v testValidator) consAddr() sdk.ConsAddress {
+	return sdk.ConsAddress(v.privKey.PubKey().Address())
+}
+
+type fixture struct {
+	ctx    sdk.Context
+	vals   []testValidator
+	prices *voteext.TypedHandler[int64]
+	mgr    *voteext.Manager
+}
+
+func setup(t *testing.T) fixture {
+	t.Helper()
+
+	vals := []testValidator{
+		{privKey: cmtsecp256k1.GenPrivKey(), power: 40},
+		{privKey: cmtsecp256k1.GenPrivKey(), power: 35},
+		{privKey: cmtsecp256k1.GenPrivKey(), power: 25},
+	}
+
+	valStore := mock.NewMockValidatorStore(gomock.NewController(t))
+	for _, val := range vals {
+		pk := cmtprotocrypto.PublicKey{
+			Sum: &cmtprotocrypto.PublicKey_Secp256K1{Secp256K1: val.privKey.PubKey().Bytes()},
+		}
+		valStore.EXPECT().GetPubKeyByConsAddr(gomock.Any(), val.consAddr()).Return(pk, nil).AnyTimes()
+	}
+
+	// the price is derived from the height, so the outcome is predictable
+	prices := voteext.NewHandler(
+		"prices",
+		collections.Int64Value,
+		func(ctx sdk.Context, _ *abci.RequestExtendVote) (int64, error) {
+			return ctx.BlockHeight() * 100, nil
+		},
+		func(_ sdk.Context, _ *abci.RequestVerifyVoteExtension, price int64) error {
+			if price <= 0 {
+				return errors.New("price must be positive")
+			}
+			return nil
+		},
+	)
+	randomness := voteext.NewHandler(
+		"randomness",
+		collections.StringValue,
+		func(_ sdk.Context, req *abci.RequestExtendVote) (string, error) {
+			return string(req.Hash), nil
+		},
+		nil,
+	)
+
+	mgr, err := voteext.NewManager(valStore, randomness, prices)
+	require.NoError(t, err)
+
+	ctx := sdk.Context{}.
+		WithContext(context.Background()).
+		WithLogger(log.NewTestLogger(t)).
+		WithConsensusParams(cmtproto.ConsensusParams{
+			Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 2},
+		}).
+		WithBlockHeader(cmtproto.Header{ChainID: chainID, Height: 3}).
+		WithHeaderInfo(header.Info{ChainID: chainID, Height: 3})
+
+	return fixture{ctx: ctx, vals: vals, prices: prices, mgr: mgr}
+}
+
+// extendedCommit builds a signed extended commit of height 2, setting the
+// matching last commit in the context.
+func (f *fixture) extendedCommit(t *testing.T, exts [][]byte) abci.ExtendedCommitInfo {
+	t.Helper()
+
+	commit := abci.ExtendedCommitInfo{}
+	lastCommit := abci.CommitInfo{}
+	for i, val := range f.vals {
+		cve := cmtproto.CanonicalVoteExtension{
+			Extension: exts[i],
+			Height:    2,
+			ChainId:   chainID,
+		}
+
+		var buf bytes.Buffer
+		require.NoError(t, protoio.NewDelimitedWriter(&buf).WriteMsg(&cve))
+		sig, err := val.privKey.Sign(buf.Bytes())
+		require.NoError(t, err)
+
+		validator := abci.Validator{Address: val.consAddr(), Power: val.power}
+		commit.Votes = append(commit.Votes, abci.ExtendedVoteInfo{
+			Validator:          validator,
+			VoteExtension:      exts[i],
+			ExtensionSignature: sig,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		})
+		lastCommit.Votes = append(lastCommit.Votes, abci.VoteInfo{Validator: validator})
+	}
+
+	f.ctx = f.ctx.WithCometInfo(baseapp.NewBlockInfo(nil, nil, nil, lastCommit))
+	return commit
+}
+
+func (f *fixture) extendVote(t *testing.T, height int64) []byte {
+	t.Helper()
+
+	resp, err := f.mgr.ExtendVoteHandler()(f.ctx.WithBlockHeight(height), &abci.RequestExtendVote{
+		Height: height,
+		Hash:   []byte("hash"),
+	})
+	require.NoError(t, err)
+
+	return resp.VoteExtension
+}
+
+func encodeExtension(t *testing.T, exts ...voteext.ModuleVoteExtension) []byte {
+	t.Helper()
+
+	bz, err := (&voteext.MultiplexedVoteExtension{Extensions: exts}).Marshal()
+	require.NoError(t, err)
+
+	return bz
+}
+
+func TestNewManager(t *testing.T) {
+	valStore := mock.NewMockValidatorStore(gomock.NewController(t))
+	handler := voteext.NewHandler("a", collections.Int64Value, nil, nil)
+
+	_, err := voteext.NewManager(nil, handler)
+	require.ErrorContains(t, err, "validator store cannot be nil")
+
+	_, err = voteext.NewManager(valStore, handler, voteext.NewHandler("a", collections.StringValue, nil, nil))
+	require.ErrorContains(t, err, "duplicate vote extension handler a")
+
+	_, err = voteext.NewManager(valStore, voteext.NewHandler(" ", collections.StringValue, nil, nil))
+	require.ErrorContains(t, err, "name cannot be empty")
+
+	_, err = voteext.NewManager(valStore)
+	require.NoError(t, err)
+}
+
+func TestExtendAndVerifyVoteExtension(t *testing.T) {
+	f := setup(t)
+
+	ve := f.extendVote(t, 2)
+
+	var decoded voteext.MultiplexedVoteExtension
+	require.NoError(t, decoded.Unmarshal(ve))
+	require.Len(t, decoded.Extensions, 2)
+	require.Equal(t, "prices", decoded.Extensions[0].Name)
+	require.Equal(t, "randomness", decoded.Extensions[1].Name)
+
+	price, err := f.prices.Decode(decoded.Extensions[0].Data)
+	require.NoError(t, err)
+	require.Equal(t, int64(200), price)
+
+	testCases := []struct {
+		name   string
+		ext    []byte
+		status abci.ResponseVerifyVoteExtension_VerifyStatus
+	}{
+		{
+			name:   "valid",
+			ext:    ve,
+			status: abci.ResponseVerifyVoteExtension_ACCEPT,
+		},
+		{
+			name:   "empty",
+			ext:    nil,
+			status: abci.ResponseVerifyVoteExtension_ACCEPT,
+		},
+		{
+			name:   "subset of handlers",
+			ext:    encodeExtension(t, decoded.Extensions[1]),
+			status: abci.ResponseVerifyVoteExtension_ACCEPT,
+		},
+		{
+			name:   "malformed",
+			ext:    []byte{0xff, 0xff},
+			status: abci.ResponseVerifyVoteExtension_REJECT,
+		},
+		{
+			name:   "unknown handler",
+			ext:    encodeExtension(t, voteext.ModuleVoteExtension{Name: "unknown"}),
+			status: abci.ResponseVerifyVoteExtension_REJECT,
+		},
+		{
+			name:   "unsorted",
+			ext:    encodeExtension(t, decoded.Extensions[1], decoded.Extensions[0]),
+			status: abci.ResponseVerifyVoteExtension_REJECT,
+		},
+		{
+			name:   "duplicate",
+			ext:    encodeExtension(t, decoded.Extensions[0], decoded.Extensions[0]),
+			status: abci.ResponseVerifyVoteExtension_REJECT,
+		},
+		{
+			name: "handler verification failure",
+			ext: encodeExtension(t, voteext.ModuleVoteExtension{
+				Name: "prices",
+				Data: encodeInt64(t, -1),
+			}),
+			status: abci.ResponseVerifyVoteExtension_REJECT,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := f.mgr.VerifyVoteExtensionHandler()(f.ctx, &abci.RequestVerifyVoteExtension{
+				Height:        2,
+				VoteExtension: tc.ext,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.status, resp.Status)
+		})
+	}
+}
+
+func TestPrepareAndProcessProposal(t *testing.T) {
+	f := setup(t)
+
+	ve := f.extendVote(t, 2)
+	commit := f.extendedCommit(t, [][]byte{ve, ve, ve})
+
+	var nextMaxTxBytes int64
+	prepare := f.mgr.PrepareProposalHandler(func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		nextMaxTxBytes = req.MaxTxBytes
+		nextCommit, ok := voteext.ExtendedCommitFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, commit, nextCommit)
+		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+	})
+
+	resp, err := prepare(f.ctx, &abci.RequestPrepareProposal{
+		Height:          3,
+		MaxTxBytes:      10_000,
+		Txs:             [][]byte{[]byte("tx1"), []byte("tx2")},
+		LocalLastCommit: commit,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Txs, 3)
+	require.Less(t, nextMaxTxBytes, int64(10_000))
+	require.Equal(t, [][]byte{[]byte("tx1"), []byte("tx2")}, resp.Txs[1:])
+
+	var nextTxs [][]byte
+	process := f.mgr.ProcessProposalHandler(func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		nextCommit, ok := voteext.ExtendedCommitFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, commit, nextCommit)
+		nextTxs = req.Txs
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	})
+
+	processResp, err := process(f.ctx, &abci.RequestProcessProposal{Height: 3, Txs: resp.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processResp.Status)
+	require.Equal(t, resp.Txs[1:], nextTxs)
+
+	// missing injected commit
+	processResp, err = process(f.ctx, &abci.RequestProcessProposal{Height: 3})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processResp.Status)
+
+	// injected commit is not an extended commit
+	processResp, err = process(f.ctx, &abci.RequestProcessProposal{Height: 3, Txs: [][]byte{{0xff, 0xff}}})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processResp.Status)
+
+	// tampered vote extension invalidates the signature
+	tampered := commit
+	tampered.Votes = slices.Clone(commit.Votes)
+	tampered.Votes[0].VoteExtension = f.extendVote(t, 5)
+	bz, err := tampered.Marshal()
+	require.NoError(t, err)
+	processResp, err = process(f.ctx, &abci.RequestProcessProposal{Height: 3, Txs: [][]byte{bz}})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processResp.Status)
+
+	// correctly signed vote extension with undecodable handler data
+	invalid := encodeExtension(t, voteext.ModuleVoteExtension{Name: "prices", Data: []byte("invalid")})
+	invalidCommit := f.extendedCommit(t, [][]byte{invalid, ve, ve})
+	bz, err = invalidCommit.Marshal()
+	require.NoError(t, err)
+	processResp, err = process(f.ctx, &abci.RequestProcessProposal{Height: 3, Txs: [][]byte{bz}})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processResp.Status)
+}
+
+func TestProposalHandlersExtensionsDisabled(t *testing.T) {
+	f := setup(t)
+	f.ctx = f.ctx.WithConsensusParams(cmtproto.ConsensusParams{})
+
+	prepare := f.mgr.PrepareProposalHandler(baseapp.NoOpPrepareProposal())
+	resp, err := prepare(f.ctx, &abci.RequestPrepareProposal{Height: 3, Txs: [][]byte{[]byte("tx")}})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("tx")}, resp.Txs)
+
+	process := f.mgr.ProcessProposalHandler(baseapp.NoOpProcessProposal())
+	processResp, err := process(f.ctx, &abci.RequestProcessProposal{Height: 3, Txs: resp.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processResp.Status)
+
+	_, ok, err := voteext.ExtendedCommit(f.ctx, resp.Txs)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestExtendedCommitVotes(t *testing.T) {
+	f := setup(t)
+
+	exts := make([][]byte, len(f.vals))
+	for i, val := range f.vals {
+		exts[i] = encodeExtension(t, voteext.ModuleVoteExtension{Name: "prices", Data: encodeInt64(t, val.power)})
+	}
+	// the last validator did not provide any data for the prices handler
+	exts[2] = nil
+
+	commit := f.extendedCommit(t, exts)
+	bz, err := commit.Marshal()
+	require.NoError(t, err)
+
+	injected, ok, err := voteext.ExtendedCommit(f.ctx, [][]byte{bz, []byte("tx")})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.Equal(t, commit, injected)
+
+	votes, totalPower, err := f.prices.Votes(injected)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), totalPower)
+	require.Len(t, votes, 2)
+	require.Equal(t, f.vals[0].consAddr(), votes[0].Validator)
+	require.Equal(t, int64(40), votes[0].Value)
+
+	median, err := voteext.StakeWeightedMedian(votes, cmp.Compare[int64])
+	require.NoError(t, err)
+	require.Equal(t, int64(40), median)
+}
+
+func encodeInt64(t *testing.T, v int64) []byte {
+	t.Helper()
+
+	bz, err := collections.Int64Value.Encode(v)
+	require.NoError(t, err)
+
+	return bz
+}
@@ -128,6 +128,15 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) {
 		keyringBackend, _ := flagSet.GetString(flags.FlagKeyringBackend)
 
+		if keyringBackend == keyring.BackendRemote {
+			remoteSigner, err := remoteSignerOption(flagSet)
+			if err != nil {
+				return clientCtx, err
+			}
+
+			clientCtx = clientCtx.WithKeyringOptions(append(clientCtx.KeyringOptions, remoteSigner)...)
+		}
+
 		if keyringBackend != "" {
 			kr, err := NewKeyringFromBackend(clientCtx, keyringBackend)
 			if err != nil {
@@ -313,6 +322,32 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 	return clientCtx, nil
 }
 
+// remoteSignerOption returns the keyring option connecting the remote backend
+// to the signer set by the remote signer flags. The connection uses TLS unless
+// --remote-signer-insecure is set.
+func remoteSignerOption(flagSet *pflag.FlagSet) (keyring.Option, error) {
+	remoteAddr, _ := flagSet.GetString(flags.FlagKeyringRemote)
+	caFile, _ := flagSet.GetString(flags.FlagRemoteSignerCA)
+	certFile, _ := flagSet.GetString(flags.FlagRemoteSignerCert)
+	keyFile, _ := flagSet.GetString(flags.FlagRemoteSignerKey)
+
+	useInsecure, _ := flagSet.GetBool(flags.FlagRemoteSignerInsecure)
+	if useInsecure {
+		if caFile != "" || certFile != "" || keyFile != "" {
+			return nil, fmt.Errorf("--%s cannot be used with the remote signer TLS flags", flags.FlagRemoteSignerInsecure)
+		}
+
+		return keyring.WithRemoteSigner(remoteAddr, grpc.WithTransportCredentials(insecure.NewCredentials())), nil
+	}
+
+	creds, err := keyring.RemoteSignerTLSCredentials(caFile, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyring.WithRemoteSigner(remoteAddr, grpc.WithTransportCredentials(creds)), nil
+}
+
 // GetClientQueryContext returns a Context from a command with fields set based on flags
 // defined in AddQueryFlagsToCmd. An error is returned if any flag query fails.
 //
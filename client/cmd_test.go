@@ -6,11 +6,14 @@ import (
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
 func TestValidateCmd(t *testing.T) {
@@ -137,3 +140,40 @@ func TestSetCmdClientContextHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestReadPersistentCommandFlagsRemoteSigner(t *testing.T) {
+	cfg := moduletestutil.MakeTestEncodingConfig()
+	initClientCtx := client.Context{}.WithCodec(cfg.Codec).WithKeyringDir(t.TempDir())
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr bool
+	}{
+		{"default TLS", nil, false},
+		{"explicit insecure", []string{"--" + flags.FlagRemoteSignerInsecure}, false},
+		{"insecure with a CA", []string{"--" + flags.FlagRemoteSignerInsecure, "--" + flags.FlagRemoteSignerCA, "ca.crt"}, true},
+		{"missing CA file", []string{"--" + flags.FlagRemoteSignerCA, "missing.crt"}, true},
+		{"client certificate without key", []string{"--" + flags.FlagRemoteSignerCert, "client.crt"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.AddKeyringFlags(flagSet)
+			args := append([]string{
+				"--" + flags.FlagKeyringBackend, keyring.BackendRemote,
+				"--" + flags.FlagKeyringRemote, "127.0.0.1:1",
+			}, tc.args...)
+			require.NoError(t, flagSet.Parse(args))
+
+			clientCtx, err := client.ReadPersistentCommandFlags(initClientCtx, flagSet)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, keyring.BackendRemote, clientCtx.Keyring.Backend())
+		})
+	}
+}
@@ -35,52 +35,61 @@ const (
 	SignModeDirectAux = "direct-aux"
 	// SignModeEIP191 is the value of the --sign-mode flag for SIGN_MODE_EIP_191
 	SignModeEIP191 = "eip-191"
+	// SignModeEIP712 is the value of the --sign-mode flag for SIGN_MODE_EIP_712
+	SignModeEIP712 = "eip-712"
+	// SignModeClearSigning is the value of the --sign-mode flag for SIGN_MODE_CLEAR_SIGNING
+	SignModeClearSigning = "clear-signing"
 )
 
 // List of CLI flags
 const (
-	FlagHome             = "home"
-	FlagKeyringDir       = "keyring-dir"
-	FlagUseLedger        = "ledger"
-	FlagChainID          = "chain-id"
-	FlagNode             = "node"
-	FlagGRPC             = "grpc-addr"
-	FlagGRPCInsecure     = "grpc-insecure"
-	FlagHeight           = "height"
-	FlagGasAdjustment    = "gas-adjustment"
-	FlagFrom             = "from"
-	FlagName             = "name"
-	FlagAccountNumber    = "account-number"
-	FlagSequence         = "sequence"
-	FlagNote             = "note"
-	FlagFees             = "fees"
-	FlagGas              = "gas"
-	FlagGasPrices        = "gas-prices"
-	FlagBroadcastMode    = "broadcast-mode"
-	FlagDryRun           = "dry-run"
-	FlagGenerateOnly     = "generate-only"
-	FlagOffline          = "offline"
-	FlagOutputDocument   = "output-document" // inspired by wget -O
-	FlagSkipConfirmation = "yes"
-	FlagProve            = "prove"
-	FlagKeyringBackend   = "keyring-backend"
-	FlagPage             = "page"
-	FlagLimit            = "limit"
-	FlagSignMode         = "sign-mode"
-	FlagPageKey          = "page-key"
-	FlagOffset           = "offset"
-	FlagCountTotal       = "count-total"
-	FlagTimeoutHeight    = "timeout-height"
-	TimeoutDuration      = "timeout-duration"
-	FlagUnordered        = "unordered"
-	FlagKeyAlgorithm     = "algo"
-	FlagKeyType          = "key-type"
-	FlagFeePayer         = "fee-payer"
-	FlagFeeGranter       = "fee-granter"
-	FlagReverse          = "reverse"
-	FlagTip              = "tip"
-	FlagAux              = "aux"
-	FlagInitHeight       = "initial-height"
+	FlagHome                 = "home"
+	FlagKeyringDir           = "keyring-dir"
+	FlagUseLedger            = "ledger"
+	FlagChainID              = "chain-id"
+	FlagNode                 = "node"
+	FlagGRPC                 = "grpc-addr"
+	FlagGRPCInsecure         = "grpc-insecure"
+	FlagHeight               = "height"
+	FlagGasAdjustment        = "gas-adjustment"
+	FlagFrom                 = "from"
+	FlagName                 = "name"
+	FlagAccountNumber        = "account-number"
+	FlagSequence             = "sequence"
+	FlagNote                 = "note"
+	FlagFees                 = "fees"
+	FlagGas                  = "gas"
+	FlagGasPrices            = "gas-prices"
+	FlagBroadcastMode        = "broadcast-mode"
+	FlagDryRun               = "dry-run"
+	FlagGenerateOnly         = "generate-only"
+	FlagOffline              = "offline"
+	FlagOutputDocument       = "output-document" // inspired by wget -O
+	FlagSkipConfirmation     = "yes"
+	FlagProve                = "prove"
+	FlagKeyringBackend       = "keyring-backend"
+	FlagKeyringRemote        = "keyring-remote-addr"
+	FlagRemoteSignerCA       = "remote-signer-tls-ca"
+	FlagRemoteSignerCert     = "remote-signer-tls-cert"
+	FlagRemoteSignerKey      = "remote-signer-tls-key"
+	FlagRemoteSignerInsecure = "remote-signer-insecure"
+	FlagPage                 = "page"
+	FlagLimit                = "limit"
+	FlagSignMode             = "sign-mode"
+	FlagPageKey              = "page-key"
+	FlagOffset               = "offset"
+	FlagCountTotal           = "count-total"
+	FlagTimeoutHeight        = "timeout-height"
+	TimeoutDuration          = "timeout-duration"
+	FlagUnordered            = "unordered"
+	FlagKeyAlgorithm         = "algo"
+	FlagKeyType              = "key-type"
+	FlagFeePayer             = "fee-payer"
+	FlagFeeGranter           = "fee-granter"
+	FlagReverse              = "reverse"
+	FlagTip                  = "tip"
+	FlagAux                  = "aux"
+	FlagInitHeight           = "initial-height"
 	// FlagOutput is the flag to set the output format.
 	// This differs from FlagOutputDocument that is used to set the output file.
 	FlagOutput = "output"
@@ -134,7 +143,7 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase only accessed when providing a key name)")
 	f.Bool(FlagOffline, false, "Offline mode (does not allow any online functionality)")
 	f.BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
-	f.String(FlagSignMode, "", "Choose sign mode (direct|amino-json|direct-aux), this is an advanced feature")
+	f.String(FlagSignMode, "", "Choose sign mode (direct|amino-json|direct-aux|eip-191|eip-712|clear-signing), this is an advanced feature")
 	f.Uint64(FlagTimeoutHeight, 0, "DEPRECATED: Please use --timeout-duration instead. Set a block timeout height to prevent the tx from being committed past a certain height")
 	f.Duration(TimeoutDuration, 0, "TimeoutDuration is the duration the transaction will be considered valid in the mempool. The transaction's unordered nonce will be set to the time of transaction creation + the duration value passed. If the transaction is still in the mempool, and the block time has passed the time of submission + TimeoutDuration, the transaction will be rejected.")
 	f.Bool(FlagUnordered, false, "Enable unordered transaction delivery; must be used in conjunction with --timeout-duration")
@@ -158,7 +167,12 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 // AddKeyringFlags sets common keyring flags
 func AddKeyringFlags(flags *pflag.FlagSet) {
 	flags.String(FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
-	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory|remote)")
+	flags.String(FlagKeyringRemote, "", "The address of the gRPC remote signer used by the remote keyring backend")
+	flags.String(FlagRemoteSignerCA, "", "The PEM encoded CA certificates verifying the remote signer; if omitted, the system root certificates will be used")
+	flags.String(FlagRemoteSignerCert, "", "The PEM encoded client certificate presented to the remote signer for mutual TLS")
+	flags.String(FlagRemoteSignerKey, "", "The PEM encoded private key of the remote signer client certificate")
+	flags.Bool(FlagRemoteSignerInsecure, false, "Connect to the remote signer over an insecure plaintext channel instead of TLS")
 }
 
 // AddPaginationFlagsToCmd adds common pagination flags to cmd
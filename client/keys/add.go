@@ -72,6 +72,9 @@ sorted by address, unless the flag --nosort is set.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+
+Use --algo mldsa65 to generate a post-quantum ML-DSA-65 key, which cannot be stored on a Ledger.
+ML-DSA-65 and secp256k1 keys can be mixed in a multisig.
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
@@ -91,7 +94,7 @@ Example:
 	f.Uint32(flagCoinType, sdk.GetConfig().GetCoinType(), "coin type number for HD derivation")
 	f.Uint32(flagAccount, 0, "Account number for HD derivation (less than equal 2147483647)")
 	f.Uint32(flagIndex, 0, "Address index number for HD derivation (less than equal 2147483647)")
-	f.String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
+	f.String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for (e.g. secp256k1, mldsa65)")
 	f.String(flagMnemonicSrc, "", "Import mnemonic from a file (only usable when recover or interactive is passed)")
 
 	// support old flags name for backwards compatibility
@@ -14,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mldsa65"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -198,6 +200,68 @@ func Test_runAddCmdMultisigDupKeys(t *testing.T) {
 	require.EqualError(t, cmd.ExecuteContext(ctx), "duplicate multisig keys: keyname1")
 }
 
+func Test_runAddCmdMlDsa65(t *testing.T) {
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kbHome := t.TempDir()
+
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithInput(mockIn).
+		WithCodec(cdc)
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	for _, key := range []struct{ name, algo string }{
+		{"classical", string(hd.Secp256k1Type)},
+		{"pq1", "mldsa65"},
+		{"pq2", string(hd.MlDsa65Type)},
+	} {
+		cmd.SetArgs([]string{
+			key.name,
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatText),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyType, key.algo),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		})
+		require.NoError(t, cmd.ExecuteContext(ctx))
+	}
+
+	for _, name := range []string{"pq1", "pq2"} {
+		k, err := kb.Key(name)
+		require.NoError(t, err)
+		pk, err := k.GetPubKey()
+		require.NoError(t, err)
+		require.IsType(t, &mldsa65.PubKey{}, pk)
+	}
+
+	// classical and post-quantum keys can be mixed in a multisig
+	cmd.SetArgs([]string{
+		"treasury",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+		fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatText),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flagMultisig, "classical,pq1,pq2"),
+		fmt.Sprintf("--%s=%s", flagMultiSigThreshold, "2"),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	k, err := kb.Key("treasury")
+	require.NoError(t, err)
+	pk, err := k.GetPubKey()
+	require.NoError(t, err)
+	multisigPk, ok := pk.(*kmultisig.LegacyAminoPubKey)
+	require.True(t, ok)
+	require.Equal(t, uint32(2), multisigPk.Threshold)
+	require.Len(t, multisigPk.GetPubKeys(), 3)
+}
+
 func Test_runAddCmdDryRun(t *testing.T) {
 	pubkey1 := `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AtObiFVE4s+9+RX5SP8TN9r2mxpoaT4eGj9CJfK7VRzN"}`
 	pubkey2 := `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A/se1vkqgdQ7VJQCM4mxN+L+ciGhnnJ4XYsQCRBMrdRi"}`
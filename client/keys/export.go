@@ -70,6 +70,44 @@ and export your keys in ASCII-armored encrypted format.`,
 	return cmd
 }
 
+// ExportAllKeysCommand exports all the keys of the key store as a single bundle.
+func ExportAllKeysCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export-all",
+		Short: "Export all keys as an encrypted bundle",
+		Long: `Export all the keys of the local keyring, including ledger, offline and multisig
+keys, in a single ASCII-armored passphrase-encrypted bundle which can be imported
+in another keyring with the import-all command.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			exporter, ok := clientCtx.Keyring.(keyring.BundleExporter)
+			if !ok {
+				return fmt.Errorf("the %s keyring backend does not support exporting bundles", clientCtx.Keyring.Backend())
+			}
+
+			encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported keys:", buf)
+			if err != nil {
+				return err
+			}
+
+			armored, err := exporter.ExportBundle(encryptPassword)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(armored)
+
+			return nil
+		},
+	}
+}
+
 func exportUnsafeUnarmored(cmd *cobra.Command, uid string, buf *bufio.Reader, kr keyring.Keyring) error {
 	// confirm export unarmored hex privkey, unless -y is passed
 	if skip, _ := cmd.Flags().GetBool(flagYes); !skip {
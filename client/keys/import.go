@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
@@ -47,6 +48,47 @@ func ImportKeyCommand() *cobra.Command {
 	}
 }
 
+// ImportAllKeysCommand imports all the keys of a bundle produced by export-all.
+func ImportAllKeysCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-all <bundlefile>",
+		Short: "Import all keys of an encrypted bundle into the local keybase",
+		Long: `Import all the keys of an ASCII armored encrypted bundle produced by the export-all
+command into the local keybase. No key is imported if any of them conflicts with an
+existing key.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			importer, ok := clientCtx.Keyring.(keyring.BundleImporter)
+			if !ok {
+				return fmt.Errorf("the %s keyring backend does not support importing bundles", clientCtx.Keyring.Backend())
+			}
+
+			armor, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt the bundle:", buf)
+			if err != nil {
+				return err
+			}
+
+			records, err := importer.ImportBundle(string(armor), passphrase)
+			if err != nil {
+				return err
+			}
+
+			return printKeyringRecords(cmd.OutOrStdout(), records, clientCtx.OutputFormat)
+		},
+	}
+}
+
 func ImportKeyHexCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "import-hex <name> [hex]",
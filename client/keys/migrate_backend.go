@@ -0,0 +1,55 @@
+package keys
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const flagToKeyringDir = "to-keyring-dir"
+
+// MigrateBackendCommand moves all the keys of the keyring to another keyring backend.
+func MigrateBackendCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-backend <backend>",
+		Short: "Copy all keys to a keyring of another backend",
+		Long: `Copy all the keys of the keyring selected with --keyring-backend and --keyring-dir,
+including ledger, offline and multisig keys, to a keyring of the given backend, e.g.
+from the test backend to the file backend. The destination keyring directory defaults
+to the source one and can be changed with --to-keyring-dir. No key is copied if any
+of them conflicts with an existing key of the destination keyring. The keys are left
+in the source keyring.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if toDir, _ := cmd.Flags().GetString(flagToKeyringDir); toDir != "" {
+				clientCtx = clientCtx.WithKeyringDir(toDir)
+			} else if args[0] == clientCtx.Keyring.Backend() {
+				return fmt.Errorf("keys are already stored in the %s backend", args[0])
+			}
+
+			dst, err := client.NewKeyringFromBackend(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			records, err := keyring.CopyRecords(clientCtx.Keyring, dst)
+			if err != nil {
+				return err
+			}
+
+			return printKeyringRecords(cmd.OutOrStdout(), records, clientCtx.OutputFormat)
+		},
+	}
+
+	cmd.Flags().String(flagToKeyringDir, "", "The destination keyring directory; if omitted, the source keyring directory will be used")
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func Test_runMigrateBackendCmd(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	kbHome := t.TempDir()
+	toHome := t.TempDir()
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+	_, _, err = kb.NewMnemonic("local", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = kb.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	cmd := MigrateBackendCommand()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+	testutil.ApplyMockIODiscardOutErr(cmd)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithKeyring(kb).
+		WithCodec(cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	// the keys are already stored in the test backend
+	cmd.SetArgs([]string{keyring.BackendTest, fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest)})
+	require.Error(t, cmd.ExecuteContext(ctx))
+
+	cmd.SetArgs([]string{
+		keyring.BackendTest,
+		fmt.Sprintf("--%s=%s", flagToKeyringDir, toHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	dst, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, toHome, nil, cdc)
+	require.NoError(t, err)
+	records, err := dst.List()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	// the source keyring is left untouched
+	records, err = kb.List()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+}
@@ -26,6 +26,11 @@ The keyring supports the following backends:
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+    remote      Lists the keys of a remote signer (KMS, HSM) and forwards signing requests to it
+                over gRPC. The signer address is set with the --keyring-remote-addr flag. The
+                connection uses TLS, configured with the --remote-signer-tls-ca,
+                --remote-signer-tls-cert and --remote-signer-tls-key flags, unless
+                --remote-signer-insecure is set.
 
 kwallet and pass backends depend on external tools. Refer to their respective documentation for more
 information:
@@ -40,7 +45,9 @@ The pass backend requires GnuPG: https://gnupg.org/
 		MnemonicKeyCommand(),
 		AddKeyCommand(),
 		ExportKeyCommand(),
+		ExportAllKeysCommand(),
 		ImportKeyCommand(),
+		ImportAllKeysCommand(),
 		ImportKeyHexCommand(),
 		ListKeysCmd(),
 		ListKeyTypesCmd(),
@@ -49,6 +56,7 @@ The pass backend requires GnuPG: https://gnupg.org/
 		RenameKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		MigrateBackendCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagOutput, "text", "Output format (text|json)")
@@ -72,6 +72,10 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		signMode = signing.SignMode_SIGN_MODE_DIRECT_AUX
 	case flags.SignModeEIP191:
 		signMode = signing.SignMode_SIGN_MODE_EIP_191
+	case flags.SignModeEIP712:
+		signMode = signing.SignMode_SIGN_MODE_EIP_712
+	case flags.SignModeClearSigning:
+		signMode = signing.SignMode_SIGN_MODE_CLEAR_SIGNING
 	}
 
 	var accNum, accSeq uint64
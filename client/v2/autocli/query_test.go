@@ -2,6 +2,7 @@ package autocli
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"os"
 	"strings"
@@ -21,6 +22,7 @@ import (
 	testpb "cosmossdk.io/client/v2/internal/testpbpulsar"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mldsa65"
 )
 
 var buildModuleQueryCommand = func(moduleName string, f *fixture) (*cobra.Command, error) {
@@ -466,6 +468,25 @@ func TestPubKeyParsingConsensusAddress(t *testing.T) {
 	assert.DeepEqual(t, fixture.conn.lastRequest, fixture.conn.lastResponse.(*testpb.EchoResponse).Request, protocmp.Transform())
 }
 
+func TestPubKeyParsingConsensusAddressMlDsa65(t *testing.T) {
+	fixture := initFixture(t)
+
+	privKey, err := mldsa65.GenPrivKey()
+	assert.NilError(t, err)
+	pubKey := privKey.PubKey()
+	expected, err := fixture.b.ConsensusAddressCodec.BytesToString(pubKey.Address())
+	assert.NilError(t, err)
+
+	_, err = runCmd(fixture, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo",
+		"--a-consensus-address", fmt.Sprintf("{\"@type\":\"/cosmos.crypto.mldsa65.PubKey\",\"key\":\"%s\"}", base64.StdEncoding.EncodeToString(pubKey.Bytes())),
+		"-u", "27", // shorthand
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, expected, fixture.conn.lastRequest.(*testpb.EchoRequest).AConsensusAddress)
+}
+
 func TestJSONParsing(t *testing.T) {
 	fixture := initFixture(t)
 
@@ -25,6 +25,7 @@ const (
 	blockTypePrivKey = "TENDERMINT PRIVATE KEY"
 	blockTypeKeyInfo = "TENDERMINT KEY INFO"
 	blockTypePubKey  = "TENDERMINT PUBLIC KEY"
+	blockTypeBundle  = "COSMOS KEYRING BUNDLE"
 
 	defaultAlgo = "secp256k1"
 
@@ -160,19 +161,22 @@ func EncryptArmorPrivKey(privKey cryptotypes.PrivKey, passphrase, algo string) s
 }
 
 func encryptPrivKey(privKey cryptotypes.PrivKey, passphrase string) (saltBytes, encBytes []byte) {
+	return encryptBytes(legacy.Cdc.MustMarshal(privKey), passphrase)
+}
+
+func encryptBytes(bz []byte, passphrase string) (saltBytes, encBytes []byte) {
 	saltBytes = crypto.CRandBytes(16)
 
 	key := argon2.IDKey([]byte(passphrase), saltBytes, argon2Time, argon2Memory, argon2Threads, chacha20poly1305.KeySize)
-	privKeyBytes := legacy.Cdc.MustMarshal(privKey)
 
 	aead, err := chacha20poly1305.New(key)
 	if err != nil {
 		panic(errorsmod.Wrap(err, "error generating cypher from key"))
 	}
 
-	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(privKeyBytes)+aead.Overhead()) // Nonce is fixed to maintain consistency, each key is generated at every encryption using a random salt.
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(bz)+aead.Overhead()) // Nonce is fixed to maintain consistency, each key is generated at every encryption using a random salt.
 
-	encBytes = aead.Seal(nil, nonce, privKeyBytes, nil)
+	encBytes = aead.Seal(nil, nonce, bz, nil)
 
 	return saltBytes, encBytes
 }
@@ -220,18 +224,9 @@ func decryptPrivKey(saltBytes, encBytes []byte, passphrase, kdf string) (privKey
 	// Since the argon2 key derivation and chacha encryption was implemented together, it is not possible to have mixed kdf and encryption algorithms
 	switch kdf {
 	case kdfArgon2:
-		key = argon2.IDKey([]byte(passphrase), saltBytes, argon2Time, argon2Memory, argon2Threads, chacha20poly1305.KeySize)
-
-		aead, err := chacha20poly1305.New(key)
+		privKeyBytes, err = decryptBytes(saltBytes, encBytes, passphrase)
 		if err != nil {
-			return privKey, errorsmod.Wrap(err, "Error generating aead cypher for key.")
-		} else if len(encBytes) < aead.NonceSize() {
-			return privKey, errorsmod.Wrap(nil, "Encrypted bytes length is smaller than aead nonce size.")
-		}
-		nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(privKeyBytes)+aead.Overhead())
-		privKeyBytes, err = aead.Open(nil, nonce, encBytes, nil) // Decrypt the message and check it wasn't tampered with.
-		if err != nil {
-			return privKey, sdkerrors.ErrWrongPassword
+			return privKey, err
 		}
 	case kdfBcrypt:
 		key, err = bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
@@ -255,6 +250,65 @@ func decryptPrivKey(saltBytes, encBytes []byte, passphrase, kdf string) (privKey
 	return legacy.PrivKeyFromBytes(privKeyBytes)
 }
 
+func decryptBytes(saltBytes, encBytes []byte, passphrase string) ([]byte, error) {
+	key := argon2.IDKey([]byte(passphrase), saltBytes, argon2Time, argon2Memory, argon2Threads, chacha20poly1305.KeySize)
+
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "Error generating aead cypher for key.")
+	} else if len(encBytes) < aead.NonceSize() {
+		return nil, errorsmod.Wrap(nil, "Encrypted bytes length is smaller than aead nonce size.")
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(encBytes)+aead.Overhead())
+	bz, err := aead.Open(nil, nonce, encBytes, nil) // Decrypt the message and check it wasn't tampered with.
+	if err != nil {
+		return nil, sdkerrors.ErrWrongPassword
+	}
+
+	return bz, nil
+}
+
+// EncryptArmorBundle encrypts and armors a keyring bundle, i.e. the serialized
+// records of a whole keyring.
+func EncryptArmorBundle(bz []byte, passphrase string) string {
+	saltBytes, encBytes := encryptBytes(bz, passphrase)
+	header := map[string]string{
+		kdfHeader:     kdfArgon2,
+		"salt":        fmt.Sprintf("%X", saltBytes),
+		headerVersion: "0.0.1",
+	}
+
+	return EncodeArmor(blockTypeBundle, header, encBytes)
+}
+
+// UnarmorDecryptBundle returns the serialized records of a keyring bundle
+// armored and encrypted with EncryptArmorBundle.
+func UnarmorDecryptBundle(armorStr, passphrase string) ([]byte, error) {
+	blockType, header, encBytes, err := DecodeArmor(armorStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if blockType != blockTypeBundle {
+		return nil, fmt.Errorf("unrecognized armor type: %v", blockType)
+	}
+
+	if header[kdfHeader] != kdfArgon2 {
+		return nil, fmt.Errorf("unrecognized KDF type: %v", header[kdfHeader])
+	}
+
+	if header["salt"] == "" {
+		return nil, fmt.Errorf("missing salt bytes")
+	}
+
+	saltBytes, err := hex.DecodeString(header["salt"])
+	if err != nil {
+		return nil, fmt.Errorf("error decoding salt: %v", err.Error())
+	}
+
+	return decryptBytes(saltBytes, encBytes, passphrase)
+}
+
 //-----------------------------------------------------------------
 // encode/decode with armor
 
@@ -39,4 +39,9 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//	remote	This backend lists the keys of a remote signer (KMS, HSM or PKCS#11 proxy) implementing
+//		the RemoteSigner gRPC service and forwards signing requests to it. The signer address
+//		is set with WithRemoteSigner and the connection uses TLS unless insecure transport
+//		credentials are given explicitly. Keys cannot be created, imported or exported. The
+//		keyring implements io.Closer to close the connection to the remote signer.
 package keyring
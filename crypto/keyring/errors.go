@@ -33,6 +33,12 @@ var (
 	ErrLedgerInvalidSignature = errors.New("Ledger generated an invalid signature. Perhaps you have multiple ledgers and need to try another one")
 	// ErrLegacyToRecord is raised when cannot be converted to a Record
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
+	// ErrRemoteSignerNotConfigured is raised when the remote backend is used without a signer address.
+	ErrRemoteSignerNotConfigured = errors.New("remote signer address is not configured")
+	// ErrRemoteUnsupported is raised when an operation is not supported by the remote keyring.
+	ErrRemoteUnsupported = errors.New("operation not supported by the remote keyring")
+	// ErrRemoteInvalidSignature is raised when the remote signer returns an invalid signature.
+	ErrRemoteInvalidSignature = errors.New("remote signer returned an invalid signature")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
 	ErrUnknownLegacyType = errors.New("unknown LegacyInfo type")
 )
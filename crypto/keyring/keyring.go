@@ -50,9 +50,11 @@ const (
 )
 
 var (
-	_                          Keyring       = &keystore{}
-	_                          KeyringWithDB = &keystore{}
-	maxPassphraseEntryAttempts               = 3
+	_                          Keyring        = &keystore{}
+	_                          KeyringWithDB  = &keystore{}
+	_                          BundleImporter = &keystore{}
+	_                          BundleExporter = &keystore{}
+	maxPassphraseEntryAttempts                = 3
 )
 
 // Keyring exposes operations over a backend supported by github.com/99designs/keyring.
@@ -131,6 +133,21 @@ type Importer interface {
 	ImportPubKey(uid, armor string) error
 }
 
+// BundleImporter is implemented by key stores that support the import of
+// passphrase-encrypted bundles of records.
+type BundleImporter interface {
+	// ImportBundle imports all the records of a passphrase-encrypted bundle
+	// produced by ExportBundle. It fails without importing any record if one
+	// of them conflicts with an existing key.
+	ImportBundle(armor, passphrase string) ([]*Record, error)
+}
+
+// recordImporter is implemented by key stores that can store records as is,
+// e.g. copied from another keyring.
+type recordImporter interface {
+	importRecords(records []*Record) error
+}
+
 // Migrator is implemented by key stores and enables migration of keys from amino to proto
 type Migrator interface {
 	MigrateAll() ([]*Record, error)
@@ -148,6 +165,14 @@ type Exporter interface {
 	ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (armor string, err error)
 }
 
+// BundleExporter is implemented by key stores that support the export of all
+// their records as a passphrase-encrypted bundle.
+type BundleExporter interface {
+	// ExportBundle returns all the records of the keyring, including ledger,
+	// offline and multisig ones, in a single ASCII armored passphrase-encrypted bundle.
+	ExportBundle(encryptPassphrase string) (armor string, err error)
+}
+
 // Option overrides keyring configuration options.
 type Option func(options *Options)
 
@@ -166,7 +191,7 @@ func NewInMemoryWithKeyring(kr keyring.Keyring, cdc codec.Codec, opts ...Option)
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
 func newKeyringGeneric(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -178,6 +203,8 @@ func newKeyringGeneric(
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(cdc, opts...), err
+	case BackendRemote:
+		return NewRemote(cdc, opts...)
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
@@ -290,6 +317,21 @@ func (ks keystore) ExportPrivKeyArmor(uid, encryptPassphrase string) (armor stri
 	return crypto.EncryptArmorPrivKey(priv, encryptPassphrase, priv.Type()), nil
 }
 
+// ExportBundle exports all the records of the keyring as an encrypted bundle
+func (ks keystore) ExportBundle(encryptPassphrase string) (armor string, err error) {
+	records, err := ks.List()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := ks.cdc.Marshal(&RecordBundle{Records: records})
+	if err != nil {
+		return "", errors.CombineErrors(ErrUnableToSerialize, err)
+	}
+
+	return crypto.EncryptArmorBundle(bz, encryptPassphrase), nil
+}
+
 // ExportPrivateKeyObject exports an armored private key object.
 func (ks keystore) ExportPrivateKeyObject(uid string) (types.PrivKey, error) {
 	k, err := ks.Key(uid)
@@ -395,6 +437,88 @@ func (ks keystore) ImportPubKey(uid, armor string) error {
 	return nil
 }
 
+func (ks keystore) ImportBundle(armor, passphrase string) ([]*Record, error) {
+	bz, err := crypto.UnarmorDecryptBundle(armor, passphrase)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to decrypt keyring bundle")
+	}
+
+	var bundle RecordBundle
+	if err := ks.cdc.Unmarshal(bz, &bundle); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to decode keyring bundle")
+	}
+
+	if err := ks.importRecords(bundle.Records); err != nil {
+		return nil, err
+	}
+
+	return bundle.Records, nil
+}
+
+// importRecords stores the given records as is. It fails without storing any
+// record if one of them conflicts with an existing key or with another record.
+func (ks keystore) importRecords(records []*Record) error {
+	// check all the records before writing any of them
+	names := make(map[string]struct{}, len(records))
+	addrs := make(map[string]struct{}, len(records))
+	for _, k := range records {
+		addr, err := k.GetAddress()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := names[k.Name]; ok {
+			return errorsmod.Wrapf(ErrKeyAlreadyExists, "%s is repeated", k.Name)
+		}
+		names[k.Name] = struct{}{}
+		if _, ok := addrs[string(addr)]; ok {
+			return errorsmod.Wrapf(ErrDuplicatedAddress, "%s has the address of another key", k.Name)
+		}
+		addrs[string(addr)] = struct{}{}
+
+		exists, err := ks.existsInDb(addr, k.Name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errorsmod.Wrap(ErrKeyAlreadyExists, k.Name)
+		}
+	}
+
+	for _, k := range records {
+		if err := ks.writeRecord(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CopyRecords copies all the records of src, including ledger, offline and
+// multisig ones, to dst as is, without encrypting them in between. It fails
+// without copying any record if one of them conflicts with a key of dst.
+func CopyRecords(src, dst Keyring) ([]*Record, error) {
+	if src.Backend() == BackendRemote {
+		return nil, errorsmod.Wrap(ErrRemoteUnsupported, "copy records")
+	}
+
+	importer, ok := dst.(recordImporter)
+	if !ok {
+		return nil, fmt.Errorf("the %s backend does not support copying records", dst.Backend())
+	}
+
+	records, err := src.List()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := importer.importRecords(records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
 func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
 	k, err := ks.Key(uid)
 	if err != nil {
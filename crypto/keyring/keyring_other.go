@@ -5,6 +5,8 @@ package keyring
 import (
 	"io"
 
+	"google.golang.org/grpc"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
@@ -25,6 +27,10 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// define the address of the gRPC signer used by the remote backend
+	RemoteSignerAddr string
+	// define the gRPC dial options used to connect to the remote signer
+	RemoteSignerDialOptions []grpc.DialOption
 }
 
 func New(
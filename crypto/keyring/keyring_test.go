@@ -2152,3 +2152,121 @@ func TestImportPrivKeyHexMlDsa65Valid(t *testing.T) {
 }
 
 func accAddr(k *Record) (sdk.AccAddress, error) { return k.GetAddress() }
+
+func TestExportImportBundle(t *testing.T) {
+	cdc := getCodec()
+	kb := NewInMemory(cdc)
+
+	local, _, err := kb.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = kb.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = kb.SaveMultisig("multi", multisig.NewLegacyAminoPubKey(1, []types.PubKey{secp256k1.GenPrivKey().PubKey()}))
+	require.NoError(t, err)
+	_, err = kb.(keystore).writeLedgerKey("ledger", secp256k1.GenPrivKey().PubKey(), hd.NewFundraiserParams(0, sdk.CoinType, 0))
+	require.NoError(t, err)
+
+	armor, err := kb.(BundleExporter).ExportBundle("passphrase")
+	require.NoError(t, err)
+
+	dst, err := New("keybasename", BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	_, err = dst.(BundleImporter).ImportBundle(armor, "wrong")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	records, err := dst.(BundleImporter).ImportBundle(armor, "passphrase")
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+
+	gotTypes := map[string]KeyType{}
+	list, err := dst.List()
+	require.NoError(t, err)
+	for _, k := range list {
+		gotTypes[k.Name] = k.GetType()
+	}
+	require.Equal(t, map[string]KeyType{"local": TypeLocal, "offline": TypeOffline, "multi": TypeMulti, "ledger": TypeLedger}, gotTypes)
+
+	// the imported local key signs as the original one
+	msg := []byte("sign bytes")
+	sig, pub, err := dst.Sign("local", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	localPub, err := local.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, localPub.Equals(pub))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// no record is imported if any of them conflicts
+	conflicting := NewInMemory(cdc)
+	_, err = conflicting.SaveOfflineKey("multi", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = conflicting.(BundleImporter).ImportBundle(armor, "passphrase")
+	require.ErrorIs(t, err, ErrKeyAlreadyExists)
+	list, err = conflicting.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
+
+func TestCopyRecords(t *testing.T) {
+	cdc := getCodec()
+	src := NewInMemory(cdc)
+
+	local, _, err := src.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = src.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = src.SaveMultisig("multi", multisig.NewLegacyAminoPubKey(1, []types.PubKey{secp256k1.GenPrivKey().PubKey()}))
+	require.NoError(t, err)
+	_, err = src.(keystore).writeLedgerKey("ledger", secp256k1.GenPrivKey().PubKey(), hd.NewFundraiserParams(0, sdk.CoinType, 0))
+	require.NoError(t, err)
+
+	dst, err := New("keybasename", BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	records, err := CopyRecords(src, dst)
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+
+	gotTypes := map[string]KeyType{}
+	list, err := dst.List()
+	require.NoError(t, err)
+	for _, k := range list {
+		gotTypes[k.Name] = k.GetType()
+	}
+	require.Equal(t, map[string]KeyType{"local": TypeLocal, "offline": TypeOffline, "multi": TypeMulti, "ledger": TypeLedger}, gotTypes)
+
+	// the copied local key signs as the original one
+	msg := []byte("sign bytes")
+	sig, pub, err := dst.Sign("local", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	localPub, err := local.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, localPub.Equals(pub))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// no record is copied if any of them conflicts
+	conflicting := NewInMemory(cdc)
+	_, err = conflicting.SaveOfflineKey("multi", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = CopyRecords(src, conflicting)
+	require.ErrorIs(t, err, ErrKeyAlreadyExists)
+	list, err = conflicting.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	// nor if the records repeat a name or an address
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	first, err := NewOfflineRecord("first", pubKey)
+	require.NoError(t, err)
+	sameName, err := NewOfflineRecord("first", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	sameAddr, err := NewOfflineRecord("second", pubKey)
+	require.NoError(t, err)
+
+	empty := NewInMemory(cdc)
+	require.ErrorIs(t, empty.(keystore).importRecords([]*Record{first, sameName}), ErrKeyAlreadyExists)
+	require.ErrorIs(t, empty.(keystore).importRecords([]*Record{first, sameAddr}), ErrDuplicatedAddress)
+	list, err = empty.List()
+	require.NoError(t, err)
+	require.Empty(t, list)
+}
@@ -67,6 +67,13 @@ func NewMultiRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	return newRecord(name, pk, recordMultiItem)
 }
 
+// NewRemoteRecord creates a new Record with remote item
+func NewRemoteRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
+	recordRemote := &Record_Remote{}
+	recordRemoteItem := &Record_Remote_{recordRemote}
+	return newRecord(name, pk, recordRemoteItem)
+}
+
 // GetPubKey fetches a public key of the record
 func (k *Record) GetPubKey() (cryptotypes.PubKey, error) {
 	pk, ok := k.PubKey.GetCachedValue().(cryptotypes.PubKey)
@@ -97,6 +104,8 @@ func (k Record) GetType() KeyType {
 		return TypeMulti
 	case k.GetOffline() != nil:
 		return TypeOffline
+	case k.GetRemote() != nil:
+		return TypeRemote
 	default:
 		panic("unrecognized record type")
 	}
@@ -117,6 +126,17 @@ func (k *Record) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return nil
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (b *RecordBundle) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, k := range b.Records {
+		if err := k.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func extractPrivKeyFromRecord(k *Record) (cryptotypes.PrivKey, error) {
 	rl := k.GetLocal()
 	if rl == nil {
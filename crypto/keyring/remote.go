@@ -0,0 +1,410 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// BackendRemote is the keyring backend forwarding signing requests to a
+// remote signer (KMS, HSM or PKCS#11 proxy) exposing the RemoteSigner gRPC
+// service. Private keys never leave the remote signer.
+const BackendRemote = "remote"
+
+// remoteSignerTimeout bounds every call made to the remote signer.
+const remoteSignerTimeout = time.Minute
+
+var (
+	_ Keyring   = &remoteKeyring{}
+	_ io.Closer = &remoteKeyring{}
+)
+
+// WithRemoteSigner sets the address of the remote signer used by the remote
+// backend and the gRPC dial options used to connect to it. TLS with the system
+// root certificates is used when no dial option is given; a plaintext
+// connection must be requested explicitly with insecure transport credentials.
+func WithRemoteSigner(addr string, dialOpts ...grpc.DialOption) Option {
+	return func(options *Options) {
+		options.RemoteSignerAddr = addr
+		options.RemoteSignerDialOptions = dialOpts
+	}
+}
+
+// RemoteSignerTLSCredentials returns the TLS transport credentials used to
+// connect to a remote signer. The server certificate is verified against the
+// PEM encoded CA certificates of caFile, or the system root certificates when
+// caFile is empty. The client certificate and key, used for mutual TLS, are
+// optional but must be given together.
+func RemoteSignerTLSCredentials(caFile, certFile, keyFile string) (credentials.TransportCredentials, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caFile != "" {
+		caPEM, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read remote signer CA certificate: %w", err)
+		}
+
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no valid certificate found in remote signer CA file %s", caFile)
+		}
+	}
+
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("remote signer client certificate and key must be set together")
+	}
+
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't load remote signer client certificate: %w", err)
+		}
+
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(cfg), nil
+}
+
+type remoteKeyring struct {
+	conn    *grpc.ClientConn
+	client  RemoteSignerClient
+	cdc     codec.Codec
+	options Options
+
+	// mtx guards records, the keys of the remote signer as of the last
+	// ListKeys call, used to look keys up without listing them again.
+	mtx     sync.Mutex
+	records []*Record
+}
+
+// NewRemote creates a keyring backed by the remote signer configured with
+// WithRemoteSigner. The returned keyring implements io.Closer, closing its
+// connection to the remote signer.
+func NewRemote(cdc codec.Codec, opts ...Option) (Keyring, error) {
+	options := Options{
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.MlDsa65},
+		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+	}
+
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	if options.RemoteSignerAddr == "" {
+		return nil, ErrRemoteSignerNotConfigured
+	}
+
+	dialOpts := options.RemoteSignerDialOptions
+	if len(dialOpts) == 0 {
+		dialOpts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}))}
+	}
+	dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(
+		grpc.ForceCodec(codec.NewProtoCodec(cdc.InterfaceRegistry()).GRPCCodec()),
+	))
+
+	conn, err := grpc.NewClient(options.RemoteSignerAddr, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't connect to remote signer %s: %w", options.RemoteSignerAddr, err)
+	}
+
+	return &remoteKeyring{
+		conn:    conn,
+		client:  NewRemoteSignerClient(conn),
+		cdc:     cdc,
+		options: options,
+	}, nil
+}
+
+func (rk *remoteKeyring) Backend() string {
+	return BackendRemote
+}
+
+// Close closes the connection to the remote signer.
+func (rk *remoteKeyring) Close() error {
+	return rk.conn.Close()
+}
+
+// List returns the keys held by the remote signer as remote records, and
+// caches them for the key lookups.
+func (rk *remoteKeyring) List() ([]*Record, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := rk.client.ListKeys(ctx, &ListKeysRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't list remote signer keys: %w", err)
+	}
+
+	records := make([]*Record, 0, len(res.Records))
+	for _, r := range res.Records {
+		var pk types.PubKey
+		if err := rk.cdc.InterfaceRegistry().UnpackAny(r.PubKey, &pk); err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid public key for remote key %s", r.Name)
+		}
+
+		record, err := NewRemoteRecord(r.Name, pk)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	rk.mtx.Lock()
+	rk.records = records
+	rk.mtx.Unlock()
+
+	return records, nil
+}
+
+// lookup returns the first record for which match returns true, or nil if there
+// is none. The cached records are looked up first, and the keys of the remote
+// signer are only listed again when none of them matches, e.g. for a key
+// created on the remote signer since they were listed.
+func (rk *remoteKeyring) lookup(match func(*Record) (bool, error)) (*Record, error) {
+	rk.mtx.Lock()
+	cached := rk.records
+	rk.mtx.Unlock()
+
+	find := func(records []*Record) (*Record, error) {
+		for _, record := range records {
+			ok, err := match(record)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				return record, nil
+			}
+		}
+		return nil, nil
+	}
+
+	record, err := find(cached)
+	if record != nil || err != nil {
+		return record, err
+	}
+
+	records, err := rk.List()
+	if err != nil {
+		return nil, err
+	}
+
+	return find(records)
+}
+
+func (rk *remoteKeyring) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return rk.options.SupportedAlgos, rk.options.SupportedAlgosLedger
+}
+
+func (rk *remoteKeyring) Key(uid string) (*Record, error) {
+	record, err := rk.lookup(func(record *Record) (bool, error) {
+		return record.Name == uid, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, uid)
+	}
+
+	return record, nil
+}
+
+func (rk *remoteKeyring) KeyByAddress(address sdk.Address) (*Record, error) {
+	record, err := rk.lookup(func(record *Record) (bool, error) {
+		addr, err := record.GetAddress()
+		if err != nil {
+			return false, err
+		}
+		return addr.Equals(address), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key with address %s not found", address.String()))
+	}
+
+	return record, nil
+}
+
+// Sign forwards the sign bytes to the remote signer and verifies the returned
+// signature against the public key of the key.
+func (rk *remoteKeyring) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	k, err := rk.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := rk.client.Sign(ctx, &SignRequest{Name: uid, Msg: msg, SignMode: signMode})
+	if err != nil {
+		return nil, nil, fmt.Errorf("remote signer failed to sign with key %s: %w", uid, err)
+	}
+
+	if !pub.VerifySignature(msg, res.Signature) {
+		return nil, nil, ErrRemoteInvalidSignature
+	}
+
+	return res.Signature, pub, nil
+}
+
+func (rk *remoteKeyring) SignByAddress(address sdk.Address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	k, err := rk.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rk.Sign(k.Name, msg, signMode)
+}
+
+func (rk *remoteKeyring) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := rk.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := k.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := rk.cdc.MarshalInterface(key)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(bz, key.Type()), nil
+}
+
+func (rk *remoteKeyring) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	k, err := rk.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return rk.ExportPubKeyArmor(k.Name)
+}
+
+// MigrateAll is a no-op for the remote keyring, whose keys are managed by the
+// remote signer.
+func (rk *remoteKeyring) MigrateAll() ([]*Record, error) {
+	return rk.List()
+}
+
+// Keys are managed by the remote signer, hence the remote keyring cannot
+// create, import, export, rename or delete them.
+
+func (rk *remoteKeyring) Delete(string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "delete")
+}
+
+func (rk *remoteKeyring) DeleteByAddress(sdk.Address) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "delete")
+}
+
+func (rk *remoteKeyring) Rename(string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "rename")
+}
+
+func (rk *remoteKeyring) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", errorsmod.Wrap(ErrRemoteUnsupported, "new mnemonic")
+}
+
+func (rk *remoteKeyring) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "new account")
+}
+
+func (rk *remoteKeyring) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save ledger key")
+}
+
+func (rk *remoteKeyring) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save offline key")
+}
+
+func (rk *remoteKeyring) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save multisig")
+}
+
+func (rk *remoteKeyring) ImportPrivKey(string, string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import private key")
+}
+
+func (rk *remoteKeyring) ImportPrivKeyHex(string, string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import private key")
+}
+
+func (rk *remoteKeyring) ImportPubKey(string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import public key")
+}
+
+func (rk *remoteKeyring) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", errorsmod.Wrap(ErrRemoteUnsupported, "export private key")
+}
+
+func (rk *remoteKeyring) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", errorsmod.Wrap(ErrRemoteUnsupported, "export private key")
+}
+
+type remoteSignerServer struct {
+	kr Keyring
+}
+
+// NewRemoteSignerServer returns a RemoteSignerServer exposing the keys of the
+// given keyring. It is meant to run a local signer, e.g. in tests.
+func NewRemoteSignerServer(kr Keyring) RemoteSignerServer {
+	return remoteSignerServer{kr: kr}
+}
+
+func (s remoteSignerServer) ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error) {
+	records, err := s.kr.List()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ListKeysResponse{Records: make([]*Record, len(records))}
+	for i, record := range records {
+		// only expose the public part of the records
+		res.Records[i] = &Record{Name: record.Name, PubKey: record.PubKey}
+	}
+
+	return res, nil
+}
+
+func (s remoteSignerServer) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	sig, _, err := s.kr.Sign(req.Name, req.Msg, req.SignMode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignResponse{Signature: sig}, nil
+}
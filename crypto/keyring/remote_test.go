@@ -0,0 +1,247 @@
+package keyring
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// countingSigner counts the ListKeys calls made to a remote signer.
+type countingSigner struct {
+	RemoteSignerServer
+	listKeys atomic.Int32
+}
+
+func (s *countingSigner) ListKeys(ctx context.Context, req *ListKeysRequest) (*ListKeysResponse, error) {
+	s.listKeys.Add(1)
+	return s.RemoteSignerServer.ListKeys(ctx, req)
+}
+
+// startRemoteSigner runs a local remote signer serving the keys of kr.
+func startRemoteSigner(t *testing.T, cdc codec.Codec, kr Keyring, opts ...grpc.ServerOption) string {
+	t.Helper()
+
+	return serveRemoteSigner(t, cdc, NewRemoteSignerServer(kr), opts...)
+}
+
+// serveRemoteSigner runs signer as a local remote signer.
+func serveRemoteSigner(t *testing.T, cdc codec.Codec, signer RemoteSignerServer, opts ...grpc.ServerOption) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	opts = append(opts, grpc.ForceServerCodec(codec.NewProtoCodec(cdc.InterfaceRegistry()).GRPCCodec()))
+	srv := grpc.NewServer(opts...)
+	RegisterRemoteSignerServer(srv, signer)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	cdc := getCodec()
+
+	local := NewInMemory(cdc)
+	localRecord, _, err := local.NewMnemonic("signer", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	_, err = New("keybasename", BackendRemote, t.TempDir(), nil, cdc)
+	require.ErrorIs(t, err, ErrRemoteSignerNotConfigured)
+
+	signer := &countingSigner{RemoteSignerServer: NewRemoteSignerServer(local)}
+	kr, err := New("keybasename", BackendRemote, t.TempDir(), nil, cdc, WithRemoteSigner(serveRemoteSigner(t, cdc, signer), grpc.WithTransportCredentials(insecure.NewCredentials())))
+	require.NoError(t, err)
+	require.Equal(t, BackendRemote, kr.Backend())
+
+	list, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, "signer", list[0].Name)
+	require.Equal(t, TypeRemote, list[0].GetType())
+	require.Nil(t, list[0].GetLocal())
+
+	addr, err := localRecord.GetAddress()
+	require.NoError(t, err)
+	record, err := kr.KeyByAddress(addr)
+	require.NoError(t, err)
+	require.Equal(t, "signer", record.Name)
+
+	_, err = kr.Key("unknown")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	msg := []byte("sign bytes")
+	sig, pub, err := kr.Sign("signer", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	sig, _, err = kr.SignByAddress(addr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// the keys are listed again only to look up a key missing from the last
+	// listing, i.e. by List and for the unknown key
+	require.Equal(t, int32(2), signer.listKeys.Load())
+
+	// a key created on the remote signer is found
+	_, _, err = local.NewMnemonic("new", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = kr.Key("new")
+	require.NoError(t, err)
+
+	armor, err := kr.ExportPubKeyArmor("signer")
+	require.NoError(t, err)
+	require.NoError(t, NewInMemory(cdc).ImportPubKey("imported", armor))
+
+	_, err = kr.ExportPrivKeyArmor("signer", "passphrase")
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+	require.ErrorIs(t, kr.Delete("signer"), ErrRemoteUnsupported)
+	_, _, err = kr.NewMnemonic("other", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+
+	// the records of the remote signer hold no private key to copy, and the
+	// remote keyring cannot store records
+	_, err = CopyRecords(kr, NewInMemory(cdc))
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+	_, err = CopyRecords(local, kr)
+	require.Error(t, err)
+	_, ok := kr.(BundleImporter)
+	require.False(t, ok)
+	_, ok = kr.(BundleExporter)
+	require.False(t, ok)
+
+	// closing the keyring closes its connection to the remote signer
+	closer, ok := kr.(io.Closer)
+	require.True(t, ok)
+	require.NoError(t, closer.Close())
+	_, err = kr.List()
+	require.Error(t, err)
+}
+
+// writeCert creates a certificate signed by parent, or self-signed when parent
+// is nil, and writes it with its key as PEM files in dir.
+func writeCert(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey, string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile := filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return cert, key, certFile, keyFile
+}
+
+func TestRemoteKeyringTLS(t *testing.T) {
+	cdc := getCodec()
+
+	local := NewInMemory(cdc)
+	_, _, err := local.NewMnemonic("signer", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	ca, caKey, caFile, _ := writeCert(t, dir, "ca", nil, nil)
+	_, _, serverCert, serverKey := writeCert(t, dir, "server", ca, caKey)
+	_, _, clientCert, clientKey := writeCert(t, dir, "client", ca, caKey)
+
+	serverPair, err := tls.LoadX509KeyPair(serverCert, serverKey)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca)
+	tlsAddr := startRemoteSigner(t, cdc, local, grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverPair},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS12,
+	})))
+	plaintextAddr := startRemoteSigner(t, cdc, local)
+
+	_, err = RemoteSignerTLSCredentials(filepath.Join(dir, "missing.crt"), "", "")
+	require.Error(t, err)
+	_, err = RemoteSignerTLSCredentials(serverKey, "", "")
+	require.Error(t, err)
+	_, err = RemoteSignerTLSCredentials(caFile, clientCert, "")
+	require.Error(t, err)
+
+	mutualTLS, err := RemoteSignerTLSCredentials(caFile, clientCert, clientKey)
+	require.NoError(t, err)
+	serverOnlyTLS, err := RemoteSignerTLSCredentials(caFile, "", "")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		addr     string
+		dialOpts []grpc.DialOption
+		expErr   bool
+	}{
+		{"mutual TLS", tlsAddr, []grpc.DialOption{grpc.WithTransportCredentials(mutualTLS)}, false},
+		{"missing client certificate", tlsAddr, []grpc.DialOption{grpc.WithTransportCredentials(serverOnlyTLS)}, true},
+		{"default TLS does not trust the signer CA", tlsAddr, nil, true},
+		{"default TLS to a plaintext signer", plaintextAddr, nil, true},
+		{"TLS to a plaintext signer", plaintextAddr, []grpc.DialOption{grpc.WithTransportCredentials(mutualTLS)}, true},
+		{"explicit plaintext", plaintextAddr, []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kr, err := New("keybasename", BackendRemote, t.TempDir(), nil, cdc, WithRemoteSigner(tc.addr, tc.dialOpts...))
+			require.NoError(t, err)
+
+			list, err := kr.List()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, list, 1)
+		})
+	}
+}
@@ -15,10 +15,12 @@ type SignatureAlgo interface {
 	Generate() hd.GenerateFn
 }
 
-// NewSigningAlgoFromString creates a supported SignatureAlgo.
+// NewSigningAlgoFromString creates a supported SignatureAlgo. Underscores are
+// ignored when matching the name of the algorithm, so that ml_dsa_65 can also be
+// referred to as mldsa65.
 func NewSigningAlgoFromString(str string, algoList SigningAlgoList) (SignatureAlgo, error) {
 	for _, algo := range algoList {
-		if str == string(algo.Name()) {
+		if str == string(algo.Name()) || str == strings.ReplaceAll(string(algo.Name()), "_", "") {
 			return algo, nil
 		}
 	}
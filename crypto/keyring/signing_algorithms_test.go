@@ -25,6 +25,20 @@ func TestNewSigningAlgoFromString(t *testing.T) {
 			hd.Secp256k1,
 			nil,
 		},
+		{
+			"supported algorithm with underscores",
+			"ml_dsa_65",
+			true,
+			hd.MlDsa65,
+			nil,
+		},
+		{
+			"supported algorithm without underscores",
+			"mldsa65",
+			true,
+			hd.MlDsa65,
+			nil,
+		},
 		{
 			"not supported",
 			"notsupportedalgo",
@@ -34,12 +48,12 @@ func TestNewSigningAlgoFromString(t *testing.T) {
 		},
 	}
 
-	list := SigningAlgoList{hd.Secp256k1}
+	list := SigningAlgoList{hd.Secp256k1, hd.MlDsa65}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			algorithm, err := NewSigningAlgoFromString(tt.algoStr, list)
 			if tt.isSupported {
-				require.Equal(t, hd.Secp256k1, algorithm)
+				require.Equal(t, tt.expectedAlgo, algorithm)
 			} else {
 				require.ErrorIs(t, err, tt.expectedErr)
 			}
@@ -0,0 +1,14 @@
+package bls12_381
+
+// AggregateMessage returns the message pubKey signs for its signature over msg
+// to be aggregated with the signatures of other keys: the public key bytes
+// followed by msg.
+//
+// Aggregating signatures of different keys over the same message is subject
+// to rogue public key attacks, in which a signer derives its public key from
+// the others' to forge the aggregate signature alone. Prefixing the message
+// with the public key of its signer, known as message augmentation, prevents
+// them without requiring proofs of possession of the keys.
+func AggregateMessage(pubKey *PubKey, msg []byte) []byte {
+	return append(append(make([]byte, 0, len(pubKey.Key)+len(msg)), pubKey.Key...), msg...)
+}
@@ -0,0 +1,60 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	bls12_381 "github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+)
+
+func TestAggregateSignatures(t *testing.T) {
+	msgs := [][]byte{[]byte("sign bytes 1"), []byte("sign bytes 2"), []byte("sign bytes 2")}
+
+	pubKeys := make([]*bls12_381.PubKey, 3)
+	sigs := make([][]byte, 3)
+	for i := range pubKeys {
+		privKey, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		pubKeys[i] = privKey.PubKey().(*bls12_381.PubKey)
+		sigs[i], err = privKey.Sign(bls12_381.AggregateMessage(pubKeys[i], msgs[i]))
+		require.NoError(t, err)
+	}
+
+	sig, err := bls12_381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.True(t, bls12_381.VerifyAggregateSignature(pubKeys, msgs, sig))
+
+	// the aggregate signature of a single key is its signature
+	single, err := bls12_381.AggregateSignatures(sigs[:1])
+	require.NoError(t, err)
+	require.Equal(t, sigs[0], single)
+	require.True(t, bls12_381.VerifyAggregateSignature(pubKeys[:1], msgs[:1], single))
+
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, [][]byte{msgs[0], msgs[1], []byte("other sign bytes")}, sig))
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, [][]byte{msgs[1], msgs[0], msgs[2]}, sig))
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, msgs[:2], sig))
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys[:2], msgs[:2], sig))
+	require.False(t, bls12_381.VerifyAggregateSignature(nil, nil, sig))
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, msgs, sig[1:]))
+
+	// the signatures must be over the augmented messages
+	plain := make([][]byte, len(sigs))
+	for i := range plain {
+		privKey, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		pubKeys[i] = privKey.PubKey().(*bls12_381.PubKey)
+		plain[i], err = privKey.Sign(msgs[i])
+		require.NoError(t, err)
+	}
+	sig, err = bls12_381.AggregateSignatures(plain)
+	require.NoError(t, err)
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, msgs, sig))
+
+	_, err = bls12_381.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = bls12_381.AggregateSignatures([][]byte{sigs[0][1:]})
+	require.Error(t, err)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 	bls "github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -105,12 +106,16 @@ var _ cryptotypes.PubKey = &PubKey{}
 //
 // The function will panic if the public key is invalid.
 func (pubKey PubKey) Address() crypto.Address {
-	panic("not implemented, build flags are required to use bls12_381 keys")
+	if len(pubKey.Key) != bls.PubKeySize {
+		panic("pubkey is incorrect size")
+	}
+	return crypto.Address(tmhash.SumTruncated(pubKey.Key))
 }
 
-// VerifySignature verifies the given signature.
+// VerifySignature verifies the given signature. Signatures cannot be verified
+// without the build flags, so it always returns false.
 func (pubKey PubKey) VerifySignature(_, _ []byte) bool {
-	panic("not implemented, build flags are required to use bls12_381 keys")
+	return false
 }
 
 // Bytes returns the byte format.
@@ -132,3 +137,19 @@ func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
 func (pubKey PubKey) String() string {
 	return fmt.Sprintf("PubKeyBLS12_381{%X}", pubKey.Key)
 }
+
+// ===============================================================================================
+// Aggregation
+// ===============================================================================================
+
+// AggregateSignatures aggregates the signatures into a single signature.
+func AggregateSignatures(_ [][]byte) ([]byte, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// VerifyAggregateSignature verifies that sig is the aggregate of the signatures
+// of AggregateMessage(pubKeys[i], msgs[i]) by each of the pubKeys. Signatures
+// cannot be verified without the build flags, so it always returns false.
+func VerifyAggregateSignature(_ []*PubKey, _ [][]byte, _ []byte) bool {
+	return false
+}
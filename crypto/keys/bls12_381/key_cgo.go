@@ -10,6 +10,7 @@ import (
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	blst "github.com/supranational/blst/bindings/go"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -167,3 +168,56 @@ func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
 func (pubKey PubKey) String() string {
 	return fmt.Sprintf("PubKeyBLS12_381{%X}", pubKey.Key)
 }
+
+// ===============================================================================================
+// Aggregation
+// ===============================================================================================
+
+// dst is the domain separation tag of the signatures of the CometBFT keys.
+var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+
+// AggregateSignatures aggregates the signatures into a single signature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+	for _, sig := range sigs {
+		if len(sig) != bls12381.SignatureLength {
+			return nil, fmt.Errorf("invalid signature size, expected %d bytes, got %d", bls12381.SignatureLength, len(sig))
+		}
+	}
+
+	agg := new(blst.P2Aggregate)
+	if !agg.AggregateCompressed(sigs, true) {
+		return nil, errors.New("invalid signature")
+	}
+
+	return agg.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature verifies that sig is the aggregate of the signatures
+// of AggregateMessage(pubKeys[i], msgs[i]) by each of the pubKeys.
+func VerifyAggregateSignature(pubKeys []*PubKey, msgs [][]byte, sig []byte) bool {
+	if len(pubKeys) == 0 || len(msgs) != len(pubKeys) || len(sig) != bls12381.SignatureLength {
+		return false
+	}
+
+	signature := new(blst.P2Affine).Uncompress(sig)
+	if signature == nil {
+		return false
+	}
+
+	pks := make([]*blst.P1Affine, len(pubKeys))
+	augmentedMsgs := make([]blst.Message, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		pks[i] = new(blst.P1Affine).Deserialize(pubKey.Key)
+		if pks[i] == nil {
+			return false
+		}
+		augmentedMsgs[i] = AggregateMessage(pubKey, msgs[i])
+	}
+
+	// the signature and the public keys are group checked, the public keys are
+	// also checked not to be infinite
+	return signature.AggregateVerify(true, pks, true, augmentedMsgs, dst)
+}
@@ -0,0 +1,24 @@
+//go:build !bls12381
+
+package bls12_381_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+)
+
+func TestPubKeyWithoutBuildFlags(t *testing.T) {
+	pubKey := &bls12_381.PubKey{Key: make([]byte, bls12381.PubKeySize)}
+
+	require.Equal(t, tmhash.SumTruncated(pubKey.Key), pubKey.Address().Bytes())
+	require.False(t, pubKey.VerifySignature([]byte("msg"), make([]byte, bls12381.SignatureLength)))
+	require.False(t, bls12_381.VerifyAggregateSignature(
+		[]*bls12_381.PubKey{pubKey}, [][]byte{[]byte("msg")}, make([]byte, bls12381.SignatureLength),
+	))
+	require.Panics(t, func() { (&bls12_381.PubKey{Key: []byte{1}}).Address() })
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mldsa65"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -346,6 +347,47 @@ func TestVerifyMultisignatureMoreBitsThanSigs(t *testing.T) {
 	require.Error(t, multisigKey.VerifyMultisignature(getSignBytes, sig))
 }
 
+func TestVerifyMultisignatureMixedKeys(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+
+	// 2-of-3 multisig mixing a classical key with post-quantum keys.
+	mlDsaKey1, err := mldsa65.GenPrivKey()
+	require.NoError(t, err)
+	mlDsaKey2, err := mldsa65.GenPrivKey()
+	require.NoError(t, err)
+	privKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), &mlDsaKey1, &mlDsaKey2}
+	pubKeys := make([]cryptotypes.PubKey, len(privKeys))
+	for i, privKey := range privKeys {
+		pubKeys[i] = privKey.PubKey()
+	}
+	pk := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+
+	// the multisig public key survives the amino round trip its address depends on
+	var decoded kmultisig.LegacyAminoPubKey
+	require.NoError(t, kmultisig.AminoCdc.Unmarshal(pk.Bytes(), &decoded))
+	require.True(t, pk.Equals(&decoded))
+	require.Equal(t, pk.Address(), decoded.Address())
+
+	for _, signers := range [][]int{{0, 1}, {1, 2}, {0, 2}} {
+		sig := multisig.NewMultisig(len(pubKeys))
+		for _, i := range signers {
+			bz, err := privKeys[i].Sign(msg)
+			require.NoError(t, err)
+			require.NoError(t, multisig.AddSignatureFromPubKey(sig, &signing.SingleSignatureData{Signature: bz}, pubKeys[i], pubKeys))
+		}
+		require.NoError(t, pk.VerifyMultisignature(signBytesFn, sig))
+	}
+
+	// a classical signature cannot stand in for a post-quantum one
+	sig := multisig.NewMultisig(len(pubKeys))
+	bz, err := privKeys[0].Sign(msg)
+	require.NoError(t, err)
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, &signing.SingleSignatureData{Signature: bz}, pubKeys[0], pubKeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, &signing.SingleSignatureData{Signature: bz}, pubKeys[1], pubKeys))
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, sig))
+}
+
 func generateNestedMultiSignature(n int, msg []byte) (multisig.PubKey, *signing.MultiSignatureData) {
 	pubKeys := make([]cryptotypes.PubKey, n)
 	signatures := make([]signing.SignatureData, n)
@@ -0,0 +1,44 @@
+// Package webauthn implements a Cosmos-SDK compatible WebAuthn public key, allowing
+// accounts to be controlled by passkeys, e.g. signing transactions with device
+// biometrics instead of a mnemonic. The keys can be protobuf serialized and packed
+// in Any.
+//
+// A WebAuthn authenticator does not sign the sign bytes directly, but
+// authenticatorData || SHA-256(clientDataJSON), where clientDataJSON holds the
+// challenge given to the authenticator. A transaction signature is a Signature
+// carrying the assertion, which is valid if:
+//
+//   - the client data type is "webauthn.get",
+//   - the client data challenge is the base64url encoding of the SHA-256 hash of
+//     the sign bytes, see Challenge,
+//   - the authenticator data flags that the user was present,
+//   - the ECDSA signature of the assertion is valid for the secp256r1 key.
+//
+// The relying party ID and the origin are not verified, as a transaction can be
+// signed from any website or application.
+package webauthn
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	name = "webauthn"
+
+	// clientDataTypeGet is the client data type of an assertion.
+	clientDataTypeGet = "webauthn.get"
+
+	// minAuthenticatorDataSize is the size of the authenticator data without
+	// extensions: the relying party ID hash, the flags and the signature counter.
+	minAuthenticatorDataSize = 32 + 1 + 4
+	// flagsIndex is the index of the flags in the authenticator data.
+	flagsIndex = 32
+	// flagUserPresent is the flag set when the user was present.
+	flagUserPresent = 0x01
+)
+
+// RegisterInterfaces adds the WebAuthn PubKey to pubkey registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+}
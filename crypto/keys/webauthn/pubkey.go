@@ -0,0 +1,153 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/internal/ecdsa"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var _ cryptotypes.PubKey = (*PubKey)(nil)
+
+// NewPubKeyFromBytes creates a WebAuthn PubKey from the compressed bytes of the
+// secp256r1 public key of a passkey credential.
+func NewPubKeyFromBytes(bz []byte) (*PubKey, error) {
+	key, err := secp256r1.NewPubKeyFromBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+	return &PubKey{Key: key}, nil
+}
+
+// String implements proto.Message interface.
+func (m *PubKey) String() string {
+	return fmt.Sprintf("%s{%X}", name, m.Bytes())
+}
+
+// Bytes implements SDK PubKey interface.
+func (m *PubKey) Bytes() []byte {
+	if m == nil {
+		return nil
+	}
+	return m.Key.Bytes()
+}
+
+// Equals implements SDK PubKey interface.
+func (m *PubKey) Equals(other cryptotypes.PubKey) bool {
+	pk2, ok := other.(*PubKey)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(m.Bytes(), pk2.Bytes())
+}
+
+// Address implements SDK PubKey interface. It is the ADR-28 address of the key,
+// which differs from the address of the same key used as a secp256r1 key.
+func (m *PubKey) Address() cmtcrypto.Address {
+	return address.Hash(proto.MessageName(m), m.Bytes())
+}
+
+// Type returns key type name. Implements SDK PubKey interface.
+func (m *PubKey) Type() string {
+	return name
+}
+
+// VerifySignature implements SDK PubKey interface. sig must be the protobuf
+// encoding of a Signature whose challenge is the hash of msg.
+func (m *PubKey) VerifySignature(msg, sig []byte) bool {
+	return m.VerifyAssertion(msg, sig) == nil
+}
+
+// VerifyAssertion verifies that sig is the protobuf encoding of a valid WebAuthn
+// assertion of msg, returning the reason why it is not otherwise.
+func (m *PubKey) VerifyAssertion(msg, sig []byte) error {
+	if m == nil || m.Key == nil || m.Key.Key == nil {
+		return errors.New("empty public key")
+	}
+
+	var assertion Signature
+	if err := assertion.Unmarshal(sig); err != nil {
+		return fmt.Errorf("invalid signature encoding: %w", err)
+	}
+
+	var clientData struct {
+		Type      string `json:"type"`
+		Challenge string `json:"challenge"`
+	}
+	if err := json.Unmarshal(assertion.ClientDataJSON, &clientData); err != nil {
+		return fmt.Errorf("invalid client data: %w", err)
+	}
+	if clientData.Type != clientDataTypeGet {
+		return fmt.Errorf("invalid client data type, expected %s, got %s", clientDataTypeGet, clientData.Type)
+	}
+	if clientData.Challenge != Challenge(msg) {
+		return errors.New("client data challenge does not match the sign bytes")
+	}
+
+	if len(assertion.AuthenticatorData) < minAuthenticatorDataSize {
+		return fmt.Errorf("authenticator data too short, expected at least %d bytes, got %d",
+			minAuthenticatorDataSize, len(assertion.AuthenticatorData))
+	}
+	if assertion.AuthenticatorData[flagsIndex]&flagUserPresent == 0 {
+		return errors.New("user not present")
+	}
+
+	if !m.Key.VerifySignature(SignedData(assertion.AuthenticatorData, assertion.ClientDataJSON), assertion.Signature) {
+		return errors.New("invalid assertion signature")
+	}
+	return nil
+}
+
+// Challenge returns the challenge to give to the authenticator to sign msg: the
+// base64url encoding, without padding, of the SHA-256 hash of msg.
+func Challenge(msg []byte) string {
+	h := sha256.Sum256(msg)
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
+
+// SignedData returns the data signed by the authenticator in an assertion:
+// authenticatorData || SHA-256(clientDataJSON).
+func SignedData(authenticatorData, clientDataJSON []byte) []byte {
+	h := sha256.Sum256(clientDataJSON)
+	return append(append(make([]byte, 0, len(authenticatorData)+len(h)), authenticatorData...), h[:]...)
+}
+
+// NewSignature returns the transaction signature of an assertion, from the
+// authenticator data, the client data JSON and the ASN.1 DER encoded ECDSA
+// signature returned by the authenticator.
+func NewSignature(authenticatorData, clientDataJSON, derSignature []byte) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	rest, err := asn1.Unmarshal(derSignature, &sig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DER signature: %w", err)
+	}
+	if len(rest) != 0 || sig.R.Sign() <= 0 || sig.S.Sign() <= 0 || sig.R.BitLen() > 256 || sig.S.BitLen() > 256 {
+		return nil, errors.New("invalid DER signature")
+	}
+
+	// the signature is verified as R || S with a low S, see secp256r1.PubKey.VerifySignature
+	raw := make([]byte, 64)
+	sig.R.FillBytes(raw[:32])
+	ecdsa.NormalizeS(sig.S).FillBytes(raw[32:])
+
+	assertion := Signature{
+		AuthenticatorData: authenticatorData,
+		ClientDataJSON:    clientDataJSON,
+		Signature:         raw,
+	}
+	return assertion.Marshal()
+}
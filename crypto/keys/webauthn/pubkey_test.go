@@ -0,0 +1,226 @@
+package webauthn_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// authenticator signs assertions as a passkey authenticator does.
+type authenticator struct {
+	key    *ecdsa.PrivateKey
+	pubKey *webauthn.PubKey
+}
+
+func newAuthenticator(t *testing.T) authenticator {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pubKey, err := webauthn.NewPubKeyFromBytes(elliptic.MarshalCompressed(elliptic.P256(), key.X, key.Y))
+	require.NoError(t, err)
+	return authenticator{key: key, pubKey: pubKey}
+}
+
+func authenticatorData(flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte("wallet.example.com"))
+	return append(append(rpIDHash[:], flags), 0, 0, 0, 1)
+}
+
+func clientDataJSON(typ, challenge string) []byte {
+	return fmt.Appendf(nil, `{"type":%q,"challenge":%q,"origin":"https://wallet.example.com","crossOrigin":false}`, typ, challenge)
+}
+
+// sign returns the ASN.1 DER signature of the assertion.
+func (a authenticator) sign(t *testing.T, authData, clientData []byte) []byte {
+	t.Helper()
+	digest := sha256.Sum256(webauthn.SignedData(authData, clientData))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+	return sig
+}
+
+func (a authenticator) assert(t *testing.T, authData, clientData []byte) []byte {
+	t.Helper()
+	sig, err := webauthn.NewSignature(authData, clientData, a.sign(t, authData, clientData))
+	require.NoError(t, err)
+	return sig
+}
+
+func TestVerifySignature(t *testing.T) {
+	a := newAuthenticator(t)
+	msg := []byte("sign bytes")
+	challenge := webauthn.Challenge(msg)
+
+	testCases := []struct {
+		name      string
+		sig       []byte
+		expectErr string
+	}{
+		{
+			name: "valid",
+			sig:  a.assert(t, authenticatorData(0x05), clientDataJSON("webauthn.get", challenge)),
+		},
+		{
+			name:      "wrong challenge",
+			sig:       a.assert(t, authenticatorData(0x05), clientDataJSON("webauthn.get", webauthn.Challenge([]byte("other sign bytes")))),
+			expectErr: "client data challenge does not match the sign bytes",
+		},
+		{
+			name:      "padded challenge",
+			sig:       a.assert(t, authenticatorData(0x05), clientDataJSON("webauthn.get", challenge+"=")),
+			expectErr: "client data challenge does not match the sign bytes",
+		},
+		{
+			name:      "wrong type",
+			sig:       a.assert(t, authenticatorData(0x05), clientDataJSON("webauthn.create", challenge)),
+			expectErr: "invalid client data type",
+		},
+		{
+			name:      "invalid client data",
+			sig:       a.assert(t, authenticatorData(0x05), []byte("{")),
+			expectErr: "invalid client data",
+		},
+		{
+			name:      "user not present",
+			sig:       a.assert(t, authenticatorData(0x04), clientDataJSON("webauthn.get", challenge)),
+			expectErr: "user not present",
+		},
+		{
+			name:      "short authenticator data",
+			sig:       a.assert(t, authenticatorData(0x05)[:36], clientDataJSON("webauthn.get", challenge)),
+			expectErr: "authenticator data too short",
+		},
+		{
+			name:      "invalid encoding",
+			sig:       []byte{0xff},
+			expectErr: "invalid signature encoding",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := a.pubKey.VerifyAssertion(msg, tc.sig)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				require.False(t, a.pubKey.VerifySignature(msg, tc.sig))
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, a.pubKey.VerifySignature(msg, tc.sig))
+		})
+	}
+}
+
+func TestVerifySignatureTampered(t *testing.T) {
+	a := newAuthenticator(t)
+	msg := []byte("sign bytes")
+	authData := authenticatorData(0x01)
+	clientData := clientDataJSON("webauthn.get", webauthn.Challenge(msg))
+
+	var assertion webauthn.Signature
+	require.NoError(t, assertion.Unmarshal(a.assert(t, authData, clientData)))
+
+	// the authenticator data is signed
+	tampered := assertion
+	tampered.AuthenticatorData = authenticatorData(0x05)
+	sig, err := tampered.Marshal()
+	require.NoError(t, err)
+	require.ErrorContains(t, a.pubKey.VerifyAssertion(msg, sig), "invalid assertion signature")
+
+	// the signature must have a low s
+	var s big.Int
+	s.SetBytes(assertion.Signature[32:])
+	s.Sub(elliptic.P256().Params().N, &s)
+	tampered = assertion
+	tampered.Signature = append(append([]byte{}, assertion.Signature[:32]...), s.FillBytes(make([]byte, 32))...)
+	sig, err = tampered.Marshal()
+	require.NoError(t, err)
+	require.ErrorContains(t, a.pubKey.VerifyAssertion(msg, sig), "invalid assertion signature")
+
+	// the signature is made with the key
+	sig = newAuthenticator(t).assert(t, authData, clientData)
+	require.ErrorContains(t, a.pubKey.VerifyAssertion(msg, sig), "invalid assertion signature")
+
+	var empty *webauthn.PubKey
+	require.False(t, empty.VerifySignature(msg, a.assert(t, authData, clientData)))
+}
+
+func TestNewSignature(t *testing.T) {
+	a := newAuthenticator(t)
+	authData := authenticatorData(0x01)
+	clientData := clientDataJSON("webauthn.get", webauthn.Challenge([]byte("sign bytes")))
+
+	// authenticators may return a high s, which is normalized
+	digest := sha256.Sum256(webauthn.SignedData(authData, clientData))
+	r, s, err := ecdsa.Sign(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+	n := elliptic.P256().Params().N
+	if s.Cmp(new(big.Int).Rsh(n, 1)) <= 0 {
+		s.Sub(n, s)
+	}
+	der, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+	require.NoError(t, err)
+
+	sig, err := webauthn.NewSignature(authData, clientData, der)
+	require.NoError(t, err)
+	require.NoError(t, a.pubKey.VerifyAssertion([]byte("sign bytes"), sig))
+
+	_, err = webauthn.NewSignature(authData, clientData, []byte("not DER"))
+	require.ErrorContains(t, err, "invalid DER signature")
+	_, err = webauthn.NewSignature(authData, clientData, append(der, 0))
+	require.ErrorContains(t, err, "invalid DER signature")
+}
+
+func TestPubKey(t *testing.T) {
+	a := newAuthenticator(t)
+	require.Equal(t, "webauthn", a.pubKey.Type())
+	require.Len(t, a.pubKey.Bytes(), 33)
+	require.Len(t, a.pubKey.Address(), 32)
+
+	// the address differs from the one of the same key used as a secp256r1 key
+	r1, err := secp256r1.NewPubKeyFromBytes(a.pubKey.Bytes())
+	require.NoError(t, err)
+	require.NotEqual(t, r1.Address(), a.pubKey.Address())
+	require.False(t, a.pubKey.Equals(r1))
+
+	other, err := webauthn.NewPubKeyFromBytes(a.pubKey.Bytes())
+	require.NoError(t, err)
+	require.True(t, a.pubKey.Equals(other))
+	require.False(t, a.pubKey.Equals(newAuthenticator(t).pubKey))
+
+	_, err = webauthn.NewPubKeyFromBytes([]byte{1, 2, 3})
+	require.Error(t, err)
+}
+
+func TestMarshalAny(t *testing.T) {
+	a := newAuthenticator(t)
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bz, err := cdc.MarshalInterface(a.pubKey)
+	require.NoError(t, err)
+	var pk cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterface(bz, &pk))
+	require.True(t, a.pubKey.Equals(pk))
+
+	bz, err = cdc.MarshalInterfaceJSON(a.pubKey)
+	require.NoError(t, err)
+	var pkJSON cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &pkJSON))
+	require.True(t, a.pubKey.Equals(pkJSON))
+}
@@ -0,0 +1,400 @@
+// Package threshold implements threshold ElGamal encryption over secp256k1.
+//
+// A message is encrypted to a public key X = x*G whose secret x is split in
+// Shamir secret shares x_i held by n parties. Decrypting a ciphertext with the
+// ephemeral key R = r*G requires the decryption shares D_i = x_i*R of at least
+// t parties, which are combined with Lagrange interpolation into x*R = r*X, the
+// Diffie-Hellman secret from which the symmetric key is derived. Every
+// decryption share carries a Chaum-Pedersen proof that it was computed with
+// the secret share matching the public share X_i = x_i*G of the party.
+//
+// The symmetric encryption uses AES-256-GCM with a key derived from the shared
+// secret and the ephemeral key. As the ephemeral key is unique per ciphertext,
+// a fixed nonce is used.
+package threshold
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+const (
+	// PointSize is the size of a compressed secp256k1 point.
+	PointSize = secp256k1.PubKeyBytesLenCompressed
+	// ScalarSize is the size of a secp256k1 scalar.
+	ScalarSize = 32
+	// ProofSize is the size of the proof of a decryption share.
+	ProofSize = 2 * ScalarSize
+
+	keyDomain   = "cosmos-sdk/threshold/key"
+	proofDomain = "cosmos-sdk/threshold/dleq"
+)
+
+var (
+	// ErrInvalidShare is returned when a decryption share or its proof is invalid.
+	ErrInvalidShare = errors.New("invalid decryption share")
+	// ErrInsufficientShares is returned when less decryption shares than the
+	// threshold are provided.
+	ErrInsufficientShares = errors.New("insufficient decryption shares")
+	// ErrDecryption is returned when a ciphertext cannot be decrypted.
+	ErrDecryption = errors.New("failed to decrypt ciphertext")
+)
+
+// SecretShare is the Shamir share of the secret key held by a single party.
+// Indices start at 1.
+type SecretShare struct {
+	Index  uint32
+	Secret []byte
+}
+
+// DecryptionShare is the decryption share of a single party for a ciphertext.
+type DecryptionShare struct {
+	Index uint32
+	Share []byte
+}
+
+// Keyset is the result of a trusted dealer key generation.
+type Keyset struct {
+	// PublicKey is the public encryption key.
+	PublicKey []byte
+	// PublicShares are the public shares of the parties, PublicShares[i] being
+	// the share of the party with index i+1.
+	PublicShares [][]byte
+	// SecretShares are the secret shares of the parties, SecretShares[i] being
+	// the share of the party with index i+1.
+	SecretShares []SecretShare
+}
+
+// GenerateKeyset generates a keyset of n shares of which t are required to
+// decrypt, using a trusted dealer. It is meant for tests and development
+// networks, production networks should use a distributed key generation.
+func GenerateKeyset(rand io.Reader, t, n uint32) (Keyset, error) {
+	if t == 0 || t > n {
+		return Keyset{}, fmt.Errorf("threshold must be in [1, %d], got %d", n, t)
+	}
+
+	// random polynomial of degree t-1, coeffs[0] is the secret key
+	coeffs := make([]secp256k1.ModNScalar, t)
+	for i := range coeffs {
+		k, err := randomScalar(rand)
+		if err != nil {
+			return Keyset{}, err
+		}
+		coeffs[i] = k
+	}
+
+	ks := Keyset{
+		PublicKey:    baseMul(&coeffs[0]),
+		PublicShares: make([][]byte, n),
+		SecretShares: make([]SecretShare, n),
+	}
+
+	for i := uint32(1); i <= n; i++ {
+		var x, y secp256k1.ModNScalar
+		x.SetInt(i)
+		// Horner evaluation of the polynomial at x
+		for j := len(coeffs) - 1; j >= 0; j-- {
+			y.Mul(&x).Add(&coeffs[j])
+		}
+
+		secret := y.Bytes()
+		ks.SecretShares[i-1] = SecretShare{Index: i, Secret: secret[:]}
+		ks.PublicShares[i-1] = baseMul(&y)
+	}
+
+	return ks, nil
+}
+
+// ValidatePoint returns an error if bz is not a valid compressed point.
+func ValidatePoint(bz []byte) error {
+	if len(bz) != PointSize {
+		return fmt.Errorf("invalid point length, expected %d, got %d", PointSize, len(bz))
+	}
+
+	_, err := secp256k1.ParsePubKey(bz)
+	return err
+}
+
+// Encrypt encrypts the plaintext to the public key. It returns the ephemeral
+// key and the ciphertext.
+func Encrypt(rand io.Reader, publicKey, plaintext []byte) (ephemeralKey, ciphertext []byte, err error) {
+	pub, err := parsePoint(publicKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	r, err := randomScalar(rand)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ephemeralKey = baseMul(&r)
+
+	var secret secp256k1.JacobianPoint
+	secp256k1.ScalarMultNonConst(&r, &pub, &secret)
+
+	aead, err := newAEAD(&secret, ephemeralKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	return ephemeralKey, aead.Seal(nil, nonce, plaintext, ephemeralKey), nil
+}
+
+// PublicShare returns the public share matching the secret share.
+func (s SecretShare) PublicShare() ([]byte, error) {
+	x, err := s.scalar()
+	if err != nil {
+		return nil, err
+	}
+
+	return baseMul(&x), nil
+}
+
+// NewDecryptionShare computes the decryption share of the secret share for the
+// given ephemeral key, along with the proof of its correctness.
+func (s SecretShare) NewDecryptionShare(rand io.Reader, ephemeralKey []byte) (share DecryptionShare, proof []byte, err error) {
+	x, err := s.scalar()
+	if err != nil {
+		return DecryptionShare{}, nil, err
+	}
+
+	r, err := parsePoint(ephemeralKey)
+	if err != nil {
+		return DecryptionShare{}, nil, fmt.Errorf("invalid ephemeral key: %w", err)
+	}
+
+	var d secp256k1.JacobianPoint
+	secp256k1.ScalarMultNonConst(&x, &r, &d)
+	shareBz := serialize(&d)
+
+	// Chaum-Pedersen proof that log_G(X_i) == log_R(D_i)
+	k, err := randomScalar(rand)
+	if err != nil {
+		return DecryptionShare{}, nil, err
+	}
+
+	var a, b secp256k1.JacobianPoint
+	secp256k1.ScalarBaseMultNonConst(&k, &a)
+	secp256k1.ScalarMultNonConst(&k, &r, &b)
+
+	c := proofChallenge(baseMul(&x), ephemeralKey, shareBz, serialize(&a), serialize(&b))
+
+	// s = k + c*x
+	var z secp256k1.ModNScalar
+	z.Mul2(&c, &x).Add(&k)
+
+	cBz, zBz := c.Bytes(), z.Bytes()
+	proof = append(cBz[:], zBz[:]...)
+
+	return DecryptionShare{Index: s.Index, Share: shareBz}, proof, nil
+}
+
+// VerifyDecryptionShare verifies that the decryption share for the given
+// ephemeral key was computed with the secret share matching the public share.
+func VerifyDecryptionShare(publicShare, ephemeralKey, share, proof []byte) error {
+	xi, err := parsePoint(publicShare)
+	if err != nil {
+		return fmt.Errorf("%w: invalid public share: %w", ErrInvalidShare, err)
+	}
+
+	r, err := parsePoint(ephemeralKey)
+	if err != nil {
+		return fmt.Errorf("%w: invalid ephemeral key: %w", ErrInvalidShare, err)
+	}
+
+	d, err := parsePoint(share)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidShare, err)
+	}
+
+	if len(proof) != ProofSize {
+		return fmt.Errorf("%w: invalid proof length %d", ErrInvalidShare, len(proof))
+	}
+
+	var c, z secp256k1.ModNScalar
+	if c.SetByteSlice(proof[:ScalarSize]) || z.SetByteSlice(proof[ScalarSize:]) {
+		return fmt.Errorf("%w: proof scalar overflow", ErrInvalidShare)
+	}
+
+	// A = z*G - c*X_i and B = z*R - c*D_i
+	var negC secp256k1.ModNScalar
+	negC.NegateVal(&c)
+
+	var zG, cX, a secp256k1.JacobianPoint
+	secp256k1.ScalarBaseMultNonConst(&z, &zG)
+	secp256k1.ScalarMultNonConst(&negC, &xi, &cX)
+	secp256k1.AddNonConst(&zG, &cX, &a)
+
+	var zR, cD, b secp256k1.JacobianPoint
+	secp256k1.ScalarMultNonConst(&z, &r, &zR)
+	secp256k1.ScalarMultNonConst(&negC, &d, &cD)
+	secp256k1.AddNonConst(&zR, &cD, &b)
+
+	if isInfinity(&a) || isInfinity(&b) {
+		return fmt.Errorf("%w: invalid proof", ErrInvalidShare)
+	}
+
+	expected := proofChallenge(publicShare, ephemeralKey, share, serialize(&a), serialize(&b))
+	if !expected.Equals(&c) {
+		return fmt.Errorf("%w: invalid proof", ErrInvalidShare)
+	}
+
+	return nil
+}
+
+// Decrypt combines the decryption shares and decrypts the ciphertext. Exactly
+// t shares with distinct indices must be provided, their validity must have
+// been checked with VerifyDecryptionShare beforehand.
+func Decrypt(t uint32, ephemeralKey, ciphertext []byte, shares []DecryptionShare) ([]byte, error) {
+	if t == 0 || uint32(len(shares)) < t {
+		return nil, fmt.Errorf("%w: got %d, need %d", ErrInsufficientShares, len(shares), t)
+	}
+	shares = shares[:t]
+
+	indices := make([]secp256k1.ModNScalar, len(shares))
+	for i, share := range shares {
+		if share.Index == 0 {
+			return nil, fmt.Errorf("%w: index cannot be zero", ErrInvalidShare)
+		}
+		for j := range i {
+			if shares[j].Index == share.Index {
+				return nil, fmt.Errorf("%w: duplicate index %d", ErrInvalidShare, share.Index)
+			}
+		}
+		indices[i].SetInt(share.Index)
+	}
+
+	var secret secp256k1.JacobianPoint
+	for i, share := range shares {
+		d, err := parsePoint(share.Share)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidShare, err)
+		}
+
+		// Lagrange coefficient at zero: prod_{j != i} x_j / (x_j - x_i)
+		var num, den secp256k1.ModNScalar
+		num.SetInt(1)
+		den.SetInt(1)
+		for j := range shares {
+			if i == j {
+				continue
+			}
+			var diff secp256k1.ModNScalar
+			diff.NegateVal(&indices[i]).Add(&indices[j])
+			num.Mul(&indices[j])
+			den.Mul(&diff)
+		}
+		lambda := num.Mul(den.InverseNonConst())
+
+		var term, sum secp256k1.JacobianPoint
+		secp256k1.ScalarMultNonConst(lambda, &d, &term)
+		secp256k1.AddNonConst(&secret, &term, &sum)
+		secret.Set(&sum)
+	}
+
+	if isInfinity(&secret) {
+		return nil, ErrDecryption
+	}
+
+	aead, err := newAEAD(&secret, ephemeralKey)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, make([]byte, aead.NonceSize()), ciphertext, ephemeralKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDecryption, err)
+	}
+
+	return plaintext, nil
+}
+
+func newAEAD(secret *secp256k1.JacobianPoint, ephemeralKey []byte) (cipher.AEAD, error) {
+	h := sha256.New()
+	h.Write([]byte(keyDomain))
+	h.Write(serialize(secret))
+	h.Write(ephemeralKey)
+
+	block, err := aes.NewCipher(h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func proofChallenge(points ...[]byte) secp256k1.ModNScalar {
+	h := sha256.New()
+	h.Write([]byte(proofDomain))
+	for _, p := range points {
+		h.Write(p)
+	}
+
+	var c secp256k1.ModNScalar
+	c.SetByteSlice(h.Sum(nil))
+	return c
+}
+
+func randomScalar(rand io.Reader) (secp256k1.ModNScalar, error) {
+	k, err := secp256k1.GeneratePrivateKeyFromRand(rand)
+	if err != nil {
+		return secp256k1.ModNScalar{}, err
+	}
+
+	return k.Key, nil
+}
+
+func parsePoint(bz []byte) (secp256k1.JacobianPoint, error) {
+	var p secp256k1.JacobianPoint
+	if len(bz) != PointSize {
+		return p, fmt.Errorf("invalid point length, expected %d, got %d", PointSize, len(bz))
+	}
+
+	pub, err := secp256k1.ParsePubKey(bz)
+	if err != nil {
+		return p, err
+	}
+
+	pub.AsJacobian(&p)
+	return p, nil
+}
+
+func (s SecretShare) scalar() (secp256k1.ModNScalar, error) {
+	var x secp256k1.ModNScalar
+	if len(s.Secret) != ScalarSize || x.SetByteSlice(s.Secret) || x.IsZero() {
+		return x, errors.New("invalid secret share")
+	}
+
+	return x, nil
+}
+
+func baseMul(k *secp256k1.ModNScalar) []byte {
+	var p secp256k1.JacobianPoint
+	secp256k1.ScalarBaseMultNonConst(k, &p)
+	return serialize(&p)
+}
+
+func isInfinity(p *secp256k1.JacobianPoint) bool {
+	return (p.X.IsZero() && p.Y.IsZero()) || p.Z.IsZero()
+}
+
+// serialize returns the compressed encoding of the point, or a zero filled
+// slice for the point at infinity.
+func serialize(p *secp256k1.JacobianPoint) []byte {
+	if isInfinity(p) {
+		return bytes.Repeat([]byte{0}, PointSize)
+	}
+
+	affine := *p
+	affine.ToAffine()
+	return secp256k1.NewPublicKey(&affine.X, &affine.Y).SerializeCompressed()
+}
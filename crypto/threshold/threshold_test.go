@@ -0,0 +1,110 @@
+package threshold_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+)
+
+func TestGenerateKeyset(t *testing.T) {
+	_, err := threshold.GenerateKeyset(rand.Reader, 0, 3)
+	require.Error(t, err)
+
+	_, err = threshold.GenerateKeyset(rand.Reader, 4, 3)
+	require.Error(t, err)
+
+	ks, err := threshold.GenerateKeyset(rand.Reader, 2, 3)
+	require.NoError(t, err)
+	require.NoError(t, threshold.ValidatePoint(ks.PublicKey))
+	require.Len(t, ks.PublicShares, 3)
+	require.Len(t, ks.SecretShares, 3)
+	for i, share := range ks.SecretShares {
+		require.Equal(t, uint32(i+1), share.Index)
+		require.NoError(t, threshold.ValidatePoint(ks.PublicShares[i]))
+
+		publicShare, err := share.PublicShare()
+		require.NoError(t, err)
+		require.Equal(t, ks.PublicShares[i], publicShare)
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	ks, err := threshold.GenerateKeyset(rand.Reader, 3, 5)
+	require.NoError(t, err)
+
+	plaintext := []byte("swap 100uatom for uosmo")
+	ephemeralKey, ciphertext, err := threshold.Encrypt(rand.Reader, ks.PublicKey, plaintext)
+	require.NoError(t, err)
+	require.NotContains(t, string(ciphertext), string(plaintext))
+
+	shares := make([]threshold.DecryptionShare, len(ks.SecretShares))
+	for i, secret := range ks.SecretShares {
+		share, proof, err := secret.NewDecryptionShare(rand.Reader, ephemeralKey)
+		require.NoError(t, err)
+		require.NoError(t, threshold.VerifyDecryptionShare(ks.PublicShares[i], ephemeralKey, share.Share, proof))
+		shares[i] = share
+	}
+
+	// any subset of threshold shares decrypts
+	for _, subset := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}} {
+		var selected []threshold.DecryptionShare
+		for _, i := range subset {
+			selected = append(selected, shares[i])
+		}
+
+		decrypted, err := threshold.Decrypt(3, ephemeralKey, ciphertext, selected)
+		require.NoError(t, err)
+		require.Equal(t, plaintext, decrypted)
+	}
+
+	_, err = threshold.Decrypt(3, ephemeralKey, ciphertext, shares[:2])
+	require.ErrorIs(t, err, threshold.ErrInsufficientShares)
+
+	_, err = threshold.Decrypt(3, ephemeralKey, ciphertext, []threshold.DecryptionShare{shares[0], shares[0], shares[1]})
+	require.ErrorIs(t, err, threshold.ErrInvalidShare)
+
+	// a share for another ciphertext yields a wrong key
+	otherEphemeralKey, _, err := threshold.Encrypt(rand.Reader, ks.PublicKey, plaintext)
+	require.NoError(t, err)
+	otherShare, _, err := ks.SecretShares[2].NewDecryptionShare(rand.Reader, otherEphemeralKey)
+	require.NoError(t, err)
+	_, err = threshold.Decrypt(3, ephemeralKey, ciphertext, []threshold.DecryptionShare{shares[0], shares[1], otherShare})
+	require.ErrorIs(t, err, threshold.ErrDecryption)
+}
+
+func TestVerifyDecryptionShare(t *testing.T) {
+	ks, err := threshold.GenerateKeyset(rand.Reader, 2, 3)
+	require.NoError(t, err)
+
+	ephemeralKey, _, err := threshold.Encrypt(rand.Reader, ks.PublicKey, []byte("tx"))
+	require.NoError(t, err)
+
+	share, proof, err := ks.SecretShares[0].NewDecryptionShare(rand.Reader, ephemeralKey)
+	require.NoError(t, err)
+	require.NoError(t, threshold.VerifyDecryptionShare(ks.PublicShares[0], ephemeralKey, share.Share, proof))
+
+	// proof against the public share of another party
+	err = threshold.VerifyDecryptionShare(ks.PublicShares[1], ephemeralKey, share.Share, proof)
+	require.ErrorIs(t, err, threshold.ErrInvalidShare)
+
+	// share of another party with a valid looking proof
+	otherShare, _, err := ks.SecretShares[1].NewDecryptionShare(rand.Reader, ephemeralKey)
+	require.NoError(t, err)
+	err = threshold.VerifyDecryptionShare(ks.PublicShares[0], ephemeralKey, otherShare.Share, proof)
+	require.ErrorIs(t, err, threshold.ErrInvalidShare)
+
+	// tampered proof
+	tampered := append([]byte{}, proof...)
+	tampered[len(tampered)-1] ^= 1
+	err = threshold.VerifyDecryptionShare(ks.PublicShares[0], ephemeralKey, share.Share, tampered)
+	require.ErrorIs(t, err, threshold.ErrInvalidShare)
+
+	err = threshold.VerifyDecryptionShare(ks.PublicShares[0], ephemeralKey, share.Share, proof[:10])
+	require.ErrorIs(t, err, threshold.ErrInvalidShare)
+
+	err = threshold.VerifyDecryptionShare(ks.PublicShares[0], ephemeralKey, []byte("invalid"), proof)
+	require.ErrorIs(t, err, threshold.ErrInvalidShare)
+}
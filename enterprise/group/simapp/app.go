@@ -202,6 +202,7 @@ func NewSimApp(
 		stakingtypes.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
 		stakingtypes.KeyRotationFeePoolName: {authtypes.Burner},
+		stakingtypes.TokenizeSharesPoolName: {authtypes.Minter, authtypes.Burner},
 	}
 
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
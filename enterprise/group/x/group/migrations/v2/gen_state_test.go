@@ -20,6 +20,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/enterprise/group/x/group"
 	v3 "github.com/cosmos/cosmos-sdk/enterprise/group/x/group/migrations/v2"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -86,10 +87,10 @@ func TestMigrateGenState(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			require.Error(t, authtypes.ValidateGenesis(*tc.oldState))
+			require.Error(t, authtypes.ValidateGenesis(*tc.oldState, addresscodec.NewBech32Codec("cosmos")))
 			actualState := v3.MigrateGenState(tc.oldState)
 			require.Equal(t, tc.newState, actualState)
-			require.NoError(t, authtypes.ValidateGenesis(*actualState))
+			require.NoError(t, authtypes.ValidateGenesis(*actualState, addresscodec.NewBech32Codec("cosmos")))
 		})
 	}
 }
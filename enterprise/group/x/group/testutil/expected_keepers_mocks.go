@@ -147,6 +147,96 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// Burn mocks base method.
+func (m *MockBankKeeper) Burn(arg0 context.Context, arg1 *types0.MsgBurn) (*types0.MsgBurnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Burn", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgBurnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Burn indicates an expected call of Burn.
+func (mr *MockBankKeeperMockRecorder) Burn(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Burn", reflect.TypeOf((*MockBankKeeper)(nil).Burn), arg0, arg1)
+}
+
+// CancelScheduledSend mocks base method.
+func (m *MockBankKeeper) CancelScheduledSend(arg0 context.Context, arg1 *types0.MsgCancelScheduledSend) (*types0.MsgCancelScheduledSendResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledSend", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgCancelScheduledSendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelScheduledSend indicates an expected call of CancelScheduledSend.
+func (mr *MockBankKeeperMockRecorder) CancelScheduledSend(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledSend", reflect.TypeOf((*MockBankKeeper)(nil).CancelScheduledSend), arg0, arg1)
+}
+
+// ChangeAdmin mocks base method.
+func (m *MockBankKeeper) ChangeAdmin(arg0 context.Context, arg1 *types0.MsgChangeAdmin) (*types0.MsgChangeAdminResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeAdmin", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgChangeAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeAdmin indicates an expected call of ChangeAdmin.
+func (mr *MockBankKeeperMockRecorder) ChangeAdmin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeAdmin", reflect.TypeOf((*MockBankKeeper)(nil).ChangeAdmin), arg0, arg1)
+}
+
+// Clawback mocks base method.
+func (m *MockBankKeeper) Clawback(arg0 context.Context, arg1 *types0.MsgClawback) (*types0.MsgClawbackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clawback", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgClawbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Clawback indicates an expected call of Clawback.
+func (mr *MockBankKeeperMockRecorder) Clawback(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clawback", reflect.TypeOf((*MockBankKeeper)(nil).Clawback), arg0, arg1)
+}
+
+// CreateDenom mocks base method.
+func (m *MockBankKeeper) CreateDenom(arg0 context.Context, arg1 *types0.MsgCreateDenom) (*types0.MsgCreateDenomResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDenom", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgCreateDenomResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDenom indicates an expected call of CreateDenom.
+func (mr *MockBankKeeperMockRecorder) CreateDenom(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDenom", reflect.TypeOf((*MockBankKeeper)(nil).CreateDenom), arg0, arg1)
+}
+
+// Freeze mocks base method.
+func (m *MockBankKeeper) Freeze(arg0 context.Context, arg1 *types0.MsgFreeze) (*types0.MsgFreezeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Freeze", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgFreezeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Freeze indicates an expected call of Freeze.
+func (mr *MockBankKeeperMockRecorder) Freeze(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Freeze", reflect.TypeOf((*MockBankKeeper)(nil).Freeze), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -161,6 +251,21 @@ func (mr *MockBankKeeperMockRecorder) GetAllBalances(ctx, addr any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalances", reflect.TypeOf((*MockBankKeeper)(nil).GetAllBalances), ctx, addr)
 }
 
+// Mint mocks base method.
+func (m *MockBankKeeper) Mint(arg0 context.Context, arg1 *types0.MsgMint) (*types0.MsgMintResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mint", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgMintResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Mint indicates an expected call of Mint.
+func (mr *MockBankKeeperMockRecorder) Mint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mint", reflect.TypeOf((*MockBankKeeper)(nil).Mint), arg0, arg1)
+}
+
 // MintCoins mocks base method.
 func (m *MockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -190,6 +295,21 @@ func (mr *MockBankKeeperMockRecorder) MultiSend(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSend", reflect.TypeOf((*MockBankKeeper)(nil).MultiSend), arg0, arg1)
 }
 
+// ScheduleSend mocks base method.
+func (m *MockBankKeeper) ScheduleSend(arg0 context.Context, arg1 *types0.MsgScheduleSend) (*types0.MsgScheduleSendResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleSend", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgScheduleSendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleSend indicates an expected call of ScheduleSend.
+func (mr *MockBankKeeperMockRecorder) ScheduleSend(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleSend", reflect.TypeOf((*MockBankKeeper)(nil).ScheduleSend), arg0, arg1)
+}
+
 // Send mocks base method.
 func (m *MockBankKeeper) Send(arg0 context.Context, arg1 *types0.MsgSend) (*types0.MsgSendResponse, error) {
 	m.ctrl.T.Helper()
@@ -219,6 +339,66 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SetAllowlisted mocks base method.
+func (m *MockBankKeeper) SetAllowlisted(arg0 context.Context, arg1 *types0.MsgSetAllowlisted) (*types0.MsgSetAllowlistedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAllowlisted", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetAllowlistedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAllowlisted indicates an expected call of SetAllowlisted.
+func (mr *MockBankKeeperMockRecorder) SetAllowlisted(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllowlisted", reflect.TypeOf((*MockBankKeeper)(nil).SetAllowlisted), arg0, arg1)
+}
+
+// SetDenomCompliance mocks base method.
+func (m *MockBankKeeper) SetDenomCompliance(arg0 context.Context, arg1 *types0.MsgSetDenomCompliance) (*types0.MsgSetDenomComplianceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomCompliance", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetDenomComplianceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomCompliance indicates an expected call of SetDenomCompliance.
+func (mr *MockBankKeeperMockRecorder) SetDenomCompliance(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomCompliance", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomCompliance), arg0, arg1)
+}
+
+// SetDenomMetadata mocks base method.
+func (m *MockBankKeeper) SetDenomMetadata(arg0 context.Context, arg1 *types0.MsgSetDenomMetadata) (*types0.MsgSetDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomMetadata indicates an expected call of SetDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) SetDenomMetadata(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetadata), arg0, arg1)
+}
+
+// SetDenomSendHook mocks base method.
+func (m *MockBankKeeper) SetDenomSendHook(arg0 context.Context, arg1 *types0.MsgSetDenomSendHook) (*types0.MsgSetDenomSendHookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomSendHook", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetDenomSendHookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomSendHook indicates an expected call of SetDenomSendHook.
+func (mr *MockBankKeeperMockRecorder) SetDenomSendHook(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomSendHook", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomSendHook), arg0, arg1)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(arg0 context.Context, arg1 *types0.MsgSetSendEnabled) (*types0.MsgSetSendEnabledResponse, error) {
 	m.ctrl.T.Helper()
@@ -248,6 +428,21 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// Unfreeze mocks base method.
+func (m *MockBankKeeper) Unfreeze(arg0 context.Context, arg1 *types0.MsgUnfreeze) (*types0.MsgUnfreezeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfreeze", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgUnfreezeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unfreeze indicates an expected call of Unfreeze.
+func (mr *MockBankKeeperMockRecorder) Unfreeze(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfreeze", reflect.TypeOf((*MockBankKeeper)(nil).Unfreeze), arg0, arg1)
+}
+
 // UpdateParams mocks base method.
 func (m *MockBankKeeper) UpdateParams(arg0 context.Context, arg1 *types0.MsgUpdateParams) (*types0.MsgUpdateParamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +226,7 @@ func NewSimApp(
 			stakingtypes.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
 			stakingtypes.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
 			stakingtypes.KeyRotationFeePoolName: {authtypes.Burner},
+			stakingtypes.TokenizeSharesPoolName: {authtypes.Minter, authtypes.Burner},
 		},
 		authcodec.NewBech32Codec(sdk.Bech32MainPrefix),
 		sdk.Bech32MainPrefix,
@@ -277,6 +277,7 @@ require (
 )
 
 replace (
+	cosmossdk.io/api => ../../../../api
 	github.com/cosmos/cosmos-sdk => ../../../../
 	github.com/cosmos/cosmos-sdk/enterprise/poa => ../../
 	github.com/cosmos/cosmos-sdk/tools/systemtests => ../../../../tools/systemtests
@@ -209,7 +209,11 @@ func (a *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (a *App) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(a.GRPCQueryRouter(), clientCtx, a.Simulate, a.interfaceRegistry)
+	var opts []authtx.TxServerOption
+	if a.GasProfiling() {
+		opts = append(opts, authtx.WithGasProfileSimulator(a.SimulateWithGasProfile))
+	}
+	authtx.RegisterTxService(a.GRPCQueryRouter(), clientCtx, a.Simulate, a.interfaceRegistry, opts...)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
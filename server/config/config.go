@@ -63,6 +63,11 @@ type BaseConfig struct {
 	// for any node that serves untrusted clients.
 	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
 
+	// GasProfiling enables the gas profiling of simulated transactions through
+	// the Simulate gas_profile field. It is meant for debugging and must stay
+	// disabled on nodes serving untrusted clients.
+	GasProfiling bool `mapstructure:"gas-profiling"`
+
 	// BlockExecutor selects the block transaction execution strategy.
 	BlockExecutor string `mapstructure:"block-executor"`
 
@@ -273,6 +278,7 @@ func DefaultConfig() *Config {
 		BaseConfig: BaseConfig{
 			MinGasPrices:        defaultMinGasPrices,
 			QueryGasLimit:       0,
+			GasProfiling:        false,
 			BlockExecutor:       DefaultBlockExecutor,
 			BlockSTMWorkers:     DefaultBlockSTMWorkers,
 			BlockSTMPreEstimate: DefaultBlockSTMPreEstimate,
@@ -28,6 +28,10 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # e.g. query-gas-limit = "50000000", for any node that serves untrusted clients.
 query-gas-limit = "{{ .BaseConfig.QueryGasLimit }}"
 
+# GasProfiling enables the gas profiling of simulated transactions requesting
+# a gas profile. It is meant for debugging, keep it disabled on public nodes.
+gas-profiling = {{ .BaseConfig.GasProfiling }}
+
 # BlockExecutor sets block execution mode: "block-stm" or "sequential".
 block-executor = "{{ .BaseConfig.BlockExecutor }}"
 
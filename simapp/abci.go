@@ -17,6 +17,10 @@ type (
 	//
 	// NOTE: This implementation is solely used for testing purposes. DO NOT use
 	// in a production application!
+	//
+	// SimApp sets the vote extension handlers of a voteext.Manager, releasing
+	// the decryption shares of x/encryptedtx, instead. This handler remains an
+	// example of standalone vote extension handlers set with SetHandlers.
 	VoteExtensionHandler struct{}
 
 	// VoteExtension defines the structure used to create a dummy vote extension.
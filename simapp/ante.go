@@ -0,0 +1,59 @@
+package simapp
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	encryptedtxkeeper "github.com/cosmos/cosmos-sdk/x/encryptedtx/keeper"
+)
+
+// HandlerOptions are the options required for constructing the SimApp
+// AnteHandler.
+type HandlerOptions struct {
+	ante.HandlerOptions
+	EncryptedTxKeeper *encryptedtxkeeper.Keeper
+}
+
+// NewAnteHandler returns an AnteHandler that checks and increments sequence
+// numbers, checks signatures & account numbers, deducts fees from the first
+// signer and rejects the encrypted transaction envelopes which cannot be
+// stored.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, errors.New("account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, errors.New("bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, errors.New("sign mode handler is required for ante builder")
+	}
+
+	if options.EncryptedTxKeeper == nil {
+		return nil, errors.New("encrypted tx keeper is required for ante builder")
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewMsgFeeDecorator(options.MsgFeeKeeper), // MsgFeeDecorator must be called before DeductFeeDecorator
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		ante.NewAuthenticatorDecorator(options.AccountKeeper, options.AuthenticatorKeeper, options.SignModeHandler, options.SigGasConsumer), // AuthenticatorDecorator must be called before SetPubKeyDecorator
+		ante.NewSessionKeyDecorator(options.AccountKeeper, options.AuthzKeeper, options.FeegrantKeeper, options.SignModeHandler, options.SigGasConsumer),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigVerifyOptions...),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		encryptedtxkeeper.NewEnvelopeDecorator(*options.EncryptedTxKeeper), // EnvelopeDecorator must be called after the fees are deducted and the signatures verified
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"path/filepath"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
@@ -16,9 +17,11 @@ import (
 	clienthelpers "cosmossdk.io/client/v2/helpers"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log/v2"
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/baseapp/blockexec"
+	"github.com/cosmos/cosmos-sdk/baseapp/voteext"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
@@ -40,6 +43,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
@@ -52,6 +56,7 @@ import (
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankindex "github.com/cosmos/cosmos-sdk/x/bank/index"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/consensus"
@@ -60,6 +65,9 @@ import (
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx"
+	encryptedtxkeeper "github.com/cosmos/cosmos-sdk/x/encryptedtx/keeper"
+	encryptedtxtypes "github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
 	"github.com/cosmos/cosmos-sdk/x/epochs"
 	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
 	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
@@ -97,6 +105,10 @@ var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
 
+	// FeeRefundRatio is the ratio of the fee refunded for the unused gas of
+	// successful transactions by the post handler
+	FeeRefundRatio = sdkmath.LegacyNewDecWithPrec(5, 1)
+
 	// module account permissions
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName:          nil,
@@ -105,6 +117,7 @@ var (
 		stakingtypes.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
 		stakingtypes.KeyRotationFeePoolName: {authtypes.Burner},
+		stakingtypes.TokenizeSharesPoolName: {authtypes.Minter, authtypes.Burner},
 		govtypes.ModuleName:                 {authtypes.Burner},
 	}
 )
@@ -125,7 +138,8 @@ type SimApp struct {
 	interfaceRegistry types.InterfaceRegistry
 
 	// keys to access the substores
-	keys map[string]*storetypes.KVStoreKey
+	keys  map[string]*storetypes.KVStoreKey
+	okeys map[string]*storetypes.ObjectStoreKey
 
 	// essential keepers
 	AccountKeeper         authkeeper.AccountKeeper
@@ -140,9 +154,10 @@ type SimApp struct {
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
 	// supplementary keepers
-	FeeGrantKeeper feegrantkeeper.Keeper
-	AuthzKeeper    authzkeeper.Keeper
-	EpochsKeeper   *epochskeeper.Keeper
+	FeeGrantKeeper    feegrantkeeper.Keeper
+	AuthzKeeper       authzkeeper.Keeper
+	EpochsKeeper      *epochskeeper.Keeper
+	EncryptedTxKeeper encryptedtxkeeper.Keeper
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -219,12 +234,7 @@ func NewSimApp(
 	// }
 	// baseAppOptions = append(baseAppOptions, prepareOpt)
 
-	// create and set dummy vote extension handler
-	voteExtOp := func(bApp *baseapp.BaseApp) {
-		voteExtHandler := NewVoteExtensionHandler()
-		voteExtHandler.SetHandlers(bApp)
-	}
-	baseAppOptions = append(baseAppOptions, voteExtOp, baseapp.SetOptimisticExecution())
+	baseAppOptions = append(baseAppOptions, baseapp.SetOptimisticExecution())
 
 	bApp := baseapp.NewBaseApp(appName, logger, db, txConfig.TxDecoder(), baseAppOptions...)
 	bApp.SetVersion(version.Version)
@@ -245,12 +255,17 @@ func NewSimApp(
 		evidencetypes.StoreKey,
 		authzkeeper.StoreKey,
 		epochstypes.StoreKey,
+		encryptedtxtypes.StoreKey,
 	)
+	okeys := storetypes.NewObjectStoreKeys(banktypes.ObjectStoreKey)
 
-	stores := make([]storetypes.StoreKey, 0, len(keys))
+	stores := make([]storetypes.StoreKey, 0, len(keys)+len(okeys))
 	for _, k := range keys {
 		stores = append(stores, k)
 	}
+	for _, k := range okeys {
+		stores = append(stores, k)
+	}
 	blockexec.Apply(bApp, appOpts, stores, txConfig.TxDecoder(),
 		func(storetypes.MultiStore) string { return sdk.DefaultBondDenom },
 	)
@@ -267,6 +282,7 @@ func NewSimApp(
 		txConfig:          txConfig,
 		interfaceRegistry: interfaceRegistry,
 		keys:              keys,
+		okeys:             okeys,
 	}
 
 	// set the BaseApp's parameter store
@@ -279,6 +295,10 @@ func NewSimApp(
 	bApp.SetParamStore(app.ConsensusParamsKeeper.ParamsStore)
 
 	// add keepers
+
+	// authenticatorManager holds the authenticator types accounts can register.
+	// Its types depending on other keepers are registered once they are built.
+	authenticatorManager := authenticator.NewManager()
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[authtypes.StoreKey]),
@@ -288,6 +308,7 @@ func NewSimApp(
 		sdk.Bech32MainPrefix,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		authkeeper.WithUnorderedTransactions(true),
+		authkeeper.WithAuthenticatorManager(authenticatorManager),
 	)
 
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
@@ -298,6 +319,35 @@ func NewSimApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		logger,
 	)
+	// the fees are credited to the fee collector at the end of the block, so
+	// that the transactions paying fees don't conflict on its balance
+	app.BankKeeper = app.BankKeeper.
+		WithObjStoreKey(okeys[banktypes.ObjectStoreKey]).
+		WithVirtualRecipients(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+
+	// enable the off-consensus balance index serving the historical balance queries
+	if cast.ToBool(appOpts.Get(bankindex.FlagBalanceIndex)) {
+		dataDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data")
+		indexDB, err := dbm.NewDB("bank_balance_index", server.GetAppDBBackend(appOpts), dataDir)
+		if err != nil {
+			panic(err)
+		}
+
+		balanceIndex := bankindex.NewBalanceIndex(indexDB, app.BankKeeper, app.AccountKeeper.AddressCodec())
+		app.BankKeeper = app.BankKeeper.WithBalanceIndex(balanceIndex)
+
+		streamingManager := bApp.StreamingManager()
+		streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, balanceIndex)
+		bApp.SetStreamingManager(streamingManager)
+	}
+
+	authenticatorManager.Register(
+		authenticator.NewSignatureVerification(appCodec),
+		authenticator.NewMessageFilter(),
+		authenticator.NewSpendLimit(appCodec, app.BankKeeper, app.AccountKeeper),
+		authenticator.NewAllOf(authenticatorManager),
+		authenticator.NewAnyOf(authenticatorManager),
+	)
 
 	txConfig, err := authtx.NewTxConfigWithOptions(
 		appCodec,
@@ -366,7 +416,7 @@ func NewSimApp(
 		appCodec,
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
-	)
+	).SetFeegrantKeeper(app.FeeGrantKeeper)
 
 	// get skipUpgradeHeights from the app options
 	skipUpgradeHeights := map[int64]bool{}
@@ -404,7 +454,7 @@ func NewSimApp(
 		app.MsgServiceRouter(),
 		govConfig,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-		govkeeper.NewDefaultCalculateVoteResultsAndVotingPower(app.StakingKeeper),
+		govkeeper.NewGovernorCalculateVoteResultsAndVotingPower(app.StakingKeeper),
 	)
 
 	// Set legacy router for backwards compatibility with gov v1beta1
@@ -441,6 +491,36 @@ func NewSimApp(
 		),
 	)
 
+	app.EncryptedTxKeeper = encryptedtxkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[encryptedtxtypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// set the vote extension and proposal handlers through which validators
+	// release the decryption shares of the encrypted transactions, see
+	// VoteExtensionHandler for an example of standalone vote extension handlers
+	secretShare, err := encryptedtxkeeper.LoadSecretShare(cast.ToString(appOpts.Get(encryptedtxkeeper.FlagSecretShareFile)))
+	if err != nil {
+		panic(err)
+	}
+
+	voteExtManager, err := voteext.NewManager(
+		app.StakingKeeper,
+		encryptedtxkeeper.NewVoteExtensionHandler(app.EncryptedTxKeeper, secretShare),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	encryptedTxProposalHandler := encryptedtxkeeper.NewProposalHandler(app.EncryptedTxKeeper)
+	defaultProposalHandler := baseapp.NewDefaultProposalHandler(bApp.Mempool(), bApp)
+
+	bApp.SetExtendVoteHandler(voteExtManager.ExtendVoteHandler())
+	bApp.SetVerifyVoteExtensionHandler(voteExtManager.VerifyVoteExtensionHandler())
+	bApp.SetPrepareProposal(voteExtManager.PrepareProposalHandler(encryptedTxProposalHandler.PrepareProposalHandler(defaultProposalHandler.PrepareProposalHandler())))
+	bApp.SetProcessProposal(voteExtManager.ProcessProposalHandler(encryptedTxProposalHandler.ProcessProposalHandler(defaultProposalHandler.ProcessProposalHandler())))
+
 	/****  Module Options ****/
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -464,6 +544,7 @@ func NewSimApp(
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
 		epochs.NewAppModule(app.EpochsKeeper),
+		encryptedtx.NewAppModule(app.EncryptedTxKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -499,6 +580,7 @@ func NewSimApp(
 		genutiltypes.ModuleName,
 		authz.ModuleName,
 		epochstypes.ModuleName,
+		encryptedtxtypes.ModuleName,
 	)
 	app.ModuleManager.SetOrderEndBlockers(
 		banktypes.ModuleName,
@@ -527,6 +609,7 @@ func NewSimApp(
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		epochstypes.ModuleName,
+		encryptedtxtypes.ModuleName,
 	}
 
 	exportModuleOrder := []string{
@@ -545,6 +628,7 @@ func NewSimApp(
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		epochstypes.ModuleName,
+		encryptedtxtypes.ModuleName,
 	}
 
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
@@ -587,6 +671,7 @@ func NewSimApp(
 
 	// initialize stores
 	app.MountKVStores(keys)
+	app.MountObjectStores(okeys)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
@@ -620,18 +705,24 @@ func NewSimApp(
 }
 
 func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   app.AccountKeeper,
-			BankKeeper:      app.BankKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			FeegrantKeeper:  app.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
-			SigVerifyOptions: []ante.SigVerificationDecoratorOption{
-				// change below as needed.
-				ante.WithUnorderedTxGasCost(ante.DefaultUnorderedTxGasCost),
-				ante.WithMaxUnorderedTxTimeoutDuration(ante.DefaultMaxTimeoutDuration),
+	anteHandler, err := NewAnteHandler(
+		HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:       app.AccountKeeper,
+				AuthenticatorKeeper: app.AccountKeeper,
+				AuthzKeeper:         app.AuthzKeeper,
+				BankKeeper:          app.BankKeeper,
+				SignModeHandler:     txConfig.SignModeHandler(),
+				FeegrantKeeper:      app.FeeGrantKeeper,
+				MsgFeeKeeper:        app.AccountKeeper,
+				SigGasConsumer:      ante.DefaultSigVerificationGasConsumer,
+				SigVerifyOptions: []ante.SigVerificationDecoratorOption{
+					// change below as needed.
+					ante.WithUnorderedTxGasCost(ante.DefaultUnorderedTxGasCost),
+					ante.WithMaxUnorderedTxTimeoutDuration(ante.DefaultMaxTimeoutDuration),
+				},
 			},
+			EncryptedTxKeeper: &app.EncryptedTxKeeper,
 		},
 	)
 	if err != nil {
@@ -644,7 +735,11 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 
 func (app *SimApp) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+		posthandler.HandlerOptions{
+			AuthenticatorKeeper: app.AccountKeeper,
+			BankKeeper:          app.BankKeeper,
+			RefundRatio:         FeeRefundRatio,
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -791,7 +886,11 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *SimApp) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.interfaceRegistry)
+	var opts []authtx.TxServerOption
+	if app.GasProfiling() {
+		opts = append(opts, authtx.WithGasProfileSimulator(app.SimulateWithGasProfile))
+	}
+	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.interfaceRegistry, opts...)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -3,6 +3,7 @@ package simapp
 import (
 	"bytes"
 	"encoding/json"
+	"math/rand"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log/v2"
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -31,15 +33,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx"
+	encryptedtxtypes "github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
 	"github.com/cosmos/cosmos-sdk/x/epochs"
 	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
@@ -401,20 +407,21 @@ func TestRunMigrations(t *testing.T) {
 			_, err = app.ModuleManager.RunMigrations(
 				app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()}), configurator,
 				module.VersionMap{
-					banktypes.ModuleName:     1,
-					authtypes.ModuleName:     auth.AppModule{}.ConsensusVersion(),
-					authz.ModuleName:         authzmodule.AppModule{}.ConsensusVersion(),
-					stakingtypes.ModuleName:  staking.AppModule{}.ConsensusVersion(),
-					minttypes.ModuleName:     mint.AppModule{}.ConsensusVersion(),
-					distrtypes.ModuleName:    distribution.AppModule{}.ConsensusVersion(),
-					slashingtypes.ModuleName: slashing.AppModule{}.ConsensusVersion(),
-					govtypes.ModuleName:      gov.AppModule{}.ConsensusVersion(),
-					upgradetypes.ModuleName:  upgrade.AppModule{}.ConsensusVersion(),
-					vestingtypes.ModuleName:  vesting.AppModule{}.ConsensusVersion(),
-					feegrant.ModuleName:      feegrantmodule.AppModule{}.ConsensusVersion(),
-					evidencetypes.ModuleName: evidence.AppModule{}.ConsensusVersion(),
-					genutiltypes.ModuleName:  genutil.AppModule{}.ConsensusVersion(),
-					epochstypes.ModuleName:   epochs.AppModule{}.ConsensusVersion(),
+					banktypes.ModuleName:        1,
+					authtypes.ModuleName:        auth.AppModule{}.ConsensusVersion(),
+					authz.ModuleName:            authzmodule.AppModule{}.ConsensusVersion(),
+					stakingtypes.ModuleName:     staking.AppModule{}.ConsensusVersion(),
+					minttypes.ModuleName:        mint.AppModule{}.ConsensusVersion(),
+					distrtypes.ModuleName:       distribution.AppModule{}.ConsensusVersion(),
+					slashingtypes.ModuleName:    slashing.AppModule{}.ConsensusVersion(),
+					govtypes.ModuleName:         gov.AppModule{}.ConsensusVersion(),
+					upgradetypes.ModuleName:     upgrade.AppModule{}.ConsensusVersion(),
+					vestingtypes.ModuleName:     vesting.AppModule{}.ConsensusVersion(),
+					feegrant.ModuleName:         feegrantmodule.AppModule{}.ConsensusVersion(),
+					evidencetypes.ModuleName:    evidence.AppModule{}.ConsensusVersion(),
+					genutiltypes.ModuleName:     genutil.AppModule{}.ConsensusVersion(),
+					epochstypes.ModuleName:      epochs.AppModule{}.ConsensusVersion(),
+					encryptedtxtypes.ModuleName: encryptedtx.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -560,3 +567,171 @@ func TestAddressCodecFactory(t *testing.T) {
 	_, ok = consAddressCodec.(customAddressCodec)
 	require.True(t, ok)
 }
+
+func TestFeeCollectorVirtualRecipient(t *testing.T) {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(senderPrivKey.PubKey().Address())
+	acc := authtypes.NewBaseAccount(sender, senderPrivKey.PubKey(), 0, 0)
+	initial := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000000000)))
+	app := SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, banktypes.Balance{
+		Address: sender.String(),
+		Coins:   initial,
+	})
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.True(t, app.BankKeeper.IsVirtualRecipient(feeCollector))
+
+	ctx := app.NewContext(true)
+	senderAcc := app.AccountKeeper.GetAccount(ctx, sender)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	sent := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)),
+		app.TxConfig(),
+		[]sdk.Msg{banktypes.NewMsgSend(sender, sdk.AccAddress("recipient___________"), sent)},
+		fees,
+		simtestutil.DefaultGenTxGas,
+		"",
+		[]uint64{senderAcc.GetAccountNumber()},
+		[]uint64{senderAcc.GetSequence()},
+		senderPrivKey,
+	)
+	require.NoError(t, err)
+	txBytes, err := app.TxConfig().TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             app.LastBlockHeight() + 1,
+		Hash:               app.LastCommitID().Hash,
+		NextValidatorsHash: valSet.Hash(),
+		Txs:                [][]byte{txBytes},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 1)
+	require.Equal(t, uint32(0), res.TxResults[0].Code, res.TxResults[0].Log)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	// the fee collector also receives the coins minted in the block
+	minted := sdk.NewCoins()
+	for _, event := range res.Events {
+		if event.Type != minttypes.EventTypeMint {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == sdk.AttributeKeyAmount {
+				amount, ok := sdkmath.NewIntFromString(attr.Value)
+				require.True(t, ok)
+				minted = minted.Add(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+			}
+		}
+	}
+
+	refund := txFeeRefund(t, res.TxResults[0])
+
+	ctx = app.NewContext(true)
+	require.Equal(t, initial.Sub(fees...).Add(refund...).Sub(sent...), app.BankKeeper.GetAllBalances(ctx, sender))
+	require.Equal(t, minted.Add(fees...).Sub(refund...), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	require.True(t, app.BankKeeper.GetTotalVirtualCoins(ctx).IsZero())
+	msg, broken := bankkeeper.TotalSupply(app.BankKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+// txFeeRefund returns the fee refunded by the post handler to a transaction.
+func txFeeRefund(t *testing.T, res *abci.ExecTxResult) sdk.Coins {
+	t.Helper()
+
+	for _, event := range res.Events {
+		if event.Type != sdk.EventTypeTx {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == sdk.AttributeKeyFeeRefund {
+				refund, err := sdk.ParseCoinsNormalized(attr.Value)
+				require.NoError(t, err)
+				return refund
+			}
+		}
+	}
+
+	return sdk.NewCoins()
+}
+
+func TestFeeRefund(t *testing.T) {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(senderPrivKey.PubKey().Address())
+	acc := authtypes.NewBaseAccount(sender, senderPrivKey.PubKey(), 0, 0)
+	initial := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000000000)))
+	app := SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, banktypes.Balance{
+		Address: sender.String(),
+		Coins:   initial,
+	})
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+	sent := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	// the first transaction fails, and is not refunded
+	msgs := [][]sdk.Msg{
+		{banktypes.NewMsgSend(sender, sdk.AccAddress("recipient___________"), initial)},
+		{banktypes.NewMsgSend(sender, sdk.AccAddress("recipient___________"), sent)},
+	}
+	txs := make([][]byte, len(msgs))
+	for i := range msgs {
+		tx, err := simtestutil.GenSignedMockTx(
+			rand.New(rand.NewSource(1)),
+			app.TxConfig(),
+			msgs[i],
+			fees,
+			simtestutil.DefaultGenTxGas,
+			"",
+			[]uint64{acc.GetAccountNumber()},
+			[]uint64{uint64(i)},
+			senderPrivKey,
+		)
+		require.NoError(t, err)
+		txs[i], err = app.TxConfig().TxEncoder()(tx)
+		require.NoError(t, err)
+	}
+
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             app.LastBlockHeight() + 1,
+		Hash:               app.LastCommitID().Hash,
+		NextValidatorsHash: valSet.Hash(),
+		Txs:                txs,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 2)
+	require.NotEqual(t, uint32(0), res.TxResults[0].Code)
+	require.Equal(t, uint32(0), res.TxResults[1].Code, res.TxResults[1].Log)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	require.True(t, txFeeRefund(t, res.TxResults[0]).IsZero())
+	refund := txFeeRefund(t, res.TxResults[1])
+	require.False(t, refund.IsZero())
+	// the refund is computed before the end of the transaction execution, so
+	// it is at least the refund for the gas reported as used
+	minRefund := posthandler.ComputeRefund(fees, nil, uint64(res.TxResults[1].GasWanted), uint64(res.TxResults[1].GasUsed), FeeRefundRatio)
+	require.True(t, refund.IsAllGTE(minRefund), "refund %s is lower than %s", refund, minRefund)
+	maxRefund := posthandler.ComputeRefund(fees, nil, uint64(res.TxResults[1].GasWanted), 0, FeeRefundRatio)
+	require.True(t, maxRefund.IsAllGTE(refund), "refund %s is higher than %s", refund, maxRefund)
+
+	ctx := app.NewContext(true)
+	require.Equal(t, initial.Sub(fees...).Sub(fees...).Add(refund...).Sub(sent...), app.BankKeeper.GetAllBalances(ctx, sender))
+	require.True(t, app.BankKeeper.GetTotalVirtualCoins(ctx).IsZero())
+	msg, broken := bankkeeper.TotalSupply(app.BankKeeper)(ctx)
+	require.False(t, broken, msg)
+}
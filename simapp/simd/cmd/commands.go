@@ -53,10 +53,22 @@ func initAppConfig() (string, interface{}) {
 		CustomField string `mapstructure:"custom-field"`
 	}
 
+	// BankConfig defines the node-local config of x/bank.
+	type BankConfig struct {
+		BalanceIndex bool `mapstructure:"balance-index"`
+	}
+
+	// EncryptedTxConfig defines the node-local config of x/encryptedtx.
+	type EncryptedTxConfig struct {
+		SecretShareFile string `mapstructure:"secret-share-file"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
 
-		Custom CustomConfig `mapstructure:"custom"`
+		Custom      CustomConfig      `mapstructure:"custom"`
+		Bank        BankConfig        `mapstructure:"bank"`
+		EncryptedTx EncryptedTxConfig `mapstructure:"encryptedtx"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -92,7 +104,19 @@ func initAppConfig() (string, interface{}) {
 [custom]
 # That field will be parsed by server.InterceptConfigsPreRunHandler and held by viper.
 # Do not forget to add quotes around the value if it is a string.
-custom-field = "{{ .Custom.CustomField }}"`
+custom-field = "{{ .Custom.CustomField }}"
+
+[bank]
+# Enable the off-consensus balance index, which records the balance changes of
+# every account from the first block executed after it is enabled, serving the
+# BalanceAtHeight and BalanceHistory queries.
+balance-index = {{ .Bank.BalanceIndex }}
+
+[encryptedtx]
+# Path of the JSON file holding the threshold decryption key share of the
+# validator, used to release the decryption shares of encrypted transactions in
+# vote extensions. Leave empty on nodes which do not hold a share.
+secret-share-file = "{{ .EncryptedTx.SecretShareFile }}"`
 
 	return customAppTemplate, customAppConfig
 }
@@ -22,7 +22,7 @@ require (
 	github.com/tendermint/go-amino v0.16.0
 	go.uber.org/mock v0.6.0
 	google.golang.org/grpc v1.83.0
-	google.golang.org/protobuf v1.36.11
+	google.golang.org/protobuf v1.36.12
 	gotest.tools/v3 v3.5.2
 	pgregory.net/rapid v1.3.0
 )
@@ -337,6 +337,8 @@ replace github.com/cosmos/cosmos-sdk/enterprise/group => ../enterprise/group
 
 // Below are the long-lived replace for tests.
 replace (
+	// and of the api generated from its protos
+	cosmossdk.io/api => ../api
 	// We always want to test against the latest version of the simapp.
 	cosmossdk.io/simapp => ../simapp
 
@@ -332,7 +332,7 @@ func TestGRPCQueryParams(t *testing.T) {
 	require.NoError(t, f.bankKeeper.SetParams(f.ctx, params))
 
 	req := &banktypes.QueryParamsRequest{}
-	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Params, 1003, false)
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Params, 1009, false)
 }
 
 func createAndReturnMetadatas(t *rapid.T, count int) []banktypes.Metadata {
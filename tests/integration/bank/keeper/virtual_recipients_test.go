@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+	"cosmossdk.io/math"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/store/v2"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// TestFeeRefundWithVirtualFeeCollector checks that the fee refunded by the
+// post handler is sent from the fee credited to a virtual fee collector in the
+// same transaction, before the credits are added to its balance at the end of
+// the block.
+func TestFeeRefundWithVirtualFeeCollector(t *testing.T) {
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey)
+	okey := storetypes.NewObjectStoreKey(banktypes.ObjectStoreKey)
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{})
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db, log.NewNopLogger())
+	for _, key := range keys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	}
+	cms.MountStoreWithDB(okey, storetypes.StoreTypeObject, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, cmtproto.Header{Height: 1}, false, log.NewNopLogger())
+
+	authority := authtypes.NewModuleAddress("gov")
+	accountKeeper := authkeeper.NewAccountKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[authtypes.StoreKey]),
+		authtypes.ProtoBaseAccount,
+		map[string][]string{
+			authtypes.FeeCollectorName: nil,
+			minttypes.ModuleName:       {authtypes.Minter},
+		},
+		addresscodec.NewBech32Codec(sdk.Bech32MainPrefix),
+		sdk.Bech32MainPrefix,
+		authority.String(),
+	)
+	feeCollector := accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	bankKeeper := keeper.NewBaseKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[banktypes.StoreKey]),
+		accountKeeper,
+		map[string]bool{},
+		authority.String(),
+		log.NewNopLogger(),
+	).WithObjStoreKey(okey).WithVirtualRecipients(feeCollector)
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, nil, nil))
+	postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{
+		BankKeeper:  bankKeeper,
+		RefundRatio: math.LegacyOneDec(),
+	})
+	require.NoError(t, err)
+
+	_, _, payer := testdata.KeyTestPubAddr()
+	accountKeeper.SetAccount(ctx, accountKeeper.NewAccountWithAddress(ctx, payer))
+	require.NoError(t, banktestutil.FundAccount(ctx, bankKeeper, payer, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(payer)))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	txBuilder.SetGasLimit(100000)
+	tx := txBuilder.GetTx()
+
+	// every transaction of the block uses 40% of its gas and is refunded 60%
+	// of its fee from the fee it credited to the fee collector
+	for txIndex := range 2 {
+		txCtx := ctx.WithTxIndex(txIndex).WithGasMeter(storetypes.NewGasMeter(100000))
+		txCtx, err = anteHandler(txCtx, tx, false)
+		require.NoError(t, err)
+		txCtx.GasMeter().ConsumeGas(40000-txCtx.GasMeter().GasConsumed(), "test")
+		_, err = postHandler(txCtx, tx, false, true)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 920)), bankKeeper.GetAllBalances(ctx, payer))
+	require.True(t, bankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+
+	require.NoError(t, bankKeeper.CreditVirtualAccounts(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 80)), bankKeeper.GetAllBalances(ctx, feeCollector))
+}
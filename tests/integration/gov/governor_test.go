@@ -0,0 +1,78 @@
+package gov_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ProvideGovernorTally provides the governor tally function to the gov module.
+func ProvideGovernorTally(sk govtypes.StakingKeeper) govkeeper.CalculateVoteResultsAndVotingPowerFn {
+	return govkeeper.NewGovernorCalculateVoteResultsAndVotingPower(sk)
+}
+
+func TestGovernorVoteCarriesDelegatorsPower(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+		govKeeper     *govkeeper.Keeper
+	)
+	app, err := simtestutil.SetupAtGenesis(
+		depinject.Configs(
+			configurator.NewAppConfig(
+				configurator.AuthModule(),
+				configurator.StakingModule(),
+				configurator.BankModule(),
+				configurator.GovModule(),
+				configurator.DistributionModule(),
+				configurator.ConsensusModule(),
+			),
+			depinject.Supply(log.NewNopLogger()),
+			// the governor tally is opt-in
+			depinject.Provide(ProvideGovernorTally),
+		),
+		&bankKeeper, &stakingKeeper, &govKeeper,
+	)
+	assert.NilError(t, err)
+
+	ctx := app.NewContext(false)
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 2, stakingKeeper.TokensFromConsensusPower(ctx, 100))
+	governor, delegator := addrs[0], addrs[1]
+
+	validators, err := stakingKeeper.GetBondedValidatorsByPower(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, len(validators) > 0)
+	delegated := stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	_, err = stakingKeeper.Delegate(ctx, delegator, delegated, stakingtypes.Unbonded, validators[0], true)
+	assert.NilError(t, err)
+
+	assert.NilError(t, govKeeper.CreateGovernor(ctx, governor, ""))
+	assert.NilError(t, govKeeper.DelegateToGovernor(ctx, delegator, governor))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", governor, false)
+	assert.NilError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	assert.NilError(t, govKeeper.SetProposal(ctx, proposal))
+
+	// the governor has no stake of its own, its vote only carries the voting
+	// power of its delegator
+	assert.NilError(t, govKeeper.AddVote(ctx, proposal.Id, governor, v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	_, _, tallyResult, err := govKeeper.Tally(ctx, proposal)
+	assert.NilError(t, err)
+	assert.Equal(t, delegated.String(), tallyResult.NoCount)
+	assert.Equal(t, "0", tallyResult.YesCount)
+}
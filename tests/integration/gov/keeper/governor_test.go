@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestGovernorDelegations(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx, queryClient := f.ctx, f.queryClient
+	msgServer := keeper.NewMsgServerImpl(f.govKeeper)
+	addrs, _ := createValidators(t, f, []int64{5, 6, 7})
+	governor, delegator := addrs[3], addrs[4]
+
+	_, err := msgServer.DelegateGovernor(ctx, v1.NewMsgDelegateGovernor(delegator.String(), governor.String()))
+	assert.ErrorIs(t, err, types.ErrGovernorNotFound)
+
+	_, err = msgServer.CreateGovernor(ctx, v1.NewMsgCreateGovernor(governor.String(), "metadata"))
+	assert.NilError(t, err)
+	_, err = msgServer.CreateGovernor(ctx, v1.NewMsgCreateGovernor(governor.String(), ""))
+	assert.ErrorIs(t, err, types.ErrGovernorExists)
+
+	_, err = msgServer.DelegateGovernor(ctx, v1.NewMsgDelegateGovernor(delegator.String(), governor.String()))
+	assert.NilError(t, err)
+
+	// governors and delegators cannot switch roles
+	_, err = msgServer.CreateGovernor(ctx, v1.NewMsgCreateGovernor(delegator.String(), ""))
+	assert.ErrorIs(t, err, types.ErrGovernorDelegation)
+	_, err = msgServer.DelegateGovernor(ctx, v1.NewMsgDelegateGovernor(governor.String(), governor.String()))
+	assert.ErrorIs(t, err, types.ErrGovernorDelegation)
+
+	govRes, err := queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{GovernorAddress: governor.String()})
+	assert.NilError(t, err)
+	assert.Equal(t, "metadata", govRes.Governor.Metadata)
+
+	delRes, err := queryClient.GovernorDelegations(gocontext.Background(), &v1.QueryGovernorDelegationsRequest{GovernorAddress: governor.String()})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(delRes.Delegations))
+	assert.Equal(t, delegator.String(), delRes.Delegations[0].DelegatorAddress)
+
+	delGovRes, err := queryClient.DelegatorGovernor(gocontext.Background(), &v1.QueryDelegatorGovernorRequest{DelegatorAddress: delegator.String()})
+	assert.NilError(t, err)
+	assert.Equal(t, governor.String(), delGovRes.Governor.Address)
+
+	_, err = msgServer.UndelegateGovernor(ctx, v1.NewMsgUndelegateGovernor(delegator.String()))
+	assert.NilError(t, err)
+	_, err = msgServer.UndelegateGovernor(ctx, v1.NewMsgUndelegateGovernor(delegator.String()))
+	assert.ErrorIs(t, err, types.ErrNoGovernorDelegation)
+
+	delRes, err = queryClient.GovernorDelegations(gocontext.Background(), &v1.QueryGovernorDelegationsRequest{GovernorAddress: governor.String()})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(delRes.Delegations))
+
+	// undelegated accounts can become governors
+	_, err = msgServer.CreateGovernor(ctx, v1.NewMsgCreateGovernor(delegator.String(), ""))
+	assert.NilError(t, err)
+
+	govsRes, err := queryClient.Governors(gocontext.Background(), &v1.QueryGovernorsRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(govsRes.Governors))
+}
+
+func TestTallyGovernor(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.ctx
+	addrs, valAddrs := createValidators(t, f, []int64{5, 6, 7})
+
+	// two delegators with 30 each assign their voting power to a governor without stake
+	delegators := addrs[3:5]
+	governor := sdk.AccAddress("governor____________")
+	assert.NilError(t, f.govKeeper.CreateGovernor(ctx, governor, ""))
+
+	for i, delegator := range delegators {
+		val, err := f.stakingKeeper.GetValidator(ctx, valAddrs[i])
+		assert.NilError(t, err)
+		_, err = f.stakingKeeper.Delegate(ctx, delegator, f.stakingKeeper.TokensFromConsensusPower(ctx, 30), stakingtypes.Unbonded, val, true)
+		assert.NilError(t, err)
+		assert.NilError(t, f.govKeeper.DelegateToGovernor(ctx, delegator, governor))
+	}
+	_, err := f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	tallyFn := keeper.NewGovernorCalculateVoteResultsAndVotingPower(f.stakingKeeper)
+	power := func(consensusPower int64) math.LegacyDec {
+		return math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, consensusPower))
+	}
+
+	testCases := []struct {
+		name       string
+		votes      map[string]v1.VoteOption
+		expYes     math.LegacyDec
+		expNo      math.LegacyDec
+		expAbstain math.LegacyDec
+	}{
+		{
+			name: "governor vote applies to its delegators",
+			votes: map[string]v1.VoteOption{
+				addrs[0].String(): v1.OptionYes,
+				addrs[1].String(): v1.OptionYes,
+				addrs[2].String(): v1.OptionYes,
+				governor.String(): v1.OptionNo,
+			},
+			expYes:     power(18),
+			expNo:      power(60),
+			expAbstain: power(0),
+		},
+		{
+			name: "delegator vote overrides the governor vote",
+			votes: map[string]v1.VoteOption{
+				addrs[0].String():      v1.OptionYes,
+				addrs[1].String():      v1.OptionYes,
+				addrs[2].String():      v1.OptionYes,
+				governor.String():      v1.OptionNo,
+				delegators[1].String(): v1.OptionAbstain,
+			},
+			expYes:     power(18),
+			expNo:      power(30),
+			expAbstain: power(30),
+		},
+		{
+			name: "validators inherit the delegators of a governor which did not vote",
+			votes: map[string]v1.VoteOption{
+				addrs[0].String(): v1.OptionYes,
+				addrs[1].String(): v1.OptionNo,
+				addrs[2].String(): v1.OptionYes,
+			},
+			expYes:     power(42),
+			expNo:      power(36),
+			expAbstain: power(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal, err := f.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "description", addrs[0], false)
+			assert.NilError(t, err)
+			proposal.Status = v1.StatusVotingPeriod
+			assert.NilError(t, f.govKeeper.SetProposal(ctx, proposal))
+
+			for voter, option := range tc.votes {
+				voterAddr, err := sdk.AccAddressFromBech32(voter)
+				assert.NilError(t, err)
+				assert.NilError(t, f.govKeeper.AddVote(ctx, proposal.Id, voterAddr, v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			totalVoterPower, totalValPower, results, err := tallyFn(ctx, *f.govKeeper, proposal)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 78), totalValPower)
+			assert.DeepEqual(t, tc.expYes, results[v1.OptionYes])
+			assert.DeepEqual(t, tc.expNo, results[v1.OptionNo])
+			assert.DeepEqual(t, tc.expAbstain, results[v1.OptionAbstain])
+			assert.DeepEqual(t, tc.expYes.Add(tc.expNo).Add(tc.expAbstain), totalVoterPower)
+		})
+	}
+}
@@ -67,6 +67,7 @@ func initFixture(tb testing.TB) *fixture {
 		stakingtypes.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
 		stakingtypes.KeyRotationFeePoolName: {authtypes.Burner},
+		stakingtypes.TokenizeSharesPoolName: {authtypes.Minter, authtypes.Burner},
 		types.ModuleName:                    {authtypes.Burner},
 	}
 
@@ -149,6 +149,52 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	assert.Assert(t, tallyResults.Equals(v1.EmptyTallyResult()) == false)
 }
 
+func TestTallyMessageBasedParams(t *testing.T) {
+	t.Parallel()
+
+	f := initFixture(t)
+
+	ctx := f.ctx
+
+	valAccAddrs, _ := createValidators(t, f, []int64{5, 6, 0})
+
+	// proposals executing legacy content require a 2/3 majority and a longer voting period
+	params, err := f.govKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	votingPeriod := 2 * *params.VotingPeriod
+	params.MessageBasedParams = []v1.MessageBasedParams{{
+		MsgTypeUrl: sdk.MsgTypeURL(&v1.MsgExecLegacyContent{}),
+		Params: v1.ProposalParams{
+			MinDeposit:   params.MinDeposit,
+			VotingPeriod: &votingPeriod,
+			Quorum:       params.Quorum,
+			Threshold:    "0.667",
+		},
+	}}
+	assert.NilError(t, f.govKeeper.Params.Set(ctx, params))
+
+	tp := TestProposal
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	assert.Assert(t, proposal.Params != nil)
+	assert.Equal(t, "0.667", proposal.Params.Threshold)
+
+	assert.NilError(t, f.govKeeper.ActivateVotingPeriod(ctx, proposal))
+	proposal, err = f.govKeeper.Proposals.Get(ctx, proposalID)
+	assert.NilError(t, err)
+	assert.Equal(t, proposal.VotingStartTime.Add(votingPeriod), *proposal.VotingEndTime)
+
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	passes, burnDeposits, tallyResults, _ := f.govKeeper.Tally(ctx, proposal)
+
+	assert.Assert(t, passes == false)
+	assert.Assert(t, burnDeposits == false)
+	assert.Assert(t, tallyResults.Equals(v1.EmptyTallyResult()) == false)
+}
+
 func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	t.Parallel()
 
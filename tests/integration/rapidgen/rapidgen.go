@@ -70,6 +70,12 @@ func GeneratorFieldMapper(t *rapid.T, field protoreflect.FieldDescriptor, name s
 			if field.Kind() == protoreflect.BytesKind {
 				return protoreflect.ValueOfBytes([]byte{}), true
 			}
+			// DecCoin amounts are not amino.dont_omitempty, so an empty amount would be
+			// omitted by x/tx while gogoproto always encodes it.
+			if field.ContainingMessage().FullName() == "cosmos.base.v1beta1.DecCoin" {
+				i32 := rapid.Int32().Draw(t, name)
+				return protoreflect.ValueOfString(fmt.Sprintf("%d", i32)), true
+			}
 
 			return protoreflect.ValueOfString(""), true
 		}
@@ -189,7 +195,7 @@ var (
 		GenType(&vestingtypes.MsgCreatePeriodicVestingAccount{}, &vestingapi.MsgCreatePeriodicVestingAccount{}, GenOpts),
 	}
 	NonsignableTypes = []GeneratedType{
-		GenType(&authtypes.Params{}, &authapi.Params{}, GenOpts),
+		GenType(&authtypes.Params{}, &authapi.Params{}, GenOpts.WithDisallowNil()),
 		GenType(&authtypes.BaseAccount{}, &authapi.BaseAccount{}, GenOpts.WithAnyTypes(&ed25519.PubKey{})),
 		GenType(&authtypes.ModuleCredential{}, &authapi.ModuleCredential{}, GenOpts),
 
@@ -201,7 +207,7 @@ var (
 		),
 
 		GenType(&banktypes.SendAuthorization{}, &bankapi.SendAuthorization{}, GenOpts),
-		GenType(&banktypes.Params{}, &bankapi.Params{}, GenOpts),
+		GenType(&banktypes.Params{}, &bankapi.Params{}, GenOpts.WithDisallowNil()),
 
 		// crypto
 		GenType(&multisig.LegacyAminoPubKey{}, &multisigapi.LegacyAminoPubKey{},
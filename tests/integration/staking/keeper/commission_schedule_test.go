@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestScheduleCommissionChange(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	queryClient := types.NewQueryClient(f.app.QueryHelper())
+	_, valAddrs, vals := createValidators(t, f, []int64{9, 8, 7})
+	valAddr := valAddrs[0]
+
+	// 5% commission, up to 50% and by 10% at most per change
+	validator, err := f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.NilError(t, err)
+	start := ctx.BlockTime()
+	validator.Commission = types.NewCommissionWithTime(math.LegacyNewDecWithPrec(5, 2), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(1, 1), start)
+	assert.NilError(t, f.stakingKeeper.SetValidator(ctx, validator))
+
+	schedule := func(ctx sdk.Context, rate math.LegacyDec, effectiveTime time.Time) error {
+		_, err := msgServer.ScheduleCommissionChange(ctx, types.NewMsgScheduleCommissionChange(vals[0].OperatorAddress, rate, effectiveTime))
+		return err
+	}
+
+	// the change is validated against the commission at its effective time
+	assert.ErrorIs(t, schedule(ctx, math.LegacyNewDecWithPrec(1, 1), start), types.ErrInvalidCommissionChangeSchedule)
+	assert.ErrorIs(t, schedule(ctx, math.LegacyNewDecWithPrec(1, 1), start.Add(time.Hour)), types.ErrCommissionUpdateTime)
+	assert.ErrorIs(t, schedule(ctx, math.LegacyNewDecWithPrec(2, 1), start.Add(48*time.Hour)), types.ErrCommissionGTMaxChangeRate)
+
+	params, err := f.stakingKeeper.GetParams(ctx)
+	assert.NilError(t, err)
+	params.MaxCommissionRate = math.LegacyNewDecWithPrec(1, 1)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+	assert.ErrorIs(t, schedule(ctx, math.LegacyNewDecWithPrec(12, 2), start.Add(48*time.Hour)), types.ErrCommissionGTMaxCommissionRate)
+
+	// a new schedule replaces the previous one
+	assert.NilError(t, schedule(ctx, math.LegacyNewDecWithPrec(8, 2), start.Add(24*time.Hour)))
+	assert.NilError(t, schedule(ctx, math.LegacyNewDecWithPrec(1, 1), start.Add(48*time.Hour)))
+
+	res, err := queryClient.ValidatorCommissionSchedule(gocontext.Background(), &types.QueryValidatorCommissionScheduleRequest{ValidatorAddr: vals[0].OperatorAddress})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecWithPrec(1, 1), res.Schedule.Rate)
+	assert.Assert(t, res.Schedule.EffectiveTime.Equal(start.Add(48*time.Hour)))
+
+	// nothing happens until the effective time
+	_, err = f.stakingKeeper.EndBlocker(ctx.WithBlockTime(start.Add(24 * time.Hour)))
+	assert.NilError(t, err)
+	validator, err = f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecWithPrec(5, 2), validator.Commission.Rate)
+
+	effectiveCtx := ctx.WithBlockTime(start.Add(48 * time.Hour))
+	_, err = f.stakingKeeper.EndBlocker(effectiveCtx)
+	assert.NilError(t, err)
+	validator, err = f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+	assert.Assert(t, validator.Commission.UpdateTime.Equal(effectiveCtx.BlockTime()))
+
+	_, err = f.stakingKeeper.GetCommissionChangeSchedule(ctx, valAddr)
+	assert.ErrorIs(t, err, types.ErrCommissionChangeScheduleNotFound)
+
+	// a change which became invalid since it was scheduled is dropped
+	assert.NilError(t, schedule(effectiveCtx, math.LegacyNewDecWithPrec(5, 2), start.Add(96*time.Hour)))
+	params.MinCommissionRate = math.LegacyNewDecWithPrec(6, 2)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	failedCtx := ctx.WithBlockTime(start.Add(96 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	_, err = f.stakingKeeper.EndBlocker(failedCtx)
+	assert.NilError(t, err)
+	validator, err = f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	_, err = f.stakingKeeper.GetCommissionChangeSchedule(ctx, valAddr)
+	assert.ErrorIs(t, err, types.ErrCommissionChangeScheduleNotFound)
+
+	found := false
+	for _, event := range failedCtx.EventManager().Events() {
+		found = found || event.Type == types.EventTypeCommissionChangeFailed
+	}
+	assert.Assert(t, found)
+}
+
+func TestUpdateParamsEnforcesCommissionRateBounds(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	_, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+
+	// one validator below the new minimum, the other above the new maximum
+	low, err := f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	low.Commission = types.NewCommission(math.LegacyNewDecWithPrec(1, 2), math.LegacyNewDecWithPrec(2, 2), math.LegacyNewDecWithPrec(1, 2))
+	assert.NilError(t, f.stakingKeeper.SetValidator(ctx, low))
+
+	high, err := f.stakingKeeper.GetValidator(ctx, valAddrs[1])
+	assert.NilError(t, err)
+	high.Commission = types.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyOneDec(), math.LegacyNewDecWithPrec(1, 1))
+	assert.NilError(t, f.stakingKeeper.SetValidator(ctx, high))
+
+	params, err := f.stakingKeeper.GetParams(ctx)
+	assert.NilError(t, err)
+	params.MinCommissionRate = math.LegacyNewDecWithPrec(5, 2)
+	params.MaxCommissionRate = math.LegacyNewDecWithPrec(2, 1)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: f.stakingKeeper.GetAuthority(), Params: params})
+	assert.NilError(t, err)
+
+	low, err = f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecWithPrec(5, 2), low.Commission.Rate)
+	assert.DeepEqual(t, math.LegacyNewDecWithPrec(5, 2), low.Commission.MaxRate)
+
+	high, err = f.stakingKeeper.GetValidator(ctx, valAddrs[1])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecWithPrec(2, 1), high.Commission.Rate)
+	assert.DeepEqual(t, math.LegacyOneDec(), high.Commission.MaxRate)
+
+	// commissions out of the bounds are rejected
+	rate := math.LegacyNewDecWithPrec(3, 1)
+	_, err = msgServer.EditValidator(ctx.WithBlockTime(ctx.BlockTime().Add(48*time.Hour)), &types.MsgEditValidator{
+		Description:      types.Description{Moniker: "high"},
+		ValidatorAddress: high.OperatorAddress,
+		CommissionRate:   &rate,
+	})
+	assert.ErrorIs(t, err, types.ErrCommissionGTMaxCommissionRate)
+}
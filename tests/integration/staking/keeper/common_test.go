@@ -116,6 +116,7 @@ func initFixture(tb testing.TB) *fixture {
 		types.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
 		types.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
 		types.KeyRotationFeePoolName: {authtypes.Burner},
+		types.TokenizeSharesPoolName: {authtypes.Minter, authtypes.Burner},
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -85,6 +85,7 @@ func initDeterministicFixture(t *testing.T) *deterministicFixture {
 		stakingtypes.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
 		stakingtypes.KeyRotationFeePoolName: {authtypes.Burner},
+		stakingtypes.TokenizeSharesPoolName: {authtypes.Minter, authtypes.Burner},
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -433,7 +434,7 @@ func TestGRPCValidatorDelegations(t *testing.T) {
 		ValidatorAddr: validator.OperatorAddress,
 	}
 
-	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.ValidatorDelegations, 14637, false)
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.ValidatorDelegations, 15204, false)
 }
 
 func TestGRPCValidatorUnbondingDelegations(t *testing.T) {
@@ -513,7 +514,7 @@ func TestGRPCDelegation(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Delegation, 4689, false)
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Delegation, 4878, false)
 }
 
 func TestGRPCUnbondingDelegation(t *testing.T) {
@@ -588,7 +589,7 @@ func TestGRPCDelegatorDelegations(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.DelegatorDelegations, 4292, false)
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.DelegatorDelegations, 4481, false)
 }
 
 func TestGRPCDelegatorValidator(t *testing.T) {
@@ -768,7 +769,7 @@ func TestGRPCPool(t *testing.T) {
 
 	f = initDeterministicFixture(t) // reset
 	getStaticValidator(t, f)
-	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6356, false)
+	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6545, false)
 }
 
 func TestGRPCRedelegations(t *testing.T) {
@@ -850,6 +851,10 @@ func TestGRPCParams(t *testing.T) {
 			HistoricalEntries: rapid.Uint32Min(1).Draw(rt, "historical-entries"),
 			MinCommissionRate: math.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "commission"), 2),
 			KeyRotationFee:    sdk.NewCoin(bondDenom, math.NewInt(rapid.Int64Range(1, 1000000).Draw(rt, "key-rotation-fee"))),
+
+			GlobalLiquidStakingCap:    math.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "global-liquid-staking-cap"), 2),
+			ValidatorLiquidStakingCap: math.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "validator-liquid-staking-cap"), 2),
+			MaxCommissionRate:         math.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "max-commission"), 2),
 		}
 
 		err := f.stakingKeeper.SetParams(f.ctx, params)
@@ -866,10 +871,14 @@ func TestGRPCParams(t *testing.T) {
 		HistoricalEntries: 5,
 		MinCommissionRate: math.LegacyNewDecWithPrec(5, 2),
 		KeyRotationFee:    sdk.NewInt64Coin("denom", 1000000),
+
+		GlobalLiquidStakingCap:    math.LegacyOneDec(),
+		ValidatorLiquidStakingCap: math.LegacyNewDecWithPrec(5, 1),
+		MaxCommissionRate:         math.LegacyNewDecWithPrec(5, 1),
 	}
 
 	err := f.stakingKeeper.SetParams(f.ctx, params)
 	assert.NilError(t, err)
 
-	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1168, false)
+	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1351, false)
 }
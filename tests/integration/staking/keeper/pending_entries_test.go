@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestGRPCQueryUnbondingAndRedelegationQueues(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	addrs, valAddrs, vals := createValidators(t, f, []int64{9, 8, 7})
+	delAddr, val1, val2 := addrs[0], valAddrs[0], valAddrs[1]
+
+	queryClient := types.NewQueryClient(f.app.QueryHelper())
+
+	unbondingTime, err := f.stakingKeeper.UnbondingTime(ctx)
+	assert.NilError(t, err)
+	shares := math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, 1))
+
+	// one unbonding at the current block time, an unbonding and a redelegation an hour later
+	start := ctx.BlockTime()
+	first, _, err := f.stakingKeeper.Undelegate(ctx, delAddr, val1, shares)
+	assert.NilError(t, err)
+	assert.Equal(t, start.Add(unbondingTime), first)
+
+	later := ctx.WithBlockTime(start.Add(time.Hour))
+	second, _, err := f.stakingKeeper.Undelegate(later, delAddr, val1, shares)
+	assert.NilError(t, err)
+	_, err = f.stakingKeeper.BeginRedelegation(later, delAddr, val2, val1, shares)
+	assert.NilError(t, err)
+
+	res, err := queryClient.UnbondingQueue(gocontext.Background(), &types.QueryUnbondingQueueRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Slices))
+	assert.Assert(t, res.Slices[0].CompletionTime.Equal(first))
+	assert.Assert(t, res.Slices[1].CompletionTime.Equal(second))
+	assert.Equal(t, delAddr.String(), res.Slices[0].Pairs[0].DelegatorAddress)
+	assert.Equal(t, vals[0].OperatorAddress, res.Slices[0].Pairs[0].ValidatorAddress)
+
+	// the time range is inclusive on both ends
+	res, err = queryClient.UnbondingQueue(gocontext.Background(), &types.QueryUnbondingQueueRequest{StartTime: second})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Slices))
+	assert.Assert(t, res.Slices[0].CompletionTime.Equal(second))
+
+	res, err = queryClient.UnbondingQueue(gocontext.Background(), &types.QueryUnbondingQueueRequest{EndTime: first})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Slices))
+	assert.Assert(t, res.Slices[0].CompletionTime.Equal(first))
+
+	res, err = queryClient.UnbondingQueue(gocontext.Background(), &types.QueryUnbondingQueueRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Slices))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	redRes, err := queryClient.RedelegationQueue(gocontext.Background(), &types.QueryRedelegationQueueRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(redRes.Slices))
+	assert.Assert(t, redRes.Slices[0].CompletionTime.Equal(second))
+	assert.Equal(t, vals[1].OperatorAddress, redRes.Slices[0].Triplets[0].ValidatorSrcAddress)
+	assert.Equal(t, vals[0].OperatorAddress, redRes.Slices[0].Triplets[0].ValidatorDstAddress)
+
+	redRes, err = queryClient.RedelegationQueue(gocontext.Background(), &types.QueryRedelegationQueueRequest{EndTime: first})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(redRes.Slices))
+}
+
+func TestGRPCQueryDelegatorPendingEntries(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	addrs, valAddrs, vals := createValidators(t, f, []int64{9, 8, 7})
+	delAddr, val1, val2 := addrs[0], valAddrs[0], valAddrs[1]
+
+	queryClient := types.NewQueryClient(f.app.QueryHelper())
+
+	_, err := queryClient.DelegatorPendingEntries(gocontext.Background(), &types.QueryDelegatorPendingEntriesRequest{})
+	assert.ErrorContains(t, err, "delegator address cannot be empty")
+
+	res, err := queryClient.DelegatorPendingEntries(gocontext.Background(), &types.QueryDelegatorPendingEntriesRequest{DelegatorAddr: delAddr.String()})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Entries))
+	assert.Equal(t, types.DefaultMaxEntries, res.MaxEntries)
+
+	tokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 1)
+	shares := math.LegacyNewDecFromInt(tokens)
+
+	// the later unbonding is listed after the earlier redelegation
+	start := ctx.BlockTime()
+	redCompletion, err := f.stakingKeeper.BeginRedelegation(ctx, delAddr, val2, val1, shares)
+	assert.NilError(t, err)
+	ubdCompletion, _, err := f.stakingKeeper.Undelegate(ctx.WithBlockTime(start.Add(time.Hour)), delAddr, val1, shares)
+	assert.NilError(t, err)
+
+	res, err = queryClient.DelegatorPendingEntries(gocontext.Background(), &types.QueryDelegatorPendingEntriesRequest{DelegatorAddr: delAddr.String()})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Entries))
+
+	assert.Equal(t, vals[1].OperatorAddress, res.Entries[0].ValidatorSrcAddress)
+	assert.Equal(t, vals[0].OperatorAddress, res.Entries[0].ValidatorDstAddress)
+	assert.Assert(t, res.Entries[0].CompletionTime.Equal(redCompletion))
+	assert.DeepEqual(t, tokens, res.Entries[0].Balance)
+
+	assert.Equal(t, vals[0].OperatorAddress, res.Entries[1].ValidatorSrcAddress)
+	assert.Equal(t, "", res.Entries[1].ValidatorDstAddress)
+	assert.Assert(t, res.Entries[1].CompletionTime.Equal(ubdCompletion))
+	assert.DeepEqual(t, tokens, res.Entries[1].Balance)
+}
+
+func TestInstantRedelegationFromJailedValidator(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithBlockHeight(10)
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	delAddr, otherDelAddr, val1, val2 := addrs[0], addrs[1], valAddrs[0], valAddrs[1]
+
+	shares := math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, 1))
+
+	// a redelegation into the first validator makes redelegating away from it transitive
+	_, err := f.stakingKeeper.BeginRedelegation(ctx, otherDelAddr, val2, val1, shares)
+	assert.NilError(t, err)
+
+	validator, err := f.stakingKeeper.GetValidator(ctx, val1)
+	assert.NilError(t, err)
+	consAddr, err := validator.GetConsAddr()
+	assert.NilError(t, err)
+	assert.NilError(t, f.stakingKeeper.Jail(ctx, consAddr))
+
+	params, err := f.stakingKeeper.GetParams(ctx)
+	assert.NilError(t, err)
+	params.AllowInstantRedelegationFromJailed = true
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	_, err = f.stakingKeeper.BeginRedelegation(ctx, otherDelAddr, val1, val2, shares)
+	assert.ErrorIs(t, err, types.ErrTransitiveRedelegation)
+
+	// the jailed validator starts unbonding, evidence of its earlier
+	// infractions can be submitted until it is unbonded
+	applyValidatorSetUpdates(t, ctx, f.stakingKeeper, -1)
+	validator, err = f.stakingKeeper.GetValidator(ctx, val1)
+	assert.NilError(t, err)
+	assert.Assert(t, validator.IsUnbonding())
+
+	// more redelegations than max entries are allowed, each with an entry
+	// completing when the source validator is unbonded
+	maxEntries, err := f.stakingKeeper.MaxEntries(ctx)
+	assert.NilError(t, err)
+	for i := uint32(0); i <= maxEntries; i++ {
+		completionTime, err := f.stakingKeeper.BeginRedelegation(ctx, delAddr, val1, val2, shares)
+		assert.NilError(t, err)
+		assert.Assert(t, completionTime.Equal(validator.UnbondingTime))
+	}
+
+	red, err := f.stakingKeeper.GetRedelegation(ctx, delAddr, val1, val2)
+	assert.NilError(t, err)
+	assert.Equal(t, int(maxEntries)+1, len(red.Entries))
+
+	// the redelegated tokens cannot be moved on while they are slashable for
+	// infractions of the source validator
+	_, err = f.stakingKeeper.BeginRedelegation(ctx, delAddr, val2, val1, shares)
+	assert.ErrorIs(t, err, types.ErrTransitiveRedelegation)
+
+	// the redelegated tokens are still slashed for an infraction committed
+	// before the jailing
+	delegationBefore, err := f.stakingKeeper.GetDelegation(ctx, delAddr, val2)
+	assert.NilError(t, err)
+
+	_, err = f.stakingKeeper.Slash(ctx, consAddr, 5, validator.ConsensusPower(f.stakingKeeper.PowerReduction(ctx)), math.LegacyNewDecWithPrec(5, 1))
+	assert.NilError(t, err)
+
+	delegationAfter, err := f.stakingKeeper.GetDelegation(ctx, delAddr, val2)
+	assert.NilError(t, err)
+	redelegated := shares.MulInt64(int64(maxEntries) + 1)
+	assert.DeepEqual(t, delegationBefore.Shares.Sub(redelegated.QuoInt64(2)), delegationAfter.Shares)
+
+	// once the source validator is unbonded, redelegations complete instantly
+	// without entry
+	ctx = ctx.WithBlockTime(validator.UnbondingTime).WithBlockHeight(validator.UnbondingHeight + 1)
+	assert.NilError(t, f.stakingKeeper.UnbondAllMatureValidators(ctx))
+	validator, err = f.stakingKeeper.GetValidator(ctx, val1)
+	assert.NilError(t, err)
+	assert.Assert(t, validator.IsUnbonded())
+
+	_, err = f.stakingKeeper.BeginRedelegation(ctx, delAddr, val1, val2, shares)
+	assert.NilError(t, err)
+	red, err = f.stakingKeeper.GetRedelegation(ctx, delAddr, val1, val2)
+	assert.NilError(t, err)
+	assert.Equal(t, int(maxEntries)+1, len(red.Entries))
+}
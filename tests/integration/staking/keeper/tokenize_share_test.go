@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	addrs, valAddrs, vals := createValidators(t, f, []int64{9, 8, 7})
+	delAddr, owner, valAddr := addrs[0], addrs[2], valAddrs[0]
+
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	bondedPool := f.stakingKeeper.GetBondedPool(ctx).GetAddress()
+	bondedBefore := f.bankKeeper.GetBalance(ctx, bondedPool, bondDenom)
+	balanceBefore := f.bankKeeper.GetBalance(ctx, delAddr, bondDenom)
+
+	tokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 4)
+	res, err := msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(
+		delAddr.String(), valAddr.String(), sdk.NewCoin(bondDenom, tokens), owner.String(),
+	))
+	assert.NilError(t, err)
+
+	record, err := f.stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, owner.String(), record.Owner)
+	assert.Equal(t, vals[0].OperatorAddress, record.Validator)
+	assert.DeepEqual(t, sdk.NewCoin(record.GetShareTokenDenom(), tokens), res.Amount)
+	assert.DeepEqual(t, res.Amount, f.bankKeeper.GetBalance(ctx, delAddr, res.Amount.Denom))
+
+	// the tokens stay bonded, delegated by the record instead of the delegator
+	assert.DeepEqual(t, bondedBefore, f.bankKeeper.GetBalance(ctx, bondedPool, bondDenom))
+	assert.DeepEqual(t, balanceBefore, f.bankKeeper.GetBalance(ctx, delAddr, bondDenom))
+
+	recordDelegation, err := f.stakingKeeper.GetDelegation(ctx, types.TokenizeShareRecordAddress(1), valAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens), recordDelegation.Shares)
+
+	delegation, err := f.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, 5)), delegation.Shares)
+
+	liquidShares, err := f.stakingKeeper.GetValidatorLiquidShares(ctx, valAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens), liquidShares)
+
+	owned, err := f.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []types.TokenizeShareRecord{record}, owned)
+
+	// share tokens are transferable, the receiver redeems them for a delegation
+	receiver := addrs[3]
+	half := sdk.NewCoin(res.Amount.Denom, tokens.QuoRaw(2))
+	assert.NilError(t, f.bankKeeper.SendCoins(ctx, delAddr, receiver, sdk.NewCoins(half)))
+
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(receiver.String(), half))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewCoin(bondDenom, half.Amount), redeemRes.Amount)
+
+	delegation, err = f.stakingKeeper.GetDelegation(ctx, receiver, valAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(half.Amount), delegation.Shares)
+
+	liquidShares, err = f.stakingKeeper.GetValidatorLiquidShares(ctx, valAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens.Sub(half.Amount)), liquidShares)
+
+	// share tokens redeem shares, a slash reduces the tokens they are worth
+	validator, err := f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.NilError(t, err)
+	consAddr, err := validator.GetConsAddr()
+	assert.NilError(t, err)
+	_, err = f.stakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), validator.ConsensusPower(f.stakingKeeper.PowerReduction(ctx)), math.LegacyNewDecWithPrec(5, 1))
+	assert.NilError(t, err)
+
+	totalLiquid, err := f.stakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, totalLiquid.LT(tokens.Sub(half.Amount)))
+
+	redeemRes, err = msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(delAddr.String(), half))
+	assert.NilError(t, err)
+	assert.Assert(t, redeemRes.Amount.Amount.LT(half.Amount))
+	assert.Assert(t, redeemRes.Amount.Amount.IsPositive())
+
+	// the last share tokens delete the record
+	_, err = f.stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.ErrorIs(t, err, types.ErrTokenizeShareRecordNotFound)
+	_, err = f.stakingKeeper.GetDelegation(ctx, types.TokenizeShareRecordAddress(1), valAddr)
+	assert.ErrorIs(t, err, types.ErrNoDelegation)
+	assert.Assert(t, f.bankKeeper.GetSupply(ctx, half.Denom).IsZero())
+
+	liquidShares, err = f.stakingKeeper.GetValidatorLiquidShares(ctx, valAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, liquidShares.IsZero())
+
+	_, err = msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(delAddr.String(), half))
+	assert.ErrorIs(t, err, types.ErrTokenizeShareRecordNotFound)
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	delAddr, valAddr := addrs[0], valAddrs[0]
+
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	// failed messages are reverted as in a transaction
+	tokenize := func(power int64) error {
+		cacheCtx, write := ctx.CacheContext()
+		_, err := msgServer.TokenizeShares(cacheCtx, types.NewMsgTokenizeShares(
+			delAddr.String(), valAddr.String(), sdk.NewCoin(bondDenom, f.stakingKeeper.TokensFromConsensusPower(ctx, power)), delAddr.String(),
+		))
+		if err == nil {
+			write()
+		}
+		return err
+	}
+
+	// 2 of the 9 delegator shares of the validator exceed a 20% cap
+	params, err := f.stakingKeeper.GetParams(ctx)
+	assert.NilError(t, err)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(2, 1)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	assert.ErrorIs(t, tokenize(2), types.ErrValidatorLiquidStakingCapExceeded)
+	assert.NilError(t, tokenize(1))
+
+	// 2 of the 24 bonded tokens exceed a 5% cap
+	params.ValidatorLiquidStakingCap = math.LegacyOneDec()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(5, 2)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	assert.ErrorIs(t, tokenize(1), types.ErrGlobalLiquidStakingCapExceeded)
+
+	totalLiquid, err := f.stakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 1), totalLiquid)
+
+	// only the bond denom can be tokenized
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(
+		delAddr.String(), valAddr.String(), sdk.NewCoin("other", math.OneInt()), delAddr.String(),
+	))
+	assert.ErrorContains(t, err, "invalid coin denomination")
+}
+
+func TestTokenizeSharesWithRedelegationInProgress(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	delAddr, srcValAddr, dstValAddr := addrs[1], valAddrs[1], valAddrs[0]
+
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	tokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 2)
+	_, err = f.stakingKeeper.BeginRedelegation(ctx, delAddr, srcValAddr, dstValAddr, math.LegacyNewDecFromInt(tokens))
+	assert.NilError(t, err)
+
+	// the redelegated shares cannot be tokenized until the redelegation completes
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(
+		delAddr.String(), dstValAddr.String(), sdk.NewCoin(bondDenom, tokens), delAddr.String(),
+	))
+	assert.ErrorIs(t, err, types.ErrRedelegationInProgress)
+
+	// shares of the source validator are not affected
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(
+		delAddr.String(), srcValAddr.String(), sdk.NewCoin(bondDenom, tokens), delAddr.String(),
+	))
+	assert.NilError(t, err)
+}
+
+func TestTokenizeSharesSelfDelegation(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	operator, valAddr := addrs[0], valAddrs[0]
+
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	validator, err := f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.NilError(t, err)
+	validator.MinSelfDelegation = f.stakingKeeper.TokensFromConsensusPower(ctx, 6)
+	assert.NilError(t, f.stakingKeeper.SetValidator(ctx, validator))
+
+	// the self-delegation cannot be tokenized below the minimum, which would
+	// jail the validator
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(
+		operator.String(), valAddr.String(), sdk.NewCoin(bondDenom, f.stakingKeeper.TokensFromConsensusPower(ctx, 4)), operator.String(),
+	))
+	assert.ErrorIs(t, err, types.ErrSelfDelegationBelowMinimum)
+
+	validator, err = f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, !validator.IsJailed())
+
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(
+		operator.String(), valAddr.String(), sdk.NewCoin(bondDenom, f.stakingKeeper.TokensFromConsensusPower(ctx, 3)), operator.String(),
+	))
+	assert.NilError(t, err)
+
+	validator, err = f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, !validator.IsJailed())
+}
@@ -227,7 +227,14 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 		// JSON before signing over them.
 		sortJSON bool
 	}{
-		"auth/params": {gogo: &authtypes.Params{TxSigLimit: 10}, pulsar: &authapi.Params{TxSigLimit: 10}},
+		"auth/params": {
+			gogo: &authtypes.Params{TxSigLimit: 10},
+			pulsar: &authapi.Params{
+				TxSigLimit:             10,
+				PubKeyRotationCooldown: &durationpb.Duration{},
+				PubKeyRotationWindow:   &durationpb.Duration{},
+			},
+		},
 		"auth/module_account": {
 			gogo: &authtypes.ModuleAccount{
 				BaseAccount: authtypes.NewBaseAccountWithAddress(addr1),
@@ -251,8 +258,12 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 			},
 		},
 		"authz/msg_update_params": {
-			gogo:   &authtypes.MsgUpdateParams{Params: authtypes.Params{TxSigLimit: 10}},
-			pulsar: &authapi.MsgUpdateParams{Params: &authapi.Params{TxSigLimit: 10}},
+			gogo: &authtypes.MsgUpdateParams{Params: authtypes.Params{TxSigLimit: 10}},
+			pulsar: &authapi.MsgUpdateParams{Params: &authapi.Params{
+				TxSigLimit:             10,
+				PubKeyRotationCooldown: &durationpb.Duration{},
+				PubKeyRotationWindow:   &durationpb.Duration{},
+			}},
 		},
 		"authz/msg_exec/empty_msgs": {
 			gogo:   &authztypes.MsgExec{Msgs: []*codectypes.Any{}},
@@ -3,6 +3,8 @@ module cosmossdk.io/tests/systemtests
 go 1.26.6
 
 // always use latest versions in tests
+replace cosmossdk.io/api => ../../api
+
 replace github.com/cosmos/cosmos-sdk => ../..
 
 replace github.com/cosmos/cosmos-sdk/tools/systemtests => ../../tools/systemtests
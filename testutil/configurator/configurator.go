@@ -145,6 +145,7 @@ func AuthModule() ModuleOption {
 					{Account: "bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
 					{Account: "not_bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
 					{Account: "key_rotation_fee_pool", Permissions: []string{"burner"}},
+					{Account: "tokenize_shares_pool", Permissions: []string{"minter", "burner"}},
 					{Account: "gov", Permissions: []string{"burner"}},
 					{Account: "nft"},
 				},
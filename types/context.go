@@ -64,6 +64,7 @@ type Context struct {
 	kvGasConfig          storetypes.GasConfig
 	transientKVGasConfig storetypes.GasConfig
 	streamingManager     storetypes.StreamingManager
+	gasProfiler          *GasProfiler
 	cometInfo            comet.BlockInfo
 	headerInfo           header.Info
 
@@ -107,6 +108,7 @@ func (c Context) Priority() int64                               { return c.prior
 func (c Context) KVGasConfig() storetypes.GasConfig             { return c.kvGasConfig }
 func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.transientKVGasConfig }
 func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
+func (c Context) GasProfiler() *GasProfiler                     { return c.gasProfiler }
 func (c Context) CometInfo() comet.BlockInfo                    { return c.cometInfo }
 func (c Context) HeaderInfo() header.Info                       { return c.headerInfo }
 func (c Context) TxIndex() int                                  { return c.txIndex }
@@ -241,12 +243,31 @@ func (c Context) WithVoteInfos(voteInfo []abci.VoteInfo) Context {
 	return c
 }
 
-// WithGasMeter returns a Context with an updated transaction GasMeter.
+// WithGasMeter returns a Context with an updated transaction GasMeter. If a
+// GasProfiler is attached, the meter is wrapped to report to it.
 func (c Context) WithGasMeter(meter storetypes.GasMeter) Context {
+	if c.gasProfiler != nil {
+		meter = c.gasProfiler.meter(meter, "")
+	}
 	c.gasMeter = meter
 	return c
 }
 
+// WithGasProfiler returns a Context recording all gas consumed through its
+// transaction GasMeter and stores in the given GasProfiler. Passing nil
+// detaches the profiler.
+func (c Context) WithGasProfiler(profiler *GasProfiler) Context {
+	if pm, ok := c.gasMeter.(profiledGasMeter); ok {
+		c.gasMeter = pm.GasMeter
+	}
+	c.gasProfiler = profiler
+	if profiler != nil {
+		profiler.kvGasConfig = c.kvGasConfig
+		c.gasMeter = profiler.meter(c.gasMeter, "")
+	}
+	return c
+}
+
 // WithBlockGasMeter returns a Context with an updated block GasMeter
 func (c Context) WithBlockGasMeter(meter storetypes.GasMeter) Context {
 	c.blockGasMeter = meter
@@ -391,12 +412,26 @@ func (c Context) Value(key any) any {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig)
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.storeGasMeter(key), c.kvGasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.transientKVGasConfig)
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.storeGasMeter(key), c.transientKVGasConfig)
+}
+
+// storeGasMeter returns the transaction GasMeter, labeled with the store name
+// when a GasProfiler is attached.
+func (c Context) storeGasMeter(key storetypes.StoreKey) storetypes.GasMeter {
+	if c.gasProfiler == nil {
+		return c.gasMeter
+	}
+	return c.gasProfiler.meter(c.gasMeter, key.Name())
+}
+
+// ObjectStore fetches an object store from the MultiStore.
+func (c Context) ObjectStore(key storetypes.StoreKey) storetypes.ObjKVStore {
+	return gaskv.NewObjStore(c.ms.GetObjKVStore(key), c.storeGasMeter(key), c.transientKVGasConfig)
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
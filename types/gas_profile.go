@@ -0,0 +1,267 @@
+package types
+
+import (
+	"sort"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+)
+
+// Store operation types reported in a GasProfile.
+const (
+	GasOperationRead    = "read"
+	GasOperationWrite   = "write"
+	GasOperationHas     = "has"
+	GasOperationDelete  = "delete"
+	GasOperationIterate = "iterate"
+)
+
+// gasOperations lists the store operation types in the order they are reported.
+var gasOperations = []string{
+	GasOperationRead,
+	GasOperationWrite,
+	GasOperationHas,
+	GasOperationDelete,
+	GasOperationIterate,
+}
+
+// gasOperation maps a KVStore gas descriptor to its operation type and
+// whether it is a flat (per operation) or a per-byte cost.
+type gasOperation struct {
+	name string
+	flat bool
+}
+
+var kvGasOperations = map[string]gasOperation{
+	storetypes.GasReadCostFlatDesc:     {GasOperationRead, true},
+	storetypes.GasReadPerByteDesc:      {GasOperationRead, false},
+	storetypes.GasWriteCostFlatDesc:    {GasOperationWrite, true},
+	storetypes.GasWritePerByteDesc:     {GasOperationWrite, false},
+	storetypes.GasHasDesc:              {GasOperationHas, true},
+	storetypes.GasDeleteDesc:           {GasOperationDelete, true},
+	storetypes.GasIterNextCostFlatDesc: {GasOperationIterate, true},
+	storetypes.GasValuePerByteDesc:     {GasOperationIterate, false},
+}
+
+// gasKey identifies a single bucket of recorded gas.
+type gasKey struct {
+	scope      string
+	store      string
+	descriptor string
+}
+
+// gasTally is the gas recorded in a bucket.
+type gasTally struct {
+	count uint64
+	gas   uint64
+}
+
+// GasProfiler records every gas charge made through the gas meter of a
+// Context it is attached to, attributing it to the active execution scope,
+// the store that charged it and the gas descriptor. It backs the gas breakdown
+// returned by transaction simulation.
+//
+// A GasProfiler is not safe for concurrent use and must only be attached to a
+// single transaction execution.
+type GasProfiler struct {
+	scope       string
+	scopes      []string
+	tallies     map[gasKey]*gasTally
+	kvGasConfig storetypes.GasConfig
+}
+
+// NewGasProfiler returns an empty GasProfiler.
+func NewGasProfiler() *GasProfiler {
+	return &GasProfiler{tallies: make(map[gasKey]*gasTally)}
+}
+
+// EnterScope makes name the active scope until the returned function is
+// called, which restores the previously active scope. Gas consumed in nested
+// scopes is only attributed to the innermost one.
+func (p *GasProfiler) EnterScope(name string) (exit func()) {
+	prev := p.scope
+	p.scope = name
+	return func() { p.scope = prev }
+}
+
+func (p *GasProfiler) record(store, descriptor string, amount storetypes.Gas) {
+	key := gasKey{scope: p.scope, store: store, descriptor: descriptor}
+	tally, ok := p.tallies[key]
+	if !ok {
+		tally = &gasTally{}
+		p.tallies[key] = tally
+		p.addScope(p.scope)
+	}
+	tally.count++
+	tally.gas += amount
+}
+
+func (p *GasProfiler) refund(store, descriptor string, amount storetypes.Gas) {
+	tally, ok := p.tallies[gasKey{scope: p.scope, store: store, descriptor: descriptor}]
+	if !ok {
+		return
+	}
+	if amount > tally.gas {
+		amount = tally.gas
+	}
+	tally.gas -= amount
+}
+
+func (p *GasProfiler) addScope(scope string) {
+	for _, s := range p.scopes {
+		if s == scope {
+			return
+		}
+	}
+	p.scopes = append(p.scopes, scope)
+}
+
+// meter returns gasMeter wrapped so that the gas it consumes is recorded
+// against store. An already profiled meter is unwrapped first.
+func (p *GasProfiler) meter(gasMeter storetypes.GasMeter, store string) storetypes.GasMeter {
+	if pm, ok := gasMeter.(profiledGasMeter); ok {
+		gasMeter = pm.GasMeter
+	}
+	return profiledGasMeter{GasMeter: gasMeter, profiler: p, store: store}
+}
+
+// GasProfile returns the gas breakdown recorded so far. Scopes are reported in
+// the order they first consumed gas; stores and descriptors are sorted by name.
+func (p *GasProfiler) GasProfile(gasUsed uint64) *GasProfile {
+	profile := &GasProfile{
+		GasUsed: gasUsed,
+		KvGasConfig: KVGasConfig{
+			HasCost:          p.kvGasConfig.HasCost,
+			DeleteCost:       p.kvGasConfig.DeleteCost,
+			ReadCostFlat:     p.kvGasConfig.ReadCostFlat,
+			ReadCostPerByte:  p.kvGasConfig.ReadCostPerByte,
+			WriteCostFlat:    p.kvGasConfig.WriteCostFlat,
+			WriteCostPerByte: p.kvGasConfig.WriteCostPerByte,
+			IterNextCostFlat: p.kvGasConfig.IterNextCostFlat,
+		},
+	}
+
+	all := make(map[gasKey]*gasTally)
+	descriptors := make(map[string]*gasTally)
+	for key, tally := range p.tallies {
+		mergeTally(all, gasKey{store: key.store, descriptor: key.descriptor}, tally)
+		mergeTally(descriptors, key.descriptor, tally)
+	}
+
+	for _, scope := range p.scopes {
+		scoped := make(map[gasKey]*gasTally)
+		for key, tally := range p.tallies {
+			if key.scope == scope {
+				mergeTally(scoped, gasKey{store: key.store, descriptor: key.descriptor}, tally)
+			}
+		}
+		stores := storeGasProfiles(scoped)
+		scopeProfile := ScopeGasProfile{Name: scope, Stores: stores}
+		for _, store := range stores {
+			scopeProfile.GasUsed += store.GasUsed
+		}
+		profile.Scopes = append(profile.Scopes, scopeProfile)
+	}
+
+	profile.Stores = storeGasProfiles(all)
+	profile.Operations = operationGasProfiles(all, func(gasKey) bool { return true })
+
+	names := make([]string, 0, len(descriptors))
+	for name := range descriptors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		profile.Descriptors = append(profile.Descriptors, DescriptorGasProfile{
+			Name:    name,
+			Count:   descriptors[name].count,
+			GasUsed: descriptors[name].gas,
+		})
+	}
+
+	return profile
+}
+
+func mergeTally[K comparable](m map[K]*gasTally, key K, tally *gasTally) {
+	t, ok := m[key]
+	if !ok {
+		t = &gasTally{}
+		m[key] = t
+	}
+	t.count += tally.count
+	t.gas += tally.gas
+}
+
+// storeGasProfiles groups tallies keyed by store and descriptor per store.
+func storeGasProfiles(tallies map[gasKey]*gasTally) []StoreGasProfile {
+	gasUsed := make(map[string]uint64)
+	for key, tally := range tallies {
+		gasUsed[key.store] += tally.gas
+	}
+
+	names := make([]string, 0, len(gasUsed))
+	for name := range gasUsed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	stores := make([]StoreGasProfile, 0, len(names))
+	for _, name := range names {
+		stores = append(stores, StoreGasProfile{
+			Name:       name,
+			GasUsed:    gasUsed[name],
+			Operations: operationGasProfiles(tallies, func(key gasKey) bool { return key.store == name }),
+		})
+	}
+	return stores
+}
+
+// operationGasProfiles sums the KVStore tallies selected by filter per
+// operation type. Operations that were never performed are omitted.
+func operationGasProfiles(tallies map[gasKey]*gasTally, filter func(gasKey) bool) []OperationGasProfile {
+	ops := make(map[string]*OperationGasProfile)
+	for key, tally := range tallies {
+		op, ok := kvGasOperations[key.descriptor]
+		if !ok || key.store == "" || !filter(key) {
+			continue
+		}
+		profile, ok := ops[op.name]
+		if !ok {
+			profile = &OperationGasProfile{Operation: op.name}
+			ops[op.name] = profile
+		}
+		if op.flat {
+			profile.Count += tally.count
+			profile.FlatGas += tally.gas
+		} else {
+			profile.PerByteGas += tally.gas
+		}
+	}
+
+	var result []OperationGasProfile
+	for _, name := range gasOperations {
+		if profile, ok := ops[name]; ok {
+			result = append(result, *profile)
+		}
+	}
+	return result
+}
+
+// profiledGasMeter is a GasMeter recording all consumed and refunded gas in a
+// GasProfiler.
+type profiledGasMeter struct {
+	storetypes.GasMeter
+	profiler *GasProfiler
+	store    string
+}
+
+// ConsumeGas implements storetypes.GasMeter.
+func (m profiledGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	m.GasMeter.ConsumeGas(amount, descriptor)
+	m.profiler.record(m.store, descriptor, amount)
+}
+
+// RefundGas implements storetypes.GasMeter.
+func (m profiledGasMeter) RefundGas(amount storetypes.Gas, descriptor string) {
+	m.GasMeter.RefundGas(amount, descriptor)
+	m.profiler.refund(m.store, descriptor, amount)
+}
@@ -0,0 +1,117 @@
+package types_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type gasProfilePostDecorator struct{}
+
+func (gasProfilePostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	ctx.GasMeter().ConsumeGas(10, "post")
+	return next(ctx, tx, simulate, success)
+}
+
+func TestGasProfiler(t *testing.T) {
+	key := storetypes.NewKVStoreKey("profiled")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_profiled"))
+
+	profiler := sdk.NewGasProfiler()
+	ctx = ctx.WithGasProfiler(profiler)
+	require.Same(t, profiler, ctx.GasProfiler())
+
+	// replacing the gas meter keeps recording, without double counting
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	ctx = ctx.WithGasMeter(ctx.GasMeter())
+
+	exit := profiler.EnterScope("outer")
+	store := ctx.KVStore(key)
+	store.Set([]byte("a"), []byte("b"))
+	require.True(t, store.Has([]byte("a")))
+
+	exitInner := profiler.EnterScope("inner")
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+	}
+	require.NoError(t, iter.Close())
+	store.Delete([]byte("a"))
+	exitInner()
+
+	ctx.GasMeter().ConsumeGas(100, "custom")
+	ctx.GasMeter().RefundGas(40, "custom")
+	exit()
+
+	postHandler := sdk.ChainPostDecorators(gasProfilePostDecorator{})
+	_, err := postHandler(ctx, nil, true, true)
+	require.NoError(t, err)
+
+	cfg := storetypes.KVGasConfig()
+	setGas := cfg.WriteCostFlat + 2*cfg.WriteCostPerByte
+	// opening the iterator and advancing past the single entry are two steps
+	iterGas := 2 * (cfg.IterNextCostFlat + 2*cfg.ReadCostPerByte)
+	require.Equal(t, setGas+cfg.HasCost+iterGas+cfg.DeleteCost+60+10, ctx.GasMeter().GasConsumed())
+
+	profile := profiler.GasProfile(ctx.GasMeter().GasConsumed())
+	require.Equal(t, cfg.ReadCostPerByte, profile.KvGasConfig.ReadCostPerByte)
+
+	require.Len(t, profile.Scopes, 3)
+	require.Equal(t, "outer", profile.Scopes[0].Name)
+	require.Equal(t, setGas+cfg.HasCost+60, profile.Scopes[0].GasUsed)
+	require.Equal(t, "inner", profile.Scopes[1].Name)
+	require.Equal(t, iterGas+cfg.DeleteCost, profile.Scopes[1].GasUsed)
+	require.Equal(t, "post/types_test.gasProfilePostDecorator", profile.Scopes[2].Name)
+	require.Equal(t, uint64(10), profile.Scopes[2].GasUsed)
+
+	require.Equal(t, []sdk.OperationGasProfile{
+		{Operation: sdk.GasOperationWrite, Count: 1, FlatGas: cfg.WriteCostFlat, PerByteGas: 2 * cfg.WriteCostPerByte},
+		{Operation: sdk.GasOperationHas, Count: 1, FlatGas: cfg.HasCost},
+		{Operation: sdk.GasOperationDelete, Count: 1, FlatGas: cfg.DeleteCost},
+		{Operation: sdk.GasOperationIterate, Count: 2, FlatGas: 2 * cfg.IterNextCostFlat, PerByteGas: 4 * cfg.ReadCostPerByte},
+	}, profile.Operations)
+
+	require.Len(t, profile.Stores, 2)
+	require.Equal(t, "", profile.Stores[0].Name)
+	require.Equal(t, uint64(70), profile.Stores[0].GasUsed)
+	require.Empty(t, profile.Stores[0].Operations)
+	require.Equal(t, "profiled", profile.Stores[1].Name)
+	require.Equal(t, profile.Operations, profile.Stores[1].Operations)
+
+	require.Contains(t, profile.Descriptors, sdk.DescriptorGasProfile{Name: "custom", Count: 1, GasUsed: 60})
+	require.Contains(t, profile.Descriptors, sdk.DescriptorGasProfile{Name: storetypes.GasHasDesc, Count: 1, GasUsed: cfg.HasCost})
+
+	// detaching the profiler stops recording
+	ctx = ctx.WithGasProfiler(nil)
+	ctx.GasMeter().ConsumeGas(1, "custom")
+	require.Equal(t, profile, profiler.GasProfile(profile.GasUsed))
+}
+
+func TestGasProfilerObjectStore(t *testing.T) {
+	key := storetypes.NewObjectStoreKey("profiled_obj")
+	rms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	rms.MountStoreWithDB(key, storetypes.StoreTypeObject, nil)
+	require.NoError(t, rms.LoadLatestVersion())
+
+	profiler := sdk.NewGasProfiler()
+	ctx := sdk.NewContext(rms, cmtproto.Header{}, false, log.NewNopLogger()).
+		WithGasMeter(storetypes.NewGasMeter(1_000_000)).
+		WithGasProfiler(profiler)
+
+	// the gas consumed by object stores is recorded per store as well
+	ctx.ObjectStore(key).Set([]byte("a"), "b")
+	require.True(t, ctx.ObjectStore(key).Has([]byte("a")))
+
+	profile := profiler.GasProfile(ctx.GasMeter().GasConsumed())
+	require.Len(t, profile.Stores, 1)
+	require.Equal(t, "profiled_obj", profile.Stores[0].Name)
+	require.Equal(t, ctx.GasMeter().GasConsumed(), profile.Stores[0].GasUsed)
+}
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // AnteHandler authenticates transactions, before their internal messages are handled.
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, err error)
@@ -32,6 +34,9 @@ type PostDecorator interface {
 // transactions to be processed with an infinite gasmeter and open a DOS attack vector.
 // Use `ante.SetUpContextDecorator` or a custom Decorator with similar functionality.
 // Returns nil when no AnteDecorator are supplied.
+//
+// When a GasProfiler is attached to the Context, the gas consumed by each
+// decorator is recorded under the scope "ante/<decorator type>".
 func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	if len(chain) == 0 {
 		return nil
@@ -44,7 +49,11 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	}
 	for i := range chain {
 		ii := i
+		scope := fmt.Sprintf("ante/%T", chain[ii])
 		handlerChain[ii] = func(ctx Context, tx Tx, simulate bool) (Context, error) {
+			if profiler := ctx.GasProfiler(); profiler != nil {
+				defer profiler.EnterScope(scope)()
+			}
 			return chain[ii].AnteHandle(ctx, tx, simulate, handlerChain[ii+1])
 		}
 	}
@@ -60,6 +69,9 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 // certain checks and updates to be performed (e.g. the Context) before the decorator
 // is run. These expectations should be documented clearly in a CONTRACT docline
 // in the decorator's godoc.
+//
+// When a GasProfiler is attached to the Context, the gas consumed by each
+// decorator is recorded under the scope "post/<decorator type>".
 func ChainPostDecorators(chain ...PostDecorator) PostHandler {
 	if len(chain) == 0 {
 		return nil
@@ -72,7 +84,11 @@ func ChainPostDecorators(chain ...PostDecorator) PostHandler {
 	}
 	for i := range chain {
 		ii := i
+		scope := fmt.Sprintf("post/%T", chain[ii])
 		handlerChain[ii] = func(ctx Context, tx Tx, simulate, success bool) (Context, error) {
+			if profiler := ctx.GasProfiler(); profiler != nil {
+				defer profiler.EnterScope(scope)()
+			}
 			return chain[ii].PostHandle(ctx, tx, simulate, success, handlerChain[ii+1])
 		}
 	}
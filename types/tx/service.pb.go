@@ -390,6 +390,8 @@ type SimulateRequest struct {
 	Tx *Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"` // Deprecated: Do not use.
 	// tx_bytes is the raw transaction.
 	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// gas_profile requests a structured gas breakdown of the simulation.
+	GasProfile bool `protobuf:"varint,3,opt,name=gas_profile,json=gasProfile,proto3" json:"gas_profile,omitempty"`
 }
 
 func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
@@ -440,6 +442,13 @@ func (m *SimulateRequest) GetTxBytes() []byte {
 	return nil
 }
 
+func (m *SimulateRequest) GetGasProfile() bool {
+	if m != nil {
+		return m.GasProfile
+	}
+	return false
+}
+
 // SimulateResponse is the response type for the
 // Service.SimulateRPC method.
 type SimulateResponse struct {
@@ -447,6 +456,9 @@ type SimulateResponse struct {
 	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
 	// result is the result of the simulation.
 	Result *types.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// gas_profile is the gas breakdown of the simulation, only set when
+	// requested.
+	GasProfile *types.GasProfile `protobuf:"bytes,3,opt,name=gas_profile,json=gasProfile,proto3" json:"gas_profile,omitempty"`
 }
 
 func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
@@ -496,6 +508,13 @@ func (m *SimulateResponse) GetResult() *types.Result {
 	return nil
 }
 
+func (m *SimulateResponse) GetGasProfile() *types.GasProfile {
+	if m != nil {
+		return m.GasProfile
+	}
+	return nil
+}
+
 // GetTxRequest is the request type for the Service.GetTx
 // RPC method.
 type GetTxRequest struct {
@@ -1124,89 +1143,92 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0x51, 0x6f, 0xd3, 0x56,
-	0x14, 0xc7, 0x6b, 0x37, 0x69, 0xd2, 0x93, 0x94, 0x86, 0xdb, 0x00, 0x69, 0x80, 0x34, 0x18, 0x4a,
-	0x43, 0xa5, 0xda, 0x10, 0x40, 0x4c, 0x68, 0xd3, 0x14, 0x27, 0xa1, 0x2b, 0x0c, 0x82, 0x9c, 0x4e,
-	0x13, 0xd3, 0xa4, 0xc8, 0x49, 0x2e, 0x89, 0x47, 0x62, 0x87, 0xf8, 0x16, 0x39, 0x62, 0x68, 0xd3,
-	0x5e, 0x36, 0x4d, 0x9b, 0x36, 0x69, 0x9f, 0x60, 0xef, 0x7b, 0xe4, 0x43, 0x4c, 0x3c, 0x21, 0xf6,
-	0x32, 0xed, 0x69, 0x82, 0x3d, 0xed, 0x53, 0x4c, 0xbe, 0xbe, 0x4e, 0xec, 0xc4, 0xa6, 0x34, 0x7b,
-	0x89, 0x7c, 0x7d, 0xcf, 0x3d, 0xe7, 0x77, 0xfe, 0xf7, 0xde, 0x73, 0x1c, 0xd8, 0x68, 0x19, 0x66,
-	0xdf, 0x30, 0x25, 0x62, 0x49, 0x4f, 0xae, 0x34, 0x31, 0x51, 0xaf, 0x48, 0x26, 0x1e, 0x3e, 0xd1,
-	0x5a, 0x58, 0x1c, 0x0c, 0x0d, 0x62, 0xa0, 0xe3, 0x8e, 0x81, 0x48, 0x2c, 0x91, 0x19, 0x64, 0xcf,
-	0x74, 0x0c, 0xa3, 0xd3, 0xc3, 0x92, 0x3a, 0xd0, 0x24, 0x55, 0xd7, 0x0d, 0xa2, 0x12, 0xcd, 0xd0,
-	0x4d, 0x67, 0x41, 0xf6, 0x3c, 0xf3, 0xd8, 0x54, 0x4d, 0x2c, 0xa9, 0xcd, 0x96, 0x36, 0x76, 0x6c,
-	0x0f, 0x98, 0x51, 0x76, 0x36, 0x2c, 0xb1, 0xd8, 0xdc, 0xb6, 0xd7, 0xc1, 0xe3, 0x03, 0x3c, 0x1c,
-	0x8d, 0x6d, 0x06, 0x6a, 0x47, 0xd3, 0x69, 0x34, 0x66, 0x7b, 0x86, 0x60, 0xbd, 0x8d, 0x87, 0x7d,
-	0x4d, 0x27, 0x12, 0x19, 0x0d, 0xb0, 0x29, 0x35, 0x7b, 0x46, 0xeb, 0x51, 0xe8, 0x2c, 0xfd, 0x65,
-	0xb3, 0xeb, 0x4e, 0x9c, 0x06, 0x1d, 0x49, 0x2c, 0x4d, 0x3a, 0x10, 0xbe, 0xe3, 0x01, 0xed, 0x62,
-	0xb2, 0x6f, 0x99, 0xd5, 0x27, 0x58, 0x27, 0x0a, 0x7e, 0x7c, 0x80, 0x4d, 0x82, 0xb2, 0xb0, 0x84,
-	0xed, 0xb1, 0x99, 0xe1, 0xf2, 0x8b, 0x85, 0x65, 0x99, 0xcf, 0x70, 0x0a, 0x7b, 0x83, 0x6e, 0x03,
-	0x4c, 0xe8, 0x32, 0x7c, 0x9e, 0x2b, 0x24, 0x8a, 0x17, 0x45, 0xe6, 0xd5, 0x4e, 0x45, 0xa4, 0xa9,
-	0xb8, 0x22, 0x8a, 0xf7, 0xd5, 0x0e, 0x66, 0x7e, 0xa9, 0x1f, 0xcf, 0x6a, 0x74, 0x1d, 0xe2, 0xc6,
-	0xb0, 0x8d, 0x87, 0x8d, 0xe6, 0x28, 0xb3, 0x98, 0xe7, 0x0a, 0xc7, 0x8a, 0x59, 0x71, 0x66, 0x1b,
-	0xc4, 0x9a, 0x6d, 0x22, 0x8f, 0x94, 0x98, 0xe1, 0x3c, 0x20, 0x04, 0x91, 0x81, 0xda, 0xc1, 0x99,
-	0x48, 0x9e, 0x2b, 0x44, 0x14, 0xfa, 0x8c, 0xd2, 0x10, 0xed, 0x69, 0x7d, 0x8d, 0x64, 0xa2, 0xf4,
-	0xa5, 0x33, 0x40, 0x97, 0x20, 0x4a, 0x69, 0x32, 0x4b, 0x79, 0xae, 0xb0, 0x2c, 0xaf, 0xfd, 0xf5,
-	0x7c, 0x67, 0xd5, 0x09, 0xb0, 0x63, 0xb6, 0x1f, 0xe5, 0x2f, 0x8b, 0xd7, 0x2f, 0x2b, 0x8e, 0x85,
-	0xf0, 0x2f, 0x07, 0x6b, 0x3e, 0x29, 0xcc, 0x81, 0xa1, 0x9b, 0x18, 0x6d, 0xc1, 0x22, 0xb1, 0x1c,
-	0x21, 0x12, 0xc5, 0x13, 0x01, 0x78, 0xfb, 0x96, 0x62, 0x5b, 0xa0, 0x5d, 0x48, 0x12, 0xab, 0x31,
-	0x64, 0xeb, 0xcc, 0x0c, 0x4f, 0x57, 0x5c, 0xf0, 0x49, 0x43, 0x4f, 0x86, 0x67, 0x21, 0x33, 0x56,
-	0x12, 0x64, 0xfc, 0x6c, 0xa2, 0x3b, 0x3e, 0x85, 0x17, 0xa9, 0xc2, 0x5b, 0x87, 0x2a, 0xec, 0xac,
-	0x9e, 0x91, 0x38, 0x0d, 0x51, 0x62, 0x10, 0xb5, 0xc7, 0xc4, 0x72, 0x06, 0x02, 0x06, 0x24, 0x0f,
-	0x0d, 0xb5, 0xdd, 0x52, 0x4d, 0x62, 0x63, 0x38, 0xdb, 0xbe, 0x0e, 0x71, 0x62, 0x35, 0x9a, 0x23,
-	0x82, 0xed, 0x7c, 0xb9, 0x42, 0x52, 0x89, 0x11, 0x4b, 0xb6, 0x87, 0xe8, 0x1a, 0x44, 0xfa, 0x46,
-	0x1b, 0xd3, 0xfd, 0x3e, 0x56, 0xcc, 0x07, 0xc8, 0x30, 0xf6, 0x77, 0xd7, 0x68, 0x63, 0x85, 0x5a,
-	0x0b, 0x9f, 0xc3, 0x9a, 0x2f, 0x0c, 0x93, 0xb4, 0x0a, 0x09, 0x8f, 0x52, 0x34, 0xd4, 0xbb, 0x0a,
-	0x05, 0x13, 0xa1, 0x84, 0x1e, 0xac, 0xd6, 0xb5, 0xfe, 0x41, 0x4f, 0x25, 0xee, 0x01, 0x43, 0x97,
-	0x80, 0x27, 0x16, 0x73, 0x18, 0xbc, 0x57, 0x54, 0x20, 0x9e, 0x58, 0x48, 0xf4, 0x24, 0x6b, 0x67,
-	0x95, 0x0c, 0x38, 0x1d, 0xd7, 0xae, 0x8e, 0x15, 0x10, 0xbe, 0xe7, 0x20, 0x35, 0x09, 0xc7, 0x32,
-	0x79, 0x1f, 0xe2, 0x1d, 0xd5, 0x6c, 0x68, 0xfa, 0x43, 0x83, 0x45, 0x3d, 0x17, 0x9e, 0xc6, 0xae,
-	0x6a, 0xee, 0xe9, 0x0f, 0x0d, 0x25, 0xd6, 0x71, 0x1e, 0xd0, 0x7b, 0xb0, 0x34, 0xc4, 0xe6, 0x41,
-	0x8f, 0xb0, 0x6b, 0x94, 0x0f, 0x5f, 0xab, 0x50, 0x3b, 0x85, 0xd9, 0x0b, 0x02, 0x24, 0xe9, 0x59,
-	0x75, 0xf3, 0x46, 0x10, 0xe9, 0xaa, 0x66, 0x97, 0x32, 0x2c, 0x2b, 0xf4, 0x59, 0x78, 0x06, 0x2b,
-	0xcc, 0x86, 0xc1, 0x6e, 0x1e, 0x2a, 0x0e, 0x15, 0x66, 0x6a, 0x77, 0xf8, 0x39, 0x77, 0xe7, 0x27,
-	0x0e, 0x4e, 0xee, 0x62, 0x22, 0xdb, 0x65, 0xea, 0x53, 0x8d, 0x74, 0xf7, 0x2d, 0xd3, 0xa5, 0x3d,
-	0x09, 0x4b, 0x5d, 0xac, 0x75, 0xba, 0x84, 0xc2, 0x2c, 0x2a, 0x6c, 0x84, 0x6e, 0xcd, 0x5f, 0x5a,
-	0xbc, 0x67, 0xfe, 0xe6, 0x89, 0x57, 0xcf, 0x77, 0x8e, 0xfb, 0x37, 0xf2, 0xba, 0x58, 0x14, 0xbe,
-	0xe5, 0xe1, 0xd4, 0x0c, 0xd1, 0x51, 0x6f, 0xf9, 0x35, 0x88, 0xd3, 0xca, 0xdb, 0xd0, 0xda, 0x8c,
-	0x70, 0x5d, 0x9c, 0x54, 0x5f, 0xd1, 0xa9, 0xbb, 0x34, 0xc4, 0x5e, 0x45, 0x89, 0x51, 0xd3, 0xbd,
-	0x36, 0xda, 0x81, 0x28, 0x7d, 0x64, 0xb7, 0xf9, 0x54, 0xc8, 0x12, 0xc5, 0xb1, 0x42, 0xbb, 0x3e,
-	0x21, 0x22, 0x47, 0xaa, 0x00, 0xef, 0xa2, 0x44, 0x09, 0x56, 0xf7, 0xad, 0x0a, 0x6e, 0xd9, 0x37,
-	0xf5, 0xd0, 0xbb, 0x7f, 0x73, 0xed, 0xd5, 0xcc, 0xbd, 0xb8, 0x21, 0xdc, 0x83, 0xd4, 0xc4, 0xc5,
-	0x91, 0x0e, 0x58, 0xb0, 0xbf, 0xbb, 0x36, 0x52, 0x55, 0xf7, 0x22, 0xfd, 0x1f, 0x77, 0xb2, 0x8d,
-	0xe7, 0xba, 0x63, 0x78, 0x47, 0x4d, 0xf1, 0x36, 0xa4, 0x5d, 0x1f, 0xa5, 0xbe, 0xa6, 0x1b, 0x2e,
-	0xd7, 0x59, 0x00, 0xd5, 0x1e, 0x37, 0xbe, 0x30, 0x0d, 0x9d, 0x5d, 0xb9, 0x65, 0xfa, 0xe6, 0xb6,
-	0x69, 0xe8, 0xc1, 0xbe, 0x6a, 0x70, 0x62, 0xca, 0x17, 0x83, 0x3a, 0x07, 0x49, 0xc7, 0x59, 0x53,
-	0xd3, 0xd5, 0xe1, 0x88, 0x81, 0x25, 0xe8, 0x3b, 0x99, 0xbe, 0x0a, 0xd3, 0x3f, 0xed, 0xea, 0xef,
-	0x83, 0x9b, 0xd7, 0xdf, 0x1d, 0x1b, 0xd0, 0xe7, 0x8f, 0x01, 0xce, 0x91, 0xed, 0xf6, 0x47, 0x10,
-	0x63, 0x4d, 0x1b, 0x65, 0x20, 0x5d, 0x53, 0x2a, 0x55, 0xa5, 0x21, 0x3f, 0x68, 0x7c, 0x72, 0xaf,
-	0x7e, 0xbf, 0x5a, 0xde, 0xbb, 0xb5, 0x57, 0xad, 0xa4, 0x16, 0x50, 0x0a, 0x92, 0xe3, 0x99, 0x52,
-	0xbd, 0x9c, 0xe2, 0xd0, 0x71, 0x58, 0x19, 0xbf, 0xa9, 0x54, 0xeb, 0xe5, 0x14, 0xbf, 0xfd, 0x35,
-	0x07, 0x2b, 0xbe, 0xce, 0x82, 0x72, 0x90, 0x95, 0x95, 0x5a, 0xa9, 0x52, 0x2e, 0xd5, 0xf7, 0x1b,
-	0x77, 0x6b, 0x95, 0xea, 0x94, 0xdb, 0x33, 0x90, 0x9e, 0x9a, 0x97, 0x3f, 0xae, 0x95, 0xef, 0xa4,
-	0xb8, 0x2c, 0x1f, 0xe7, 0xd0, 0x29, 0x58, 0x9b, 0x9a, 0xad, 0x3f, 0xb8, 0x57, 0x4e, 0xf1, 0x36,
-	0xe7, 0xd4, 0x44, 0x89, 0xce, 0x2c, 0x16, 0x7f, 0x04, 0x88, 0xd5, 0x9d, 0x4f, 0x45, 0xf4, 0x14,
-	0xe2, 0x6e, 0x0f, 0x40, 0x42, 0xc0, 0x91, 0x9c, 0xea, 0x47, 0xd9, 0xf3, 0x6f, 0xb5, 0x61, 0x95,
-	0xf2, 0xe2, 0x37, 0x7f, 0xfc, 0xf3, 0x0b, 0x9f, 0xbf, 0xc9, 0x6d, 0x0b, 0xa7, 0xa5, 0x80, 0xcf,
-	0x54, 0x37, 0xe0, 0x63, 0x88, 0xd2, 0x82, 0x8e, 0x36, 0x02, 0xbc, 0x7a, 0xdb, 0x41, 0x36, 0x1f,
-	0x6e, 0xc0, 0x62, 0x6e, 0xd2, 0x98, 0x1b, 0xe8, 0xac, 0x14, 0xf4, 0x81, 0x6a, 0x4a, 0x4f, 0xed,
-	0x16, 0xf2, 0x0c, 0x7d, 0x05, 0x09, 0x4f, 0x03, 0x47, 0x9b, 0x6f, 0xeb, 0xfb, 0x93, 0xf0, 0x17,
-	0x0f, 0x33, 0x63, 0x10, 0xe7, 0x28, 0xc4, 0x69, 0x3b, 0xf1, 0x93, 0xc1, 0x1c, 0xe8, 0x4b, 0x48,
-	0x78, 0x3e, 0xca, 0x02, 0x01, 0x66, 0xbf, 0x5f, 0x03, 0x01, 0x02, 0xbe, 0xed, 0x84, 0x1c, 0x05,
-	0xc8, 0xa0, 0xb0, 0xe8, 0xbf, 0x71, 0xb0, 0x3a, 0xd5, 0x31, 0xd0, 0xa5, 0x60, 0xdf, 0x01, 0x7d,
-	0x2e, 0xbb, 0xfd, 0x2e, 0xa6, 0x0c, 0x45, 0x7e, 0x11, 0x54, 0xa9, 0x29, 0xdf, 0x16, 0xda, 0x0c,
-	0xd9, 0x25, 0xda, 0x2d, 0xa4, 0xa7, 0x4e, 0xfb, 0x7c, 0x86, 0x7e, 0xe0, 0x20, 0xee, 0x5e, 0xe2,
-	0xc0, 0xe3, 0x39, 0x55, 0xf4, 0x03, 0x8f, 0xe7, 0x74, 0x55, 0x17, 0x6e, 0xbc, 0x98, 0xbd, 0xe1,
-	0x94, 0x2b, 0x67, 0x6f, 0xdc, 0x7a, 0x00, 0x5a, 0xdb, 0x21, 0x70, 0x70, 0x9c, 0xa2, 0x17, 0x82,
-	0xe3, 0x2b, 0xf8, 0x21, 0x38, 0xfe, 0x2a, 0x3e, 0x07, 0x0e, 0x76, 0x08, 0x7e, 0xe5, 0x60, 0xc5,
-	0x57, 0x83, 0xd1, 0xd6, 0x5b, 0xe2, 0x79, 0x8b, 0x6a, 0xb6, 0x70, 0xb8, 0x21, 0xa3, 0xfb, 0x20,
-	0x8c, 0xee, 0x82, 0x4d, 0xb7, 0x11, 0x4a, 0x27, 0xd1, 0x8a, 0xca, 0x18, 0x3d, 0x65, 0x38, 0x84,
-	0x71, 0xb6, 0xf0, 0x87, 0x30, 0x06, 0x54, 0xf4, 0x39, 0x18, 0x9d, 0x0d, 0x75, 0x18, 0xe5, 0x0f,
-	0x7f, 0x7f, 0x9d, 0xe3, 0x5e, 0xbe, 0xce, 0x71, 0x7f, 0xbf, 0xce, 0x71, 0x3f, 0xbf, 0xc9, 0x2d,
-	0xbc, 0x7c, 0x93, 0x5b, 0xf8, 0xf3, 0x4d, 0x6e, 0xe1, 0xb3, 0xcd, 0x8e, 0x46, 0xba, 0x07, 0x4d,
-	0xb1, 0x65, 0xf4, 0x5d, 0x27, 0x93, 0x30, 0xee, 0x1f, 0x53, 0xab, 0xb9, 0x44, 0xff, 0x7b, 0x5e,
-	0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x02, 0x7f, 0xb9, 0x08, 0x93, 0x0f, 0x00, 0x00,
+	// 1356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x6f, 0x13, 0xc7,
+	0x1b, 0xce, 0x38, 0xff, 0x9c, 0xd7, 0x09, 0x31, 0x93, 0x04, 0x9c, 0x05, 0x1c, 0xb3, 0x90, 0xc4,
+	0x58, 0xca, 0x2e, 0x04, 0xf8, 0xf1, 0x53, 0xd4, 0xaa, 0xca, 0xc6, 0x26, 0x0d, 0x14, 0x82, 0xd6,
+	0xa9, 0x2a, 0x50, 0x25, 0x6b, 0x6d, 0x0f, 0xce, 0x16, 0x7b, 0xc7, 0x78, 0x26, 0x68, 0x23, 0x8a,
+	0x5a, 0xf5, 0xd2, 0x1e, 0x5a, 0xb5, 0x52, 0x3f, 0x41, 0x7b, 0xee, 0x91, 0x0f, 0x51, 0x71, 0x42,
+	0xf4, 0x52, 0xf5, 0x54, 0x41, 0xd5, 0x43, 0x3f, 0x45, 0xb5, 0xb3, 0xe3, 0xff, 0xbb, 0x76, 0x92,
+	0x5e, 0xac, 0x9d, 0x99, 0x67, 0x9e, 0x79, 0xde, 0x67, 0x66, 0xde, 0x77, 0x0c, 0x4b, 0x25, 0xca,
+	0x6a, 0x94, 0xe9, 0xdc, 0xd5, 0x9f, 0x5d, 0x2b, 0x12, 0x6e, 0x5d, 0xd3, 0x19, 0x69, 0x3c, 0xb3,
+	0x4b, 0x44, 0xab, 0x37, 0x28, 0xa7, 0xf8, 0xb4, 0x0f, 0xd0, 0xb8, 0xab, 0x49, 0x80, 0x72, 0xbe,
+	0x42, 0x69, 0xa5, 0x4a, 0x74, 0xab, 0x6e, 0xeb, 0x96, 0xe3, 0x50, 0x6e, 0x71, 0x9b, 0x3a, 0xcc,
+	0x9f, 0xa0, 0x5c, 0x92, 0x8c, 0x45, 0x8b, 0x11, 0xdd, 0x2a, 0x96, 0xec, 0x16, 0xb1, 0xd7, 0x90,
+	0xa0, 0x4c, 0x28, 0xa8, 0x62, 0xb1, 0x42, 0xbd, 0x41, 0x1f, 0xdb, 0x55, 0xa9, 0x40, 0x51, 0xfa,
+	0x25, 0x72, 0x37, 0x88, 0xe7, 0xe9, 0x01, 0x69, 0x1c, 0xb6, 0x30, 0x75, 0xab, 0x62, 0x3b, 0x42,
+	0x99, 0xc4, 0x9e, 0xe7, 0xc4, 0x29, 0x93, 0x46, 0xcd, 0x76, 0xb8, 0xce, 0x0f, 0xeb, 0x84, 0xe9,
+	0xc5, 0x2a, 0x2d, 0x3d, 0x09, 0x1d, 0x15, 0xbf, 0x72, 0x74, 0xd1, 0x5f, 0xa7, 0x20, 0x5a, 0xba,
+	0xdf, 0xf0, 0x87, 0xd4, 0x6f, 0x22, 0x80, 0xb7, 0x09, 0xdf, 0x73, 0x59, 0xee, 0x19, 0x71, 0xb8,
+	0x49, 0x9e, 0x1e, 0x10, 0xc6, 0xb1, 0x02, 0x13, 0xc4, 0x6b, 0xb3, 0x04, 0x4a, 0x8d, 0xa6, 0xa7,
+	0x8c, 0x48, 0x02, 0x99, 0xb2, 0x07, 0xdf, 0x01, 0x68, 0xab, 0x4b, 0x44, 0x52, 0x28, 0x1d, 0x5b,
+	0x5f, 0xd1, 0x24, 0xab, 0x17, 0x8a, 0x26, 0x42, 0x69, 0x1a, 0xae, 0x3d, 0xb0, 0x2a, 0x44, 0xf2,
+	0x0a, 0x9e, 0x8e, 0xd9, 0xf8, 0x26, 0x44, 0x69, 0xa3, 0x4c, 0x1a, 0x85, 0xe2, 0x61, 0x62, 0x34,
+	0x85, 0xd2, 0xa7, 0xd6, 0x15, 0xad, 0x6f, 0xcb, 0xb4, 0x5d, 0x0f, 0x62, 0x1c, 0x9a, 0x93, 0xd4,
+	0xff, 0xc0, 0x18, 0xc6, 0xea, 0x56, 0x85, 0x24, 0xc6, 0x52, 0x28, 0x3d, 0x66, 0x8a, 0x6f, 0x3c,
+	0x0f, 0xe3, 0x55, 0xbb, 0x66, 0xf3, 0xc4, 0xb8, 0xe8, 0xf4, 0x1b, 0xf8, 0x0a, 0x8c, 0x0b, 0x35,
+	0x89, 0x89, 0x14, 0x4a, 0x4f, 0x19, 0x73, 0x7f, 0xbc, 0x5c, 0x9b, 0xf5, 0x17, 0x58, 0x63, 0xe5,
+	0x27, 0xa9, 0xab, 0xda, 0xcd, 0xab, 0xa6, 0x8f, 0x50, 0xff, 0x41, 0x30, 0xd7, 0x65, 0x05, 0xab,
+	0x53, 0x87, 0x11, 0xbc, 0x0a, 0xa3, 0xdc, 0xf5, 0x8d, 0x88, 0xad, 0x2f, 0x04, 0xc8, 0xdb, 0x73,
+	0x4d, 0x0f, 0x81, 0xb7, 0x61, 0x9a, 0xbb, 0x85, 0x86, 0x9c, 0xc7, 0x12, 0x11, 0x31, 0xe3, 0x72,
+	0x97, 0x35, 0xe2, 0x14, 0x75, 0x4c, 0x94, 0x60, 0x33, 0xc6, 0x5b, 0xdf, 0x0c, 0xdf, 0xed, 0x72,
+	0x78, 0x54, 0x38, 0xbc, 0x3a, 0xd4, 0x61, 0x7f, 0x76, 0x9f, 0xc5, 0xf3, 0x30, 0xce, 0x29, 0xb7,
+	0xaa, 0xd2, 0x2c, 0xbf, 0xa1, 0x12, 0xc0, 0x46, 0x83, 0x5a, 0xe5, 0x92, 0xc5, 0xf8, 0x9e, 0x2b,
+	0xb7, 0x07, 0x2f, 0x42, 0x94, 0xbb, 0x85, 0xe2, 0x21, 0x27, 0x5e, 0xbc, 0x28, 0x3d, 0x6d, 0x4e,
+	0x72, 0xd7, 0xf0, 0x9a, 0xf8, 0x06, 0x8c, 0xd5, 0x68, 0x99, 0x88, 0xfd, 0x3e, 0xb5, 0x9e, 0x0a,
+	0xb0, 0xa1, 0xc5, 0x77, 0x8f, 0x96, 0x89, 0x29, 0xd0, 0xea, 0xa7, 0x30, 0xd7, 0xb5, 0x8c, 0xb4,
+	0x34, 0x07, 0xb1, 0x0e, 0xa7, 0xc4, 0x52, 0x47, 0x35, 0x0a, 0xda, 0x46, 0xa9, 0x3f, 0x23, 0x98,
+	0xcd, 0xdb, 0xb5, 0x83, 0xaa, 0xc5, 0x9b, 0x27, 0x0c, 0x5f, 0x81, 0x08, 0x77, 0x25, 0x63, 0xf0,
+	0x66, 0x09, 0x87, 0x22, 0xdc, 0xc5, 0x5a, 0x47, 0xb4, 0x5e, 0x58, 0xd3, 0x01, 0xc7, 0xe3, 0xc6,
+	0xf5, 0x4e, 0x0b, 0x62, 0x1d, 0xf7, 0x5b, 0xec, 0x4b, 0x34, 0xe8, 0x44, 0xfd, 0xcf, 0x84, 0x8a,
+	0xc5, 0x1e, 0xf8, 0x30, 0xf5, 0x6f, 0x04, 0xf1, 0xb6, 0x48, 0x69, 0xc0, 0x7b, 0x10, 0xf5, 0xa8,
+	0x6c, 0xe7, 0x31, 0x95, 0x5a, 0x2f, 0x86, 0x47, 0xbf, 0x6d, 0xb1, 0x1d, 0xe7, 0x31, 0x35, 0x27,
+	0x2b, 0xfe, 0x07, 0xfe, 0x3f, 0x4c, 0x34, 0x08, 0x3b, 0xa8, 0x72, 0x79, 0xfb, 0x52, 0xe1, 0x73,
+	0x4d, 0x81, 0x33, 0x25, 0x1e, 0x3f, 0xea, 0x0f, 0x61, 0xa0, 0xf1, 0xdb, 0xad, 0x38, 0x86, 0x07,
+	0xaa, 0xc2, 0xb4, 0xb8, 0x3e, 0xcd, 0x9d, 0xc0, 0x30, 0xb6, 0x6f, 0xb1, 0x7d, 0x11, 0xdf, 0x94,
+	0x29, 0xbe, 0xd5, 0x17, 0x30, 0x23, 0x31, 0xd2, 0x88, 0xe5, 0xa1, 0xdb, 0x25, 0xb6, 0xaa, 0xe7,
+	0xc0, 0x44, 0x4e, 0x78, 0x60, 0xbe, 0x47, 0x70, 0x66, 0x9b, 0x70, 0xc3, 0xcb, 0x9c, 0x9f, 0xd8,
+	0x7c, 0x7f, 0xcf, 0x65, 0x4d, 0xb5, 0x67, 0x60, 0x62, 0x9f, 0xd8, 0x95, 0x7d, 0x2e, 0xc4, 0x8c,
+	0x9a, 0xb2, 0x85, 0x6f, 0x9f, 0x3c, 0xdb, 0x75, 0x5e, 0xc3, 0x8d, 0x85, 0x37, 0x2f, 0xd7, 0x4e,
+	0x77, 0x1f, 0xad, 0x9b, 0xda, 0xba, 0xfa, 0x75, 0x04, 0xce, 0xf6, 0x29, 0x3a, 0x6e, 0xe2, 0xb9,
+	0x01, 0x51, 0x51, 0x0c, 0x0a, 0x76, 0x59, 0x2a, 0x5c, 0xd4, 0xda, 0x05, 0x41, 0xf3, 0x4b, 0x81,
+	0x58, 0x62, 0x27, 0x6b, 0x4e, 0x0a, 0xe8, 0x4e, 0x19, 0xaf, 0xc1, 0xb8, 0xf8, 0x94, 0xa7, 0xe0,
+	0x6c, 0xc8, 0x14, 0xd3, 0x47, 0xe1, 0xed, 0x2e, 0x23, 0xc6, 0x8e, 0x95, 0x94, 0x8e, 0xe2, 0xc4,
+	0x26, 0xcc, 0xee, 0xb9, 0x59, 0x52, 0xa2, 0xe5, 0xa6, 0x7f, 0x03, 0xd2, 0xd1, 0xc6, 0xdc, 0x9b,
+	0xbe, 0x9b, 0x7a, 0x4b, 0xbd, 0x0f, 0xf1, 0x36, 0xc5, 0xb1, 0x0e, 0x58, 0x30, 0xdf, 0x3d, 0x4f,
+	0x52, 0xce, 0xe9, 0x94, 0xf4, 0x5f, 0xe8, 0x0c, 0x88, 0xb7, 0xe9, 0xa4, 0xbc, 0xe3, 0x86, 0x78,
+	0x07, 0xe6, 0x9b, 0x1c, 0x9b, 0x35, 0xdb, 0xa1, 0x4d, 0x5d, 0x17, 0x00, 0x2c, 0xaf, 0x5d, 0xf8,
+	0x8c, 0x51, 0x47, 0x5e, 0xb9, 0x29, 0xd1, 0x73, 0x87, 0x51, 0x27, 0x98, 0x6b, 0x17, 0x16, 0x7a,
+	0xb8, 0xa4, 0xa8, 0x8b, 0x30, 0xed, 0x93, 0x15, 0x6d, 0xc7, 0x6a, 0x1c, 0x4a, 0x61, 0x31, 0xd1,
+	0x67, 0x88, 0xae, 0x30, 0xff, 0xe7, 0x9b, 0xfe, 0x77, 0x89, 0x3b, 0x29, 0xdf, 0x5d, 0x58, 0xe8,
+	0xe1, 0x93, 0x02, 0x4f, 0x10, 0x6d, 0xe6, 0x43, 0x98, 0x94, 0xef, 0x08, 0x9c, 0x80, 0xf9, 0x5d,
+	0x33, 0x9b, 0x33, 0x0b, 0xc6, 0xc3, 0xc2, 0xc7, 0xf7, 0xf3, 0x0f, 0x72, 0x5b, 0x3b, 0xb7, 0x77,
+	0x72, 0xd9, 0xf8, 0x08, 0x8e, 0xc3, 0x74, 0x6b, 0x64, 0x33, 0xbf, 0x15, 0x47, 0xf8, 0x34, 0xcc,
+	0xb4, 0x7a, 0xb2, 0xb9, 0xfc, 0x56, 0x3c, 0x92, 0xf9, 0x12, 0xc1, 0x4c, 0x57, 0xb1, 0xc3, 0x49,
+	0x50, 0x0c, 0x73, 0x77, 0x33, 0xbb, 0xb5, 0x99, 0xdf, 0x2b, 0xdc, 0xdb, 0xcd, 0xe6, 0x7a, 0x68,
+	0xcf, 0xc3, 0x7c, 0xcf, 0xb8, 0xf1, 0xd1, 0xee, 0xd6, 0xdd, 0x38, 0x52, 0x22, 0x51, 0x84, 0xcf,
+	0xc2, 0x5c, 0xcf, 0x68, 0xfe, 0xe1, 0xfd, 0xad, 0x78, 0xc4, 0xd3, 0xd9, 0x33, 0xb0, 0x29, 0x46,
+	0x46, 0xd7, 0xbf, 0x03, 0x98, 0xcc, 0xfb, 0x2f, 0x5d, 0xfc, 0x1c, 0xa2, 0xcd, 0xfa, 0x82, 0xd5,
+	0x80, 0x23, 0xd9, 0x53, 0x21, 0x95, 0x4b, 0x03, 0x31, 0x32, 0x53, 0xae, 0x7c, 0xf5, 0xdb, 0x5f,
+	0x3f, 0x46, 0x52, 0xea, 0x39, 0x3d, 0xe0, 0x89, 0x2d, 0xc1, 0x1b, 0x28, 0x83, 0x9f, 0xc2, 0xb8,
+	0x48, 0xe8, 0x78, 0x29, 0x80, 0xb5, 0xb3, 0x1c, 0x28, 0xa9, 0x70, 0x80, 0x5c, 0x73, 0x59, 0xac,
+	0xb9, 0x84, 0x2f, 0xe8, 0x41, 0x6f, 0x66, 0xa6, 0x3f, 0xf7, 0x4a, 0xc8, 0x0b, 0xfc, 0x05, 0xc4,
+	0x3a, 0xde, 0x14, 0x78, 0x79, 0xd0, 0x53, 0xa4, 0xbd, 0xfc, 0xca, 0x30, 0x98, 0x14, 0x71, 0x51,
+	0x88, 0x38, 0xb7, 0x81, 0x32, 0xea, 0x99, 0x60, 0x1d, 0xf8, 0x73, 0x88, 0x75, 0xbc, 0x13, 0x03,
+	0x05, 0xf4, 0x3f, 0xa9, 0x95, 0x95, 0x61, 0x30, 0x29, 0x20, 0x29, 0x04, 0x24, 0x70, 0xd8, 0xea,
+	0xbf, 0x20, 0x98, 0xed, 0xa9, 0x18, 0xf8, 0x4a, 0x30, 0x77, 0x40, 0x9d, 0x53, 0x32, 0x47, 0x81,
+	0x4a, 0x29, 0xc6, 0xab, 0xa0, 0x4c, 0x2d, 0xf4, 0xad, 0xe2, 0xe5, 0x90, 0x5d, 0x12, 0xd5, 0x42,
+	0x7f, 0xee, 0x97, 0xcf, 0x17, 0xf8, 0x5b, 0x04, 0xd1, 0xe6, 0x25, 0x0e, 0x3c, 0x9e, 0x3d, 0x49,
+	0x5f, 0xb9, 0x34, 0x10, 0x23, 0x95, 0xdd, 0x7a, 0xd5, 0x7f, 0xc3, 0x85, 0xae, 0xa4, 0xb7, 0x71,
+	0x8b, 0x01, 0xd2, 0xca, 0xbe, 0x02, 0x5f, 0x4e, 0xce, 0x19, 0x20, 0x27, 0xe7, 0x0c, 0x97, 0x93,
+	0x73, 0x8e, 0x28, 0x27, 0x50, 0x0b, 0x11, 0xb3, 0xbd, 0xeb, 0xf3, 0x13, 0x82, 0x99, 0xae, 0x1c,
+	0x8c, 0x57, 0x07, 0xac, 0xd7, 0x99, 0x54, 0x95, 0xf4, 0x70, 0xa0, 0x54, 0xf7, 0x7e, 0x98, 0xba,
+	0xcb, 0x9e, 0x59, 0x4b, 0xa1, 0x02, 0x75, 0x91, 0x51, 0xa5, 0xc6, 0x2c, 0x19, 0xa6, 0x31, 0x4b,
+	0x8e, 0xa8, 0x31, 0x4b, 0x8e, 0xa3, 0x51, 0x5d, 0x0a, 0xdd, 0x4d, 0x5f, 0xe0, 0x06, 0xca, 0x18,
+	0x1f, 0xfc, 0xfa, 0x36, 0x89, 0x5e, 0xbf, 0x4d, 0xa2, 0x3f, 0xdf, 0x26, 0xd1, 0x0f, 0xef, 0x92,
+	0x23, 0xaf, 0xdf, 0x25, 0x47, 0x7e, 0x7f, 0x97, 0x1c, 0x79, 0xb4, 0x5c, 0xb1, 0xf9, 0xfe, 0x41,
+	0x51, 0x2b, 0xd1, 0x5a, 0x93, 0xa7, 0xbd, 0x4c, 0xf3, 0xbf, 0xb2, 0x5b, 0x9c, 0x10, 0x7f, 0x87,
+	0xaf, 0xff, 0x3b, 0x00, 0xe2, 0x83, 0x06, 0x12, 0x52, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1820,6 +1842,16 @@ func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasProfile {
+		i--
+		if m.GasProfile {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TxBytes) > 0 {
 		i -= len(m.TxBytes)
 		copy(dAtA[i:], m.TxBytes)
@@ -1862,6 +1894,18 @@ func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasProfile != nil {
+		{
+			size, err := m.GasProfile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Result != nil {
 		{
 			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
@@ -2443,6 +2487,9 @@ func (m *SimulateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.GasProfile {
+		n += 2
+	}
 	return n
 }
 
@@ -2460,6 +2507,10 @@ func (m *SimulateResponse) Size() (n int) {
 		l = m.Result.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.GasProfile != nil {
+		l = m.GasProfile.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	return n
 }
 
@@ -3314,6 +3365,26 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 				m.TxBytes = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasProfile", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GasProfile = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -3436,6 +3507,42 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasProfile == nil {
+				m.GasProfile = &types.GasProfile{}
+			}
+			if err := m.GasProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -14,9 +14,12 @@ import (
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
 	AccountKeeper          AccountKeeper
+	AuthenticatorKeeper    AuthenticatorKeeper
+	AuthzKeeper            AuthzKeeper
 	BankKeeper             types.BankKeeper
 	ExtensionOptionChecker ExtensionOptionChecker
 	FeegrantKeeper         FeegrantKeeper
+	MsgFeeKeeper           MsgFeeKeeper
 	SignModeHandler        *txsigning.HandlerMap
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
@@ -49,7 +52,10 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewTxTimeoutHeightDecorator(),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		NewMsgFeeDecorator(options.MsgFeeKeeper), // MsgFeeDecorator must be called before DeductFeeDecorator
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		NewAuthenticatorDecorator(options.AccountKeeper, options.AuthenticatorKeeper, options.SignModeHandler, options.SigGasConsumer), // AuthenticatorDecorator must be called before SetPubKeyDecorator
+		NewSessionKeyDecorator(options.AccountKeeper, options.AuthzKeeper, options.FeegrantKeeper, options.SignModeHandler, options.SigGasConsumer),
 		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
@@ -0,0 +1,204 @@
+package ante
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	txsigning "github.com/cosmos/cosmos-sdk/x/tx/signing"
+)
+
+// AuthenticatorKeeper defines the expected keeper holding the authenticators
+// registered by the accounts.
+type AuthenticatorKeeper interface {
+	GetInitializedAuthenticators(ctx context.Context, account sdk.AccAddress) ([]authenticator.InitializedAuthenticator, error)
+}
+
+// AuthenticatedSigner is a signer of a transaction authenticated by one of
+// its authenticators.
+type AuthenticatedSigner struct {
+	// Authenticator is the authenticator which authenticated the signer.
+	Authenticator authenticator.InitializedAuthenticator
+	// Request is the authentication request of the signer.
+	Request authenticator.Request
+}
+
+type authenticatedSignersKey struct{}
+
+// AuthenticatedSignersFromContext returns the signers of the transaction being
+// executed which were authenticated by the AuthenticatorDecorator, e.g. for a
+// post handler to confirm the execution of the transaction with their
+// authenticators.
+func AuthenticatedSignersFromContext(ctx sdk.Context) []AuthenticatedSigner {
+	signers, _ := ctx.Value(authenticatedSignersKey{}).([]AuthenticatedSigner)
+	return signers
+}
+
+// isAuthenticatedSigner returns true if the signer was authenticated by the
+// AuthenticatorDecorator, in which case its public key is not used.
+func isAuthenticatedSigner(ctx sdk.Context, signer sdk.AccAddress) bool {
+	for _, s := range AuthenticatedSignersFromContext(ctx) {
+		if s.Request.Account.Equals(signer) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthenticatorDecorator authenticates the signers of a transaction which
+// registered authenticators, instead of their public key. A signer is
+// authenticated by the first of its authenticators which authenticates it,
+// and the authenticator then tracks the transaction. The public key of the
+// authenticated signers is neither set nor verified by the following
+// signature decorators, which still check their sequence.
+// CONTRACT: Tx must implement SigVerifiableTx interface
+// CONTRACT: AuthenticatorDecorator must be placed before the SetPubKeyDecorator
+type AuthenticatorDecorator struct {
+	ak              AccountKeeper
+	authk           AuthenticatorKeeper
+	signModeHandler *txsigning.HandlerMap
+	sigGasConsumer  SignatureVerificationGasConsumer
+}
+
+func NewAuthenticatorDecorator(
+	ak AccountKeeper, authk AuthenticatorKeeper, signModeHandler *txsigning.HandlerMap, sigGasConsumer SignatureVerificationGasConsumer,
+) AuthenticatorDecorator {
+	if sigGasConsumer == nil {
+		sigGasConsumer = DefaultSigVerificationGasConsumer
+	}
+
+	return AuthenticatorDecorator{
+		ak:              ak,
+		authk:           authk,
+		signModeHandler: signModeHandler,
+		sigGasConsumer:  sigGasConsumer,
+	}
+}
+
+func (ad AuthenticatorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ad.authk == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	if len(sigs) != len(signers) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	params := ad.ak.GetParams(ctx)
+
+	var authenticated []AuthenticatedSigner
+	for i, signer := range signers {
+		authenticators, err := ad.authk.GetInitializedAuthenticators(ctx, signer)
+		if err != nil {
+			return ctx, err
+		}
+		if len(authenticators) == 0 {
+			continue
+		}
+
+		acc, err := GetSignerAcc(ctx, ad.ak, signer)
+		if err != nil {
+			return ctx, err
+		}
+
+		request, err := newAuthenticatorRequest(ctx, ad.ak, sigTx, acc, sigs[i], params, ad.signModeHandler, ad.sigGasConsumer, simulate)
+		if err != nil {
+			return ctx, err
+		}
+
+		signerAuthenticated := false
+		var errs []error
+		for _, a := range authenticators {
+			req := request.WithAuthenticatorID(strconv.FormatUint(a.ID, 10))
+			if err := a.Authenticator.Authenticate(ctx, req); err != nil {
+				errs = append(errs, fmt.Errorf("authenticator %d: %w", a.ID, err))
+				continue
+			}
+
+			if err := a.Authenticator.Track(ctx, req); err != nil {
+				return ctx, err
+			}
+
+			authenticated = append(authenticated, AuthenticatedSigner{Authenticator: a, Request: req})
+			signerAuthenticated = true
+			break
+		}
+
+		if !signerAuthenticated {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+				"no authenticator of account %s authenticated the transaction: %s", request.SignerData.Address, errors.Join(errs...))
+		}
+	}
+
+	if len(authenticated) > 0 {
+		ctx = ctx.WithValue(authenticatedSignersKey{}, authenticated)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// newAuthenticatorRequest returns the request authenticating the signature of
+// the account signing the transaction.
+func newAuthenticatorRequest(
+	ctx sdk.Context, ak AccountKeeper, tx authsigning.Tx, acc sdk.AccountI, sig signing.SignatureV2, params types.Params,
+	signModeHandler *txsigning.HandlerMap, sigGasConsumer SignatureVerificationGasConsumer, simulate bool,
+) (authenticator.Request, error) {
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return authenticator.Request{}, fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+	}
+
+	addr, err := ak.AddressCodec().BytesToString(acc.GetAddress())
+	if err != nil {
+		return authenticator.Request{}, err
+	}
+
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+
+	return authenticator.Request{
+		Account:   acc.GetAddress(),
+		Msgs:      tx.GetMsgs(),
+		Fee:       tx.GetFee(),
+		Signature: sig,
+		SignerData: txsigning.SignerData{
+			Address:       addr,
+			ChainID:       ctx.ChainID(),
+			AccountNumber: accNum,
+			Sequence:      sig.Sequence,
+		},
+		TxData:          adaptableTx.GetSigningTxData(),
+		SignModeHandler: signModeHandler,
+		Simulate:        simulate,
+		ConsumeSigVerificationGas: func(pubKey cryptotypes.PubKey, data signing.SignatureData) error {
+			return sigGasConsumer(ctx.GasMeter(), signing.SignatureV2{PubKey: pubKey, Data: data, Sequence: sig.Sequence}, params)
+		},
+	}, nil
+}
@@ -0,0 +1,93 @@
+package ante_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestAuthenticatorDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	sessionPriv, sessionPub, _ := testdata.KeyTestPubAddr()
+	otherPriv, _, _ := testdata.KeyTestPubAddr()
+
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	pubKeyConfig, err := suite.encCfg.Codec.MarshalInterfaceJSON(sessionPub)
+	require.NoError(t, err)
+	config, err := json.Marshal([]authenticator.SubAuthenticatorConfig{
+		{Type: authenticator.SignatureVerificationType, Config: pubKeyConfig},
+		{Type: authenticator.MessageFilterType, Config: []byte(`["` + sdk.MsgTypeURL(&testdata.TestMsg{}) + `"]`)},
+	})
+	require.NoError(t, err)
+
+	signModeHandler := suite.clientCtx.TxConfig.SignModeHandler()
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewAuthenticatorDecorator(suite.accountKeeper, suite.accountKeeper, signModeHandler, nil),
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigGasConsumeDecorator(suite.accountKeeper, nil),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, signModeHandler),
+		ante.NewIncrementSequenceDecorator(suite.accountKeeper),
+	)
+	posthandler := sdk.ChainPostDecorators(posthandler.NewAuthenticatorDecorator())
+
+	createTx := func(priv cryptotypes.PrivKey, seq uint64, msgs ...sdk.Msg) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{seq}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+
+	// without authenticators, the key of the session is not the key of the
+	// account
+	_, err = antehandler(suite.ctx, createTx(sessionPriv, 0, testdata.NewTestMsg(addr)), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+
+	_, err = suite.accountKeeper.AddAuthenticator(suite.ctx, addr, authenticator.AllOfType, config)
+	require.NoError(t, err)
+
+	ctx, err := antehandler(suite.ctx, createTx(sessionPriv, 0, testdata.NewTestMsg(addr)), false)
+	require.NoError(t, err)
+	signers := ante.AuthenticatedSignersFromContext(ctx)
+	require.Len(t, signers, 1)
+	require.Equal(t, addr, signers[0].Request.Account)
+	_, err = posthandler(ctx, nil, false, true)
+	require.NoError(t, err)
+
+	// the public key of the account is not set, while its sequence is
+	// incremented
+	acc = suite.accountKeeper.GetAccount(suite.ctx, addr)
+	require.Nil(t, acc.GetPubKey())
+	require.Equal(t, uint64(1), acc.GetSequence())
+
+	// wrong sequence
+	_, err = antehandler(suite.ctx, createTx(sessionPriv, 0, testdata.NewTestMsg(addr)), false)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+
+	// signed by another key
+	_, err = antehandler(suite.ctx, createTx(otherPriv, 1, testdata.NewTestMsg(addr)), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// message not allowed by the filter
+	msg := banktypes.NewMsgSend(addr, addr, testdata.NewTestFeeAmount())
+	_, err = antehandler(suite.ctx, createTx(sessionPriv, 1, msg), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
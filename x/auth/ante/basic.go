@@ -136,7 +136,7 @@ func (cgts ConsumeTxSizeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 
 			// use stdsignature to mock the size of a full signature
 			simSig := legacytx.StdSignature{ //nolint:staticcheck // SA1019: legacytx.StdSignature is deprecated
-				Signature: simSecp256k1Sig[:],
+				Signature: simSignature(pubkey),
 				PubKey:    pubkey,
 			}
 
@@ -144,8 +144,9 @@ func (cgts ConsumeTxSizeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 			cost := storetypes.Gas(len(sigBz) + 6)
 
 			// If the pubkey is a multi-signature pubkey, then we estimate for the maximum
-			// number of signers.
-			if _, ok := pubkey.(*multisig.LegacyAminoPubKey); ok {
+			// number of signers, unless its placeholder already holds the signatures of
+			// its required signers because some of its keys have larger signatures.
+			if pk, ok := pubkey.(*multisig.LegacyAminoPubKey); ok && !hasLargeSimSignatures(pk) {
 				cost *= params.TxSigLimit
 			}
 
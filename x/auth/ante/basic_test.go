@@ -7,6 +7,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mldsa65"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
@@ -15,6 +19,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
 func TestValidateBasic(t *testing.T) {
@@ -178,6 +183,147 @@ func TestConsumeGasForTxSize(t *testing.T) {
 	}
 }
 
+func TestConsumeGasForTxSizeMlDsa65(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+
+	// an account with a large post-quantum public key and signature
+	priv, err := mldsa65.GenPrivKey()
+	require.NoError(t, err)
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetPubKey(priv.PubKey()))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{&priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewConsumeGasForTxSizeDecorator(suite.accountKeeper))
+	ctx := suite.ctx.WithTxBytes(txBytes).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	expectedGas := ctx.GasMeter().GasConsumed()
+
+	// simulation must account for the size of the missing ML-DSA-65 signature
+	txBuilder, err := suite.clientCtx.TxConfig.WrapTxBuilder(tx)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{PubKey: priv.PubKey()}))
+	simTxBytes, err := suite.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	simCtx := suite.ctx.WithTxBytes(simTxBytes).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = antehandler(simCtx, txBuilder.GetTx(), true)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, simCtx.GasMeter().GasConsumed(), expectedGas)
+}
+
+func TestConsumeGasForTxSizeMultisig(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+
+	// a multisig mixing a post-quantum key with classical keys, signed by the
+	// post-quantum key and one classical key
+	privMlDsa65, err := mldsa65.GenPrivKey()
+	require.NoError(t, err)
+	privs := []cryptotypes.PrivKey{&privMlDsa65, secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys := make([]cryptotypes.PubKey, len(privs))
+	for i, priv := range privs {
+		pubKeys[i] = priv.PubKey()
+	}
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	addr := sdk.AccAddress(multisigKey.Address())
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetPubKey(multisigKey))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	multisigData := multisig.NewMultisig(len(pubKeys))
+	for _, priv := range privs[:2] {
+		sig, err := priv.Sign([]byte("sign bytes"))
+		require.NoError(t, err)
+		require.NoError(t, multisig.AddSignatureV2(multisigData, signing.SignatureV2{
+			PubKey: priv.PubKey(),
+			Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: sig},
+		}, pubKeys))
+	}
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{PubKey: multisigKey, Data: multisigData}))
+	tx := suite.txBuilder.GetTx()
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewConsumeGasForTxSizeDecorator(suite.accountKeeper))
+	ctx := suite.ctx.WithTxBytes(txBytes).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	expectedGas := ctx.GasMeter().GasConsumed()
+
+	// simulation accounts for the signatures of the required signers
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{PubKey: multisigKey, Data: multisig.NewMultisig(len(pubKeys))}))
+	simTx := suite.txBuilder.GetTx()
+	simTxBytes, err := suite.clientCtx.TxConfig.TxEncoder()(simTx)
+	require.NoError(t, err)
+
+	simCtx := suite.ctx.WithTxBytes(simTxBytes).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = antehandler(simCtx, simTx, true)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, simCtx.GasMeter().GasConsumed(), expectedGas)
+
+	// but not for more signatures than required, e.g. a second ML-DSA-65 signature
+	mlDsa65Sig, err := privMlDsa65.Sign([]byte("sign bytes"))
+	require.NoError(t, err)
+	params := suite.accountKeeper.GetParams(suite.ctx)
+	mlDsa65SigGas := params.TxSizeCostPerByte * storetypes.Gas(len(mlDsa65Sig))
+	require.Less(t, simCtx.GasMeter().GasConsumed(), expectedGas+mlDsa65SigGas)
+}
+
+func TestConsumeGasForTxSizeLegacyMultisig(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+
+	// a multisig of classical keys keeps the legacy estimate of TxSigLimit
+	// secp256k1 placeholder signatures
+	pubKeys := []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()}
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	addr := sdk.AccAddress(multisigKey.Address())
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetPubKey(multisigKey))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{PubKey: multisigKey, Data: multisig.NewMultisig(len(pubKeys))}))
+	simTx := suite.txBuilder.GetTx()
+	simTxBytes, err := suite.clientCtx.TxConfig.TxEncoder()(simTx)
+	require.NoError(t, err)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewConsumeGasForTxSizeDecorator(suite.accountKeeper))
+	simulate := func(txSigLimit uint64) storetypes.Gas {
+		params := suite.accountKeeper.GetParams(suite.ctx)
+		params.TxSigLimit = txSigLimit
+		require.NoError(t, suite.accountKeeper.Params.Set(suite.ctx, params))
+
+		simCtx := suite.ctx.WithTxBytes(simTxBytes).WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err = antehandler(simCtx, simTx, true)
+		require.NoError(t, err)
+		return simCtx.GasMeter().GasConsumed()
+	}
+
+	params := suite.accountKeeper.GetParams(suite.ctx)
+	simSig := legacytx.StdSignature{Signature: make([]byte, 64), PubKey: multisigKey} //nolint:staticcheck // SA1019: legacytx.StdSignature is deprecated
+	sigGas := params.TxSizeCostPerByte * storetypes.Gas(len(legacy.Cdc.MustMarshal(simSig))+6)
+	require.Equal(t, 6*sigGas, simulate(7)-simulate(1))
+}
+
 func TestTxTimeoutHeightDecorator(t *testing.T) {
 	suite := SetupTestSuite(t, true)
 
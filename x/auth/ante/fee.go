@@ -83,23 +83,24 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 			return ctx, err
 		}
 	}
-	if err := dfd.checkDeductFee(ctx, tx, fee); err != nil {
+	deductedFee, err := dfd.checkDeductFee(ctx, tx, fee)
+	if err != nil {
 		return ctx, err
 	}
 
-	newCtx := ctx.WithPriority(priority)
+	newCtx := WithDeductedFee(ctx.WithPriority(priority), deductedFee)
 
 	return next(newCtx, tx, simulate)
 }
 
-func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
+func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) (DeductedFee, error) {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
-		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		return DeductedFee{}, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
 	if addr := dfd.accountKeeper.GetModuleAddress(dfd.feeRecipientModule); addr == nil {
-		return fmt.Errorf("fee recipient module account (%s) has not been set", dfd.feeRecipientModule)
+		return DeductedFee{}, fmt.Errorf("fee recipient module account (%s) has not been set", dfd.feeRecipientModule)
 	}
 
 	feePayer := feeTx.FeePayer()
@@ -112,11 +113,11 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 		feeGranterAddr := sdk.AccAddress(feeGranter)
 
 		if dfd.feegrantKeeper == nil {
-			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+			return DeductedFee{}, sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
 		} else if !bytes.Equal(feeGranterAddr, feePayer) {
 			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranterAddr, feePayer, fee, sdkTx.GetMsgs())
 			if err != nil {
-				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+				return DeductedFee{}, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
 			}
 		}
 
@@ -125,14 +126,14 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 
 	deductFeesFromAcc := dfd.accountKeeper.GetAccount(ctx, deductFeesFrom)
 	if deductFeesFromAcc == nil {
-		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+		return DeductedFee{}, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
 	}
 
 	// deduct the fees
 	if !fee.IsZero() {
 		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
 		if err != nil {
-			return err
+			return DeductedFee{}, err
 		}
 	}
 
@@ -145,7 +146,40 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	}
 	ctx.EventManager().EmitEvents(events)
 
-	return nil
+	return DeductedFee{
+		Amount:          fee,
+		Payer:           deductFeesFrom,
+		RecipientModule: dfd.feeRecipientModule,
+	}, nil
+}
+
+// DeductedFee describes the fee deducted by the DeductFeeDecorator.
+type DeductedFee struct {
+	// Amount is the deducted fee, which is the effective fee returned by the
+	// TxFeeChecker.
+	Amount sdk.Coins
+	// Payer is the account the fee was deducted from, i.e. the fee granter if
+	// any or the fee payer.
+	Payer sdk.AccAddress
+	// RecipientModule is the module account which received the fee.
+	RecipientModule string
+}
+
+type deductedFeeKey struct{}
+
+// WithDeductedFee returns a copy of the context holding the given deducted
+// fee. Custom fee decorators replacing the DeductFeeDecorator can use it for
+// post handlers relying on DeductedFeeFromContext to keep working.
+func WithDeductedFee(ctx sdk.Context, fee DeductedFee) sdk.Context {
+	return ctx.WithValue(deductedFeeKey{}, fee)
+}
+
+// DeductedFeeFromContext returns the fee deducted by the DeductFeeDecorator in
+// the ante handler chain of the transaction being executed, e.g. for a post
+// handler to refund part of it.
+func DeductedFeeFromContext(ctx sdk.Context) (DeductedFee, bool) {
+	fee, ok := ctx.Value(deductedFeeKey{}).(DeductedFee)
+	return fee, ok
 }
 
 // DeductFees deducts fees from the given account and sends them to the
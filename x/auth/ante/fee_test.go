@@ -191,8 +191,16 @@ func TestDeductFees_WithFeeRecipientModule(t *testing.T) {
 				gomock.Any(), accs[0].acc.GetAddress(), tc.expRecipient, feeAmount,
 			).Return(nil)
 
-			_, err = antehandler(s.ctx, tx, false)
+			newCtx, err := antehandler(s.ctx, tx, false)
 			require.NoError(t, err)
+
+			deductedFee, ok := ante.DeductedFeeFromContext(newCtx)
+			require.True(t, ok)
+			require.Equal(t, ante.DeductedFee{
+				Amount:          feeAmount,
+				Payer:           accs[0].acc.GetAddress(),
+				RecipientModule: tc.expRecipient,
+			}, deductedFee)
 		})
 	}
 }
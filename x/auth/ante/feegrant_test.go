@@ -172,9 +172,20 @@ func TestDeductFeesNoDelegation(t *testing.T) {
 			require.NoError(t, err)
 			bytesCtx := suite.ctx.WithTxBytes(txBytes)
 			require.NoError(t, err)
-			_, err = feeAnteHandler(bytesCtx, tx, false) // tests only feegrant ante
+			newCtx, err := feeAnteHandler(bytesCtx, tx, false) // tests only feegrant ante
 			if tc.valid {
 				require.NoError(t, err)
+
+				// the fee is deducted from the granter if any
+				expPayer := signer.acc.GetAddress()
+				if feeAcc != nil {
+					expPayer = feeAcc
+				}
+
+				deductedFee, ok := ante.DeductedFeeFromContext(newCtx)
+				require.True(t, ok)
+				require.Equal(t, expPayer, deductedFee.Payer)
+				require.Equal(t, fee, deductedFee.Amount)
 			} else {
 				testutil.AssertError(t, err, tc.err, tc.errMsg)
 			}
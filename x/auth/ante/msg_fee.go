@@ -0,0 +1,174 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// maxMsgExecDepth is the maximum nesting of messages executing other messages,
+// such as the authz MsgExec, the MsgFeeDecorator descends into.
+const maxMsgExecDepth = 8
+
+// MsgFeeKeeper defines the expected keeper holding the per message type gas
+// multipliers and minimum fees, and the consensus minimum gas prices.
+type MsgFeeKeeper interface {
+	GetParams(ctx context.Context) types.Params
+	GetMsgFee(ctx context.Context, msgTypeURL string) (types.MsgFee, error)
+}
+
+// msgExecutor is implemented by the messages executing other messages, such as
+// the authz MsgExec.
+type msgExecutor interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// MsgFeeDecorator applies the per message type fees configured by governance.
+// The tx fee must cover the sum of the minimum fees of its messages, and the
+// validator minimum gas prices are scaled by the highest gas multiplier of its
+// messages, so that the fee check of the DeductFeeDecorator applies the message
+// specific gas price. As the validator minimum gas prices are only checked in
+// CheckTx, the consensus minimum gas prices of the x/auth params, scaled by the
+// same multiplier, are checked in every execution mode. The messages executed
+// through an authz MsgExec are accounted for as well, up to a nesting depth of
+// 8. The required minimum fee and the scaled consensus minimum gas prices are
+// recorded in the context, available through RequiredMinFeeFromContext and
+// RequiredMinGasPricesFromContext, so that a fee refund cannot return them.
+// CONTRACT: Tx must implement FeeTx interface to use MsgFeeDecorator
+// CONTRACT: MsgFeeDecorator must be placed before the DeductFeeDecorator
+type MsgFeeDecorator struct {
+	mfk MsgFeeKeeper
+}
+
+func NewMsgFeeDecorator(mfk MsgFeeKeeper) MsgFeeDecorator {
+	return MsgFeeDecorator{
+		mfk: mfk,
+	}
+}
+
+func (mfd MsgFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if mfd.mfk == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	gasMultiplier, minFee, err := mfd.msgFees(ctx, tx.GetMsgs(), 0)
+	if err != nil {
+		return ctx, err
+	}
+
+	if !simulate && !feeTx.GetFee().IsAllGTE(minFee) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee,
+			"insufficient fees; got: %s required minimum fee for messages: %s", feeTx.GetFee(), minFee)
+	}
+
+	minGasPrices := mfd.mfk.GetParams(ctx).MinGasPrices.MulDec(gasMultiplier)
+	if !simulate && !minGasPrices.IsZero() {
+		requiredFees := make(sdk.Coins, len(minGasPrices))
+		glDec := math.LegacyNewDec(int64(feeTx.GetGas()))
+		for i, gp := range minGasPrices {
+			requiredFees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+		}
+
+		if !feeTx.GetFee().IsAnyGTE(requiredFees) {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee,
+				"insufficient fees; got: %s required by consensus minimum gas prices: %s", feeTx.GetFee(), requiredFees)
+		}
+	}
+
+	if !gasMultiplier.Equal(math.LegacyOneDec()) {
+		ctx = ctx.WithMinGasPrices(ctx.MinGasPrices().MulDec(gasMultiplier))
+	}
+
+	return next(WithRequiredMinGasPrices(WithRequiredMinFee(ctx, minFee), minGasPrices), tx, simulate)
+}
+
+// msgFees returns the highest gas multiplier and the sum of the minimum fees
+// of msgs, including the messages they execute, such as the messages nested in
+// authz MsgExec messages.
+func (mfd MsgFeeDecorator) msgFees(ctx sdk.Context, msgs []sdk.Msg, depth int) (math.LegacyDec, sdk.Coins, error) {
+	if depth > maxMsgExecDepth {
+		return math.LegacyDec{}, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "nested messages exceed the max nesting depth of %d", maxMsgExecDepth)
+	}
+
+	var (
+		gasMultiplier math.LegacyDec
+		minFee        = sdk.NewCoins()
+	)
+
+	for _, msg := range msgs {
+		msgFee, err := mfd.mfk.GetMsgFee(ctx, sdk.MsgTypeURL(msg))
+		if err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+
+		if gasMultiplier.IsNil() || msgFee.GasMultiplier.GT(gasMultiplier) {
+			gasMultiplier = msgFee.GasMultiplier
+		}
+		minFee = minFee.Add(msgFee.MinFee...)
+
+		if execMsg, ok := msg.(msgExecutor); ok {
+			nestedMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return math.LegacyDec{}, nil, err
+			}
+
+			nestedMultiplier, nestedMinFee, err := mfd.msgFees(ctx, nestedMsgs, depth+1)
+			if err != nil {
+				return math.LegacyDec{}, nil, err
+			}
+
+			if nestedMultiplier.GT(gasMultiplier) {
+				gasMultiplier = nestedMultiplier
+			}
+			minFee = minFee.Add(nestedMinFee...)
+		}
+	}
+
+	if gasMultiplier.IsNil() {
+		gasMultiplier = math.LegacyOneDec()
+	}
+
+	return gasMultiplier, minFee, nil
+}
+
+type requiredMinFeeKey struct{}
+
+// WithRequiredMinFee returns a copy of the context holding the minimum fee
+// required by the messages of the transaction being executed.
+func WithRequiredMinFee(ctx sdk.Context, minFee sdk.Coins) sdk.Context {
+	return ctx.WithValue(requiredMinFeeKey{}, minFee)
+}
+
+// RequiredMinFeeFromContext returns the minimum fee required by the messages of
+// the transaction being executed, as recorded by the MsgFeeDecorator.
+func RequiredMinFeeFromContext(ctx sdk.Context) sdk.Coins {
+	minFee, _ := ctx.Value(requiredMinFeeKey{}).(sdk.Coins)
+	return minFee
+}
+
+type requiredMinGasPricesKey struct{}
+
+// WithRequiredMinGasPrices returns a copy of the context holding the consensus
+// minimum gas prices, scaled by the gas multiplier, required by the messages of
+// the transaction being executed.
+func WithRequiredMinGasPrices(ctx sdk.Context, minGasPrices sdk.DecCoins) sdk.Context {
+	return ctx.WithValue(requiredMinGasPricesKey{}, minGasPrices)
+}
+
+// RequiredMinGasPricesFromContext returns the consensus minimum gas prices,
+// scaled by the gas multiplier, required by the messages of the transaction
+// being executed, as recorded by the MsgFeeDecorator.
+func RequiredMinGasPricesFromContext(ctx sdk.Context) sdk.DecCoins {
+	minGasPrices, _ := ctx.Value(requiredMinGasPricesKey{}).(sdk.DecCoins)
+	return minGasPrices
+}
@@ -0,0 +1,311 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// minGasPricesRecorder records the minimum gas prices seen by the next decorator.
+type minGasPricesRecorder struct {
+	minGasPrices *sdk.DecCoins
+}
+
+func (r minGasPricesRecorder) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*r.minGasPrices = ctx.MinGasPrices()
+	return next(ctx, tx, simulate)
+}
+
+// requiredMinFeeRecorder records the required minimum fee seen by the next
+// decorator.
+type requiredMinFeeRecorder struct {
+	minFee *sdk.Coins
+}
+
+func (r requiredMinFeeRecorder) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*r.minFee = ante.RequiredMinFeeFromContext(ctx)
+	return next(ctx, tx, simulate)
+}
+
+// requiredMinGasPricesRecorder records the required consensus minimum gas
+// prices seen by the next decorator.
+type requiredMinGasPricesRecorder struct {
+	minGasPrices *sdk.DecCoins
+}
+
+func (r requiredMinGasPricesRecorder) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*r.minGasPrices = ante.RequiredMinGasPricesFromContext(ctx)
+	return next(ctx, tx, simulate)
+}
+
+func TestMsgFeeDecorator(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	var minGasPrices sdk.DecCoins
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewMsgFeeDecorator(s.accountKeeper),
+		minGasPricesRecorder{&minGasPrices},
+	)
+
+	accs := s.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, s.txBuilder.SetMsgs(msg, msg))
+	s.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	gasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyNewDecWithPrec(2, 1)))
+	s.ctx = s.ctx.WithMinGasPrices(gasPrices)
+
+	// without configured fees the min gas prices are untouched
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, gasPrices, minGasPrices)
+
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	otherTypeURL := sdk.MsgTypeURL(&testdata.MsgCreateDog{})
+
+	testCases := []struct {
+		name         string
+		msgFees      []authtypes.MsgFee
+		simulate     bool
+		expErr       error
+		minGasPrices sdk.DecCoins
+	}{
+		{
+			name:         "gas multiplier scales min gas prices",
+			msgFees:      []authtypes.MsgFee{authtypes.NewMsgFee(msgTypeURL, math.LegacyNewDec(3), nil)},
+			minGasPrices: gasPrices.MulDec(math.LegacyNewDec(3)),
+		},
+		{
+			name:         "gas multiplier below one",
+			msgFees:      []authtypes.MsgFee{authtypes.NewMsgFee(msgTypeURL, math.LegacyNewDecWithPrec(5, 1), nil)},
+			minGasPrices: gasPrices.MulDec(math.LegacyNewDecWithPrec(5, 1)),
+		},
+		{
+			name:         "unrelated message type",
+			msgFees:      []authtypes.MsgFee{authtypes.NewMsgFee(otherTypeURL, math.LegacyNewDec(3), sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))},
+			minGasPrices: gasPrices,
+		},
+		{
+			name:         "min fee is charged per message",
+			msgFees:      []authtypes.MsgFee{authtypes.NewMsgFee(msgTypeURL, math.LegacyOneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 75)))},
+			minGasPrices: gasPrices,
+		},
+		{
+			name:    "insufficient min fee",
+			msgFees: []authtypes.MsgFee{authtypes.NewMsgFee(msgTypeURL, math.LegacyOneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 76)))},
+			expErr:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:    "min fee in a missing denom",
+			msgFees: []authtypes.MsgFee{authtypes.NewMsgFee(msgTypeURL, math.LegacyOneDec(), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))},
+			expErr:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:         "min fee is not enforced in simulation",
+			msgFees:      []authtypes.MsgFee{authtypes.NewMsgFee(msgTypeURL, math.LegacyOneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))},
+			simulate:     true,
+			minGasPrices: gasPrices,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, s.accountKeeper.SetMsgFees(s.ctx, tc.msgFees))
+			minGasPrices = nil
+
+			_, err := antehandler(s.ctx, tx, tc.simulate)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.minGasPrices, minGasPrices)
+		})
+	}
+}
+
+func TestMsgFeeDecoratorDeductFee(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewMsgFeeDecorator(s.accountKeeper),
+		ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil),
+	)
+
+	accs := s.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	s.txBuilder.SetGasLimit(100)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// 150atom covers 100 gas at 1atom but not at 2atom
+	s.ctx = s.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin("atom", math.NewInt(1))))
+	require.NoError(t, s.accountKeeper.SetMsgFees(s.ctx, []authtypes.MsgFee{
+		authtypes.NewMsgFee(sdk.MsgTypeURL(msg), math.LegacyNewDec(2), nil),
+	}))
+
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
+
+func TestMsgFeeDecoratorConsensusMinGasPrices(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	var minGasPrices sdk.DecCoins
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewMsgFeeDecorator(s.accountKeeper),
+		requiredMinGasPricesRecorder{&minGasPrices},
+	)
+
+	accs := s.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	s.txBuilder.SetGasLimit(100)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	consensusGasPrices := sdk.NewDecCoins(sdk.NewDecCoin("atom", math.NewInt(1)))
+	params := s.accountKeeper.GetParams(s.ctx)
+	params.MinGasPrices = consensusGasPrices
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	// the consensus minimum gas prices are enforced outside of CheckTx, where
+	// the validator minimum gas prices are not set
+	deliverCtx := s.ctx.WithIsCheckTx(false)
+
+	// 150atom covers 100 gas at 1atom but not at 2atom
+	_, err = antehandler(deliverCtx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, consensusGasPrices, minGasPrices)
+
+	require.NoError(t, s.accountKeeper.SetMsgFees(s.ctx, []authtypes.MsgFee{
+		authtypes.NewMsgFee(sdk.MsgTypeURL(msg), math.LegacyNewDec(2), nil),
+	}))
+	_, err = antehandler(deliverCtx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	_, err = antehandler(deliverCtx, tx, true)
+	require.NoError(t, err)
+	require.Equal(t, consensusGasPrices.MulDec(math.LegacyNewDec(2)), minGasPrices)
+}
+
+func TestMsgFeeDecoratorRequiredMinFee(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	var minFee sdk.Coins
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewMsgFeeDecorator(s.accountKeeper),
+		requiredMinFeeRecorder{&minFee},
+	)
+
+	accs := s.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, s.txBuilder.SetMsgs(msg, msg))
+	s.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// the summed minimum fee of the messages is recorded for the post handlers
+	require.NoError(t, s.accountKeeper.SetMsgFees(s.ctx, []authtypes.MsgFee{
+		authtypes.NewMsgFee(sdk.MsgTypeURL(msg), math.LegacyOneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 50))),
+	}))
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), minFee)
+}
+
+func TestMsgFeeDecoratorMsgExecDepth(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewMsgFeeDecorator(s.accountKeeper))
+
+	accs := s.CreateTestAccounts(1)
+	newTx := func(depth int) sdk.Tx {
+		var msg sdk.Msg = testdata.NewTestMsg(accs[0].acc.GetAddress())
+		for range depth {
+			execMsg := authz.NewMsgExec(accs[0].acc.GetAddress(), []sdk.Msg{msg})
+			msg = &execMsg
+		}
+		require.NoError(t, s.txBuilder.SetMsgs(msg))
+		s.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+		tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+
+	_, err := antehandler(s.ctx, newTx(8), false)
+	require.NoError(t, err)
+
+	_, err = antehandler(s.ctx, newTx(9), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestMsgFeeDecoratorMsgExec(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewMsgFeeDecorator(s.accountKeeper))
+
+	accs := s.CreateTestAccounts(2)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	execMsg := authz.NewMsgExec(accs[1].acc.GetAddress(), []sdk.Msg{msg})
+	require.NoError(t, s.txBuilder.SetMsgs(&execMsg))
+	s.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[1].priv}, []uint64{1}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// the minimum fee of a message executed through MsgExec applies
+	require.NoError(t, s.accountKeeper.SetMsgFees(s.ctx, []authtypes.MsgFee{
+		authtypes.NewMsgFee(sdk.MsgTypeURL(msg), math.LegacyOneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 151))),
+	}))
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// and so does its gas multiplier
+	var minGasPrices sdk.DecCoins
+	antehandler = sdk.ChainAnteDecorators(ante.NewMsgFeeDecorator(s.accountKeeper), minGasPricesRecorder{&minGasPrices})
+	gasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyNewDecWithPrec(2, 1)))
+	require.NoError(t, s.accountKeeper.SetMsgFees(s.ctx, []authtypes.MsgFee{
+		authtypes.NewMsgFee(sdk.MsgTypeURL(msg), math.LegacyNewDec(3), nil),
+	}))
+	_, err = antehandler(s.ctx.WithMinGasPrices(gasPrices), tx, false)
+	require.NoError(t, err)
+	require.Equal(t, gasPrices.MulDec(math.LegacyNewDec(3)), minGasPrices)
+}
@@ -0,0 +1,176 @@
+package ante
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	txsigning "github.com/cosmos/cosmos-sdk/x/tx/signing"
+)
+
+// AuthzKeeper defines the expected authz keeper, holding the grants of the
+// session keys.
+type AuthzKeeper interface {
+	IsSessionKey(ctx context.Context, sessionKey, granter sdk.AccAddress, msgType string) (bool, error)
+}
+
+// SessionKeyDecorator authenticates the signers of a transaction which signed
+// it with one of their session keys, granted through the x/authz
+// MsgGrantSessionKey, without the transaction wrapping its messages in a
+// MsgExec. A signer signed with a session key when its signature public key
+// neither derives its address nor is its account public key, and holds an
+// unexpired session key grant of the signer for every message of the
+// transaction. Generic authorizations granted through MsgGrant do not make a
+// session key. When the signer pays the fees of the transaction, they are
+// deducted from the fee budget of the session key, i.e. its x/feegrant
+// allowance. As for the signers authenticated by the AuthenticatorDecorator,
+// the public key of the signer is neither set nor verified by the following
+// signature decorators, which still check its sequence.
+// CONTRACT: Tx must implement SigVerifiableTx interface
+// CONTRACT: SessionKeyDecorator must be placed before the SetPubKeyDecorator
+type SessionKeyDecorator struct {
+	ak              AccountKeeper
+	authzKeeper     AuthzKeeper
+	feegrantKeeper  FeegrantKeeper
+	signModeHandler *txsigning.HandlerMap
+	sigGasConsumer  SignatureVerificationGasConsumer
+}
+
+func NewSessionKeyDecorator(
+	ak AccountKeeper, authzKeeper AuthzKeeper, feegrantKeeper FeegrantKeeper, signModeHandler *txsigning.HandlerMap,
+	sigGasConsumer SignatureVerificationGasConsumer,
+) SessionKeyDecorator {
+	if sigGasConsumer == nil {
+		sigGasConsumer = DefaultSigVerificationGasConsumer
+	}
+
+	return SessionKeyDecorator{
+		ak:              ak,
+		authzKeeper:     authzKeeper,
+		feegrantKeeper:  feegrantKeeper,
+		signModeHandler: signModeHandler,
+		sigGasConsumer:  sigGasConsumer,
+	}
+}
+
+func (skd SessionKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if skd.authzKeeper == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	if len(sigs) != len(signers) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	params := skd.ak.GetParams(ctx)
+
+	authenticated := AuthenticatedSignersFromContext(ctx)
+	for i, signer := range signers {
+		pubKey := sigs[i].PubKey
+		if pubKey == nil || bytes.Equal(pubKey.Address(), signer) || isAuthenticatedSigner(ctx, signer) {
+			continue
+		}
+
+		acc, err := GetSignerAcc(ctx, skd.ak, signer)
+		if err != nil {
+			return ctx, err
+		}
+
+		// the account public key may not derive its address once rotated
+		if accPubKey := acc.GetPubKey(); accPubKey != nil && accPubKey.Equals(pubKey) {
+			continue
+		}
+
+		sessionKey := sdk.AccAddress(pubKey.Address())
+		granted, err := skd.isGranted(ctx, sessionKey, signer, tx.GetMsgs())
+		if err != nil {
+			return ctx, err
+		}
+		if !granted {
+			// not a session key, rejected by the SetPubKeyDecorator
+			continue
+		}
+
+		request, err := newAuthenticatorRequest(ctx, skd.ak, sigTx, acc, sigs[i], params, skd.signModeHandler, skd.sigGasConsumer, simulate)
+		if err != nil {
+			return ctx, err
+		}
+
+		sv := authenticator.NewPubKeySignatureVerification(pubKey)
+		if err := sv.Authenticate(ctx, request); err != nil {
+			return ctx, err
+		}
+
+		// the fees paid by the granter, including through a fee granter set to
+		// the granter itself, are deducted from the fee budget of the session key
+		fee := sigTx.GetFee()
+		feeGranter := sigTx.FeeGranter()
+		if bytes.Equal(sigTx.FeePayer(), signer) && (feeGranter == nil || bytes.Equal(feeGranter, signer)) && !fee.IsZero() {
+			if skd.feegrantKeeper == nil {
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session key %s cannot pay fees", sessionKey)
+			}
+
+			if err := skd.feegrantKeeper.UseGrantedFees(ctx, signer, sessionKey, fee, tx.GetMsgs()); err != nil {
+				return ctx, errorsmod.Wrapf(err, "session key %s does not have enough fee budget", sessionKey)
+			}
+		}
+
+		authenticated = append(authenticated, AuthenticatedSigner{
+			Authenticator: authenticator.InitializedAuthenticator{Authenticator: sv},
+			Request:       request,
+		})
+	}
+
+	if len(authenticated) > 0 {
+		ctx = ctx.WithValue(authenticatedSignersKey{}, authenticated)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// isGranted returns true if the session key is granted by the granter all the
+// messages through MsgGrantSessionKey. It returns an error if it is only granted
+// some of them, and false if none of them.
+func (skd SessionKeyDecorator) isGranted(ctx sdk.Context, sessionKey, granter sdk.AccAddress, msgs []sdk.Msg) (bool, error) {
+	var missing []string
+	for _, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+
+		granted, err := skd.authzKeeper.IsSessionKey(ctx, sessionKey, granter, msgTypeURL)
+		if err != nil {
+			return false, err
+		}
+		if !granted {
+			missing = append(missing, msgTypeURL)
+		}
+	}
+
+	switch len(missing) {
+	case 0:
+		return true, nil
+	case len(msgs):
+		return false, nil
+	default:
+		return false, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session key %s is not granted %v by %s", sessionKey, missing, granter)
+	}
+}
@@ -0,0 +1,108 @@
+package ante_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type mockAuthzKeeper map[string]bool
+
+func (k mockAuthzKeeper) IsSessionKey(_ context.Context, sessionKey, granter sdk.AccAddress, msgType string) (bool, error) {
+	return k[sessionKey.String()+granter.String()+msgType], nil
+}
+
+func TestSessionKeyDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	granterPriv, granterPub, granter := testdata.KeyTestPubAddr()
+	sessionPriv, sessionPub, _ := testdata.KeyTestPubAddr()
+	sessionKey := sdk.AccAddress(sessionPub.Address())
+
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, granter)
+	require.NoError(t, acc.SetPubKey(granterPub))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	testMsgType := sdk.MsgTypeURL(&testdata.TestMsg{})
+	authzKeeper := mockAuthzKeeper{
+		sessionKey.String() + granter.String() + testMsgType: true,
+	}
+
+	signModeHandler := suite.clientCtx.TxConfig.SignModeHandler()
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSessionKeyDecorator(suite.accountKeeper, authzKeeper, suite.feeGrantKeeper, signModeHandler, nil),
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigGasConsumeDecorator(suite.accountKeeper, nil),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, signModeHandler),
+		ante.NewIncrementSequenceDecorator(suite.accountKeeper),
+	)
+
+	createTx := func(priv cryptotypes.PrivKey, seq uint64, msgs ...sdk.Msg) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{seq}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+
+	// the fees paid by the granter are deducted from the fee budget of the
+	// session key
+	tx := createTx(sessionPriv, 0, testdata.NewTestMsg(granter))
+	suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), granter, sessionKey, testdata.NewTestFeeAmount(), tx.GetMsgs()).Return(nil)
+	ctx, err := antehandler(suite.ctx, tx, false)
+	require.NoError(t, err)
+	signers := ante.AuthenticatedSignersFromContext(ctx)
+	require.Len(t, signers, 1)
+	require.Equal(t, granter, signers[0].Request.Account)
+
+	// the public key of the granter is kept, while its sequence is incremented
+	acc = suite.accountKeeper.GetAccount(suite.ctx, granter)
+	require.True(t, granterPub.Equals(acc.GetPubKey()))
+	require.Equal(t, uint64(1), acc.GetSequence())
+
+	// the granter can still sign with its own key
+	_, err = antehandler(suite.ctx, createTx(granterPriv, 1, testdata.NewTestMsg(granter)), false)
+	require.NoError(t, err)
+
+	// fee budget exhausted
+	tx = createTx(sessionPriv, 2, testdata.NewTestMsg(granter))
+	suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), granter, sessionKey, testdata.NewTestFeeAmount(), tx.GetMsgs()).Return(errors.New("fee limit exceeded"))
+	_, err = antehandler(suite.ctx, tx, false)
+	require.ErrorContains(t, err, "does not have enough fee budget")
+
+	send := banktypes.NewMsgSend(granter, granter, testdata.NewTestFeeAmount())
+
+	// setting the granter as fee granter does not bypass the fee budget
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(granter)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetFeeGranter(granter)
+	tx, err = suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{sessionPriv}, []uint64{acc.GetAccountNumber()}, []uint64{2}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), granter, sessionKey, testdata.NewTestFeeAmount(), tx.GetMsgs()).Return(errors.New("fee limit exceeded"))
+	_, err = antehandler(suite.ctx, tx, false)
+	require.ErrorContains(t, err, "does not have enough fee budget")
+
+	// some messages are not granted
+	_, err = antehandler(suite.ctx, createTx(sessionPriv, 2, testdata.NewTestMsg(granter), send), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// no message is granted, so the key is not a session key
+	_, err = antehandler(suite.ctx, createTx(sessionPriv, 2, send), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+}
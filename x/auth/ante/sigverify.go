@@ -5,18 +5,25 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	cmtbls12381 "github.com/cometbft/cometbft/crypto/bls12381"
+	cmtmldsa65 "github.com/cometbft/cometbft/crypto/mldsa65"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	errorsmod "cosmossdk.io/errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	bls12_381 "github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/mldsa65"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
@@ -33,6 +40,15 @@ var (
 	key                = make([]byte, secp256k1.PubKeySize)
 	simSecp256k1Pubkey = &secp256k1.PubKey{Key: key}
 	simSecp256k1Sig    [64]byte
+	simMlDsa65Sig      [cmtmldsa65.SignatureSize]byte
+	simBLS12381Sig     [cmtbls12381.SignatureLength]byte
+	// a WebAuthn assertion with the minimal authenticator data and client data,
+	// the origin of the client data being unknown
+	simWebAuthnSig, _ = (&webauthn.Signature{
+		AuthenticatorData: make([]byte, 37),
+		ClientDataJSON:    []byte(`{"type":"webauthn.get","challenge":"` + strings.Repeat("A", 43) + `","origin":""}`),
+		Signature:         make([]byte, 64),
+	}).Marshal()
 )
 
 func init() {
@@ -42,6 +58,59 @@ func init() {
 	simSecp256k1Pubkey.Key = key
 }
 
+// simSignature returns a placeholder signature of the size of the signatures of
+// pubKey, used to estimate gas consumption in simulation mode. For a multisig
+// holding keys with larger signatures, it is a multisignature holding the
+// placeholders of the threshold largest signatures of its keys. Other
+// multisigs use the secp256k1 placeholder, the tx size gas of which is
+// multiplied by TxSigLimit.
+func simSignature(pubKey cryptotypes.PubKey) []byte {
+	switch pubKey := pubKey.(type) {
+	case *mldsa65.PubKey:
+		return simMlDsa65Sig[:]
+	case *bls12_381.PubKey:
+		return simBLS12381Sig[:]
+	case *webauthn.PubKey:
+		return simWebAuthnSig
+	case multisig.PubKey:
+		if !hasLargeSimSignatures(pubKey) {
+			return simSecp256k1Sig[:]
+		}
+
+		sigs := make([][]byte, 0, len(pubKey.GetPubKeys()))
+		for _, pk := range pubKey.GetPubKeys() {
+			sigs = append(sigs, simSignature(pk))
+		}
+		slices.SortStableFunc(sigs, func(a, b []byte) int { return len(b) - len(a) })
+
+		multiSig := cryptotypes.MultiSignature{Signatures: sigs[:min(int(pubKey.GetThreshold()), len(sigs))]}
+		bz, err := multiSig.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		return bz
+	default:
+		return simSecp256k1Sig[:]
+	}
+}
+
+// hasLargeSimSignatures returns true if a key of the multisig pubKey, including
+// the keys of nested multisigs, has a placeholder signature larger than the
+// secp256k1 one.
+func hasLargeSimSignatures(pubKey multisig.PubKey) bool {
+	for _, pk := range pubKey.GetPubKeys() {
+		switch pk := pk.(type) {
+		case *mldsa65.PubKey, *bls12_381.PubKey, *webauthn.PubKey:
+			return true
+		case multisig.PubKey:
+			if hasLargeSimSignatures(pk) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // SignatureVerificationGasConsumer is the type of function that is used to both
 // consume gas when verifying signatures and also to accept or reject different types of pubkeys
 // This is where apps can define their own PubKey
@@ -89,6 +158,11 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			return sdk.Context{}, err
 		}
 
+		// the public key of the signers authenticated by their authenticators is not used
+		if isAuthenticatedSigner(ctx, signers[i]) {
+			continue
+		}
+
 		// PublicKey was omitted from slice since it has already been set in context
 		if pk == nil {
 			if !simulate {
@@ -96,16 +170,20 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && ctx.IsSigverifyTx() {
-			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signerStrs[i], i)
-		}
 
 		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
+
+		// Only make check if simulate=false. A rotated public key does not
+		// derive the signer address, but is accepted when it is the one stored
+		// in the account.
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && ctx.IsSigverifyTx() &&
+			(acc.GetPubKey() == nil || !acc.GetPubKey().Equals(pk)) {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", signerStrs[i], i)
+		}
 		// account already has pubkey set, no need to reset
 		if acc.GetPubKey() != nil {
 			continue
@@ -199,6 +277,11 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	}
 
 	for i, sig := range sigs {
+		// the authenticators of the authenticated signers consume their own gas
+		if isAuthenticatedSigner(ctx, signers[i]) {
+			continue
+		}
+
 		signerAcc, err := GetSignerAcc(ctx, sgcd.ak, signers[i])
 		if err != nil {
 			return ctx, err
@@ -295,12 +378,29 @@ func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.Ha
 // explicitly set, and we should skip the explicit verification of sig.Sequence
 // in the SigVerificationDecorator's AnteHandler function.
 func OnlyLegacyAminoSigners(sigData signing.SignatureData) bool {
+	return onlySignModes(sigData, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+}
+
+// onlyAminoJSONSignDocSigners checks SignatureData to see if all signers are
+// using a sign mode whose sign document is built like the
+// SIGN_MODE_LEGACY_AMINO_JSON one, i.e. SIGN_MODE_LEGACY_AMINO_JSON,
+// SIGN_MODE_EIP_191 or SIGN_MODE_EIP_712. Their account sequence is only
+// verified as part of the signature.
+func onlyAminoJSONSignDocSigners(sigData signing.SignatureData) bool {
+	return onlySignModes(sigData,
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signing.SignMode_SIGN_MODE_EIP_191,
+		signing.SignMode_SIGN_MODE_EIP_712,
+	)
+}
+
+func onlySignModes(sigData signing.SignatureData, modes ...signing.SignMode) bool {
 	switch v := sigData.(type) {
 	case *signing.SingleSignatureData:
-		return v.SignMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+		return slices.Contains(modes, v.SignMode)
 	case *signing.MultiSignatureData:
 		for _, s := range v.Signatures {
-			if !OnlyLegacyAminoSigners(s) {
+			if !onlySignModes(s, modes...) {
 				return false
 			}
 		}
@@ -350,6 +450,9 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 	}
 
+	// signers using SIGN_MODE_BLS_AGGREGATE are verified at once after the other signers
+	var blsAggregate blsAggregateSigners
+
 	for i, sig := range sigs {
 		if sig.Sequence > 0 && isUnordered {
 			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sequence is not allowed for unordered transactions")
@@ -359,9 +462,13 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return ctx, err
 		}
 
+		// the signature of the signers authenticated by their authenticators is
+		// already verified
+		authenticated := isAuthenticatedSigner(ctx, signers[i])
+
 		// retrieve pubkey
 		pubKey := acc.GetPubKey()
-		if !simulate && pubKey == nil {
+		if !simulate && !authenticated && pubKey == nil {
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
@@ -384,7 +491,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 
 		// no need to verify signatures on recheck tx
-		if !simulate && !ctx.IsReCheckTx() && ctx.IsSigverifyTx() {
+		if !simulate && !authenticated && !ctx.IsReCheckTx() && ctx.IsSigverifyTx() {
 			anyPk, _ := codectypes.NewAnyWithValue(pubKey)
 
 			signerData := txsigning.SignerData{
@@ -402,11 +509,17 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 				return ctx, fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
 			}
 			txData := adaptableTx.GetSigningTxData()
+			if isBLSAggregate(sig.Data) {
+				if err := blsAggregate.add(pubKey, sig.Data.(*signing.SingleSignatureData), signerData, txData); err != nil {
+					return ctx, err
+				}
+				continue
+			}
 			err = authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
 			if err != nil {
 				var errMsg string
-				if OnlyLegacyAminoSigners(sig.Data) {
-					// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
+				if onlyAminoJSONSignDocSigners(sig.Data) {
+					// If all signers are using SIGN_MODE_LEGACY_AMINO or a sign mode derived from it, we rely on VerifySignature to check account sequence number,
 					// and therefore communicate sequence number as a potential cause of error.
 					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
 				} else {
@@ -417,9 +530,78 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 	}
 
+	if err := blsAggregate.verify(ctx, svd.signModeHandler); err != nil {
+		return ctx, err
+	}
+
 	return next(ctx, tx, simulate)
 }
 
+// isBLSAggregate returns true if the signature data is a single signature using
+// SIGN_MODE_BLS_AGGREGATE.
+func isBLSAggregate(sigData signing.SignatureData) bool {
+	data, ok := sigData.(*signing.SingleSignatureData)
+	return ok && data.SignMode == signing.SignMode_SIGN_MODE_BLS_AGGREGATE
+}
+
+// blsAggregateSigners collects the signers of a transaction using
+// SIGN_MODE_BLS_AGGREGATE. Each of them signs its own sign bytes, and the
+// aggregate of their signatures is set as the signature of the first of them,
+// the signatures of the others being empty.
+type blsAggregateSigners struct {
+	pubKeys    []*bls12_381.PubKey
+	signature  []byte
+	signerData []txsigning.SignerData
+	txData     txsigning.TxData
+}
+
+// add adds a signer to the aggregate.
+func (a *blsAggregateSigners) add(
+	pubKey cryptotypes.PubKey, sig *signing.SingleSignatureData, signerData txsigning.SignerData, txData txsigning.TxData,
+) error {
+	blsPubKey, ok := pubKey.(*bls12_381.PubKey)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "%s requires a bls12_381 public key, got %T", sig.SignMode, pubKey)
+	}
+
+	if len(a.pubKeys) == 0 {
+		if len(sig.Signature) == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "the first signer using %s must carry the aggregate signature", sig.SignMode)
+		}
+		a.signature = sig.Signature
+		a.txData = txData
+	} else if len(sig.Signature) != 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only the first signer using %s can carry a signature", sig.SignMode)
+	}
+
+	a.pubKeys = append(a.pubKeys, blsPubKey)
+	a.signerData = append(a.signerData, signerData)
+	return nil
+}
+
+// verify verifies the aggregate signature against the public keys and the sign
+// bytes of all the signers, if any.
+func (a *blsAggregateSigners) verify(ctx sdk.Context, handler *txsigning.HandlerMap) error {
+	if len(a.pubKeys) == 0 {
+		return nil
+	}
+
+	signBytes := make([][]byte, len(a.signerData))
+	for i, signerData := range a.signerData {
+		var err error
+		signBytes[i], err = handler.GetSignBytes(ctx, signingv1beta1.SignMode_SIGN_MODE_BLS_AGGREGATE, signerData, a.txData)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !bls12_381.VerifyAggregateSignature(a.pubKeys, signBytes, a.signature) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+			"aggregate signature verification failed for %d signers; please verify account numbers and chain-id (%s)", len(a.pubKeys), a.signerData[0].ChainID)
+	}
+	return nil
+}
+
 // verifyUnorderedNonce verifies the unordered nonce of an unordered transaction.
 // This checks that:
 // 1. The unordered transaction's timeout timestamp is set.
@@ -594,10 +776,24 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *webauthn.PubKey:
+		// the assertion is verified as a secp256r1 signature over the authenticator data and client data hash
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: webauthn")
+		return nil
+
 	case *mldsa65.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostMlDsa65, "ante verify: ml_dsa_65")
 		return nil
 
+	case *bls12_381.PubKey:
+		// the signers of an aggregate signature but the first carry no signature
+		if data, ok := sig.Data.(*signing.SingleSignatureData); ok && isBLSAggregate(data) && len(data.Signature) == 0 {
+			meter.ConsumeGas(params.SigVerifyCostBLS12381Aggregated(), "ante verify: bls12_381 aggregated")
+			return nil
+		}
+		meter.ConsumeGas(params.SigVerifyCostBLS12381(), "ante verify: bls12_381")
+		return nil
+
 	case multisig.PubKey:
 		multiSignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -0,0 +1,118 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	bls12_381 "github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func TestSigVerificationBLSAggregate(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	txConfig := authtx.NewTxConfig(suite.encCfg.Codec, []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_BLS_AGGREGATE})
+	antehandler := sdk.ChainAnteDecorators(ante.NewSigVerificationDecorator(suite.accountKeeper, txConfig.SignModeHandler()))
+
+	privs := make([]bls12_381.PrivKey, 4)
+	pubKeys := make([]*bls12_381.PubKey, len(privs))
+	addrs := make([]sdk.AccAddress, len(privs))
+	for i := range privs {
+		var err error
+		privs[i], err = bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		pubKeys[i] = privs[i].PubKey().(*bls12_381.PubKey)
+		addrs[i] = sdk.AccAddress(pubKeys[i].Address())
+
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addrs[i])
+		require.NoError(t, acc.SetPubKey(pubKeys[i]))
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+	}
+
+	// the transaction is signed by the first three accounts, the last one being
+	// an outsider
+	signers := 3
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addrs[:signers]...)))
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	setAggregate := func(aggregate []byte) sdk.Tx {
+		sigs := make([]signing.SignatureV2, signers)
+		for i := range sigs {
+			sigs[i] = signing.SignatureV2{
+				PubKey: pubKeys[i],
+				Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_BLS_AGGREGATE},
+			}
+		}
+		sigs[0].Data.(*signing.SingleSignatureData).Signature = aggregate
+		require.NoError(t, txBuilder.SetSignatures(sigs...))
+		return txBuilder.GetTx()
+	}
+
+	// the sign bytes of each account, holding its account number
+	signBytes := make([][]byte, len(privs))
+	for i, addr := range addrs {
+		acc := suite.accountKeeper.GetAccount(suite.ctx, addr)
+		var err error
+		signBytes[i], err = authsigning.GetSignBytesAdapter(suite.ctx, txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_BLS_AGGREGATE, authsigning.SignerData{
+			Address:       addr.String(),
+			ChainID:       suite.ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			PubKey:        pubKeys[i],
+		}, setAggregate(nil))
+		require.NoError(t, err)
+	}
+	require.NotEqual(t, signBytes[0], signBytes[1])
+
+	// aggregate returns the aggregate of the signatures of the sign bytes of
+	// the given accounts by the keys of the given accounts
+	aggregate := func(keys, msgs []int) []byte {
+		sigs := make([][]byte, len(keys))
+		for i := range keys {
+			var err error
+			sigs[i], err = privs[keys[i]].Sign(bls12_381.AggregateMessage(pubKeys[keys[i]], signBytes[msgs[i]]))
+			require.NoError(t, err)
+		}
+		sig, err := bls12_381.AggregateSignatures(sigs)
+		require.NoError(t, err)
+		return sig
+	}
+
+	valid := aggregate([]int{0, 1, 2}, []int{0, 1, 2})
+	tampered := append([]byte(nil), valid...)
+	tampered[len(tampered)-1] ^= 1
+
+	testCases := []struct {
+		name      string
+		aggregate []byte
+		expErr    error
+	}{
+		{"valid aggregate", valid, nil},
+		{"missing signer", aggregate([]int{0, 1}, []int{0, 1}), sdkerrors.ErrUnauthorized},
+		{"outsider instead of a signer", aggregate([]int{0, 1, 3}, []int{0, 1, 2}), sdkerrors.ErrUnauthorized},
+		{"extra signer", aggregate([]int{0, 1, 2, 3}, []int{0, 1, 2, 3}), sdkerrors.ErrUnauthorized},
+		{"sign bytes of another account number", aggregate([]int{0, 1, 2}, []int{1, 0, 2}), sdkerrors.ErrUnauthorized},
+		{"tampered aggregate", tampered, sdkerrors.ErrUnauthorized},
+		{"missing aggregate", nil, sdkerrors.ErrUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := antehandler(suite.ctx, setAggregate(tc.aggregate), false)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"testing"
 	"time"
@@ -9,11 +10,13 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	bls12_381 "github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/mldsa65"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
@@ -26,6 +29,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	txmodule "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -102,6 +106,40 @@ func TestSetPubKey_UnorderedNoEvents(t *testing.T) {
 	}
 }
 
+// TestSetPubKey_RotatedPubKey tests that an account whose public key was
+// rotated can sign with a public key which does not derive its address.
+func TestSetPubKey_RotatedPubKey(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	rotatedPriv, rotatedPub, _ := testdata.KeyTestPubAddr()
+	otherPriv, _, _ := testdata.KeyTestPubAddr()
+
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetPubKey(rotatedPub))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+	)
+
+	tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{rotatedPriv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, err = antehandler(suite.ctx, tx, false)
+	require.NoError(t, err)
+
+	// a key which is neither the rotated key nor derives the address is rejected
+	tx, err = suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{otherPriv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, err = antehandler(suite.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+}
+
 func TestSetPubKey_SignerInfoCountMismatch(t *testing.T) {
 	suite := SetupTestSuite(t, true)
 
@@ -172,7 +210,11 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		{"PubKeyEd25519", args{storetypes.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, false},
 		{"PubKeySecp256k1", args{storetypes.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{storetypes.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeyWebAuthn", args{storetypes.NewInfiniteGasMeter(), nil, newWebAuthnAuthenticator(t).PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
 		{"PubKeyMlDsa65", args{storetypes.NewInfiniteGasMeter(), nil, skMlDsa65.PubKey(), params}, p.SigVerifyCostMlDsa65, false},
+		{"PubKeyBLS12381", args{storetypes.NewInfiniteGasMeter(), nil, &bls12_381.PubKey{}, params}, p.SigVerifyCostBLS12381(), false},
+		{"PubKeyBLS12381 aggregate", args{storetypes.NewInfiniteGasMeter(), blsAggregateSig([]byte{1}), &bls12_381.PubKey{}, params}, p.SigVerifyCostBLS12381(), false},
+		{"PubKeyBLS12381 aggregated", args{storetypes.NewInfiniteGasMeter(), blsAggregateSig(nil), &bls12_381.PubKey{}, params}, p.SigVerifyCostBLS12381Aggregated(), false},
 		{"Multisig", args{storetypes.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{storetypes.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -193,6 +235,10 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}
 }
 
+func blsAggregateSig(sig []byte) *signing.SingleSignatureData {
+	return &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_BLS_AGGREGATE, Signature: sig}
+}
+
 func TestConsumeMultisignatureVerificationGasMalformedBitArray(t *testing.T) {
 	params := types.DefaultParams()
 	pkSet, _ := generatePubKeysAndSignatures(3, []byte{1, 2, 3, 4}, false)
@@ -222,9 +268,16 @@ func TestSigVerification(t *testing.T) {
 	suite := SetupTestSuite(t, true)
 	suite.txBankKeeper.EXPECT().DenomMetadata(gomock.Any(), gomock.Any()).Return(&banktypes.QueryDenomMetadataResponse{}, nil).AnyTimes()
 
-	enabledSignModes := []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}
+	enabledSignModes := []signing.SignMode{
+		signing.SignMode_SIGN_MODE_DIRECT,
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signing.SignMode_SIGN_MODE_EIP_191,
+		signing.SignMode_SIGN_MODE_EIP_712,
+		signing.SignMode_SIGN_MODE_CLEAR_SIGNING,
+	}
 	txConfigOpts := authtx.ConfigOptions{
-		EnabledSignModes: enabledSignModes,
+		EnabledSignModes:             enabledSignModes,
+		ClearSignCoinMetadataQueryFn: txmodule.NewBankKeeperCoinMetadataQueryFn(suite.txBankKeeper),
 	}
 	var err error
 	suite.clientCtx.TxConfig, err = authtx.NewTxConfigWithOptions(
@@ -260,7 +313,8 @@ func TestSigVerification(t *testing.T) {
 
 	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
 	txConfigOpts = authtx.ConfigOptions{
-		EnabledSignModes: enabledSignModes,
+		EnabledSignModes:             enabledSignModes,
+		ClearSignCoinMetadataQueryFn: txmodule.NewBankKeeperCoinMetadataQueryFn(suite.txBankKeeper),
 	}
 	anteTxConfig, err := authtx.NewTxConfigWithOptions(
 		codec.NewProtoCodec(suite.encCfg.InterfaceRegistry),
@@ -356,6 +410,56 @@ func TestSigIntegration(t *testing.T) {
 	require.Equal(t, initialSigCost*uint64(len(privs)), doubleCost-initialCost)
 }
 
+// webAuthnAuthenticator is a PrivKey signing as a passkey authenticator does,
+// with the sign bytes hash as the challenge.
+type webAuthnAuthenticator struct {
+	*secp256r1.PrivKey
+
+	challenge func(msg []byte) string
+}
+
+func newWebAuthnAuthenticator(t *testing.T) webAuthnAuthenticator {
+	t.Helper()
+	sk, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	return webAuthnAuthenticator{PrivKey: sk, challenge: webauthn.Challenge}
+}
+
+func (a webAuthnAuthenticator) PubKey() cryptotypes.PubKey {
+	return &webauthn.PubKey{Key: a.PrivKey.PubKey().(*secp256r1.PubKey)}
+}
+
+func (a webAuthnAuthenticator) Sign(msg []byte) ([]byte, error) {
+	rpIDHash := sha256.Sum256([]byte("wallet.example.com"))
+	authData := append(rpIDHash[:], 0x05, 0, 0, 0, 1)
+	clientData := fmt.Appendf(nil, `{"type":"webauthn.get","challenge":%q,"origin":"https://wallet.example.com"}`, a.challenge(msg))
+	sig, err := a.PrivKey.Sign(webauthn.SignedData(authData, clientData))
+	if err != nil {
+		return nil, err
+	}
+	return (&webauthn.Signature{AuthenticatorData: authData, ClientDataJSON: clientData, Signature: sig}).Marshal()
+}
+
+func TestWebAuthnSigVerification(t *testing.T) {
+	params := types.DefaultParams()
+
+	privs := []cryptotypes.PrivKey{newWebAuthnAuthenticator(t), newWebAuthnAuthenticator(t)}
+	initialSigCost := params.SigVerifyCostSecp256r1()
+	initialCost, err := runSigDecorators(t, params, false, privs...)
+	require.NoError(t, err)
+
+	params.SigVerifyCostSecp256k1 *= 2
+	doubleCost, err := runSigDecorators(t, params, false, privs...)
+	require.NoError(t, err)
+	require.Equal(t, initialSigCost*uint64(len(privs)), doubleCost-initialCost)
+
+	// the challenge must be the hash of the sign bytes of the transaction
+	wrongChallenge := newWebAuthnAuthenticator(t)
+	wrongChallenge.challenge = func([]byte) string { return webauthn.Challenge([]byte("other sign bytes")) }
+	_, err = runSigDecorators(t, params, false, wrongChallenge)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
 func runSigDecorators(t *testing.T, params types.Params, _ bool, privs ...cryptotypes.PrivKey) (storetypes.Gas, error) {
 	t.Helper()
 
@@ -28,6 +28,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	antetestutil "github.com/cosmos/cosmos-sdk/x/auth/ante/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -79,9 +80,16 @@ func setupSuite(t *testing.T, isCheckTx, enableUnorderedTxs bool) *AnteTestSuite
 		"random":                 {"random"},
 	}
 
+	authenticatorManager := authenticator.NewManager()
 	suite.accountKeeper = keeper.NewAccountKeeper(
 		suite.encCfg.Codec, runtime.NewKVStoreService(key), types.ProtoBaseAccount, maccPerms, authcodec.NewBech32Codec("cosmos"),
 		sdk.Bech32MainPrefix, types.NewModuleAddress("gov").String(), keeper.WithUnorderedTransactions(enableUnorderedTxs),
+		keeper.WithAuthenticatorManager(authenticatorManager),
+	)
+	authenticatorManager.Register(
+		authenticator.NewSignatureVerification(suite.encCfg.Codec),
+		authenticator.NewMessageFilter(),
+		authenticator.NewAllOf(authenticatorManager),
 	)
 	suite.accountKeeper.GetModuleAccount(suite.ctx, types.FeeCollectorName)
 	err := suite.accountKeeper.Params.Set(suite.ctx, types.DefaultParams())
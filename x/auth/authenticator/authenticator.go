@@ -0,0 +1,102 @@
+// Package authenticator defines pluggable authenticators, which accounts can
+// register to authenticate their transactions instead of their public key,
+// e.g. to let another key sign for them, to limit their spending per period or
+// the message types they can send.
+package authenticator
+
+import (
+	"context"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	txsigning "github.com/cosmos/cosmos-sdk/x/tx/signing"
+)
+
+// Authenticator authenticates the signature of an account on a transaction.
+//
+// The ante handler calls Authenticate and, once the transaction is
+// authenticated, Track before the execution of its messages. The post handler
+// calls ConfirmExecution after their execution, which can reject the
+// transaction, reverting its messages, e.g. when a limit is exceeded.
+type Authenticator interface {
+	// Type returns the type of the authenticator, under which it is
+	// registered in the Manager.
+	Type() string
+
+	// Initialize returns a copy of the authenticator configured with config.
+	Initialize(config []byte) (Authenticator, error)
+
+	// VerifiesSignature returns true if the configured authenticator only
+	// authenticates requests carrying a valid signature. Only such
+	// authenticators can be registered by an account, so that its
+	// transactions are always signed.
+	VerifiesSignature() bool
+
+	// Authenticate returns an error if the request is not authenticated.
+	Authenticate(ctx sdk.Context, request Request) error
+
+	// Track is called once the request is authenticated, before the execution
+	// of the messages of the transaction.
+	Track(ctx sdk.Context, request Request) error
+
+	// ConfirmExecution is called after the execution of the messages of the
+	// transaction, and rejects it by returning an error.
+	ConfirmExecution(ctx sdk.Context, request Request) error
+}
+
+// Request is an authentication request of the signature of an account on a
+// transaction.
+type Request struct {
+	// Account is the address of the account being authenticated.
+	Account sdk.AccAddress
+	// AuthenticatorID is the identifier of the authenticator handling the
+	// request. The sub-authenticators of a composite authenticator have the
+	// identifier of their parent suffixed by their index, e.g. "3.0".
+	AuthenticatorID string
+	// Msgs are the messages of the transaction.
+	Msgs []sdk.Msg
+	// Fee is the fee of the transaction.
+	Fee sdk.Coins
+	// Signature is the signature of the account.
+	Signature signing.SignatureV2
+	// SignerData is the data of the signer, without its public key.
+	SignerData txsigning.SignerData
+	// TxData is the data of the transaction.
+	TxData txsigning.TxData
+	// SignModeHandler is used to get the sign bytes of the transaction.
+	SignModeHandler *txsigning.HandlerMap
+	// Simulate is true when the transaction is simulated, in which case it
+	// carries no valid signature.
+	Simulate bool
+	// ConsumeSigVerificationGas consumes the gas of verifying sig with pubKey.
+	ConsumeSigVerificationGas func(pubKey cryptotypes.PubKey, sig signing.SignatureData) error
+}
+
+// WithAuthenticatorID returns a copy of the request handled by the
+// authenticator with the given identifier.
+func (r Request) WithAuthenticatorID(id string) Request {
+	r.AuthenticatorID = id
+	return r
+}
+
+// InitializedAuthenticator is an authenticator registered by an account,
+// configured with its configuration.
+type InitializedAuthenticator struct {
+	// ID is the identifier of the authenticator.
+	ID uint64
+	// Authenticator is the configured authenticator.
+	Authenticator Authenticator
+}
+
+// DataStore stores the data of the authenticators of the accounts, e.g. the
+// amount spent over a period.
+type DataStore interface {
+	GetAuthenticatorData(ctx context.Context, account sdk.AccAddress, authenticatorID string) ([]byte, error)
+	SetAuthenticatorData(ctx context.Context, account sdk.AccAddress, authenticatorID string, data []byte) error
+}
+
+// BankKeeper defines the contract needed for the bank keeper.
+type BankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
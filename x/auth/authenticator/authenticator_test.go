@@ -0,0 +1,194 @@
+package authenticator_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (bk mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return bk.balances[addr.String()]
+}
+
+type mockDataStore struct {
+	data map[string][]byte
+}
+
+func (s mockDataStore) GetAuthenticatorData(_ context.Context, account sdk.AccAddress, authenticatorID string) ([]byte, error) {
+	return s.data[account.String()+"/"+authenticatorID], nil
+}
+
+func (s mockDataStore) SetAuthenticatorData(_ context.Context, account sdk.AccAddress, authenticatorID string, data []byte) error {
+	s.data[account.String()+"/"+authenticatorID] = data
+	return nil
+}
+
+type testSetup struct {
+	ctx        sdk.Context
+	manager    *authenticator.Manager
+	bankKeeper mockBankKeeper
+	store      mockDataStore
+}
+
+func setup(t *testing.T) testSetup {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx.
+		WithBlockHeader(cmtproto.Header{Time: time.Unix(1_000_000, 0)})
+
+	s := testSetup{
+		ctx:        ctx,
+		manager:    authenticator.NewManager(),
+		bankKeeper: mockBankKeeper{balances: map[string]sdk.Coins{}},
+		store:      mockDataStore{data: map[string][]byte{}},
+	}
+	s.manager.Register(
+		authenticator.NewSignatureVerification(encCfg.Codec),
+		authenticator.NewMessageFilter(),
+		authenticator.NewSpendLimit(encCfg.Codec, s.bankKeeper, s.store),
+		authenticator.NewAllOf(s.manager),
+		authenticator.NewAnyOf(s.manager),
+	)
+
+	return s
+}
+
+func mustJSON(t *testing.T, v any) []byte {
+	t.Helper()
+	bz, err := json.Marshal(v)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestManager(t *testing.T) {
+	s := setup(t)
+
+	_, ok := s.manager.Get(authenticator.MessageFilterType)
+	require.True(t, ok)
+
+	_, err := s.manager.Initialize("Unknown", nil)
+	require.ErrorContains(t, err, "unknown authenticator type Unknown")
+
+	require.Panics(t, func() { s.manager.Register(authenticator.NewMessageFilter()) })
+}
+
+func TestMessageFilter(t *testing.T) {
+	s := setup(t)
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	_, err := s.manager.Initialize(authenticator.MessageFilterType, []byte(`[]`))
+	require.ErrorContains(t, err, "requires at least one message type")
+	_, err = s.manager.Initialize(authenticator.MessageFilterType, []byte(`{`))
+	require.ErrorContains(t, err, "invalid MessageFilter config")
+
+	a, err := s.manager.Initialize(authenticator.MessageFilterType, mustJSON(t, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}))
+	require.NoError(t, err)
+	require.False(t, a.VerifiesSignature())
+
+	request := authenticator.Request{Account: addr, Msgs: []sdk.Msg{&banktypes.MsgSend{}}}
+	require.NoError(t, a.Authenticate(s.ctx, request))
+
+	request.Msgs = append(request.Msgs, &banktypes.MsgMultiSend{})
+	require.ErrorIs(t, a.Authenticate(s.ctx, request), sdkerrors.ErrUnauthorized)
+}
+
+func TestSpendLimit(t *testing.T) {
+	s := setup(t)
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	_, err := s.manager.Initialize(authenticator.SpendLimitType, mustJSON(t, authenticator.SpendLimitConfig{Period: "24h"}))
+	require.ErrorContains(t, err, "invalid SpendLimit limit")
+	_, err = s.manager.Initialize(authenticator.SpendLimitType, mustJSON(t, authenticator.SpendLimitConfig{Limit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), Period: "-1h"}))
+	require.ErrorContains(t, err, "period must be positive")
+
+	a, err := s.manager.Initialize(authenticator.SpendLimitType, mustJSON(t, authenticator.SpendLimitConfig{
+		Limit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Period: "24h",
+	}))
+	require.NoError(t, err)
+
+	request := authenticator.Request{Account: addr, AuthenticatorID: "1"}
+	spend := func(ctx sdk.Context, amount int64) error {
+		require.NoError(t, a.Authenticate(ctx, request))
+		require.NoError(t, a.Track(ctx, request))
+		balance := s.bankKeeper.balances[addr.String()]
+		s.bankKeeper.balances[addr.String()] = balance.Sub(sdk.NewInt64Coin("stake", amount))
+		return a.ConfirmExecution(ctx, request)
+	}
+
+	s.bankKeeper.balances[addr.String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("atom", 1000))
+
+	require.NoError(t, spend(s.ctx, 60))
+	require.NoError(t, spend(s.ctx, 40))
+	require.ErrorContains(t, spend(s.ctx, 1), "spend limit exceeded")
+
+	// the balance restored by the reverted transaction can be spent again in
+	// the next period
+	s.bankKeeper.balances[addr.String()] = s.bankKeeper.balances[addr.String()].Add(sdk.NewInt64Coin("stake", 1))
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(24 * time.Hour))
+	require.NoError(t, spend(ctx, 100))
+
+	// denoms out of the limit are not limited
+	require.NoError(t, a.Track(ctx, request))
+	s.bankKeeper.balances[addr.String()] = s.bankKeeper.balances[addr.String()].Sub(sdk.NewInt64Coin("atom", 500))
+	require.NoError(t, a.ConfirmExecution(ctx, request))
+}
+
+func TestComposites(t *testing.T) {
+	s := setup(t)
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	sendFilter := authenticator.SubAuthenticatorConfig{Type: authenticator.MessageFilterType, Config: mustJSON(t, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})}
+	multiSendFilter := authenticator.SubAuthenticatorConfig{Type: authenticator.MessageFilterType, Config: mustJSON(t, []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})})}
+	spendLimit := authenticator.SubAuthenticatorConfig{Type: authenticator.SpendLimitType, Config: mustJSON(t, authenticator.SpendLimitConfig{
+		Limit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Period: "24h",
+	})}
+
+	_, err := s.manager.Initialize(authenticator.AllOfType, mustJSON(t, []authenticator.SubAuthenticatorConfig{sendFilter}))
+	require.ErrorContains(t, err, "requires at least two sub-authenticators")
+	_, err = s.manager.Initialize(authenticator.AnyOfType, mustJSON(t, []authenticator.SubAuthenticatorConfig{sendFilter, {Type: "Unknown"}}))
+	require.ErrorContains(t, err, "invalid AnyOf sub-authenticator 1")
+
+	send := authenticator.Request{Account: addr, AuthenticatorID: "1", Msgs: []sdk.Msg{&banktypes.MsgSend{}}}
+	multiSend := authenticator.Request{Account: addr, AuthenticatorID: "1", Msgs: []sdk.Msg{&banktypes.MsgMultiSend{}}}
+
+	allOf, err := s.manager.Initialize(authenticator.AllOfType, mustJSON(t, []authenticator.SubAuthenticatorConfig{sendFilter, spendLimit}))
+	require.NoError(t, err)
+	require.NoError(t, allOf.Authenticate(s.ctx, send))
+	require.ErrorIs(t, allOf.Authenticate(s.ctx, multiSend), sdkerrors.ErrUnauthorized)
+
+	// the sub-authenticators track the request with their own identifier
+	require.NoError(t, allOf.Track(s.ctx, send))
+	require.Contains(t, s.store.data, fmt.Sprintf("%s/1.1", addr))
+
+	anyOf, err := s.manager.Initialize(authenticator.AnyOfType, mustJSON(t, []authenticator.SubAuthenticatorConfig{sendFilter, multiSendFilter}))
+	require.NoError(t, err)
+	require.False(t, anyOf.VerifiesSignature())
+	require.ErrorIs(t, anyOf.Track(s.ctx, send), sdkerrors.ErrUnauthorized)
+	require.NoError(t, anyOf.Authenticate(s.ctx, send))
+	require.NoError(t, anyOf.Authenticate(s.ctx, multiSend))
+	require.NoError(t, anyOf.Track(s.ctx, multiSend))
+	require.ErrorIs(t, anyOf.Authenticate(s.ctx, authenticator.Request{Account: addr, Msgs: []sdk.Msg{&banktypes.MsgUpdateParams{}}}), sdkerrors.ErrUnauthorized)
+}
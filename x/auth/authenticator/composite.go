@@ -0,0 +1,197 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// AllOfType is the type of the AllOf authenticator.
+	AllOfType = "AllOf"
+	// AnyOfType is the type of the AnyOf authenticator.
+	AnyOfType = "AnyOf"
+)
+
+var (
+	_ Authenticator = AllOf{}
+	_ Authenticator = &AnyOf{}
+)
+
+// SubAuthenticatorConfig is the configuration of a sub-authenticator of a
+// composite authenticator.
+type SubAuthenticatorConfig struct {
+	// Type is the type of the sub-authenticator.
+	Type string `json:"type"`
+	// Config is the configuration of the sub-authenticator.
+	Config json.RawMessage `json:"config"`
+}
+
+// initializeSubAuthenticators initializes the sub-authenticators configured by
+// the JSON list of SubAuthenticatorConfig config.
+func initializeSubAuthenticators(m *Manager, compositeType string, config []byte) ([]Authenticator, error) {
+	var configs []SubAuthenticatorConfig
+	if err := json.Unmarshal(config, &configs); err != nil {
+		return nil, fmt.Errorf("invalid %s config: %w", compositeType, err)
+	}
+
+	if len(configs) < 2 {
+		return nil, fmt.Errorf("%s requires at least two sub-authenticators", compositeType)
+	}
+
+	subs := make([]Authenticator, len(configs))
+	for i, cfg := range configs {
+		var err error
+		if subs[i], err = m.Initialize(cfg.Type, cfg.Config); err != nil {
+			return nil, fmt.Errorf("invalid %s sub-authenticator %d: %w", compositeType, i, err)
+		}
+	}
+
+	return subs, nil
+}
+
+// subRequest returns the request handled by the i-th sub-authenticator.
+func subRequest(request Request, i int) Request {
+	return request.WithAuthenticatorID(request.AuthenticatorID + "." + strconv.Itoa(i))
+}
+
+// AllOf authenticates the requests authenticated by all its sub-authenticators,
+// e.g. a signature by a key and a spend limit. Its configuration is the JSON
+// list of the SubAuthenticatorConfig of its sub-authenticators, e.g.
+// [{"type":"SignatureVerification","config":{...}},{"type":"MessageFilter","config":[...]}].
+type AllOf struct {
+	manager *Manager
+	subs    []Authenticator
+}
+
+// NewAllOf returns a new AllOf authenticator, initializing its
+// sub-authenticators with m.
+func NewAllOf(m *Manager) AllOf {
+	return AllOf{manager: m}
+}
+
+func (AllOf) Type() string { return AllOfType }
+
+func (a AllOf) Initialize(config []byte) (Authenticator, error) {
+	subs, err := initializeSubAuthenticators(a.manager, AllOfType, config)
+	if err != nil {
+		return nil, err
+	}
+
+	a.subs = subs
+	return a, nil
+}
+
+// VerifiesSignature returns true if any sub-authenticator verifies a
+// signature.
+func (a AllOf) VerifiesSignature() bool {
+	for _, sub := range a.subs {
+		if sub.VerifiesSignature() {
+			return true
+		}
+	}
+	return false
+}
+
+func (a AllOf) Authenticate(ctx sdk.Context, request Request) error {
+	for i, sub := range a.subs {
+		if err := sub.Authenticate(ctx, subRequest(request, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a AllOf) Track(ctx sdk.Context, request Request) error {
+	for i, sub := range a.subs {
+		if err := sub.Track(ctx, subRequest(request, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a AllOf) ConfirmExecution(ctx sdk.Context, request Request) error {
+	for i, sub := range a.subs {
+		if err := sub.ConfirmExecution(ctx, subRequest(request, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AnyOf authenticates the requests authenticated by any of its
+// sub-authenticators, e.g. a signature by one of several keys. Only the first
+// sub-authenticator authenticating a request tracks it and confirms its
+// execution. Its configuration is the JSON list of the SubAuthenticatorConfig
+// of its sub-authenticators.
+type AnyOf struct {
+	manager *Manager
+	subs    []Authenticator
+
+	// selected is the index of the sub-authenticator which authenticated the
+	// request, or -1.
+	selected int
+}
+
+// NewAnyOf returns a new AnyOf authenticator, initializing its
+// sub-authenticators with m.
+func NewAnyOf(m *Manager) *AnyOf {
+	return &AnyOf{manager: m, selected: -1}
+}
+
+func (*AnyOf) Type() string { return AnyOfType }
+
+func (a *AnyOf) Initialize(config []byte) (Authenticator, error) {
+	subs, err := initializeSubAuthenticators(a.manager, AnyOfType, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AnyOf{manager: a.manager, subs: subs, selected: -1}, nil
+}
+
+// VerifiesSignature returns true if all the sub-authenticators verify a
+// signature.
+func (a *AnyOf) VerifiesSignature() bool {
+	for _, sub := range a.subs {
+		if !sub.VerifiesSignature() {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *AnyOf) Authenticate(ctx sdk.Context, request Request) error {
+	var errs []error
+	for i, sub := range a.subs {
+		err := sub.Authenticate(ctx, subRequest(request, i))
+		if err == nil {
+			a.selected = i
+			return nil
+		}
+		errs = append(errs, err)
+	}
+
+	return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "no %s sub-authenticator authenticated the request: %s", AnyOfType, errors.Join(errs...))
+}
+
+func (a *AnyOf) Track(ctx sdk.Context, request Request) error {
+	if a.selected < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s did not authenticate the request", AnyOfType)
+	}
+	return a.subs[a.selected].Track(ctx, subRequest(request, a.selected))
+}
+
+func (a *AnyOf) ConfirmExecution(ctx sdk.Context, request Request) error {
+	if a.selected < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s did not authenticate the request", AnyOfType)
+	}
+	return a.subs[a.selected].ConfirmExecution(ctx, subRequest(request, a.selected))
+}
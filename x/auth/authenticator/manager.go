@@ -0,0 +1,44 @@
+package authenticator
+
+import (
+	"fmt"
+)
+
+// Manager is the registry of the authenticator types accounts can register.
+type Manager struct {
+	authenticators map[string]Authenticator
+}
+
+// NewManager returns a new Manager with the given authenticators registered.
+func NewManager(authenticators ...Authenticator) *Manager {
+	m := &Manager{authenticators: make(map[string]Authenticator)}
+	m.Register(authenticators...)
+	return m
+}
+
+// Register registers the given authenticators under their type. It panics if
+// an authenticator of the same type is already registered.
+func (m *Manager) Register(authenticators ...Authenticator) {
+	for _, a := range authenticators {
+		if _, ok := m.authenticators[a.Type()]; ok {
+			panic(fmt.Errorf("authenticator %s is already registered", a.Type()))
+		}
+		m.authenticators[a.Type()] = a
+	}
+}
+
+// Get returns the authenticator registered under the given type.
+func (m *Manager) Get(authenticatorType string) (Authenticator, bool) {
+	a, ok := m.authenticators[authenticatorType]
+	return a, ok
+}
+
+// Initialize returns the authenticator registered under the given type,
+// configured with config.
+func (m *Manager) Initialize(authenticatorType string, config []byte) (Authenticator, error) {
+	a, ok := m.Get(authenticatorType)
+	if !ok {
+		return nil, fmt.Errorf("unknown authenticator type %s", authenticatorType)
+	}
+	return a.Initialize(config)
+}
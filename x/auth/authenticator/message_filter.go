@@ -0,0 +1,63 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MessageFilterType is the type of the MessageFilter authenticator.
+const MessageFilterType = "MessageFilter"
+
+var _ Authenticator = MessageFilter{}
+
+// MessageFilter authenticates the requests whose messages all have one of the
+// allowed message types. It does not verify any signature, so it must be
+// composed with a SignatureVerification authenticator in an AllOf
+// authenticator. Its configuration is the JSON list of the allowed message
+// type URLs, e.g. ["/cosmos.bank.v1beta1.MsgSend"].
+type MessageFilter struct {
+	msgTypeURLs []string
+}
+
+// NewMessageFilter returns a new MessageFilter authenticator.
+func NewMessageFilter() MessageFilter {
+	return MessageFilter{}
+}
+
+func (MessageFilter) Type() string { return MessageFilterType }
+
+func (mf MessageFilter) Initialize(config []byte) (Authenticator, error) {
+	var msgTypeURLs []string
+	if err := json.Unmarshal(config, &msgTypeURLs); err != nil {
+		return nil, fmt.Errorf("invalid %s config: %w", MessageFilterType, err)
+	}
+
+	if len(msgTypeURLs) == 0 {
+		return nil, fmt.Errorf("%s requires at least one message type", MessageFilterType)
+	}
+
+	mf.msgTypeURLs = msgTypeURLs
+	return mf, nil
+}
+
+func (MessageFilter) VerifiesSignature() bool { return false }
+
+func (mf MessageFilter) Authenticate(_ sdk.Context, request Request) error {
+	for _, msg := range request.Msgs {
+		if msgTypeURL := sdk.MsgTypeURL(msg); !slices.Contains(mf.msgTypeURLs, msgTypeURL) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "message type %s is not allowed", msgTypeURL)
+		}
+	}
+
+	return nil
+}
+
+func (MessageFilter) Track(sdk.Context, Request) error { return nil }
+
+func (MessageFilter) ConfirmExecution(sdk.Context, Request) error { return nil }
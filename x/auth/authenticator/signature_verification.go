@@ -0,0 +1,91 @@
+package authenticator
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// SignatureVerificationType is the type of the SignatureVerification
+// authenticator.
+const SignatureVerificationType = "SignatureVerification"
+
+var _ Authenticator = SignatureVerification{}
+
+// SignatureVerification authenticates the requests signed by a public key,
+// which can be of any type, e.g. a multisig. Its configuration is the public
+// key encoded in JSON, e.g.
+// {"@type":"/cosmos.crypto.secp256k1.PubKey","key":"..."}.
+type SignatureVerification struct {
+	cdc    codec.Codec
+	pubKey cryptotypes.PubKey
+}
+
+// NewSignatureVerification returns a new SignatureVerification authenticator
+// decoding the public keys of its configurations with cdc.
+func NewSignatureVerification(cdc codec.Codec) SignatureVerification {
+	return SignatureVerification{cdc: cdc}
+}
+
+// NewPubKeySignatureVerification returns a new SignatureVerification
+// authenticator of the given public key, without configuration.
+func NewPubKeySignatureVerification(pubKey cryptotypes.PubKey) SignatureVerification {
+	return SignatureVerification{pubKey: pubKey}
+}
+
+func (SignatureVerification) Type() string { return SignatureVerificationType }
+
+func (sv SignatureVerification) Initialize(config []byte) (Authenticator, error) {
+	var pubKey cryptotypes.PubKey
+	if err := sv.cdc.UnmarshalInterfaceJSON(config, &pubKey); err != nil {
+		return nil, fmt.Errorf("invalid %s public key: %w", SignatureVerificationType, err)
+	}
+
+	sv.pubKey = pubKey
+	return sv, nil
+}
+
+func (SignatureVerification) VerifiesSignature() bool { return true }
+
+func (sv SignatureVerification) Authenticate(ctx sdk.Context, request Request) error {
+	if request.ConsumeSigVerificationGas != nil {
+		// simulated transactions carry no signature matching the public key
+		if err := request.ConsumeSigVerificationGas(sv.pubKey, request.Signature.Data); err != nil && !request.Simulate {
+			return err
+		}
+	}
+
+	if request.Simulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+		return nil
+	}
+
+	anyPk, err := codectypes.NewAnyWithValue(sv.pubKey)
+	if err != nil {
+		return err
+	}
+
+	signerData := request.SignerData
+	signerData.PubKey = &anypb.Any{
+		TypeUrl: anyPk.TypeUrl,
+		Value:   anyPk.Value,
+	}
+
+	if err := authsigning.VerifySignature(ctx, sv.pubKey, signerData, request.Signature.Data, request.SignModeHandler, request.TxData); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signature verification failed: %s", err)
+	}
+
+	return nil
+}
+
+func (SignatureVerification) Track(sdk.Context, Request) error { return nil }
+
+func (SignatureVerification) ConfirmExecution(sdk.Context, Request) error { return nil }
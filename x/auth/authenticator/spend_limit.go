@@ -0,0 +1,146 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SpendLimitType is the type of the SpendLimit authenticator.
+const SpendLimitType = "SpendLimit"
+
+var _ Authenticator = SpendLimit{}
+
+// SpendLimitConfig is the configuration of a SpendLimit authenticator.
+type SpendLimitConfig struct {
+	// Limit is the maximum amount the account can spend per period. Only the
+	// denoms of the limit are limited.
+	Limit sdk.Coins `json:"limit"`
+	// Period is the duration of a period, e.g. "24h".
+	Period string `json:"period"`
+}
+
+// SpendLimit limits the amount an account can spend per period. It does not
+// verify any signature, so it must be composed with a SignatureVerification
+// authenticator in an AllOf authenticator. Its configuration is a
+// SpendLimitConfig encoded in JSON, e.g.
+// {"limit":[{"denom":"stake","amount":"1000"}],"period":"24h"}.
+//
+// The amount spent by a transaction is the decrease of the balance of the
+// account by the execution of its messages, so fees are not included. The
+// transaction is rejected when the amount spent over the current period
+// exceeds the limit.
+type SpendLimit struct {
+	cdc        codec.BinaryCodec
+	bankKeeper BankKeeper
+	store      DataStore
+
+	limit  sdk.Coins
+	period time.Duration
+}
+
+// NewSpendLimit returns a new SpendLimit authenticator, reading balances from
+// bk and storing the amount spent in store.
+func NewSpendLimit(cdc codec.BinaryCodec, bk BankKeeper, store DataStore) SpendLimit {
+	return SpendLimit{cdc: cdc, bankKeeper: bk, store: store}
+}
+
+func (SpendLimit) Type() string { return SpendLimitType }
+
+func (sl SpendLimit) Initialize(config []byte) (Authenticator, error) {
+	var cfg SpendLimitConfig
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid %s config: %w", SpendLimitType, err)
+	}
+
+	if cfg.Limit.Empty() || !cfg.Limit.IsValid() {
+		return nil, fmt.Errorf("invalid %s limit: %s", SpendLimitType, cfg.Limit)
+	}
+
+	period, err := time.ParseDuration(cfg.Period)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s period: %w", SpendLimitType, err)
+	}
+	if period <= 0 {
+		return nil, fmt.Errorf("%s period must be positive: %s", SpendLimitType, period)
+	}
+
+	sl.limit = cfg.Limit
+	sl.period = period
+	return sl, nil
+}
+
+func (SpendLimit) VerifiesSignature() bool { return false }
+
+func (SpendLimit) Authenticate(sdk.Context, Request) error { return nil }
+
+// Track records the balance of the account before the execution of the
+// messages.
+func (sl SpendLimit) Track(ctx sdk.Context, request Request) error {
+	usage, err := sl.getUsage(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	usage.BalanceBefore = sl.bankKeeper.GetAllBalances(ctx, request.Account)
+	return sl.setUsage(ctx, request, usage)
+}
+
+// ConfirmExecution adds the decrease of the balance of the account to the
+// amount spent over the current period, and rejects the transaction if it
+// exceeds the limit.
+func (sl SpendLimit) ConfirmExecution(ctx sdk.Context, request Request) error {
+	usage, err := sl.getUsage(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	if blockTime := ctx.BlockTime(); !blockTime.Before(usage.PeriodStart.Add(sl.period)) {
+		usage.PeriodStart = blockTime
+		usage.Spent = nil
+	}
+
+	balance := sl.bankKeeper.GetAllBalances(ctx, request.Account)
+	for _, limit := range sl.limit {
+		before, after := usage.BalanceBefore.AmountOf(limit.Denom), balance.AmountOf(limit.Denom)
+		if after.LT(before) {
+			usage.Spent = usage.Spent.Add(sdk.NewCoin(limit.Denom, before.Sub(after)))
+		}
+	}
+
+	if !sl.limit.IsAllGTE(usage.Spent) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+			"spend limit exceeded; spent %s over the period, limit is %s per %s", usage.Spent, sl.limit, sl.period)
+	}
+
+	usage.BalanceBefore = nil
+	return sl.setUsage(ctx, request, usage)
+}
+
+func (sl SpendLimit) getUsage(ctx sdk.Context, request Request) (types.SpendLimitUsage, error) {
+	var usage types.SpendLimitUsage
+
+	bz, err := sl.store.GetAuthenticatorData(ctx, request.Account, request.AuthenticatorID)
+	if err != nil || bz == nil {
+		return usage, err
+	}
+
+	err = sl.cdc.Unmarshal(bz, &usage)
+	return usage, err
+}
+
+func (sl SpendLimit) setUsage(ctx sdk.Context, request Request, usage types.SpendLimitUsage) error {
+	bz, err := sl.cdc.Marshal(&usage)
+	if err != nil {
+		return err
+	}
+
+	return sl.store.SetAuthenticatorData(ctx, request.Account, request.AuthenticatorID, bz)
+}
@@ -74,6 +74,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current auth parameters",
 				},
+				{
+					RpcMethod:      "PubKeyRotations",
+					Use:            "pub-key-rotations [address]",
+					Short:          "Query the public key rotation history of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Authenticators",
+					Use:            "authenticators [address]",
+					Short:          "Query the authenticators registered by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -87,6 +99,26 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "RotatePubKey",
+					Use:            "rotate-pub-key [new-pub-key]",
+					Short:          "Rotate the public key of an account, keeping its address",
+					Example:        fmt.Sprintf(`%s tx auth rotate-pub-key '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"..."}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "new_pub_key"}},
+				},
+				{
+					RpcMethod:      "AddAuthenticator",
+					Use:            "add-authenticator [authenticator-type] [config-file]",
+					Short:          "Register an authenticator for an account, configured with the content of the config file",
+					Example:        fmt.Sprintf(`%s tx auth add-authenticator AllOf config.json --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "authenticator_type"}, {ProtoField: "config"}},
+				},
+				{
+					RpcMethod:      "RemoveAuthenticator",
+					Use:            "remove-authenticator [id]",
+					Short:          "Remove an authenticator of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 			},
 		},
 	}
@@ -8,9 +8,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 )
 
+const flagGasProfile = "gas-profile"
+
 // GetSimulateCmd returns a command that simulates whether a transaction will be
 // successful.
 func GetSimulateCmd() *cobra.Command {
@@ -63,6 +66,11 @@ empty; they will be auto-populated by dummy data for simulation purpose.
 
 The --from flag is mandatory, as the signer account's correct sequence number is
 necessary for simulation.
+
+With --gas-profile, the result also includes a breakdown of the gas consumed per
+ante decorator, message handler, post decorator, store and store operation
+(read, write, has, delete, iterate), along with the KVStore gas costs applied.
+The node must run with gas profiling enabled (gas-profiling in app.toml).
 `),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -86,6 +94,23 @@ necessary for simulation.
 				return err
 			}
 
+			if gasProfile, _ := cmd.Flags().GetBool(flagGasProfile); gasProfile {
+				txBytes, err := txf.BuildSimTx(stdTx.GetMsgs()...)
+				if err != nil {
+					return err
+				}
+
+				simRes, err := txtypes.NewServiceClient(clientCtx).Simulate(cmd.Context(), &txtypes.SimulateRequest{
+					TxBytes:    txBytes,
+					GasProfile: true,
+				})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(simRes)
+			}
+
 			simRes, _, err := tx.CalculateGas(clientCtx, txf, stdTx.GetMsgs()...)
 			if err != nil {
 				return err
@@ -95,6 +120,7 @@ necessary for simulation.
 		},
 	}
 
+	cmd.Flags().Bool(flagGasProfile, false, "Include a per decorator, message, store and operation breakdown of the consumed gas")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
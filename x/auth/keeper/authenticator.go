@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ authenticator.DataStore = AccountKeeper{}
+
+// AddAuthenticator registers an authenticator of the given type and
+// configuration for the account, and returns its identifier. The
+// authenticator must verify a signature, so that the transactions of the
+// account are always signed.
+func (ak AccountKeeper) AddAuthenticator(ctx context.Context, account sdk.AccAddress, authenticatorType string, config []byte) (uint64, error) {
+	if ak.authenticatorManager == nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "authenticators are not enabled")
+	}
+
+	a, err := ak.authenticatorManager.Initialize(authenticatorType, config)
+	if err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if !a.VerifiesSignature() {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "authenticator %s does not verify a signature", authenticatorType)
+	}
+
+	addrStr, err := ak.addressCodec.BytesToString(account)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := ak.NextAuthenticatorID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = ak.Authenticators.Set(ctx, collections.Join(account, id), types.AccountAuthenticator{
+		Address:           addrStr,
+		Id:                id,
+		AuthenticatorType: authenticatorType,
+		Config:            config,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddAuthenticator,
+			sdk.NewAttribute(types.AttributeKeyAddress, addrStr),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorType, authenticatorType),
+		),
+	)
+
+	return id, nil
+}
+
+// RemoveAuthenticator removes the authenticator of the account with the given
+// identifier, along with its data.
+func (ak AccountKeeper) RemoveAuthenticator(ctx context.Context, account sdk.AccAddress, id uint64) error {
+	key := collections.Join(account, id)
+	has, err := ak.Authenticators.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "authenticator %d of account %s", id, account)
+	}
+
+	if err := ak.Authenticators.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	// remove the data of the authenticator and of its sub-authenticators
+	authenticatorID := strconv.FormatUint(id, 10)
+	iter, err := ak.AuthenticatorData.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](account))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if dataID := k.K2(); dataID == authenticatorID || strings.HasPrefix(dataID, authenticatorID+".") {
+			if err := ak.AuthenticatorData.Remove(ctx, k); err != nil {
+				return err
+			}
+		}
+	}
+
+	addrStr, err := ak.addressCodec.BytesToString(account)
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveAuthenticator,
+			sdk.NewAttribute(types.AttributeKeyAddress, addrStr),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorID, authenticatorID),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthenticators returns the authenticators registered by the account,
+// ordered by identifier.
+func (ak AccountKeeper) GetAuthenticators(ctx context.Context, account sdk.AccAddress) ([]types.AccountAuthenticator, error) {
+	iter, err := ak.Authenticators.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](account))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// GetInitializedAuthenticators returns the authenticators registered by the
+// account, configured with their configuration. It returns none when
+// authenticators are disabled.
+func (ak AccountKeeper) GetInitializedAuthenticators(ctx context.Context, account sdk.AccAddress) ([]authenticator.InitializedAuthenticator, error) {
+	if ak.authenticatorManager == nil {
+		return nil, nil
+	}
+
+	accountAuthenticators, err := ak.GetAuthenticators(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	initialized := make([]authenticator.InitializedAuthenticator, len(accountAuthenticators))
+	for i, aa := range accountAuthenticators {
+		a, err := ak.authenticatorManager.Initialize(aa.AuthenticatorType, aa.Config)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "authenticator %d of account %s", aa.Id, account)
+		}
+		initialized[i] = authenticator.InitializedAuthenticator{ID: aa.Id, Authenticator: a}
+	}
+
+	return initialized, nil
+}
+
+// GetAllAuthenticators returns the authenticators registered by all the
+// accounts.
+func (ak AccountKeeper) GetAllAuthenticators(ctx context.Context) ([]types.AccountAuthenticator, error) {
+	iter, err := ak.Authenticators.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// SetAuthenticators sets the given authenticators, and the next authenticator
+// identifier after the highest of them.
+func (ak AccountKeeper) SetAuthenticators(ctx context.Context, authenticators []types.AccountAuthenticator) error {
+	nextID, err := ak.NextAuthenticatorID.Peek(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, aa := range authenticators {
+		account, err := ak.addressCodec.StringToBytes(aa.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := ak.Authenticators.Set(ctx, collections.Join(sdk.AccAddress(account), aa.Id), aa); err != nil {
+			return err
+		}
+
+		if aa.Id >= nextID {
+			nextID = aa.Id + 1
+		}
+	}
+
+	return ak.NextAuthenticatorID.Set(ctx, nextID)
+}
+
+// GetAuthenticatorData implements authenticator.DataStore.
+func (ak AccountKeeper) GetAuthenticatorData(ctx context.Context, account sdk.AccAddress, authenticatorID string) ([]byte, error) {
+	bz, err := ak.AuthenticatorData.Get(ctx, collections.Join(account, authenticatorID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	}
+	return bz, err
+}
+
+// SetAuthenticatorData implements authenticator.DataStore.
+func (ak AccountKeeper) SetAuthenticatorData(ctx context.Context, account sdk.AccAddress, authenticatorID string, data []byte) error {
+	return ak.AuthenticatorData.Set(ctx, collections.Join(account, authenticatorID), data)
+}
@@ -14,6 +14,10 @@ func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		panic(err)
 	}
 
+	if err := ak.SetMsgFees(ctx, data.MsgFees); err != nil {
+		panic(err)
+	}
+
 	accounts, err := types.UnpackAccounts(data.Accounts)
 	if err != nil {
 		panic(err)
@@ -25,6 +29,14 @@ func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		ak.SetAccount(ctx, acc)
 	}
 
+	if err := ak.SetPubKeyRotations(ctx, data.PubKeyRotations); err != nil {
+		panic(err)
+	}
+
+	if err := ak.SetAuthenticators(ctx, data.Authenticators); err != nil {
+		panic(err)
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 }
 
@@ -39,5 +51,24 @@ func (ak AccountKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	msgFees, err := ak.GetAllMsgFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	rotations, err := ak.GetAllPubKeyRotations(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	authenticators, err := ak.GetAllAuthenticators(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(params, genAccounts)
+	genState.MsgFees = msgFees
+	genState.PubKeyRotations = rotations
+	genState.Authenticators = authenticators
+	return genState
 }
@@ -9,6 +9,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -245,3 +247,96 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 		},
 	}, nil
 }
+
+// MsgFees returns the configured per message type fees.
+func (s queryServer) MsgFees(ctx context.Context, req *types.QueryMsgFeesRequest) (*types.QueryMsgFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	msgFees, pageRes, err := query.CollectionPaginate(
+		ctx,
+		s.k.MsgFees,
+		req.Pagination,
+		func(_ string, value types.MsgFee) (types.MsgFee, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMsgFeesResponse{MsgFees: msgFees, Pagination: pageRes}, nil
+}
+
+// MsgFee returns the fee applied to a message type.
+func (s queryServer) MsgFee(ctx context.Context, req *types.QueryMsgFeeRequest) (*types.QueryMsgFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.MsgTypeUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty message type url")
+	}
+
+	msgFee, err := s.k.GetMsgFee(ctx, req.MsgTypeUrl)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMsgFeeResponse{MsgFee: msgFee}, nil
+}
+
+// PubKeyRotations returns the public key rotation history of an account.
+func (s queryServer) PubKeyRotations(ctx context.Context, req *types.QueryPubKeyRotationsRequest) (*types.QueryPubKeyRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	rotations, pageRes, err := query.CollectionPaginate(
+		ctx,
+		s.k.PubKeyRotations,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], value types.PubKeyRotation) (types.PubKeyRotation, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPubKeyRotationsResponse{Rotations: rotations, Pagination: pageRes}, nil
+}
+
+// Authenticators returns the authenticators registered by an account.
+func (s queryServer) Authenticators(ctx context.Context, req *types.QueryAuthenticatorsRequest) (*types.QueryAuthenticatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	authenticators, pageRes, err := query.CollectionPaginate(
+		ctx,
+		s.k.Authenticators,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], value types.AccountAuthenticator) (types.AccountAuthenticator, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuthenticatorsResponse{Authenticators: authenticators, Pagination: pageRes}, nil
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -535,3 +537,25 @@ func (suite *KeeperTestSuite) TestQueryAccountInfoWithoutPubKey() {
 	suite.Require().Equal(addr.String(), res.Info.Address)
 	suite.Require().Nil(res.Info.PubKey)
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryMsgFees() {
+	voteFee := types.NewMsgFee("/cosmos.gov.v1.MsgVote", sdkmath.LegacyNewDecWithPrec(5, 1), nil)
+	sendFee := types.NewMsgFee("/cosmos.bank.v1beta1.MsgSend", sdkmath.LegacyOneDec(), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	suite.Require().NoError(suite.accountKeeper.SetMsgFees(suite.ctx, []types.MsgFee{voteFee, sendFee}))
+
+	res, err := suite.queryClient.MsgFees(suite.ctx, &types.QueryMsgFeesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.MsgFee{sendFee, voteFee}, res.MsgFees)
+
+	feeRes, err := suite.queryClient.MsgFee(suite.ctx, &types.QueryMsgFeeRequest{MsgTypeUrl: voteFee.MsgTypeUrl})
+	suite.Require().NoError(err)
+	suite.Require().Equal(voteFee, feeRes.MsgFee)
+
+	// message types without a configured fee report the default
+	feeRes, err = suite.queryClient.MsgFee(suite.ctx, &types.QueryMsgFeeRequest{MsgTypeUrl: "/cosmos.gov.v1.MsgDeposit"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultMsgFee("/cosmos.gov.v1.MsgDeposit"), feeRes.MsgFee)
+
+	_, err = suite.queryClient.MsgFee(suite.ctx, &types.QueryMsgFeeRequest{})
+	suite.Require().ErrorContains(err, "empty message type url")
+}
@@ -17,6 +17,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -95,6 +96,10 @@ type AccountKeeper struct {
 	// This boolean helps sigverify ante handlers to determine if they should process unordered transactions.
 	enableUnorderedTxs bool
 
+	// authenticatorManager holds the authenticator types accounts can
+	// register. Authenticators are disabled when it is nil.
+	authenticatorManager *authenticator.Manager
+
 	// The prototypical AccountI constructor.
 	proto func() sdk.AccountI
 
@@ -108,10 +113,29 @@ type AccountKeeper struct {
 	AccountNumber   collections.Sequence
 	Accounts        *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
 	UnorderedNonces collections.KeySet[collections.Pair[int64, []byte]]
+	MsgFees         collections.Map[string, types.MsgFee]
+	// PubKeyRotations stores the public key rotation history of the accounts,
+	// keyed by account address and rotation index.
+	PubKeyRotations collections.Map[collections.Pair[sdk.AccAddress, uint64], types.PubKeyRotation]
+	// Authenticators stores the authenticators registered by the accounts,
+	// keyed by account address and authenticator identifier.
+	Authenticators      collections.Map[collections.Pair[sdk.AccAddress, uint64], types.AccountAuthenticator]
+	NextAuthenticatorID collections.Sequence
+	// AuthenticatorData stores the data of the authenticators, keyed by
+	// account address and authenticator identifier.
+	AuthenticatorData collections.Map[collections.Pair[sdk.AccAddress, string], []byte]
 }
 
 type InitOption func(*AccountKeeper)
 
+// WithAuthenticatorManager enables authenticators, which accounts can register
+// among the types of the given manager.
+func WithAuthenticatorManager(m *authenticator.Manager) InitOption {
+	return func(ak *AccountKeeper) {
+		ak.authenticatorManager = m
+	}
+}
+
 // WithUnorderedTransactions enables unordered transaction support.
 // When true, sigverify ante handlers will validate and process unordered transactions.
 // When false, sigverify ante handlers will reject unordered transactions.
@@ -141,17 +165,22 @@ func NewAccountKeeper(
 	sb := collections.NewSchemaBuilder(storeService)
 
 	ak := AccountKeeper{
-		addressCodec:    ac,
-		bech32Prefix:    bech32Prefix,
-		storeService:    storeService,
-		proto:           proto,
-		cdc:             cdc,
-		permAddrs:       permAddrs,
-		authority:       authority,
-		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		AccountNumber:   collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"), //nolint:staticcheck // kept in place for the migration
-		Accounts:        collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
-		UnorderedNonces: collections.NewKeySet(sb, types.UnorderedNoncesKey, "unordered_nonces", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		addressCodec:        ac,
+		bech32Prefix:        bech32Prefix,
+		storeService:        storeService,
+		proto:               proto,
+		cdc:                 cdc,
+		permAddrs:           permAddrs,
+		authority:           authority,
+		Params:              collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		AccountNumber:       collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"), //nolint:staticcheck // kept in place for the migration
+		Accounts:            collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		UnorderedNonces:     collections.NewKeySet(sb, types.UnorderedNoncesKey, "unordered_nonces", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		MsgFees:             collections.NewMap(sb, types.MsgFeesKeyPrefix, "msg_fees", collections.StringKey, codec.CollValue[types.MsgFee](cdc)),
+		PubKeyRotations:     collections.NewMap(sb, types.PubKeyRotationsKeyPrefix, "pub_key_rotations", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.PubKeyRotation](cdc)),
+		Authenticators:      collections.NewMap(sb, types.AuthenticatorsKeyPrefix, "authenticators", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.AccountAuthenticator](cdc)),
+		NextAuthenticatorID: collections.NewSequence(sb, types.NextAuthenticatorIDKey, "next_authenticator_id"),
+		AuthenticatorData:   collections.NewMap(sb, types.AuthenticatorDataKeyPrefix, "authenticator_data", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), collections.BytesValue),
 	}
 	schema, err := sb.Build()
 	if err != nil {
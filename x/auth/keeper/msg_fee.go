@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetMsgFee returns the fee applied to the given message type, falling back to
+// types.DefaultMsgFee when none is configured.
+func (ak AccountKeeper) GetMsgFee(ctx context.Context, msgTypeURL string) (types.MsgFee, error) {
+	msgFee, err := ak.MsgFees.Get(ctx, msgTypeURL)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultMsgFee(msgTypeURL), nil
+	}
+	return msgFee, err
+}
+
+// GetAllMsgFees returns all the configured message fees, ordered by message
+// type URL.
+func (ak AccountKeeper) GetAllMsgFees(ctx context.Context) ([]types.MsgFee, error) {
+	iter, err := ak.MsgFees.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// SetMsgFees replaces all the configured message fees with msgFees.
+func (ak AccountKeeper) SetMsgFees(ctx context.Context, msgFees []types.MsgFee) error {
+	if err := types.ValidateMsgFees(msgFees); err != nil {
+		return err
+	}
+
+	if err := ak.MsgFees.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	for _, msgFee := range msgFees {
+		if err := ak.MsgFees.Set(ctx, msgFee.MsgTypeUrl, msgFee); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -36,3 +40,66 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) UpdateMsgFees(goCtx context.Context, msg *types.MsgUpdateMsgFees) (*types.MsgUpdateMsgFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, ms.ak.authority, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := ms.ak.SetMsgFees(ctx, msg.MsgFees); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgUpdateMsgFeesResponse{}, nil
+}
+
+func (ms msgServer) RotatePubKey(goCtx context.Context, msg *types.MsgRotatePubKey) (*types.MsgRotatePubKeyResponse, error) {
+	addr, err := ms.ak.addressCodec.StringToBytes(msg.Address)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+
+	if msg.NewPubKey == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "new public key cannot be empty")
+	}
+
+	newPubKey, ok := msg.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", msg.NewPubKey.GetCachedValue())
+	}
+
+	if err := ms.ak.RotatePubKey(goCtx, addr, newPubKey); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotatePubKeyResponse{}, nil
+}
+
+func (ms msgServer) AddAuthenticator(goCtx context.Context, msg *types.MsgAddAuthenticator) (*types.MsgAddAuthenticatorResponse, error) {
+	sender, err := ms.ak.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	id, err := ms.ak.AddAuthenticator(goCtx, sender, msg.AuthenticatorType, msg.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddAuthenticatorResponse{Id: id}, nil
+}
+
+func (ms msgServer) RemoveAuthenticator(goCtx context.Context, msg *types.MsgRemoveAuthenticator) (*types.MsgRemoveAuthenticatorResponse, error) {
+	sender, err := ms.ak.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if err := ms.ak.RemoveAuthenticator(goCtx, sender, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAuthenticatorResponse{}, nil
+}
@@ -1,9 +1,20 @@
 package keeper_test
 
 import (
+	"encoding/json"
+	"time"
+
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mldsa65"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -168,3 +179,320 @@ func (s *KeeperTestSuite) TestUpdateParamsAuthority() {
 		s.Require().Contains(err.Error(), "invalid authority")
 	})
 }
+
+func (s *KeeperTestSuite) TestUpdateMsgFees() {
+	voteFee := types.NewMsgFee("/cosmos.gov.v1.MsgVote", sdkmath.LegacyNewDecWithPrec(5, 1), nil)
+	createValFee := types.NewMsgFee("/cosmos.staking.v1beta1.MsgCreateValidator", sdkmath.LegacyNewDec(10), sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateMsgFees
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			req:       &types.MsgUpdateMsgFees{Authority: "foo", MsgFees: []types.MsgFee{voteFee}},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "invalid type url",
+			req: &types.MsgUpdateMsgFees{
+				Authority: s.accountKeeper.GetAuthority(),
+				MsgFees:   []types.MsgFee{types.NewMsgFee("cosmos.gov.v1.MsgVote", sdkmath.LegacyOneDec(), nil)},
+			},
+			expErrMsg: "invalid message type url",
+		},
+		{
+			name: "zero gas multiplier",
+			req: &types.MsgUpdateMsgFees{
+				Authority: s.accountKeeper.GetAuthority(),
+				MsgFees:   []types.MsgFee{types.NewMsgFee("/cosmos.gov.v1.MsgVote", sdkmath.LegacyZeroDec(), nil)},
+			},
+			expErrMsg: "gas multiplier of /cosmos.gov.v1.MsgVote must be positive",
+		},
+		{
+			name: "invalid min fee",
+			req: &types.MsgUpdateMsgFees{
+				Authority: s.accountKeeper.GetAuthority(),
+				MsgFees:   []types.MsgFee{types.NewMsgFee("/cosmos.gov.v1.MsgVote", sdkmath.LegacyOneDec(), sdk.Coins{{Denom: "stake", Amount: sdkmath.NewInt(-1)}})},
+			},
+			expErrMsg: "invalid minimum fee",
+		},
+		{
+			name: "duplicate message type",
+			req: &types.MsgUpdateMsgFees{
+				Authority: s.accountKeeper.GetAuthority(),
+				MsgFees:   []types.MsgFee{voteFee, voteFee},
+			},
+			expErrMsg: "duplicate message fee",
+		},
+		{
+			name: "success",
+			req: &types.MsgUpdateMsgFees{
+				Authority: s.accountKeeper.GetAuthority(),
+				MsgFees:   []types.MsgFee{voteFee, createValFee},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateMsgFees(s.ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			msgFees, err := s.accountKeeper.GetAllMsgFees(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal([]types.MsgFee{voteFee, createValFee}, msgFees)
+		})
+	}
+
+	// updating replaces the whole set
+	_, err := s.msgServer.UpdateMsgFees(s.ctx, &types.MsgUpdateMsgFees{
+		Authority: s.accountKeeper.GetAuthority(),
+		MsgFees:   []types.MsgFee{voteFee},
+	})
+	s.Require().NoError(err)
+
+	msgFee, err := s.accountKeeper.GetMsgFee(s.ctx, createValFee.MsgTypeUrl)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultMsgFee(createValFee.MsgTypeUrl), msgFee)
+}
+
+func (s *KeeperTestSuite) TestRotatePubKey() {
+	ctx := s.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_000_000, 0))
+	s.Require().NoError(s.accountKeeper.Params.Set(ctx, types.DefaultParams()))
+
+	oldPubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+	acc := s.accountKeeper.NewAccountWithAddress(ctx, addr)
+	s.Require().NoError(acc.SetPubKey(oldPubKey))
+	s.accountKeeper.SetAccount(ctx, acc)
+
+	noPubKeyAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.accountKeeper.SetAccount(ctx, s.accountKeeper.NewAccountWithAddress(ctx, noPubKeyAddr))
+
+	modAcc := s.accountKeeper.GetModuleAccount(ctx, multiPerm)
+
+	mlDsaPrivKey, err := mldsa65.GenPrivKey()
+	s.Require().NoError(err)
+	newPubKey := mlDsaPrivKey.PubKey()
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{
+		secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(),
+	})
+
+	newMsg := func(addr sdk.AccAddress, pubKey cryptotypes.PubKey) *types.MsgRotatePubKey {
+		msg, err := types.NewMsgRotatePubKey(addr.String(), pubKey)
+		s.Require().NoError(err)
+		return msg
+	}
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgRotatePubKey
+		expErrMsg string
+	}{
+		{
+			name:      "invalid address",
+			req:       &types.MsgRotatePubKey{Address: "foo"},
+			expErrMsg: "invalid address",
+		},
+		{
+			name:      "empty public key",
+			req:       &types.MsgRotatePubKey{Address: addr.String()},
+			expErrMsg: "new public key cannot be empty",
+		},
+		{
+			name:      "unknown account",
+			req:       newMsg(sdk.AccAddress("unknown"), newPubKey),
+			expErrMsg: "does not exist",
+		},
+		{
+			name:      "module account",
+			req:       newMsg(modAcc.GetAddress(), newPubKey),
+			expErrMsg: "cannot rotate the public key of module account",
+		},
+		{
+			name:      "account without public key",
+			req:       newMsg(noPubKeyAddr, newPubKey),
+			expErrMsg: "has no public key set",
+		},
+		{
+			name:      "same public key",
+			req:       newMsg(addr, oldPubKey),
+			expErrMsg: "same as the current one",
+		},
+		{
+			name: "success",
+			req:  newMsg(addr, newPubKey),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.RotatePubKey(ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	// the address is kept while the public key is replaced
+	pubKey, err := s.accountKeeper.GetPubKey(ctx, addr)
+	s.Require().NoError(err)
+	s.Require().True(newPubKey.Equals(pubKey))
+
+	rotations, err := s.accountKeeper.GetPubKeyRotations(ctx, addr)
+	s.Require().NoError(err)
+	s.Require().Len(rotations, 1)
+	s.Require().Equal(addr.String(), rotations[0].Address)
+	s.Require().Equal(int64(10), rotations[0].Height)
+	s.Require().Equal(ctx.BlockTime(), rotations[0].Time)
+
+	// cooldown
+	params := s.accountKeeper.GetParams(ctx)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.PubKeyRotationCooldown - time.Second))
+	_, err = s.msgServer.RotatePubKey(ctx, newMsg(addr, multisigPubKey))
+	s.Require().ErrorContains(err, "next rotation allowed at")
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	_, err = s.msgServer.RotatePubKey(ctx, newMsg(addr, multisigPubKey))
+	s.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.PubKeyRotationCooldown))
+	_, err = s.msgServer.RotatePubKey(ctx, newMsg(addr, oldPubKey))
+	s.Require().NoError(err)
+
+	// rate limit
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.PubKeyRotationCooldown))
+	_, err = s.msgServer.RotatePubKey(ctx, newMsg(addr, newPubKey))
+	s.Require().ErrorContains(err, "already rotated 3 times")
+
+	ctx = ctx.WithBlockTime(rotations[0].Time.Add(params.PubKeyRotationWindow))
+	_, err = s.msgServer.RotatePubKey(ctx, newMsg(addr, newPubKey))
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.PubKeyRotations(ctx, &types.QueryPubKeyRotationsRequest{Address: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Rotations, 4)
+	s.Require().Equal(uint64(4), res.Pagination.Total)
+
+	// disabled
+	params.MaxPubKeyRotations = 0
+	s.Require().NoError(s.accountKeeper.Params.Set(ctx, params))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.PubKeyRotationWindow))
+	_, err = s.msgServer.RotatePubKey(ctx, newMsg(addr, oldPubKey))
+	s.Require().ErrorContains(err, "public key rotation is disabled")
+}
+
+func (s *KeeperTestSuite) TestAddRemoveAuthenticator() {
+	ctx := s.ctx
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otherAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	pubKeyConfig, err := s.encCfg.Codec.MarshalInterfaceJSON(secp256k1.GenPrivKey().PubKey())
+	s.Require().NoError(err)
+	filterConfig := []byte(`["/cosmos.bank.v1beta1.MsgSend"]`)
+	allOfConfig, err := json.Marshal([]authenticator.SubAuthenticatorConfig{
+		{Type: authenticator.SignatureVerificationType, Config: pubKeyConfig},
+		{Type: authenticator.MessageFilterType, Config: filterConfig},
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgAddAuthenticator
+		expID     uint64
+		expErrMsg string
+	}{
+		{
+			name:      "invalid address",
+			req:       &types.MsgAddAuthenticator{Sender: "foo"},
+			expErrMsg: "invalid sender address",
+		},
+		{
+			name:      "unknown type",
+			req:       &types.MsgAddAuthenticator{Sender: addr.String(), AuthenticatorType: "Unknown"},
+			expErrMsg: "unknown authenticator type Unknown",
+		},
+		{
+			name:      "invalid config",
+			req:       &types.MsgAddAuthenticator{Sender: addr.String(), AuthenticatorType: authenticator.SignatureVerificationType, Config: []byte("{")},
+			expErrMsg: "invalid SignatureVerification public key",
+		},
+		{
+			name:      "no signature verification",
+			req:       &types.MsgAddAuthenticator{Sender: addr.String(), AuthenticatorType: authenticator.MessageFilterType, Config: filterConfig},
+			expErrMsg: "does not verify a signature",
+		},
+		{
+			name:  "signature verification",
+			req:   &types.MsgAddAuthenticator{Sender: addr.String(), AuthenticatorType: authenticator.SignatureVerificationType, Config: pubKeyConfig},
+			expID: 0,
+		},
+		{
+			name:  "composite",
+			req:   &types.MsgAddAuthenticator{Sender: addr.String(), AuthenticatorType: authenticator.AllOfType, Config: allOfConfig},
+			expID: 1,
+		},
+		{
+			name:  "other account",
+			req:   &types.MsgAddAuthenticator{Sender: otherAddr.String(), AuthenticatorType: authenticator.SignatureVerificationType, Config: pubKeyConfig},
+			expID: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.msgServer.AddAuthenticator(ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expID, res.Id)
+		})
+	}
+
+	res, err := s.queryClient.Authenticators(ctx, &types.QueryAuthenticatorsRequest{Address: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Authenticators, 2)
+	s.Require().Equal(authenticator.AllOfType, res.Authenticators[1].AuthenticatorType)
+
+	authenticators, err := s.accountKeeper.GetInitializedAuthenticators(ctx, addr)
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 2)
+	s.Require().True(authenticators[1].Authenticator.VerifiesSignature())
+
+	// the data of the authenticator and its sub-authenticators is removed
+	// along with it
+	s.Require().NoError(s.accountKeeper.SetAuthenticatorData(ctx, addr, "1.1", []byte("data")))
+	s.Require().NoError(s.accountKeeper.SetAuthenticatorData(ctx, addr, "10", []byte("data")))
+
+	_, err = s.msgServer.RemoveAuthenticator(ctx, &types.MsgRemoveAuthenticator{Sender: otherAddr.String(), Id: 1})
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	_, err = s.msgServer.RemoveAuthenticator(ctx, &types.MsgRemoveAuthenticator{Sender: addr.String(), Id: 1})
+	s.Require().NoError(err)
+
+	data, err := s.accountKeeper.GetAuthenticatorData(ctx, addr, "1.1")
+	s.Require().NoError(err)
+	s.Require().Nil(data)
+	data, err = s.accountKeeper.GetAuthenticatorData(ctx, addr, "10")
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("data"), data)
+
+	res, err = s.queryClient.Authenticators(ctx, &types.QueryAuthenticatorsRequest{Address: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Authenticators, 1)
+	s.Require().Equal(uint64(0), res.Authenticators[0].Id)
+
+	_, err = s.msgServer.RemoveAuthenticator(ctx, &types.MsgRemoveAuthenticator{Sender: addr.String(), Id: 1})
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RotatePubKey replaces the public key of the account at addr with newPubKey.
+// The address of the account is kept, so the new public key can be of any key
+// type. The rotation is subject to the cooldown and rate limit defined in the
+// params, and is recorded in the rotation history of the account.
+func (ak AccountKeeper) RotatePubKey(ctx context.Context, addr sdk.AccAddress, newPubKey cryptotypes.PubKey) error {
+	params := ak.GetParams(ctx)
+	if params.MaxPubKeyRotations == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "public key rotation is disabled")
+	}
+
+	if newPubKey == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "new public key cannot be empty")
+	}
+	if _, ok := newPubKey.(*types.ModuleCredential); ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "new public key cannot be a module credential")
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+	if _, ok := acc.(sdk.ModuleAccountI); ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot rotate the public key of module account %s", addr)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s has no public key set", addr)
+	}
+	if oldPubKey.Equals(newPubKey) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "new public key is the same as the current one")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	rotations, err := ak.GetPubKeyRotations(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	if len(rotations) > 0 {
+		last := rotations[len(rotations)-1]
+		if next := last.Time.Add(params.PubKeyRotationCooldown); blockTime.Before(next) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"public key of account %s was rotated at %s, next rotation allowed at %s", addr, last.Time, next)
+		}
+	}
+
+	windowStart := blockTime.Add(-params.PubKeyRotationWindow)
+	var count uint64
+	for _, rotation := range rotations {
+		if rotation.Time.After(windowStart) {
+			count++
+		}
+	}
+	if count >= params.MaxPubKeyRotations {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"public key of account %s was already rotated %d times within %s", addr, count, params.PubKeyRotationWindow)
+	}
+
+	if err := acc.SetPubKey(newPubKey); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	ak.SetAccount(ctx, acc)
+
+	oldPubKeyAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return err
+	}
+	newPubKeyAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return err
+	}
+
+	addrStr, err := ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	rotation := types.PubKeyRotation{
+		Address:   addrStr,
+		OldPubKey: oldPubKeyAny,
+		NewPubKey: newPubKeyAny,
+		Height:    sdkCtx.BlockHeight(),
+		Time:      blockTime,
+	}
+	if err := ak.PubKeyRotations.Set(ctx, collections.Join(addr, uint64(len(rotations))), rotation); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotatePubKey,
+			sdk.NewAttribute(types.AttributeKeyAddress, addrStr),
+			sdk.NewAttribute(types.AttributeKeyOldPubKey, oldPubKey.String()),
+			sdk.NewAttribute(types.AttributeKeyNewPubKey, newPubKey.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetPubKeyRotations returns the public key rotation history of the account at
+// addr, from the oldest to the latest rotation.
+func (ak AccountKeeper) GetPubKeyRotations(ctx context.Context, addr sdk.AccAddress) ([]types.PubKeyRotation, error) {
+	iter, err := ak.PubKeyRotations.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// GetAllPubKeyRotations returns the public key rotation history of all the
+// accounts.
+func (ak AccountKeeper) GetAllPubKeyRotations(ctx context.Context) ([]types.PubKeyRotation, error) {
+	iter, err := ak.PubKeyRotations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// SetPubKeyRotations appends rotations to the public key rotation history of
+// their accounts.
+func (ak AccountKeeper) SetPubKeyRotations(ctx context.Context, rotations []types.PubKeyRotation) error {
+	for _, rotation := range rotations {
+		addr, err := ak.addressCodec.StringToBytes(rotation.Address)
+		if err != nil {
+			return err
+		}
+
+		history, err := ak.GetPubKeyRotations(ctx, addr)
+		if err != nil {
+			return err
+		}
+
+		if err := ak.PubKeyRotations.Set(ctx, collections.Join(sdk.AccAddress(addr), uint64(len(history))), rotation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
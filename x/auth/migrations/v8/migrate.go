@@ -0,0 +1,31 @@
+package v8
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Migrate from v7 to v8. This includes the addition of the public key rotation
+// fields PubKeyRotationCooldown, PubKeyRotationWindow and MaxPubKeyRotations.
+func Migrate(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.PubKeyRotationCooldown = types.DefaultPubKeyRotationCooldown
+	p.PubKeyRotationWindow = types.DefaultPubKeyRotationWindow
+	p.MaxPubKeyRotations = types.DefaultMaxPubKeyRotations
+	if err = p.Validate(); err != nil {
+		return err
+	}
+
+	if err = params.Set(ctx, p); err != nil {
+		return err
+	}
+
+	return nil
+}
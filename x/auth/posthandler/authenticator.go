@@ -0,0 +1,32 @@
+package posthandler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// AuthenticatorDecorator confirms the execution of a transaction with the
+// authenticators which authenticated its signers in the
+// ante.AuthenticatorDecorator, e.g. to update and enforce spend limits. An
+// authenticator rejecting the execution fails the transaction, reverting its
+// messages.
+type AuthenticatorDecorator struct{}
+
+// NewAuthenticatorDecorator returns a new AuthenticatorDecorator.
+func NewAuthenticatorDecorator() AuthenticatorDecorator {
+	return AuthenticatorDecorator{}
+}
+
+func (AuthenticatorDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	for _, signer := range ante.AuthenticatedSignersFromContext(ctx) {
+		if err := signer.Authenticator.Authenticator.ConfirmExecution(ctx, signer.Request); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
@@ -1,15 +1,49 @@
 package posthandler
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	// AuthenticatorKeeper enables the confirmation of the execution of
+	// transactions with the authenticators of their signers. It must be set
+	// along with the AuthenticatorKeeper of the ante handler.
+	AuthenticatorKeeper ante.AuthenticatorKeeper
+	// BankKeeper is required when RefundRatio is positive.
+	BankKeeper BankKeeper
+	// RefundRatio is the ratio, in [0, 1], of the fee refunded for unused gas.
+	// Refunds are disabled when it is nil or zero.
+	RefundRatio math.LegacyDec
+}
+
+// NewPostHandler returns the default SDK PostHandler chain, which confirms the
+// execution of transactions with the authenticators of their signers when an
+// AuthenticatorKeeper is set, and refunds part of the fee for unused gas when a
+// RefundRatio is set.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
+	var postDecorators []sdk.PostDecorator
+
+	if options.AuthenticatorKeeper != nil {
+		postDecorators = append(postDecorators, NewAuthenticatorDecorator())
+	}
+
+	if !options.RefundRatio.IsNil() && !options.RefundRatio.IsZero() {
+		if options.BankKeeper == nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for fee refunds")
+		}
+
+		if options.RefundRatio.IsNegative() || options.RefundRatio.GT(math.LegacyOneDec()) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "refund ratio must be in [0, 1], got %s", options.RefundRatio)
+		}
 
-// NewPostHandler returns an empty PostHandler chain.
-func NewPostHandler(_ HandlerOptions) (sdk.PostHandler, error) {
-	postDecorators := []sdk.PostDecorator{}
+		postDecorators = append(postDecorators, NewRefundDecorator(options.BankKeeper, options.RefundRatio))
+	}
 
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
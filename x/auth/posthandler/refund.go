@@ -0,0 +1,136 @@
+package posthandler
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// RefundDecorator refunds part of the fee paid for the gas a transaction did
+// not use. The refund is the fee deducted by the ante.DeductFeeDecorator,
+// multiplied by the share of unused gas and by the refund ratio, truncated per
+// denom. It is sent from the module account which received the fee back to
+// the account it was deducted from, which is the fee granter when the fee was
+// paid through a fee grant. The spent fee grant allowance is not restored.
+//
+// The refund never brings the fee below the minimum fee required by the
+// messages of the transaction, recorded by the ante.MsgFeeDecorator, nor below
+// the minimum gas prices of the context or the consensus minimum gas prices
+// applied to the gas used, so that a high gas limit cannot be used to get the
+// minimum fee back.
+//
+// The refund only depends on the transaction itself and its gas consumption,
+// and is sent from funds received by the same transaction, so it is
+// deterministic regardless of the transaction execution order, e.g. under
+// Block-STM. The refund transfer does not consume gas from the transaction,
+// so it cannot run out of gas.
+//
+// Only successful transactions are refunded, as the state changes of the post
+// handlers are discarded for failed transactions.
+type RefundDecorator struct {
+	bankKeeper  BankKeeper
+	refundRatio math.LegacyDec
+}
+
+// NewRefundDecorator returns a new RefundDecorator refunding the given ratio,
+// in [0, 1], of the fee for unused gas.
+func NewRefundDecorator(bk BankKeeper, refundRatio math.LegacyDec) RefundDecorator {
+	if refundRatio.IsNil() || refundRatio.IsNegative() || refundRatio.GT(math.LegacyOneDec()) {
+		panic(fmt.Errorf("refund ratio must be in [0, 1], got %s", refundRatio))
+	}
+
+	return RefundDecorator{
+		bankKeeper:  bk,
+		refundRatio: refundRatio,
+	}
+}
+
+func (rd RefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !success || rd.refundRatio.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	deductedFee, ok := ante.DeductedFeeFromContext(ctx)
+	if !ok || deductedFee.Amount.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// use the gas limit of the transaction rather than the one of the gas
+	// meter, which is infinite in simulations and in the genesis block
+	gasUsed := ctx.GasMeter().GasConsumed()
+	refund := ComputeRefund(deductedFee.Amount, refundFloor(ctx, gasUsed), feeTx.GetGas(), gasUsed, rd.refundRatio)
+	if refund.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	refundCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if err := rd.bankKeeper.SendCoinsFromModuleToAccount(refundCtx, deductedFee.RecipientModule, deductedFee.Payer, refund); err != nil {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "failed to refund fee: %s", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFeeRefund, refund.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductedFee.Payer.String()),
+		),
+	)
+
+	return next(ctx, tx, simulate, success)
+}
+
+// ComputeRefund returns the part of the fee refunded for the unused gas of a
+// transaction, i.e. fee * (gasLimit - gasUsed) / gasLimit * refundRatio,
+// truncated per denom and capped so that the fee minus the refund is at least
+// floor in every denom.
+func ComputeRefund(fee, floor sdk.Coins, gasLimit, gasUsed uint64, refundRatio math.LegacyDec) sdk.Coins {
+	if gasLimit == 0 || gasUsed >= gasLimit {
+		return sdk.Coins{}
+	}
+
+	share := refundRatio.
+		Mul(math.LegacyNewDecFromInt(math.NewIntFromUint64(gasLimit - gasUsed))).
+		Quo(math.LegacyNewDecFromInt(math.NewIntFromUint64(gasLimit)))
+
+	refund := sdk.Coins{}
+	for _, coin := range fee {
+		amount := share.MulInt(coin.Amount).TruncateInt()
+		amount = math.MinInt(amount, coin.Amount.Sub(floor.AmountOf(coin.Denom)))
+		if amount.IsPositive() {
+			refund = refund.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return refund
+}
+
+// refundFloor returns the part of the fee which is not refundable, i.e. the
+// highest of the minimum fee required by the messages of the transaction, and
+// the minimum gas prices of the context and the consensus minimum gas prices
+// required by its messages applied to the gas used, per denom. The minimum gas
+// prices of the context are only set in CheckTx.
+func refundFloor(ctx sdk.Context, gasUsed uint64) sdk.Coins {
+	gasUsedDec := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed))
+	gasFee := func(gasPrices sdk.DecCoins) sdk.Coins {
+		fee := sdk.NewCoins()
+		for _, gp := range gasPrices {
+			fee = fee.Add(sdk.NewCoin(gp.Denom, gp.Amount.Mul(gasUsedDec).Ceil().RoundInt()))
+		}
+		return fee
+	}
+
+	return ante.RequiredMinFeeFromContext(ctx).
+		Max(gasFee(ctx.MinGasPrices())).
+		Max(gasFee(ante.RequiredMinGasPricesFromContext(ctx)))
+}
@@ -0,0 +1,188 @@
+package posthandler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type refund struct {
+	module    string
+	recipient sdk.AccAddress
+	amount    sdk.Coins
+}
+
+type mockBankKeeper struct {
+	refunds []refund
+}
+
+func (bk *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	bk.refunds = append(bk.refunds, refund{module: senderModule, recipient: recipientAddr, amount: amt})
+	return nil
+}
+
+func TestComputeRefund(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 3))
+
+	testCases := []struct {
+		name     string
+		floor    sdk.Coins
+		gasLimit uint64
+		gasUsed  uint64
+		ratio    math.LegacyDec
+		expected sdk.Coins
+	}{
+		{
+			name:     "full refund of unused gas",
+			gasLimit: 100,
+			gasUsed:  40,
+			ratio:    math.LegacyOneDec(),
+			expected: sdk.NewCoins(sdk.NewInt64Coin("atom", 600), sdk.NewInt64Coin("stake", 1)),
+		},
+		{
+			name:     "partial refund truncated per denom",
+			gasLimit: 100,
+			gasUsed:  40,
+			ratio:    math.LegacyNewDecWithPrec(5, 1),
+			expected: sdk.NewCoins(sdk.NewInt64Coin("atom", 300)),
+		},
+		{
+			name:     "refund capped by the floor",
+			floor:    sdk.NewCoins(sdk.NewInt64Coin("atom", 900)),
+			gasLimit: 100,
+			gasUsed:  1,
+			ratio:    math.LegacyOneDec(),
+			expected: sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 2)),
+		},
+		{
+			name:     "floor above the fee",
+			floor:    sdk.NewCoins(sdk.NewInt64Coin("atom", 2000), sdk.NewInt64Coin("stake", 3)),
+			gasLimit: 100,
+			gasUsed:  1,
+			ratio:    math.LegacyOneDec(),
+			expected: sdk.Coins{},
+		},
+		{
+			name:     "all gas used",
+			gasLimit: 100,
+			gasUsed:  100,
+			ratio:    math.LegacyOneDec(),
+			expected: sdk.Coins{},
+		},
+		{
+			name:     "gas used above limit",
+			gasLimit: 100,
+			gasUsed:  101,
+			ratio:    math.LegacyOneDec(),
+			expected: sdk.Coins{},
+		},
+		{
+			name:     "zero gas limit",
+			ratio:    math.LegacyOneDec(),
+			expected: sdk.Coins{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, posthandler.ComputeRefund(fee, tc.floor, tc.gasLimit, tc.gasUsed, tc.ratio))
+		})
+	}
+}
+
+func TestRefundDecorator(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	txBuilder.SetGasLimit(100)
+	tx := txBuilder.GetTx()
+
+	payer := sdk.AccAddress("payer")
+	deductedFee := ante.DeductedFee{
+		Amount:          sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+		Payer:           payer,
+		RecipientModule: authtypes.FeeCollectorName,
+	}
+
+	newCtx := func(gasUsed uint64) sdk.Context {
+		ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).
+			WithGasMeter(storetypes.NewGasMeter(100))
+		ctx.GasMeter().ConsumeGas(gasUsed, "test")
+		return ante.WithDeductedFee(ctx, deductedFee)
+	}
+
+	bk := &mockBankKeeper{}
+	postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{
+		BankKeeper:  bk,
+		RefundRatio: math.LegacyNewDecWithPrec(5, 1),
+	})
+	require.NoError(t, err)
+
+	ctx := newCtx(20)
+	_, err = postHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+	require.Equal(t, []refund{{
+		module:    authtypes.FeeCollectorName,
+		recipient: payer,
+		amount:    sdk.NewCoins(sdk.NewInt64Coin("atom", 400)),
+	}}, bk.refunds)
+	require.Equal(t, uint64(20), ctx.GasMeter().GasConsumed(), "refund must not consume gas")
+
+	attrs := ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Attributes
+	require.Equal(t, sdk.AttributeKeyFeeRefund, attrs[0].Key)
+	require.Equal(t, "400atom", attrs[0].Value)
+
+	// the minimum fee required by the messages is not refunded
+	bk.refunds = nil
+	ctx = ante.WithRequiredMinFee(newCtx(20), sdk.NewCoins(sdk.NewInt64Coin("atom", 700)))
+	_, err = postHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 300)), bk.refunds[0].amount)
+
+	// nor is the fee for the gas used at the minimum gas prices
+	bk.refunds = nil
+	ctx = newCtx(20).WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 40)))
+	_, err = postHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 200)), bk.refunds[0].amount)
+
+	// nor at the consensus minimum gas prices required by the messages
+	bk.refunds = nil
+	ctx = ante.WithRequiredMinGasPrices(newCtx(20), sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 40)))
+	_, err = postHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 200)), bk.refunds[0].amount)
+
+	// failed transactions are not refunded
+	bk.refunds = nil
+	_, err = postHandler(newCtx(20), tx, false, false)
+	require.NoError(t, err)
+	require.Empty(t, bk.refunds)
+
+	// no fee deducted by the ante handler
+	ctx = testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+	_, err = postHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+	require.Empty(t, bk.refunds)
+}
+
+func TestNewPostHandler(t *testing.T) {
+	_, err := posthandler.NewPostHandler(posthandler.HandlerOptions{})
+	require.NoError(t, err)
+
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{RefundRatio: math.LegacyOneDec()})
+	require.ErrorContains(t, err, "bank keeper is required")
+
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{BankKeeper: &mockBankKeeper{}, RefundRatio: math.LegacyNewDec(2)})
+	require.ErrorContains(t, err, "refund ratio must be in [0, 1]")
+}
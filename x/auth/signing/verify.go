@@ -34,6 +34,14 @@ func APISignModeToInternal(mode signingv1beta1.SignMode) (signing.SignMode, erro
 		return signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil
 	case signingv1beta1.SignMode_SIGN_MODE_DIRECT_AUX:
 		return signing.SignMode_SIGN_MODE_DIRECT_AUX, nil
+	case signingv1beta1.SignMode_SIGN_MODE_CLEAR_SIGNING:
+		return signing.SignMode_SIGN_MODE_CLEAR_SIGNING, nil
+	case signingv1beta1.SignMode_SIGN_MODE_BLS_AGGREGATE:
+		return signing.SignMode_SIGN_MODE_BLS_AGGREGATE, nil
+	case signingv1beta1.SignMode_SIGN_MODE_EIP_191:
+		return signing.SignMode_SIGN_MODE_EIP_191, nil
+	case signingv1beta1.SignMode_SIGN_MODE_EIP_712:
+		return signing.SignMode_SIGN_MODE_EIP_712, nil
 	default:
 		return signing.SignMode_SIGN_MODE_UNSPECIFIED, fmt.Errorf("unsupported sign mode %s", mode)
 	}
@@ -48,6 +56,14 @@ func internalSignModeToAPI(mode signing.SignMode) (signingv1beta1.SignMode, erro
 		return signingv1beta1.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil
 	case signing.SignMode_SIGN_MODE_DIRECT_AUX:
 		return signingv1beta1.SignMode_SIGN_MODE_DIRECT_AUX, nil
+	case signing.SignMode_SIGN_MODE_CLEAR_SIGNING:
+		return signingv1beta1.SignMode_SIGN_MODE_CLEAR_SIGNING, nil
+	case signing.SignMode_SIGN_MODE_BLS_AGGREGATE:
+		return signingv1beta1.SignMode_SIGN_MODE_BLS_AGGREGATE, nil
+	case signing.SignMode_SIGN_MODE_EIP_191:
+		return signingv1beta1.SignMode_SIGN_MODE_EIP_191, nil
+	case signing.SignMode_SIGN_MODE_EIP_712:
+		return signingv1beta1.SignMode_SIGN_MODE_EIP_712, nil
 	default:
 		return signingv1beta1.SignMode_SIGN_MODE_UNSPECIFIED, fmt.Errorf("unsupported sign mode %s", mode)
 	}
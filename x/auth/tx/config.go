@@ -10,8 +10,12 @@ import (
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	txsigning "github.com/cosmos/cosmos-sdk/x/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/tx/signing/aminojson"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/blsaggregate"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/clearsign"
 	"github.com/cosmos/cosmos-sdk/x/tx/signing/direct"
 	"github.com/cosmos/cosmos-sdk/x/tx/signing/directaux"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/eip191"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/eip712"
 )
 
 type config struct {
@@ -47,6 +51,13 @@ type ConfigOptions struct {
 	// If nil, a default aminojson.Encoder is created using the FileResolver and TypeResolver
 	// derived from SigningOptions. See https://github.com/cosmos/cosmos-sdk/issues/25221.
 	AminoJSONEncoder *aminojson.Encoder
+	// EIP712Options are the options of the SIGN_MODE_EIP_712 handler, used when it is enabled. Its FileResolver
+	// and TypeResolver default to the ones of SigningOptions.
+	EIP712Options eip712.SignModeHandlerOptions
+	// ClearSignCoinMetadataQueryFn is the function the SIGN_MODE_CLEAR_SIGNING handler uses to query the denom
+	// metadata rendering coins. It is required to enable SIGN_MODE_CLEAR_SIGNING. See
+	// NewBankKeeperCoinMetadataQueryFn and NewGRPCCoinMetadataQueryFn.
+	ClearSignCoinMetadataQueryFn clearsign.CoinMetadataQueryFn
 	// ProtoDecoder is the decoder that will be used to decode protobuf transactions.
 	ProtoDecoder sdk.TxDecoder
 	// ProtoEncoder is the encoder that will be used to encode protobuf transactions.
@@ -67,8 +78,9 @@ var DefaultSignModes = []signingtypes.SignMode{
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
 // first enabled sign mode will become the default sign mode.
 //
-// NOTE: Use NewTxConfigWithOptions to provide a custom signing handler in case the sign mode
-// is not supported by default (eg: SignMode_SIGN_MODE_EIP_191).
+// NOTE: SignMode_SIGN_MODE_EIP_191, SignMode_SIGN_MODE_EIP_712, SignMode_SIGN_MODE_CLEAR_SIGNING and
+// SignMode_SIGN_MODE_BLS_AGGREGATE are supported but not enabled by default. Use NewTxConfigWithOptions to provide
+// a custom signing handler in case the sign mode is not supported.
 //
 // We prefer to use depinject to provide client.TxConfig, but we permit this constructor usage. Within the SDK,
 // this constructor is primarily used in tests, but also sees usage in app chains like:
@@ -141,6 +153,35 @@ func NewSigningHandlerMap(configOpts ConfigOptions) (*txsigning.HandlerMap, erro
 				TypeResolver: signingOpts.TypeResolver,
 				Encoder:      configOpts.AminoJSONEncoder,
 			})
+		case signingtypes.SignMode_SIGN_MODE_EIP_191:
+			handlers[i] = eip191.NewSignModeHandler(eip191.SignModeHandlerOptions{
+				FileResolver: signingOpts.FileResolver,
+				TypeResolver: signingOpts.TypeResolver,
+				Encoder:      configOpts.AminoJSONEncoder,
+			})
+		case signingtypes.SignMode_SIGN_MODE_EIP_712:
+			eip712Opts := configOpts.EIP712Options
+			if eip712Opts.FileResolver == nil {
+				eip712Opts.FileResolver = signingOpts.FileResolver
+			}
+			if eip712Opts.TypeResolver == nil {
+				eip712Opts.TypeResolver = signingOpts.TypeResolver
+			}
+			handlers[i] = eip712.NewSignModeHandler(eip712Opts)
+		case signingtypes.SignMode_SIGN_MODE_CLEAR_SIGNING:
+			if configOpts.ClearSignCoinMetadataQueryFn == nil {
+				return nil, fmt.Errorf("cannot enable %s without a ClearSignCoinMetadataQueryFn", m)
+			}
+			handlers[i], err = clearsign.NewSignModeHandler(clearsign.SignModeOptions{
+				CoinMetadataQuerier: configOpts.ClearSignCoinMetadataQueryFn,
+				FileResolver:        signingOpts.FileResolver,
+				TypeResolver:        signingOpts.TypeResolver,
+			})
+			if err != nil {
+				return nil, err
+			}
+		case signingtypes.SignMode_SIGN_MODE_BLS_AGGREGATE:
+			handlers[i] = blsaggregate.SignModeHandler{}
 		}
 	}
 	for i, m := range configOpts.CustomSignModes {
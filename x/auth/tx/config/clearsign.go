@@ -0,0 +1,64 @@
+package tx
+
+import (
+	"context"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/clearsign"
+)
+
+// NewBankKeeperCoinMetadataQueryFn creates a new clear signing coin metadata
+// query function querying the bank keeper directly, to be used on the node.
+func NewBankKeeperCoinMetadataQueryFn(bk BankKeeper) clearsign.CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		res, err := bk.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		if err != nil {
+			return nil, metadataExists(err)
+		}
+
+		// convert the gogoproto metadata to its protoreflect counterpart
+		bz, err := gogoproto.Marshal(&res.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		m := &bankv1beta1.Metadata{}
+		if err := proto.Unmarshal(bz, m); err != nil {
+			return nil, err
+		}
+
+		return m, nil
+	}
+}
+
+// NewGRPCCoinMetadataQueryFn creates a new clear signing coin metadata query
+// function querying the bank module over the given gRPC connection, to be
+// used by clients.
+func NewGRPCCoinMetadataQueryFn(grpcConn grpc.ClientConnInterface) clearsign.CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		bankQueryClient := bankv1beta1.NewQueryClient(grpcConn)
+		res, err := bankQueryClient.DenomMetadata(ctx, &bankv1beta1.QueryDenomMetadataRequest{Denom: denom})
+		if err != nil {
+			return nil, metadataExists(err)
+		}
+
+		return res.Metadata, nil
+	}
+}
+
+// metadataExists returns nil if the error is a NotFound error, so that coins
+// without metadata are rendered in their base denom.
+func metadataExists(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+
+	return err
+}
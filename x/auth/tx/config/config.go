@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/registry"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -44,6 +45,8 @@ type ModuleInputs struct {
 	FeeGrantKeeper         ante.FeegrantKeeper                `optional:"true"`
 	CustomSignModeHandlers func() []txsigning.SignModeHandler `optional:"true"`
 	CustomGetSigners       []txsigning.CustomGetSigner        `optional:"true"`
+	// TxBankKeeper is the bank keeper used to query denom metadata, enabling SIGN_MODE_CLEAR_SIGNING
+	TxBankKeeper BankKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -75,6 +78,15 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		CustomSignModes: customSignModeHandlers,
 	}
 
+	// enable SIGN_MODE_CLEAR_SIGNING only if a bank keeper is available for querying the denom metadata
+	if in.TxBankKeeper != nil {
+		txConfigOptions.EnabledSignModes = append(
+			append([]signingtypes.SignMode{}, tx.DefaultSignModes...),
+			signingtypes.SignMode_SIGN_MODE_CLEAR_SIGNING,
+		)
+		txConfigOptions.ClearSignCoinMetadataQueryFn = NewBankKeeperCoinMetadataQueryFn(in.TxBankKeeper)
+	}
+
 	for _, mode := range in.CustomGetSigners {
 		txConfigOptions.SigningOptions.CustomGetSigners[mode.MsgType] = mode.Fn
 	}
@@ -131,12 +143,16 @@ func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler,
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
 	}
 
+	// the x/auth keeper holds the per message type fees
+	msgFeeKeeper, _ := in.AccountKeeper.(ante.MsgFeeKeeper)
+
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
 			AccountKeeper:   in.AccountKeeper,
 			BankKeeper:      in.BankKeeper,
 			SignModeHandler: txConfig.SignModeHandler(),
 			FeegrantKeeper:  in.FeeGrantKeeper,
+			MsgFeeKeeper:    msgFeeKeeper,
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 		},
 	)
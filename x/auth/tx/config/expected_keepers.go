@@ -0,0 +1,12 @@
+package tx
+
+import (
+	"context"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BankKeeper defines the contract needed for tx-related APIs
+type BankKeeper interface {
+	DenomMetadata(c context.Context, req *banktypes.QueryDenomMetadataRequest) (*banktypes.QueryDenomMetadataResponse, error)
+}
@@ -24,20 +24,40 @@ import (
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppGasProfileFn is the signature of the Baseapp#SimulateWithGasProfile
+// function.
+type baseAppGasProfileFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, *sdk.GasProfile, error)
+
+// TxServerOption configures optional behavior of the Tx service server.
+type TxServerOption func(*txServer)
+
+// WithGasProfileSimulator enables gas profiling for Simulate requests setting
+// gas_profile, using the given Baseapp#SimulateWithGasProfile function.
+func WithGasProfileSimulator(simulateWithGasProfile baseAppGasProfileFn) TxServerOption {
+	return func(s *txServer) {
+		s.simulateWithGasProfile = simulateWithGasProfile
+	}
+}
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
-	clientCtx         client.Context
-	simulate          baseAppSimulateFn
-	interfaceRegistry codectypes.InterfaceRegistry
+	clientCtx              client.Context
+	simulate               baseAppSimulateFn
+	simulateWithGasProfile baseAppGasProfileFn
+	interfaceRegistry      codectypes.InterfaceRegistry
 }
 
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
-	return txServer{
+func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry, opts ...TxServerOption) txtypes.ServiceServer {
+	s := txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
 		interfaceRegistry: interfaceRegistry,
 	}
+	for _, opt := range opts {
+		opt(&s)
+	}
+	return s
 }
 
 var _ txtypes.ServiceServer = txServer{}
@@ -95,6 +115,23 @@ func (s txServer) Simulate(_ context.Context, req *txtypes.SimulateRequest) (*tx
 		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
 	}
 
+	if req.GasProfile {
+		if s.simulateWithGasProfile == nil {
+			return nil, status.Error(codes.Unimplemented, "gas profiling is not enabled on this node")
+		}
+
+		gasInfo, result, gasProfile, err := s.simulateWithGasProfile(txBytes)
+		if err != nil {
+			return nil, status.Errorf(codes.Unknown, "%v with gas used: '%d'", err, gasInfo.GasUsed)
+		}
+
+		return &txtypes.SimulateResponse{
+			GasInfo:    &gasInfo,
+			Result:     result,
+			GasProfile: gasProfile,
+		}, nil
+	}
+
 	gasInfo, result, err := s.simulate(txBytes)
 	if err != nil {
 		return nil, status.Errorf(codes.Unknown, "%v with gas used: '%d'", err, gasInfo.GasUsed)
@@ -315,10 +352,11 @@ func RegisterTxService(
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	opts ...TxServerOption,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, interfaceRegistry, opts...),
 	)
 }
 
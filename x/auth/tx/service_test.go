@@ -0,0 +1,45 @@
+package tx_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func TestSimulateGasProfile(t *testing.T) {
+	gasInfo := sdk.GasInfo{GasWanted: 100, GasUsed: 42}
+	simulate := func([]byte) (sdk.GasInfo, *sdk.Result, error) {
+		return gasInfo, &sdk.Result{}, nil
+	}
+	simulateWithGasProfile := func([]byte) (sdk.GasInfo, *sdk.Result, *sdk.GasProfile, error) {
+		return gasInfo, &sdk.Result{}, &sdk.GasProfile{GasUsed: gasInfo.GasUsed}, nil
+	}
+	txBytes := []byte("tx")
+
+	// without the option, profiling requests are rejected
+	srv := tx.NewTxServer(client.Context{}, simulate, nil)
+	_, err := srv.Simulate(context.Background(), &txtypes.SimulateRequest{TxBytes: txBytes, GasProfile: true})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	res, err := srv.Simulate(context.Background(), &txtypes.SimulateRequest{TxBytes: txBytes})
+	require.NoError(t, err)
+	require.Nil(t, res.GasProfile)
+
+	srv = tx.NewTxServer(client.Context{}, simulate, nil, tx.WithGasProfileSimulator(simulateWithGasProfile))
+	res, err = srv.Simulate(context.Background(), &txtypes.SimulateRequest{TxBytes: txBytes, GasProfile: true})
+	require.NoError(t, err)
+	require.Equal(t, gasInfo, *res.GasInfo)
+	require.Equal(t, &sdk.GasProfile{GasUsed: 42}, res.GasProfile)
+
+	res, err = srv.Simulate(context.Background(), &txtypes.SimulateRequest{TxBytes: txBytes})
+	require.NoError(t, err)
+	require.Nil(t, res.GasProfile)
+}
@@ -4,21 +4,29 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -173,6 +181,20 @@ type Params struct {
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
 	SigVerifyCostMlDsa65   uint64 `protobuf:"varint,6,opt,name=sig_verify_cost_mldsa65,json=sigVerifyCostMldsa65,proto3" json:"sig_verify_cost_mldsa65,omitempty"`
+	// pub_key_rotation_cooldown is the minimum duration between two rotations of
+	// the public key of an account with MsgRotatePubKey.
+	PubKeyRotationCooldown time.Duration `protobuf:"bytes,7,opt,name=pub_key_rotation_cooldown,json=pubKeyRotationCooldown,proto3,stdduration" json:"pub_key_rotation_cooldown"`
+	// pub_key_rotation_window is the period over which max_pub_key_rotations
+	// applies.
+	PubKeyRotationWindow time.Duration `protobuf:"bytes,8,opt,name=pub_key_rotation_window,json=pubKeyRotationWindow,proto3,stdduration" json:"pub_key_rotation_window"`
+	// max_pub_key_rotations is the maximum number of rotations of the public key
+	// of an account within pub_key_rotation_window. Zero disables MsgRotatePubKey.
+	MaxPubKeyRotations uint64 `protobuf:"varint,9,opt,name=max_pub_key_rotations,json=maxPubKeyRotations,proto3" json:"max_pub_key_rotations,omitempty"`
+	// min_gas_prices are the consensus minimum gas prices, scaled by the highest
+	// gas multiplier of the messages of a transaction. Unlike the validator
+	// minimum gas prices, they are enforced in every execution mode. Empty
+	// disables the check.
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,10,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -250,66 +272,421 @@ func (m *Params) GetSigVerifyCostMlDsa65() uint64 {
 	return 0
 }
 
+func (m *Params) GetPubKeyRotationCooldown() time.Duration {
+	if m != nil {
+		return m.PubKeyRotationCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetPubKeyRotationWindow() time.Duration {
+	if m != nil {
+		return m.PubKeyRotationWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPubKeyRotations() uint64 {
+	if m != nil {
+		return m.MaxPubKeyRotations
+	}
+	return 0
+}
+
+func (m *Params) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+// MsgFee defines the gas pricing and minimum fee applied to transactions
+// containing a given message type.
+type MsgFee struct {
+	// msg_type_url is the type URL of the message, e.g. "/cosmos.gov.v1.MsgVote".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// gas_multiplier scales the validator minimum gas prices for transactions
+	// containing the message. When a transaction contains several messages, the
+	// highest multiplier applies.
+	GasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=gas_multiplier,json=gasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gas_multiplier"`
+	// min_fee is the flat minimum fee charged for each occurrence of the message
+	// in a transaction.
+	MinFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_fee,json=minFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fee"`
+}
+
+func (m *MsgFee) Reset()         { *m = MsgFee{} }
+func (m *MsgFee) String() string { return proto.CompactTextString(m) }
+func (*MsgFee) ProtoMessage()    {}
+func (*MsgFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *MsgFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFee.Merge(m, src)
+}
+func (m *MsgFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFee proto.InternalMessageInfo
+
+func (m *MsgFee) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgFee) GetMinFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFee
+	}
+	return nil
+}
+
+// PubKeyRotation records the rotation of the public key of an account with
+// MsgRotatePubKey.
+type PubKeyRotation struct {
+	// address is the address of the account, which is kept by the rotation.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// old_pub_key is the public key of the account before the rotation.
+	OldPubKey *any.Any `protobuf:"bytes,2,opt,name=old_pub_key,json=oldPubKey,proto3" json:"old_pub_key,omitempty"`
+	// new_pub_key is the public key of the account after the rotation.
+	NewPubKey *any.Any `protobuf:"bytes,3,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+	// height is the block height of the rotation.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the rotation.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PubKeyRotation) Reset()         { *m = PubKeyRotation{} }
+func (m *PubKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*PubKeyRotation) ProtoMessage()    {}
+func (*PubKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{5}
+}
+func (m *PubKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyRotation.Merge(m, src)
+}
+func (m *PubKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyRotation proto.InternalMessageInfo
+
+func (m *PubKeyRotation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PubKeyRotation) GetOldPubKey() *any.Any {
+	if m != nil {
+		return m.OldPubKey
+	}
+	return nil
+}
+
+func (m *PubKeyRotation) GetNewPubKey() *any.Any {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+func (m *PubKeyRotation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PubKeyRotation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// AccountAuthenticator defines an authenticator registered by an account. When
+// an account has authenticators, its signatures are authenticated by them
+// instead of its public key, the transaction being authenticated if any of
+// them accepts it.
+type AccountAuthenticator struct {
+	// address is the address of the account which registered the authenticator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// id is the identifier of the authenticator, unique across all the accounts.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// authenticator_type is the type of the authenticator, e.g.
+	// "SignatureVerification", "SpendLimit", "MessageFilter", "AllOf" or "AnyOf".
+	AuthenticatorType string `protobuf:"bytes,3,opt,name=authenticator_type,json=authenticatorType,proto3" json:"authenticator_type,omitempty"`
+	// config is the configuration of the authenticator, whose encoding depends on
+	// its type.
+	Config []byte `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (m *AccountAuthenticator) Reset()         { *m = AccountAuthenticator{} }
+func (m *AccountAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*AccountAuthenticator) ProtoMessage()    {}
+func (*AccountAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{6}
+}
+func (m *AccountAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAuthenticator.Merge(m, src)
+}
+func (m *AccountAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAuthenticator proto.InternalMessageInfo
+
+func (m *AccountAuthenticator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountAuthenticator) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AccountAuthenticator) GetAuthenticatorType() string {
+	if m != nil {
+		return m.AuthenticatorType
+	}
+	return ""
+}
+
+func (m *AccountAuthenticator) GetConfig() []byte {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// SpendLimitUsage defines the usage of a SpendLimit authenticator over its
+// current period.
+type SpendLimitUsage struct {
+	// period_start is the start time of the current period.
+	PeriodStart time.Time `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3,stdtime" json:"period_start"`
+	// spent is the amount spent by the account over the current period.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// balance_before is the balance of the account before the execution of the
+	// messages of the transaction being authenticated.
+	BalanceBefore github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=balance_before,json=balanceBefore,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance_before"`
+}
+
+func (m *SpendLimitUsage) Reset()         { *m = SpendLimitUsage{} }
+func (m *SpendLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*SpendLimitUsage) ProtoMessage()    {}
+func (*SpendLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{7}
+}
+func (m *SpendLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendLimitUsage.Merge(m, src)
+}
+func (m *SpendLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendLimitUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendLimitUsage proto.InternalMessageInfo
+
+func (m *SpendLimitUsage) GetPeriodStart() time.Time {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return time.Time{}
+}
+
+func (m *SpendLimitUsage) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *SpendLimitUsage) GetBalanceBefore() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BalanceBefore
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*MsgFee)(nil), "cosmos.auth.v1beta1.MsgFee")
+	proto.RegisterType((*PubKeyRotation)(nil), "cosmos.auth.v1beta1.PubKeyRotation")
+	proto.RegisterType((*AccountAuthenticator)(nil), "cosmos.auth.v1beta1.AccountAuthenticator")
+	proto.RegisterType((*SpendLimitUsage)(nil), "cosmos.auth.v1beta1.SpendLimitUsage")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 769 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x9b, 0x90, 0xa5, 0x93, 0x6e, 0x97, 0x7a, 0x43, 0xf1, 0x46, 0x28, 0xf6, 0x46, 0x42,
-	0x1b, 0x2a, 0x62, 0x6f, 0x02, 0x59, 0xb4, 0xb9, 0x35, 0x59, 0x84, 0x56, 0x4b, 0x96, 0x95, 0x23,
-	0xf6, 0xb0, 0x17, 0x6b, 0x6c, 0xcf, 0xba, 0xa3, 0x66, 0x3c, 0xc6, 0x33, 0xae, 0xe2, 0xfd, 0x05,
-	0x15, 0x27, 0xc4, 0x85, 0x6b, 0xe1, 0xc4, 0xb1, 0x87, 0xfe, 0x08, 0xc4, 0xa9, 0xea, 0x89, 0x53,
-	0x84, 0xd2, 0x43, 0x2b, 0xc4, 0x8f, 0x40, 0x9e, 0x71, 0x9a, 0xa4, 0x84, 0x8b, 0xe5, 0xf9, 0xbe,
-	0xef, 0xbd, 0xf7, 0xbd, 0x37, 0x4f, 0x03, 0xea, 0x1e, 0x65, 0x84, 0x32, 0x0b, 0x26, 0xfc, 0xc0,
-	0x3a, 0x6a, 0xbb, 0x88, 0xc3, 0xb6, 0x38, 0x98, 0x51, 0x4c, 0x39, 0x55, 0xef, 0x4b, 0xde, 0x14,
-	0x50, 0xce, 0xd7, 0x76, 0x20, 0xc1, 0x21, 0xb5, 0xc4, 0x57, 0xea, 0x6a, 0x0f, 0xa4, 0xce, 0x11,
-	0x27, 0x2b, 0x0f, 0x92, 0x54, 0x35, 0xa0, 0x01, 0x95, 0x78, 0xf6, 0x37, 0x0f, 0x08, 0x28, 0x0d,
-	0xc6, 0xc8, 0x12, 0x27, 0x37, 0x79, 0x6b, 0xc1, 0x30, 0x95, 0x54, 0xe3, 0x97, 0x0d, 0x50, 0xe9,
-	0x43, 0x86, 0xf6, 0x3d, 0x8f, 0x26, 0x21, 0x57, 0x3b, 0xe0, 0x0e, 0xf4, 0xfd, 0x18, 0x31, 0xa6,
-	0x29, 0x86, 0xd2, 0xdc, 0xec, 0x6b, 0x17, 0x67, 0xad, 0x6a, 0x5e, 0x63, 0x5f, 0x32, 0x23, 0x1e,
-	0xe3, 0x30, 0xb0, 0xe7, 0x42, 0xf5, 0x35, 0xb8, 0x13, 0x25, 0xae, 0x73, 0x88, 0x52, 0x6d, 0xc3,
-	0x50, 0x9a, 0x95, 0x4e, 0xd5, 0x94, 0x05, 0xcd, 0x79, 0x41, 0x73, 0x3f, 0x4c, 0xfb, 0x8f, 0xfe,
-	0x9e, 0xea, 0xd5, 0x28, 0x71, 0xc7, 0xd8, 0xcb, 0xb4, 0x9f, 0x51, 0x82, 0x39, 0x22, 0x11, 0x4f,
-	0x7f, 0xbd, 0x3a, 0xdd, 0x03, 0x0b, 0xc2, 0x2e, 0x47, 0x89, 0xfb, 0x02, 0xa5, 0xea, 0x27, 0x60,
-	0x1b, 0x4a, 0x5b, 0x4e, 0x98, 0x10, 0x17, 0xc5, 0x5a, 0xd1, 0x50, 0x9a, 0x25, 0xfb, 0x6e, 0x8e,
-	0xbe, 0x14, 0xa0, 0x5a, 0x03, 0xef, 0x33, 0xf4, 0x7d, 0x82, 0x42, 0x0f, 0x69, 0x25, 0x21, 0xb8,
-	0x39, 0xf7, 0x06, 0xc7, 0x27, 0x7a, 0xe1, 0xfa, 0x44, 0x2f, 0xfc, 0x71, 0xd6, 0xfa, 0x78, 0xcd,
-	0x78, 0xcd, 0xbc, 0xef, 0xe7, 0x3f, 0x5c, 0x9d, 0xee, 0xed, 0x4a, 0x41, 0x8b, 0xf9, 0x87, 0xd6,
-	0xd2, 0x4c, 0x1a, 0xff, 0x28, 0xe0, 0xee, 0x90, 0xfa, 0xc9, 0xf8, 0x66, 0x4a, 0xcf, 0xc1, 0x96,
-	0x0b, 0x19, 0x72, 0x72, 0x23, 0x62, 0x54, 0x95, 0x8e, 0x61, 0xae, 0xab, 0xb0, 0x94, 0xa9, 0x5f,
-	0x3a, 0x9f, 0xea, 0x8a, 0x5d, 0x71, 0x97, 0x06, 0xae, 0x82, 0x52, 0x08, 0x09, 0x12, 0x93, 0xdb,
-	0xb4, 0xc5, 0xbf, 0x6a, 0x80, 0x4a, 0x84, 0x62, 0x82, 0x19, 0xc3, 0x34, 0x64, 0x5a, 0xd1, 0x28,
-	0x36, 0x37, 0xed, 0x65, 0xa8, 0xf7, 0xe6, 0x58, 0xf6, 0xd4, 0x58, 0x57, 0x71, 0xc5, 0xab, 0xe8,
-	0x4c, 0x5b, 0xea, 0x6c, 0x85, 0xfd, 0xe9, 0xea, 0x74, 0x6f, 0x9b, 0x08, 0x64, 0xde, 0x4c, 0xe3,
-	0x67, 0x05, 0x7c, 0x20, 0x45, 0x83, 0x18, 0xf9, 0x28, 0xe4, 0x18, 0x8e, 0x55, 0x1d, 0x54, 0x72,
-	0x99, 0x70, 0x2b, 0x76, 0xc3, 0x06, 0x12, 0x7a, 0x99, 0x79, 0x7e, 0x04, 0xee, 0xf9, 0x28, 0xc6,
-	0x47, 0x90, 0x63, 0x1a, 0x66, 0xd7, 0xc8, 0xb4, 0x0d, 0xa3, 0xd8, 0xdc, 0xb2, 0xb7, 0x17, 0xf0,
-	0x0b, 0x94, 0xb2, 0xde, 0xd3, 0x8b, 0xb3, 0xd6, 0xbd, 0x85, 0x1f, 0xe3, 0xb1, 0xf9, 0xc5, 0x97,
-	0x99, 0xc7, 0x87, 0x4b, 0x1e, 0xbf, 0x8e, 0x69, 0x12, 0xe5, 0x16, 0x17, 0x26, 0x1a, 0xbf, 0x15,
-	0x41, 0xf9, 0x15, 0x8c, 0x21, 0x61, 0xaa, 0x09, 0xee, 0x13, 0x38, 0x71, 0x08, 0x22, 0xd4, 0xf1,
-	0x0e, 0x60, 0x0c, 0x3d, 0x8e, 0x62, 0xb9, 0xb3, 0x25, 0x7b, 0x87, 0xc0, 0xc9, 0x10, 0x11, 0x3a,
-	0xb8, 0x21, 0x54, 0x03, 0x6c, 0xf1, 0x89, 0xc3, 0x70, 0xe0, 0x8c, 0x31, 0xc1, 0x5c, 0x8c, 0xbb,
-	0x64, 0x03, 0x3e, 0x19, 0xe1, 0xe0, 0x9b, 0x0c, 0x51, 0x1f, 0x83, 0x0f, 0x85, 0xe2, 0x1d, 0x72,
-	0x3c, 0xca, 0xb8, 0x13, 0xa1, 0xd8, 0x71, 0x53, 0x8e, 0xf2, 0xa5, 0xdb, 0xc9, 0xa4, 0xef, 0xd0,
-	0x80, 0x32, 0xfe, 0x0a, 0xc5, 0xfd, 0x94, 0x23, 0xf5, 0x5b, 0xf0, 0x51, 0x96, 0xf0, 0x08, 0xc5,
-	0xf8, 0x6d, 0x2a, 0x83, 0x90, 0xdf, 0xe9, 0x76, 0xdb, 0x4f, 0xe5, 0x1e, 0xf6, 0xb5, 0xd9, 0x54,
-	0xaf, 0x8e, 0x70, 0xf0, 0x5a, 0x28, 0xb2, 0xd0, 0xaf, 0x9e, 0x09, 0xde, 0xae, 0xb2, 0x15, 0x54,
-	0x46, 0xa9, 0xdf, 0x81, 0x07, 0xb7, 0x13, 0x32, 0xe4, 0x45, 0x9d, 0xee, 0x93, 0xc3, 0xb6, 0xf6,
-	0x9e, 0x48, 0x59, 0x9b, 0x4d, 0xf5, 0xdd, 0x95, 0x94, 0xa3, 0xb9, 0xc2, 0xde, 0x65, 0x6b, 0xf1,
-	0x75, 0x3e, 0xc9, 0xd8, 0x67, 0xf0, 0x49, 0x57, 0x2b, 0xff, 0x8f, 0xcf, 0xe1, 0xf8, 0x59, 0xc6,
-	0xdf, 0xf2, 0x39, 0x94, 0x51, 0xbd, 0x87, 0xd7, 0x27, 0xba, 0x72, 0x7b, 0xaf, 0x26, 0xf2, 0x5d,
-	0x93, 0xf7, 0xd3, 0x1f, 0xfc, 0x3e, 0xab, 0x2b, 0xe7, 0xb3, 0xba, 0xf2, 0xd7, 0xac, 0xae, 0xfc,
-	0x78, 0x59, 0x2f, 0x9c, 0x5f, 0xd6, 0x0b, 0x7f, 0x5e, 0xd6, 0x0b, 0x6f, 0x3e, 0x0d, 0x30, 0x3f,
-	0x48, 0x5c, 0xd3, 0xa3, 0x24, 0x7f, 0xbb, 0xac, 0xff, 0x66, 0xe1, 0x69, 0x84, 0x98, 0x5b, 0x16,
-	0xef, 0xc7, 0xe7, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x48, 0xab, 0xc7, 0x44, 0x39, 0x05, 0x00,
-	0x00,
+	// 1342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
+	0x1b, 0x66, 0x6d, 0xc7, 0x84, 0xb1, 0x71, 0x3e, 0x16, 0x87, 0x2c, 0x7c, 0x91, 0xd7, 0xb1, 0xf4,
+	0x29, 0xfe, 0xf8, 0x3e, 0xec, 0xe0, 0x16, 0xaa, 0x20, 0xf5, 0x80, 0x4d, 0x89, 0xa2, 0x84, 0x14,
+	0x2d, 0x49, 0x2a, 0x45, 0xaa, 0x56, 0xe3, 0xdd, 0x61, 0x3d, 0x62, 0x77, 0x67, 0xbb, 0x33, 0x0b,
+	0x38, 0x7f, 0x41, 0xd4, 0x4b, 0xa3, 0x5e, 0x5a, 0xf5, 0x50, 0xa5, 0x3d, 0x55, 0x3d, 0x71, 0x40,
+	0xfd, 0x1b, 0xa2, 0x9c, 0x10, 0xa7, 0x2a, 0x07, 0x52, 0x91, 0x03, 0x51, 0x55, 0xf5, 0xdc, 0x63,
+	0x35, 0x33, 0x6b, 0x63, 0x1b, 0xa7, 0x4a, 0x53, 0x29, 0x17, 0xcb, 0xf3, 0xfe, 0x78, 0xde, 0xe7,
+	0x7d, 0x66, 0xe6, 0x9d, 0x05, 0x05, 0x8b, 0x50, 0x8f, 0xd0, 0x2a, 0x8c, 0x58, 0xab, 0xba, 0x3d,
+	0xdf, 0x44, 0x0c, 0xce, 0x8b, 0x45, 0x25, 0x08, 0x09, 0x23, 0xea, 0xa4, 0xf4, 0x57, 0x84, 0x29,
+	0xf6, 0xcf, 0x4c, 0x40, 0x0f, 0xfb, 0xa4, 0x2a, 0x7e, 0x65, 0xdc, 0xcc, 0xb4, 0x8c, 0x33, 0xc5,
+	0xaa, 0x1a, 0x27, 0x49, 0x57, 0xa7, 0x44, 0x13, 0x52, 0xd4, 0x2d, 0x61, 0x11, 0xec, 0xc7, 0xfe,
+	0xbc, 0x43, 0x1c, 0x22, 0xf3, 0xf8, 0xbf, 0x0e, 0xa0, 0x43, 0x88, 0xe3, 0xa2, 0xaa, 0x58, 0x35,
+	0xa3, 0xcd, 0x2a, 0xf4, 0xdb, 0x1d, 0xc0, 0x41, 0x97, 0x1d, 0x85, 0x90, 0x61, 0xd2, 0x01, 0xd4,
+	0x07, 0xfd, 0x0c, 0x7b, 0x88, 0x32, 0xe8, 0x05, 0x32, 0xa0, 0xf4, 0x5d, 0x02, 0x64, 0xea, 0x90,
+	0xa2, 0x65, 0xcb, 0x22, 0x91, 0xcf, 0xd4, 0x1a, 0x18, 0x85, 0xb6, 0x1d, 0x22, 0x4a, 0x35, 0xa5,
+	0xa8, 0x94, 0xc7, 0xea, 0xda, 0xe1, 0xfe, 0x5c, 0x3e, 0x6e, 0x62, 0x59, 0x7a, 0x36, 0x58, 0x88,
+	0x7d, 0xc7, 0xe8, 0x04, 0xaa, 0xf7, 0xc1, 0x68, 0x10, 0x35, 0xcd, 0x2d, 0xd4, 0xd6, 0x12, 0x45,
+	0xa5, 0x9c, 0xa9, 0xe5, 0x2b, 0xb2, 0x6c, 0xa5, 0x53, 0xb6, 0xb2, 0xec, 0xb7, 0xeb, 0x57, 0x7f,
+	0x3d, 0xd2, 0xf3, 0x41, 0xd4, 0x74, 0xb1, 0xc5, 0x63, 0xff, 0x4f, 0x3c, 0xcc, 0x90, 0x17, 0xb0,
+	0xf6, 0xf7, 0x27, 0x7b, 0xb3, 0xe0, 0xd4, 0x61, 0xa4, 0x83, 0xa8, 0x79, 0x0b, 0xb5, 0xd5, 0xff,
+	0x80, 0x1c, 0x94, 0xb4, 0x4c, 0x3f, 0xf2, 0x9a, 0x28, 0xd4, 0x92, 0x45, 0xa5, 0x9c, 0x32, 0xc6,
+	0x63, 0xeb, 0x1d, 0x61, 0x54, 0x67, 0xc0, 0x79, 0x8a, 0x3e, 0x8b, 0x90, 0x6f, 0x21, 0x2d, 0x25,
+	0x02, 0xba, 0xeb, 0xa5, 0xc6, 0xa3, 0x27, 0xfa, 0xc8, 0xab, 0x27, 0xfa, 0xc8, 0xb3, 0xfd, 0xb9,
+	0xcb, 0x43, 0xf6, 0xaf, 0x12, 0xf7, 0x7d, 0xf3, 0xf3, 0x93, 0xbd, 0xd9, 0x29, 0x19, 0x30, 0x47,
+	0xed, 0xad, 0x6a, 0x8f, 0x26, 0xa5, 0xdf, 0x14, 0x30, 0xbe, 0x46, 0xec, 0xc8, 0xed, 0xaa, 0x74,
+	0x13, 0x64, 0xf9, 0x16, 0x9a, 0x31, 0x11, 0x21, 0x55, 0xa6, 0x56, 0xac, 0x0c, 0xab, 0xd0, 0x83,
+	0x54, 0x4f, 0x1d, 0x1c, 0xe9, 0x8a, 0x91, 0x69, 0xf6, 0x08, 0xae, 0x82, 0x94, 0x0f, 0x3d, 0x24,
+	0x94, 0x1b, 0x33, 0xc4, 0x7f, 0xb5, 0x08, 0x32, 0x01, 0x0a, 0x3d, 0x4c, 0x29, 0x26, 0x3e, 0xd5,
+	0x92, 0xc5, 0x64, 0x79, 0xcc, 0xe8, 0x35, 0x2d, 0x3d, 0x78, 0x24, 0x7b, 0x2a, 0x0d, 0xab, 0xd8,
+	0xc7, 0x55, 0x74, 0xa6, 0xf5, 0x74, 0xd6, 0xe7, 0xfd, 0xf2, 0x64, 0x6f, 0x36, 0xe7, 0x09, 0x4b,
+	0xa7, 0x99, 0xd2, 0x57, 0x0a, 0xf8, 0x97, 0x0c, 0x6a, 0x84, 0xc8, 0x46, 0x3e, 0xc3, 0xd0, 0x55,
+	0x75, 0x90, 0x89, 0xc3, 0x04, 0x5b, 0x71, 0x36, 0x0c, 0x20, 0x4d, 0x77, 0x38, 0xe7, 0xab, 0xe0,
+	0x82, 0x8d, 0x42, 0xbc, 0x2d, 0x4e, 0x1f, 0xdf, 0x46, 0xaa, 0x25, 0x8a, 0xc9, 0x72, 0xd6, 0xc8,
+	0x9d, 0x9a, 0x6f, 0xa1, 0x36, 0x5d, 0xba, 0x7e, 0xb8, 0x3f, 0x77, 0xe1, 0x94, 0x4f, 0xf1, 0x5a,
+	0xe5, 0xfd, 0x0f, 0x38, 0xc7, 0x2b, 0x3d, 0x1c, 0x6f, 0x84, 0x24, 0x0a, 0x62, 0x8a, 0xa7, 0x24,
+	0x4a, 0xbf, 0xa7, 0x41, 0x7a, 0x1d, 0x86, 0xd0, 0xa3, 0x6a, 0x05, 0x4c, 0x7a, 0x70, 0xd7, 0xf4,
+	0x90, 0x47, 0x4c, 0xab, 0x05, 0x43, 0x68, 0x31, 0x14, 0xca, 0x33, 0x9b, 0x32, 0x26, 0x3c, 0xb8,
+	0xbb, 0x86, 0x3c, 0xd2, 0xe8, 0x3a, 0xd4, 0x22, 0xc8, 0xb2, 0x5d, 0x93, 0x62, 0xc7, 0x74, 0xb1,
+	0x87, 0x99, 0x90, 0x3b, 0x65, 0x00, 0xb6, 0xbb, 0x81, 0x9d, 0xdb, 0xdc, 0xa2, 0x5e, 0x03, 0x17,
+	0x45, 0xc4, 0x43, 0x64, 0x5a, 0x84, 0x32, 0x33, 0x40, 0xa1, 0xd9, 0x6c, 0x33, 0x14, 0x1f, 0xba,
+	0x09, 0x1e, 0xfa, 0x10, 0x35, 0x08, 0x65, 0xeb, 0x28, 0xac, 0xb7, 0x19, 0x52, 0x3f, 0x06, 0x97,
+	0x38, 0xe0, 0x36, 0x0a, 0xf1, 0x66, 0x5b, 0x26, 0x21, 0xbb, 0xb6, 0xb0, 0x30, 0x7f, 0x5d, 0x9e,
+	0xc3, 0xba, 0x76, 0x7c, 0xa4, 0xe7, 0x37, 0xb0, 0x73, 0x5f, 0x44, 0xf0, 0xd4, 0x8f, 0x56, 0x84,
+	0xdf, 0xc8, 0xd3, 0x3e, 0xab, 0xcc, 0x52, 0xef, 0x81, 0xe9, 0x41, 0x40, 0x8a, 0xac, 0xa0, 0xb6,
+	0xb0, 0xb8, 0x35, 0xaf, 0x9d, 0x13, 0x90, 0x33, 0xc7, 0x47, 0xfa, 0x54, 0x1f, 0xe4, 0x46, 0x27,
+	0xc2, 0x98, 0xa2, 0x43, 0xed, 0xc3, 0x78, 0x7a, 0xae, 0x4d, 0xe1, 0xe2, 0x82, 0x96, 0x7e, 0x0d,
+	0xcf, 0x35, 0x77, 0x85, 0xfb, 0x07, 0x78, 0xae, 0xc9, 0x2c, 0x75, 0x1b, 0x4c, 0xc7, 0x17, 0xde,
+	0x0c, 0x09, 0x93, 0x3b, 0x6e, 0x11, 0xe2, 0xda, 0x64, 0xc7, 0xd7, 0x46, 0xc5, 0x5d, 0x98, 0x3e,
+	0x33, 0x02, 0x56, 0xe2, 0xc9, 0x54, 0x2f, 0x3e, 0x3d, 0xd2, 0x47, 0xbe, 0x7e, 0xa1, 0x2b, 0xcf,
+	0x07, 0x0f, 0xc2, 0xc2, 0xe2, 0x0f, 0x27, 0x7b, 0xb3, 0x8a, 0x31, 0x25, 0x07, 0x80, 0x11, 0x63,
+	0x37, 0x62, 0x68, 0x35, 0x04, 0x97, 0xce, 0xd4, 0xdd, 0xc1, 0xbe, 0x4d, 0x76, 0xb4, 0xf3, 0xff,
+	0xbc, 0x6a, 0xbe, 0xbf, 0xea, 0x27, 0x02, 0x58, 0x5d, 0x05, 0x17, 0xf9, 0x41, 0x1b, 0xac, 0x4b,
+	0xb5, 0x31, 0x21, 0xdd, 0xe4, 0x10, 0x38, 0x43, 0xf5, 0xe0, 0xee, 0x7a, 0x1f, 0x18, 0x55, 0xbf,
+	0x50, 0x40, 0xce, 0xc3, 0xbe, 0xe9, 0x40, 0xfe, 0x32, 0x60, 0x0b, 0x51, 0x0d, 0x14, 0x93, 0xe5,
+	0x4c, 0xed, 0x72, 0x67, 0x6a, 0xf0, 0xa9, 0xd0, 0xbd, 0xc3, 0x2b, 0xc8, 0x6a, 0x10, 0xec, 0xd7,
+	0x6f, 0x71, 0xda, 0x3f, 0xbe, 0xd0, 0xff, 0xe7, 0x60, 0xd6, 0x8a, 0x9a, 0x15, 0x8b, 0x78, 0xf1,
+	0x93, 0x52, 0xed, 0xb9, 0x2f, 0xac, 0x1d, 0x20, 0xda, 0xc9, 0xa1, 0xaf, 0xed, 0x30, 0xeb, 0x61,
+	0xff, 0x06, 0xa4, 0xeb, 0xa2, 0xfc, 0xd2, 0x95, 0x57, 0x4f, 0x74, 0x65, 0x70, 0x3a, 0xec, 0xca,
+	0xe7, 0x4f, 0xde, 0xb2, 0xd2, 0xb7, 0x09, 0x90, 0x5e, 0xa3, 0xce, 0x2a, 0xe2, 0x33, 0x29, 0xeb,
+	0x51, 0xc7, 0xe4, 0x95, 0xcc, 0x28, 0x74, 0xbb, 0x13, 0x80, 0x3a, 0x77, 0xdb, 0x01, 0xba, 0x17,
+	0xba, 0xea, 0xa7, 0x20, 0xc7, 0x9b, 0xf3, 0x22, 0x97, 0xe1, 0xc0, 0xc5, 0x28, 0x94, 0x33, 0xad,
+	0xbe, 0xc8, 0x5b, 0x78, 0x7e, 0xa4, 0xff, 0x5b, 0x96, 0xa1, 0xf6, 0x56, 0x05, 0x93, 0xaa, 0x07,
+	0x59, 0xab, 0x72, 0x1b, 0x39, 0xd0, 0x6a, 0xaf, 0x20, 0xeb, 0x70, 0x7f, 0x0e, 0xc4, 0x32, 0xac,
+	0x20, 0x4b, 0xb2, 0x1d, 0x77, 0x20, 0x5d, 0xeb, 0x82, 0xa9, 0x0f, 0xc1, 0x28, 0xd7, 0x6f, 0x13,
+	0x21, 0x31, 0x10, 0xf9, 0x66, 0x0f, 0x13, 0x4e, 0xa8, 0xb6, 0x1a, 0xab, 0x56, 0x7e, 0x03, 0xd5,
+	0x84, 0x64, 0xdf, 0x9c, 0xec, 0xcd, 0x66, 0x5d, 0xc1, 0xc6, 0xe4, 0xef, 0x31, 0x95, 0x14, 0xd2,
+	0x1e, 0xf6, 0x57, 0x11, 0x5a, 0xba, 0xc4, 0xa5, 0x3a, 0x3c, 0x2b, 0x6b, 0xe9, 0x20, 0x01, 0x72,
+	0xfd, 0x3b, 0xfd, 0x56, 0x2f, 0xe8, 0x1d, 0x90, 0x21, 0xae, 0x6d, 0xbe, 0xc9, 0x2b, 0xaa, 0x3d,
+	0x3b, 0x45, 0xb3, 0xc2, 0x76, 0xc0, 0x48, 0x25, 0x26, 0x30, 0x46, 0x5c, 0x5b, 0xfe, 0xe5, 0x78,
+	0x3e, 0xda, 0xe9, 0xe2, 0x25, 0xdf, 0x0e, 0xcf, 0x47, 0x3b, 0x31, 0xde, 0x14, 0x48, 0xb7, 0x10,
+	0x76, 0x5a, 0x4c, 0x0c, 0xb6, 0xa4, 0x11, 0xaf, 0xd4, 0x0f, 0x41, 0x8a, 0x7f, 0x50, 0x88, 0xd9,
+	0x94, 0xa9, 0xcd, 0x9c, 0x29, 0x70, 0xb7, 0xf3, 0xb5, 0x51, 0x1f, 0xe7, 0x3b, 0xf2, 0xf8, 0x85,
+	0xae, 0x48, 0x61, 0x45, 0xda, 0xd2, 0xe4, 0x30, 0x49, 0x7f, 0x52, 0x40, 0x3e, 0x1e, 0xfd, 0xcb,
+	0x11, 0x6b, 0xf1, 0xd1, 0x6f, 0x41, 0x46, 0xc2, 0xb7, 0x12, 0x36, 0x07, 0x12, 0xd8, 0x8e, 0x87,
+	0x7d, 0x02, 0xdb, 0xea, 0x1c, 0x50, 0x61, 0x2f, 0xa8, 0x38, 0xcf, 0x42, 0x9f, 0x31, 0x63, 0xa2,
+	0xcf, 0xc3, 0x4f, 0x35, 0xef, 0xdb, 0x22, 0xfe, 0x26, 0x76, 0x44, 0xdf, 0x59, 0x23, 0x5e, 0x0d,
+	0x27, 0xfe, 0x47, 0x02, 0x5c, 0xd8, 0x08, 0x90, 0x6f, 0x8b, 0xf7, 0xe4, 0x1e, 0x85, 0x0e, 0x52,
+	0x6f, 0x83, 0x6c, 0x80, 0x42, 0x4c, 0x6c, 0x93, 0x32, 0x18, 0x76, 0x3e, 0x14, 0xfe, 0x86, 0x50,
+	0x19, 0x99, 0xbe, 0xc1, 0xb3, 0xd5, 0x1d, 0x70, 0x8e, 0x06, 0xc8, 0x67, 0x5a, 0xe2, 0x5d, 0x5d,
+	0x00, 0x59, 0x4f, 0x7d, 0xa4, 0x80, 0x5c, 0x13, 0xba, 0xd0, 0xb7, 0x90, 0xd9, 0x44, 0x9b, 0x24,
+	0x7c, 0x87, 0x77, 0x70, 0x3c, 0x2e, 0x5c, 0x17, 0x75, 0x87, 0x4a, 0x5f, 0x6f, 0x3c, 0x3d, 0x2e,
+	0x28, 0x07, 0xc7, 0x05, 0xe5, 0x97, 0xe3, 0x82, 0xf2, 0xf8, 0x65, 0x61, 0xe4, 0xe0, 0x65, 0x61,
+	0xe4, 0xe7, 0x97, 0x85, 0x91, 0x07, 0xff, 0xfd, 0xcb, 0xea, 0xf1, 0xb4, 0x13, 0x24, 0x9a, 0x69,
+	0xb1, 0x1b, 0xef, 0xfd, 0x39, 0x00, 0xd4, 0x8f, 0x9b, 0xff, 0x08, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -349,6 +726,58 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostMlDsa65 != that1.SigVerifyCostMlDsa65 {
 		return false
 	}
+	if this.PubKeyRotationCooldown != that1.PubKeyRotationCooldown {
+		return false
+	}
+	if this.PubKeyRotationWindow != that1.PubKeyRotationWindow {
+		return false
+	}
+	if this.MaxPubKeyRotations != that1.MaxPubKeyRotations {
+		return false
+	}
+	if len(this.MinGasPrices) != len(that1.MinGasPrices) {
+		return false
+	}
+	for i := range this.MinGasPrices {
+		if !this.MinGasPrices[i].Equal(&that1.MinGasPrices[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFee)
+	if !ok {
+		that2, ok := that.(MsgFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if !this.GasMultiplier.Equal(that1.GasMultiplier) {
+		return false
+	}
+	if len(this.MinFee) != len(that1.MinFee) {
+		return false
+	}
+	for i := range this.MinFee {
+		if !this.MinFee[i].Equal(&that1.MinFee[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -513,6 +942,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.MaxPubKeyRotations != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxPubKeyRotations))
+		i--
+		dAtA[i] = 0x48
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PubKeyRotationWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PubKeyRotationWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuth(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PubKeyRotationCooldown, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PubKeyRotationCooldown):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuth(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
 	if m.SigVerifyCostMlDsa65 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostMlDsa65))
 		i--
@@ -546,30 +1010,259 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuth(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BaseAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if len(m.MinFee) > 0 {
+		for iNdEx := len(m.MinFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.PubKey != nil {
-		l = m.PubKey.Size()
-		n += 1 + l + sovAuth(uint64(l))
+	{
+		size := m.GasMultiplier.Size()
+		i -= size
+		if _, err := m.GasMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PubKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintAuth(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewPubKey != nil {
+		{
+			size, err := m.NewPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldPubKey != nil {
+		{
+			size, err := m.OldPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AuthenticatorType) > 0 {
+		i -= len(m.AuthenticatorType)
+		copy(dAtA[i:], m.AuthenticatorType)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.AuthenticatorType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BalanceBefore) > 0 {
+		for iNdEx := len(m.BalanceBefore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BalanceBefore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintAuth(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuth(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BaseAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
 	}
 	if m.AccountNumber != 0 {
 		n += 1 + sovAuth(uint64(m.AccountNumber))
@@ -646,6 +1339,113 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostMlDsa65 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostMlDsa65))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PubKeyRotationCooldown)
+	n += 1 + l + sovAuth(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PubKeyRotationWindow)
+	n += 1 + l + sovAuth(uint64(l))
+	if m.MaxPubKeyRotations != 0 {
+		n += 1 + sovAuth(uint64(m.MaxPubKeyRotations))
+	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.GasMultiplier.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if len(m.MinFee) > 0 {
+		for _, e := range m.MinFee {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.OldPubKey != nil {
+		l = m.OldPubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.NewPubKey != nil {
+		l = m.NewPubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAuth(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *AccountAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovAuth(uint64(m.Id))
+	}
+	l = len(m.AuthenticatorType)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *SpendLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart)
+	n += 1 + l + sovAuth(uint64(l))
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.BalanceBefore) > 0 {
+		for _, e := range m.BalanceBefore {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -811,7 +1611,704 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
+func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationKeys = append(m.DerivationKeys, make([]byte, postIndex-iNdEx))
+			copy(m.DerivationKeys[len(m.DerivationKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			}
+			m.MaxMemoCharacters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			}
+			m.TxSigLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSigLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			}
+			m.TxSizeCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+			}
+			m.SigVerifyCostED25519 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+			}
+			m.SigVerifyCostSecp256k1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256k1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostMlDsa65", wireType)
+			}
+			m.SigVerifyCostMlDsa65 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostMlDsa65 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PubKeyRotationCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PubKeyRotationWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPubKeyRotations", wireType)
+			}
+			m.MaxPubKeyRotations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPubKeyRotations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFee = append(m.MinFee, types.Coin{})
+			if err := m.MinFee[len(m.MinFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -834,15 +2331,47 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: PubKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPubKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -869,18 +2398,18 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &BaseAccount{}
+			if m.OldPubKey == nil {
+				m.OldPubKey = &any.Any{}
 			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -890,29 +2419,52 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.NewPubKey == nil {
+				m.NewPubKey = &any.Any{}
+			}
+			if err := m.NewPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -922,23 +2474,24 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -961,7 +2514,7 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
+func (m *AccountAuthenticator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -984,15 +2537,15 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleCredential: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountAuthenticator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1020,11 +2573,62 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1051,8 +2655,10 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DerivationKeys = append(m.DerivationKeys, make([]byte, postIndex-iNdEx))
-			copy(m.DerivationKeys[len(m.DerivationKeys)-1], dAtA[iNdEx:postIndex])
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1075,7 +2681,7 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *SpendLimitUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1098,17 +2704,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpendLimitUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpendLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
 			}
-			m.MaxMemoCharacters = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1118,16 +2724,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
-			m.TxSigLimit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1137,54 +2757,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxSigLimit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.TxSizeCostPerByte = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SigVerifyCostED25519 = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceBefore", wireType)
 			}
-			m.SigVerifyCostSecp256k1 = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1194,30 +2791,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigVerifyCostSecp256k1 |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostMlDsa65", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.SigVerifyCostMlDsa65 = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SigVerifyCostMlDsa65 |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceBefore = append(m.BalanceBefore, types.Coin{})
+			if err := m.BalanceBefore[len(m.BalanceBefore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateAuthenticators validates the authenticators of the accounts. Their
+// configuration is validated when they are used, as it depends on the
+// authenticator types registered by the application.
+func ValidateAuthenticators(authenticators []AccountAuthenticator) error {
+	ids := make(map[uint64]bool, len(authenticators))
+	for _, a := range authenticators {
+		if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+			return fmt.Errorf("invalid authenticator address %s: %w", a.Address, err)
+		}
+
+		if ids[a.Id] {
+			return fmt.Errorf("duplicate authenticator id %d", a.Id)
+		}
+		ids[a.Id] = true
+
+		if a.AuthenticatorType == "" {
+			return fmt.Errorf("authenticator %d has no type", a.Id)
+		}
+	}
+
+	return nil
+}
@@ -21,6 +21,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMsgFees{}, "cosmos-sdk/x/auth/MsgUpdateMsgFees")
+	legacy.RegisterAminoMsg(cdc, &MsgRotatePubKey{}, "cosmos-sdk/x/auth/MsgRotatePubKey")
+	legacy.RegisterAminoMsg(cdc, &MsgAddAuthenticator{}, "cosmos-sdk/MsgAddAuthenticator")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveAuthenticator{}, "cosmos-sdk/MsgRemoveAuthenticator")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -57,5 +61,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateMsgFees{},
+		&MsgRotatePubKey{},
+		&MsgAddAuthenticator{},
+		&MsgRemoveAuthenticator{},
 	)
 }
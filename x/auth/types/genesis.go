@@ -8,8 +8,11 @@ import (
 
 	proto "github.com/cosmos/gogoproto/proto"
 
+	"cosmossdk.io/core/address"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
@@ -40,6 +43,11 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, rotation := range g.PubKeyRotations {
+		if err := rotation.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -62,17 +70,30 @@ func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMe
 
 // ValidateGenesis performs basic validation of auth genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error {
+func ValidateGenesis(data GenesisState, ac address.Codec) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
 
+	if err := ValidateMsgFees(data.MsgFees); err != nil {
+		return err
+	}
+
+	rotatedPubKeys, err := ValidatePubKeyRotations(ac, data.PubKeyRotations)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateAuthenticators(data.Authenticators); err != nil {
+		return err
+	}
+
 	genAccs, err := UnpackAccounts(data.Accounts)
 	if err != nil {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	return validateGenAccounts(genAccs, rotatedPubKeys)
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -122,6 +143,14 @@ func SanitizeGenesisAccounts(genAccs GenesisAccounts) GenesisAccounts {
 
 // ValidateGenAccounts validates an array of GenesisAccounts and checks for duplicates
 func ValidateGenAccounts(accounts GenesisAccounts) error {
+	return validateGenAccounts(accounts, nil)
+}
+
+// validateGenAccounts validates an array of GenesisAccounts and checks for
+// duplicates. An account whose public key is the latest one of its rotations
+// in rotatedPubKeys is validated without its public key, as a rotated public
+// key does not derive the account address.
+func validateGenAccounts(accounts GenesisAccounts, rotatedPubKeys map[string]cryptotypes.PubKey) error {
 	addrMap := make(map[string]bool, len(accounts))
 
 	for _, acc := range accounts {
@@ -133,6 +162,17 @@ func ValidateGenAccounts(accounts GenesisAccounts) error {
 
 		addrMap[addrStr] = true
 
+		if pubKey, ok := rotatedPubKeys[addrStr]; ok && acc.GetPubKey() != nil && acc.GetPubKey().Equals(pubKey) {
+			clone, ok := proto.Clone(acc.(proto.Message)).(GenesisAccount)
+			if !ok {
+				return fmt.Errorf("cannot clone account %s", addrStr)
+			}
+			if err := clone.SetPubKey(nil); err != nil {
+				return err
+			}
+			acc = clone
+		}
+
 		// check account specific validation
 		if err := acc.Validate(); err != nil {
 			return fmt.Errorf("invalid account found in genesis state; address: %s, error: %s", addrStr, err.Error())
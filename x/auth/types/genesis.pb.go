@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -31,6 +32,12 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*any.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// msg_fees are the per message type gas multipliers and minimum fees.
+	MsgFees []MsgFee `protobuf:"bytes,3,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+	// pub_key_rotations are the rotations of the public keys of the accounts.
+	PubKeyRotations []PubKeyRotation `protobuf:"bytes,4,rep,name=pub_key_rotations,json=pubKeyRotations,proto3" json:"pub_key_rotations"`
+	// authenticators are the authenticators registered by the accounts.
+	Authenticators []AccountAuthenticator `protobuf:"bytes,5,rep,name=authenticators,proto3" json:"authenticators"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +87,27 @@ func (m *GenesisState) GetAccounts() []*any.Any {
 	return nil
 }
 
+func (m *GenesisState) GetMsgFees() []MsgFee {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPubKeyRotations() []PubKeyRotation {
+	if m != nil {
+		return m.PubKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthenticators() []AccountAuthenticator {
+	if m != nil {
+		return m.Authenticators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -87,24 +115,32 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0xaf, 0x41, 0x28, 0xdd, 0xe2, 0x94,
-	0x6c, 0xfd, 0x0a, 0x88, 0x3f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x70, 0x8d, 0xea, 0x6c, 0x01, 0x00, 0x00,
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0x9b, 0x40,
+	0x10, 0xc6, 0xa1, 0xb4, 0xae, 0x8b, 0xab, 0x5a, 0xa6, 0x96, 0x8a, 0x5d, 0x89, 0xd2, 0xf6, 0x62,
+	0x57, 0xf2, 0xae, 0xed, 0xaa, 0x3d, 0x56, 0xb2, 0x2b, 0x25, 0x87, 0x28, 0x4a, 0x44, 0x6e, 0xb9,
+	0xa0, 0x85, 0xac, 0xd7, 0xc4, 0x81, 0x45, 0xec, 0x12, 0x85, 0xb7, 0xc8, 0x5b, 0x24, 0xc7, 0x1c,
+	0xf2, 0x10, 0x3e, 0xfa, 0x18, 0xe5, 0x10, 0x45, 0xf6, 0x21, 0xaf, 0x11, 0xb1, 0x4b, 0xfe, 0x0a,
+	0x5f, 0xd0, 0x30, 0xfc, 0xe6, 0xfb, 0xe6, 0xd3, 0xa0, 0x7f, 0xf7, 0x29, 0x0b, 0x29, 0x83, 0x28,
+	0xe5, 0x53, 0x78, 0x3c, 0xf0, 0x30, 0x47, 0x03, 0x48, 0x70, 0x84, 0x59, 0xc0, 0x40, 0x9c, 0x50,
+	0x4e, 0x8d, 0xcf, 0x12, 0x01, 0x39, 0x02, 0x0a, 0xa4, 0xdd, 0x22, 0x94, 0x92, 0x23, 0x0c, 0x05,
+	0xe2, 0xa5, 0x13, 0x88, 0xa2, 0x4c, 0xf2, 0xed, 0x26, 0xa1, 0x84, 0x8a, 0x12, 0xe6, 0x55, 0xd1,
+	0xb5, 0xca, 0x8c, 0x84, 0xa4, 0xfc, 0xde, 0x40, 0x61, 0x10, 0x51, 0x28, 0x9e, 0x45, 0xab, 0x25,
+	0x47, 0x5c, 0xa9, 0x55, 0x6c, 0x21, 0x5e, 0x7e, 0x9c, 0x69, 0xfa, 0xc7, 0x4d, 0xb9, 0xe5, 0x1e,
+	0x47, 0x1c, 0x1b, 0xff, 0xf4, 0x4a, 0x8c, 0x12, 0x14, 0x32, 0x53, 0xb5, 0xd5, 0x4e, 0x6d, 0xf8,
+	0x15, 0x94, 0x6c, 0x0d, 0x76, 0x05, 0x32, 0xfe, 0x30, 0xbf, 0xf9, 0xa6, 0x9c, 0xdf, 0x5d, 0xfc,
+	0x52, 0x9d, 0x62, 0xca, 0xe8, 0xeb, 0x55, 0xe4, 0xfb, 0x34, 0x8d, 0x38, 0x33, 0xdf, 0xd8, 0x5a,
+	0xa7, 0x36, 0x6c, 0x02, 0x19, 0x11, 0x3c, 0x44, 0x04, 0xa3, 0x28, 0x73, 0x1e, 0x29, 0x63, 0x47,
+	0xaf, 0x86, 0x8c, 0xb8, 0x13, 0x8c, 0x99, 0xa9, 0xd9, 0xda, 0x5a, 0xcf, 0x6d, 0x46, 0x36, 0x30,
+	0x1e, 0x7f, 0xc9, 0x3d, 0xaf, 0x2f, 0x7b, 0x75, 0xc9, 0xf4, 0xd8, 0xc1, 0xcc, 0xee, 0x83, 0x3f,
+	0x7f, 0x9d, 0xf7, 0xa1, 0x00, 0x98, 0x31, 0xd5, 0x1b, 0x71, 0xea, 0xb9, 0x33, 0x9c, 0xb9, 0x09,
+	0xe5, 0x88, 0x07, 0x34, 0x62, 0xe6, 0x5b, 0xa1, 0xfc, 0xb3, 0x3c, 0x4d, 0xea, 0x6d, 0xe1, 0xcc,
+	0x29, 0xd8, 0xf5, 0x0e, 0xf5, 0xf8, 0x05, 0xc8, 0x8c, 0x43, 0xfd, 0x53, 0x2e, 0x84, 0x23, 0x1e,
+	0xf8, 0x88, 0xd3, 0x84, 0x99, 0xef, 0x84, 0x4d, 0xb7, 0xd4, 0x66, 0x24, 0x13, 0x8f, 0x9e, 0x4f,
+	0xac, 0x37, 0x7b, 0xa5, 0x3c, 0xfe, 0x3f, 0x5f, 0x5a, 0xea, 0x62, 0x69, 0xa9, 0xb7, 0x4b, 0x4b,
+	0x3d, 0x5d, 0x59, 0xca, 0x62, 0x65, 0x29, 0x57, 0x2b, 0x4b, 0xd9, 0xef, 0x92, 0x80, 0x4f, 0x53,
+	0x0f, 0xf8, 0x34, 0x2c, 0x8e, 0x0b, 0x9f, 0xc4, 0xe0, 0x89, 0xfc, 0x53, 0x78, 0x16, 0x63, 0xe6,
+	0x55, 0xc4, 0x0d, 0x7e, 0xdf, 0x0f, 0x00, 0xc1, 0xeb, 0x11, 0x22, 0xae, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +163,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Authenticators) > 0 {
+		for iNdEx := len(m.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PubKeyRotations) > 0 {
+		for iNdEx := len(m.PubKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +257,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PubKeyRotations) > 0 {
+		for _, e := range m.PubKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Authenticators) > 0 {
+		for _, e := range m.Authenticators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -284,6 +380,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, MsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyRotations = append(m.PubKeyRotations, PubKeyRotation{})
+			if err := m.PubKeyRotations[len(m.PubKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticators = append(m.Authenticators, AccountAuthenticator{})
+			if err := m.Authenticators[len(m.Authenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
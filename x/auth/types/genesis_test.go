@@ -7,8 +7,12 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -52,6 +56,74 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateGenesisMsgFees(t *testing.T) {
+	genState := types.DefaultGenesisState()
+	require.NoError(t, types.ValidateGenesis(*genState, addresscodec.NewBech32Codec("cosmos")))
+
+	voteFee := types.NewMsgFee("/cosmos.gov.v1.MsgVote", math.LegacyNewDecWithPrec(5, 1), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	genState.MsgFees = []types.MsgFee{voteFee}
+	require.NoError(t, types.ValidateGenesis(*genState, addresscodec.NewBech32Codec("cosmos")))
+
+	genState.MsgFees = []types.MsgFee{voteFee, voteFee}
+	require.ErrorContains(t, types.ValidateGenesis(*genState, addresscodec.NewBech32Codec("cosmos")), "duplicate message fee")
+
+	genState.MsgFees = []types.MsgFee{types.NewMsgFee("/cosmos.gov.v1.MsgVote", math.LegacyNewDec(-1), nil)}
+	require.ErrorContains(t, types.ValidateGenesis(*genState, addresscodec.NewBech32Codec("cosmos")), "must be positive")
+}
+
+func TestValidateGenesisPubKeyRotations(t *testing.T) {
+	oldPubKey := secp256k1.GenPrivKey().PubKey()
+	newPubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+
+	oldPubKeyAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	require.NoError(t, err)
+	newPubKeyAny, err := codectypes.NewAnyWithValue(newPubKey)
+	require.NoError(t, err)
+
+	rotation := types.PubKeyRotation{Address: addr.String(), OldPubKey: oldPubKeyAny, NewPubKey: newPubKeyAny, Height: 1}
+
+	// an account with a rotated public key is only valid with its rotation
+	acc := types.NewBaseAccount(addr, newPubKey, 0, 0)
+	genAccs, err := types.PackAccounts(types.GenesisAccounts{acc})
+	require.NoError(t, err)
+
+	genState := types.DefaultGenesisState()
+	genState.Accounts = genAccs
+	require.ErrorContains(t, types.ValidateGenesis(*genState, addresscodec.NewBech32Codec("cosmos")), "account address and pubkey address do not match")
+
+	genState.PubKeyRotations = []types.PubKeyRotation{rotation}
+	require.NoError(t, types.ValidateGenesis(*genState, addresscodec.NewBech32Codec("cosmos")))
+	require.True(t, newPubKey.Equals(acc.GetPubKey()))
+
+	// rotations must be chained
+	genState.PubKeyRotations = []types.PubKeyRotation{rotation, rotation}
+	require.ErrorContains(t, types.ValidateGenesis(*genState, addresscodec.NewBech32Codec("cosmos")), "does not replace the previous public key")
+
+	genState.PubKeyRotations = []types.PubKeyRotation{{Address: "foo", OldPubKey: oldPubKeyAny, NewPubKey: newPubKeyAny}}
+	require.ErrorContains(t, types.ValidateGenesis(*genState, addresscodec.NewBech32Codec("cosmos")), "invalid public key rotation address")
+}
+
+func TestValidateGenesisAuthenticators(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	genState := types.DefaultGenesisState()
+	genState.Authenticators = []types.AccountAuthenticator{
+		{Address: addr.String(), Id: 0, AuthenticatorType: "SignatureVerification"},
+		{Address: addr.String(), Id: 1, AuthenticatorType: "AllOf"},
+	}
+	require.NoError(t, types.ValidateGenesis(*genState, addresscodec.NewBech32Codec("cosmos")))
+
+	genState.Authenticators[1].Id = 0
+	require.ErrorContains(t, types.ValidateGenesis(*genState, addresscodec.NewBech32Codec("cosmos")), "duplicate authenticator id 0")
+
+	genState.Authenticators[1] = types.AccountAuthenticator{Address: addr.String(), Id: 1}
+	require.ErrorContains(t, types.ValidateGenesis(*genState, addresscodec.NewBech32Codec("cosmos")), "authenticator 1 has no type")
+
+	genState.Authenticators[1] = types.AccountAuthenticator{Address: "foo", Id: 1, AuthenticatorType: "AllOf"}
+	require.ErrorContains(t, types.ValidateGenesis(*genState, addresscodec.NewBech32Codec("cosmos")), "invalid authenticator address")
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	encodingConfig := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
 	cdc := encodingConfig.Codec
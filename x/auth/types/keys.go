@@ -26,6 +26,21 @@ var (
 	// This storage is deprecated as of 0.45, but left here to prevent future use of the ID
 	GlobalAccountNumberKey = collections.NewPrefix(2)
 
+	// MsgFeesKeyPrefix prefix for the per message type fees
+	MsgFeesKeyPrefix = collections.NewPrefix(3)
+
+	// PubKeyRotationsKeyPrefix prefix for the public key rotation history
+	PubKeyRotationsKeyPrefix = collections.NewPrefix(4)
+
+	// AuthenticatorsKeyPrefix prefix for the authenticators of the accounts
+	AuthenticatorsKeyPrefix = collections.NewPrefix(5)
+
+	// NextAuthenticatorIDKey is the key of the next authenticator identifier
+	NextAuthenticatorIDKey = collections.NewPrefix(6)
+
+	// AuthenticatorDataKeyPrefix prefix for the data of the authenticators
+	AuthenticatorDataKeyPrefix = collections.NewPrefix(7)
+
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
 
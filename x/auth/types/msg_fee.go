@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMsgFee creates a new MsgFee object.
+func NewMsgFee(msgTypeURL string, gasMultiplier math.LegacyDec, minFee sdk.Coins) MsgFee {
+	return MsgFee{
+		MsgTypeUrl:    msgTypeURL,
+		GasMultiplier: gasMultiplier,
+		MinFee:        minFee,
+	}
+}
+
+// DefaultMsgFee returns the fee applied to message types without a configured
+// fee: a gas multiplier of 1 and no minimum fee.
+func DefaultMsgFee(msgTypeURL string) MsgFee {
+	return NewMsgFee(msgTypeURL, math.LegacyOneDec(), nil)
+}
+
+// Validate performs basic validation of a MsgFee.
+func (f MsgFee) Validate() error {
+	if !strings.HasPrefix(f.MsgTypeUrl, "/") || len(f.MsgTypeUrl) == 1 {
+		return fmt.Errorf("invalid message type url: %q", f.MsgTypeUrl)
+	}
+
+	if f.GasMultiplier.IsNil() || !f.GasMultiplier.IsPositive() {
+		return fmt.Errorf("gas multiplier of %s must be positive: %s", f.MsgTypeUrl, f.GasMultiplier)
+	}
+
+	if err := f.MinFee.Validate(); err != nil {
+		return fmt.Errorf("invalid minimum fee of %s: %w", f.MsgTypeUrl, err)
+	}
+
+	return nil
+}
+
+// ValidateMsgFees validates each MsgFee and that no message type is configured
+// more than once.
+func ValidateMsgFees(msgFees []MsgFee) error {
+	seen := make(map[string]struct{}, len(msgFees))
+	for _, f := range msgFees {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[f.MsgTypeUrl]; ok {
+			return fmt.Errorf("duplicate message fee for %s", f.MsgTypeUrl)
+		}
+		seen[f.MsgTypeUrl] = struct{}{}
+	}
+
+	return nil
+}
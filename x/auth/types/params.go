@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 )
 
 // Default parameter values
@@ -21,9 +22,14 @@ const (
 	//
 	// The large ML-DSA signature is additionally charged via TxSizeCostPerByte.
 	DefaultSigVerifyCostMlDsa65 uint64 = 750
+
+	DefaultPubKeyRotationCooldown        = 24 * time.Hour
+	DefaultPubKeyRotationWindow          = 30 * 24 * time.Hour
+	DefaultMaxPubKeyRotations     uint64 = 3
 )
 
-// NewParams creates a new Params object
+// NewParams creates a new Params object. The public key rotation parameters
+// are set to their default values.
 func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1, sigVerifyCostMlDsa65 uint64) Params {
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
@@ -32,6 +38,9 @@ func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
 		SigVerifyCostMlDsa65:   sigVerifyCostMlDsa65,
+		PubKeyRotationCooldown: DefaultPubKeyRotationCooldown,
+		PubKeyRotationWindow:   DefaultPubKeyRotationWindow,
+		MaxPubKeyRotations:     DefaultMaxPubKeyRotations,
 	}
 }
 
@@ -44,6 +53,9 @@ func DefaultParams() Params {
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
 		SigVerifyCostMlDsa65:   DefaultSigVerifyCostMlDsa65,
+		PubKeyRotationCooldown: DefaultPubKeyRotationCooldown,
+		PubKeyRotationWindow:   DefaultPubKeyRotationWindow,
+		MaxPubKeyRotations:     DefaultMaxPubKeyRotations,
 	}
 }
 
@@ -59,6 +71,28 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// SigVerifyCostBLS12381 returns gas fee of bls12_381 signature verification, also
+// charged to the first signer of an aggregate signature.
+// Set by benchmarking current implementation:
+//
+//	BenchmarkSig/secp256k1               8983    134009 ns/op
+//	BenchmarkSig/bls12_381               1197    970794 ns/op
+//	BenchmarkSig/bls12_381-aggregate-2    925   1313922 ns/op
+//	BenchmarkSig/bls12_381-aggregate-8    360   3372824 ns/op
+//	BenchmarkSig/bls12_381-aggregate-32    98  11783331 ns/op
+//
+// Due to the above, we set the cost to seven times the secp256k1 cost.
+func (p Params) SigVerifyCostBLS12381() uint64 {
+	return p.SigVerifyCostSecp256k1 * 7
+}
+
+// SigVerifyCostBLS12381Aggregated returns gas fee of the verification of an
+// aggregate bls12_381 signature for each signer but the first. Based on the
+// benchmarks above, we set it to three times the secp256k1 cost.
+func (p Params) SigVerifyCostBLS12381Aggregated() uint64 {
+	return p.SigVerifyCostSecp256k1 * 3
+}
+
 func validateTxSigLimit(i any) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -137,6 +171,14 @@ func validateTxSizeCostPerByte(i any) error {
 	return nil
 }
 
+func validatePubKeyRotationDuration(name string, v time.Duration) error {
+	if v < 0 {
+		return fmt.Errorf("%s cannot be negative: %s", name, v)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -157,6 +199,15 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validatePubKeyRotationDuration("public key rotation cooldown", p.PubKeyRotationCooldown); err != nil {
+		return err
+	}
+	if err := validatePubKeyRotationDuration("public key rotation window", p.PubKeyRotationWindow); err != nil {
+		return err
+	}
+	if err := p.MinGasPrices.Validate(); err != nil {
+		return fmt.Errorf("invalid minimum gas prices: %w", err)
+	}
 
 	return nil
 }
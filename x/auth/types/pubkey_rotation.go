@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = (*MsgRotatePubKey)(nil)
+	_ codectypes.UnpackInterfacesMessage = PubKeyRotation{}
+	_ codectypes.UnpackInterfacesMessage = (*QueryPubKeyRotationsResponse)(nil)
+)
+
+// NewMsgRotatePubKey creates a new MsgRotatePubKey instance.
+func NewMsgRotatePubKey(addr string, pubKey cryptotypes.PubKey) (*MsgRotatePubKey, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgRotatePubKey{
+		Address:   addr,
+		NewPubKey: pkAny,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (msg *MsgRotatePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (r PubKeyRotation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var oldPubKey, newPubKey cryptotypes.PubKey
+	if err := unpacker.UnpackAny(r.OldPubKey, &oldPubKey); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(r.NewPubKey, &newPubKey)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (m *QueryPubKeyRotationsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, rotation := range m.Rotations {
+		if err := rotation.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidatePubKeyRotations validates the public key rotation history of the
+// accounts. The rotations of an account must be ordered by height, and each of
+// them must replace the public key set by the previous one. It returns the
+// latest public key of every rotated account, keyed by address.
+func ValidatePubKeyRotations(ac address.Codec, rotations []PubKeyRotation) (map[string]cryptotypes.PubKey, error) {
+	latest := make(map[string]cryptotypes.PubKey)
+	heights := make(map[string]int64)
+
+	for _, rotation := range rotations {
+		if _, err := ac.StringToBytes(rotation.Address); err != nil {
+			return nil, fmt.Errorf("invalid public key rotation address %s: %w", rotation.Address, err)
+		}
+
+		oldPubKey, ok := rotation.OldPubKey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return nil, fmt.Errorf("invalid old public key in public key rotation of %s", rotation.Address)
+		}
+		newPubKey, ok := rotation.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return nil, fmt.Errorf("invalid new public key in public key rotation of %s", rotation.Address)
+		}
+
+		if prev, ok := latest[rotation.Address]; ok {
+			if !prev.Equals(oldPubKey) {
+				return nil, fmt.Errorf("public key rotation of %s at height %d does not replace the previous public key", rotation.Address, rotation.Height)
+			}
+			if rotation.Height < heights[rotation.Address] {
+				return nil, fmt.Errorf("public key rotations of %s are not ordered by height", rotation.Address)
+			}
+		}
+
+		latest[rotation.Address] = newPubKey
+		heights[rotation.Address] = rotation.Height
+	}
+
+	return latest, nil
+}
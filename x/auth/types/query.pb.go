@@ -932,6 +932,423 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QueryMsgFeesRequest is the request type for the Query/MsgFees RPC method.
+type QueryMsgFeesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMsgFeesRequest) Reset()         { *m = QueryMsgFeesRequest{} }
+func (m *QueryMsgFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgFeesRequest) ProtoMessage()    {}
+func (*QueryMsgFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{20}
+}
+func (m *QueryMsgFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgFeesRequest.Merge(m, src)
+}
+func (m *QueryMsgFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgFeesRequest proto.InternalMessageInfo
+
+func (m *QueryMsgFeesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMsgFeesResponse is the response type for the Query/MsgFees RPC method.
+type QueryMsgFeesResponse struct {
+	// msg_fees are the configured message fees.
+	MsgFees []MsgFee `protobuf:"bytes,1,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMsgFeesResponse) Reset()         { *m = QueryMsgFeesResponse{} }
+func (m *QueryMsgFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgFeesResponse) ProtoMessage()    {}
+func (*QueryMsgFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QueryMsgFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgFeesResponse.Merge(m, src)
+}
+func (m *QueryMsgFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgFeesResponse proto.InternalMessageInfo
+
+func (m *QueryMsgFeesResponse) GetMsgFees() []MsgFee {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
+}
+
+func (m *QueryMsgFeesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMsgFeeRequest is the request type for the Query/MsgFee RPC method.
+type QueryMsgFeeRequest struct {
+	// msg_type_url is the type URL of the message, e.g. "/cosmos.gov.v1.MsgVote".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *QueryMsgFeeRequest) Reset()         { *m = QueryMsgFeeRequest{} }
+func (m *QueryMsgFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgFeeRequest) ProtoMessage()    {}
+func (*QueryMsgFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{22}
+}
+func (m *QueryMsgFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgFeeRequest.Merge(m, src)
+}
+func (m *QueryMsgFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgFeeRequest proto.InternalMessageInfo
+
+func (m *QueryMsgFeeRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// QueryMsgFeeResponse is the response type for the Query/MsgFee RPC method.
+type QueryMsgFeeResponse struct {
+	// msg_fee is the fee applied to the message type.
+	MsgFee MsgFee `protobuf:"bytes,1,opt,name=msg_fee,json=msgFee,proto3" json:"msg_fee"`
+}
+
+func (m *QueryMsgFeeResponse) Reset()         { *m = QueryMsgFeeResponse{} }
+func (m *QueryMsgFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgFeeResponse) ProtoMessage()    {}
+func (*QueryMsgFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{23}
+}
+func (m *QueryMsgFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgFeeResponse.Merge(m, src)
+}
+func (m *QueryMsgFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgFeeResponse proto.InternalMessageInfo
+
+func (m *QueryMsgFeeResponse) GetMsgFee() MsgFee {
+	if m != nil {
+		return m.MsgFee
+	}
+	return MsgFee{}
+}
+
+// QueryPubKeyRotationsRequest is the request type for the Query/PubKeyRotations
+// RPC method.
+type QueryPubKeyRotationsRequest struct {
+	// address is the address of the account to query the rotations for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyRotationsRequest) Reset()         { *m = QueryPubKeyRotationsRequest{} }
+func (m *QueryPubKeyRotationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyRotationsRequest) ProtoMessage()    {}
+func (*QueryPubKeyRotationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{24}
+}
+func (m *QueryPubKeyRotationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyRotationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyRotationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyRotationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyRotationsRequest.Merge(m, src)
+}
+func (m *QueryPubKeyRotationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyRotationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyRotationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyRotationsRequest proto.InternalMessageInfo
+
+func (m *QueryPubKeyRotationsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPubKeyRotationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPubKeyRotationsResponse is the response type for the
+// Query/PubKeyRotations RPC method.
+type QueryPubKeyRotationsResponse struct {
+	// rotations are the rotations of the public key of the account.
+	Rotations []PubKeyRotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyRotationsResponse) Reset()         { *m = QueryPubKeyRotationsResponse{} }
+func (m *QueryPubKeyRotationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyRotationsResponse) ProtoMessage()    {}
+func (*QueryPubKeyRotationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{25}
+}
+func (m *QueryPubKeyRotationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyRotationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyRotationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyRotationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyRotationsResponse.Merge(m, src)
+}
+func (m *QueryPubKeyRotationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyRotationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyRotationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyRotationsResponse proto.InternalMessageInfo
+
+func (m *QueryPubKeyRotationsResponse) GetRotations() []PubKeyRotation {
+	if m != nil {
+		return m.Rotations
+	}
+	return nil
+}
+
+func (m *QueryPubKeyRotationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuthenticatorsRequest is the request type for the Query/Authenticators
+// RPC method.
+type QueryAuthenticatorsRequest struct {
+	// address is the address of the account to query the authenticators for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuthenticatorsRequest) Reset()         { *m = QueryAuthenticatorsRequest{} }
+func (m *QueryAuthenticatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthenticatorsRequest) ProtoMessage()    {}
+func (*QueryAuthenticatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{26}
+}
+func (m *QueryAuthenticatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthenticatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthenticatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthenticatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthenticatorsRequest.Merge(m, src)
+}
+func (m *QueryAuthenticatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthenticatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthenticatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthenticatorsRequest proto.InternalMessageInfo
+
+func (m *QueryAuthenticatorsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAuthenticatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuthenticatorsResponse is the response type for the
+// Query/Authenticators RPC method.
+type QueryAuthenticatorsResponse struct {
+	// authenticators are the authenticators registered by the account.
+	Authenticators []AccountAuthenticator `protobuf:"bytes,1,rep,name=authenticators,proto3" json:"authenticators"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuthenticatorsResponse) Reset()         { *m = QueryAuthenticatorsResponse{} }
+func (m *QueryAuthenticatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthenticatorsResponse) ProtoMessage()    {}
+func (*QueryAuthenticatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{27}
+}
+func (m *QueryAuthenticatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthenticatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthenticatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthenticatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthenticatorsResponse.Merge(m, src)
+}
+func (m *QueryAuthenticatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthenticatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthenticatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthenticatorsResponse proto.InternalMessageInfo
+
+func (m *QueryAuthenticatorsResponse) GetAuthenticators() []AccountAuthenticator {
+	if m != nil {
+		return m.Authenticators
+	}
+	return nil
+}
+
+func (m *QueryAuthenticatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -953,84 +1370,113 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryMsgFeesRequest)(nil), "cosmos.auth.v1beta1.QueryMsgFeesRequest")
+	proto.RegisterType((*QueryMsgFeesResponse)(nil), "cosmos.auth.v1beta1.QueryMsgFeesResponse")
+	proto.RegisterType((*QueryMsgFeeRequest)(nil), "cosmos.auth.v1beta1.QueryMsgFeeRequest")
+	proto.RegisterType((*QueryMsgFeeResponse)(nil), "cosmos.auth.v1beta1.QueryMsgFeeResponse")
+	proto.RegisterType((*QueryPubKeyRotationsRequest)(nil), "cosmos.auth.v1beta1.QueryPubKeyRotationsRequest")
+	proto.RegisterType((*QueryPubKeyRotationsResponse)(nil), "cosmos.auth.v1beta1.QueryPubKeyRotationsResponse")
+	proto.RegisterType((*QueryAuthenticatorsRequest)(nil), "cosmos.auth.v1beta1.QueryAuthenticatorsRequest")
+	proto.RegisterType((*QueryAuthenticatorsResponse)(nil), "cosmos.auth.v1beta1.QueryAuthenticatorsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1150 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xa6, 0x21, 0x49, 0x5f, 0x3e, 0x2a, 0xc6, 0xae, 0x08, 0x9b, 0xd4, 0xb6, 0xb6, 0x90,
-	0x2f, 0xea, 0xdd, 0xda, 0x71, 0x13, 0x88, 0xc4, 0x21, 0x4b, 0xd5, 0x2a, 0x42, 0x20, 0x77, 0x5b,
-	0x41, 0xe9, 0xc5, 0x5a, 0xdb, 0x6b, 0x67, 0x45, 0xbd, 0xeb, 0x7a, 0xd7, 0xa8, 0x26, 0xb2, 0x90,
-	0x90, 0x90, 0x7a, 0xe0, 0x80, 0x04, 0x37, 0x2e, 0xf9, 0x13, 0x38, 0x58, 0x42, 0x48, 0xdc, 0xe0,
-	0x50, 0x72, 0xaa, 0xc2, 0x05, 0x71, 0x40, 0x28, 0x41, 0x82, 0xbf, 0x02, 0x55, 0x9e, 0x79, 0xfb,
-	0xe1, 0x78, 0x6c, 0x6f, 0xda, 0x9b, 0xbd, 0xf3, 0xde, 0xef, 0xe3, 0xcd, 0x9b, 0x79, 0x03, 0xa9,
-	0xb2, 0xed, 0xd4, 0x6d, 0x47, 0xd1, 0x5b, 0xee, 0xbe, 0xf2, 0x59, 0xb6, 0x64, 0xb8, 0x7a, 0x56,
-	0x79, 0xd4, 0x32, 0x9a, 0x6d, 0xb9, 0xd1, 0xb4, 0x5d, 0x9b, 0xc4, 0x59, 0x80, 0xdc, 0x0b, 0x90,
-	0x31, 0x40, 0xdc, 0xc0, 0xac, 0x92, 0xee, 0x18, 0x2c, 0xda, 0xcf, 0x6d, 0xe8, 0x35, 0xd3, 0xd2,
-	0x5d, 0xd3, 0xb6, 0x18, 0x80, 0x98, 0xa8, 0xd9, 0x35, 0x9b, 0xfe, 0x54, 0x7a, 0xbf, 0xf0, 0xeb,
-	0xeb, 0x35, 0xdb, 0xae, 0x3d, 0x34, 0x14, 0xfa, 0xaf, 0xd4, 0xaa, 0x2a, 0xba, 0x85, 0x8c, 0xe2,
-	0x32, 0x2e, 0xe9, 0x0d, 0x53, 0xd1, 0x2d, 0xcb, 0x76, 0x29, 0x9a, 0x83, 0xab, 0x49, 0x9e, 0x60,
-	0x2a, 0x0e, 0x81, 0xd9, 0x7a, 0x91, 0x31, 0xa2, 0x78, 0xb6, 0xb4, 0x84, 0xa9, 0x9e, 0xe0, 0xb0,
-	0x4f, 0xc9, 0x81, 0xc4, 0x9d, 0xde, 0xdf, 0xdd, 0x72, 0xd9, 0x6e, 0x59, 0xae, 0xa3, 0x19, 0x8f,
-	0x5a, 0x86, 0xe3, 0x92, 0x5b, 0x00, 0x81, 0xa5, 0x45, 0x21, 0x2d, 0xac, 0xcd, 0xe6, 0x56, 0x64,
-	0xc4, 0xed, 0xf9, 0x97, 0x19, 0x0a, 0x4a, 0x91, 0x0b, 0x7a, 0xcd, 0xc0, 0x5c, 0x2d, 0x94, 0xb9,
-	0x13, 0x3f, 0xee, 0x66, 0x2e, 0xb1, 0xb4, 0x8c, 0x53, 0xf9, 0x34, 0x7d, 0x5d, 0xce, 0x6f, 0x4a,
-	0xbf, 0x09, 0x70, 0xf9, 0x0c, 0xab, 0xd3, 0xb0, 0x2d, 0xc7, 0x20, 0x1a, 0xcc, 0xe8, 0xf8, 0x6d,
-	0x51, 0x48, 0x5f, 0x58, 0x9b, 0xcd, 0x25, 0x64, 0x56, 0x17, 0xd9, 0x2b, 0x99, 0xbc, 0x6b, 0xb5,
-	0xd5, 0xf4, 0x51, 0x37, 0xb3, 0xcc, 0xd9, 0x22, 0x19, 0x11, 0xf7, 0x34, 0x1f, 0x87, 0xdc, 0xee,
-	0xb3, 0x32, 0x41, 0xad, 0xac, 0x8e, 0xb5, 0xc2, 0x04, 0x8d, 0xf7, 0x72, 0x17, 0xe2, 0x61, 0x2b,
-	0x5e, 0xfd, 0x72, 0x30, 0xad, 0x57, 0x2a, 0x4d, 0xc3, 0x71, 0x68, 0xf1, 0x2e, 0xaa, 0x8b, 0xc7,
-	0xdd, 0x4c, 0x02, 0x49, 0x77, 0xd9, 0xca, 0x5d, 0xb7, 0x69, 0x5a, 0x35, 0xcd, 0x0b, 0xdc, 0x99,
-	0x79, 0x72, 0x98, 0x8a, 0xfd, 0x77, 0x98, 0x8a, 0x49, 0xfb, 0xfd, 0xbb, 0xe2, 0x97, 0xa7, 0x00,
-	0xd3, 0x68, 0x0b, 0xb7, 0xe4, 0x45, 0xab, 0xe3, 0xc1, 0x48, 0x09, 0x20, 0x94, 0xa9, 0xa0, 0x37,
-	0xf5, 0xba, 0xb7, 0xfb, 0x52, 0x01, 0x4d, 0x79, 0x5f, 0x91, 0xfe, 0x1d, 0x98, 0x6a, 0xd0, 0x2f,
-	0xc8, 0xbe, 0x24, 0xf3, 0x48, 0x58, 0x92, 0x3a, 0xf9, 0xf4, 0xaf, 0x54, 0x4c, 0xc3, 0x04, 0x29,
-	0x0b, 0x22, 0x45, 0xfc, 0xc0, 0xae, 0xb4, 0x1e, 0x1a, 0x67, 0xba, 0x8d, 0x57, 0xd9, 0x2d, 0xe9,
-	0x6b, 0x01, 0x96, 0xb8, 0x39, 0xa8, 0xe6, 0x7e, 0xc4, 0x5e, 0x59, 0x39, 0xea, 0x66, 0x24, 0x9e,
-	0xd0, 0x3e, 0xdc, 0x50, 0xc7, 0xf0, 0xe5, 0xdc, 0x80, 0xd4, 0xa0, 0x1a, 0xb5, 0xfd, 0xa1, 0x5e,
-	0xf7, 0x1a, 0x9f, 0x10, 0x98, 0xb4, 0xf4, 0xba, 0xc1, 0x76, 0x5c, 0xa3, 0xbf, 0xa5, 0xcf, 0x21,
-	0x3d, 0x3c, 0x0d, 0x9d, 0x7c, 0x14, 0x6d, 0x5b, 0xa3, 0x1a, 0xf1, 0x37, 0x77, 0x03, 0xe2, 0xaa,
-	0x51, 0xde, 0xdf, 0xcc, 0x15, 0x9a, 0x46, 0xd5, 0x7c, 0x3c, 0xb2, 0xda, 0x05, 0x48, 0xf4, 0xc7,
-	0xa2, 0xb6, 0xab, 0x30, 0x5f, 0xa2, 0xdf, 0x8b, 0x0d, 0xba, 0x80, 0xe6, 0xe6, 0x4a, 0xa1, 0x60,
-	0x3e, 0xe2, 0xc7, 0xb0, 0x84, 0x8d, 0xae, 0xb6, 0x5d, 0xc3, 0xb9, 0x67, 0x63, 0xbf, 0x63, 0xb1,
-	0xae, 0xc2, 0x3c, 0x36, 0x7e, 0xb1, 0xd4, 0x5b, 0xa7, 0xc0, 0x73, 0xda, 0x9c, 0x1e, 0xca, 0xe1,
-	0x03, 0x3f, 0x80, 0x65, 0x3e, 0x30, 0x4a, 0x7e, 0x13, 0x16, 0x3c, 0x64, 0x87, 0xae, 0xa0, 0x66,
-	0x8f, 0x8f, 0x85, 0xf3, 0xb1, 0x3f, 0xf1, 0x45, 0xb3, 0xa8, 0x7b, 0x36, 0xe5, 0xf0, 0x44, 0xbf,
-	0x0c, 0xf4, 0x7d, 0x5f, 0xf6, 0x19, 0xe8, 0xa0, 0xd2, 0x2f, 0x58, 0x90, 0x2f, 0x20, 0x19, 0xbe,
-	0x2e, 0xfc, 0xe2, 0xec, 0xdd, 0x0c, 0x3a, 0x73, 0xc2, 0xac, 0x50, 0xc0, 0x0b, 0xea, 0xc4, 0xa2,
-	0xa0, 0x4d, 0x98, 0x15, 0x92, 0x03, 0xc0, 0x46, 0x29, 0x9a, 0x15, 0x7a, 0x2f, 0x4e, 0xaa, 0xf1,
-	0x3f, 0x07, 0xe0, 0xb7, 0xb5, 0x8b, 0x18, 0xb6, 0x57, 0xd9, 0xb9, 0x7c, 0xdc, 0xcd, 0xbc, 0x7a,
-	0x86, 0x5e, 0xce, 0x49, 0x07, 0x78, 0x36, 0x78, 0x02, 0xd0, 0xdd, 0x2e, 0x5c, 0xf2, 0xd8, 0xa2,
-	0x5e, 0x8c, 0x0b, 0x7a, 0x1f, 0xdc, 0x30, 0xf2, 0x12, 0xbc, 0x16, 0x26, 0xdf, 0xb3, 0xaa, 0xf6,
-	0xcb, 0xdc, 0xc2, 0x9c, 0x0a, 0x6f, 0x4b, 0x06, 0x2c, 0x0e, 0x72, 0xa0, 0xb3, 0x3c, 0x4c, 0x9a,
-	0x56, 0xd5, 0xc6, 0xa3, 0x9b, 0xe6, 0xde, 0x89, 0xaa, 0xee, 0x78, 0xe7, 0x53, 0xa3, 0xd1, 0x5c,
-	0x9a, 0xdc, 0xff, 0xf3, 0xf0, 0x0a, 0xe5, 0x21, 0x87, 0x02, 0xcc, 0x78, 0x37, 0x1e, 0x59, 0xe7,
-	0x62, 0xf2, 0xe6, 0xb6, 0xb8, 0x11, 0x25, 0x94, 0x09, 0x97, 0xde, 0x3d, 0x1a, 0x9c, 0x67, 0x4f,
-	0xfe, 0xfd, 0x61, 0x43, 0xf8, 0xf2, 0xf7, 0x7f, 0xbe, 0x9d, 0x48, 0x91, 0x2b, 0x0a, 0xf7, 0xd1,
-	0xe1, 0xa9, 0xfa, 0x4e, 0x80, 0x69, 0xc4, 0x24, 0x6b, 0x63, 0x69, 0x3d, 0x81, 0xeb, 0x11, 0x22,
-	0x51, 0x5f, 0x3e, 0x10, 0xb3, 0x4e, 0x56, 0x47, 0x8a, 0x51, 0x0e, 0x70, 0xfb, 0x3a, 0xe4, 0x58,
-	0x00, 0x32, 0xd8, 0x87, 0x64, 0x73, 0x2c, 0xef, 0xe0, 0xb1, 0x11, 0xf3, 0xe7, 0x4b, 0x42, 0xdd,
-	0x77, 0x8e, 0x78, 0x7d, 0x1a, 0x98, 0xc9, 0x12, 0x85, 0x6f, 0xc6, 0x3f, 0xfd, 0x45, 0xb3, 0xa2,
-	0x1c, 0x04, 0x87, 0xb3, 0x43, 0xbe, 0x12, 0x60, 0x8a, 0xcd, 0x55, 0xb2, 0x3a, 0x5c, 0x53, 0xdf,
-	0x10, 0x17, 0xd7, 0xc6, 0x07, 0xa2, 0xe0, 0xb5, 0x40, 0xdb, 0x15, 0xb2, 0xc4, 0xd5, 0xc6, 0xc6,
-	0x38, 0xf9, 0x49, 0x80, 0x85, 0xfe, 0x71, 0x4c, 0x94, 0xe1, 0x34, 0xdc, 0x61, 0x2f, 0x5e, 0x8f,
-	0x9e, 0x80, 0xfa, 0x6e, 0x0e, 0x36, 0xea, 0x56, 0x20, 0x79, 0x85, 0xbc, 0xc1, 0x95, 0x5c, 0xa7,
-	0x60, 0x45, 0xbf, 0x5f, 0x7f, 0x16, 0x20, 0xce, 0x99, 0xc2, 0x24, 0x1f, 0x51, 0x4f, 0xdf, 0xac,
-	0x17, 0x6f, 0x9c, 0x33, 0x0b, 0xad, 0xbc, 0x1d, 0xe8, 0xce, 0x90, 0xb7, 0xa2, 0xe8, 0x56, 0x0e,
-	0x7a, 0xef, 0x88, 0x0e, 0xf9, 0x5e, 0x80, 0xb9, 0xf0, 0x84, 0x1e, 0x72, 0xe6, 0x38, 0x03, 0x7f,
-	0xc8, 0x99, 0xe3, 0x8d, 0x7b, 0x69, 0x8b, 0x53, 0xea, 0x91, 0x8d, 0xc1, 0xde, 0x01, 0xe4, 0x17,
-	0x01, 0x12, 0xbc, 0xa1, 0x4c, 0xf8, 0xbb, 0x3d, 0xe2, 0x61, 0x20, 0x66, 0xcf, 0x91, 0x81, 0xaa,
-	0x6f, 0x0d, 0x53, 0x3d, 0xac, 0xc6, 0x4c, 0xb5, 0x7f, 0x6b, 0xb0, 0x81, 0xdb, 0x21, 0xbf, 0x06,
-	0x2e, 0xfa, 0x66, 0xf4, 0x68, 0x17, 0xbc, 0x97, 0xc2, 0x68, 0x17, 0xdc, 0x07, 0x80, 0x74, 0x7b,
-	0x98, 0x0b, 0x99, 0x5c, 0x8b, 0xe4, 0x82, 0x3d, 0x49, 0x3a, 0xe4, 0x47, 0x01, 0x66, 0x43, 0x93,
-	0x8a, 0x5c, 0x1b, 0x7b, 0x8d, 0x85, 0x86, 0xa6, 0x98, 0x89, 0x18, 0x8d, 0xaa, 0xdf, 0x1f, 0x54,
-	0xbd, 0x3d, 0xbe, 0xc9, 0xfd, 0xcb, 0xcd, 0xaa, 0xda, 0xc1, 0xe5, 0xad, 0xbe, 0xf7, 0xf4, 0x24,
-	0x29, 0x3c, 0x3b, 0x49, 0x0a, 0x7f, 0x9f, 0x24, 0x85, 0x6f, 0x4e, 0x93, 0xb1, 0x67, 0xa7, 0xc9,
-	0xd8, 0x1f, 0xa7, 0xc9, 0xd8, 0x83, 0xf5, 0x9a, 0xe9, 0xee, 0xb7, 0x4a, 0x72, 0xd9, 0xae, 0x7b,
-	0x80, 0x01, 0xa9, 0xf2, 0x98, 0xa1, 0xbb, 0xed, 0x86, 0xe1, 0x94, 0xa6, 0xe8, 0xab, 0x79, 0xf3,
-	0x79, 0x00, 0x00, 0x00, 0xff, 0xff, 0xab, 0x3c, 0x5a, 0xe7, 0xe5, 0x0f, 0x00, 0x00,
+	// 1476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdf, 0x6f, 0xdb, 0xd4,
+	0x17, 0xef, 0xed, 0xfa, 0x6d, 0xbb, 0xb3, 0xae, 0xd3, 0xf7, 0x26, 0x13, 0xc5, 0xed, 0x92, 0xc8,
+	0x83, 0xad, 0x2d, 0x8b, 0xbd, 0xa4, 0x3f, 0xb6, 0x95, 0xf1, 0xd0, 0x30, 0x75, 0xaa, 0xa6, 0xa1,
+	0x2c, 0x1b, 0xfb, 0xf5, 0x12, 0x39, 0x8d, 0x93, 0x46, 0x6b, 0xec, 0x2c, 0x76, 0xd0, 0x42, 0x55,
+	0x21, 0x21, 0x21, 0xed, 0x81, 0x07, 0x24, 0x78, 0x00, 0xf1, 0xb2, 0x7f, 0x00, 0x84, 0x50, 0x25,
+	0x84, 0xc4, 0x1b, 0x20, 0x8d, 0xf2, 0x32, 0x0a, 0x12, 0x88, 0x07, 0x84, 0x36, 0x24, 0xf8, 0x33,
+	0x50, 0x7c, 0xcf, 0x8d, 0xed, 0xe4, 0xc6, 0x71, 0x37, 0x8a, 0x78, 0x8b, 0x7d, 0xcf, 0xf9, 0x9c,
+	0xcf, 0xe7, 0xdc, 0xe3, 0x7b, 0xcf, 0x09, 0xc4, 0xd7, 0x4c, 0xab, 0x6a, 0x5a, 0xaa, 0xd6, 0xb0,
+	0xd7, 0xd5, 0x37, 0x52, 0x05, 0xdd, 0xd6, 0x52, 0xea, 0xdd, 0x86, 0x5e, 0x6f, 0x2a, 0xb5, 0xba,
+	0x69, 0x9b, 0x34, 0xc2, 0x0c, 0x94, 0x96, 0x81, 0x82, 0x06, 0xd2, 0x2c, 0x7a, 0x15, 0x34, 0x4b,
+	0x67, 0xd6, 0x6d, 0xdf, 0x9a, 0x56, 0xae, 0x18, 0x9a, 0x5d, 0x31, 0x0d, 0x06, 0x20, 0x45, 0xcb,
+	0x66, 0xd9, 0x74, 0x7e, 0xaa, 0xad, 0x5f, 0xf8, 0xf6, 0xf9, 0xb2, 0x69, 0x96, 0x37, 0x74, 0xd5,
+	0x79, 0x2a, 0x34, 0x4a, 0xaa, 0x66, 0x60, 0x44, 0x69, 0x0a, 0x97, 0xb4, 0x5a, 0x45, 0xd5, 0x0c,
+	0xc3, 0xb4, 0x1d, 0x34, 0x0b, 0x57, 0x63, 0x22, 0xc2, 0x0e, 0x39, 0x04, 0x66, 0xeb, 0x79, 0x16,
+	0x11, 0xc9, 0xb3, 0xa5, 0x49, 0x74, 0xe5, 0x84, 0xbd, 0x3a, 0x65, 0x0b, 0xa2, 0x57, 0x5a, 0x8f,
+	0xcb, 0x6b, 0x6b, 0x66, 0xc3, 0xb0, 0xad, 0x9c, 0x7e, 0xb7, 0xa1, 0x5b, 0x36, 0x5d, 0x01, 0x70,
+	0x25, 0x4d, 0x90, 0x04, 0x99, 0x3e, 0x94, 0x3e, 0xa1, 0x20, 0x6e, 0x4b, 0xbf, 0xc2, 0x50, 0x90,
+	0x8a, 0x92, 0xd5, 0xca, 0x3a, 0xfa, 0xe6, 0x3c, 0x9e, 0x4b, 0x91, 0xdd, 0xed, 0xe4, 0x11, 0xe6,
+	0x96, 0xb4, 0x8a, 0x77, 0x12, 0xa7, 0x95, 0xf9, 0x39, 0xf9, 0x3b, 0x02, 0x47, 0x3b, 0xa2, 0x5a,
+	0x35, 0xd3, 0xb0, 0x74, 0x9a, 0x83, 0x51, 0x0d, 0xdf, 0x4d, 0x90, 0xc4, 0x81, 0xe9, 0x43, 0xe9,
+	0xa8, 0xc2, 0xf2, 0xa2, 0xf0, 0x94, 0x29, 0xcb, 0x46, 0x33, 0x93, 0xd8, 0xd9, 0x4e, 0x4e, 0x09,
+	0xb6, 0x48, 0x41, 0xc4, 0xd5, 0x5c, 0x1b, 0x87, 0x5e, 0xf4, 0x49, 0x19, 0x74, 0xa4, 0x9c, 0xec,
+	0x2b, 0x85, 0x11, 0xea, 0xaf, 0xe5, 0x2a, 0x44, 0xbc, 0x52, 0x78, 0xfe, 0xd2, 0x30, 0xa2, 0x15,
+	0x8b, 0x75, 0xdd, 0xb2, 0x9c, 0xe4, 0x1d, 0xcc, 0x4c, 0xec, 0x6e, 0x27, 0xa3, 0x18, 0x74, 0x99,
+	0xad, 0x5c, 0xb5, 0xeb, 0x15, 0xa3, 0x9c, 0xe3, 0x86, 0x4b, 0xa3, 0xf7, 0x1f, 0xc4, 0x07, 0xfe,
+	0x7a, 0x10, 0x1f, 0x90, 0xd7, 0xfd, 0xbb, 0xd2, 0x4e, 0x4f, 0x16, 0x46, 0x50, 0x16, 0x6e, 0xc9,
+	0xd3, 0x66, 0x87, 0xc3, 0xc8, 0x51, 0xa0, 0x4e, 0xa4, 0xac, 0x56, 0xd7, 0xaa, 0x7c, 0xf7, 0xe5,
+	0x2c, 0x44, 0x7c, 0x6f, 0x31, 0xfc, 0x39, 0x18, 0xae, 0x39, 0x6f, 0x30, 0xfa, 0xa4, 0x22, 0x0a,
+	0xc2, 0x9c, 0x32, 0x43, 0x0f, 0x7f, 0x8b, 0x0f, 0xe4, 0xd0, 0x41, 0x4e, 0x81, 0xe4, 0x20, 0x5e,
+	0x36, 0x8b, 0x8d, 0x0d, 0xbd, 0xa3, 0xda, 0x44, 0x99, 0x5d, 0x94, 0xdf, 0x25, 0x30, 0x29, 0xf4,
+	0x41, 0x36, 0x37, 0x43, 0xd6, 0xca, 0x89, 0x9d, 0xed, 0xa4, 0x2c, 0x22, 0xea, 0xc3, 0xf5, 0x54,
+	0x8c, 0x98, 0xce, 0x02, 0xc4, 0xbb, 0xd9, 0x64, 0x9a, 0xaf, 0x69, 0x55, 0x5e, 0xf8, 0x94, 0xc2,
+	0x90, 0xa1, 0x55, 0x75, 0xb6, 0xe3, 0x39, 0xe7, 0xb7, 0xfc, 0x26, 0x24, 0x7a, 0xbb, 0xa1, 0x92,
+	0xeb, 0xe1, 0xb6, 0x35, 0xac, 0x90, 0xf6, 0xe6, 0xce, 0x42, 0x24, 0xa3, 0xaf, 0xad, 0xcf, 0xa5,
+	0xb3, 0x75, 0xbd, 0x54, 0xb9, 0x17, 0x98, 0xed, 0x2c, 0x44, 0xfd, 0xb6, 0xc8, 0xed, 0x38, 0x1c,
+	0x2e, 0x38, 0xef, 0xf3, 0x35, 0x67, 0x01, 0xc5, 0x8d, 0x15, 0x3c, 0xc6, 0x62, 0xc4, 0x1b, 0x30,
+	0x89, 0x85, 0x9e, 0x69, 0xda, 0xba, 0x75, 0xcd, 0xc4, 0x7a, 0xc7, 0x64, 0x1d, 0x87, 0xc3, 0x58,
+	0xf8, 0xf9, 0x42, 0x6b, 0xdd, 0x01, 0x1e, 0xcb, 0x8d, 0x69, 0x1e, 0x1f, 0x31, 0xf0, 0x6d, 0x98,
+	0x12, 0x03, 0x23, 0xe5, 0x17, 0x61, 0x9c, 0x23, 0x5b, 0xce, 0x0a, 0x72, 0xe6, 0xf1, 0x98, 0xb9,
+	0x18, 0xfb, 0x56, 0x9b, 0x34, 0xb3, 0xba, 0x66, 0x3a, 0x31, 0x38, 0xe9, 0x67, 0x81, 0xbe, 0xd9,
+	0xa6, 0xdd, 0x01, 0xed, 0x66, 0xfa, 0x29, 0x13, 0xf2, 0x16, 0xc4, 0xbc, 0xc7, 0x45, 0x3b, 0x39,
+	0xab, 0x17, 0xdc, 0xca, 0x1c, 0xac, 0x14, 0x1d, 0xc0, 0x03, 0x99, 0xc1, 0x09, 0x92, 0x1b, 0xac,
+	0x14, 0x69, 0x1a, 0x00, 0x0b, 0x25, 0x5f, 0x29, 0x3a, 0xe7, 0xe2, 0x50, 0x26, 0xf2, 0x6b, 0x17,
+	0xfc, 0x99, 0xdc, 0x41, 0x34, 0x5b, 0x2d, 0x2e, 0x1d, 0xdd, 0xdd, 0x4e, 0xfe, 0xbf, 0x23, 0xbc,
+	0x92, 0x96, 0x37, 0x21, 0xde, 0x93, 0x00, 0xaa, 0x5b, 0x86, 0x23, 0x3c, 0x5a, 0xd8, 0x83, 0x71,
+	0x5c, 0xf3, 0xc1, 0xf5, 0x0a, 0x5e, 0x80, 0xe7, 0xbc, 0xc1, 0x57, 0x8d, 0x92, 0xf9, 0x2c, 0xa7,
+	0xb0, 0x20, 0xc3, 0x67, 0x64, 0x1d, 0x26, 0xba, 0x63, 0xa0, 0xb2, 0x79, 0x18, 0xaa, 0x18, 0x25,
+	0x13, 0x3f, 0xdd, 0x84, 0xf0, 0x4c, 0xcc, 0x68, 0x16, 0xff, 0x3e, 0x73, 0x8e, 0xb5, 0x38, 0x4c,
+	0x1d, 0xcf, 0xdd, 0xcb, 0x56, 0x79, 0x45, 0xd7, 0xff, 0x85, 0xcb, 0x78, 0x61, 0x51, 0xfe, 0x9c,
+	0x40, 0xd4, 0x1f, 0x14, 0x75, 0x9d, 0x87, 0xd1, 0xaa, 0x55, 0xce, 0x97, 0x74, 0x9d, 0x9f, 0xaf,
+	0xe2, 0xf3, 0x9e, 0xf9, 0xe1, 0x79, 0x3f, 0x52, 0x75, 0x9e, 0xf6, 0xf5, 0xd6, 0x5d, 0x58, 0x94,
+	0x2f, 0xe1, 0xb5, 0xc5, 0x62, 0xf3, 0x3c, 0x25, 0x60, 0xac, 0xc5, 0xd8, 0x6e, 0xd6, 0xf4, 0x7c,
+	0xa3, 0xbe, 0x81, 0xdf, 0x26, 0x54, 0xad, 0xf2, 0xb5, 0x66, 0x4d, 0x7f, 0xbd, 0xbe, 0x21, 0x06,
+	0x2b, 0xf9, 0xb2, 0xde, 0xd6, 0xbf, 0x04, 0x23, 0xa8, 0x3f, 0xf0, 0xba, 0xf3, 0xc9, 0x1f, 0x66,
+	0xf2, 0xc5, 0x71, 0x3e, 0xe5, 0x17, 0x5a, 0xb6, 0x51, 0xb8, 0xa4, 0x37, 0x73, 0xbc, 0xc5, 0x7b,
+	0x86, 0x6a, 0xa5, 0x2b, 0x82, 0x34, 0xff, 0x63, 0xa5, 0xf1, 0x2d, 0x81, 0x29, 0x31, 0x61, 0x4c,
+	0xd1, 0x45, 0x38, 0x58, 0xe7, 0x2f, 0xb1, 0x46, 0x8e, 0x8b, 0x7b, 0x02, 0x1f, 0x00, 0x26, 0xcb,
+	0xf5, 0xdd, 0xe7, 0x6a, 0xf9, 0x84, 0x60, 0xf7, 0xb1, 0xdc, 0xb0, 0xd7, 0x75, 0xc3, 0xae, 0xac,
+	0x69, 0xb6, 0x59, 0xff, 0xef, 0xe6, 0xfd, 0x27, 0x5e, 0x28, 0x9d, 0x7c, 0x31, 0xed, 0x37, 0x60,
+	0x5c, 0xf3, 0xad, 0x60, 0xee, 0x67, 0x94, 0x80, 0xa6, 0xcf, 0x87, 0x85, 0x3b, 0xd0, 0x01, 0xb3,
+	0xbf, 0xdb, 0x90, 0xfe, 0x39, 0x02, 0xff, 0x73, 0x64, 0xd1, 0x07, 0x04, 0x46, 0x97, 0x79, 0x7f,
+	0x2e, 0x66, 0x2d, 0x9a, 0x4a, 0xa4, 0xd9, 0x30, 0xa6, 0x8c, 0x8e, 0xfc, 0xca, 0x4e, 0x77, 0xb7,
+	0x7e, 0xff, 0xcf, 0xcf, 0x66, 0xc9, 0xdb, 0x3f, 0xfe, 0xf1, 0xfe, 0x60, 0x9c, 0x1e, 0x53, 0x85,
+	0x23, 0x15, 0x67, 0xf5, 0x01, 0x81, 0x11, 0xc4, 0xa4, 0xd3, 0x7d, 0xc3, 0x72, 0x82, 0x33, 0x21,
+	0x2c, 0x91, 0xdf, 0xbc, 0x4b, 0x66, 0x86, 0x9e, 0x0c, 0x24, 0xa3, 0x6e, 0x62, 0xd9, 0x6d, 0xd1,
+	0x5d, 0x02, 0xb4, 0xfb, 0x96, 0xa5, 0x73, 0x7d, 0xe3, 0x76, 0x37, 0x05, 0xd2, 0xfc, 0xde, 0x9c,
+	0x90, 0xf7, 0x95, 0x1d, 0xd1, 0x2d, 0xec, 0x8a, 0x49, 0x51, 0x55, 0x2c, 0xa6, 0xdd, 0xdb, 0xe4,
+	0x2b, 0x45, 0x75, 0xd3, 0x6d, 0x3d, 0xb6, 0xe8, 0x3b, 0x04, 0x86, 0xd9, 0xd4, 0x40, 0x4f, 0xf6,
+	0xe6, 0xe4, 0x1b, 0x51, 0xa4, 0xe9, 0xfe, 0x86, 0x48, 0x78, 0xda, 0xe5, 0x76, 0x8c, 0x4e, 0x0a,
+	0xb9, 0xb1, 0x21, 0x85, 0x7e, 0x49, 0x60, 0xdc, 0x3f, 0x6c, 0x50, 0xb5, 0x77, 0x18, 0xe1, 0x28,
+	0x23, 0x9d, 0x0e, 0xef, 0x80, 0xfc, 0x2e, 0x74, 0x17, 0xea, 0xa2, 0x4b, 0xf9, 0x04, 0x7d, 0x41,
+	0x48, 0xb9, 0xea, 0x80, 0xe5, 0xdb, 0xf5, 0xfa, 0x15, 0x81, 0x88, 0x60, 0xc6, 0xa0, 0xf3, 0x21,
+	0xf9, 0xf8, 0x26, 0x19, 0x69, 0x61, 0x8f, 0x5e, 0x28, 0xe5, 0xac, 0xcb, 0x3b, 0x49, 0x5f, 0x0a,
+	0xc3, 0x5b, 0xdd, 0x6c, 0x4d, 0x49, 0x5b, 0xf4, 0x63, 0x02, 0x63, 0xde, 0xf9, 0xa3, 0xc7, 0x37,
+	0x27, 0x18, 0x67, 0xa4, 0x99, 0x10, 0x96, 0xc8, 0x6f, 0x51, 0x90, 0xea, 0xc0, 0xc2, 0x60, 0x53,
+	0x0e, 0xfd, 0x9a, 0x40, 0x54, 0x34, 0x72, 0x50, 0xf1, 0x6e, 0x07, 0x8c, 0x3d, 0x52, 0x6a, 0x0f,
+	0x1e, 0xc8, 0x7a, 0xa5, 0x17, 0xeb, 0x5e, 0x39, 0x66, 0xac, 0xd5, 0x4d, 0xdf, 0x38, 0xb1, 0x45,
+	0xbf, 0x71, 0x55, 0xf8, 0x26, 0x90, 0x60, 0x15, 0xa2, 0x39, 0x48, 0x4a, 0xed, 0xc1, 0x03, 0x55,
+	0x5c, 0xec, 0xa5, 0x42, 0xa1, 0xa7, 0x42, 0xa9, 0x60, 0x03, 0xd7, 0x16, 0xfd, 0x82, 0xc0, 0x21,
+	0x4f, 0x1f, 0x4e, 0x4f, 0xf5, 0x3d, 0xc6, 0x3c, 0x23, 0x81, 0x94, 0x0c, 0x69, 0x8d, 0xac, 0x2f,
+	0x75, 0xb3, 0x3e, 0xd3, 0xbf, 0xc8, 0xdb, 0x87, 0x9b, 0x51, 0x32, 0x3d, 0x87, 0xf7, 0x87, 0x04,
+	0x46, 0xb0, 0xcb, 0x0e, 0xba, 0x53, 0xfc, 0xdd, 0xbf, 0x34, 0x13, 0xc2, 0x92, 0x7f, 0x7f, 0x3b,
+	0xdd, 0xd7, 0x6e, 0xe0, 0x75, 0xc7, 0x1b, 0x7c, 0xfa, 0x11, 0x81, 0x61, 0x86, 0x16, 0x74, 0x04,
+	0xfb, 0xda, 0x6d, 0x69, 0xba, 0xbf, 0x21, 0xf2, 0x3a, 0x2f, 0xe0, 0xe5, 0x66, 0x31, 0x46, 0xa7,
+	0x82, 0xc8, 0xd1, 0x1f, 0x08, 0x1c, 0xe9, 0xe8, 0x40, 0x69, 0xc0, 0x31, 0x2b, 0xee, 0xae, 0xa5,
+	0xd4, 0x1e, 0x3c, 0x90, 0xf6, 0xad, 0x40, 0xda, 0x2f, 0xd3, 0x73, 0x21, 0x6f, 0x6d, 0xb5, 0xd6,
+	0x28, 0xe4, 0xef, 0xe8, 0xcd, 0xbc, 0xdb, 0xf0, 0x7e, 0x4f, 0x60, 0xdc, 0xdf, 0xdd, 0x05, 0x5d,
+	0x35, 0xc2, 0xbe, 0x55, 0x3a, 0x1d, 0xde, 0x01, 0x05, 0x5d, 0x0f, 0x14, 0x74, 0x96, 0x2e, 0x86,
+	0x15, 0xe4, 0xef, 0x1b, 0x33, 0xaf, 0x3e, 0x7c, 0x1c, 0x23, 0x8f, 0x1e, 0xc7, 0xc8, 0xef, 0x8f,
+	0x63, 0xe4, 0xbd, 0x27, 0xb1, 0x81, 0x47, 0x4f, 0x62, 0x03, 0xbf, 0x3c, 0x89, 0x0d, 0xdc, 0x9e,
+	0x29, 0x57, 0xec, 0xf5, 0x46, 0x41, 0x59, 0x33, 0xab, 0x1c, 0xdb, 0x8d, 0xaf, 0xde, 0x63, 0x81,
+	0x5a, 0x03, 0x9b, 0x55, 0x18, 0x76, 0xfe, 0xec, 0x9a, 0xfb, 0x7b, 0x00, 0x26, 0x01, 0xe8, 0xec,
+	0x9c, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1068,6 +1514,18 @@ type QueryClient interface {
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// MsgFees returns the gas multipliers and minimum fees of all the message
+	// types that have one configured.
+	MsgFees(ctx context.Context, in *QueryMsgFeesRequest, opts ...grpc.CallOption) (*QueryMsgFeesResponse, error)
+	// MsgFee returns the gas multiplier and minimum fee of a message type. Message
+	// types without a configured fee report a gas multiplier of 1 and no minimum
+	// fee.
+	MsgFee(ctx context.Context, in *QueryMsgFeeRequest, opts ...grpc.CallOption) (*QueryMsgFeeResponse, error)
+	// PubKeyRotations returns the history of the rotations of the public key of
+	// an account, oldest first.
+	PubKeyRotations(ctx context.Context, in *QueryPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPubKeyRotationsResponse, error)
+	// Authenticators returns the authenticators registered by an account.
+	Authenticators(ctx context.Context, in *QueryAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAuthenticatorsResponse, error)
 }
 
 type queryClient struct {
@@ -1168,10 +1626,46 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Accounts returns all the existing accounts.
-	//
+func (c *queryClient) MsgFees(ctx context.Context, in *QueryMsgFeesRequest, opts ...grpc.CallOption) (*QueryMsgFeesResponse, error) {
+	out := new(QueryMsgFeesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/MsgFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgFee(ctx context.Context, in *QueryMsgFeeRequest, opts ...grpc.CallOption) (*QueryMsgFeeResponse, error) {
+	out := new(QueryMsgFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/MsgFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PubKeyRotations(ctx context.Context, in *QueryPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPubKeyRotationsResponse, error) {
+	out := new(QueryPubKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyRotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Authenticators(ctx context.Context, in *QueryAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAuthenticatorsResponse, error) {
+	out := new(QueryAuthenticatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/Authenticators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Accounts returns all the existing accounts.
+	//
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
@@ -1193,6 +1687,18 @@ type QueryServer interface {
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// MsgFees returns the gas multipliers and minimum fees of all the message
+	// types that have one configured.
+	MsgFees(context.Context, *QueryMsgFeesRequest) (*QueryMsgFeesResponse, error)
+	// MsgFee returns the gas multiplier and minimum fee of a message type. Message
+	// types without a configured fee report a gas multiplier of 1 and no minimum
+	// fee.
+	MsgFee(context.Context, *QueryMsgFeeRequest) (*QueryMsgFeeResponse, error)
+	// PubKeyRotations returns the history of the rotations of the public key of
+	// an account, oldest first.
+	PubKeyRotations(context.Context, *QueryPubKeyRotationsRequest) (*QueryPubKeyRotationsResponse, error)
+	// Authenticators returns the authenticators registered by an account.
+	Authenticators(context.Context, *QueryAuthenticatorsRequest) (*QueryAuthenticatorsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1229,6 +1735,18 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) MsgFees(ctx context.Context, req *QueryMsgFeesRequest) (*QueryMsgFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgFees not implemented")
+}
+func (*UnimplementedQueryServer) MsgFee(ctx context.Context, req *QueryMsgFeeRequest) (*QueryMsgFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgFee not implemented")
+}
+func (*UnimplementedQueryServer) PubKeyRotations(ctx context.Context, req *QueryPubKeyRotationsRequest) (*QueryPubKeyRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyRotations not implemented")
+}
+func (*UnimplementedQueryServer) Authenticators(ctx context.Context, req *QueryAuthenticatorsRequest) (*QueryAuthenticatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticators not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1414,6 +1932,78 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MsgFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/MsgFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgFees(ctx, req.(*QueryMsgFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/MsgFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgFee(ctx, req.(*QueryMsgFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PubKeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyRotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyRotations(ctx, req.(*QueryPubKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Authenticators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthenticatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Authenticators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/Authenticators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Authenticators(ctx, req.(*QueryAuthenticatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
@@ -1459,6 +2049,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "MsgFees",
+			Handler:    _Query_MsgFees_Handler,
+		},
+		{
+			MethodName: "MsgFee",
+			Handler:    _Query_MsgFee_Handler,
+		},
+		{
+			MethodName: "PubKeyRotations",
+			Handler:    _Query_PubKeyRotations_Handler,
+		},
+		{
+			MethodName: "Authenticators",
+			Handler:    _Query_Authenticators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -2095,280 +2701,1294 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryMsgFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryMsgFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMsgFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryMsgFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryMsgFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMsgFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMsgFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryModuleAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryMsgFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryModuleAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMsgFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.MsgFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleAccountByNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPubKeyRotationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyRotationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyRotationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleAccountByNameResponse) Size() (n int) {
+func (m *QueryPubKeyRotationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyRotationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyRotationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rotations) > 0 {
+		for iNdEx := len(m.Rotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthenticatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthenticatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthenticatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthenticatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthenticatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthenticatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authenticators) > 0 {
+		for iNdEx := len(m.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *Bech32PrefixRequest) Size() (n int) {
+func (m *QueryAccountsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *Bech32PrefixResponse) Size() (n int) {
+func (m *QueryAccountRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Bech32Prefix)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *AddressBytesToStringRequest) Size() (n int) {
+func (m *QueryAccountResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.AddressBytes)
-	if l > 0 {
+	if m.Account != nil {
+		l = m.Account.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *AddressBytesToStringResponse) Size() (n int) {
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.AddressString)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *AddressStringToBytesRequest) Size() (n int) {
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.AddressString)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *AddressStringToBytesResponse) Size() (n int) {
+func (m *QueryModuleAccountsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.AddressBytes)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *QueryAccountAddressByIDRequest) Size() (n int) {
+func (m *QueryModuleAccountsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	if m.AccountId != 0 {
-		n += 1 + sovQuery(uint64(m.AccountId))
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *QueryAccountAddressByIDResponse) Size() (n int) {
+func (m *QueryModuleAccountByNameRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.AccountAddress)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryAccountInfoRequest) Size() (n int) {
+func (m *QueryModuleAccountByNameResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
+	if m.Account != nil {
+		l = m.Account.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryAccountInfoResponse) Size() (n int) {
+func (m *Bech32PrefixRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *Bech32PrefixResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bech32Prefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressBytesToStringRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AddressBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressBytesToStringResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AddressString)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressStringToBytesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AddressString)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressStringToBytesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AddressBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountAddressByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.AccountId != 0 {
+		n += 1 + sovQuery(uint64(m.AccountId))
+	}
+	return n
+}
+
+func (m *QueryAccountAddressByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MsgFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPubKeyRotationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyRotationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for _, e := range m.Rotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuthenticatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuthenticatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for _, e := range m.Authenticators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &any.Any{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &any.Any{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2391,15 +4011,15 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2426,10 +4046,8 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accounts = append(m.Accounts, &any.Any{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2454,7 +4072,7 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2477,17 +4095,17 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAccountByNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAccountByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2497,29 +4115,77 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, &any.Any{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountByNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2546,10 +4212,10 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Account == nil {
+				m.Account = &any.Any{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2574,7 +4240,7 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *Bech32PrefixRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2597,44 +4263,12 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bech32PrefixRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bech32PrefixRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2656,7 +4290,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2679,17 +4313,17 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bech32PrefixResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bech32PrefixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2699,27 +4333,23 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &any.Any{}
-			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2742,7 +4372,7 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2752,25 +4382,59 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressBytesToStringRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressBytesToStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AddressBytes = append(m.AddressBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.AddressBytes == nil {
+				m.AddressBytes = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2792,7 +4456,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *AddressBytesToStringResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2815,17 +4479,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressBytesToStringResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressBytesToStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressString", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2835,24 +4499,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AddressString = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2875,7 +4538,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *AddressStringToBytesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2898,12 +4561,44 @@ func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressStringToBytesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressStringToBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2925,7 +4620,7 @@ func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *AddressStringToBytesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2948,17 +4643,17 @@ func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressStringToBytesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressStringToBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressBytes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2968,24 +4663,24 @@ func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, &any.Any{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AddressBytes = append(m.AddressBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.AddressBytes == nil {
+				m.AddressBytes = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3009,7 +4704,7 @@ func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountAddressByIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3032,17 +4727,17 @@ func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountByNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountAddressByIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountAddressByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3052,24 +4747,30 @@ func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.AccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3091,7 +4792,7 @@ func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountAddressByIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3114,17 +4815,17 @@ func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountByNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountAddressByIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountAddressByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3134,27 +4835,23 @@ func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &any.Any{}
-			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3177,7 +4874,7 @@ func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bech32PrefixRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3200,12 +4897,44 @@ func (m *Bech32PrefixRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bech32PrefixRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bech32PrefixRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3227,7 +4956,7 @@ func (m *Bech32PrefixRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3250,17 +4979,17 @@ func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bech32PrefixResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bech32PrefixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3270,23 +4999,27 @@ func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
+			if m.Info == nil {
+				m.Info = &BaseAccount{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3309,7 +5042,7 @@ func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMsgFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3332,17 +5065,17 @@ func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressBytesToStringRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMsgFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressBytesToStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMsgFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3352,24 +5085,26 @@ func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressBytes = append(m.AddressBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.AddressBytes == nil {
-				m.AddressBytes = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3393,7 +5128,7 @@ func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressBytesToStringResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMsgFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3416,17 +5151,17 @@ func (m *AddressBytesToStringResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressBytesToStringResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMsgFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressBytesToStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMsgFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3436,23 +5171,61 @@ func (m *AddressBytesToStringResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressString = string(dAtA[iNdEx:postIndex])
+			m.MsgFees = append(m.MsgFees, MsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3475,7 +5248,7 @@ func (m *AddressBytesToStringResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressStringToBytesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMsgFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3498,15 +5271,15 @@ func (m *AddressStringToBytesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressStringToBytesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMsgFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressStringToBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMsgFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3534,7 +5307,7 @@ func (m *AddressStringToBytesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressString = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3557,7 +5330,7 @@ func (m *AddressStringToBytesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressStringToBytesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMsgFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3580,17 +5353,17 @@ func (m *AddressStringToBytesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressStringToBytesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMsgFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressStringToBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMsgFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFee", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3600,24 +5373,23 @@ func (m *AddressStringToBytesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressBytes = append(m.AddressBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.AddressBytes == nil {
-				m.AddressBytes = []byte{}
+			if err := m.MsgFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3641,7 +5413,7 @@ func (m *AddressStringToBytesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountAddressByIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPubKeyRotationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3664,17 +5436,17 @@ func (m *QueryAccountAddressByIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountAddressByIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPubKeyRotationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountAddressByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPubKeyRotationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3684,16 +5456,29 @@ func (m *QueryAccountAddressByIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.AccountId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3703,11 +5488,28 @@ func (m *QueryAccountAddressByIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3729,7 +5531,7 @@ func (m *QueryAccountAddressByIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountAddressByIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPubKeyRotationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3752,17 +5554,17 @@ func (m *QueryAccountAddressByIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountAddressByIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPubKeyRotationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountAddressByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPubKeyRotationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3772,23 +5574,61 @@ func (m *QueryAccountAddressByIDResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.Rotations = append(m.Rotations, PubKeyRotation{})
+			if err := m.Rotations[len(m.Rotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3811,7 +5651,7 @@ func (m *QueryAccountAddressByIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3834,10 +5674,10 @@ func (m *QueryAccountInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuthenticatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuthenticatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3872,6 +5712,42 @@ func (m *QueryAccountInfoRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3893,7 +5769,7 @@ func (m *QueryAccountInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3916,15 +5792,15 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuthenticatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuthenticatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3951,10 +5827,44 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &BaseAccount{}
+			m.Authenticators = append(m.Authenticators, AccountAuthenticator{})
+			if err := m.Authenticators[len(m.Authenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
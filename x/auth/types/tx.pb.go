@@ -12,6 +12,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -126,38 +127,463 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateMsgFees is the Msg/UpdateMsgFees request type.
+type MsgUpdateMsgFees struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msg_fees replaces all the configured message fees. Message types without
+	// an entry pay the regular gas price and no minimum fee.
+	MsgFees []MsgFee `protobuf:"bytes,2,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+}
+
+func (m *MsgUpdateMsgFees) Reset()         { *m = MsgUpdateMsgFees{} }
+func (m *MsgUpdateMsgFees) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMsgFees) ProtoMessage()    {}
+func (*MsgUpdateMsgFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{2}
+}
+func (m *MsgUpdateMsgFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMsgFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMsgFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMsgFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMsgFees.Merge(m, src)
+}
+func (m *MsgUpdateMsgFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMsgFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMsgFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMsgFees proto.InternalMessageInfo
+
+func (m *MsgUpdateMsgFees) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateMsgFees) GetMsgFees() []MsgFee {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
+}
+
+// MsgUpdateMsgFeesResponse defines the response structure for executing a
+// MsgUpdateMsgFees message.
+type MsgUpdateMsgFeesResponse struct {
+}
+
+func (m *MsgUpdateMsgFeesResponse) Reset()         { *m = MsgUpdateMsgFeesResponse{} }
+func (m *MsgUpdateMsgFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMsgFeesResponse) ProtoMessage()    {}
+func (*MsgUpdateMsgFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{3}
+}
+func (m *MsgUpdateMsgFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMsgFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMsgFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMsgFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMsgFeesResponse.Merge(m, src)
+}
+func (m *MsgUpdateMsgFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMsgFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMsgFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMsgFeesResponse proto.InternalMessageInfo
+
+// MsgRotatePubKey is the Msg/RotatePubKey request type.
+type MsgRotatePubKey struct {
+	// address is the address of the account, which must sign with its current
+	// public key.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// new_pub_key is the public key replacing the current one. It can be of any
+	// key type, e.g. a multisig or ML-DSA-65 public key.
+	NewPubKey *any.Any `protobuf:"bytes,2,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+}
+
+func (m *MsgRotatePubKey) Reset()         { *m = MsgRotatePubKey{} }
+func (m *MsgRotatePubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotatePubKey) ProtoMessage()    {}
+func (*MsgRotatePubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{4}
+}
+func (m *MsgRotatePubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotatePubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotatePubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotatePubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotatePubKey.Merge(m, src)
+}
+func (m *MsgRotatePubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotatePubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotatePubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotatePubKey proto.InternalMessageInfo
+
+func (m *MsgRotatePubKey) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgRotatePubKey) GetNewPubKey() *any.Any {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+// MsgRotatePubKeyResponse defines the response structure for executing a
+// MsgRotatePubKey message.
+type MsgRotatePubKeyResponse struct {
+}
+
+func (m *MsgRotatePubKeyResponse) Reset()         { *m = MsgRotatePubKeyResponse{} }
+func (m *MsgRotatePubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotatePubKeyResponse) ProtoMessage()    {}
+func (*MsgRotatePubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{5}
+}
+func (m *MsgRotatePubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotatePubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotatePubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotatePubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotatePubKeyResponse.Merge(m, src)
+}
+func (m *MsgRotatePubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotatePubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotatePubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotatePubKeyResponse proto.InternalMessageInfo
+
+// MsgAddAuthenticator is the Msg/AddAuthenticator request type.
+type MsgAddAuthenticator struct {
+	// sender is the address of the account registering the authenticator.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// authenticator_type is the type of the authenticator.
+	AuthenticatorType string `protobuf:"bytes,2,opt,name=authenticator_type,json=authenticatorType,proto3" json:"authenticator_type,omitempty"`
+	// config is the configuration of the authenticator.
+	Config []byte `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (m *MsgAddAuthenticator) Reset()         { *m = MsgAddAuthenticator{} }
+func (m *MsgAddAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAuthenticator) ProtoMessage()    {}
+func (*MsgAddAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{6}
+}
+func (m *MsgAddAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAuthenticator.Merge(m, src)
+}
+func (m *MsgAddAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAuthenticator proto.InternalMessageInfo
+
+func (m *MsgAddAuthenticator) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgAddAuthenticator) GetAuthenticatorType() string {
+	if m != nil {
+		return m.AuthenticatorType
+	}
+	return ""
+}
+
+func (m *MsgAddAuthenticator) GetConfig() []byte {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// MsgAddAuthenticatorResponse defines the response structure for executing a
+// MsgAddAuthenticator message.
+type MsgAddAuthenticatorResponse struct {
+	// id is the identifier of the registered authenticator.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgAddAuthenticatorResponse) Reset()         { *m = MsgAddAuthenticatorResponse{} }
+func (m *MsgAddAuthenticatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAuthenticatorResponse) ProtoMessage()    {}
+func (*MsgAddAuthenticatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{7}
+}
+func (m *MsgAddAuthenticatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAuthenticatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAuthenticatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAuthenticatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAuthenticatorResponse.Merge(m, src)
+}
+func (m *MsgAddAuthenticatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAuthenticatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAuthenticatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAuthenticatorResponse proto.InternalMessageInfo
+
+func (m *MsgAddAuthenticatorResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgRemoveAuthenticator is the Msg/RemoveAuthenticator request type.
+type MsgRemoveAuthenticator struct {
+	// sender is the address of the account removing the authenticator.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// id is the identifier of the authenticator to remove.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRemoveAuthenticator) Reset()         { *m = MsgRemoveAuthenticator{} }
+func (m *MsgRemoveAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAuthenticator) ProtoMessage()    {}
+func (*MsgRemoveAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{8}
+}
+func (m *MsgRemoveAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAuthenticator.Merge(m, src)
+}
+func (m *MsgRemoveAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAuthenticator proto.InternalMessageInfo
+
+func (m *MsgRemoveAuthenticator) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRemoveAuthenticator) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgRemoveAuthenticatorResponse defines the response structure for executing
+// a MsgRemoveAuthenticator message.
+type MsgRemoveAuthenticatorResponse struct {
+}
+
+func (m *MsgRemoveAuthenticatorResponse) Reset()         { *m = MsgRemoveAuthenticatorResponse{} }
+func (m *MsgRemoveAuthenticatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAuthenticatorResponse) ProtoMessage()    {}
+func (*MsgRemoveAuthenticatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{9}
+}
+func (m *MsgRemoveAuthenticatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAuthenticatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAuthenticatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAuthenticatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAuthenticatorResponse.Merge(m, src)
+}
+func (m *MsgRemoveAuthenticatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAuthenticatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAuthenticatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAuthenticatorResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.auth.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.auth.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateMsgFees)(nil), "cosmos.auth.v1beta1.MsgUpdateMsgFees")
+	proto.RegisterType((*MsgUpdateMsgFeesResponse)(nil), "cosmos.auth.v1beta1.MsgUpdateMsgFeesResponse")
+	proto.RegisterType((*MsgRotatePubKey)(nil), "cosmos.auth.v1beta1.MsgRotatePubKey")
+	proto.RegisterType((*MsgRotatePubKeyResponse)(nil), "cosmos.auth.v1beta1.MsgRotatePubKeyResponse")
+	proto.RegisterType((*MsgAddAuthenticator)(nil), "cosmos.auth.v1beta1.MsgAddAuthenticator")
+	proto.RegisterType((*MsgAddAuthenticatorResponse)(nil), "cosmos.auth.v1beta1.MsgAddAuthenticatorResponse")
+	proto.RegisterType((*MsgRemoveAuthenticator)(nil), "cosmos.auth.v1beta1.MsgRemoveAuthenticator")
+	proto.RegisterType((*MsgRemoveAuthenticatorResponse)(nil), "cosmos.auth.v1beta1.MsgRemoveAuthenticatorResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/tx.proto", fileDescriptor_c2d62bd9c4c212e5) }
 
 var fileDescriptor_c2d62bd9c4c212e5 = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xa0, 0xb2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x79, 0x7d, 0x10, 0x0b, 0xa2, 0x54, 0x4a,
-	0x12, 0xa2, 0x34, 0x1e, 0x22, 0x01, 0xd5, 0x07, 0x91, 0x12, 0x87, 0xda, 0x91, 0x5b, 0x9c, 0xae,
-	0x5f, 0x66, 0x08, 0xa2, 0xa0, 0x12, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x2a,
-	0x24, 0x87, 0xcd, 0x3d, 0x60, 0xeb, 0xc1, 0xf2, 0x4a, 0x97, 0x18, 0xb9, 0xf8, 0x7d, 0x8b, 0xd3,
-	0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x03, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x85, 0xcc, 0xb8, 0x38,
-	0x41, 0x2a, 0xf2, 0x8b, 0x32, 0x4b, 0x2a, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e,
-	0x6d, 0xd1, 0x15, 0x81, 0x3a, 0xc2, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28,
-	0x33, 0x2f, 0x3d, 0x08, 0xa1, 0x54, 0xc8, 0x8e, 0x8b, 0xad, 0x00, 0x6c, 0x82, 0x04, 0x93, 0x02,
-	0xa3, 0x06, 0xb7, 0x91, 0xb4, 0x1e, 0x16, 0xef, 0xea, 0x41, 0x2c, 0x71, 0xe2, 0x3c, 0x71, 0x4f,
-	0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x56, 0xee, 0x97, 0xb6, 0xe8, 0xf2,
-	0x43, 0xb4, 0xe8, 0x16, 0xa7, 0x64, 0x2b, 0x18, 0xe8, 0x99, 0x98, 0x37, 0x3d, 0xdf, 0xa0, 0x85,
-	0xb0, 0xa2, 0xeb, 0xf9, 0x06, 0x2d, 0x45, 0x84, 0x0a, 0xfd, 0x0a, 0x88, 0xbf, 0xd0, 0x3c, 0xa0,
-	0xa4, 0xc7, 0x25, 0x8e, 0x26, 0x14, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x6a, 0x25, 0x8c,
-	0xc5, 0x0e, 0xa3, 0x56, 0x46, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x72, 0x2e, 0x1e, 0x94, 0x80,
-	0x50, 0xc1, 0xea, 0x01, 0x34, 0xa3, 0xa5, 0x74, 0x88, 0x51, 0x05, 0x73, 0x80, 0x92, 0xf0, 0x29,
-	0x4c, 0x07, 0x48, 0xb1, 0x36, 0x80, 0x02, 0xc2, 0xc9, 0xf9, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x34, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xa1,
-	0x69, 0x41, 0x1f, 0x33, 0x18, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x11, 0x6b, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0x81, 0xf5, 0xf7, 0x21, 0x8a, 0x02, 0x00, 0x00,
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x53, 0x9a, 0x92, 0x6d, 0xa1, 0xad, 0x53, 0xb5, 0xc1, 0x45, 0x26, 0xb5, 0x40, 0x0a,
+	0xa5, 0xb1, 0xfb, 0x41, 0x82, 0x94, 0x03, 0x52, 0xc2, 0xa7, 0x84, 0x82, 0x90, 0x81, 0x0b, 0x97,
+	0xc8, 0x89, 0xb7, 0xae, 0x55, 0xec, 0xb5, 0xbc, 0x9b, 0xb6, 0xe6, 0x84, 0xe0, 0x86, 0x90, 0xe0,
+	0x27, 0x70, 0xe4, 0xd8, 0x43, 0x7f, 0x44, 0xd5, 0x53, 0xe9, 0x05, 0x0e, 0x08, 0xa1, 0xf6, 0xd0,
+	0xbf, 0x81, 0xec, 0xdd, 0x34, 0x5f, 0xb6, 0x1b, 0xe0, 0xd2, 0xda, 0x33, 0x6f, 0xe7, 0xcd, 0x7b,
+	0x99, 0x9d, 0x04, 0x5c, 0x6d, 0x22, 0x6c, 0x21, 0xac, 0x68, 0x2d, 0xb2, 0xa1, 0x6c, 0xad, 0x34,
+	0x20, 0xd1, 0x56, 0x14, 0xb2, 0x23, 0x3b, 0x2e, 0x22, 0x88, 0xcf, 0xd0, 0xac, 0xec, 0x67, 0x65,
+	0x96, 0x15, 0x66, 0x0c, 0x64, 0xa0, 0x20, 0xaf, 0xf8, 0x4f, 0x14, 0x2a, 0x5c, 0x31, 0x10, 0x32,
+	0x5e, 0x43, 0x25, 0x78, 0x6b, 0xb4, 0xd6, 0x15, 0xcd, 0xf6, 0xda, 0x29, 0x5a, 0xa5, 0x4e, 0xcf,
+	0xb0, 0x92, 0x34, 0x35, 0xc7, 0xe8, 0x2d, 0x6c, 0x28, 0x5b, 0x2b, 0xfe, 0x3f, 0x96, 0x98, 0xd6,
+	0x2c, 0xd3, 0x46, 0x4a, 0xf0, 0x97, 0x85, 0xc4, 0xb0, 0x56, 0x83, 0xce, 0x82, 0xbc, 0x74, 0xc4,
+	0x81, 0xc9, 0x1a, 0x36, 0x5e, 0x3a, 0xba, 0x46, 0xe0, 0x33, 0xcd, 0xd5, 0x2c, 0xcc, 0x97, 0x40,
+	0xda, 0x47, 0x20, 0xd7, 0x24, 0x5e, 0x96, 0xcb, 0x71, 0xf9, 0x74, 0x35, 0x7b, 0xb4, 0x57, 0x98,
+	0x61, 0x4d, 0x54, 0x74, 0xdd, 0x85, 0x18, 0x3f, 0x27, 0xae, 0x69, 0x1b, 0x6a, 0x07, 0xca, 0xdf,
+	0x05, 0x29, 0x27, 0xa8, 0x90, 0x4d, 0xe6, 0xb8, 0xfc, 0xf8, 0xea, 0xbc, 0x1c, 0xe2, 0x84, 0x4c,
+	0x49, 0xaa, 0xe9, 0xfd, 0x5f, 0xd7, 0x12, 0x5f, 0x4f, 0x77, 0x17, 0x39, 0x95, 0x9d, 0x2a, 0x3f,
+	0x3a, 0xda, 0x2b, 0x4c, 0xd2, 0x23, 0x05, 0xac, 0x6f, 0xe6, 0x96, 0xe5, 0xdb, 0x77, 0xde, 0x9d,
+	0xee, 0x2e, 0x76, 0x28, 0x3e, 0x9c, 0xee, 0x2e, 0x2e, 0x74, 0x10, 0xca, 0x0e, 0xd5, 0xd5, 0x27,
+	0x40, 0x92, 0xc1, 0x5c, 0x5f, 0x48, 0x85, 0xd8, 0x41, 0x36, 0x86, 0xe5, 0x4c, 0x08, 0x87, 0xf4,
+	0x9d, 0x03, 0x53, 0x67, 0x07, 0x6a, 0xd8, 0x78, 0x08, 0xe1, 0xbf, 0xbb, 0x50, 0x01, 0x17, 0x2d,
+	0x6c, 0xd4, 0xd7, 0x21, 0xf4, 0x7d, 0x18, 0x89, 0xf4, 0x81, 0xf2, 0x74, 0xfb, 0x30, 0x66, 0x51,
+	0xea, 0xf2, 0xe3, 0x81, 0x26, 0x8b, 0xa5, 0x41, 0x23, 0xa4, 0x18, 0x23, 0x98, 0x08, 0x49, 0x01,
+	0xd9, 0xfe, 0x58, 0x8c, 0x15, 0xc5, 0x92, 0xf4, 0x93, 0xce, 0x83, 0x8a, 0x88, 0xef, 0x5d, 0xab,
+	0xf1, 0x04, 0x7a, 0xfc, 0x2a, 0x18, 0xd3, 0xa8, 0xda, 0x73, 0x7d, 0x68, 0x03, 0xf9, 0xa7, 0x60,
+	0xdc, 0x86, 0xdb, 0x75, 0xa7, 0xd5, 0xa8, 0x6f, 0x42, 0x8f, 0x0d, 0xc4, 0x8c, 0x4c, 0xe7, 0x5d,
+	0x6e, 0xcf, 0xbb, 0x5c, 0xb1, 0xbd, 0x6a, 0xf6, 0xa0, 0x53, 0xad, 0xe9, 0x7a, 0x0e, 0x41, 0x32,
+	0x25, 0x56, 0xd3, 0x36, 0xdc, 0xa6, 0x8f, 0xe5, 0x07, 0x11, 0x96, 0xb4, 0x09, 0xa3, 0x27, 0xa3,
+	0x5b, 0x0a, 0x9b, 0x8c, 0xee, 0x50, 0xbc, 0x1d, 0xdf, 0x38, 0x90, 0xa9, 0x61, 0xa3, 0xa2, 0xeb,
+	0x95, 0x16, 0xd9, 0x80, 0x36, 0x31, 0x9b, 0x1a, 0x41, 0x2e, 0xbf, 0x0c, 0x52, 0x18, 0xda, 0x3a,
+	0x74, 0xcf, 0x75, 0x84, 0xe1, 0xf8, 0x02, 0xe0, 0xb5, 0xee, 0x12, 0x75, 0xe2, 0x39, 0x30, 0xf0,
+	0x25, 0xad, 0x4e, 0xf7, 0x64, 0x5e, 0x78, 0x0e, 0xe4, 0x67, 0x41, 0xaa, 0x89, 0xec, 0x75, 0xd3,
+	0xc8, 0x8e, 0xe4, 0xb8, 0xfc, 0x84, 0xca, 0xde, 0xca, 0x95, 0x08, 0x1f, 0x18, 0x8d, 0x6f, 0x83,
+	0xd8, 0x65, 0x43, 0x48, 0xef, 0x52, 0x15, 0xcc, 0x87, 0x84, 0xdb, 0x3e, 0xf0, 0x97, 0x41, 0xd2,
+	0xd4, 0x03, 0x59, 0x17, 0xd4, 0xa4, 0xa9, 0x87, 0xfb, 0xf2, 0x85, 0x03, 0xb3, 0xbe, 0x91, 0xd0,
+	0x42, 0x5b, 0xf0, 0x7f, 0xad, 0xa1, 0x8c, 0xc9, 0x33, 0xc6, 0xfb, 0xe7, 0x6b, 0x5c, 0xe8, 0xd5,
+	0x18, 0xd2, 0x87, 0x54, 0x04, 0x62, 0x78, 0x26, 0xf6, 0x13, 0x5f, 0xfd, 0x34, 0x0a, 0x46, 0x6a,
+	0xd8, 0xe0, 0xb7, 0xc1, 0x44, 0xcf, 0x52, 0xbc, 0x1e, 0x75, 0x89, 0xbb, 0x51, 0xc2, 0xd2, 0x30,
+	0xa8, 0x76, 0x03, 0x52, 0xe6, 0x60, 0x70, 0x19, 0xf1, 0x6f, 0xc0, 0xa5, 0xde, 0x45, 0x74, 0x23,
+	0xbe, 0x26, 0x83, 0x09, 0x85, 0xa1, 0x60, 0x31, 0xdc, 0xc5, 0x92, 0x2f, 0xba, 0xe7, 0xe6, 0x47,
+	0x8a, 0xee, 0x46, 0x09, 0x4b, 0xc3, 0xa0, 0xe2, 0x89, 0xdf, 0x73, 0x60, 0x6a, 0xe0, 0x92, 0xe5,
+	0xa3, 0xea, 0xf6, 0x23, 0x85, 0xe5, 0x61, 0x91, 0xf1, 0x5d, 0x7c, 0xe4, 0x40, 0x26, 0x6c, 0xa4,
+	0x6f, 0x45, 0x0a, 0x1c, 0x04, 0x0b, 0x6b, 0x7f, 0x01, 0x8e, 0x6d, 0x47, 0x18, 0x7d, 0xeb, 0x7f,
+	0x2d, 0x54, 0xef, 0xed, 0x1f, 0x8b, 0xdc, 0xe1, 0xb1, 0xc8, 0xfd, 0x3e, 0x16, 0xb9, 0xcf, 0x27,
+	0x62, 0xe2, 0xf0, 0x44, 0x4c, 0xfc, 0x38, 0x11, 0x13, 0xaf, 0x6e, 0x1a, 0x26, 0xd9, 0x68, 0x35,
+	0xe4, 0x26, 0xb2, 0xd8, 0x2f, 0x04, 0x65, 0x70, 0x05, 0xfa, 0x7b, 0x06, 0x37, 0x52, 0xc1, 0xca,
+	0x5d, 0xfb, 0x33, 0x00, 0xf3, 0xcb, 0x93, 0xe9, 0xbb, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -175,6 +601,19 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateMsgFees defines a (governance) operation for replacing the per message
+	// type gas multipliers and minimum fees. The authority defaults to the x/gov
+	// module account.
+	UpdateMsgFees(ctx context.Context, in *MsgUpdateMsgFees, opts ...grpc.CallOption) (*MsgUpdateMsgFeesResponse, error)
+	// RotatePubKey defines an operation for replacing the public key of an
+	// account, keeping its address, e.g. when its key is compromised.
+	RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error)
+	// AddAuthenticator defines an operation for registering an authenticator for
+	// an account.
+	AddAuthenticator(ctx context.Context, in *MsgAddAuthenticator, opts ...grpc.CallOption) (*MsgAddAuthenticatorResponse, error)
+	// RemoveAuthenticator defines an operation for removing an authenticator of
+	// an account.
+	RemoveAuthenticator(ctx context.Context, in *MsgRemoveAuthenticator, opts ...grpc.CallOption) (*MsgRemoveAuthenticatorResponse, error)
 }
 
 type msgClient struct {
@@ -194,11 +633,60 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateMsgFees(ctx context.Context, in *MsgUpdateMsgFees, opts ...grpc.CallOption) (*MsgUpdateMsgFeesResponse, error) {
+	out := new(MsgUpdateMsgFeesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/UpdateMsgFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error) {
+	out := new(MsgRotatePubKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/RotatePubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAuthenticator(ctx context.Context, in *MsgAddAuthenticator, opts ...grpc.CallOption) (*MsgAddAuthenticatorResponse, error) {
+	out := new(MsgAddAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/AddAuthenticator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAuthenticator(ctx context.Context, in *MsgRemoveAuthenticator, opts ...grpc.CallOption) (*MsgRemoveAuthenticatorResponse, error) {
+	out := new(MsgRemoveAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/RemoveAuthenticator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateMsgFees defines a (governance) operation for replacing the per message
+	// type gas multipliers and minimum fees. The authority defaults to the x/gov
+	// module account.
+	UpdateMsgFees(context.Context, *MsgUpdateMsgFees) (*MsgUpdateMsgFeesResponse, error)
+	// RotatePubKey defines an operation for replacing the public key of an
+	// account, keeping its address, e.g. when its key is compromised.
+	RotatePubKey(context.Context, *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error)
+	// AddAuthenticator defines an operation for registering an authenticator for
+	// an account.
+	AddAuthenticator(context.Context, *MsgAddAuthenticator) (*MsgAddAuthenticatorResponse, error)
+	// RemoveAuthenticator defines an operation for removing an authenticator of
+	// an account.
+	RemoveAuthenticator(context.Context, *MsgRemoveAuthenticator) (*MsgRemoveAuthenticatorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -208,6 +696,18 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateMsgFees(ctx context.Context, req *MsgUpdateMsgFees) (*MsgUpdateMsgFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMsgFees not implemented")
+}
+func (*UnimplementedMsgServer) RotatePubKey(ctx context.Context, req *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotatePubKey not implemented")
+}
+func (*UnimplementedMsgServer) AddAuthenticator(ctx context.Context, req *MsgAddAuthenticator) (*MsgAddAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuthenticator not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAuthenticator(ctx context.Context, req *MsgRemoveAuthenticator) (*MsgRemoveAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAuthenticator not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -231,15 +731,103 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.auth.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
+func _Msg_UpdateMsgFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMsgFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMsgFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/UpdateMsgFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMsgFees(ctx, req.(*MsgUpdateMsgFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotatePubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotatePubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotatePubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/RotatePubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotatePubKey(ctx, req.(*MsgRotatePubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/AddAuthenticator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAuthenticator(ctx, req.(*MsgAddAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/RemoveAuthenticator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAuthenticator(ctx, req.(*MsgRemoveAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.auth.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "UpdateMsgFees",
+			Handler:    _Msg_UpdateMsgFees_Handler,
+		},
+		{
+			MethodName: "RotatePubKey",
+			Handler:    _Msg_RotatePubKey_Handler,
+		},
+		{
+			MethodName: "AddAuthenticator",
+			Handler:    _Msg_AddAuthenticator_Handler,
+		},
+		{
+			MethodName: "RemoveAuthenticator",
+			Handler:    _Msg_RemoveAuthenticator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
@@ -308,48 +896,921 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateMsgFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgUpdateMsgFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMsgFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMsgFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMsgFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMsgFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotatePubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotatePubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotatePubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPubKey != nil {
+		{
+			size, err := m.NewPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotatePubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotatePubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotatePubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AuthenticatorType) > 0 {
+		i -= len(m.AuthenticatorType)
+		copy(dAtA[i:], m.AuthenticatorType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuthenticatorType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAuthenticatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAuthenticatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAuthenticatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAuthenticatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMsgFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateMsgFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotatePubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewPubKey != nil {
+		l = m.NewPubKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotatePubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuthenticatorType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAuthenticatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRemoveAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRemoveAuthenticatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMsgFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMsgFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMsgFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, MsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMsgFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMsgFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMsgFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotatePubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotatePubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotatePubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubKey == nil {
+				m.NewPubKey = &any.Any{}
+			}
+			if err := m.NewPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgRotatePubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotatePubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotatePubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAuthenticator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -372,15 +1833,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAuthenticator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -408,13 +1869,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -424,25 +1885,228 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AuthenticatorType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAuthenticatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAuthenticatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAuthenticatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -464,7 +2128,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAuthenticatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -487,10 +2151,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAuthenticatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAuthenticatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -29,6 +29,8 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagFeeBudget         = "fee-budget"
+	FlagDuration          = "duration"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -49,6 +51,7 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 		NewCmdGrantAuthorization(ac),
 		NewCmdRevokeAuthorization(ac),
 		NewCmdExecAuthorization(),
+		NewCmdGrantSessionKey(ac),
 	)
 
 	return AuthorizationTxCmd
@@ -301,6 +304,60 @@ Example:
 	return cmd
 }
 
+// NewCmdGrantSessionKey returns a CLI command handler for creating a MsgGrantSessionKey transaction.
+func NewCmdGrantSessionKey(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-session-key [session-key] [msg-type-url]... --from [granter]",
+		Short: "Grant a short-lived session key the right to sign messages as the granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`grant a short-lived session key the right to sign transactions of the given
+message types as the granter, without wrapping them in a MsgExec, paying their fees
+within the given fee budget until the session key expires:
+
+Example:
+ $ %s tx %s grant-session-key cosmos1skj.. %s --fee-budget=1000stake --duration=1h --from=cosmos1skl..
+			`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sessionKey, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			budget, err := cmd.Flags().GetString(FlagFeeBudget)
+			if err != nil {
+				return err
+			}
+
+			feeBudget, err := sdk.ParseCoinsNormalized(budget)
+			if err != nil {
+				return err
+			}
+
+			duration, err := cmd.Flags().GetDuration(FlagDuration)
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgGrantSessionKey(clientCtx.GetFromAddress(), sessionKey, args[1:], feeBudget, time.Now().Add(duration))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagFeeBudget, "", "The maximum amount of fees the granter pays for the transactions signed by the session key")
+	cmd.Flags().Duration(FlagDuration, time.Hour, fmt.Sprintf("The duration of the session key, at most %s", authz.MaxSessionKeyDuration))
+
+	return cmd
+}
+
 // bech32toValAddresses returns []ValAddress from a list of Bech32 string addresses.
 func bech32toValAddresses(validators []string) ([]sdk.ValAddress, error) {
 	vals := make([]sdk.ValAddress, len(validators))
@@ -36,6 +36,8 @@ type Keeper struct {
 	router       baseapp.MessageRouter
 	authKeeper   authz.AccountKeeper
 	bankKeeper   authz.BankKeeper
+
+	feegrantKeeper authz.FeegrantKeeper
 }
 
 // NewKeeper constructs a message authorization Keeper
@@ -55,6 +57,13 @@ func (k Keeper) SetBankKeeper(bk authz.BankKeeper) Keeper {
 	return k
 }
 
+// SetFeegrantKeeper sets the feegrant keeper granting the fee budget of the
+// session keys. Session keys can only be granted a fee budget when it is set.
+func (k Keeper) SetFeegrantKeeper(fk authz.FeegrantKeeper) Keeper {
+	k.feegrantKeeper = fk
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx context.Context) log.Logger {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -188,13 +197,17 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
 // with the provided expiration time and insert authorization key into the grants queue. If there is an existing authorization grant for the
-// same `sdk.Msg` type, this grant overwrites that.
+// same `sdk.Msg` type, this grant overwrites that, and the grantee is no longer a session key for that `sdk.Msg` type.
 func (k Keeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	msgType := authorization.MsgTypeURL()
 	store := k.storeService.OpenKVStore(ctx)
 	skey := grantStoreKey(grantee, granter, msgType)
 
+	if err := store.Delete(sessionKeyStoreKey(grantee, granter, msgType)); err != nil {
+		return err
+	}
+
 	grant, err := authz.NewGrant(sdkCtx.BlockTime(), authorization, expiration)
 	if err != nil {
 		return err
@@ -257,6 +270,11 @@ func (k Keeper) DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress
 		return err
 	}
 
+	err = store.Delete(sessionKeyStoreKey(grantee, granter, msgType))
+	if err != nil {
+		return err
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	return sdkCtx.EventManager().EmitTypedEvent(&authz.EventRevoke{
 		MsgTypeUrl: msgType,
@@ -310,6 +328,31 @@ func (k Keeper) GetAuthorization(ctx context.Context, grantee, granter sdk.AccAd
 	return auth, grant.Expiration
 }
 
+// saveSessionKeyGrant grants a generic authorization for the provided message
+// type to the session key on the granter's account, with the provided
+// expiration time, and marks the grant as a session key.
+func (k Keeper) saveSessionKeyGrant(ctx context.Context, sessionKey, granter sdk.AccAddress, msgType string, expiration time.Time) error {
+	if err := k.SaveGrant(ctx, sessionKey, granter, authz.NewGenericAuthorization(msgType), &expiration); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(sessionKeyStoreKey(sessionKey, granter, msgType), []byte{})
+}
+
+// IsSessionKey returns true if the session key is granted the provided message
+// type on the granter's account through MsgGrantSessionKey, and the grant has
+// not expired.
+func (k Keeper) IsSessionKey(ctx context.Context, sessionKey, granter sdk.AccAddress, msgType string) (bool, error) {
+	authorization, _ := k.GetAuthorization(ctx, sessionKey, granter, msgType)
+	if _, ok := authorization.(*authz.GenericAuthorization); !ok {
+		return false, nil
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Has(sessionKeyStoreKey(sessionKey, granter, msgType))
+}
+
 // IterateGrants iterates over all authorization grants
 // This function should be used with caution because it can involve significant IO operations.
 // It should not be used in query or msg services without charging additional gas.
@@ -450,6 +493,11 @@ func (k Keeper) DequeueAndDeleteExpiredGrants(ctx context.Context, limit int32)
 			if err != nil {
 				return err
 			}
+
+			err = store.Delete(sessionKeyStoreKey(grantee, granter, typeURL))
+			if err != nil {
+				return err
+			}
 		}
 
 		count++
@@ -15,9 +15,11 @@ import (
 //
 // - 0x01<grant_Bytes>: Grant
 // - 0x02<grant_expiration_Bytes>: GrantQueueItem
+// - 0x03<grant_Bytes>: nothing, marks the grant as a session key
 var (
 	GrantKey         = []byte{0x01} // prefix for each key
 	GrantQueuePrefix = []byte{0x02}
+	SessionKeyPrefix = []byte{0x03}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -38,6 +40,18 @@ func grantStoreKey(grantee, granter sdk.AccAddress, msgType string) []byte {
 	return key
 }
 
+// sessionKeyStoreKey - return session key store key
+// Items are stored with the following key: values
+//
+// - 0x03<granterAddressLen (1 Byte)><granterAddress_Bytes><sessionKeyAddressLen (1 Byte)><sessionKeyAddress_Bytes><msgType_Bytes>: nothing
+func sessionKeyStoreKey(sessionKey, granter sdk.AccAddress, msgType string) []byte {
+	m := conv.UnsafeStrToBytes(msgType)
+	granter = address.MustLengthPrefix(granter)
+	sessionKey = address.MustLengthPrefix(sessionKey)
+
+	return sdk.AppendLengthPrefixedBytes(SessionKeyPrefix, granter, sessionKey, m)
+}
+
 // parseGrantStoreKey - split granter, grantee address and msg type from the authorization key
 func parseGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress, msgType string) {
 	// key is of format:
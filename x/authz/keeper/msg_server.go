@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
 var _ authz.MsgServer = Keeper{}
@@ -125,6 +126,76 @@ func (k Keeper) Exec(goCtx context.Context, msg *authz.MsgExec) (*authz.MsgExecR
 	return &authz.MsgExecResponse{Results: results}, nil
 }
 
+// GrantSessionKey implements the MsgServer.GrantSessionKey method.
+func (k Keeper) GrantSessionKey(goCtx context.Context, msg *authz.MsgGrantSessionKey) (*authz.MsgGrantSessionKeyResponse, error) {
+	if strings.EqualFold(msg.SessionKey, msg.Granter) {
+		return nil, authz.ErrGranteeIsGranter
+	}
+
+	sessionKey, err := k.authKeeper.AddressCodec().StringToBytes(msg.SessionKey)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid session key address: %s", err)
+	}
+
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	if len(msg.MsgTypeUrls) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg type urls cannot be empty")
+	}
+
+	for _, t := range msg.MsgTypeUrls {
+		if k.router.HandlerByTypeURL(t) == nil {
+			return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist.", t)
+		}
+	}
+
+	if err := msg.FeeBudget.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid fee budget: %s", err)
+	}
+
+	if !msg.FeeBudget.IsZero() && k.feegrantKeeper == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("session keys cannot be granted a fee budget")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !msg.Expiration.After(ctx.BlockTime()) {
+		return nil, authz.ErrInvalidExpirationTime
+	}
+
+	if maxExpiration := ctx.BlockTime().Add(authz.MaxSessionKeyDuration); msg.Expiration.After(maxExpiration) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("session key expiration cannot be after %s", maxExpiration)
+	}
+
+	// create the account if it is not in account state
+	sessionKeyAcc := k.authKeeper.GetAccount(ctx, sessionKey)
+	if sessionKeyAcc == nil {
+		if k.bankKeeper.BlockedAddr(sessionKey) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", sessionKey)
+		}
+
+		sessionKeyAcc = k.authKeeper.NewAccountWithAddress(ctx, sessionKey)
+		k.authKeeper.SetAccount(ctx, sessionKeyAcc)
+	}
+
+	for _, t := range msg.MsgTypeUrls {
+		if err := k.saveSessionKeyGrant(ctx, sessionKey, granter, t, msg.Expiration); err != nil {
+			return nil, err
+		}
+	}
+
+	if !msg.FeeBudget.IsZero() {
+		allowance := &feegrant.BasicAllowance{SpendLimit: msg.FeeBudget, Expiration: &msg.Expiration}
+		if err := k.feegrantKeeper.GrantAllowance(ctx, granter, sessionKey, allowance); err != nil {
+			return nil, err
+		}
+	}
+
+	return &authz.MsgGrantSessionKeyResponse{}, nil
+}
+
 func validateMsgs(msgs []sdk.Msg) error {
 	for i, msg := range msgs {
 		m, ok := msg.(sdk.HasValidateBasic)
@@ -12,7 +12,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	authztestutil "github.com/cosmos/cosmos-sdk/x/authz/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
 func (suite *TestSuite) createAccounts(accs int) []sdk.AccAddress {
@@ -368,3 +370,118 @@ func (suite *TestSuite) TestExec() {
 		})
 	}
 }
+
+func (suite *TestSuite) TestGrantSessionKey() {
+	ctx := suite.ctx.WithBlockTime(time.Now())
+	addrs := suite.createAccounts(2)
+	sessionKey, granter := addrs[0], addrs[1]
+
+	oneHour := ctx.BlockTime().Add(time.Hour)
+	sendMsgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	feeBudget := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	feegrantKeeper := authztestutil.NewMockFeegrantKeeper(gomock.NewController(suite.T()))
+	msgSrvr := suite.authzKeeper.SetFeegrantKeeper(feegrantKeeper)
+
+	testCases := []struct {
+		name   string
+		msg    *authz.MsgGrantSessionKey
+		keeper authz.MsgServer
+		errMsg string
+	}{
+		{
+			name:   "identical session key and granter",
+			msg:    authz.NewMsgGrantSessionKey(granter, granter, []string{sendMsgType}, feeBudget, oneHour),
+			errMsg: "grantee and granter should be different",
+		},
+		{
+			name:   "no msg type urls",
+			msg:    authz.NewMsgGrantSessionKey(granter, sessionKey, nil, feeBudget, oneHour),
+			errMsg: "msg type urls cannot be empty",
+		},
+		{
+			name:   "unknown msg type url",
+			msg:    authz.NewMsgGrantSessionKey(granter, sessionKey, []string{"/cosmos.unknown.MsgUnknown"}, feeBudget, oneHour),
+			errMsg: "/cosmos.unknown.MsgUnknown doesn't exist",
+		},
+		{
+			name:   "past expiration",
+			msg:    authz.NewMsgGrantSessionKey(granter, sessionKey, []string{sendMsgType}, feeBudget, ctx.BlockTime()),
+			errMsg: "expiration time of authorization should be more than current time",
+		},
+		{
+			name:   "expiration too far",
+			msg:    authz.NewMsgGrantSessionKey(granter, sessionKey, []string{sendMsgType}, feeBudget, ctx.BlockTime().Add(authz.MaxSessionKeyDuration+time.Second)),
+			errMsg: "session key expiration cannot be after",
+		},
+		{
+			name:   "fee budget without feegrant keeper",
+			msg:    authz.NewMsgGrantSessionKey(granter, sessionKey, []string{sendMsgType}, feeBudget, oneHour),
+			keeper: suite.authzKeeper,
+			errMsg: "session keys cannot be granted a fee budget",
+		},
+		{
+			name:   "no fee budget without feegrant keeper",
+			msg:    authz.NewMsgGrantSessionKey(granter, sessionKey, []string{sendMsgType}, nil, oneHour),
+			keeper: suite.authzKeeper,
+		},
+		{
+			name: "valid",
+			msg:  authz.NewMsgGrantSessionKey(granter, sessionKey, []string{sendMsgType, sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}, feeBudget, oneHour),
+		},
+	}
+
+	feegrantKeeper.EXPECT().GrantAllowance(gomock.Any(), granter, sessionKey, &feegrant.BasicAllowance{SpendLimit: feeBudget, Expiration: &oneHour}).Return(nil)
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			keeper := tc.keeper
+			if keeper == nil {
+				keeper = msgSrvr
+			}
+
+			_, err := keeper.GrantSessionKey(ctx, tc.msg)
+			if tc.errMsg != "" {
+				suite.Require().ErrorContains(err, tc.errMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			for _, msgType := range tc.msg.MsgTypeUrls {
+				authorization, expiration := suite.authzKeeper.GetAuthorization(ctx, sessionKey, granter, msgType)
+				suite.Require().Equal(authz.NewGenericAuthorization(msgType), authorization)
+				suite.Require().Equal(oneHour, *expiration)
+
+				isSessionKey, err := suite.authzKeeper.IsSessionKey(ctx, sessionKey, granter, msgType)
+				suite.Require().NoError(err)
+				suite.Require().True(isSessionKey)
+			}
+		})
+	}
+
+	// the grants expire with the session key
+	authorization, _ := suite.authzKeeper.GetAuthorization(ctx.WithBlockTime(oneHour.Add(time.Second)), sessionKey, granter, sendMsgType)
+	suite.Require().Nil(authorization)
+	isSessionKey, err := suite.authzKeeper.IsSessionKey(ctx.WithBlockTime(oneHour.Add(time.Second)), sessionKey, granter, sendMsgType)
+	suite.Require().NoError(err)
+	suite.Require().False(isSessionKey)
+
+	// a generic authorization granted through MsgGrant is not a session key,
+	// and overwrites the session key grant
+	grant, err := authz.NewGrant(ctx.BlockTime(), authz.NewGenericAuthorization(sendMsgType), &oneHour)
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.Grant(ctx, &authz.MsgGrant{Granter: granter.String(), Grantee: sessionKey.String(), Grant: grant})
+	suite.Require().NoError(err)
+	isSessionKey, err = suite.authzKeeper.IsSessionKey(ctx, sessionKey, granter, sendMsgType)
+	suite.Require().NoError(err)
+	suite.Require().False(isSessionKey)
+
+	// revoking a session key grant revokes the session key
+	multiSendMsgType := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	revoke := authz.NewMsgRevoke(granter, sessionKey, multiSendMsgType)
+	_, err = suite.msgSrvr.Revoke(ctx, &revoke)
+	suite.Require().NoError(err)
+	isSessionKey, err = suite.authzKeeper.IsSessionKey(ctx, sessionKey, granter, multiSendMsgType)
+	suite.Require().NoError(err)
+	suite.Require().False(isSessionKey)
+}
@@ -0,0 +1,24 @@
+package authz
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSessionKeyDuration is the maximum duration of a session key, from the
+// time it is granted to its expiration.
+const MaxSessionKeyDuration = 24 * time.Hour
+
+var _ sdk.Msg = &MsgGrantSessionKey{}
+
+// NewMsgGrantSessionKey creates a new MsgGrantSessionKey
+func NewMsgGrantSessionKey(granter, sessionKey sdk.AccAddress, msgTypeURLs []string, feeBudget sdk.Coins, expiration time.Time) *MsgGrantSessionKey {
+	return &MsgGrantSessionKey{
+		Granter:     granter.String(),
+		SessionKey:  sessionKey.String(),
+		MsgTypeUrls: msgTypeURLs,
+		FeeBudget:   feeBudget,
+		Expiration:  expiration,
+	}
+}
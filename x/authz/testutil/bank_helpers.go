@@ -23,3 +23,55 @@ func (k MockBankKeeper) UpdateParams(goCtx context.Context, req *bank.MsgUpdateP
 func (k MockBankKeeper) SetSendEnabled(goCtx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) CreateDenom(goCtx context.Context, msg *bank.MsgCreateDenom) (*bank.MsgCreateDenomResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) Mint(goCtx context.Context, msg *bank.MsgMint) (*bank.MsgMintResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) Burn(goCtx context.Context, msg *bank.MsgBurn) (*bank.MsgBurnResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ChangeAdmin(goCtx context.Context, msg *bank.MsgChangeAdmin) (*bank.MsgChangeAdminResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDenomMetadata(goCtx context.Context, msg *bank.MsgSetDenomMetadata) (*bank.MsgSetDenomMetadataResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDenomSendHook(goCtx context.Context, msg *bank.MsgSetDenomSendHook) (*bank.MsgSetDenomSendHookResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDenomCompliance(goCtx context.Context, msg *bank.MsgSetDenomCompliance) (*bank.MsgSetDenomComplianceResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) Freeze(goCtx context.Context, msg *bank.MsgFreeze) (*bank.MsgFreezeResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) Unfreeze(goCtx context.Context, msg *bank.MsgUnfreeze) (*bank.MsgUnfreezeResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) Clawback(goCtx context.Context, msg *bank.MsgClawback) (*bank.MsgClawbackResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetAllowlisted(goCtx context.Context, msg *bank.MsgSetAllowlisted) (*bank.MsgSetAllowlistedResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ScheduleSend(goCtx context.Context, msg *bank.MsgScheduleSend) (*bank.MsgScheduleSendResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CancelScheduledSend(goCtx context.Context, msg *bank.MsgCancelScheduledSend) (*bank.MsgCancelScheduledSendResponse, error) {
+	return nil, nil
+}
@@ -15,6 +15,7 @@ import (
 
 	address "cosmossdk.io/core/address"
 	types "github.com/cosmos/cosmos-sdk/types"
+	feegrant "github.com/cosmos/cosmos-sdk/x/feegrant"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -166,3 +167,41 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
+
+// MockFeegrantKeeper is a mock of FeegrantKeeper interface.
+type MockFeegrantKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeegrantKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockFeegrantKeeperMockRecorder is the mock recorder for MockFeegrantKeeper.
+type MockFeegrantKeeperMockRecorder struct {
+	mock *MockFeegrantKeeper
+}
+
+// NewMockFeegrantKeeper creates a new mock instance.
+func NewMockFeegrantKeeper(ctrl *gomock.Controller) *MockFeegrantKeeper {
+	mock := &MockFeegrantKeeper{ctrl: ctrl}
+	mock.recorder = &MockFeegrantKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeegrantKeeper) EXPECT() *MockFeegrantKeeperMockRecorder {
+	return m.recorder
+}
+
+// GrantAllowance mocks base method.
+func (m *MockFeegrantKeeper) GrantAllowance(ctx context.Context, granter, grantee types.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantAllowance", ctx, granter, grantee, feeAllowance)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantAllowance indicates an expected call of GrantAllowance.
+func (mr *MockFeegrantKeeperMockRecorder) GrantAllowance(ctx, granter, grantee, feeAllowance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantAllowance", reflect.TypeOf((*MockFeegrantKeeper)(nil).GrantAllowance), ctx, granter, grantee, feeAllowance)
+}
@@ -7,24 +7,30 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -270,6 +276,92 @@ func (m *MsgRevokeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeResponse proto.InternalMessageInfo
 
+// MsgGrantSessionKey grants a short-lived session key the right to sign
+// transactions of the given message types as the granter, paying their fees
+// within the given budget, until the given expiration.
+type MsgGrantSessionKey struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// session_key is the address of the session key.
+	SessionKey string `protobuf:"bytes,2,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
+	// msg_type_urls are the type URLs of the messages the session key can sign.
+	MsgTypeUrls []string `protobuf:"bytes,3,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// fee_budget is the maximum amount of fees the granter pays for the
+	// transactions signed by the session key.
+	FeeBudget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fee_budget,json=feeBudget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_budget"`
+	// expiration is the time at which the session key expires.
+	Expiration time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *MsgGrantSessionKey) Reset()         { *m = MsgGrantSessionKey{} }
+func (m *MsgGrantSessionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantSessionKey) ProtoMessage()    {}
+func (*MsgGrantSessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{6}
+}
+func (m *MsgGrantSessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantSessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantSessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantSessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantSessionKey.Merge(m, src)
+}
+func (m *MsgGrantSessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantSessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantSessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantSessionKey proto.InternalMessageInfo
+
+// MsgGrantSessionKeyResponse defines the Msg/GrantSessionKey response type.
+type MsgGrantSessionKeyResponse struct {
+}
+
+func (m *MsgGrantSessionKeyResponse) Reset()         { *m = MsgGrantSessionKeyResponse{} }
+func (m *MsgGrantSessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantSessionKeyResponse) ProtoMessage()    {}
+func (*MsgGrantSessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{7}
+}
+func (m *MsgGrantSessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantSessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantSessionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantSessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantSessionKeyResponse.Merge(m, src)
+}
+func (m *MsgGrantSessionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantSessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantSessionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantSessionKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
@@ -277,47 +369,62 @@ func init() {
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.authz.v1beta1.MsgExecResponse")
 	proto.RegisterType((*MsgRevoke)(nil), "cosmos.authz.v1beta1.MsgRevoke")
 	proto.RegisterType((*MsgRevokeResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeResponse")
+	proto.RegisterType((*MsgGrantSessionKey)(nil), "cosmos.authz.v1beta1.MsgGrantSessionKey")
+	proto.RegisterType((*MsgGrantSessionKeyResponse)(nil), "cosmos.authz.v1beta1.MsgGrantSessionKeyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6e, 0x13, 0x4f,
-	0x10, 0xf6, 0xc6, 0x71, 0xfc, 0xf3, 0x26, 0xd2, 0x8f, 0x5c, 0x2c, 0x71, 0xb9, 0x28, 0x97, 0xd3,
-	0x91, 0x80, 0x65, 0xe4, 0x5d, 0xd9, 0x74, 0x16, 0x4d, 0x2c, 0x45, 0x34, 0x58, 0x48, 0x07, 0x34,
-	0x34, 0xd6, 0xd9, 0x5e, 0x36, 0x56, 0x7c, 0xb7, 0xd6, 0xed, 0xd9, 0xb2, 0xa9, 0x10, 0x25, 0x15,
-	0x8f, 0x01, 0x9d, 0x8b, 0x94, 0x3c, 0x80, 0x45, 0x15, 0x51, 0x20, 0x2a, 0x04, 0x76, 0xe1, 0xc7,
-	0x00, 0xdd, 0xfe, 0x31, 0x0e, 0x72, 0x42, 0x2a, 0x9a, 0xbb, 0x99, 0xf9, 0xbe, 0xd9, 0x9d, 0x6f,
-	0x66, 0xb4, 0x70, 0xbf, 0xc5, 0x78, 0xc0, 0x38, 0xf6, 0xfb, 0xf1, 0xe9, 0x2b, 0x3c, 0x28, 0x37,
-	0x49, 0xec, 0x97, 0x71, 0x3c, 0x44, 0xbd, 0x88, 0xc5, 0xcc, 0xc8, 0x4b, 0x18, 0x09, 0x18, 0x29,
-	0xd8, 0xda, 0x95, 0xd1, 0x86, 0xe0, 0x60, 0x45, 0x11, 0x8e, 0x95, 0xa7, 0x8c, 0x32, 0x19, 0x4f,
-	0x2c, 0x15, 0xdd, 0xa5, 0x8c, 0xd1, 0x2e, 0xc1, 0xc2, 0x6b, 0xf6, 0x5f, 0x62, 0x3f, 0x1c, 0x29,
-	0xc8, 0x59, 0x59, 0x80, 0xbc, 0x4f, 0x32, 0x6e, 0x2b, 0x46, 0xc0, 0x29, 0x1e, 0x94, 0x93, 0x9f,
-	0x02, 0xb6, 0xfd, 0xa0, 0x13, 0x32, 0x2c, 0xbe, 0x32, 0xe4, 0x7e, 0x01, 0xf0, 0xbf, 0x3a, 0xa7,
-	0x8f, 0x22, 0x3f, 0x8c, 0x8d, 0x0a, 0xcc, 0xd2, 0xc4, 0x20, 0x91, 0x09, 0x1c, 0x50, 0xc8, 0xd5,
-	0xcc, 0xcf, 0xe7, 0x25, 0xad, 0xe8, 0xb8, 0xdd, 0x8e, 0x08, 0xe7, 0x4f, 0xe3, 0xa8, 0x13, 0x52,
-	0x4f, 0x13, 0x7f, 0xe7, 0x10, 0x73, 0xed, 0x66, 0x39, 0xc4, 0x78, 0x08, 0x33, 0xc2, 0x34, 0xd3,
-	0x0e, 0x28, 0x6c, 0x56, 0xf6, 0xd0, 0xaa, 0xa6, 0x21, 0x51, 0x53, 0x2d, 0x37, 0xf9, 0x76, 0x90,
-	0x7a, 0x3f, 0x1f, 0x17, 0x81, 0x27, 0x93, 0xaa, 0x87, 0x6f, 0xe6, 0xe3, 0xa2, 0xbe, 0xff, 0xed,
-	0x7c, 0x5c, 0xdc, 0x91, 0xe9, 0x25, 0xde, 0x3e, 0xc3, 0x5a, 0x8b, 0x6b, 0xc0, 0x5b, 0xda, 0xf6,
-	0x08, 0xef, 0xb1, 0x90, 0x13, 0xf7, 0x03, 0x80, 0xd9, 0x3a, 0xa7, 0x27, 0x43, 0xd2, 0x5a, 0xae,
-	0x1b, 0xdc, 0xb4, 0xee, 0x13, 0xb8, 0x1e, 0x70, 0xca, 0xcd, 0x35, 0x27, 0x5d, 0xd8, 0xac, 0xe4,
-	0x91, 0x1c, 0x12, 0xd2, 0x43, 0x42, 0xc7, 0xe1, 0xa8, 0xb6, 0xf7, 0xe9, 0xbc, 0xa4, 0x06, 0x80,
-	0x9a, 0x3e, 0x27, 0x0b, 0x39, 0x75, 0x4e, 0x3d, 0x91, 0x5e, 0xbd, 0xb3, 0x24, 0x80, 0x24, 0x02,
-	0x8c, 0xcb, 0x02, 0x92, 0xfa, 0xdc, 0xfb, 0xf0, 0x7f, 0x65, 0xea, 0xf2, 0x0d, 0x13, 0x66, 0x23,
-	0xc2, 0xfb, 0xdd, 0x98, 0x9b, 0xc0, 0x49, 0x17, 0xb6, 0x3c, 0xed, 0xba, 0x1f, 0x01, 0xcc, 0x25,
-	0xe7, 0x93, 0x01, 0x3b, 0x23, 0xff, 0x6c, 0x8c, 0x0e, 0xdc, 0x0a, 0x38, 0x6d, 0xc4, 0xa3, 0x1e,
-	0x69, 0xf4, 0xa3, 0xae, 0x98, 0x66, 0xce, 0x83, 0x01, 0xa7, 0xcf, 0x46, 0x3d, 0xf2, 0x3c, 0xea,
-	0x56, 0x8f, 0xfe, 0x1c, 0x55, 0xfe, 0xb2, 0x52, 0x59, 0xb0, 0xbb, 0x03, 0xb7, 0x17, 0x8e, 0x56,
-	0x5b, 0xf9, 0x09, 0x60, 0xba, 0xce, 0xa9, 0xf1, 0x04, 0x66, 0xe4, 0x76, 0xda, 0xab, 0xd7, 0x44,
-	0x4f, 0xd9, 0xba, 0x7b, 0x3d, 0xbe, 0x68, 0xe3, 0x63, 0xb8, 0x2e, 0x36, 0x60, 0xff, 0x4a, 0x7e,
-	0x02, 0x5b, 0x47, 0xd7, 0xc2, 0x8b, 0xd3, 0x3c, 0xb8, 0xa1, 0xda, 0x7e, 0x70, 0x65, 0x82, 0x24,
-	0x58, 0xf7, 0xfe, 0x42, 0xd0, 0x67, 0x5a, 0x99, 0xd7, 0xc9, 0xbe, 0xd7, 0x6a, 0x93, 0x1f, 0x76,
-	0x6a, 0x32, 0xb5, 0xc1, 0xc5, 0xd4, 0x06, 0xdf, 0xa7, 0x36, 0x78, 0x37, 0xb3, 0x53, 0x17, 0x33,
-	0x3b, 0xf5, 0x75, 0x66, 0xa7, 0x5e, 0x1c, 0xd2, 0x4e, 0x7c, 0xda, 0x6f, 0xa2, 0x16, 0x0b, 0xd4,
-	0x8b, 0x82, 0x97, 0x9a, 0x3b, 0x94, 0x2f, 0x42, 0x73, 0x43, 0x2c, 0xe7, 0x83, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x13, 0x38, 0x6d, 0x34, 0xb7, 0x04, 0x00, 0x00,
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xbf, 0x4f, 0xdb, 0x4c,
+	0x18, 0x8e, 0x09, 0x81, 0x2f, 0x17, 0x10, 0x1f, 0x26, 0xd2, 0x67, 0xcc, 0x87, 0x13, 0xf9, 0x83,
+	0xaf, 0x51, 0x2a, 0xec, 0x92, 0x4e, 0x8d, 0xba, 0xe0, 0x8a, 0x56, 0x55, 0x1b, 0x55, 0x32, 0x74,
+	0xe9, 0x12, 0x39, 0xc9, 0x71, 0x58, 0xc4, 0xbe, 0xc8, 0xaf, 0x83, 0x92, 0x4e, 0xa8, 0x63, 0x27,
+	0xe6, 0xee, 0x95, 0xda, 0x4e, 0x19, 0x18, 0xfb, 0x07, 0xa0, 0x4e, 0xa8, 0x43, 0xd5, 0xa9, 0xb4,
+	0x30, 0x64, 0xeb, 0xdf, 0x50, 0xf9, 0xce, 0x4e, 0x42, 0x08, 0x01, 0x75, 0xe8, 0x92, 0xdc, 0xdd,
+	0xfb, 0xbc, 0x3f, 0x9e, 0x7b, 0xde, 0xf7, 0x8c, 0x96, 0xab, 0x14, 0x1c, 0x0a, 0xba, 0xd5, 0xf4,
+	0x77, 0x5f, 0xea, 0xfb, 0xeb, 0x15, 0xec, 0x5b, 0xeb, 0xba, 0xdf, 0xd2, 0x1a, 0x1e, 0xf5, 0xa9,
+	0x98, 0xe6, 0x66, 0x8d, 0x99, 0xb5, 0xd0, 0x2c, 0x2f, 0xf2, 0xd3, 0x32, 0xc3, 0xe8, 0x21, 0x84,
+	0x6d, 0xe4, 0x34, 0xa1, 0x84, 0xf2, 0xf3, 0x60, 0x15, 0x9e, 0x2e, 0x12, 0x4a, 0x49, 0x1d, 0xeb,
+	0x6c, 0x57, 0x69, 0xee, 0xe8, 0x96, 0xdb, 0x0e, 0x4d, 0x99, 0x61, 0x93, 0x6f, 0x3b, 0x18, 0x7c,
+	0xcb, 0x69, 0x84, 0x80, 0xec, 0xc8, 0x0a, 0x79, 0x41, 0x1c, 0xf1, 0x4f, 0x88, 0x70, 0x80, 0xe8,
+	0xfb, 0xeb, 0xc1, 0x5f, 0x68, 0x98, 0xb7, 0x1c, 0xdb, 0xa5, 0x3a, 0xfb, 0x0d, 0x8f, 0x94, 0x10,
+	0x5b, 0xb1, 0x00, 0xf7, 0x82, 0x55, 0xa9, 0xed, 0x72, 0xbb, 0xfa, 0x45, 0x40, 0x7f, 0x95, 0x80,
+	0x3c, 0xf2, 0x2c, 0xd7, 0x17, 0x0b, 0x68, 0x9a, 0x04, 0x0b, 0xec, 0x49, 0x42, 0x56, 0xc8, 0x25,
+	0x0d, 0xe9, 0xf3, 0xd1, 0x5a, 0x74, 0x25, 0x1b, 0xb5, 0x9a, 0x87, 0x01, 0xb6, 0x7c, 0xcf, 0x76,
+	0x89, 0x19, 0x01, 0xfb, 0x3e, 0x58, 0x9a, 0xb8, 0x99, 0x0f, 0x16, 0xef, 0xa3, 0x04, 0x5b, 0x4a,
+	0xf1, 0xac, 0x90, 0x4b, 0x15, 0x96, 0xb4, 0x51, 0xb7, 0xae, 0xb1, 0x9a, 0x8c, 0xe4, 0xf1, 0xb7,
+	0x4c, 0xec, 0x5d, 0xb7, 0x93, 0x17, 0x4c, 0xee, 0x54, 0x5c, 0x79, 0xd5, 0xed, 0xe4, 0xa3, 0xfc,
+	0xaf, 0xbb, 0x9d, 0xfc, 0x02, 0x77, 0x5f, 0x83, 0xda, 0x9e, 0x1e, 0x71, 0x51, 0x45, 0xf4, 0x77,
+	0xb4, 0x36, 0x31, 0x34, 0xa8, 0x0b, 0x58, 0x7d, 0x2f, 0xa0, 0xe9, 0x12, 0x90, 0xcd, 0x16, 0xae,
+	0x0e, 0xd6, 0x2d, 0xdc, 0xb4, 0xee, 0x4d, 0x34, 0xe9, 0x00, 0x01, 0x69, 0x22, 0x1b, 0xcf, 0xa5,
+	0x0a, 0x69, 0x8d, 0x4b, 0xa9, 0x45, 0x52, 0x6a, 0x1b, 0x6e, 0xdb, 0x58, 0xfa, 0x74, 0xb4, 0x16,
+	0x0a, 0xa4, 0x05, 0x97, 0xde, 0xa3, 0x53, 0x02, 0x62, 0x32, 0xf7, 0xe2, 0x7f, 0x03, 0x04, 0x70,
+	0x40, 0x40, 0xbc, 0x48, 0x20, 0xa8, 0x4f, 0xbd, 0x8d, 0xe6, 0xc2, 0x65, 0x54, 0xbe, 0x28, 0xa1,
+	0x69, 0x0f, 0x43, 0xb3, 0xee, 0x83, 0x24, 0x64, 0xe3, 0xb9, 0x19, 0x33, 0xda, 0xaa, 0x1f, 0x05,
+	0x94, 0x0c, 0xe2, 0xe3, 0x7d, 0xba, 0x87, 0xff, 0x98, 0x8c, 0x59, 0x34, 0xe3, 0x00, 0x29, 0xfb,
+	0xed, 0x06, 0x2e, 0x37, 0xbd, 0x3a, 0x53, 0x33, 0x69, 0x22, 0x07, 0xc8, 0x76, 0xbb, 0x81, 0x9f,
+	0x7b, 0xf5, 0xe2, 0xea, 0xb0, 0x54, 0xe9, 0x8b, 0x4c, 0x79, 0xc1, 0xea, 0x02, 0x9a, 0xef, 0x6d,
+	0x7a, 0x62, 0xbd, 0x8d, 0x23, 0x31, 0x52, 0x70, 0x0b, 0x03, 0xd8, 0xd4, 0x7d, 0x82, 0xdb, 0xbf,
+	0x45, 0xee, 0x1e, 0x4a, 0x01, 0x8f, 0x50, 0xde, 0xc3, 0xed, 0x6b, 0x09, 0x22, 0xe8, 0xa7, 0x53,
+	0xd1, 0xec, 0x20, 0x47, 0x90, 0xe2, 0xd9, 0x78, 0x2e, 0x69, 0xa6, 0xfa, 0x24, 0x41, 0x3c, 0x10,
+	0x10, 0xda, 0xc1, 0xb8, 0x5c, 0x69, 0xd6, 0x08, 0xf6, 0xa5, 0x49, 0xd6, 0x1d, 0x8b, 0xda, 0xa8,
+	0x26, 0x78, 0x40, 0x6d, 0xd7, 0x78, 0x18, 0xb4, 0xf4, 0x87, 0xd3, 0x4c, 0x8e, 0xd8, 0xfe, 0x6e,
+	0xb3, 0xa2, 0x55, 0xa9, 0x13, 0x3e, 0x2a, 0xfa, 0xc0, 0xf5, 0x04, 0x29, 0x81, 0x39, 0xc0, 0x9b,
+	0x6e, 0x27, 0x3f, 0x53, 0xc7, 0xc4, 0xaa, 0xb6, 0xcb, 0xc1, 0xec, 0x02, 0x9f, 0x87, 0xe4, 0x0e,
+	0xc6, 0x06, 0xcb, 0x29, 0x3e, 0x46, 0x08, 0xb7, 0x1a, 0xb6, 0x67, 0xf9, 0x36, 0x75, 0xa5, 0x04,
+	0x1b, 0x2b, 0xf9, 0x52, 0x7f, 0x6e, 0x47, 0x4f, 0x8d, 0x31, 0x1b, 0x94, 0x70, 0x78, 0x9a, 0x11,
+	0x78, 0xa4, 0x01, 0xe7, 0xa2, 0x36, 0xac, 0xd9, 0xf2, 0x88, 0xf1, 0xea, 0x0b, 0xa2, 0xfe, 0x8b,
+	0xe4, 0xcb, 0xa7, 0x91, 0x8a, 0x85, 0x9f, 0x13, 0x28, 0x5e, 0x02, 0x22, 0x3e, 0x43, 0x09, 0xfe,
+	0xc6, 0x28, 0xa3, 0x87, 0x3d, 0x0a, 0x21, 0xff, 0x3f, 0xde, 0xde, 0x1b, 0x86, 0xa7, 0x68, 0x92,
+	0xcd, 0xf1, 0xf2, 0x95, 0xf8, 0xc0, 0x2c, 0xaf, 0x8e, 0x35, 0xf7, 0xa2, 0x99, 0x68, 0x2a, 0x1c,
+	0x9e, 0xcc, 0x95, 0x0e, 0x1c, 0x20, 0xdf, 0xba, 0x06, 0xd0, 0x8b, 0xe9, 0xa0, 0xb9, 0xe1, 0xe6,
+	0xcd, 0x8d, 0x27, 0xd7, 0x47, 0xca, 0x77, 0x6e, 0x8a, 0x8c, 0xd2, 0xc9, 0x89, 0x83, 0x40, 0x4a,
+	0xc3, 0x38, 0xfe, 0xa1, 0xc4, 0x8e, 0xcf, 0x14, 0xe1, 0xe4, 0x4c, 0x11, 0xbe, 0x9f, 0x29, 0xc2,
+	0xe1, 0xb9, 0x12, 0x3b, 0x39, 0x57, 0x62, 0x5f, 0xcf, 0x95, 0xd8, 0x8b, 0x95, 0xb1, 0x2d, 0xd7,
+	0xe2, 0x9f, 0x99, 0xca, 0x14, 0xeb, 0x98, 0xbb, 0xbf, 0x06, 0x00, 0x6a, 0x7e, 0x63, 0x43, 0x2d,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -344,6 +451,12 @@ type MsgClient interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// GrantSessionKey grants a short-lived session key the generic authorization
+	// of the given message types and a fee allowance of the given budget, both
+	// expiring at the given expiration. The session key can then sign
+	// transactions of these messages as the granter directly, without wrapping
+	// them in a MsgExec.
+	GrantSessionKey(ctx context.Context, in *MsgGrantSessionKey, opts ...grpc.CallOption) (*MsgGrantSessionKeyResponse, error)
 }
 
 type msgClient struct {
@@ -381,6 +494,15 @@ func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) GrantSessionKey(ctx context.Context, in *MsgGrantSessionKey, opts ...grpc.CallOption) (*MsgGrantSessionKeyResponse, error) {
+	out := new(MsgGrantSessionKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/GrantSessionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Grant grants the provided authorization to the grantee on the granter's
@@ -395,6 +517,12 @@ type MsgServer interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// GrantSessionKey grants a short-lived session key the generic authorization
+	// of the given message types and a fee allowance of the given budget, both
+	// expiring at the given expiration. The session key can then sign
+	// transactions of these messages as the granter directly, without wrapping
+	// them in a MsgExec.
+	GrantSessionKey(context.Context, *MsgGrantSessionKey) (*MsgGrantSessionKeyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -410,6 +538,9 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) Revoke(ctx context.Context, req *MsgRevoke) (*MsgRevokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (*UnimplementedMsgServer) GrantSessionKey(ctx context.Context, req *MsgGrantSessionKey) (*MsgGrantSessionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantSessionKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -469,6 +600,24 @@ func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantSessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantSessionKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantSessionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/GrantSessionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantSessionKey(ctx, req.(*MsgGrantSessionKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Msg",
@@ -486,6 +635,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _Msg_Revoke_Handler,
 		},
+		{
+			MethodName: "GrantSessionKey",
+			Handler:    _Msg_GrantSessionKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -704,6 +857,97 @@ func (m *MsgRevokeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgGrantSessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantSessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantSessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.FeeBudget) > 0 {
+		for iNdEx := len(m.FeeBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SessionKey) > 0 {
+		i -= len(m.SessionKey)
+		copy(dAtA[i:], m.SessionKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantSessionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantSessionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantSessionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -807,6 +1051,46 @@ func (m *MsgRevokeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgGrantSessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SessionKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FeeBudget) > 0 {
+		for _, e := range m.FeeBudget {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGrantSessionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1404,6 +1688,269 @@ func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgGrantSessionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantSessionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantSessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeBudget = append(m.FeeBudget, types.Coin{})
+			if err := m.FeeBudget[len(m.FeeBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantSessionKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantSessionKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantSessionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
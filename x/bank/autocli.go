@@ -87,6 +87,60 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms", Varargs: true}},
 				},
+				{
+					RpcMethod:      "DenomAuthorityMetadata",
+					Use:            "denom-authority-metadata [denom]",
+					Short:          "Query the admin and send hook of a factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsFromCreator",
+					Use:            "denoms-from-creator [creator]",
+					Short:          "Query the factory denoms created by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod:      "BalanceAtHeight",
+					Use:            "balance-at-height [address] [denom] [height]",
+					Short:          "Query the balance of a single denom of an account at a height from the balance index of the node",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "denom"}, {ProtoField: "height"}},
+				},
+				{
+					RpcMethod:      "BalanceHistory",
+					Use:            "balance-history [address] [denom]",
+					Short:          "Query the changes of the balance of a single denom of an account from the balance index of the node",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomCompliance",
+					Use:            "denom-compliance [denom]",
+					Short:          "Query the compliance controls of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "FrozenAddresses",
+					Use:            "frozen-addresses [denom]",
+					Short:          "Query the addresses frozen for a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "AllowlistedAddresses",
+					Use:            "allowlisted-addresses [denom]",
+					Short:          "Query the addresses allowlisted for a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "ScheduledSend",
+					Use:            "scheduled-send [id]",
+					Short:          "Query a scheduled send by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ScheduledSendsBySender",
+					Use:            "scheduled-sends [sender]",
+					Short:          "Query the scheduled sends of a sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -122,6 +176,91 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "CreateDenom",
+					Use:            "create-denom [subdenom]",
+					Short:          "Create a factory denom factory/{sender}/{subdenom} administered by the sender",
+					Long:           "Create a factory denom factory/{sender}/{subdenom} administered by the sender. The denom creation fee set in the bank params is burned from the sender.",
+					Example:        fmt.Sprintf(`%s tx bank create-denom mytoken --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subdenom"}},
+				},
+				{
+					RpcMethod:      "Mint",
+					Use:            "mint [amount]",
+					Short:          "Mint coins of a factory denom administered by the sender",
+					Example:        fmt.Sprintf(`%s tx bank mint 1000factory/cosmos1.../mytoken --mint-to-address cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "Burn",
+					Use:            "burn [amount]",
+					Short:          "Burn coins of a factory denom administered by the sender from the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ChangeAdmin",
+					Use:            "change-admin [denom] [new_admin]",
+					Short:          "Change the admin of a factory denom, an empty new admin renounces it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "new_admin"}},
+				},
+				{
+					RpcMethod:      "SetDenomMetadata",
+					Use:            "set-denom-metadata [metadata]",
+					Short:          "Set the client metadata of a factory denom administered by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+				},
+				{
+					RpcMethod:      "SetDenomSendHook",
+					Use:            "set-denom-send-hook [denom] [send_hook]",
+					Short:          "Set the send hook run on every transfer of a factory denom, an empty send hook unsets it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "send_hook"}},
+				},
+				{
+					RpcMethod:      "SetDenomCompliance",
+					Use:            "set-denom-compliance [denom] [compliance]",
+					Short:          "Set the compliance controls of a denom, an empty issuer removes them",
+					Long:           "Set the compliance controls of a denom. The sender must be the governance authority, the current issuer of the denom or, for a factory denom, its admin. An empty issuer removes the compliance controls of the denom.",
+					Example:        fmt.Sprintf(`%s tx bank set-denom-compliance factory/cosmos1.../mytoken '{"issuer":"cosmos1...","treasury":"cosmos1...","allowlist_enabled":true}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "compliance"}},
+				},
+				{
+					RpcMethod:      "Freeze",
+					Use:            "freeze [denom] [address]",
+					Short:          "Freeze an address for a denom issued by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Unfreeze",
+					Use:            "unfreeze [denom] [address]",
+					Short:          "Unfreeze an address for a denom issued by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Clawback",
+					Use:            "clawback [address] [amount]",
+					Short:          "Claw back coins of a denom issued by the sender from an address to the treasury of the denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "SetAllowlisted",
+					Use:            "set-allowlisted [denom] [address] [allowed]",
+					Short:          "Add an address to, or remove it from, the allowlist of a denom issued by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}, {ProtoField: "allowed"}},
+				},
+				{
+					RpcMethod:      "ScheduleSend",
+					Use:            "schedule-send [recipient] [start_time] [period] [count] [amount]...",
+					Short:          "Schedule count transfers from the sender, the first one at start_time and the following ones every period",
+					Long:           "Schedule count transfers from the sender to the recipient, the first one at start_time and the following ones every period. The coins are sent from the balance of the sender at each execution, at the end of the first block after its time.",
+					Example:        fmt.Sprintf(`%s tx bank schedule-send cosmos1... 2026-01-01T00:00:00Z 720h 12 1000stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "start_time"}, {ProtoField: "period"}, {ProtoField: "count"}, {ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod:      "CancelScheduledSend",
+					Use:            "cancel-scheduled-send [id]",
+					Short:          "Cancel the remaining executions of a scheduled send of the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 			},
 		},
 	}
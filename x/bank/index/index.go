@@ -0,0 +1,333 @@
+// Package index implements the balance index of x/bank, an off-consensus
+// index of the changes of the account balances per block, maintained by a
+// node from the coin_spent and coin_received events and served by the
+// BalanceAtHeight and BalanceHistory queries.
+package index
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// FlagBalanceIndex is the app option enabling the balance index of a node.
+const FlagBalanceIndex = "bank.balance-index"
+
+var (
+	// ChangesPrefix is the prefix of the balance changes,
+	// ChangesPrefix | len(address) | address | len(denom) | denom | height.
+	ChangesPrefix = []byte{0x01}
+	// FirstHeightKey is the key of the first height of the index.
+	FirstHeightKey = []byte{0x02}
+	// LastHeightKey is the key of the last height of the index.
+	LastHeightKey = []byte{0x03}
+)
+
+var (
+	_ storetypes.ABCIListener = (*BalanceIndex)(nil)
+	_ types.BalanceIndex      = (*BalanceIndex)(nil)
+)
+
+// BalanceKeeper defines the bank keeper methods used to seed the index with
+// the balances of the first indexed height.
+type BalanceKeeper interface {
+	IterateAllBalances(ctx context.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+}
+
+// BalanceIndex records the net change of the balance of every coin of every
+// account in each block in its own database, out of the consensus state.
+//
+// It is registered on the BaseApp as an ABCIListener. The first block it
+// listens to seeds it with all the balances at that height, balances at
+// earlier heights are not indexed. The balances of the following blocks are
+// derived from the coin_spent and coin_received events of the block.
+type BalanceIndex struct {
+	db           dbm.DB
+	bk           BalanceKeeper
+	addressCodec address.Codec
+}
+
+// NewBalanceIndex returns a BalanceIndex stored in the given database.
+func NewBalanceIndex(db dbm.DB, bk BalanceKeeper, addressCodec address.Codec) *BalanceIndex {
+	return &BalanceIndex{db: db, bk: bk, addressCodec: addressCodec}
+}
+
+// ListenFinalizeBlock indexes the balance changes of a block.
+func (idx *BalanceIndex) ListenFinalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	lastHeight, err := idx.getHeight(LastHeightKey)
+	if err != nil {
+		return err
+	}
+
+	// blocks replayed on restart are already indexed
+	if lastHeight != 0 && req.Height <= lastHeight {
+		return nil
+	}
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	if lastHeight == 0 {
+		// the state at the first indexed height already includes the
+		// changes of its block
+		if err := idx.seed(ctx, batch, req.Height); err != nil {
+			return err
+		}
+	} else {
+		events := res.Events
+		for _, txRes := range res.TxResults {
+			events = append(events, txRes.Events...)
+		}
+
+		if err := idx.indexEvents(batch, req.Height, events); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Set(LastHeightKey, sdk.Uint64ToBigEndian(uint64(req.Height))); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+func (idx *BalanceIndex) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	return nil
+}
+
+// BalanceAtHeight returns the balance of a coin of an account at a height.
+func (idx *BalanceIndex) BalanceAtHeight(addr sdk.AccAddress, denom string, height int64) (sdk.Coin, error) {
+	if err := idx.checkIndexed(height); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the last change at or before the height holds the balance
+	prefixKey := changesPrefix(addr, denom)
+	it, err := idx.db.ReverseIterator(prefixKey, changeKey(addr, denom, height+1))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return sdk.NewCoin(denom, math.ZeroInt()), it.Error()
+	}
+
+	var change types.BalanceChange
+	if err := change.Unmarshal(it.Value()); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(denom, change.Balance), nil
+}
+
+// BalanceHistory returns the changes of the balance of a coin of an account,
+// ordered by height.
+func (idx *BalanceIndex) BalanceHistory(addr sdk.AccAddress, denom string, pageReq *query.PageRequest) ([]types.BalanceChange, *query.PageResponse, error) {
+	store := prefix.NewStore(dbadapter.Store{DB: idx.db}, changesPrefix(addr, denom))
+
+	var changes []types.BalanceChange
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		var change types.BalanceChange
+		if err := change.Unmarshal(value); err != nil {
+			return err
+		}
+
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changes, pageRes, nil
+}
+
+// checkIndexed returns an error if the height is not indexed.
+func (idx *BalanceIndex) checkIndexed(height int64) error {
+	firstHeight, err := idx.getHeight(FirstHeightKey)
+	if err != nil {
+		return err
+	}
+
+	lastHeight, err := idx.getHeight(LastHeightKey)
+	if err != nil {
+		return err
+	}
+
+	if firstHeight == 0 || height < firstHeight || height > lastHeight {
+		return errorsmod.Wrapf(types.ErrHeightNotIndexed, "height %d, indexed heights are [%d, %d]", height, firstHeight, lastHeight)
+	}
+
+	return nil
+}
+
+// seed indexes all the balances at the first indexed height.
+func (idx *BalanceIndex) seed(ctx context.Context, batch dbm.Batch, height int64) error {
+	var err error
+	idx.bk.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		err = idx.setChange(batch, addr, coin.Denom, types.BalanceChange{
+			Height:  height,
+			Delta:   coin.Amount,
+			Balance: coin.Amount,
+		})
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return batch.Set(FirstHeightKey, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// indexEvents indexes the net balance changes of the coin_spent and
+// coin_received events of a block.
+func (idx *BalanceIndex) indexEvents(batch dbm.Batch, height int64, events []abci.Event) error {
+	type balanceKey struct {
+		addr  string
+		denom string
+	}
+
+	var keys []balanceKey
+	deltas := make(map[balanceKey]math.Int)
+	for _, event := range events {
+		var addrKey string
+		var sign int64
+		switch event.Type {
+		case types.EventTypeCoinSpent:
+			addrKey, sign = types.AttributeKeySpender, -1
+		case types.EventTypeCoinReceived:
+			addrKey, sign = types.AttributeKeyReceiver, 1
+		default:
+			continue
+		}
+
+		var addr, amount string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case addrKey:
+				addr = attr.Value
+			case sdk.AttributeKeyAmount:
+				amount = attr.Value
+			}
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			return fmt.Errorf("invalid %s event amount %q: %w", event.Type, amount, err)
+		}
+
+		for _, coin := range coins {
+			key := balanceKey{addr: addr, denom: coin.Denom}
+			delta, ok := deltas[key]
+			if !ok {
+				keys = append(keys, key)
+				delta = math.ZeroInt()
+			}
+			deltas[key] = delta.Add(coin.Amount.MulRaw(sign))
+		}
+	}
+
+	// keys are processed in the order of the events for a deterministic batch
+	for _, key := range keys {
+		delta := deltas[key]
+		if delta.IsZero() {
+			continue
+		}
+
+		addr, err := idx.addressCodec.StringToBytes(key.addr)
+		if err != nil {
+			return fmt.Errorf("invalid event address %q: %w", key.addr, err)
+		}
+
+		balance, err := idx.lastBalance(addr, key.denom)
+		if err != nil {
+			return err
+		}
+
+		if err := idx.setChange(batch, addr, key.denom, types.BalanceChange{
+			Height:  height,
+			Delta:   delta,
+			Balance: balance.Add(delta),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lastBalance returns the last indexed balance of a coin of an account.
+func (idx *BalanceIndex) lastBalance(addr sdk.AccAddress, denom string) (math.Int, error) {
+	prefixKey := changesPrefix(addr, denom)
+	it, err := idx.db.ReverseIterator(prefixKey, storetypes.PrefixEndBytes(prefixKey))
+	if err != nil {
+		return math.Int{}, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return math.ZeroInt(), it.Error()
+	}
+
+	var change types.BalanceChange
+	if err := change.Unmarshal(it.Value()); err != nil {
+		return math.Int{}, err
+	}
+
+	return change.Balance, nil
+}
+
+func (idx *BalanceIndex) setChange(batch dbm.Batch, addr sdk.AccAddress, denom string, change types.BalanceChange) error {
+	bz, err := change.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return batch.Set(changeKey(addr, denom, change.Height), bz)
+}
+
+func (idx *BalanceIndex) getHeight(key []byte) (int64, error) {
+	bz, err := idx.db.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	if len(bz) != 8 {
+		return 0, errors.New("invalid balance index height")
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// changesPrefix returns the prefix of the changes of the balance of a coin of
+// an account.
+func changesPrefix(addr sdk.AccAddress, denom string) []byte {
+	key := append([]byte{}, ChangesPrefix...)
+	key = append(key, byte(len(addr)))
+	key = append(key, addr...)
+	key = append(key, byte(len(denom)))
+	return append(key, denom...)
+}
+
+// changeKey returns the key of the change of the balance of a coin of an
+// account at a height.
+func changeKey(addr sdk.AccAddress, denom string, height int64) []byte {
+	return append(changesPrefix(addr, denom), sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -0,0 +1,123 @@
+package index_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/index"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type mockBalanceKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (bk mockBalanceKeeper) IterateAllBalances(_ context.Context, cb func(sdk.AccAddress, sdk.Coin) bool) {
+	for addr, coins := range bk.balances {
+		for _, coin := range coins {
+			if cb(sdk.AccAddress(addr), coin) {
+				return
+			}
+		}
+	}
+}
+
+func finalizeBlock(t *testing.T, idx *index.BalanceIndex, height int64, blockEvents []abci.Event, txEvents ...[]abci.Event) {
+	t.Helper()
+
+	res := abci.ResponseFinalizeBlock{Events: blockEvents}
+	for _, events := range txEvents {
+		res.TxResults = append(res.TxResults, &abci.ExecTxResult{Events: events})
+	}
+
+	require.NoError(t, idx.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: height}, res))
+}
+
+func TestBalanceIndex(t *testing.T) {
+	addrA := sdk.AccAddress("addrA_______________")
+	addrB := sdk.AccAddress("addrB_______________")
+
+	bk := mockBalanceKeeper{balances: map[string]sdk.Coins{
+		string(addrA): sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}}
+	idx := index.NewBalanceIndex(dbm.NewMemDB(), bk, address.NewBech32Codec("cosmos"))
+
+	// nothing is indexed before the first block
+	_, err := idx.BalanceAtHeight(addrA, "stake", 1)
+	require.ErrorIs(t, err, types.ErrHeightNotIndexed)
+
+	// the first block seeds the index with the state
+	finalizeBlock(t, idx, 5, []abci.Event{
+		abci.Event(types.NewCoinReceivedEvent(addrA, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))),
+	})
+
+	// addrA sends 30stake to addrB, addrB pays a 1stake fee in a failed tx
+	finalizeBlock(t, idx, 6, nil,
+		[]abci.Event{
+			abci.Event(types.NewCoinSpentEvent(addrA, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)))),
+			abci.Event(types.NewCoinReceivedEvent(addrB, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)))),
+		},
+		[]abci.Event{
+			abci.Event(types.NewCoinSpentEvent(addrB, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))),
+		},
+	)
+
+	// addrA receives and spends 10stake in the same block
+	finalizeBlock(t, idx, 7, []abci.Event{
+		abci.Event(types.NewCoinReceivedEvent(addrA, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))),
+		abci.Event(types.NewCoinSpentEvent(addrA, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))),
+		abci.Event(types.NewCoinReceivedEvent(addrB, sdk.NewCoins(sdk.NewInt64Coin("foo", 5)))),
+	})
+
+	// replayed blocks are ignored
+	finalizeBlock(t, idx, 6, nil, []abci.Event{
+		abci.Event(types.NewCoinSpentEvent(addrA, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)))),
+	})
+
+	for _, tc := range []struct {
+		addr     sdk.AccAddress
+		denom    string
+		height   int64
+		expected int64
+	}{
+		{addrA, "stake", 5, 100},
+		{addrA, "stake", 6, 70},
+		{addrA, "stake", 7, 70},
+		{addrB, "stake", 5, 0},
+		{addrB, "stake", 6, 29},
+		{addrB, "foo", 6, 0},
+		{addrB, "foo", 7, 5},
+	} {
+		balance, err := idx.BalanceAtHeight(tc.addr, tc.denom, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin(tc.denom, tc.expected), balance, "%s %s at %d", tc.addr, tc.denom, tc.height)
+	}
+
+	_, err = idx.BalanceAtHeight(addrA, "stake", 4)
+	require.ErrorIs(t, err, types.ErrHeightNotIndexed)
+	_, err = idx.BalanceAtHeight(addrA, "stake", 8)
+	require.ErrorIs(t, err, types.ErrHeightNotIndexed)
+
+	changes, _, err := idx.BalanceHistory(addrA, "stake", nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.BalanceChange{
+		{Height: 5, Delta: math.NewInt(100), Balance: math.NewInt(100)},
+		{Height: 6, Delta: math.NewInt(-30), Balance: math.NewInt(70)},
+	}, changes)
+
+	changes, pageRes, err := idx.BalanceHistory(addrB, "stake", &query.PageRequest{Limit: 1, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []types.BalanceChange{
+		{Height: 6, Delta: math.NewInt(29), Balance: math.NewInt(29)},
+	}, changes)
+	require.Equal(t, uint64(1), pageRes.Total)
+}
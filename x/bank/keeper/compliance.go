@@ -0,0 +1,296 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetDenomCompliance returns the compliance controls of a denom.
+func (k BaseKeeper) GetDenomCompliance(ctx context.Context, denom string) (types.DenomCompliance, error) {
+	compliance, err := k.BaseViewKeeper.DenomCompliance.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DenomCompliance{}, errorsmod.Wrap(types.ErrNoDenomCompliance, denom)
+	}
+
+	return compliance, err
+}
+
+// SetDenomCompliance sets the compliance controls of a denom. An empty issuer
+// removes the compliance controls of the denom, including its frozen and
+// allowlisted addresses.
+func (k BaseKeeper) SetDenomCompliance(ctx context.Context, denom string, compliance types.DenomCompliance) error {
+	if err := compliance.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if compliance.Issuer != "" {
+		return k.BaseViewKeeper.DenomCompliance.Set(ctx, denom, compliance)
+	}
+
+	if err := k.BaseViewKeeper.DenomCompliance.Remove(ctx, denom); err != nil {
+		return err
+	}
+
+	if err := k.BaseViewKeeper.FrozenAddresses.Clear(ctx, collections.NewPrefixedPairRange[string, sdk.AccAddress](denom)); err != nil {
+		return err
+	}
+
+	return k.BaseViewKeeper.AllowlistedAddresses.Clear(ctx, collections.NewPrefixedPairRange[string, sdk.AccAddress](denom))
+}
+
+// IsFrozen returns whether an address is frozen for a denom.
+func (k BaseKeeper) IsFrozen(ctx context.Context, denom string, addr sdk.AccAddress) bool {
+	has, err := k.BaseViewKeeper.FrozenAddresses.Has(ctx, collections.Join(denom, addr))
+	if err != nil {
+		panic(err)
+	}
+
+	return has
+}
+
+// SetFrozen freezes or unfreezes an address for a denom with compliance controls.
+func (k BaseKeeper) SetFrozen(ctx context.Context, denom string, addr sdk.AccAddress, frozen bool) error {
+	if _, err := k.GetDenomCompliance(ctx, denom); err != nil {
+		return err
+	}
+
+	if frozen {
+		return k.BaseViewKeeper.FrozenAddresses.Set(ctx, collections.Join(denom, addr))
+	}
+
+	return k.BaseViewKeeper.FrozenAddresses.Remove(ctx, collections.Join(denom, addr))
+}
+
+// IsAllowlisted returns whether an address is allowlisted for a denom.
+func (k BaseKeeper) IsAllowlisted(ctx context.Context, denom string, addr sdk.AccAddress) bool {
+	has, err := k.BaseViewKeeper.AllowlistedAddresses.Has(ctx, collections.Join(denom, addr))
+	if err != nil {
+		panic(err)
+	}
+
+	return has
+}
+
+// SetAllowlisted adds an address to, or removes it from, the allowlist of a
+// denom with compliance controls.
+func (k BaseKeeper) SetAllowlisted(ctx context.Context, denom string, addr sdk.AccAddress, allowed bool) error {
+	if _, err := k.GetDenomCompliance(ctx, denom); err != nil {
+		return err
+	}
+
+	if allowed {
+		return k.BaseViewKeeper.AllowlistedAddresses.Set(ctx, collections.Join(denom, addr))
+	}
+
+	return k.BaseViewKeeper.AllowlistedAddresses.Remove(ctx, collections.Join(denom, addr))
+}
+
+// Clawback transfers coins of a denom with compliance controls from an address
+// to the treasury of the denom. The transfer bypasses the send restrictions,
+// hence frozen addresses can be clawed back, but not the locked coins of
+// vesting accounts. Coins cannot be clawed back from blocked addresses and
+// module accounts, whose balances are accounted for by their modules.
+func (k BaseKeeper) Clawback(ctx context.Context, from sdk.AccAddress, amount sdk.Coin) error {
+	compliance, err := k.GetDenomCompliance(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	if k.BlockedAddr(from) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to be clawed back", from)
+	}
+
+	if _, ok := k.ak.GetAccount(ctx, from).(sdk.ModuleAccountI); ok {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s is not allowed to be clawed back", from)
+	}
+
+	treasury, err := k.ak.AddressCodec().StringToBytes(compliance.Treasury)
+	if err != nil {
+		return err
+	}
+
+	amounts := sdk.NewCoins(amount)
+	if !amounts.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	if err := k.subUnlockedCoins(ctx, from, amounts); err != nil {
+		return err
+	}
+
+	if err := k.addCoins(ctx, treasury, amounts); err != nil {
+		return err
+	}
+
+	k.ensureAccountCreated(ctx, treasury)
+
+	return k.emitSendCoinsEvents(ctx, from, treasury, amounts)
+}
+
+// GetAllDenomCompliance returns the compliance controls of all the denoms and
+// their frozen and allowlisted addresses.
+func (k BaseKeeper) GetAllDenomCompliance(ctx context.Context) []types.DenomComplianceState {
+	var states []types.DenomComplianceState
+	err := k.BaseViewKeeper.DenomCompliance.Walk(ctx, nil, func(denom string, compliance types.DenomCompliance) (bool, error) {
+		frozen, err := k.denomAddresses(ctx, k.BaseViewKeeper.FrozenAddresses, denom)
+		if err != nil {
+			return true, err
+		}
+
+		allowlisted, err := k.denomAddresses(ctx, k.BaseViewKeeper.AllowlistedAddresses, denom)
+		if err != nil {
+			return true, err
+		}
+
+		states = append(states, types.DenomComplianceState{
+			Denom:                denom,
+			Compliance:           compliance,
+			FrozenAddresses:      frozen,
+			AllowlistedAddresses: allowlisted,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return states
+}
+
+// setDenomComplianceState sets the compliance controls of a denom and its
+// frozen and allowlisted addresses.
+func (k BaseKeeper) setDenomComplianceState(ctx context.Context, state types.DenomComplianceState) error {
+	if err := k.BaseViewKeeper.DenomCompliance.Set(ctx, state.Denom, state.Compliance); err != nil {
+		return err
+	}
+
+	for _, addrStr := range state.FrozenAddresses {
+		addr, err := k.ak.AddressCodec().StringToBytes(addrStr)
+		if err != nil {
+			return err
+		}
+
+		if err := k.BaseViewKeeper.FrozenAddresses.Set(ctx, collections.Join(state.Denom, sdk.AccAddress(addr))); err != nil {
+			return err
+		}
+	}
+
+	for _, addrStr := range state.AllowlistedAddresses {
+		addr, err := k.ak.AddressCodec().StringToBytes(addrStr)
+		if err != nil {
+			return err
+		}
+
+		if err := k.BaseViewKeeper.AllowlistedAddresses.Set(ctx, collections.Join(state.Denom, sdk.AccAddress(addr))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// denomAddresses returns the addresses of a denom in a set of frozen or
+// allowlisted addresses.
+func (k BaseKeeper) denomAddresses(ctx context.Context, set collections.KeySet[collections.Pair[string, sdk.AccAddress]], denom string) ([]string, error) {
+	var addrs []string
+	err := set.Walk(ctx, collections.NewPrefixedPairRange[string, sdk.AccAddress](denom), func(key collections.Pair[string, sdk.AccAddress]) (bool, error) {
+		addr, err := k.ak.AddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		addrs = append(addrs, addr)
+		return false, nil
+	})
+
+	return addrs, err
+}
+
+// complianceSendRestriction is the SendRestrictionFn enforcing the compliance
+// controls of the denoms of the sent coins.
+func (k BaseKeeper) complianceSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	return toAddr, k.checkCompliance(ctx, fromAddr, toAddr, amt)
+}
+
+// checkCompliance enforces the compliance controls of the denoms of coins
+// transferred to toAddr: neither the sender, if any, nor the recipient can be
+// frozen and, if the allowlist of the denom is enabled, the recipient must be
+// allowlisted, the issuer or the treasury of the denom.
+func (k BaseKeeper) checkCompliance(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if !k.hasDenomCompliance(ctx) {
+		return nil
+	}
+
+	for _, coin := range amt {
+		compliance, err := k.BaseViewKeeper.DenomCompliance.Get(ctx, coin.Denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		for _, addr := range []sdk.AccAddress{fromAddr, toAddr} {
+			if addr == nil {
+				continue
+			}
+			if k.IsFrozen(ctx, coin.Denom, addr) {
+				return errorsmod.Wrapf(types.ErrAddressFrozen, "%s is frozen for %s", addr, coin.Denom)
+			}
+		}
+
+		if !compliance.AllowlistEnabled || k.IsAllowlisted(ctx, coin.Denom, toAddr) {
+			continue
+		}
+
+		toAddrStr, err := k.ak.AddressCodec().BytesToString(toAddr)
+		if err != nil {
+			return err
+		}
+
+		if toAddrStr != compliance.Issuer && toAddrStr != compliance.Treasury {
+			return errorsmod.Wrapf(types.ErrNotAllowlisted, "%s is not allowlisted for %s", toAddrStr, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// hasDenomCompliance returns whether any denom has compliance controls. It is
+// checked without consuming gas, so that the transfers of chains without
+// compliance controls do not pay for the compliance send restriction.
+func (k BaseKeeper) hasDenomCompliance(ctx context.Context) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	iter, err := k.BaseViewKeeper.DenomCompliance.Iterate(sdkCtx, nil)
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+
+	return iter.Valid()
+}
+
+// newComplianceEvent returns an event of the compliance controls of a denom
+// applied to an address.
+func newComplianceEvent(eventType, issuer, denom, addr string) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyIssuer, issuer),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyAddress, addr),
+	)
+}
+
+// newSetAllowlistedEvent returns the event of an update of the allowlist of a denom.
+func newSetAllowlistedEvent(issuer, denom, addr string, allowed bool) sdk.Event {
+	return newComplianceEvent(types.EventTypeSetAllowlisted, issuer, denom, addr).
+		AppendAttributes(sdk.NewAttribute(types.AttributeKeyAllowed, strconv.FormatBool(allowed)))
+}
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// RegisterFactoryDenomSendHook registers a send hook under the given name. The
+// admins of factory denoms select the hook run on every transfer of their
+// denom with MsgSetDenomSendHook. It panics if a hook is already registered
+// under the name.
+func (k BaseKeeper) RegisterFactoryDenomSendHook(name string, hook types.SendRestrictionFn) {
+	if _, ok := k.factorySendHooks[name]; ok {
+		panic(fmt.Errorf("factory denom send hook %s already registered", name))
+	}
+
+	k.factorySendHooks[name] = hook
+}
+
+// HasFactoryDenomSendHook returns whether a send hook is registered under the given name.
+func (k BaseKeeper) HasFactoryDenomSendHook(name string) bool {
+	_, ok := k.factorySendHooks[name]
+	return ok
+}
+
+// CreateFactoryDenom creates the factory denom factory/{creator}/{subdenom},
+// of which the creator is the admin, and burns the denom creation fee from
+// the creator.
+func (k BaseKeeper) CreateFactoryDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	creatorStr, err := k.ak.AddressCodec().BytesToString(creator)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.GetFactoryDenom(creatorStr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if has, err := k.FactoryDenoms.Has(ctx, denom); err != nil {
+		return "", err
+	} else if has || k.HasSupply(ctx, denom) {
+		return "", errorsmod.Wrap(types.ErrFactoryDenomExists, denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.burnFactoryCoinsFrom(ctx, creator, fee); err != nil {
+			return "", errorsmod.Wrap(err, "failed to pay the denom creation fee")
+		}
+	}
+
+	if err := k.setFactoryDenom(ctx, denom, types.DenomAuthorityMetadata{Admin: creatorStr}); err != nil {
+		return "", err
+	}
+
+	if !k.HasDenomMetaData(ctx, denom) {
+		k.SetDenomMetaData(ctx, types.Metadata{
+			DenomUnits: []*types.DenomUnit{{Denom: denom, Exponent: 0}},
+			Base:       denom,
+		})
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyCreator, creatorStr),
+			sdk.NewAttribute(types.AttributeKeyNewTokenDenom, denom),
+		),
+	)
+
+	return denom, nil
+}
+
+// GetFactoryDenomAuthority returns the authority of a factory denom.
+func (k BaseKeeper) GetFactoryDenomAuthority(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	metadata, err := k.FactoryDenoms.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DenomAuthorityMetadata{}, errorsmod.Wrapf(types.ErrInvalidFactoryDenom, "factory denom %s does not exist", denom)
+	}
+
+	return metadata, err
+}
+
+// SetFactoryDenomAuthority sets the authority of an existing factory denom.
+func (k BaseKeeper) SetFactoryDenomAuthority(ctx context.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	if _, err := k.GetFactoryDenomAuthority(ctx, denom); err != nil {
+		return err
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	return k.FactoryDenoms.Set(ctx, denom, metadata)
+}
+
+// GetAllFactoryDenoms returns all the factory denoms and their authority.
+func (k BaseKeeper) GetAllFactoryDenoms(ctx context.Context) []types.FactoryDenom {
+	var denoms []types.FactoryDenom
+	err := k.FactoryDenoms.Walk(ctx, nil, func(denom string, metadata types.DenomAuthorityMetadata) (bool, error) {
+		denoms = append(denoms, types.FactoryDenom{Denom: denom, AuthorityMetadata: metadata})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return denoms
+}
+
+// MintFactoryCoins mints coins of a factory denom to the given address, which
+// is subject to the compliance controls of the denom.
+func (k BaseKeeper) MintFactoryCoins(ctx context.Context, to sdk.AccAddress, amount sdk.Coin) error {
+	if _, err := k.GetFactoryDenomAuthority(ctx, amount.Denom); err != nil {
+		return err
+	}
+
+	amounts := sdk.NewCoins(amount)
+	if !amounts.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	if err := k.checkCompliance(ctx, nil, to, amounts); err != nil {
+		return err
+	}
+
+	if err := k.addCoins(ctx, to, amounts); err != nil {
+		return err
+	}
+
+	supply := k.GetSupply(ctx, amount.Denom)
+	k.setSupply(ctx, supply.Add(amount))
+
+	k.ensureAccountCreated(ctx, to)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		types.NewCoinMintEvent(to, amounts),
+	)
+
+	return nil
+}
+
+// BurnFactoryCoins burns coins of a factory denom from the given address.
+func (k BaseKeeper) BurnFactoryCoins(ctx context.Context, from sdk.AccAddress, amount sdk.Coin) error {
+	if _, err := k.GetFactoryDenomAuthority(ctx, amount.Denom); err != nil {
+		return err
+	}
+
+	return k.burnFactoryCoinsFrom(ctx, from, sdk.NewCoins(amount))
+}
+
+func (k BaseKeeper) burnFactoryCoinsFrom(ctx context.Context, from sdk.AccAddress, amounts sdk.Coins) error {
+	if !amounts.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amounts.String())
+	}
+
+	if err := k.subUnlockedCoins(ctx, from, amounts); err != nil {
+		return err
+	}
+
+	for _, amount := range amounts {
+		supply := k.GetSupply(ctx, amount.Denom)
+		k.setSupply(ctx, supply.Sub(amount))
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		types.NewCoinBurnEvent(from, amounts),
+	)
+
+	return nil
+}
+
+func (k BaseKeeper) setFactoryDenom(ctx context.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	creator, _, err := types.DeconstructFactoryDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	creatorAddr, err := k.ak.AddressCodec().StringToBytes(creator)
+	if err != nil {
+		return err
+	}
+
+	if err := k.FactoryDenoms.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return k.CreatorDenoms.Set(ctx, collections.Join(sdk.AccAddress(creatorAddr), denom))
+}
+
+// factoryDenomSendRestriction is the SendRestrictionFn running the send hooks
+// selected by the admins of the factory denoms of the sent coins.
+func (k BaseKeeper) factoryDenomSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	for _, coin := range amt {
+		if !types.IsFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		metadata, err := k.FactoryDenoms.Get(ctx, coin.Denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if metadata.SendHook == "" {
+			continue
+		}
+
+		hook, ok := k.factorySendHooks[metadata.SendHook]
+		if !ok {
+			return nil, errorsmod.Wrapf(types.ErrUnknownSendHook, "send hook %s of denom %s", metadata.SendHook, coin.Denom)
+		}
+
+		toAddr, err = hook(ctx, fromAddr, toAddr, sdk.NewCoins(coin))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return toAddr, nil
+}
@@ -54,6 +54,28 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, fd := range genState.FactoryDenoms {
+		if err := k.setFactoryDenom(ctx, fd.Denom, fd.AuthorityMetadata); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, dc := range genState.DenomCompliance {
+		if err := k.setDenomComplianceState(ctx, dc); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, ss := range genState.ScheduledSends {
+		if err := k.setScheduledSend(ctx, ss); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.ScheduledSendSeq.Set(ctx, genState.NextScheduledSendId); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -70,5 +92,14 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+	rv.FactoryDenoms = k.GetAllFactoryDenoms(ctx)
+	rv.DenomCompliance = k.GetAllDenomCompliance(ctx)
+	rv.ScheduledSends = k.GetAllScheduledSends(ctx)
+
+	nextScheduledSendID, err := k.ScheduledSendSeq.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	rv.NextScheduledSendId = nextScheduledSendID
 	return rv
 }
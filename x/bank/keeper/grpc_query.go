@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -316,3 +317,209 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// DenomAuthorityMetadata implements the Query/DenomAuthorityMetadata gRPC method
+func (k BaseKeeper) DenomAuthorityMetadata(ctx context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	metadata, err := k.GetFactoryDenomAuthority(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: metadata}, nil
+}
+
+// DenomsFromCreator implements the Query/DenomsFromCreator gRPC method
+func (k BaseKeeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	creator, err := k.ak.AddressCodec().StringToBytes(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err.Error())
+	}
+
+	denoms, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.CreatorDenoms,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, string], _ collections.NoValue) (string, error) {
+			return key.K2(), nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](creator),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+// BalanceAtHeight implements the Query/BalanceAtHeight gRPC method
+func (k BaseKeeper) BalanceAtHeight(_ context.Context, req *types.QueryBalanceAtHeightRequest) (*types.QueryBalanceAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if k.balanceIndex == nil {
+		return nil, status.Error(codes.Unimplemented, "balance index is not enabled on this node")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	balance, err := k.balanceIndex.BalanceAtHeight(addr, req.Denom, req.Height)
+	if errors.Is(err, types.ErrHeightNotIndexed) {
+		return nil, status.Error(codes.OutOfRange, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBalanceAtHeightResponse{Balance: &balance}, nil
+}
+
+// BalanceHistory implements the Query/BalanceHistory gRPC method
+func (k BaseKeeper) BalanceHistory(_ context.Context, req *types.QueryBalanceHistoryRequest) (*types.QueryBalanceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if k.balanceIndex == nil {
+		return nil, status.Error(codes.Unimplemented, "balance index is not enabled on this node")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	changes, pageRes, err := k.balanceIndex.BalanceHistory(addr, req.Denom, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryBalanceHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// DenomCompliance implements the Query/DenomCompliance gRPC method
+func (k BaseKeeper) DenomCompliance(ctx context.Context, req *types.QueryDenomComplianceRequest) (*types.QueryDenomComplianceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	compliance, err := k.GetDenomCompliance(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryDenomComplianceResponse{Compliance: compliance}, nil
+}
+
+// FrozenAddresses implements the Query/FrozenAddresses gRPC method
+func (k BaseKeeper) FrozenAddresses(ctx context.Context, req *types.QueryFrozenAddressesRequest) (*types.QueryFrozenAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addrs, pageRes, err := k.paginateDenomAddresses(ctx, k.BaseViewKeeper.FrozenAddresses, req.Denom, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFrozenAddressesResponse{Addresses: addrs, Pagination: pageRes}, nil
+}
+
+// AllowlistedAddresses implements the Query/AllowlistedAddresses gRPC method
+func (k BaseKeeper) AllowlistedAddresses(ctx context.Context, req *types.QueryAllowlistedAddressesRequest) (*types.QueryAllowlistedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addrs, pageRes, err := k.paginateDenomAddresses(ctx, k.BaseViewKeeper.AllowlistedAddresses, req.Denom, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllowlistedAddressesResponse{Addresses: addrs, Pagination: pageRes}, nil
+}
+
+func (k BaseKeeper) paginateDenomAddresses(
+	ctx context.Context,
+	set collections.KeySet[collections.Pair[string, sdk.AccAddress]],
+	denom string,
+	pageReq *query.PageRequest,
+) ([]string, *query.PageResponse, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addrs, pageRes, err := query.CollectionPaginate(
+		ctx,
+		set,
+		pageReq,
+		func(key collections.Pair[string, sdk.AccAddress], _ collections.NoValue) (string, error) {
+			return k.ak.AddressCodec().BytesToString(key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, sdk.AccAddress](denom),
+	)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return addrs, pageRes, nil
+}
+
+// ScheduledSend implements the Query/ScheduledSend gRPC method
+func (k BaseKeeper) ScheduledSend(ctx context.Context, req *types.QueryScheduledSendRequest) (*types.QueryScheduledSendResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	scheduledSend, err := k.GetScheduledSend(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryScheduledSendResponse{ScheduledSend: scheduledSend}, nil
+}
+
+// ScheduledSendsBySender implements the Query/ScheduledSendsBySender gRPC method
+func (k BaseKeeper) ScheduledSendsBySender(ctx context.Context, req *types.QueryScheduledSendsBySenderRequest) (*types.QueryScheduledSendsBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := k.ak.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err)
+	}
+
+	scheduledSends, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.SenderScheduledSends,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.ScheduledSend, error) {
+			return k.ScheduledSends.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](sender),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryScheduledSendsBySenderResponse{ScheduledSends: scheduledSends, Pagination: pageRes}, nil
+}
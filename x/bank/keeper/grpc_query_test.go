@@ -5,6 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -911,3 +916,41 @@ func (suite *KeeperTestSuite) TestGRPCDenomOwnersByQuery() {
 
 	suite.Require().True(true)
 }
+
+type mockBalanceIndex struct{}
+
+func (mockBalanceIndex) BalanceAtHeight(_ sdk.AccAddress, denom string, height int64) (sdk.Coin, error) {
+	if height > 10 {
+		return sdk.Coin{}, types.ErrHeightNotIndexed
+	}
+	return sdk.NewInt64Coin(denom, height), nil
+}
+
+func (mockBalanceIndex) BalanceHistory(_ sdk.AccAddress, _ string, _ *query.PageRequest) ([]types.BalanceChange, *query.PageResponse, error) {
+	return []types.BalanceChange{{Height: 1, Delta: math.NewInt(1), Balance: math.NewInt(1)}}, &query.PageResponse{}, nil
+}
+
+func (suite *KeeperTestSuite) TestQueryBalanceIndex() {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	_, err := suite.queryClient.BalanceAtHeight(gocontext.Background(), &types.QueryBalanceAtHeightRequest{Address: addr.String(), Denom: fooDenom, Height: 1})
+	suite.Require().Equal(codes.Unimplemented, status.Code(err))
+	_, err = suite.queryClient.BalanceHistory(gocontext.Background(), &types.QueryBalanceHistoryRequest{Address: addr.String(), Denom: fooDenom})
+	suite.Require().Equal(codes.Unimplemented, status.Code(err))
+
+	k := suite.bankKeeper.WithBalanceIndex(mockBalanceIndex{})
+
+	res, err := k.BalanceAtHeight(suite.ctx, &types.QueryBalanceAtHeightRequest{Address: addr.String(), Denom: fooDenom, Height: 5})
+	suite.Require().NoError(err)
+	suite.Require().Equal(newFooCoin(5), *res.Balance)
+
+	_, err = k.BalanceAtHeight(suite.ctx, &types.QueryBalanceAtHeightRequest{Address: addr.String(), Denom: fooDenom, Height: 11})
+	suite.Require().Equal(codes.OutOfRange, status.Code(err))
+
+	_, err = k.BalanceAtHeight(suite.ctx, &types.QueryBalanceAtHeightRequest{Address: "invalid", Denom: fooDenom, Height: 5})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	historyRes, err := k.BalanceHistory(suite.ctx, &types.QueryBalanceHistoryRequest{Address: addr.String(), Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Len(historyRes.Changes, 1)
+}
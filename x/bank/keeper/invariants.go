@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// RegisterInvariants registers the bank module invariants, e.g. with the
+// contrib x/crisis keeper, which asserts them at the end of the block.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupply(k))
+}
+
+// TotalSupply checks that the total supply reflects all the coins held in
+// accounts, including the coins sent to virtual recipients in the current block
+// and not credited to their balance yet.
+func TotalSupply(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		supply := sdk.NewMapCoins(nil)
+		k.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+			supply.Add(coin)
+			return false
+		})
+
+		balances := sdk.NewMapCoins(nil)
+		k.IterateAllBalances(ctx, func(_ sdk.AccAddress, balance sdk.Coin) bool {
+			balances.Add(balance)
+			return false
+		})
+		accountsCoins := balances.ToCoins()
+		virtualCoins := k.GetTotalVirtualCoins(ctx)
+		supplyCoins := supply.ToCoins()
+
+		broken := !accountsCoins.Add(virtualCoins...).Equal(supplyCoins)
+		return sdk.FormatInvariant(types.ModuleName, "total supply",
+			fmt.Sprintf("\tsum of accounts coins: %v\n\tvirtual coins:         %v\n\tsupply.Total:          %v\n",
+				accountsCoins, virtualCoins, supplyCoins)), broken
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
@@ -26,6 +27,8 @@ type Keeper interface {
 	SendKeeper
 	WithMintCoinsRestriction(types.MintingRestrictionFn) BaseKeeper
 	WithObjStoreKey(storetypes.StoreKey) BaseKeeper
+	WithVirtualRecipients(...sdk.AccAddress) BaseKeeper
+	WithBalanceIndex(types.BalanceIndex) BaseKeeper
 
 	InitGenesis(context.Context, *types.GenesisState)
 	ExportGenesis(context.Context) *types.GenesisState
@@ -53,11 +56,37 @@ type Keeper interface {
 	CreditVirtualAccounts(ctx context.Context) error
 	SendCoinsFromVirtual(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsToVirtual(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	IsVirtualRecipient(addr sdk.AccAddress) bool
+	GetTotalVirtualCoins(ctx context.Context) sdk.Coins
 	UncheckedSetBalance(ctx context.Context, addr sdk.AccAddress, balance sdk.Coin) error
 
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	RegisterFactoryDenomSendHook(name string, hook types.SendRestrictionFn)
+	HasFactoryDenomSendHook(name string) bool
+	CreateFactoryDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error)
+	GetFactoryDenomAuthority(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error)
+	SetFactoryDenomAuthority(ctx context.Context, denom string, metadata types.DenomAuthorityMetadata) error
+	GetAllFactoryDenoms(ctx context.Context) []types.FactoryDenom
+	MintFactoryCoins(ctx context.Context, to sdk.AccAddress, amount sdk.Coin) error
+	BurnFactoryCoins(ctx context.Context, from sdk.AccAddress, amount sdk.Coin) error
+
+	GetDenomCompliance(ctx context.Context, denom string) (types.DenomCompliance, error)
+	SetDenomCompliance(ctx context.Context, denom string, compliance types.DenomCompliance) error
+	IsFrozen(ctx context.Context, denom string, addr sdk.AccAddress) bool
+	SetFrozen(ctx context.Context, denom string, addr sdk.AccAddress, frozen bool) error
+	IsAllowlisted(ctx context.Context, denom string, addr sdk.AccAddress) bool
+	SetAllowlisted(ctx context.Context, denom string, addr sdk.AccAddress, allowed bool) error
+	Clawback(ctx context.Context, from sdk.AccAddress, amount sdk.Coin) error
+	GetAllDenomCompliance(ctx context.Context) []types.DenomComplianceState
+
+	ScheduleSend(ctx context.Context, sender, recipient sdk.AccAddress, amount sdk.Coins, startTime time.Time, period time.Duration, count uint64) (uint64, error)
+	GetScheduledSend(ctx context.Context, id uint64) (types.ScheduledSend, error)
+	CancelScheduledSend(ctx context.Context, id uint64) error
+	GetAllScheduledSends(ctx context.Context) []types.ScheduledSend
+	ExecuteScheduledSends(ctx context.Context) error
+
 	types.QueryServer
 }
 
@@ -70,6 +99,12 @@ type BaseKeeper struct {
 	storeService           store.KVStoreService
 	mintCoinsRestrictionFn types.MintingRestrictionFn
 	logger                 log.Logger
+
+	// send hooks selectable by the admins of factory denoms
+	factorySendHooks map[string]types.SendRestrictionFn
+
+	// off-consensus balance index, nil if not enabled on the node
+	balanceIndex types.BalanceIndex
 }
 
 func (k BaseKeeper) WithObjStoreKey(okey storetypes.StoreKey) BaseKeeper {
@@ -77,6 +112,36 @@ func (k BaseKeeper) WithObjStoreKey(okey storetypes.StoreKey) BaseKeeper {
 	return k
 }
 
+// WithVirtualRecipients returns a copy of the keeper crediting the coins sent
+// to the given addresses by SendCoins and InputOutputCoins at the end of the
+// block, as SendCoinsToVirtual does, instead of in each transaction. It is
+// meant for hot recipients, e.g. the fee collector, written by most
+// transactions, which would otherwise serialize their parallel execution.
+//
+// The credits are only spendable in the transaction which sent them before the
+// end of the block, hence a virtual recipient must not spend in a block the
+// coins it receives in other transactions of the block. The object store key
+// must be set with WithObjStoreKey.
+func (k BaseKeeper) WithVirtualRecipients(addrs ...sdk.AccAddress) BaseKeeper {
+	if k.objStoreKey == nil {
+		panic("virtual recipients require the object store key of the bank keeper")
+	}
+
+	recipients := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		recipients[string(addr)] = true
+	}
+	k.virtualRecipients = recipients
+	return k
+}
+
+// WithBalanceIndex returns a copy of the keeper serving the BalanceAtHeight
+// and BalanceHistory queries from the given balance index.
+func (k BaseKeeper) WithBalanceIndex(index types.BalanceIndex) BaseKeeper {
+	k.balanceIndex = index
+	return k
+}
+
 // GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination
 func (k BaseKeeper) GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
 	coins, pageResp, err := query.CollectionPaginate(ctx, k.Supply, pagination, func(key string, value math.Int) (sdk.Coin, error) {
@@ -110,14 +175,22 @@ func NewBaseKeeper(
 	// add the module name to the logger
 	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
 
-	return BaseKeeper{
+	k := BaseKeeper{
 		BaseSendKeeper:         NewBaseSendKeeper(cdc, storeService, ak, blockedAddrs, authority, logger),
 		ak:                     ak,
 		cdc:                    cdc,
 		storeService:           storeService,
 		mintCoinsRestrictionFn: types.NoOpMintingRestrictionFn,
 		logger:                 logger,
+		factorySendHooks:       make(map[string]types.SendRestrictionFn),
 	}
+
+	// the send hooks of the factory denoms and the compliance controls of the
+	// denoms run first in the send restriction chain
+	k.AppendSendRestriction(k.factoryDenomSendRestriction)
+	k.AppendSendRestriction(k.complianceSendRestriction)
+
+	return k
 }
 
 // WithMintCoinsRestriction restricts the bank Keeper used within a specific module to
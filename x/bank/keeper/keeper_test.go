@@ -693,6 +693,75 @@ func (suite *KeeperTestSuite) TestSendCoinsVirtual() {
 	require.Equal(math.NewInt(25), keeper.GetBalance(suite.ctx, burnerAcc.GetAddress(), feeDenom2).Amount)
 }
 
+func (suite *KeeperTestSuite) TestVirtualRecipients() {
+	ctx := suite.ctx
+	require := suite.Require()
+	sender, hot, other := accAddrs[0], accAddrs[1], accAddrs[2]
+	totalSupply := keeper.TotalSupply(suite.bankKeeper)
+	keeper := suite.bankKeeper.WithVirtualRecipients(hot)
+	require.True(keeper.IsVirtualRecipient(hot))
+	require.False(keeper.IsVirtualRecipient(other))
+
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(100))
+	suite.mockFundAccount(sender)
+	require.NoError(banktestutil.FundAccount(ctx, keeper, sender, balances))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	// sends to the virtual recipient are deferred, other sends are not
+	require.NoError(keeper.SendCoins(ctx, sender, hot, sdk.NewCoins(newFooCoin(10))))
+	require.NoError(keeper.InputOutputCoins(ctx,
+		banktypes.NewInput(sender, sdk.NewCoins(newFooCoin(20), newBarCoin(10))),
+		[]banktypes.Output{
+			banktypes.NewOutput(hot, sdk.NewCoins(newFooCoin(10), newBarCoin(10))),
+			banktypes.NewOutput(other, sdk.NewCoins(newFooCoin(10))),
+		},
+	))
+	require.True(keeper.GetAllBalances(ctx, hot).IsZero())
+	require.Equal(sdk.NewCoins(newFooCoin(10)), keeper.GetAllBalances(ctx, other))
+
+	// the coins credited in the transaction are spendable in the transaction
+	require.Equal(sdk.NewCoins(newFooCoin(20), newBarCoin(10)), keeper.SpendableCoins(ctx, hot))
+	require.Equal(newFooCoin(20), keeper.SpendableCoin(ctx, hot, fooDenom))
+	require.NoError(keeper.SendCoins(ctx, hot, other, sdk.NewCoins(newFooCoin(5))))
+	require.Equal(sdk.NewCoins(newFooCoin(15)), keeper.GetAllBalances(ctx, other))
+
+	// the coins credited in other transactions are not spendable before the
+	// end of the block
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	otherTxCtx := sdkCtx.WithTxIndex(sdkCtx.TxIndex() + 1)
+	require.True(keeper.SpendableCoins(otherTxCtx, hot).IsZero())
+	err := keeper.SendCoins(otherTxCtx, hot, other, sdk.NewCoins(newFooCoin(1)))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// the deferred coins are not part of the balances
+	spendable, err := suite.queryClient.SpendableBalances(ctx, &banktypes.QuerySpendableBalancesRequest{Address: hot.String()})
+	require.NoError(err)
+	require.True(spendable.Balances.IsZero())
+
+	// the balances and the deferred coins sum up to the supply
+	require.Equal(sdk.NewCoins(newFooCoin(15), newBarCoin(10)), keeper.GetTotalVirtualCoins(ctx))
+	msg, broken := totalSupply(sdkCtx)
+	require.False(broken, msg)
+
+	require.NoError(keeper.CreditVirtualAccounts(ctx))
+	require.Equal(sdk.NewCoins(newFooCoin(15), newBarCoin(10)), keeper.GetAllBalances(ctx, hot))
+	require.True(keeper.GetTotalVirtualCoins(ctx).IsZero())
+	msg, broken = totalSupply(sdkCtx)
+	require.False(broken, msg)
+
+	// the coins are spent from both the credits of the transaction and the
+	// balance
+	require.NoError(keeper.SendCoins(otherTxCtx, sender, hot, sdk.NewCoins(newFooCoin(10))))
+	require.NoError(keeper.SendCoins(otherTxCtx, hot, other, sdk.NewCoins(newFooCoin(20))))
+	require.Equal(sdk.NewCoins(newFooCoin(5), newBarCoin(10)), keeper.GetAllBalances(ctx, hot))
+	require.Equal(keeper.GetAllBalances(ctx, hot), keeper.SpendableCoins(otherTxCtx, hot))
+
+	spendable, err = suite.queryClient.SpendableBalances(ctx, &banktypes.QuerySpendableBalancesRequest{Address: hot.String()})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(5), newBarCoin(10)), spendable.Balances)
+}
+
 func (suite *KeeperTestSuite) TestInputOutputNewAccount() {
 	ctx := suite.ctx
 	require := suite.Require()
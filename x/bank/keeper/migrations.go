@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v5 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v5"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper BaseKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper BaseKeeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate4to5 migrates the x/bank module state from the consensus version 4 to
+// version 5. Specifically, it sets the scheduled send params.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.Migrate(ctx, m.keeper.BaseViewKeeper.Params)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/hashicorp/go-metrics"
 
@@ -183,3 +184,371 @@ func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEn
 
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
+
+func (k msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	denom, err := k.CreateFactoryDenom(goCtx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	if _, err := k.factoryDenomAdmin(goCtx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	mintTo := msg.MintToAddress
+	if mintTo == "" {
+		mintTo = msg.Sender
+	}
+
+	to, err := k.stringToBytes(mintTo)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid mint to address: %s", err)
+	}
+
+	if k.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", mintTo)
+	}
+
+	if err := k.MintFactoryCoins(goCtx, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{}, nil
+}
+
+func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	if _, err := k.factoryDenomAdmin(goCtx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.BurnFactoryCoins(goCtx, sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+func (k msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	metadata, err := k.factoryDenomAdmin(goCtx, msg.Sender, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.NewAdmin != "" {
+		if _, err := k.stringToBytes(msg.NewAdmin); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	metadata.Admin = msg.NewAdmin
+	if err := k.SetFactoryDenomAuthority(goCtx, msg.Denom, metadata); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNewAdmin, msg.NewAdmin),
+		),
+	)
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	if _, err := k.factoryDenomAdmin(goCtx, msg.Sender, msg.Metadata.Base); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetDenomMetaData(goCtx, msg.Metadata)
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) SetDenomSendHook(goCtx context.Context, msg *types.MsgSetDenomSendHook) (*types.MsgSetDenomSendHookResponse, error) {
+	metadata, err := k.factoryDenomAdmin(goCtx, msg.Sender, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.SendHook != "" && !k.HasFactoryDenomSendHook(msg.SendHook) {
+		return nil, errorsmod.Wrap(types.ErrUnknownSendHook, msg.SendHook)
+	}
+
+	metadata.SendHook = msg.SendHook
+	if err := k.SetFactoryDenomAuthority(goCtx, msg.Denom, metadata); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomSendHook,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeySendHook, msg.SendHook),
+		),
+	)
+
+	return &types.MsgSetDenomSendHookResponse{}, nil
+}
+
+// factoryDenomAdmin returns the authority of a factory denom, checking that
+// the sender is its admin.
+func (k msgServer) factoryDenomAdmin(ctx context.Context, sender, denom string) (types.DenomAuthorityMetadata, error) {
+	metadata, err := k.GetFactoryDenomAuthority(ctx, denom)
+	if err != nil {
+		return types.DenomAuthorityMetadata{}, err
+	}
+
+	if metadata.Admin == "" || metadata.Admin != sender {
+		return types.DenomAuthorityMetadata{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of %s", sender, denom)
+	}
+
+	return metadata, nil
+}
+
+// stringToBytes decodes an address with the address codec of the keeper.
+func (k msgServer) stringToBytes(addr string) ([]byte, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	return base.ak.AddressCodec().StringToBytes(addr)
+}
+
+func (k msgServer) SetDenomCompliance(goCtx context.Context, msg *types.MsgSetDenomCompliance) (*types.MsgSetDenomComplianceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if !k.canSetDenomCompliance(ctx, msg.Sender, msg.Denom) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot set the compliance controls of %s", msg.Sender, msg.Denom)
+	}
+
+	if err := k.Keeper.SetDenomCompliance(ctx, msg.Denom, msg.Compliance); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomCompliance,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.Compliance.Issuer),
+			sdk.NewAttribute(types.AttributeKeyTreasury, msg.Compliance.Treasury),
+		),
+	)
+
+	return &types.MsgSetDenomComplianceResponse{}, nil
+}
+
+func (k msgServer) Freeze(goCtx context.Context, msg *types.MsgFreeze) (*types.MsgFreezeResponse, error) {
+	if err := k.setFrozen(goCtx, msg.Issuer, msg.Denom, msg.Address, true); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		newComplianceEvent(types.EventTypeFreeze, msg.Issuer, msg.Denom, msg.Address),
+	)
+
+	return &types.MsgFreezeResponse{}, nil
+}
+
+func (k msgServer) Unfreeze(goCtx context.Context, msg *types.MsgUnfreeze) (*types.MsgUnfreezeResponse, error) {
+	if err := k.setFrozen(goCtx, msg.Issuer, msg.Denom, msg.Address, false); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		newComplianceEvent(types.EventTypeUnfreeze, msg.Issuer, msg.Denom, msg.Address),
+	)
+
+	return &types.MsgUnfreezeResponse{}, nil
+}
+
+func (k msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	if err := k.checkDenomIssuer(goCtx, msg.Issuer, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	from, err := k.stringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if err := k.Keeper.Clawback(goCtx, from, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		newComplianceEvent(types.EventTypeClawback, msg.Issuer, msg.Amount.Denom, msg.Address).
+			AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String())),
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+func (k msgServer) SetAllowlisted(goCtx context.Context, msg *types.MsgSetAllowlisted) (*types.MsgSetAllowlistedResponse, error) {
+	if err := k.checkDenomIssuer(goCtx, msg.Issuer, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	addr, err := k.stringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if err := k.Keeper.SetAllowlisted(goCtx, msg.Denom, addr, msg.Allowed); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		newSetAllowlistedEvent(msg.Issuer, msg.Denom, msg.Address, msg.Allowed),
+	)
+
+	return &types.MsgSetAllowlistedResponse{}, nil
+}
+
+// canSetDenomCompliance returns whether the sender is the authority, the
+// current issuer of the denom or, for a factory denom, its admin.
+func (k msgServer) canSetDenomCompliance(ctx sdk.Context, sender, denom string) bool {
+	if sdk.ValidateAuthority(ctx, k.GetAuthority(), sender) == nil {
+		return true
+	}
+
+	if compliance, err := k.GetDenomCompliance(ctx, denom); err == nil && compliance.Issuer == sender {
+		return true
+	}
+
+	if metadata, err := k.GetFactoryDenomAuthority(ctx, denom); err == nil && metadata.Admin != "" && metadata.Admin == sender {
+		return true
+	}
+
+	return false
+}
+
+// checkDenomIssuer checks that the sender is the issuer of a denom.
+func (k msgServer) checkDenomIssuer(ctx context.Context, sender, denom string) error {
+	compliance, err := k.GetDenomCompliance(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if compliance.Issuer != sender {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the issuer of %s", sender, denom)
+	}
+
+	return nil
+}
+
+func (k msgServer) setFrozen(ctx context.Context, issuer, denom, address string, frozen bool) error {
+	if err := k.checkDenomIssuer(ctx, issuer, denom); err != nil {
+		return err
+	}
+
+	addr, err := k.stringToBytes(address)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return k.SetFrozen(ctx, denom, addr, frozen)
+}
+
+func (k msgServer) ScheduleSend(goCtx context.Context, msg *types.MsgScheduleSend) (*types.MsgScheduleSendResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	recipient, err := k.stringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := types.ValidateSchedule(msg.Amount, msg.Period, msg.Count); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(recipient) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Recipient)
+	}
+
+	id, err := k.Keeper.ScheduleSend(ctx, sender, recipient, msg.Amount, msg.StartTime, msg.Period, msg.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleSend,
+			sdk.NewAttribute(types.AttributeKeyScheduledSendID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgScheduleSendResponse{Id: id}, nil
+}
+
+func (k msgServer) CancelScheduledSend(goCtx context.Context, msg *types.MsgCancelScheduledSend) (*types.MsgCancelScheduledSendResponse, error) {
+	scheduledSend, err := k.GetScheduledSend(goCtx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if scheduledSend.Sender != msg.Sender {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of scheduled send %d", msg.Sender, msg.Id)
+	}
+
+	if err := k.Keeper.CancelScheduledSend(goCtx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelScheduledSend,
+			sdk.NewAttribute(types.AttributeKeyScheduledSendID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgCancelScheduledSendResponse{}, nil
+}
@@ -1,10 +1,17 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
+	"time"
+
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"go.uber.org/mock/gomock"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -443,3 +450,378 @@ func (suite *KeeperTestSuite) TestSetSendEnabledAuthority() {
 		suite.Require().Contains(err.Error(), "invalid authority")
 	})
 }
+
+func (suite *KeeperTestSuite) TestMsgFactoryDenoms() {
+	creator, other, blocked := accAddrs[0], accAddrs[1], accAddrs[4]
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	params := banktypes.DefaultParams()
+	params.DenomCreationFee = fee
+	suite.Require().NoError(suite.bankKeeper.SetParams(suite.ctx, params))
+
+	suite.mockFundAccount(creator)
+	suite.Require().NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, creator, initCoins))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	res, err := suite.msgServer.CreateDenom(suite.ctx, banktypes.NewMsgCreateDenom(creator.String(), "token"))
+	suite.Require().NoError(err)
+	denom := res.NewTokenDenom
+	suite.Require().Equal("factory/"+creator.String()+"/token", denom)
+	suite.Require().Equal(initCoins.Sub(fee...), suite.bankKeeper.GetAllBalances(suite.ctx, creator))
+	suite.Require().Equal(initCoins.Sub(fee...)[0], suite.bankKeeper.GetSupply(suite.ctx, sdk.DefaultBondDenom))
+	suite.Require().True(suite.bankKeeper.HasDenomMetaData(suite.ctx, denom))
+
+	_, err = suite.msgServer.CreateDenom(suite.ctx, banktypes.NewMsgCreateDenom(creator.String(), "token"))
+	suite.Require().ErrorIs(err, banktypes.ErrFactoryDenomExists)
+
+	// only the admin mints and burns
+	_, err = suite.msgServer.Mint(suite.ctx, banktypes.NewMsgMint(other.String(), sdk.NewInt64Coin(denom, 100), ""))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.Mint(suite.ctx, banktypes.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 100), blocked.String()))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.Mint(suite.ctx, banktypes.NewMsgMint(creator.String(), sdk.NewInt64Coin("foo", 100), ""))
+	suite.Require().ErrorIs(err, banktypes.ErrInvalidFactoryDenom)
+
+	_, err = suite.msgServer.Mint(suite.ctx, banktypes.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 100), other.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(suite.ctx, banktypes.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 50), ""))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(suite.ctx, banktypes.NewMsgBurn(creator.String(), sdk.NewInt64Coin(denom, 20)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(suite.ctx, banktypes.NewMsgBurn(creator.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 100), suite.bankKeeper.GetBalance(suite.ctx, other, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 30), suite.bankKeeper.GetBalance(suite.ctx, creator, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 130), suite.bankKeeper.GetSupply(suite.ctx, denom))
+
+	// send hooks
+	_, err = suite.msgServer.SetDenomSendHook(suite.ctx, banktypes.NewMsgSetDenomSendHook(creator.String(), denom, "frozen"))
+	suite.Require().ErrorIs(err, banktypes.ErrUnknownSendHook)
+
+	suite.bankKeeper.RegisterFactoryDenomSendHook("frozen", func(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return nil, errors.New("denom is frozen")
+	})
+	suite.Require().Panics(func() {
+		suite.bankKeeper.RegisterFactoryDenomSendHook("frozen", nil)
+	})
+
+	_, err = suite.msgServer.SetDenomSendHook(suite.ctx, banktypes.NewMsgSetDenomSendHook(creator.String(), denom, "frozen"))
+	suite.Require().NoError(err)
+	err = suite.bankKeeper.SendCoins(suite.ctx, other, creator, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorContains(err, "denom is frozen")
+
+	_, err = suite.msgServer.SetDenomSendHook(suite.ctx, banktypes.NewMsgSetDenomSendHook(creator.String(), denom, ""))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.bankKeeper.SendCoins(suite.ctx, other, creator, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+
+	// minted coins are subject to the compliance controls of the denom
+	compliance := banktypes.DenomCompliance{Issuer: creator.String(), Treasury: creator.String(), AllowlistEnabled: true}
+	suite.Require().NoError(suite.bankKeeper.SetDenomCompliance(suite.ctx, denom, compliance))
+	_, err = suite.msgServer.Mint(suite.ctx, banktypes.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 10), other.String()))
+	suite.Require().ErrorIs(err, banktypes.ErrNotAllowlisted)
+	suite.Require().NoError(suite.bankKeeper.SetFrozen(suite.ctx, denom, creator, true))
+	_, err = suite.msgServer.Mint(suite.ctx, banktypes.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 10), ""))
+	suite.Require().ErrorIs(err, banktypes.ErrAddressFrozen)
+	suite.Require().NoError(suite.bankKeeper.SetDenomCompliance(suite.ctx, denom, banktypes.DenomCompliance{}))
+
+	// metadata
+	metadata := banktypes.Metadata{
+		Description: "factory token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "token", Exponent: 6},
+		},
+		Base:    denom,
+		Display: "token",
+		Name:    "token",
+		Symbol:  "TKN",
+	}
+	_, err = suite.msgServer.SetDenomMetadata(suite.ctx, banktypes.NewMsgSetDenomMetadata(other.String(), metadata))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.SetDenomMetadata(suite.ctx, banktypes.NewMsgSetDenomMetadata(creator.String(), metadata))
+	suite.Require().NoError(err)
+	got, found := suite.bankKeeper.GetDenomMetaData(suite.ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata, got)
+
+	// admin changes
+	_, err = suite.msgServer.ChangeAdmin(suite.ctx, banktypes.NewMsgChangeAdmin(creator.String(), denom, other.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(suite.ctx, banktypes.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 1), ""))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.ChangeAdmin(suite.ctx, banktypes.NewMsgChangeAdmin(other.String(), denom, ""))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(suite.ctx, banktypes.NewMsgMint(other.String(), sdk.NewInt64Coin(denom, 1), ""))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// queries
+	authRes, err := suite.queryClient.DenomAuthorityMetadata(suite.ctx, &banktypes.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(banktypes.DenomAuthorityMetadata{}, authRes.AuthorityMetadata)
+
+	denomsRes, err := suite.queryClient.DenomsFromCreator(suite.ctx, &banktypes.QueryDenomsFromCreatorRequest{Creator: creator.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom}, denomsRes.Denoms)
+
+	denomsRes, err = suite.queryClient.DenomsFromCreator(suite.ctx, &banktypes.QueryDenomsFromCreatorRequest{Creator: other.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(denomsRes.Denoms)
+}
+
+func (suite *KeeperTestSuite) TestMsgDenomCompliance() {
+	issuer, treasury, holder, other := accAddrs[0], accAddrs[1], accAddrs[2], accAddrs[3]
+	denom := fooDenom
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+
+	suite.mockFundAccount(holder)
+	suite.Require().NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, holder, sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), mintAcc.GetAddress()).Return(mintAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	compliance := banktypes.DenomCompliance{Issuer: issuer.String(), Treasury: treasury.String()}
+
+	// only the authority sets the compliance controls of a denom without issuer
+	_, err := suite.msgServer.SetDenomCompliance(suite.ctx, banktypes.NewMsgSetDenomCompliance(issuer.String(), denom, compliance))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.Freeze(suite.ctx, banktypes.NewMsgFreeze(issuer.String(), denom, holder.String()))
+	suite.Require().ErrorIs(err, banktypes.ErrNoDenomCompliance)
+	_, err = suite.msgServer.SetDenomCompliance(suite.ctx, banktypes.NewMsgSetDenomCompliance(suite.bankKeeper.GetAuthority(), denom, compliance))
+	suite.Require().NoError(err)
+
+	// only the issuer freezes
+	_, err = suite.msgServer.Freeze(suite.ctx, banktypes.NewMsgFreeze(other.String(), denom, holder.String()))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.Freeze(suite.ctx, banktypes.NewMsgFreeze(issuer.String(), denom, holder.String()))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.bankKeeper.IsFrozen(suite.ctx, denom, holder))
+
+	// frozen senders and recipients cannot transfer the denom, but other denoms
+	err = suite.bankKeeper.SendCoins(suite.ctx, holder, other, coins)
+	suite.Require().ErrorIs(err, banktypes.ErrAddressFrozen)
+	suite.Require().NoError(suite.bankKeeper.SendCoins(suite.ctx, holder, other, sdk.NewCoins(newBarCoin(10))))
+
+	_, err = suite.msgServer.Unfreeze(suite.ctx, banktypes.NewMsgUnfreeze(issuer.String(), denom, holder.String()))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.bankKeeper.SendCoins(suite.ctx, holder, other, coins))
+
+	_, err = suite.msgServer.Freeze(suite.ctx, banktypes.NewMsgFreeze(issuer.String(), denom, other.String()))
+	suite.Require().NoError(err)
+	err = suite.bankKeeper.SendCoins(suite.ctx, holder, other, coins)
+	suite.Require().ErrorIs(err, banktypes.ErrAddressFrozen)
+
+	// frozen coins are clawed back to the treasury
+	_, err = suite.msgServer.Clawback(suite.ctx, banktypes.NewMsgClawback(other.String(), other.String(), sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.Clawback(suite.ctx, banktypes.NewMsgClawback(issuer.String(), other.String(), sdk.NewInt64Coin(denom, 20)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	_, err = suite.msgServer.Clawback(suite.ctx, banktypes.NewMsgClawback(issuer.String(), other.String(), sdk.NewInt64Coin(denom, 10)))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.bankKeeper.GetBalance(suite.ctx, other, denom).IsZero())
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 10), suite.bankKeeper.GetBalance(suite.ctx, treasury, denom))
+
+	// blocked addresses and module accounts are not clawed back
+	_, err = suite.msgServer.Clawback(suite.ctx, banktypes.NewMsgClawback(issuer.String(), accAddrs[4].String(), sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.Clawback(suite.ctx, banktypes.NewMsgClawback(issuer.String(), mintAcc.GetAddress().String(), sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the issuer enables the allowlist
+	compliance.AllowlistEnabled = true
+	_, err = suite.msgServer.SetDenomCompliance(suite.ctx, banktypes.NewMsgSetDenomCompliance(issuer.String(), denom, compliance))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Unfreeze(suite.ctx, banktypes.NewMsgUnfreeze(issuer.String(), denom, other.String()))
+	suite.Require().NoError(err)
+	err = suite.bankKeeper.SendCoins(suite.ctx, holder, other, coins)
+	suite.Require().ErrorIs(err, banktypes.ErrNotAllowlisted)
+	suite.Require().NoError(suite.bankKeeper.SendCoins(suite.ctx, holder, issuer, coins))
+
+	_, err = suite.msgServer.SetAllowlisted(suite.ctx, banktypes.NewMsgSetAllowlisted(issuer.String(), denom, other.String(), true))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.bankKeeper.SendCoins(suite.ctx, holder, other, coins))
+
+	// the allowlist applies to every output of a multi-send
+	input := banktypes.NewInput(holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 20)))
+	outputs := []banktypes.Output{banktypes.NewOutput(other, coins), banktypes.NewOutput(treasury, coins)}
+	suite.Require().NoError(suite.bankKeeper.InputOutputCoins(suite.ctx, input, outputs))
+
+	_, err = suite.msgServer.SetAllowlisted(suite.ctx, banktypes.NewMsgSetAllowlisted(issuer.String(), denom, other.String(), false))
+	suite.Require().NoError(err)
+	err = suite.bankKeeper.InputOutputCoins(suite.ctx, input, outputs)
+	suite.Require().ErrorIs(err, banktypes.ErrNotAllowlisted)
+
+	// queries
+	complianceRes, err := suite.queryClient.DenomCompliance(suite.ctx, &banktypes.QueryDenomComplianceRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(compliance, complianceRes.Compliance)
+
+	_, err = suite.queryClient.DenomCompliance(suite.ctx, &banktypes.QueryDenomComplianceRequest{Denom: barDenom})
+	suite.Require().ErrorContains(err, banktypes.ErrNoDenomCompliance.Error())
+
+	_, err = suite.msgServer.Freeze(suite.ctx, banktypes.NewMsgFreeze(issuer.String(), denom, holder.String()))
+	suite.Require().NoError(err)
+	frozenRes, err := suite.queryClient.FrozenAddresses(suite.ctx, &banktypes.QueryFrozenAddressesRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{holder.String()}, frozenRes.Addresses)
+
+	allowlistedRes, err := suite.queryClient.AllowlistedAddresses(suite.ctx, &banktypes.QueryAllowlistedAddressesRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Empty(allowlistedRes.Addresses)
+
+	// removing the compliance controls clears the frozen addresses
+	_, err = suite.msgServer.SetDenomCompliance(suite.ctx, banktypes.NewMsgSetDenomCompliance(issuer.String(), denom, banktypes.DenomCompliance{}))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.bankKeeper.IsFrozen(suite.ctx, denom, holder))
+	suite.Require().NoError(suite.bankKeeper.SendCoins(suite.ctx, holder, other, coins))
+	suite.Require().Empty(suite.bankKeeper.GetAllDenomCompliance(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestScheduledSendLimits() {
+	sender, recipient := accAddrs[0], accAddrs[1]
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	now := ctx.BlockTime()
+	amount := sdk.NewCoins(newFooCoin(10))
+
+	// the sender is funded after a failed execution
+	suite.mockFundAccount(sender)
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	params := banktypes.DefaultParams()
+	params.MinScheduledSendPeriod = time.Hour
+	params.MaxScheduledSendCount = 5
+	params.MaxScheduledSendsPerSender = 2
+	suite.Require().NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	// the period, count and pending scheduled sends of the sender are capped
+	_, err := suite.msgServer.ScheduleSend(ctx, banktypes.NewMsgScheduleSend(sender.String(), recipient.String(), amount, now, time.Minute, 2))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = suite.msgServer.ScheduleSend(ctx, banktypes.NewMsgScheduleSend(sender.String(), recipient.String(), amount, now, time.Hour, 6))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	res, err := suite.msgServer.ScheduleSend(ctx, banktypes.NewMsgScheduleSend(sender.String(), recipient.String(), amount, now, time.Hour, 5))
+	suite.Require().NoError(err)
+	id := res.Id
+	_, err = suite.msgServer.ScheduleSend(ctx, banktypes.NewMsgScheduleSend(sender.String(), recipient.String(), amount, now.Add(time.Hour), 0, 1))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ScheduleSend(ctx, banktypes.NewMsgScheduleSend(sender.String(), recipient.String(), amount, now.Add(time.Hour), 0, 1))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the executions missed are merged into the next one, at the block time
+	ctx = ctx.WithBlockTime(now.Add(5 * time.Hour))
+	suite.Require().NoError(suite.bankKeeper.ExecuteScheduledSends(ctx))
+	scheduledSend, err := suite.bankKeeper.GetScheduledSend(ctx, id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ctx.BlockTime(), scheduledSend.NextExecutionTime)
+	suite.Require().Equal(uint64(4), scheduledSend.Remaining)
+	suite.Require().Equal(uint32(1), scheduledSend.Failures)
+
+	// a successful execution resets the failures
+	suite.Require().NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, sender, amount))
+	suite.Require().NoError(suite.bankKeeper.ExecuteScheduledSends(ctx))
+	suite.Require().Equal(newFooCoin(10), suite.bankKeeper.GetBalance(ctx, recipient, fooDenom))
+	scheduledSend, err = suite.bankKeeper.GetScheduledSend(ctx, id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ctx.BlockTime().Add(time.Hour), scheduledSend.NextExecutionTime)
+	suite.Require().Equal(uint32(0), scheduledSend.Failures)
+
+	// the scheduled send is canceled after consecutive failed executions
+	for i := 1; i <= banktypes.MaxScheduledSendFailures; i++ {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(suite.bankKeeper.ExecuteScheduledSends(ctx))
+	}
+	_, err = suite.bankKeeper.GetScheduledSend(ctx, id)
+	suite.Require().ErrorIs(err, banktypes.ErrScheduledSendNotFound)
+}
+
+func (suite *KeeperTestSuite) TestMsgScheduledSends() {
+	sender, recipient, other, blocked := accAddrs[0], accAddrs[1], accAddrs[2], accAddrs[4]
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	now := ctx.BlockTime()
+	amount := sdk.NewCoins(newFooCoin(10))
+
+	suite.mockFundAccount(sender)
+	suite.Require().NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, sender, sdk.NewCoins(newFooCoin(25))))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	params := banktypes.DefaultParams()
+	params.MaxScheduledSendsPerBlock = 1
+	suite.Require().NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	// invalid schedules
+	_, err := suite.msgServer.ScheduleSend(ctx, banktypes.NewMsgScheduleSend(sender.String(), recipient.String(), amount, now, time.Hour, 0))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = suite.msgServer.ScheduleSend(ctx, banktypes.NewMsgScheduleSend(sender.String(), recipient.String(), amount, now, 0, 2))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = suite.msgServer.ScheduleSend(ctx, banktypes.NewMsgScheduleSend(sender.String(), recipient.String(), sdk.Coins{}, now, time.Hour, 1))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = suite.msgServer.ScheduleSend(ctx, banktypes.NewMsgScheduleSend(sender.String(), blocked.String(), amount, now, time.Hour, 1))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// a recurring send starting in an hour and a one-off send due now
+	res, err := suite.msgServer.ScheduleSend(ctx, banktypes.NewMsgScheduleSend(sender.String(), recipient.String(), amount, now.Add(time.Hour), time.Hour, 3))
+	suite.Require().NoError(err)
+	recurringID := res.Id
+	res, err = suite.msgServer.ScheduleSend(ctx, banktypes.NewMsgScheduleSend(sender.String(), other.String(), sdk.NewCoins(newFooCoin(5)), now, 0, 1))
+	suite.Require().NoError(err)
+	oneOffID := res.Id
+
+	// one send is executed per block
+	suite.Require().NoError(suite.bankKeeper.ExecuteScheduledSends(ctx))
+	suite.Require().Equal(newFooCoin(5), suite.bankKeeper.GetBalance(ctx, other, fooDenom))
+	_, err = suite.bankKeeper.GetScheduledSend(ctx, oneOffID)
+	suite.Require().ErrorIs(err, banktypes.ErrScheduledSendNotFound)
+
+	ctx = ctx.WithBlockTime(now.Add(90 * time.Minute))
+	suite.Require().NoError(suite.bankKeeper.ExecuteScheduledSends(ctx))
+	suite.Require().NoError(suite.bankKeeper.ExecuteScheduledSends(ctx))
+	suite.Require().Equal(newFooCoin(10), suite.bankKeeper.GetBalance(ctx, recipient, fooDenom))
+
+	scheduledSend, err := suite.bankKeeper.GetScheduledSend(ctx, recurringID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), scheduledSend.Remaining)
+	suite.Require().Equal(now.Add(2*time.Hour), scheduledSend.NextExecutionTime)
+
+	// an execution without enough funds is skipped
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.bankKeeper.SendCoins(ctx, sender, other, sdk.NewCoins(newFooCoin(5))))
+	suite.Require().NoError(suite.bankKeeper.ExecuteScheduledSends(ctx))
+	suite.Require().Equal(newFooCoin(10), suite.bankKeeper.GetBalance(ctx, recipient, fooDenom))
+
+	var failed bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != banktypes.EventTypeScheduledSend {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			failed = failed || attr.Key == banktypes.AttributeKeyError
+		}
+	}
+	suite.Require().True(failed)
+
+	// queries
+	queryRes, err := suite.queryClient.ScheduledSendsBySender(ctx, &banktypes.QueryScheduledSendsBySenderRequest{Sender: sender.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(queryRes.ScheduledSends, 1)
+	suite.Require().Equal(uint64(1), queryRes.ScheduledSends[0].Remaining)
+
+	genState := suite.bankKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(queryRes.ScheduledSends, genState.ScheduledSends)
+	suite.Require().Equal(uint64(2), genState.NextScheduledSendId)
+	suite.Require().NoError(genState.Validate())
+
+	// only the sender cancels
+	_, err = suite.msgServer.CancelScheduledSend(ctx, banktypes.NewMsgCancelScheduledSend(other.String(), recurringID))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.CancelScheduledSend(ctx, banktypes.NewMsgCancelScheduledSend(sender.String(), recurringID))
+	suite.Require().NoError(err)
+
+	_, err = suite.queryClient.ScheduledSend(ctx, &banktypes.QueryScheduledSendRequest{Id: recurringID})
+	suite.Require().ErrorContains(err, banktypes.ErrScheduledSendNotFound.Error())
+	queryRes, err = suite.queryClient.ScheduledSendsBySender(ctx, &banktypes.QueryScheduledSendsBySenderRequest{Sender: sender.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(queryRes.ScheduledSends)
+}
@@ -0,0 +1,271 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ScheduleSend schedules count transfers of amount from the sender to the
+// recipient, the first one at startTime and the following ones every period,
+// and returns the identifier of the scheduled send. The coins are not escrowed:
+// they are sent from the balance of the sender at each execution.
+//
+// The period of a recurring send must be at least MinScheduledSendPeriod, the
+// count at most MaxScheduledSendCount, and the sender can have at most
+// MaxScheduledSendsPerSender pending scheduled sends.
+func (k BaseKeeper) ScheduleSend(
+	ctx context.Context,
+	sender, recipient sdk.AccAddress,
+	amount sdk.Coins,
+	startTime time.Time,
+	period time.Duration,
+	count uint64,
+) (uint64, error) {
+	if err := types.ValidateSchedule(amount, period, count); err != nil {
+		return 0, err
+	}
+
+	params := k.GetParams(ctx)
+	if count > 1 && period < params.MinScheduledSendPeriod {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "period %s is shorter than the minimum %s", period, params.MinScheduledSendPeriod)
+	}
+
+	if count > params.MaxScheduledSendCount {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "count %d exceeds the maximum %d", count, params.MaxScheduledSendCount)
+	}
+
+	pending, err := k.countScheduledSends(ctx, sender)
+	if err != nil {
+		return 0, err
+	}
+
+	if pending >= uint64(params.MaxScheduledSendsPerSender) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sender has %d pending scheduled sends, the maximum is %d", pending, params.MaxScheduledSendsPerSender)
+	}
+
+	senderStr, err := k.ak.AddressCodec().BytesToString(sender)
+	if err != nil {
+		return 0, err
+	}
+
+	recipientStr, err := k.ak.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := k.ScheduledSendSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, k.setScheduledSend(ctx, types.ScheduledSend{
+		Id:                id,
+		Sender:            senderStr,
+		Recipient:         recipientStr,
+		Amount:            amount,
+		NextExecutionTime: startTime,
+		Period:            period,
+		Remaining:         count,
+	})
+}
+
+// GetScheduledSend returns a scheduled send by its identifier.
+func (k BaseKeeper) GetScheduledSend(ctx context.Context, id uint64) (types.ScheduledSend, error) {
+	scheduledSend, err := k.ScheduledSends.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ScheduledSend{}, errorsmod.Wrapf(types.ErrScheduledSendNotFound, "id %d", id)
+	}
+
+	return scheduledSend, err
+}
+
+// CancelScheduledSend removes a scheduled send and its remaining executions.
+func (k BaseKeeper) CancelScheduledSend(ctx context.Context, id uint64) error {
+	scheduledSend, err := k.GetScheduledSend(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return k.removeScheduledSend(ctx, scheduledSend)
+}
+
+// GetAllScheduledSends returns all the pending scheduled sends.
+func (k BaseKeeper) GetAllScheduledSends(ctx context.Context) []types.ScheduledSend {
+	var scheduledSends []types.ScheduledSend
+	err := k.ScheduledSends.Walk(ctx, nil, func(_ uint64, scheduledSend types.ScheduledSend) (bool, error) {
+		scheduledSends = append(scheduledSends, scheduledSend)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return scheduledSends
+}
+
+// ExecuteScheduledSends executes the scheduled sends whose next execution time
+// is not after the block time, at most MaxScheduledSendsPerBlock of them in
+// the order of their execution time, should be called at end blocker. Each
+// scheduled send is executed at most once per block, and its next execution
+// is one period after the current one, or at the block time if it is later:
+// the executions it missed are not skipped nor merged, but delayed, one per
+// block, until it catches up with its schedule.
+//
+// An execution failing, e.g. because the sender has not enough funds, is
+// skipped: it is not retried and counts as one of the executions of the
+// scheduled send. The scheduled send is canceled after
+// MaxScheduledSendFailures consecutive failed executions.
+func (k BaseKeeper) ExecuteScheduledSends(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	limit := k.GetParams(ctx).MaxScheduledSendsPerBlock
+	if limit == 0 {
+		return nil
+	}
+
+	// the queue is updated by the executions, collect the due ones first
+	var due []collections.Pair[time.Time, uint64]
+	err := k.ScheduledSendQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		if key.K1().After(sdkCtx.BlockTime()) {
+			return true, nil
+		}
+
+		due = append(due, key)
+		return len(due) >= int(limit), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		scheduledSend, err := k.ScheduledSends.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		// a failed execution is not committed
+		cacheCtx, write := sdkCtx.CacheContext()
+		execErr := k.executeScheduledSend(cacheCtx, scheduledSend)
+		if execErr != nil {
+			scheduledSend.Failures++
+		} else {
+			write()
+			scheduledSend.Failures = 0
+		}
+
+		scheduledSend.Remaining--
+		if scheduledSend.Failures >= types.MaxScheduledSendFailures {
+			scheduledSend.Remaining = 0
+		}
+
+		event := sdk.NewEvent(
+			types.EventTypeScheduledSend,
+			sdk.NewAttribute(types.AttributeKeyScheduledSendID, strconv.FormatUint(scheduledSend.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, scheduledSend.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, scheduledSend.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, scheduledSend.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRemaining, strconv.FormatUint(scheduledSend.Remaining, 10)),
+		)
+		if execErr != nil {
+			event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyError, execErr.Error()))
+		}
+		sdkCtx.EventManager().EmitEvent(event)
+
+		if err := k.ScheduledSendQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		if scheduledSend.Remaining == 0 {
+			if err := k.removeScheduledSend(ctx, scheduledSend); err != nil {
+				return err
+			}
+			continue
+		}
+
+		scheduledSend.NextExecutionTime = scheduledSend.NextExecutionTime.Add(scheduledSend.Period)
+		if scheduledSend.NextExecutionTime.Before(sdkCtx.BlockTime()) {
+			scheduledSend.NextExecutionTime = sdkCtx.BlockTime()
+		}
+		if err := k.setScheduledSend(ctx, scheduledSend); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// countScheduledSends returns the number of pending scheduled sends of a
+// sender.
+func (k BaseKeeper) countScheduledSends(ctx context.Context, sender sdk.AccAddress) (uint64, error) {
+	var count uint64
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](sender)
+	err := k.SenderScheduledSends.Walk(ctx, rng, func(_ collections.Pair[sdk.AccAddress, uint64]) (bool, error) {
+		count++
+		return false, nil
+	})
+
+	return count, err
+}
+
+func (k BaseKeeper) executeScheduledSend(ctx context.Context, scheduledSend types.ScheduledSend) error {
+	sender, err := k.ak.AddressCodec().StringToBytes(scheduledSend.Sender)
+	if err != nil {
+		return err
+	}
+
+	recipient, err := k.ak.AddressCodec().StringToBytes(scheduledSend.Recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.IsSendEnabledCoins(ctx, scheduledSend.Amount...); err != nil {
+		return err
+	}
+
+	return k.SendCoins(ctx, sender, recipient, scheduledSend.Amount)
+}
+
+// setScheduledSend sets a scheduled send and indexes it by next execution
+// time and by sender.
+func (k BaseKeeper) setScheduledSend(ctx context.Context, scheduledSend types.ScheduledSend) error {
+	sender, err := k.ak.AddressCodec().StringToBytes(scheduledSend.Sender)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ScheduledSends.Set(ctx, scheduledSend.Id, scheduledSend); err != nil {
+		return err
+	}
+
+	if err := k.ScheduledSendQueue.Set(ctx, collections.Join(scheduledSend.NextExecutionTime, scheduledSend.Id)); err != nil {
+		return err
+	}
+
+	return k.SenderScheduledSends.Set(ctx, collections.Join(sdk.AccAddress(sender), scheduledSend.Id))
+}
+
+// removeScheduledSend removes a scheduled send and its indexes.
+func (k BaseKeeper) removeScheduledSend(ctx context.Context, scheduledSend types.ScheduledSend) error {
+	sender, err := k.ak.AddressCodec().StringToBytes(scheduledSend.Sender)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ScheduledSends.Remove(ctx, scheduledSend.Id); err != nil {
+		return err
+	}
+
+	if err := k.ScheduledSendQueue.Remove(ctx, collections.Join(scheduledSend.NextExecutionTime, scheduledSend.Id)); err != nil {
+		return err
+	}
+
+	return k.SenderScheduledSends.Remove(ctx, collections.Join(sdk.AccAddress(sender), scheduledSend.Id))
+}
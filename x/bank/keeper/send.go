@@ -66,6 +66,10 @@ type BaseSendKeeper struct {
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
 
+	// recipients whose credits are deferred to the end of the block, keyed by
+	// the address bytes
+	virtualRecipients map[string]bool
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -134,7 +138,7 @@ func (k BaseSendKeeper) SetParams(ctx context.Context, params types.Params) erro
 		k.SetAllSendEnabled(ctx, params.SendEnabled)
 
 		// override params without SendEnabled
-		params = types.NewParams(params.DefaultSendEnabled)
+		params.SendEnabled = nil
 	}
 	return k.Params.Set(ctx, params)
 }
@@ -208,7 +212,9 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 	}
 
 	for _, out := range sending {
-		if err := k.addCoins(ctx, out.AddressBz, out.Coins); err != nil {
+		if k.IsVirtualRecipient(out.AddressBz) {
+			k.addVirtualCoins(ctx, out.AddressBz, out.Coins)
+		} else if err := k.addCoins(ctx, out.AddressBz, out.Coins); err != nil {
 			return err
 		}
 		sdkCtx.EventManager().EmitEvent(
@@ -225,6 +231,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
+// The coins sent to a virtual recipient are credited at the end of the block.
 // An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	if !amt.IsValid() {
@@ -242,12 +249,19 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
-	err = k.addCoins(ctx, toAddr, amt)
-	if err != nil {
-		return err
+	// the credits of virtual recipients are added to their balance, and their
+	// account created, by CreditVirtualAccounts at the end of the block
+	if k.IsVirtualRecipient(toAddr) {
+		k.addVirtualCoins(ctx, toAddr, amt)
+	} else {
+		err = k.addCoins(ctx, toAddr, amt)
+		if err != nil {
+			return err
+		}
+
+		k.ensureAccountCreated(ctx, toAddr)
 	}
 
-	k.ensureAccountCreated(ctx, toAddr)
 	if err := k.emitSendCoinsEvents(ctx, fromAddr, toAddr, amt); err != nil {
 		return err
 	}
@@ -302,9 +316,20 @@ func (k BaseSendKeeper) emitSendCoinsEvents(ctx context.Context, fromAddr, toAdd
 //
 // A coin_spent event is emitted after the operation.
 func (k BaseSendKeeper) subUnlockedCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	// the coins credited to a virtual recipient in the current transaction are
+	// not part of its balance yet, and are spent first
+	fromBalance := amt
+	if k.IsVirtualRecipient(addr) {
+		var err error
+		fromBalance, err = k.subPendingVirtualCoins(ctx, addr, amt)
+		if err != nil {
+			return err
+		}
+	}
+
 	lockedCoins := k.LockedCoins(ctx, addr)
 
-	for _, coin := range amt {
+	for _, coin := range fromBalance {
 		balance := k.GetBalance(ctx, addr, coin.Denom)
 		ok, locked := lockedCoins.Find(coin.Denom)
 		if !ok {
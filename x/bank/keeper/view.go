@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
@@ -67,6 +68,17 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	FactoryDenoms collections.Map[string, types.DenomAuthorityMetadata]
+	CreatorDenoms collections.KeySet[collections.Pair[sdk.AccAddress, string]]
+
+	DenomCompliance      collections.Map[string, types.DenomCompliance]
+	FrozenAddresses      collections.KeySet[collections.Pair[string, sdk.AccAddress]]
+	AllowlistedAddresses collections.KeySet[collections.Pair[string, sdk.AccAddress]]
+
+	ScheduledSends       collections.Map[uint64, types.ScheduledSend]
+	ScheduledSendQueue   collections.KeySet[collections.Pair[time.Time, uint64]]
+	SenderScheduledSends collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	ScheduledSendSeq     collections.Sequence
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -82,6 +94,17 @@ func NewBaseViewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService,
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FactoryDenoms: collections.NewMap(sb, types.FactoryDenomsPrefix, "factory_denoms", collections.StringKey, codec.CollValue[types.DenomAuthorityMetadata](cdc)),
+		CreatorDenoms: collections.NewKeySet(sb, types.CreatorDenomsPrefix, "creator_denoms", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)),
+
+		DenomCompliance:      collections.NewMap(sb, types.DenomCompliancePrefix, "denom_compliance", collections.StringKey, codec.CollValue[types.DenomCompliance](cdc)),
+		FrozenAddresses:      collections.NewKeySet(sb, types.FrozenAddressesPrefix, "frozen_addresses", collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey)),
+		AllowlistedAddresses: collections.NewKeySet(sb, types.AllowlistedAddressesPrefix, "allowlisted_addresses", collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey)),
+
+		ScheduledSends:       collections.NewMap(sb, types.ScheduledSendsPrefix, "scheduled_sends", collections.Uint64Key, codec.CollValue[types.ScheduledSend](cdc)),
+		ScheduledSendQueue:   collections.NewKeySet(sb, types.ScheduledSendQueuePrefix, "scheduled_send_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		SenderScheduledSends: collections.NewKeySet(sb, types.SenderScheduledSendsPrefix, "sender_scheduled_sends", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		ScheduledSendSeq:     collections.NewSequence(sb, types.ScheduledSendSeqKey, "scheduled_send_seq"),
 	}
 
 	schema, err := sb.Build()
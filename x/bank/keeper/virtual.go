@@ -88,6 +88,12 @@ func (k BaseSendKeeper) SendCoinsFromVirtual(ctx context.Context, fromAddr, toAd
 	return nil
 }
 
+// IsVirtualRecipient returns whether the coins sent to an address by SendCoins
+// and InputOutputCoins are credited at the end of the block.
+func (k BaseSendKeeper) IsVirtualRecipient(addr sdk.AccAddress) bool {
+	return k.virtualRecipients[string(addr)]
+}
+
 func (k BaseSendKeeper) addVirtualCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	store := sdkCtx.ObjectStore(k.objStoreKey)
@@ -140,8 +146,64 @@ func (k BaseSendKeeper) subVirtualCoins(ctx context.Context, addr sdk.AccAddress
 	return nil
 }
 
+// pendingVirtualCoins returns the coins credited to the given virtual recipient
+// in the current transaction, which are added to its balance at the end of the
+// block.
+func (k BaseSendKeeper) pendingVirtualCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.ObjectStore(k.objStoreKey)
+
+	key := make([]byte, len(addr)+8)
+	copy(key, addr)
+	binary.BigEndian.PutUint64(key[len(addr):], uint64(sdkCtx.TxIndex()))
+
+	value := store.Get(key)
+	if value == nil {
+		return sdk.NewCoins()
+	}
+	return value.(sdk.Coins)
+}
+
+// subPendingVirtualCoins spends from amt the coins credited to the given virtual
+// recipient in the current transaction, e.g. to refund part of a fee sent to
+// the fee collector, and returns the remaining coins to spend from its balance.
+func (k BaseSendKeeper) subPendingVirtualCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
+	spent := k.pendingVirtualCoins(ctx, addr).Min(amt)
+	if spent.IsZero() {
+		return amt, nil
+	}
+
+	if err := k.subVirtualCoins(ctx, addr, spent); err != nil {
+		return nil, err
+	}
+	return amt.Sub(spent...), nil
+}
+
+// SpendableCoins returns the total balances of spendable coins for an account
+// by address, including for a virtual recipient the coins credited to it in the
+// current transaction.
+func (k BaseSendKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	spendable := k.BaseViewKeeper.SpendableCoins(ctx, addr)
+	if k.IsVirtualRecipient(addr) {
+		spendable = spendable.Add(k.pendingVirtualCoins(ctx, addr)...)
+	}
+	return spendable
+}
+
+// SpendableCoin returns the balance of specific denomination of spendable coins
+// for an account by address, including for a virtual recipient the coins
+// credited to it in the current transaction.
+func (k BaseSendKeeper) SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	spendable := k.BaseViewKeeper.SpendableCoin(ctx, addr, denom)
+	if k.IsVirtualRecipient(addr) {
+		spendable = spendable.AddAmount(k.pendingVirtualCoins(ctx, addr).AmountOf(denom))
+	}
+	return spendable
+}
+
 // CreditVirtualAccounts sum up the transient coins and add them to the real account,
-// should be called at end blocker.
+// should be called at end blocker. The credited coins are removed from the
+// transient state, so that they are not counted twice by GetTotalVirtualCoins.
 func (k BaseSendKeeper) CreditVirtualAccounts(ctx context.Context) error {
 	// No-op if we're not using the objStore to accumulate to module accounts
 	if k.objStoreKey == nil {
@@ -166,23 +228,55 @@ func (k BaseSendKeeper) CreditVirtualAccounts(ctx context.Context) error {
 		return nil
 	}
 
-	it := store.Iterator(nil, nil)
-	defer it.Close()
-	for ; it.Valid(); it.Next() {
-		if len(it.Key()) <= 8 {
-			return fmt.Errorf("unexpected key length: %s", hex.EncodeToString(it.Key()))
-		}
+	// the store can't be written while iterating, collect the keys to delete
+	var credited [][]byte
+	creditAll := func() error {
+		it := store.Iterator(nil, nil)
+		defer it.Close()
+		for ; it.Valid(); it.Next() {
+			if len(it.Key()) <= 8 {
+				return fmt.Errorf("unexpected key length: %s", hex.EncodeToString(it.Key()))
+			}
 
-		addr := it.Key()[:len(it.Key())-8]
-		if !bytes.Equal(toAddr, addr) {
-			if err := flushCurrentAddr(); err != nil {
-				return err
+			addr := it.Key()[:len(it.Key())-8]
+			if !bytes.Equal(toAddr, addr) {
+				if err := flushCurrentAddr(); err != nil {
+					return err
+				}
+				toAddr = addr
 			}
-			toAddr = addr
+
+			sum.Add(it.Value().(sdk.Coins)...)
+			credited = append(credited, bytes.Clone(it.Key()))
 		}
 
+		return flushCurrentAddr()
+	}
+	if err := creditAll(); err != nil {
+		return err
+	}
+
+	for _, key := range credited {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// GetTotalVirtualCoins returns the sum of the coins sent to virtual accounts in
+// the current block, credited by CreditVirtualAccounts at the end of the block.
+// Before it runs, the balances and these coins sum up to the supply.
+func (k BaseSendKeeper) GetTotalVirtualCoins(ctx context.Context) sdk.Coins {
+	if k.objStoreKey == nil {
+		return sdk.NewCoins()
+	}
+	store := sdk.UnwrapSDKContext(ctx).ObjectStore(k.objStoreKey)
+
+	sum := sdk.NewMapCoins(nil)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
 		sum.Add(it.Value().(sdk.Coins)...)
 	}
 
-	return flushCurrentAddr()
+	return sum.ToCoins()
 }
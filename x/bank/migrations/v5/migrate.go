@@ -0,0 +1,29 @@
+package v5
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Migrate from v4 to v5. This includes the addition of the scheduled send
+// params MaxScheduledSendsPerBlock, MinScheduledSendPeriod,
+// MaxScheduledSendCount and MaxScheduledSendsPerSender.
+func Migrate(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.MaxScheduledSendsPerBlock = types.DefaultMaxScheduledSendsPerBlock
+	p.MinScheduledSendPeriod = types.DefaultMinScheduledSendPeriod
+	p.MaxScheduledSendCount = types.DefaultMaxScheduledSendCount
+	p.MaxScheduledSendsPerSender = types.DefaultMaxScheduledSendsPerSender
+	if err = p.Validate(); err != nil {
+		return err
+	}
+
+	return params.Set(ctx, p)
+}
@@ -0,0 +1,38 @@
+package v5
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMigrate(t *testing.T) {
+	kv, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(kv)
+	params := collections.NewItem(sb, collections.NewPrefix(0), "params", colltest.MockValueCodec[types.Params]())
+
+	require.ErrorIs(t, Migrate(ctx, params), collections.ErrNotFound)
+
+	// params of a chain before the scheduled sends
+	paramsUnderTest := types.DefaultParams()
+	paramsUnderTest.MaxScheduledSendsPerBlock = 0
+	paramsUnderTest.MinScheduledSendPeriod = 0
+	paramsUnderTest.MaxScheduledSendCount = 0
+	paramsUnderTest.MaxScheduledSendsPerSender = 0
+	require.NoError(t, params.Set(ctx, paramsUnderTest))
+
+	require.NoError(t, Migrate(ctx, params))
+
+	seenParams, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultMaxScheduledSendsPerBlock, seenParams.MaxScheduledSendsPerBlock)
+	require.Equal(t, types.DefaultMinScheduledSendPeriod, seenParams.MinScheduledSendPeriod)
+	require.Equal(t, types.DefaultMaxScheduledSendCount, seenParams.MaxScheduledSendCount)
+	require.Equal(t, types.DefaultMaxScheduledSendsPerSender, seenParams.MaxScheduledSendsPerSender)
+	require.True(t, seenParams.DefaultSendEnabled)
+}
@@ -34,7 +34,7 @@ import (
 )
 
 // ConsensusVersion defines the current x/bank module consensus version.
-const ConsensusVersion = 4
+const ConsensusVersion = 5
 
 var (
 	_ module.AppModuleBasic      = AppModule{}
@@ -67,13 +67,23 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 }
 
 // ValidateGenesis performs genesis state validation for the bank module.
-func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+func (ab AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
 	var data types.GenesisState
 	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
 
-	return data.Validate()
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	for _, ss := range data.ScheduledSends {
+		if err := ss.Validate(ab.ac); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the bank module.
@@ -111,6 +121,13 @@ func (am AppModule) IsAppModule() {}
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	if baseKeeper, ok := am.keeper.(keeper.BaseKeeper); ok {
+		m := keeper.NewMigrator(baseKeeper)
+		if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+			panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+		}
+	}
 }
 
 // NewAppModule creates a new AppModule object
@@ -182,6 +199,11 @@ func (am AppModule) WeightedOperationsX(weights simsx.WeightSource, reg simsx.Re
 }
 
 func (am AppModule) EndBlock(ctx context.Context) error {
+	// scheduled sends to virtual recipients are credited in the same block
+	if err := am.keeper.ExecuteScheduledSends(ctx); err != nil {
+		return err
+	}
+
 	return am.keeper.CreditVirtualAccounts(ctx)
 }
 
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// BalanceIndex defines the off-consensus index of the balance changes served
+// by the BalanceAtHeight and BalanceHistory queries.
+type BalanceIndex interface {
+	// BalanceAtHeight returns the balance of a coin of an account at a height.
+	BalanceAtHeight(addr sdk.AccAddress, denom string, height int64) (sdk.Coin, error)
+	// BalanceHistory returns the changes of the balance of a coin of an
+	// account, ordered by height.
+	BalanceHistory(addr sdk.AccAddress, denom string, pageReq *query.PageRequest) ([]BalanceChange, *query.PageResponse, error)
+}
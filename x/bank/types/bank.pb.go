@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -12,15 +13,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,6 +43,20 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// denom_creation_fee is the fee burned from the creator of a factory denom.
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee"`
+	// max_scheduled_sends_per_block is the maximum number of scheduled sends
+	// executed at the end of a block, the others are executed in the next blocks.
+	MaxScheduledSendsPerBlock uint32 `protobuf:"varint,4,opt,name=max_scheduled_sends_per_block,json=maxScheduledSendsPerBlock,proto3" json:"max_scheduled_sends_per_block,omitempty"`
+	// min_scheduled_send_period is the minimum period between two executions of
+	// a scheduled send.
+	MinScheduledSendPeriod time.Duration `protobuf:"bytes,5,opt,name=min_scheduled_send_period,json=minScheduledSendPeriod,proto3,stdduration" json:"min_scheduled_send_period"`
+	// max_scheduled_send_count is the maximum number of executions of a
+	// scheduled send.
+	MaxScheduledSendCount uint64 `protobuf:"varint,6,opt,name=max_scheduled_send_count,json=maxScheduledSendCount,proto3" json:"max_scheduled_send_count,omitempty"`
+	// max_scheduled_sends_per_sender is the maximum number of pending scheduled
+	// sends of a sender.
+	MaxScheduledSendsPerSender uint32 `protobuf:"varint,7,opt,name=max_scheduled_sends_per_sender,json=maxScheduledSendsPerSender,proto3" json:"max_scheduled_sends_per_sender,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +107,41 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxScheduledSendsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxScheduledSendsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMinScheduledSendPeriod() time.Duration {
+	if m != nil {
+		return m.MinScheduledSendPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxScheduledSendCount() uint64 {
+	if m != nil {
+		return m.MaxScheduledSendCount
+	}
+	return 0
+}
+
+func (m *Params) GetMaxScheduledSendsPerSender() uint32 {
+	if m != nil {
+		return m.MaxScheduledSendsPerSender
+	}
+	return 0
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -442,6 +497,348 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// DenomAuthorityMetadata defines the authority of a factory denom, created
+// through MsgCreateDenom.
+type DenomAuthorityMetadata struct {
+	// admin is the account allowed to mint, burn and change the metadata and
+	// the send hook of the denom. An empty admin renounces these permissions.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// send_hook is the name of the send hook, registered on the keeper, run
+	// on every transfer of the denom.
+	SendHook string `protobuf:"bytes,2,opt,name=send_hook,json=sendHook,proto3" json:"send_hook,omitempty"`
+}
+
+func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
+func (m *DenomAuthorityMetadata) String() string { return proto.CompactTextString(m) }
+func (*DenomAuthorityMetadata) ProtoMessage()    {}
+func (*DenomAuthorityMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *DenomAuthorityMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAuthorityMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAuthorityMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAuthorityMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAuthorityMetadata.Merge(m, src)
+}
+func (m *DenomAuthorityMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAuthorityMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAuthorityMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAuthorityMetadata proto.InternalMessageInfo
+
+func (m *DenomAuthorityMetadata) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *DenomAuthorityMetadata) GetSendHook() string {
+	if m != nil {
+		return m.SendHook
+	}
+	return ""
+}
+
+// FactoryDenom defines a factory denom and its authority.
+type FactoryDenom struct {
+	// denom is the factory denom, in the factory/{creator}/{subdenom} format.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// authority_metadata is the authority of the denom.
+	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata"`
+}
+
+func (m *FactoryDenom) Reset()         { *m = FactoryDenom{} }
+func (m *FactoryDenom) String() string { return proto.CompactTextString(m) }
+func (*FactoryDenom) ProtoMessage()    {}
+func (*FactoryDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{8}
+}
+func (m *FactoryDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FactoryDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FactoryDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FactoryDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactoryDenom.Merge(m, src)
+}
+func (m *FactoryDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FactoryDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactoryDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactoryDenom proto.InternalMessageInfo
+
+func (m *FactoryDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FactoryDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
+	if m != nil {
+		return m.AuthorityMetadata
+	}
+	return DenomAuthorityMetadata{}
+}
+
+// BalanceChange defines the change of the balance of a coin of an account in
+// a block, as recorded by the off-consensus balance index.
+type BalanceChange struct {
+	// height is the height of the block changing the balance.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// delta is the net change of the balance in the block.
+	Delta cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=delta,proto3,customtype=cosmossdk.io/math.Int" json:"delta"`
+	// balance is the balance after the block.
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+}
+
+func (m *BalanceChange) Reset()         { *m = BalanceChange{} }
+func (m *BalanceChange) String() string { return proto.CompactTextString(m) }
+func (*BalanceChange) ProtoMessage()    {}
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{9}
+}
+func (m *BalanceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceChange.Merge(m, src)
+}
+func (m *BalanceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceChange proto.InternalMessageInfo
+
+func (m *BalanceChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// DenomCompliance defines the compliance controls of a denom, applied by its
+// issuer to every transfer of the denom.
+type DenomCompliance struct {
+	// issuer is the account allowed to freeze addresses, claw back balances and
+	// update the allowlist of the denom.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// treasury is the recipient of the clawed back coins.
+	Treasury string `protobuf:"bytes,2,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// allowlist_enabled restricts holding the denom to the allowlisted
+	// addresses, the issuer and the treasury.
+	AllowlistEnabled bool `protobuf:"varint,3,opt,name=allowlist_enabled,json=allowlistEnabled,proto3" json:"allowlist_enabled,omitempty"`
+}
+
+func (m *DenomCompliance) Reset()         { *m = DenomCompliance{} }
+func (m *DenomCompliance) String() string { return proto.CompactTextString(m) }
+func (*DenomCompliance) ProtoMessage()    {}
+func (*DenomCompliance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{10}
+}
+func (m *DenomCompliance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomCompliance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomCompliance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomCompliance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomCompliance.Merge(m, src)
+}
+func (m *DenomCompliance) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomCompliance) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomCompliance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomCompliance proto.InternalMessageInfo
+
+func (m *DenomCompliance) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *DenomCompliance) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+func (m *DenomCompliance) GetAllowlistEnabled() bool {
+	if m != nil {
+		return m.AllowlistEnabled
+	}
+	return false
+}
+
+// ScheduledSend defines a transfer executed once or periodically, at the end of
+// the first block after each of its execution times.
+type ScheduledSend struct {
+	// id is the identifier of the scheduled send.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the account the amount is sent from at each execution.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the account the amount is sent to at each execution.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount sent at each execution.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// next_execution_time is the time of the next execution.
+	NextExecutionTime time.Time `protobuf:"bytes,5,opt,name=next_execution_time,json=nextExecutionTime,proto3,stdtime" json:"next_execution_time"`
+	// period is the duration between two executions.
+	Period time.Duration `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period"`
+	// remaining is the number of executions left, including the next one.
+	Remaining uint64 `protobuf:"varint,7,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// failures is the number of consecutive failed executions. The scheduled send
+	// is canceled after MaxScheduledSendFailures of them.
+	Failures uint32 `protobuf:"varint,8,opt,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (m *ScheduledSend) Reset()         { *m = ScheduledSend{} }
+func (m *ScheduledSend) String() string { return proto.CompactTextString(m) }
+func (*ScheduledSend) ProtoMessage()    {}
+func (*ScheduledSend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{11}
+}
+func (m *ScheduledSend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledSend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledSend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledSend.Merge(m, src)
+}
+func (m *ScheduledSend) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledSend) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledSend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledSend proto.InternalMessageInfo
+
+func (m *ScheduledSend) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledSend) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ScheduledSend) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ScheduledSend) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *ScheduledSend) GetNextExecutionTime() time.Time {
+	if m != nil {
+		return m.NextExecutionTime
+	}
+	return time.Time{}
+}
+
+func (m *ScheduledSend) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *ScheduledSend) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+func (m *ScheduledSend) GetFailures() uint32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -450,57 +847,96 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*DenomAuthorityMetadata)(nil), "cosmos.bank.v1beta1.DenomAuthorityMetadata")
+	proto.RegisterType((*FactoryDenom)(nil), "cosmos.bank.v1beta1.FactoryDenom")
+	proto.RegisterType((*BalanceChange)(nil), "cosmos.bank.v1beta1.BalanceChange")
+	proto.RegisterType((*DenomCompliance)(nil), "cosmos.bank.v1beta1.DenomCompliance")
+	proto.RegisterType((*ScheduledSend)(nil), "cosmos.bank.v1beta1.ScheduledSend")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0x13, 0x49,
-	0x14, 0xf6, 0xf8, 0x77, 0xc6, 0x39, 0x9d, 0x6e, 0x62, 0xe9, 0x26, 0x3e, 0xdd, 0xda, 0x72, 0x73,
-	0x8e, 0xef, 0x6c, 0xe7, 0xc7, 0x09, 0x09, 0x37, 0x08, 0x87, 0x5f, 0x2e, 0x10, 0x68, 0xa3, 0x08,
-	0x89, 0xc6, 0x1a, 0x7b, 0x07, 0x7b, 0x95, 0xdd, 0x99, 0xd5, 0xce, 0x6c, 0x88, 0x5b, 0x2a, 0x44,
-	0x45, 0x4d, 0x15, 0x51, 0x21, 0x44, 0xe1, 0x22, 0x3d, 0x6d, 0x94, 0x2a, 0xa2, 0x42, 0x29, 0x02,
-	0x72, 0x0a, 0xe7, 0xcf, 0x40, 0x3b, 0xbb, 0x6b, 0x3b, 0xc2, 0xa1, 0x44, 0xa2, 0xb1, 0xe7, 0xbd,
-	0xef, 0x7b, 0xef, 0x7d, 0xf3, 0xf6, 0xdb, 0x85, 0x5a, 0x8f, 0x0b, 0x9b, 0x8b, 0x46, 0x97, 0xb0,
-	0xbd, 0xc6, 0xfe, 0x46, 0x97, 0x4a, 0xb2, 0xa1, 0x82, 0xba, 0xe3, 0x72, 0xc9, 0xd1, 0x4a, 0x80,
-	0xd7, 0x55, 0x2a, 0xc4, 0x0b, 0xf9, 0x3e, 0xef, 0x73, 0x85, 0x37, 0xfc, 0x53, 0x40, 0x2d, 0xac,
-	0x06, 0xd4, 0x4e, 0x00, 0x84, 0x75, 0x01, 0x34, 0x9b, 0x22, 0xe8, 0x74, 0x4a, 0x8f, 0x9b, 0x2c,
-	0xc4, 0xff, 0x0c, 0x71, 0x5b, 0xf4, 0x1b, 0xfb, 0x1b, 0xfe, 0x5f, 0x08, 0xfc, 0x41, 0x6c, 0x93,
-	0xf1, 0x86, 0xfa, 0x0d, 0x52, 0xe5, 0xb7, 0x00, 0xa6, 0x1f, 0x13, 0x97, 0xd8, 0x02, 0xdd, 0x87,
-	0xcb, 0x82, 0x32, 0xa3, 0x43, 0x19, 0xe9, 0x5a, 0xd4, 0xc0, 0xa0, 0x94, 0xa8, 0xe4, 0x36, 0x4b,
-	0xf5, 0x05, 0x9a, 0xeb, 0x3b, 0x94, 0x19, 0x77, 0x03, 0x5e, 0x2b, 0x8e, 0x81, 0x9e, 0x13, 0xb3,
-	0x04, 0x5a, 0x87, 0x79, 0x83, 0x3e, 0x23, 0x9e, 0x25, 0x3b, 0x57, 0x1a, 0xc6, 0x4b, 0xa0, 0x92,
-	0xd5, 0x51, 0x88, 0xcd, 0xb5, 0x68, 0xfe, 0xfd, 0x6a, 0x32, 0xaa, 0xe2, 0x60, 0x50, 0x4d, 0x18,
-	0x7b, 0x8d, 0x83, 0x60, 0x85, 0x81, 0xb2, 0xf2, 0x36, 0xcc, 0xcd, 0xb1, 0x51, 0x1e, 0xa6, 0x0c,
-	0xca, 0xb8, 0x8d, 0x41, 0x09, 0x54, 0x96, 0xf4, 0x20, 0x40, 0x18, 0x66, 0xae, 0x0e, 0x8a, 0xc2,
-	0x66, 0xf2, 0xf2, 0xb0, 0x08, 0xca, 0x27, 0x00, 0xa6, 0xda, 0xcc, 0xf1, 0x24, 0xda, 0x84, 0x19,
-	0x62, 0x18, 0x2e, 0x15, 0x22, 0xe8, 0xd0, 0xc2, 0x9f, 0x8e, 0x6a, 0xf9, 0xf0, 0x9a, 0xb7, 0x03,
-	0x64, 0x47, 0xba, 0x26, 0xeb, 0xeb, 0x11, 0x11, 0x3d, 0x87, 0x29, 0x7f, 0xc3, 0x02, 0xc7, 0xd5,
-	0x56, 0x56, 0x67, 0x5b, 0x11, 0x74, 0xba, 0x95, 0x6d, 0x6e, 0xb2, 0xd6, 0xbd, 0xe3, 0xf3, 0x62,
-	0xec, 0xfd, 0x97, 0x62, 0xa5, 0x6f, 0xca, 0x81, 0xd7, 0xad, 0xf7, 0xb8, 0x1d, 0x3e, 0xbe, 0xc6,
-	0xdc, 0x05, 0xe5, 0xd0, 0xa1, 0x42, 0x15, 0x88, 0x37, 0x93, 0x51, 0x75, 0xd9, 0xa2, 0x7d, 0xd2,
-	0x1b, 0x76, 0xd4, 0x8c, 0x77, 0x93, 0x51, 0x15, 0xe8, 0xc1, 0xbc, 0x66, 0xfe, 0xe5, 0x61, 0x31,
-	0x76, 0x79, 0x58, 0x8c, 0xbd, 0x98, 0x8c, 0xaa, 0x91, 0x9c, 0xf2, 0x47, 0x00, 0xd3, 0x8f, 0x3c,
-	0xf9, 0xcb, 0xdd, 0x26, 0x1b, 0xdd, 0xa6, 0xfc, 0x01, 0xc0, 0xf4, 0x8e, 0xe7, 0x38, 0xd6, 0xd0,
-	0x57, 0x23, 0xb9, 0x24, 0x56, 0xe8, 0xb8, 0x9f, 0xa1, 0x46, 0xcd, 0x6b, 0xae, 0x85, 0x6a, 0xc0,
-	0xc9, 0x51, 0xed, 0xaf, 0x85, 0x36, 0x57, 0x02, 0xdb, 0x18, 0x94, 0x9f, 0xc0, 0xa5, 0x3b, 0xbe,
-	0xcd, 0x76, 0x99, 0x29, 0xaf, 0x31, 0x60, 0x01, 0x66, 0xe9, 0x81, 0xc3, 0x19, 0x65, 0x52, 0x39,
-	0xf0, 0x37, 0x7d, 0x1a, 0xfb, 0xe6, 0x24, 0x96, 0x49, 0x04, 0x15, 0x38, 0x51, 0x4a, 0x54, 0x96,
-	0xf4, 0x28, 0x2c, 0x9f, 0xc5, 0x61, 0xf6, 0x21, 0x95, 0xc4, 0x20, 0x92, 0xa0, 0x12, 0xcc, 0x19,
-	0x54, 0xf4, 0x5c, 0xd3, 0x91, 0x26, 0x67, 0x61, 0xfb, 0xf9, 0x14, 0xba, 0xe5, 0x33, 0x18, 0xb7,
-	0x3b, 0x1e, 0x33, 0x65, 0xf4, 0xfc, 0xb4, 0x85, 0xef, 0xe8, 0x54, 0xaf, 0x0e, 0x8d, 0xe8, 0x28,
-	0x10, 0x82, 0x49, 0x7f, 0xaf, 0x38, 0xa1, 0x7a, 0xab, 0xb3, 0xaf, 0xce, 0x30, 0x85, 0x63, 0x91,
-	0x21, 0x4e, 0xaa, 0x74, 0x14, 0xa2, 0x7f, 0x60, 0x92, 0x11, 0x9b, 0xe2, 0x94, 0x72, 0xd6, 0xca,
-	0xd9, 0x51, 0xed, 0xf7, 0xd9, 0xa2, 0x4b, 0xeb, 0xf5, 0xff, 0xb7, 0x74, 0x45, 0x40, 0xff, 0xc2,
-	0xb4, 0x18, 0xda, 0x5d, 0x6e, 0xe1, 0xf4, 0xf5, 0xd4, 0x90, 0x82, 0xfe, 0x83, 0x09, 0xcf, 0x35,
-	0x71, 0x46, 0x31, 0x0b, 0xe3, 0xf3, 0x62, 0x62, 0x57, 0x6f, 0x7f, 0x5f, 0x70, 0x43, 0xf7, 0x69,
-	0xe8, 0x26, 0xcc, 0x7a, 0xae, 0xd9, 0x19, 0x10, 0x31, 0xc0, 0x59, 0x55, 0xa2, 0x8d, 0xcf, 0x8b,
-	0x99, 0x5d, 0xbd, 0xfd, 0x80, 0x88, 0xc1, 0xa2, 0xb2, 0x8c, 0xe7, 0x9a, 0x3e, 0xd6, 0xda, 0x3e,
-	0x1e, 0x6b, 0xe0, 0x74, 0xac, 0x81, 0xaf, 0x63, 0x0d, 0xbc, 0xbe, 0xd0, 0x62, 0xa7, 0x17, 0x5a,
-	0xec, 0xf3, 0x85, 0x16, 0x7b, 0xba, 0xf6, 0x43, 0x07, 0x85, 0x9f, 0x1f, 0x65, 0xa4, 0x6e, 0x5a,
-	0x7d, 0x29, 0xb7, 0xbe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x05, 0x03, 0x28, 0xdd, 0x05, 0x00,
-	0x00,
+	// 1253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xbf, 0x6f, 0x1b, 0xb7,
+	0x17, 0xf7, 0x49, 0xf2, 0x59, 0xa2, 0xe2, 0x6f, 0x62, 0xc6, 0xc9, 0xf7, 0xec, 0x34, 0x92, 0xa0,
+	0xa5, 0x8a, 0x53, 0x4b, 0x8e, 0x13, 0xa4, 0xa8, 0x97, 0x26, 0xa7, 0xc4, 0x8d, 0x0a, 0x14, 0x0d,
+	0xce, 0x0d, 0x8a, 0x76, 0x39, 0x50, 0x77, 0xb4, 0x44, 0xe8, 0x8e, 0x3c, 0x1c, 0x79, 0x89, 0xb4,
+	0x76, 0x2a, 0xda, 0xa1, 0x19, 0x8b, 0x4e, 0x19, 0x8b, 0xa2, 0x83, 0x07, 0x6f, 0x1d, 0xba, 0x06,
+	0x99, 0x82, 0x4c, 0x41, 0x06, 0xa7, 0x75, 0x06, 0xe7, 0xcf, 0x28, 0xc8, 0xe3, 0x49, 0xfe, 0x59,
+	0xa3, 0x1d, 0x02, 0x74, 0x91, 0x8e, 0x7c, 0x1f, 0xbe, 0xf7, 0x79, 0x3f, 0xf8, 0xf8, 0x40, 0xc5,
+	0x63, 0x3c, 0x64, 0xbc, 0xd5, 0x45, 0x74, 0xd0, 0x7a, 0x78, 0xad, 0x8b, 0x05, 0xba, 0xa6, 0x16,
+	0xcd, 0x28, 0x66, 0x82, 0xc1, 0xf3, 0xa9, 0xbc, 0xa9, 0xb6, 0xb4, 0x7c, 0x71, 0xbe, 0xc7, 0x7a,
+	0x4c, 0xc9, 0x5b, 0xf2, 0x2b, 0x85, 0x2e, 0x2e, 0xa4, 0x50, 0x37, 0x15, 0xe8, 0x73, 0xa9, 0x68,
+	0x62, 0x85, 0xe3, 0xb1, 0x15, 0x8f, 0x11, 0xaa, 0xe5, 0xff, 0xd7, 0xf2, 0x90, 0xf7, 0x5a, 0x0f,
+	0xaf, 0xc9, 0x3f, 0x2d, 0x98, 0x43, 0x21, 0xa1, 0xac, 0xa5, 0x7e, 0xf5, 0x56, 0xb5, 0xc7, 0x58,
+	0x2f, 0xc0, 0x2d, 0xb5, 0xea, 0x26, 0x9b, 0x2d, 0x41, 0x42, 0xcc, 0x05, 0x0a, 0xa3, 0xcc, 0xd8,
+	0x61, 0x80, 0x9f, 0xc4, 0x48, 0x10, 0xa6, 0x8d, 0xd5, 0x5f, 0x16, 0x80, 0x79, 0x1f, 0xc5, 0x28,
+	0xe4, 0xf0, 0x13, 0x70, 0x86, 0x63, 0xea, 0xbb, 0x98, 0xa2, 0x6e, 0x80, 0x7d, 0xcb, 0xa8, 0xe5,
+	0x1b, 0xe5, 0xd5, 0x5a, 0xf3, 0x18, 0xa7, 0x9b, 0x1b, 0x98, 0xfa, 0x77, 0x53, 0x9c, 0x9d, 0xb3,
+	0x0c, 0xa7, 0xcc, 0x27, 0x1b, 0x70, 0x05, 0xcc, 0xfb, 0x78, 0x13, 0x25, 0x81, 0x70, 0x0f, 0x28,
+	0xcc, 0xd5, 0x8c, 0x46, 0xd1, 0x81, 0x5a, 0xb6, 0x4f, 0x05, 0xfc, 0xc1, 0x00, 0xd0, 0xc7, 0x94,
+	0x85, 0xae, 0x17, 0x63, 0x45, 0xcf, 0xdd, 0xc4, 0xd8, 0xca, 0x2b, 0x06, 0x0b, 0x13, 0x06, 0x1c,
+	0x8f, 0x19, 0xb4, 0x19, 0xa1, 0xf6, 0xfa, 0xd3, 0x9d, 0xea, 0xd4, 0x2f, 0xaf, 0xab, 0x8d, 0x1e,
+	0x11, 0xfd, 0xa4, 0xdb, 0xf4, 0x58, 0xa8, 0x63, 0xad, 0xff, 0x96, 0xb9, 0x3f, 0x68, 0x89, 0x51,
+	0x84, 0xb9, 0x3a, 0xc0, 0x7f, 0xda, 0xdb, 0x5a, 0x3a, 0x13, 0xe0, 0x1e, 0xf2, 0x46, 0xae, 0x0c,
+	0x39, 0xff, 0x79, 0x6f, 0x6b, 0xc9, 0x70, 0xce, 0x29, 0xe3, 0x6d, 0x6d, 0x7b, 0x1d, 0x63, 0x78,
+	0x0b, 0x5c, 0x0e, 0xd1, 0xd0, 0xe5, 0x5e, 0x1f, 0xfb, 0x49, 0x80, 0x7d, 0xe5, 0x09, 0x77, 0x23,
+	0x1c, 0xbb, 0xdd, 0x80, 0x79, 0x03, 0xab, 0x50, 0x33, 0x1a, 0xb3, 0xce, 0x42, 0x88, 0x86, 0x1b,
+	0x19, 0x46, 0x7a, 0xc4, 0xef, 0xe3, 0xd8, 0x96, 0x00, 0xe8, 0x81, 0x85, 0x90, 0xd0, 0x43, 0x1a,
+	0xa4, 0x02, 0xc2, 0x7c, 0x6b, 0xba, 0x66, 0x28, 0xcf, 0xd2, 0xec, 0x34, 0xb3, 0xec, 0x34, 0xef,
+	0xe8, 0xec, 0xd8, 0xb3, 0xd2, 0xb3, 0x1f, 0x5f, 0x57, 0x8d, 0x94, 0xe0, 0xc5, 0x90, 0xd0, 0x03,
+	0x76, 0xee, 0x2b, 0x3d, 0xf0, 0x43, 0x60, 0x1d, 0xa5, 0xe9, 0x7a, 0x2c, 0xa1, 0xc2, 0x32, 0x6b,
+	0x46, 0xa3, 0xe0, 0x5c, 0x38, 0xcc, 0xb0, 0x2d, 0x85, 0xd0, 0x06, 0x95, 0x93, 0xfc, 0x93, 0x5f,
+	0x38, 0xb6, 0x66, 0x94, 0x83, 0x8b, 0xc7, 0x39, 0xb8, 0xa1, 0x10, 0x6b, 0x97, 0xbf, 0xdb, 0xdb,
+	0x5a, 0xb2, 0xf6, 0x05, 0x7a, 0x98, 0xde, 0x9c, 0xb4, 0x9e, 0xea, 0x6d, 0x50, 0xde, 0x9f, 0xe3,
+	0x79, 0x30, 0xad, 0xa2, 0x6c, 0x19, 0x35, 0xa3, 0x51, 0x72, 0xd2, 0x05, 0xb4, 0xc0, 0xcc, 0xc1,
+	0xf2, 0xc8, 0x96, 0x6b, 0x85, 0xb7, 0x4f, 0xaa, 0x46, 0xfd, 0x99, 0x01, 0xa6, 0x3b, 0x34, 0x4a,
+	0x04, 0x5c, 0x05, 0x33, 0xc8, 0xf7, 0x63, 0xcc, 0x79, 0xaa, 0xc1, 0xb6, 0x5e, 0x6c, 0x2f, 0xcf,
+	0xeb, 0xd2, 0xb8, 0x9d, 0x4a, 0x36, 0x44, 0x4c, 0x68, 0xcf, 0xc9, 0x80, 0xf0, 0x11, 0x98, 0x56,
+	0x59, 0xb6, 0x72, 0xef, 0xaa, 0x92, 0x52, 0x7b, 0x6b, 0xf3, 0xdf, 0x3e, 0xa9, 0x4e, 0xbd, 0x7d,
+	0x52, 0x9d, 0xfa, 0x66, 0x6f, 0x6b, 0x29, 0xa3, 0x53, 0xff, 0xdd, 0x00, 0xe6, 0xe7, 0x89, 0xf8,
+	0xcf, 0x79, 0x53, 0xcc, 0xbc, 0xa9, 0xff, 0x6a, 0x00, 0x73, 0x23, 0x89, 0xa2, 0x60, 0x24, 0xd9,
+	0x08, 0x26, 0x50, 0x60, 0x19, 0xef, 0x8c, 0x8d, 0xb2, 0xb7, 0x76, 0x45, 0xb3, 0x31, 0x9e, 0x6d,
+	0x2f, 0x5f, 0x3a, 0xb6, 0x39, 0x29, 0x82, 0x1d, 0xcb, 0xa8, 0x7f, 0x09, 0x4a, 0x77, 0x64, 0x99,
+	0x3d, 0xa0, 0x44, 0x9c, 0x50, 0x80, 0x8b, 0xa0, 0x88, 0x87, 0x11, 0xa3, 0x98, 0x0a, 0x55, 0x81,
+	0xb3, 0xce, 0x78, 0x2d, 0x8b, 0x13, 0x05, 0x04, 0x71, 0xcc, 0x55, 0x2b, 0x2a, 0x39, 0xd9, 0xb2,
+	0xfe, 0x2a, 0x07, 0x8a, 0x9f, 0x61, 0x81, 0x7c, 0x24, 0x10, 0xac, 0x81, 0xb2, 0x8f, 0xb9, 0x17,
+	0x93, 0x48, 0x5e, 0x5d, 0xad, 0x7e, 0xff, 0x16, 0xfc, 0x18, 0x94, 0xd3, 0xf6, 0x96, 0x50, 0x22,
+	0xb2, 0xfc, 0x55, 0x8e, 0xed, 0xac, 0x63, 0xbe, 0x0e, 0xf0, 0xb3, 0x4f, 0x0e, 0x21, 0x28, 0xc8,
+	0xb8, 0x5a, 0x79, 0xa5, 0x5b, 0x7d, 0x4b, 0x76, 0x3e, 0xe1, 0x51, 0x80, 0x46, 0xaa, 0x19, 0x95,
+	0x9c, 0x6c, 0x09, 0xdf, 0x07, 0x05, 0x8a, 0x42, 0xac, 0xba, 0x4c, 0xc9, 0x3e, 0xff, 0x6a, 0x7b,
+	0xf9, 0xec, 0x24, 0xd0, 0xb5, 0x95, 0xe6, 0x8d, 0xeb, 0x8e, 0x02, 0xc0, 0xab, 0xc0, 0xe4, 0xa3,
+	0xb0, 0xcb, 0x02, 0xcb, 0x3c, 0x19, 0xaa, 0x21, 0xf0, 0x03, 0x90, 0x4f, 0x62, 0xa2, 0xfa, 0x42,
+	0xc9, 0x5e, 0xdc, 0xdd, 0xa9, 0xe6, 0x1f, 0x38, 0x9d, 0xa3, 0x07, 0x6e, 0x3a, 0x12, 0x06, 0x3f,
+	0x02, 0xc5, 0x24, 0x26, 0x6e, 0x1f, 0xf1, 0xbe, 0x55, 0x54, 0x47, 0x2a, 0xbb, 0x3b, 0xd5, 0x99,
+	0x07, 0x4e, 0xe7, 0x1e, 0xe2, 0xfd, 0xe3, 0x8e, 0xcd, 0x24, 0x31, 0x91, 0xb2, 0xfa, 0x00, 0x5c,
+	0x54, 0x51, 0xb8, 0x9d, 0x88, 0x3e, 0x8b, 0x89, 0x18, 0x8d, 0x23, 0xdd, 0x04, 0xd3, 0xc8, 0x0f,
+	0x09, 0x3d, 0xf5, 0xce, 0xa4, 0x30, 0x78, 0x09, 0x94, 0x54, 0x43, 0xec, 0x33, 0x36, 0x50, 0xd9,
+	0x2d, 0x39, 0x45, 0xb9, 0x71, 0x8f, 0xb1, 0x81, 0x6e, 0x30, 0xdf, 0x1b, 0xe0, 0xcc, 0x3a, 0xf2,
+	0x04, 0x8b, 0x47, 0xca, 0xe8, 0x09, 0x65, 0x82, 0x01, 0x44, 0x19, 0x1d, 0x37, 0xd4, 0x7c, 0x94,
+	0xca, 0xf2, 0xea, 0xd5, 0x93, 0x13, 0x79, 0xc4, 0x05, 0xbb, 0x24, 0x2f, 0x43, 0x5a, 0xcf, 0x73,
+	0xe8, 0xb0, 0xb4, 0xfe, 0x9b, 0x01, 0x66, 0x6d, 0x14, 0x20, 0xea, 0xe1, 0x76, 0x1f, 0xd1, 0x1e,
+	0x86, 0x17, 0x81, 0xd9, 0xc7, 0xa4, 0xd7, 0x17, 0x8a, 0x4f, 0xde, 0xd1, 0x2b, 0xb8, 0x2e, 0x69,
+	0x06, 0x9a, 0x43, 0xc9, 0x5e, 0x91, 0x6a, 0x5f, 0xed, 0x54, 0x2f, 0xa4, 0x54, 0xb8, 0x3f, 0x68,
+	0x12, 0xd6, 0x0a, 0x91, 0xe8, 0x37, 0x3b, 0x54, 0xbc, 0xd8, 0x5e, 0x06, 0x9a, 0x63, 0x87, 0x0a,
+	0x7d, 0x9b, 0xd4, 0x71, 0xf8, 0x29, 0x98, 0xe9, 0xa6, 0x06, 0xad, 0xfc, 0xbf, 0xd4, 0x94, 0x29,
+	0x90, 0xdd, 0xe1, 0xac, 0x72, 0xbb, 0xcd, 0xc2, 0x28, 0x20, 0x72, 0x0f, 0xae, 0x00, 0x93, 0x70,
+	0x9e, 0xe0, 0xf8, 0xd4, 0x9c, 0x69, 0x1c, 0xbc, 0x01, 0x8a, 0x22, 0xc6, 0x88, 0x27, 0xf1, 0xc8,
+	0xca, 0x9d, 0x72, 0x66, 0x8c, 0x84, 0x57, 0xc1, 0x1c, 0x0a, 0x02, 0xf6, 0x28, 0x20, 0x5c, 0x8c,
+	0x27, 0x8e, 0xbc, 0x7a, 0x52, 0xce, 0x8d, 0x05, 0x77, 0x0f, 0xbc, 0x2d, 0x7f, 0xe6, 0xc1, 0xec,
+	0x81, 0xb7, 0x0d, 0xfe, 0x0f, 0xe4, 0x88, 0xaf, 0x88, 0x16, 0x9c, 0x1c, 0x91, 0x93, 0x8c, 0xa9,
+	0x5f, 0xc3, 0xd3, 0x88, 0x68, 0x1c, 0xbc, 0x09, 0x4a, 0x31, 0xf6, 0x48, 0x44, 0x64, 0x3f, 0xc9,
+	0x9f, 0x72, 0x68, 0x02, 0x85, 0x23, 0x60, 0xa2, 0x50, 0x3d, 0xdb, 0x85, 0x77, 0xd5, 0x4e, 0xb5,
+	0x41, 0xf8, 0x15, 0x38, 0x4f, 0xf1, 0x50, 0xb8, 0x78, 0x88, 0xbd, 0x44, 0xcd, 0x5e, 0x82, 0xe8,
+	0xe6, 0x51, 0x5e, 0x5d, 0x3c, 0x32, 0xa2, 0x7c, 0x91, 0x4d, 0x98, 0xe9, 0x8c, 0xf2, 0x78, 0x3c,
+	0xa3, 0xcc, 0x49, 0x2d, 0x77, 0x33, 0x25, 0x12, 0x06, 0x6f, 0x01, 0x53, 0x0f, 0x3c, 0xe6, 0x3f,
+	0x1c, 0x78, 0xf4, 0x39, 0xf8, 0x9e, 0x8c, 0x67, 0x88, 0x08, 0x25, 0xb4, 0xa7, 0x5a, 0x4f, 0xc1,
+	0x99, 0x6c, 0xc8, 0xe6, 0xbd, 0x89, 0x48, 0x90, 0xc4, 0x98, 0xab, 0x26, 0x33, 0xeb, 0x8c, 0xd7,
+	0x69, 0x8e, 0xed, 0xf6, 0xd3, 0xdd, 0x8a, 0xf1, 0x7c, 0xb7, 0x62, 0xfc, 0xb1, 0x5b, 0x31, 0x1e,
+	0xbf, 0xa9, 0x4c, 0x3d, 0x7f, 0x53, 0x99, 0x7a, 0xf9, 0xa6, 0x32, 0xf5, 0xf5, 0x95, 0xbf, 0x0d,
+	0x9f, 0x1e, 0x65, 0x54, 0x14, 0xbb, 0xa6, 0xa2, 0x7b, 0xfd, 0xaf, 0x01, 0x00, 0x8c, 0x1a, 0xa8,
+	0x31, 0x20, 0x0c, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -559,35 +995,179 @@ func (this *Supply) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*DenomAuthorityMetadata)
+	if !ok {
+		that2, ok := that.(DenomAuthorityMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if this.SendHook != that1.SendHook {
+		return false
+	}
+	return true
 }
+func (this *DenomCompliance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DefaultSendEnabled {
-		i--
-		if m.DefaultSendEnabled {
-			dAtA[i] = 1
+	that1, ok := that.(*DenomCompliance)
+	if !ok {
+		that2, ok := that.(DenomCompliance)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x10
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Issuer != that1.Issuer {
+		return false
+	}
+	if this.Treasury != that1.Treasury {
+		return false
+	}
+	if this.AllowlistEnabled != that1.AllowlistEnabled {
+		return false
+	}
+	return true
+}
+func (this *ScheduledSend) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledSend)
+	if !ok {
+		that2, ok := that.(ScheduledSend)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if !this.NextExecutionTime.Equal(that1.NextExecutionTime) {
+		return false
+	}
+	if this.Period != that1.Period {
+		return false
+	}
+	if this.Remaining != that1.Remaining {
+		return false
+	}
+	if this.Failures != that1.Failures {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxScheduledSendsPerSender != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.MaxScheduledSendsPerSender))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxScheduledSendCount != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.MaxScheduledSendCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinScheduledSendPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinScheduledSendPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBank(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.MaxScheduledSendsPerBlock != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.MaxScheduledSendsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DefaultSendEnabled {
+		i--
+		if m.DefaultSendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
@@ -901,6 +1481,260 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAuthorityMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SendHook) > 0 {
+		i -= len(m.SendHook)
+		copy(dAtA[i:], m.SendHook)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.SendHook)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FactoryDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FactoryDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FactoryDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Delta.Size()
+		i -= size
+		if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomCompliance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomCompliance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomCompliance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowlistEnabled {
+		i--
+		if m.AllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledSend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failures != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x38
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintBank(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintBank(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -927,6 +1761,23 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.MaxScheduledSendsPerBlock != 0 {
+		n += 1 + sovBank(uint64(m.MaxScheduledSendsPerBlock))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinScheduledSendPeriod)
+	n += 1 + l + sovBank(uint64(l))
+	if m.MaxScheduledSendCount != 0 {
+		n += 1 + sovBank(uint64(m.MaxScheduledSendCount))
+	}
+	if m.MaxScheduledSendsPerSender != 0 {
+		n += 1 + sovBank(uint64(m.MaxScheduledSendsPerSender))
+	}
 	return n
 }
 
@@ -1064,7 +1915,111 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
-func sovBank(x uint64) (n int) {
+func (m *DenomAuthorityMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.SendHook)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *FactoryDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *BalanceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBank(uint64(m.Height))
+	}
+	l = m.Delta.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *DenomCompliance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.AllowlistEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *ScheduledSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBank(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime)
+	n += 1 + l + sovBank(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovBank(uint64(l))
+	if m.Remaining != 0 {
+		n += 1 + sovBank(uint64(m.Remaining))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovBank(uint64(m.Failures))
+	}
+	return n
+}
+
+func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozBank(x uint64) (n int) {
@@ -1153,6 +2108,130 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScheduledSendsPerBlock", wireType)
+			}
+			m.MaxScheduledSendsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScheduledSendsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinScheduledSendPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinScheduledSendPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScheduledSendCount", wireType)
+			}
+			m.MaxScheduledSendCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScheduledSendCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScheduledSendsPerSender", wireType)
+			}
+			m.MaxScheduledSendsPerSender = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScheduledSendsPerSender |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -1254,7 +2333,764 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Output) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Supply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomUnit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomUnits = append(m.DenomUnits, &DenomUnit{})
+			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -1276,7 +3112,7 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Input) Unmarshal(dAtA []byte) error {
+func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1299,15 +3135,15 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomAuthorityMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomAuthorityMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1335,13 +3171,13 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendHook", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1351,25 +3187,23 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SendHook = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1392,7 +3226,7 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Output) Unmarshal(dAtA []byte) error {
+func (m *FactoryDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1415,15 +3249,15 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+			return fmt.Errorf("proto: FactoryDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FactoryDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1451,11 +3285,11 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1482,8 +3316,7 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1508,7 +3341,7 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Supply) Unmarshal(dAtA []byte) error {
+func (m *BalanceChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1531,17 +3364,36 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
+			return fmt.Errorf("proto: BalanceChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BalanceChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1551,23 +3403,57 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.Coin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1592,7 +3478,7 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomUnit) Unmarshal(dAtA []byte) error {
+func (m *DenomCompliance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1615,15 +3501,15 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomCompliance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomCompliance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1651,30 +3537,11 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
-			}
-			m.Exponent = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBank
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1702,8 +3569,28 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			m.Treasury = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowlistEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -1725,7 +3612,7 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *ScheduledSend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1748,17 +3635,17 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledSend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledSend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1768,29 +3655,16 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBank
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBank
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1800,29 +3674,27 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomUnits = append(m.DenomUnits, &DenomUnit{})
-			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1850,13 +3722,13 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Base = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1866,29 +3738,31 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Display = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1898,29 +3772,30 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1930,29 +3805,30 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
-			var stringLen uint64
+			m.Remaining = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1962,29 +3838,16 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Remaining |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBank
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBank
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URI = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
-			var stringLen uint64
+			m.Failures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1994,24 +3857,11 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Failures |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBank
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBank
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URIHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
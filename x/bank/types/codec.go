@@ -16,6 +16,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateDenom{}, "cosmos-sdk/MsgCreateDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgMint{}, "cosmos-sdk/MsgMint")
+	legacy.RegisterAminoMsg(cdc, &MsgBurn{}, "cosmos-sdk/MsgBurn")
+	legacy.RegisterAminoMsg(cdc, &MsgChangeAdmin{}, "cosmos-sdk/MsgChangeAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomMetadata{}, "cosmos-sdk/MsgSetDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomSendHook{}, "cosmos-sdk/MsgSetDenomSendHook")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomCompliance{}, "cosmos-sdk/MsgSetDenomCompliance")
+	legacy.RegisterAminoMsg(cdc, &MsgFreeze{}, "cosmos-sdk/MsgFreeze")
+	legacy.RegisterAminoMsg(cdc, &MsgUnfreeze{}, "cosmos-sdk/MsgUnfreeze")
+	legacy.RegisterAminoMsg(cdc, &MsgClawback{}, "cosmos-sdk/MsgClawback")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAllowlisted{}, "cosmos-sdk/MsgSetAllowlisted")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleSend{}, "cosmos-sdk/MsgScheduleSend")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelScheduledSend{}, "cosmos-sdk/MsgCancelScheduledSend")
 
 	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params", nil)
@@ -26,6 +39,19 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSend{},
 		&MsgMultiSend{},
 		&MsgUpdateParams{},
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgChangeAdmin{},
+		&MsgSetDenomMetadata{},
+		&MsgSetDenomSendHook{},
+		&MsgSetDenomCompliance{},
+		&MsgFreeze{},
+		&MsgUnfreeze{},
+		&MsgClawback{},
+		&MsgSetAllowlisted{},
+		&MsgScheduleSend{},
+		&MsgCancelScheduledSend{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
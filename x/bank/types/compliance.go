@@ -0,0 +1,94 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgSetDenomCompliance{}
+	_ sdk.Msg = &MsgFreeze{}
+	_ sdk.Msg = &MsgUnfreeze{}
+	_ sdk.Msg = &MsgClawback{}
+	_ sdk.Msg = &MsgSetAllowlisted{}
+)
+
+// Validate performs a basic validation of the compliance controls of a denom.
+func (c DenomCompliance) Validate() error {
+	if c.Issuer == "" {
+		if c.Treasury != "" || c.AllowlistEnabled {
+			return errors.New("compliance controls without issuer")
+		}
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(c.Issuer); err != nil {
+		return fmt.Errorf("invalid issuer %s: %w", c.Issuer, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(c.Treasury); err != nil {
+		return fmt.Errorf("invalid treasury %s: %w", c.Treasury, err)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the compliance controls of a denom
+// in the genesis state.
+func (s DenomComplianceState) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+
+	if s.Compliance.Issuer == "" {
+		return fmt.Errorf("compliance controls of denom %s without issuer", s.Denom)
+	}
+
+	if err := s.Compliance.Validate(); err != nil {
+		return err
+	}
+
+	for _, addrs := range [][]string{s.FrozenAddresses, s.AllowlistedAddresses} {
+		seen := make(map[string]bool)
+		for _, addr := range addrs {
+			if seen[addr] {
+				return fmt.Errorf("duplicate address %s for denom %s", addr, s.Denom)
+			}
+
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return fmt.Errorf("invalid address %s: %w", addr, err)
+			}
+
+			seen[addr] = true
+		}
+	}
+
+	return nil
+}
+
+// NewMsgSetDenomCompliance creates a new MsgSetDenomCompliance instance.
+func NewMsgSetDenomCompliance(sender, denom string, compliance DenomCompliance) *MsgSetDenomCompliance {
+	return &MsgSetDenomCompliance{Sender: sender, Denom: denom, Compliance: compliance}
+}
+
+// NewMsgFreeze creates a new MsgFreeze instance.
+func NewMsgFreeze(issuer, denom, address string) *MsgFreeze {
+	return &MsgFreeze{Issuer: issuer, Denom: denom, Address: address}
+}
+
+// NewMsgUnfreeze creates a new MsgUnfreeze instance.
+func NewMsgUnfreeze(issuer, denom, address string) *MsgUnfreeze {
+	return &MsgUnfreeze{Issuer: issuer, Denom: denom, Address: address}
+}
+
+// NewMsgClawback creates a new MsgClawback instance.
+func NewMsgClawback(issuer, address string, amount sdk.Coin) *MsgClawback {
+	return &MsgClawback{Issuer: issuer, Address: address, Amount: amount}
+}
+
+// NewMsgSetAllowlisted creates a new MsgSetAllowlisted instance.
+func NewMsgSetAllowlisted(issuer, denom, address string, allowed bool) *MsgSetAllowlisted {
+	return &MsgSetAllowlisted{Issuer: issuer, Denom: denom, Address: address, Allowed: allowed}
+}
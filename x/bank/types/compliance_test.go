@@ -0,0 +1,26 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenomCompliance(t *testing.T) {
+	issuer := "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"
+	treasury := "cosmos1a3dc2yfqv84ce6jl9g3u5d3wvd8dlshk8ra48x"
+
+	require.NoError(t, DenomCompliance{}.Validate())
+	require.NoError(t, DenomCompliance{Issuer: issuer, Treasury: treasury, AllowlistEnabled: true}.Validate())
+	require.Error(t, DenomCompliance{Treasury: treasury}.Validate())
+	require.Error(t, DenomCompliance{AllowlistEnabled: true}.Validate())
+	require.Error(t, DenomCompliance{Issuer: issuer}.Validate())
+	require.Error(t, DenomCompliance{Issuer: "invalid", Treasury: treasury}.Validate())
+
+	compliance := DenomCompliance{Issuer: issuer, Treasury: treasury}
+	require.NoError(t, DenomComplianceState{Denom: "uatom", Compliance: compliance, FrozenAddresses: []string{issuer}, AllowlistedAddresses: []string{issuer, treasury}}.Validate())
+	require.Error(t, DenomComplianceState{Denom: "uatom"}.Validate())
+	require.Error(t, DenomComplianceState{Denom: "", Compliance: compliance}.Validate())
+	require.Error(t, DenomComplianceState{Denom: "uatom", Compliance: compliance, FrozenAddresses: []string{"invalid"}}.Validate())
+	require.Error(t, DenomComplianceState{Denom: "uatom", Compliance: compliance, AllowlistedAddresses: []string{treasury, treasury}}.Validate())
+}
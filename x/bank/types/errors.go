@@ -12,4 +12,12 @@ var (
 	ErrInvalidKey            = errors.Register(ModuleName, 7, "invalid key")
 	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
+	ErrInvalidFactoryDenom   = errors.Register(ModuleName, 10, "invalid factory denom")
+	ErrFactoryDenomExists    = errors.Register(ModuleName, 11, "factory denom already exists")
+	ErrUnknownSendHook       = errors.Register(ModuleName, 12, "unknown send hook")
+	ErrHeightNotIndexed      = errors.Register(ModuleName, 13, "height not indexed")
+	ErrNoDenomCompliance     = errors.Register(ModuleName, 14, "denom has no compliance controls")
+	ErrAddressFrozen         = errors.Register(ModuleName, 15, "address is frozen for denom")
+	ErrNotAllowlisted        = errors.Register(ModuleName, 16, "address is not allowlisted for denom")
+	ErrScheduledSendNotFound = errors.Register(ModuleName, 17, "scheduled send not found")
 )
@@ -17,6 +17,38 @@ const (
 	EventTypeCoinMint     = "coinbase" // NOTE(fdymylja): using mint clashes with mint module event
 	EventTypeCoinBurn     = "burn"
 
+	// factory denoms events name and attributes
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeChangeAdmin      = "change_admin"
+	EventTypeSetDenomSendHook = "set_denom_send_hook"
+
+	AttributeKeyCreator       = "creator"
+	AttributeKeyNewTokenDenom = "new_token_denom"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyNewAdmin      = "new_admin"
+	AttributeKeySendHook      = "send_hook"
+
+	// compliance controls events name and attributes
+	EventTypeSetDenomCompliance = "set_denom_compliance"
+	EventTypeFreeze             = "freeze"
+	EventTypeUnfreeze           = "unfreeze"
+	EventTypeClawback           = "clawback"
+	EventTypeSetAllowlisted     = "set_allowlisted"
+
+	AttributeKeyIssuer   = "issuer"
+	AttributeKeyTreasury = "treasury"
+	AttributeKeyAddress  = "address"
+	AttributeKeyAllowed  = "allowed"
+
+	// scheduled sends events name and attributes
+	EventTypeScheduleSend        = "schedule_send"
+	EventTypeCancelScheduledSend = "cancel_scheduled_send"
+	EventTypeScheduledSend       = "scheduled_send"
+
+	AttributeKeyScheduledSendID = "scheduled_send_id"
+	AttributeKeyRemaining       = "remaining"
+	AttributeKeyError           = "error"
+
 	AttributeKeySpender  = "spender"
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FactoryDenomPrefix is the prefix of the denoms created through MsgCreateDenom.
+	FactoryDenomPrefix = "factory"
+	// MaxSubdenomLength is the maximum length of the subdenom of a factory denom.
+	MaxSubdenomLength = 44
+	// MaxSendHookNameLength is the maximum length of the name of a factory denom send hook.
+	MaxSendHookNameLength = 64
+)
+
+var (
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+	_ sdk.Msg = &MsgSetDenomSendHook{}
+)
+
+// GetFactoryDenom returns the factory denom, factory/{creator}/{subdenom}, of
+// the given creator and subdenom.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "subdenom too long, max length is %d", MaxSubdenomLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "invalid creator %s", creator)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructFactoryDenom returns the creator and the subdenom of a factory denom.
+func DeconstructFactoryDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != FactoryDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "%s is not in the %s/{creator}/{subdenom} format", denom, FactoryDenomPrefix)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(parts[1]); err != nil {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "invalid creator %s: %s", parts[1], err)
+	}
+
+	return parts[1], parts[2], nil
+}
+
+// IsFactoryDenom returns whether the denom is in the namespace of the factory denoms.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, FactoryDenomPrefix+"/")
+}
+
+// Validate performs a basic validation of a factory denom.
+func (fd FactoryDenom) Validate() error {
+	if _, _, err := DeconstructFactoryDenom(fd.Denom); err != nil {
+		return err
+	}
+
+	return fd.AuthorityMetadata.Validate()
+}
+
+// Validate performs a basic validation of the authority of a factory denom.
+func (m DenomAuthorityMetadata) Validate() error {
+	if m.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+			return fmt.Errorf("invalid admin %s: %w", m.Admin, err)
+		}
+	}
+
+	if len(m.SendHook) > MaxSendHookNameLength {
+		return fmt.Errorf("send hook name too long, max length is %d", MaxSendHookNameLength)
+	}
+
+	return nil
+}
+
+// NewMsgCreateDenom creates a new MsgCreateDenom instance.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{Sender: sender, Subdenom: subdenom}
+}
+
+// NewMsgMint creates a new MsgMint instance.
+func NewMsgMint(sender string, amount sdk.Coin, mintToAddress string) *MsgMint {
+	return &MsgMint{Sender: sender, Amount: amount, MintToAddress: mintToAddress}
+}
+
+// NewMsgBurn creates a new MsgBurn instance.
+func NewMsgBurn(sender string, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{Sender: sender, Amount: amount}
+}
+
+// NewMsgChangeAdmin creates a new MsgChangeAdmin instance.
+func NewMsgChangeAdmin(sender, denom, newAdmin string) *MsgChangeAdmin {
+	return &MsgChangeAdmin{Sender: sender, Denom: denom, NewAdmin: newAdmin}
+}
+
+// NewMsgSetDenomMetadata creates a new MsgSetDenomMetadata instance.
+func NewMsgSetDenomMetadata(sender string, metadata Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{Sender: sender, Metadata: metadata}
+}
+
+// NewMsgSetDenomSendHook creates a new MsgSetDenomSendHook instance.
+func NewMsgSetDenomSendHook(sender, denom, sendHook string) *MsgSetDenomSendHook {
+	return &MsgSetDenomSendHook{Sender: sender, Denom: denom, SendHook: sendHook}
+}
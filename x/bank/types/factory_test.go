@@ -0,0 +1,38 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactoryDenom(t *testing.T) {
+	creator := "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"
+
+	denom, err := GetFactoryDenom(creator, "token")
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+creator+"/token", denom)
+	require.True(t, IsFactoryDenom(denom))
+	require.False(t, IsFactoryDenom("uatom"))
+
+	gotCreator, subdenom, err := DeconstructFactoryDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, creator, gotCreator)
+	require.Equal(t, "token", subdenom)
+
+	_, err = GetFactoryDenom(creator, strings.Repeat("a", MaxSubdenomLength+1))
+	require.ErrorIs(t, err, ErrInvalidFactoryDenom)
+	_, err = GetFactoryDenom("cosmos1/x", "token")
+	require.ErrorIs(t, err, ErrInvalidFactoryDenom)
+
+	for _, invalid := range []string{"uatom", "ibc/" + creator + "/token", "factory/creator/token", "factory/" + creator} {
+		_, _, err = DeconstructFactoryDenom(invalid)
+		require.ErrorIs(t, err, ErrInvalidFactoryDenom, invalid)
+	}
+
+	require.NoError(t, FactoryDenom{Denom: denom, AuthorityMetadata: DenomAuthorityMetadata{Admin: creator}}.Validate())
+	require.NoError(t, FactoryDenom{Denom: denom}.Validate())
+	require.Error(t, FactoryDenom{Denom: denom, AuthorityMetadata: DenomAuthorityMetadata{Admin: "invalid"}}.Validate())
+	require.Error(t, FactoryDenom{Denom: denom, AuthorityMetadata: DenomAuthorityMetadata{SendHook: strings.Repeat("a", MaxSendHookNameLength+1)}}.Validate())
+}
@@ -23,6 +23,9 @@ func (gs GenesisState) Validate() error {
 	seenSendEnabled := make(map[string]bool)
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
+	seenFactoryDenoms := make(map[string]bool)
+	seenDenomCompliance := make(map[string]bool)
+	seenScheduledSends := make(map[uint64]bool)
 
 	totalSupply := sdk.Coins{}
 
@@ -62,6 +65,46 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	for _, fd := range gs.FactoryDenoms {
+		if seenFactoryDenoms[fd.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", fd.Denom)
+		}
+
+		if err := fd.Validate(); err != nil {
+			return err
+		}
+
+		seenFactoryDenoms[fd.Denom] = true
+	}
+
+	for _, dc := range gs.DenomCompliance {
+		if seenDenomCompliance[dc.Denom] {
+			return fmt.Errorf("duplicate compliance controls for denom %s", dc.Denom)
+		}
+
+		if err := dc.Validate(); err != nil {
+			return err
+		}
+
+		seenDenomCompliance[dc.Denom] = true
+	}
+
+	for _, ss := range gs.ScheduledSends {
+		if seenScheduledSends[ss.Id] {
+			return fmt.Errorf("duplicate scheduled send %d", ss.Id)
+		}
+
+		if ss.Id >= gs.NextScheduledSendId {
+			return fmt.Errorf("scheduled send %d not lower than the next scheduled send id %d", ss.Id, gs.NextScheduledSendId)
+		}
+
+		if err := ValidateSchedule(ss.Amount, ss.Period, ss.Remaining); err != nil {
+			return fmt.Errorf("scheduled send %d: %w", ss.Id, err)
+		}
+
+		seenScheduledSends[ss.Id] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -40,6 +40,14 @@ type GenesisState struct {
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
 	// send_enabled defines the denoms where send is enabled or disabled.
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// factory_denoms defines the denoms created through MsgCreateDenom.
+	FactoryDenoms []FactoryDenom `protobuf:"bytes,6,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms"`
+	// denom_compliance defines the compliance controls of the denoms.
+	DenomCompliance []DenomComplianceState `protobuf:"bytes,7,rep,name=denom_compliance,json=denomCompliance,proto3" json:"denom_compliance"`
+	// scheduled_sends defines the pending scheduled sends.
+	ScheduledSends []ScheduledSend `protobuf:"bytes,8,rep,name=scheduled_sends,json=scheduledSends,proto3" json:"scheduled_sends"`
+	// next_scheduled_send_id is the identifier of the next scheduled send.
+	NextScheduledSendId uint64 `protobuf:"varint,9,opt,name=next_scheduled_send_id,json=nextScheduledSendId,proto3" json:"next_scheduled_send_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +118,108 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetFactoryDenoms() []FactoryDenom {
+	if m != nil {
+		return m.FactoryDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomCompliance() []DenomComplianceState {
+	if m != nil {
+		return m.DenomCompliance
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledSends() []ScheduledSend {
+	if m != nil {
+		return m.ScheduledSends
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduledSendId() uint64 {
+	if m != nil {
+		return m.NextScheduledSendId
+	}
+	return 0
+}
+
+// DenomComplianceState defines the compliance controls of a denom and its
+// frozen and allowlisted addresses, used in the bank module's genesis state.
+type DenomComplianceState struct {
+	// denom is the denom the compliance controls apply to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// compliance is the compliance controls of the denom.
+	Compliance DenomCompliance `protobuf:"bytes,2,opt,name=compliance,proto3" json:"compliance"`
+	// frozen_addresses are the addresses frozen for the denom.
+	FrozenAddresses []string `protobuf:"bytes,3,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty"`
+	// allowlisted_addresses are the addresses allowlisted for the denom.
+	AllowlistedAddresses []string `protobuf:"bytes,4,rep,name=allowlisted_addresses,json=allowlistedAddresses,proto3" json:"allowlisted_addresses,omitempty"`
+}
+
+func (m *DenomComplianceState) Reset()         { *m = DenomComplianceState{} }
+func (m *DenomComplianceState) String() string { return proto.CompactTextString(m) }
+func (*DenomComplianceState) ProtoMessage()    {}
+func (*DenomComplianceState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{1}
+}
+func (m *DenomComplianceState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomComplianceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomComplianceState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomComplianceState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomComplianceState.Merge(m, src)
+}
+func (m *DenomComplianceState) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomComplianceState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomComplianceState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomComplianceState proto.InternalMessageInfo
+
+func (m *DenomComplianceState) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomComplianceState) GetCompliance() DenomCompliance {
+	if m != nil {
+		return m.Compliance
+	}
+	return DenomCompliance{}
+}
+
+func (m *DenomComplianceState) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
+func (m *DenomComplianceState) GetAllowlistedAddresses() []string {
+	if m != nil {
+		return m.AllowlistedAddresses
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -123,7 +233,7 @@ func (m *Balance) Reset()         { *m = Balance{} }
 func (m *Balance) String() string { return proto.CompactTextString(m) }
 func (*Balance) ProtoMessage()    {}
 func (*Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f007de11b420c6e, []int{1}
+	return fileDescriptor_8f007de11b420c6e, []int{2}
 }
 func (m *Balance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -154,43 +264,56 @@ var xxx_messageInfo_Balance proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v1beta1.GenesisState")
+	proto.RegisterType((*DenomComplianceState)(nil), "cosmos.bank.v1beta1.DenomComplianceState")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v1beta1.Balance")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xbf, 0x6e, 0xd3, 0x50,
-	0x14, 0xc6, 0x6d, 0x42, 0xd3, 0xf6, 0x26, 0x80, 0x30, 0x1d, 0xdc, 0x52, 0x9c, 0xd0, 0x29, 0x54,
-	0x8a, 0x4d, 0x03, 0x12, 0x12, 0x03, 0x12, 0x8e, 0x80, 0x09, 0x81, 0x9a, 0x8d, 0x25, 0xba, 0xb6,
-	0x8f, 0x8c, 0xd5, 0xf8, 0x5e, 0x2b, 0xe7, 0x16, 0xc8, 0x1b, 0x30, 0x32, 0x33, 0x75, 0x44, 0x4c,
-	0x1d, 0xfa, 0x00, 0x88, 0xa9, 0x63, 0xc5, 0x84, 0x18, 0x00, 0x25, 0x43, 0x79, 0x0c, 0xe4, 0x7b,
-	0x6e, 0xfe, 0x48, 0x44, 0x8c, 0x5d, 0x6c, 0xcb, 0xdf, 0x77, 0x7e, 0xdf, 0x39, 0xe7, 0xda, 0xec,
-	0x76, 0x2c, 0x31, 0x97, 0x18, 0x44, 0x5c, 0x1c, 0x04, 0x6f, 0xf6, 0x22, 0x50, 0x7c, 0x2f, 0x48,
-	0x41, 0x00, 0x66, 0xe8, 0x17, 0x43, 0xa9, 0xa4, 0x73, 0x83, 0x2c, 0x7e, 0x69, 0xf1, 0x8d, 0x65,
-	0x6b, 0x23, 0x95, 0xa9, 0xd4, 0x7a, 0x50, 0x3e, 0x91, 0x75, 0xcb, 0x9b, 0xd1, 0x10, 0x66, 0xb4,
-	0x58, 0x66, 0xe2, 0x1f, 0x7d, 0x21, 0x4d, 0x73, 0x49, 0xdf, 0x24, 0xbd, 0x4f, 0x60, 0x93, 0x4b,
-	0xd2, 0x75, 0x9e, 0x67, 0x42, 0x06, 0xfa, 0x4a, 0xaf, 0x76, 0xbe, 0x56, 0x58, 0xfd, 0x19, 0xb5,
-	0xda, 0x53, 0x5c, 0x81, 0xf3, 0x88, 0x55, 0x0b, 0x3e, 0xe4, 0x39, 0xba, 0x76, 0xd3, 0x6e, 0xd5,
-	0x3a, 0x37, 0xfd, 0x25, 0xad, 0xfb, 0x2f, 0xb5, 0x25, 0x5c, 0x3f, 0xfd, 0xd9, 0xb0, 0x3e, 0x9d,
-	0x1f, 0xef, 0xda, 0xfb, 0xa6, 0xca, 0xe9, 0xb2, 0xb5, 0x88, 0x0f, 0xb8, 0x88, 0x01, 0xdd, 0x4b,
-	0xcd, 0x4a, 0xab, 0xd6, 0xd9, 0x5e, 0x4a, 0x08, 0xc9, 0xb4, 0x88, 0x98, 0x15, 0x3a, 0x23, 0x56,
-	0xc5, 0xc3, 0xa2, 0x18, 0x8c, 0xdc, 0x8a, 0x46, 0x6c, 0xce, 0x11, 0x08, 0x33, 0x44, 0x57, 0x66,
-	0x22, 0x7c, 0x5a, 0xd6, 0x7f, 0xfe, 0xd5, 0x68, 0xa5, 0x99, 0x7a, 0x7d, 0x18, 0xf9, 0xb1, 0xcc,
-	0xcd, 0xd0, 0xe6, 0xd6, 0xc6, 0xe4, 0x20, 0x50, 0xa3, 0x02, 0x50, 0x17, 0xe0, 0xc7, 0xf3, 0xe3,
-	0xdd, 0xfa, 0x00, 0x52, 0x1e, 0x8f, 0xfa, 0xe5, 0x5a, 0xd1, 0xf4, 0x4f, 0x81, 0xce, 0x0b, 0x76,
-	0x35, 0x01, 0x21, 0xf3, 0x7e, 0x0e, 0x8a, 0x27, 0x5c, 0x71, 0xf7, 0xb2, 0x6e, 0xe1, 0xd6, 0xd2,
-	0x29, 0x9e, 0x1b, 0xd3, 0xe2, 0x18, 0x57, 0x74, 0xfd, 0x54, 0x71, 0x38, 0xab, 0x23, 0x88, 0xa4,
-	0x0f, 0x82, 0x47, 0x03, 0x48, 0xdc, 0x15, 0x8d, 0x6b, 0x2e, 0xc5, 0xf5, 0x40, 0x24, 0x4f, 0xc8,
-	0x17, 0x6e, 0x97, 0xc4, 0x1f, 0x27, 0xed, 0x6b, 0xf3, 0x31, 0x9a, 0x77, 0xfd, 0xfb, 0x0f, 0x28,
-	0xa4, 0x86, 0x73, 0xeb, 0xce, 0x17, 0x9b, 0xad, 0x9a, 0x7d, 0x3a, 0x1d, 0xb6, 0xca, 0x93, 0x64,
-	0x08, 0x48, 0x07, 0xb8, 0x1e, 0xba, 0xdf, 0x4e, 0xda, 0x1b, 0x26, 0xec, 0x31, 0x29, 0x3d, 0x35,
-	0xcc, 0x44, 0xba, 0x3f, 0x35, 0x3a, 0x6f, 0xd9, 0x8a, 0xde, 0x84, 0x39, 0xb0, 0x0b, 0xd8, 0x36,
-	0xe5, 0x3d, 0x5c, 0x7b, 0x7f, 0xd4, 0xb0, 0xfe, 0x1c, 0x35, 0xac, 0xb0, 0x7b, 0x3a, 0xf6, 0xec,
-	0xb3, 0xb1, 0x67, 0xff, 0x1e, 0x7b, 0xf6, 0x87, 0x89, 0x67, 0x9d, 0x4d, 0x3c, 0xeb, 0xfb, 0xc4,
-	0xb3, 0x5e, 0xdd, 0xf9, 0x6f, 0xd4, 0x3b, 0xfa, 0x0f, 0x74, 0x62, 0x54, 0xd5, 0xdf, 0xf4, 0xbd,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x58, 0x75, 0x60, 0x91, 0x03, 0x00, 0x00,
+	// 671 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcf, 0x4f, 0x13, 0x4f,
+	0x18, 0xc6, 0xbb, 0x05, 0x0a, 0x1d, 0xf8, 0x52, 0xbe, 0x43, 0x35, 0x0b, 0xe2, 0xb6, 0x34, 0x1e,
+	0x0a, 0x09, 0x5b, 0x01, 0x13, 0x13, 0x0f, 0x26, 0xb4, 0x8a, 0xf1, 0x40, 0x30, 0x6d, 0xe2, 0xc1,
+	0xcb, 0x66, 0xba, 0x33, 0x94, 0x0d, 0xbb, 0x33, 0x4d, 0xdf, 0x45, 0xa8, 0x77, 0x13, 0x8f, 0x7a,
+	0xf5, 0xc4, 0xd1, 0x78, 0xe2, 0xc0, 0x1f, 0xe0, 0x91, 0x23, 0xf1, 0x64, 0x3c, 0xa8, 0x81, 0x03,
+	0xfe, 0x19, 0x66, 0x67, 0xb6, 0xcb, 0x34, 0x6e, 0xd0, 0x93, 0x97, 0xfe, 0x7a, 0x9e, 0xf7, 0xf3,
+	0xbc, 0xef, 0xdb, 0x9d, 0x41, 0x8b, 0xae, 0x80, 0x40, 0x40, 0xad, 0x4d, 0xf8, 0x5e, 0xed, 0xe5,
+	0x6a, 0x9b, 0x85, 0x64, 0xb5, 0xd6, 0x61, 0x9c, 0x81, 0x07, 0x76, 0xb7, 0x27, 0x42, 0x81, 0x67,
+	0x95, 0xc5, 0x8e, 0x2c, 0x76, 0x6c, 0x99, 0x2f, 0x76, 0x44, 0x47, 0x48, 0xbd, 0x16, 0x7d, 0x52,
+	0xd6, 0x79, 0x2b, 0xa1, 0x01, 0x4b, 0x68, 0xae, 0xf0, 0xf8, 0x6f, 0xba, 0x96, 0x26, 0xb9, 0x4a,
+	0x9f, 0x53, 0xba, 0xa3, 0xc0, 0x71, 0xae, 0x92, 0xfe, 0x27, 0x81, 0xc7, 0x45, 0x4d, 0xbe, 0xaa,
+	0x9f, 0x2a, 0xaf, 0x73, 0x68, 0xea, 0x89, 0x6a, 0xb5, 0x15, 0x92, 0x90, 0xe1, 0x87, 0x28, 0xd7,
+	0x25, 0x3d, 0x12, 0x80, 0x69, 0x94, 0x8d, 0xea, 0xe4, 0xda, 0x2d, 0x3b, 0xa5, 0x75, 0xfb, 0x99,
+	0xb4, 0xd4, 0xf3, 0xa7, 0xdf, 0x4a, 0x99, 0x0f, 0x97, 0xc7, 0xcb, 0x46, 0x33, 0xae, 0xc2, 0x0d,
+	0x34, 0xd1, 0x26, 0x3e, 0xe1, 0x2e, 0x03, 0x33, 0x5b, 0x1e, 0xa9, 0x4e, 0xae, 0x2d, 0xa4, 0x12,
+	0xea, 0xca, 0xa4, 0x23, 0x92, 0x42, 0xdc, 0x47, 0x39, 0xd8, 0xef, 0x76, 0xfd, 0xbe, 0x39, 0x22,
+	0x11, 0x73, 0x57, 0x08, 0x60, 0x09, 0xa2, 0x21, 0x3c, 0x5e, 0xdf, 0x8c, 0xea, 0x3f, 0x7e, 0x2f,
+	0x55, 0x3b, 0x5e, 0xb8, 0xbb, 0xdf, 0xb6, 0x5d, 0x11, 0xc4, 0x43, 0xc7, 0x6f, 0x2b, 0x40, 0xf7,
+	0x6a, 0x61, 0xbf, 0xcb, 0x40, 0x16, 0xc0, 0xfb, 0xcb, 0xe3, 0xe5, 0x29, 0x9f, 0x75, 0x88, 0xdb,
+	0x77, 0xa2, 0xb5, 0x42, 0xdc, 0xbf, 0x0a, 0xc4, 0xdb, 0x68, 0x9a, 0x32, 0x2e, 0x02, 0x27, 0x60,
+	0x21, 0xa1, 0x24, 0x24, 0xe6, 0xa8, 0x6c, 0xe1, 0x76, 0xea, 0x14, 0x5b, 0xb1, 0x49, 0x1f, 0xe3,
+	0x3f, 0x59, 0x3f, 0x50, 0x30, 0x41, 0x53, 0xc0, 0x38, 0x75, 0x18, 0x27, 0x6d, 0x9f, 0x51, 0x73,
+	0x4c, 0xe2, 0xca, 0xa9, 0xb8, 0x16, 0xe3, 0xf4, 0xb1, 0xf2, 0xd5, 0x17, 0x22, 0xe2, 0xd7, 0x93,
+	0x95, 0xc2, 0xd5, 0x18, 0xe5, 0xbb, 0xf6, 0xbd, 0xfb, 0x2a, 0x64, 0x12, 0xae, 0xac, 0xb8, 0x85,
+	0xa6, 0x77, 0x88, 0x1b, 0x8a, 0x5e, 0xdf, 0x91, 0xd9, 0x60, 0xe6, 0x64, 0xc8, 0x62, 0x6a, 0xc8,
+	0xa6, 0xb2, 0x3e, 0x8a, 0x9c, 0x43, 0x7d, 0xef, 0x68, 0x02, 0x60, 0x07, 0xcd, 0xa8, 0x45, 0xb8,
+	0x22, 0xe8, 0xfa, 0x5e, 0xf4, 0xc7, 0x98, 0xe3, 0x12, 0xbb, 0x94, 0x8a, 0x95, 0x65, 0x8d, 0xc4,
+	0x2b, 0x9f, 0x26, 0x1d, 0x5f, 0xa0, 0xc3, 0x06, 0xfc, 0x1c, 0x15, 0xc0, 0xdd, 0x65, 0x74, 0xdf,
+	0x67, 0xd4, 0x89, 0xc6, 0x01, 0x73, 0x42, 0xf2, 0x2b, 0xe9, 0xbb, 0x19, 0x78, 0xa3, 0x25, 0xe9,
+	0xe0, 0x69, 0xd0, 0x15, 0xc0, 0xeb, 0xe8, 0x26, 0x67, 0x87, 0xa1, 0x33, 0x0c, 0x77, 0x3c, 0x6a,
+	0xe6, 0xcb, 0x46, 0x75, 0xb4, 0x39, 0x1b, 0xa9, 0x43, 0xb4, 0xa7, 0xb4, 0xf2, 0x2e, 0x8b, 0x8a,
+	0x69, 0x13, 0xe0, 0x22, 0x1a, 0x93, 0x8d, 0xcb, 0xe3, 0x90, 0x6f, 0xaa, 0x2f, 0x78, 0x1b, 0x21,
+	0x6d, 0x2d, 0x59, 0x79, 0x52, 0xee, 0xfc, 0xcd, 0x5a, 0xf4, 0xc6, 0x35, 0x04, 0x6e, 0xa0, 0x99,
+	0x9d, 0x9e, 0x78, 0xc5, 0xb8, 0x43, 0x28, 0xed, 0x31, 0x00, 0x06, 0xf2, 0xd9, 0xcf, 0xd7, 0xcd,
+	0xcf, 0x27, 0x2b, 0xc5, 0x98, 0xbc, 0xa1, 0xb4, 0x56, 0xd8, 0xf3, 0x78, 0xa7, 0x59, 0x50, 0x15,
+	0x1b, 0x83, 0x02, 0xbc, 0x85, 0x6e, 0x10, 0xdf, 0x17, 0x07, 0xbe, 0x07, 0x21, 0xa3, 0x1a, 0x69,
+	0xf4, 0x0f, 0xa4, 0xa2, 0x56, 0x96, 0xe0, 0x2a, 0x9f, 0x0c, 0x34, 0x1e, 0x1f, 0x53, 0xbc, 0x86,
+	0xc6, 0x63, 0x9c, 0x5a, 0xc4, 0x35, 0xb0, 0x81, 0x11, 0x1f, 0xa0, 0x31, 0x79, 0xc0, 0xcc, 0xec,
+	0xbf, 0x3a, 0xc4, 0x2a, 0xef, 0xc1, 0xc4, 0x9b, 0xa3, 0x52, 0xe6, 0xe7, 0x51, 0x29, 0x53, 0x6f,
+	0x9c, 0x9e, 0x5b, 0xc6, 0xd9, 0xb9, 0x65, 0xfc, 0x38, 0xb7, 0x8c, 0xb7, 0x17, 0x56, 0xe6, 0xec,
+	0xc2, 0xca, 0x7c, 0xb9, 0xb0, 0x32, 0x2f, 0x96, 0xae, 0x8d, 0x3a, 0x54, 0xd7, 0xab, 0x4c, 0x6c,
+	0xe7, 0xe4, 0x55, 0xb9, 0xfe, 0x6b, 0x00, 0x47, 0x98, 0xe0, 0xff, 0xe8, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +336,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextScheduledSendId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledSendId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ScheduledSends) > 0 {
+		for iNdEx := len(m.ScheduledSends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledSends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DenomCompliance) > 0 {
+		for iNdEx := len(m.DenomCompliance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCompliance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FactoryDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -282,6 +452,64 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomComplianceState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomComplianceState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomComplianceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowlistedAddresses) > 0 {
+		for iNdEx := len(m.AllowlistedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowlistedAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowlistedAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowlistedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Compliance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Balance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -369,6 +597,54 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FactoryDenoms) > 0 {
+		for _, e := range m.FactoryDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomCompliance) > 0 {
+		for _, e := range m.DenomCompliance {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledSends) > 0 {
+		for _, e := range m.ScheduledSends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledSendId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledSendId))
+	}
+	return n
+}
+
+func (m *DenomComplianceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Compliance.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowlistedAddresses) > 0 {
+		for _, s := range m.AllowlistedAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,6 +871,306 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryDenoms = append(m.FactoryDenoms, FactoryDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCompliance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCompliance = append(m.DenomCompliance, DenomComplianceState{})
+			if err := m.DenomCompliance[len(m.DenomCompliance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledSends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledSends = append(m.ScheduledSends, ScheduledSend{})
+			if err := m.ScheduledSends[len(m.ScheduledSends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledSendId", wireType)
+			}
+			m.NextScheduledSendId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledSendId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomComplianceState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomComplianceState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomComplianceState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compliance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Compliance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowlistedAddresses = append(m.AllowlistedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -136,6 +136,111 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid factory denoms",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{
+					{
+						Denom:             "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/token",
+						AuthorityMetadata: DenomAuthorityMetadata{Admin: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"dup factory denoms",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{
+					{Denom: "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/token"},
+					{Denom: "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/token"},
+				},
+			},
+			true,
+		},
+		{
+			"invalid factory denom",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{{Denom: "uatom"}},
+			},
+			true,
+		},
+		{
+			"valid denom compliance",
+			GenesisState{
+				DenomCompliance: []DenomComplianceState{
+					{
+						Denom: "uatom",
+						Compliance: DenomCompliance{
+							Issuer:   "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+							Treasury: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						},
+						FrozenAddresses: []string{"cosmos1a3dc2yfqv84ce6jl9g3u5d3wvd8dlshk8ra48x"},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"dup denom compliance",
+			GenesisState{
+				DenomCompliance: []DenomComplianceState{
+					{Denom: "uatom", Compliance: DenomCompliance{Issuer: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Treasury: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"}},
+					{Denom: "uatom", Compliance: DenomCompliance{Issuer: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Treasury: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"}},
+				},
+			},
+			true,
+		},
+		{
+			"denom compliance without issuer",
+			GenesisState{
+				DenomCompliance: []DenomComplianceState{{Denom: "uatom"}},
+			},
+			true,
+		},
+		{
+			"valid scheduled sends",
+			GenesisState{
+				ScheduledSends: []ScheduledSend{
+					{
+						Id:        1,
+						Sender:    "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Recipient: "cosmos1a3dc2yfqv84ce6jl9g3u5d3wvd8dlshk8ra48x",
+						Amount:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+						Remaining: 1,
+					},
+				},
+				NextScheduledSendId: 2,
+			},
+			false,
+		},
+		{
+			"scheduled send id not lower than the next id",
+			GenesisState{
+				ScheduledSends: []ScheduledSend{
+					{
+						Id:        2,
+						Sender:    "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Recipient: "cosmos1a3dc2yfqv84ce6jl9g3u5d3wvd8dlshk8ra48x",
+						Amount:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+						Remaining: 1,
+					},
+				},
+				NextScheduledSendId: 2,
+			},
+			true,
+		},
+		{
+			"dup scheduled sends",
+			GenesisState{
+				ScheduledSends: []ScheduledSend{
+					{Id: 1, Sender: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Recipient: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), Remaining: 1},
+					{Id: 1, Sender: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Recipient: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), Remaining: 1},
+				},
+				NextScheduledSendId: 2,
+			},
+			true,
+		},
 		{
 			"invalid supply",
 			GenesisState{
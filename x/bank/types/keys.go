@@ -35,6 +35,27 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// FactoryDenomsPrefix is the prefix for the authority of the factory denoms.
+	FactoryDenomsPrefix = collections.NewPrefix(6)
+	// CreatorDenomsPrefix is the prefix for the factory denoms by creator index.
+	CreatorDenomsPrefix = collections.NewPrefix(7)
+
+	// DenomCompliancePrefix is the prefix for the compliance controls of the denoms.
+	DenomCompliancePrefix = collections.NewPrefix(8)
+	// FrozenAddressesPrefix is the prefix for the addresses frozen per denom.
+	FrozenAddressesPrefix = collections.NewPrefix(9)
+	// AllowlistedAddressesPrefix is the prefix for the addresses allowlisted per denom.
+	AllowlistedAddressesPrefix = collections.NewPrefix(10)
+
+	// ScheduledSendsPrefix is the prefix for the scheduled sends by identifier.
+	ScheduledSendsPrefix = collections.NewPrefix(11)
+	// ScheduledSendQueuePrefix is the prefix for the scheduled sends by next execution time.
+	ScheduledSendQueuePrefix = collections.NewPrefix(12)
+	// SenderScheduledSendsPrefix is the prefix for the scheduled sends by sender index.
+	SenderScheduledSendsPrefix = collections.NewPrefix(13)
+	// ScheduledSendSeqKey is the key for the identifier of the next scheduled send.
+	ScheduledSendSeqKey = collections.NewPrefix(14)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
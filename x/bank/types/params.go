@@ -2,6 +2,8 @@ package types
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -9,6 +11,18 @@ import (
 // DefaultDefaultSendEnabled is the value that DefaultSendEnabled will have from DefaultParams().
 var DefaultDefaultSendEnabled = true
 
+// DefaultMaxScheduledSendsPerBlock is the value that MaxScheduledSendsPerBlock will have from DefaultParams().
+var DefaultMaxScheduledSendsPerBlock uint32 = 100
+
+// DefaultMinScheduledSendPeriod is the value that MinScheduledSendPeriod will have from DefaultParams().
+var DefaultMinScheduledSendPeriod = time.Hour
+
+// DefaultMaxScheduledSendCount is the value that MaxScheduledSendCount will have from DefaultParams().
+var DefaultMaxScheduledSendCount uint64 = 1000
+
+// DefaultMaxScheduledSendsPerSender is the value that MaxScheduledSendsPerSender will have from DefaultParams().
+var DefaultMaxScheduledSendsPerSender uint32 = 10
+
 // NewParams creates a new parameter configuration for the bank module
 func NewParams(defaultSendEnabled bool) Params {
 	return Params{
@@ -20,8 +34,12 @@ func NewParams(defaultSendEnabled bool) Params {
 // DefaultParams is the default parameter configuration for the bank module
 func DefaultParams() Params {
 	return Params{
-		SendEnabled:        nil,
-		DefaultSendEnabled: DefaultDefaultSendEnabled,
+		SendEnabled:                nil,
+		DefaultSendEnabled:         DefaultDefaultSendEnabled,
+		MaxScheduledSendsPerBlock:  DefaultMaxScheduledSendsPerBlock,
+		MinScheduledSendPeriod:     DefaultMinScheduledSendPeriod,
+		MaxScheduledSendCount:      DefaultMaxScheduledSendCount,
+		MaxScheduledSendsPerSender: DefaultMaxScheduledSendsPerSender,
 	}
 }
 
@@ -30,6 +48,12 @@ func (p Params) Validate() error {
 	if len(p.SendEnabled) > 0 {
 		return errors.New("use of send_enabled in params is no longer supported")
 	}
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+	if p.MinScheduledSendPeriod < 0 {
+		return fmt.Errorf("min scheduled send period must not be negative: %s", p.MinScheduledSendPeriod)
+	}
 	return nil
 }
 
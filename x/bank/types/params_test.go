@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -38,22 +39,22 @@ func Test_ParamsString(t *testing.T) {
 	}{
 		{
 			name:     "default true empty send enabled",
-			params:   Params{[]*SendEnabled{}, true},
+			params:   Params{[]*SendEnabled{}, true, nil, 0, 0, 0, 0},
 			expected: "default_send_enabled:true ",
 		},
 		{
 			name:     "default false empty send enabled",
-			params:   Params{[]*SendEnabled{}, false},
+			params:   Params{[]*SendEnabled{}, false, nil, 0, 0, 0, 0},
 			expected: "",
 		},
 		{
 			name:     "default true one true send enabled",
-			params:   Params{[]*SendEnabled{{"foocoin", true}}, true},
+			params:   Params{[]*SendEnabled{{"foocoin", true}}, true, nil, 0, 0, 0, 0},
 			expected: "send_enabled:<denom:\"foocoin\" enabled:true > default_send_enabled:true ",
 		},
 		{
 			name:     "default true one false send enabled",
-			params:   Params{[]*SendEnabled{{"barcoin", false}}, true},
+			params:   Params{[]*SendEnabled{{"barcoin", false}}, true, nil, 0, 0, 0, 0},
 			expected: "send_enabled:<denom:\"barcoin\" > default_send_enabled:true ",
 		},
 	}
@@ -69,5 +70,6 @@ func Test_validateParams(t *testing.T) {
 	assert.NoError(t, DefaultParams().Validate(), "default")
 	assert.NoError(t, NewParams(true).Validate(), "true")
 	assert.NoError(t, NewParams(false).Validate(), "false")
-	assert.Error(t, Params{[]*SendEnabled{{"foocoin", false}}, true}.Validate(), "with SendEnabled entry")
+	assert.Error(t, Params{[]*SendEnabled{{"foocoin", false}}, true, nil, 0, 0, 0, 0}.Validate(), "with SendEnabled entry")
+	assert.Error(t, Params{MinScheduledSendPeriod: -time.Second}.Validate(), "negative min scheduled send period")
 }
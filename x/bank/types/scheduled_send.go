@@ -0,0 +1,72 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgScheduleSend{}
+	_ sdk.Msg = &MsgCancelScheduledSend{}
+)
+
+// MaxScheduledSendFailures is the number of consecutive failed executions
+// after which a scheduled send is canceled.
+const MaxScheduledSendFailures = 3
+
+// ValidateSchedule validates the amount, period and count of executions of a
+// scheduled send.
+func ValidateSchedule(amount sdk.Coins, period time.Duration, count uint64) error {
+	if !amount.IsValid() || amount.IsZero() {
+		return fmt.Errorf("invalid amount %s", amount)
+	}
+
+	if count == 0 {
+		return errors.New("count must be positive")
+	}
+
+	if period < 0 || (count > 1 && period == 0) {
+		return fmt.Errorf("invalid period %s for %d executions", period, count)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of a scheduled send of the genesis state.
+func (s ScheduledSend) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(s.Sender); err != nil {
+		return fmt.Errorf("invalid sender %s: %w", s.Sender, err)
+	}
+
+	if _, err := ac.StringToBytes(s.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient %s: %w", s.Recipient, err)
+	}
+
+	if err := ValidateSchedule(s.Amount, s.Period, s.Remaining); err != nil {
+		return fmt.Errorf("scheduled send %d: %w", s.Id, err)
+	}
+
+	return nil
+}
+
+// NewMsgScheduleSend creates a new MsgScheduleSend instance.
+func NewMsgScheduleSend(sender, recipient string, amount sdk.Coins, startTime time.Time, period time.Duration, count uint64) *MsgScheduleSend {
+	return &MsgScheduleSend{
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+		StartTime: startTime,
+		Period:    period,
+		Count:     count,
+	}
+}
+
+// NewMsgCancelScheduledSend creates a new MsgCancelScheduledSend instance.
+func NewMsgCancelScheduledSend(sender string, id uint64) *MsgCancelScheduledSend {
+	return &MsgCancelScheduledSend{Sender: sender, Id: id}
+}
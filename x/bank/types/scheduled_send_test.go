@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestScheduledSendValidate(t *testing.T) {
+	ac := addresscodec.NewBech32Codec("cosmos")
+	addr := "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	require.NoError(t, ValidateSchedule(amount, 0, 1))
+	require.NoError(t, ValidateSchedule(amount, time.Hour, 12))
+	require.Error(t, ValidateSchedule(amount, time.Hour, 0))
+	require.Error(t, ValidateSchedule(amount, 0, 2))
+	require.Error(t, ValidateSchedule(amount, -time.Hour, 1))
+	require.Error(t, ValidateSchedule(sdk.Coins{}, time.Hour, 1))
+	require.Error(t, ValidateSchedule(sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.ZeroInt()}}, time.Hour, 1))
+
+	valid := ScheduledSend{Sender: addr, Recipient: addr, Amount: amount, Period: time.Hour, Remaining: 2}
+	require.NoError(t, valid.Validate(ac))
+
+	invalid := valid
+	invalid.Sender = "invalid"
+	require.Error(t, invalid.Validate(ac))
+
+	invalid = valid
+	invalid.Recipient = ""
+	require.Error(t, invalid.Validate(ac))
+
+	invalid = valid
+	invalid.Remaining = 0
+	require.Error(t, invalid.Validate(ac))
+}
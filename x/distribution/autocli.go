@@ -136,6 +136,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "WithdrawTokenizeShareRecordReward",
+					Use:       "withdraw-tokenize-share-rewards",
+					Short:     "Withdraw the rewards of all the tokenize share records owned by the sender",
+				},
 				{
 					RpcMethod: "WithdrawValidatorCommission",
 					Use:       "withdraw-validator-commission [validator-addr]",
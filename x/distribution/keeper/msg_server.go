@@ -76,6 +76,65 @@ func (k msgServer) WithdrawDelegatorReward(ctx context.Context, msg *types.MsgWi
 	return &types.MsgWithdrawDelegatorRewardResponse{Amount: amount}, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegations of
+// all the tokenize share records of an owner and sends them to the owner.
+func (k msgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, msg *types.MsgWithdrawTokenizeShareRecordReward) (*types.MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(msg.OwnerAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	records, err := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, errors.Wrap(types.ErrNoTokenizeShareRecords, msg.OwnerAddress)
+	}
+
+	amount := sdk.NewCoins()
+	for _, record := range records {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		recordAddr, err := k.authKeeper.AddressCodec().StringToBytes(record.ModuleAccount)
+		if err != nil {
+			return nil, err
+		}
+
+		// the rewards are withdrawn to the module account of the record, which
+		// also holds the rewards withdrawn on changes of its delegation
+		if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+			return nil, err
+		}
+
+		rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+		if rewards.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, rewards); err != nil {
+			return nil, err
+		}
+
+		amount = amount.Add(rewards...)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeReward,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.OwnerAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return &types.MsgWithdrawTokenizeShareRecordRewardResponse{Amount: amount}, nil
+}
+
 func (k msgServer) WithdrawValidatorCommission(ctx context.Context, msg *types.MsgWithdrawValidatorCommission) (*types.MsgWithdrawValidatorCommissionResponse, error) {
 	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
 	if err != nil {
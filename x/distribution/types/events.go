@@ -9,11 +9,13 @@ const (
 	EventTypeWithdrawCommission     = "withdraw_commission"
 	EventTypeProposerReward         = "proposer_reward"
 	EventTypeWithdrawAddrRedirected = "withdraw_addr_redirected"
+	EventTypeWithdrawTokenizeReward = "withdraw_tokenize_share_reward"
 
 	AttributeKeyWithdrawAddress         = "withdraw_address"
 	AttributeKeyValidator               = "validator"
 	AttributeKeyDelegator               = "delegator"
 	AttributeKeyOriginalWithdrawAddress = "original_withdraw_address"
+	AttributeKeyOwner                   = "owner"
 
 	AttributeValueCommunityPool = "community_pool"
 )
@@ -25,6 +25,7 @@ type BankKeeper interface {
 
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -53,6 +54,7 @@ type StakingKeeper interface {
 	GetAllSDKDelegations(ctx context.Context) ([]stakingtypes.Delegation, error)
 	GetAllValidators(ctx context.Context) ([]stakingtypes.Validator, error)
 	GetAllDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress) ([]stakingtypes.Delegation, error)
+	GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]stakingtypes.TokenizeShareRecord, error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -12,6 +12,7 @@ var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgWithdrawTokenizeShareRecordReward)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -34,6 +35,12 @@ func NewMsgWithdrawValidatorCommission(valAddr string) *MsgWithdrawValidatorComm
 	}
 }
 
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr string) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr,
+	}
+}
+
 // NewMsgFundCommunityPool returns a new MsgFundCommunityPool with a sender and
 // a funding amount.
 func NewMsgFundCommunityPool(amount sdk.Coins, depositor string) *MsgFundCommunityPool {
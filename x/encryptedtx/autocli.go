@@ -0,0 +1,52 @@
+package encryptedtx
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "cosmos.encryptedtx.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current encryptedtx parameters",
+				},
+				{
+					RpcMethod: "Keyset",
+					Use:       "keyset",
+					Short:     "Query the threshold encryption keyset transactions are encrypted to",
+				},
+				{
+					RpcMethod: "Envelopes",
+					Use:       "envelopes [height]",
+					Short:     "Query the encrypted transactions submitted at a height which are pending decryption",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "height"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "cosmos.encryptedtx.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SubmitEncryptedTx",
+					Skip:      true, // use the custom submit command encrypting a signed transaction
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateKeyset",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+			EnhanceCustomCommand: true,
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
+)
+
+// GetTxCmd returns the transaction commands for the encryptedtx module.
+func GetTxCmd() *cobra.Command {
+	encryptedTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Encrypted transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	encryptedTxCmd.AddCommand(
+		NewSubmitEncryptedTxCmd(),
+	)
+
+	return encryptedTxCmd
+}
+
+// NewSubmitEncryptedTxCmd returns a CLI command handler to encrypt a signed
+// transaction to the threshold encryption key of the validator set and submit
+// it in a MsgSubmitEncryptedTx.
+func NewSubmitEncryptedTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit [signed-tx-file]",
+		Short: "Encrypt a signed transaction and submit it for decryption and execution by the validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Encrypt a signed transaction to the threshold encryption key of the validator set
+and submit it from the '--from' account. The transaction is decrypted and executed two blocks
+later, after the validators released their decryption shares.
+
+When the '--from' account also signed the inner transaction, the inner transaction must be signed
+with the account sequence following the one of the submission.
+
+Example:
+$ %s tx %s submit signed.json --from mykey
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			innerTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			txBz, err := clientCtx.TxConfig.TxEncoder()(innerTx)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Keyset(cmd.Context(), &types.QueryKeysetRequest{})
+			if err != nil {
+				return err
+			}
+
+			ephemeralKey, ciphertext, err := threshold.Encrypt(rand.Reader, res.Keyset.PublicKey, txBz)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubmitEncryptedTx{
+				Sender:       clientCtx.GetFromAddress().String(),
+				EphemeralKey: ephemeralKey,
+				Ciphertext:   ciphertext,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,13 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker prunes the envelopes which were decrypted, or dropped, in the
+// current block.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	return k.PruneEnvelopes(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight()-2)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
+)
+
+// EnvelopeDecorator rejects transactions submitting envelopes which cannot be
+// stored, e.g. because no keyset is set, the ciphertext is too large or the
+// block limit is reached. It lets CheckTx keep such envelopes out of the
+// mempool instead of only failing them on execution. It must be chained after
+// the decorators deducting the fees and verifying the signatures.
+type EnvelopeDecorator struct {
+	keeper Keeper
+}
+
+// NewEnvelopeDecorator returns a new EnvelopeDecorator.
+func NewEnvelopeDecorator(k Keeper) EnvelopeDecorator {
+	return EnvelopeDecorator{
+		keeper: k,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d EnvelopeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		submit, ok := msg.(*types.MsgSubmitEncryptedTx)
+		if !ok {
+			continue
+		}
+
+		if _, err := d.keeper.validateEnvelope(ctx, submit); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"crypto/rand"
+
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/keeper"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
+)
+
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func (s *KeeperTestSuite) TestEnvelopeDecorator() {
+	ephemeralKey, ciphertext, err := threshold.Encrypt(rand.Reader, s.keyset.PublicKey, []byte("tx"))
+	s.Require().NoError(err)
+
+	params := types.DefaultParams()
+	params.MaxEnvelopesPerBlock = 1
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	decorator := keeper.NewEnvelopeDecorator(s.keeper)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	valid := &types.MsgSubmitEncryptedTx{Sender: s.sender, EphemeralKey: ephemeralKey, Ciphertext: ciphertext}
+
+	_, err = decorator.AnteHandle(s.ctx, testTx{msgs: []sdk.Msg{valid}}, false, next)
+	s.Require().NoError(err)
+
+	_, err = decorator.AnteHandle(s.ctx, testTx{msgs: []sdk.Msg{&types.MsgSubmitEncryptedTx{Sender: s.sender, EphemeralKey: []byte("invalid"), Ciphertext: ciphertext}}}, false, next)
+	s.Require().ErrorIs(err, types.ErrInvalidEnvelope)
+
+	// other messages are ignored
+	_, err = decorator.AnteHandle(s.ctx, testTx{msgs: []sdk.Msg{&types.MsgUpdateParams{}}}, false, next)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SubmitEncryptedTx(s.ctx, valid)
+	s.Require().NoError(err)
+
+	_, err = decorator.AnteHandle(s.ctx, testTx{msgs: []sdk.Msg{valid}}, false, next)
+	s.Require().ErrorIs(err, types.ErrBlockEnvelopesLimit)
+
+	s.Require().NoError(s.keeper.Keyset.Remove(s.ctx))
+	_, err = decorator.AnteHandle(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1), testTx{msgs: []sdk.Msg{valid}}, false, next)
+	s.Require().ErrorIs(err, types.ErrNoKeyset)
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
+)
+
+// InitGenesis initializes the x/encryptedtx module's state from a provided
+// genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	if gs.Keyset != nil {
+		return k.Keyset.Set(ctx, *gs.Keyset)
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the x/encryptedtx module's exported genesis. Envelopes
+// pending decryption are not exported, they only live for two blocks.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := types.NewGenesisState(params, nil)
+
+	keyset, err := k.Keyset.Get(ctx)
+	switch {
+	case err == nil:
+		gs.Keyset = &keyset
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	return gs, nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/encryptedtx QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the encryptedtx module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Keyset returns the threshold encryption keyset.
+func (q queryServer) Keyset(ctx context.Context, _ *types.QueryKeysetRequest) (*types.QueryKeysetResponse, error) {
+	keyset, err := q.k.Keyset.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, types.ErrNoKeyset.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryKeysetResponse{Keyset: keyset}, nil
+}
+
+// Envelopes returns the envelopes submitted at a given height which are
+// pending decryption.
+func (q queryServer) Envelopes(ctx context.Context, req *types.QueryEnvelopesRequest) (*types.QueryEnvelopesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	envelopes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Envelopes,
+		req.Pagination,
+		func(key collections.Pair[int64, uint64], envelope types.Envelope) (types.IdentifiedEnvelope, error) {
+			return types.IdentifiedEnvelope{EnvelopeId: key.K2(), Envelope: envelope}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[int64, uint64](req.Height),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEnvelopesResponse{Envelopes: envelopes, Pagination: pageRes}, nil
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
+)
+
+// Keeper defines the x/encryptedtx keeper. It stores the threshold encryption
+// keyset of the validator set and the encrypted transactions pending
+// decryption.
+type Keeper struct {
+	storeService store.KVStoreService
+	cdc          codec.BinaryCodec
+
+	// the address capable of executing MsgUpdateParams and MsgUpdateKeyset.
+	// Typically, this should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	Keyset collections.Item[types.Keyset]
+	// Envelopes are the encrypted transactions pending decryption, keyed by
+	// the height they were submitted at and their identifier.
+	Envelopes  collections.Map[collections.Pair[int64, uint64], types.Envelope]
+	EnvelopeID collections.Sequence
+	// EnvelopeCounts is the number of envelopes submitted at each height
+	// pending decryption, which bounds the envelopes of a block without
+	// iterating them.
+	EnvelopeCounts collections.Map[int64, uint32]
+}
+
+// NewKeeper returns a new x/encryptedtx keeper.
+func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authority string) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService: storeService,
+		cdc:          cdc,
+		authority:    authority,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Keyset:       collections.NewItem(sb, types.KeysetKey, "keyset", codec.CollValue[types.Keyset](cdc)),
+		Envelopes: collections.NewMap(
+			sb, types.EnvelopesKey, "envelopes",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+			codec.CollValue[types.Envelope](cdc),
+		),
+		EnvelopeID:     collections.NewSequence(sb, types.EnvelopeIDKey, "envelope_id"),
+		EnvelopeCounts: collections.NewMap(sb, types.EnvelopeCountsKey, "envelope_counts", collections.Int64Key, collections.Uint32Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/encryptedtx module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetEnvelopes returns the envelopes submitted at the given height, ordered by
+// identifier.
+func (k Keeper) GetEnvelopes(ctx context.Context, height int64) ([]types.IdentifiedEnvelope, error) {
+	iter, err := k.Envelopes.Iterate(ctx, collections.NewPrefixedPairRange[int64, uint64](height))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var envelopes []types.IdentifiedEnvelope
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		envelopes = append(envelopes, types.IdentifiedEnvelope{
+			EnvelopeId: kv.Key.K2(),
+			Envelope:   kv.Value,
+		})
+	}
+
+	return envelopes, nil
+}
+
+// PruneEnvelopes removes the envelopes submitted at or before the given
+// height.
+func (k Keeper) PruneEnvelopes(ctx context.Context, height int64) error {
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndExclusive(collections.Join(height+1, uint64(0)))
+
+	if err := k.Envelopes.Clear(ctx, rng); err != nil {
+		return err
+	}
+
+	return k.EnvelopeCounts.Clear(ctx, new(collections.Range[int64]).EndInclusive(height))
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/keeper"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	keeper    keeper.Keeper
+	msgServer types.MsgServer
+	authority string
+	sender    string
+
+	keyset     threshold.Keyset
+	validators []sdk.ConsAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(encryptedtx.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeight(10)
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.sender = sdk.AccAddress("sender").String()
+	s.keeper = keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), s.authority)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+
+	var err error
+	s.keyset, err = threshold.GenerateKeyset(rand.Reader, 2, 3)
+	s.Require().NoError(err)
+
+	s.validators = []sdk.ConsAddress{
+		sdk.ConsAddress("validator1"),
+		sdk.ConsAddress("validator2"),
+		sdk.ConsAddress("validator3"),
+	}
+
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, *types.NewGenesisState(types.DefaultParams(), s.onChainKeyset())))
+}
+
+func (s *KeeperTestSuite) onChainKeyset() *types.Keyset {
+	keyset := &types.Keyset{
+		PublicKey: s.keyset.PublicKey,
+		Threshold: 2,
+	}
+
+	for i, validator := range s.validators {
+		keyset.Shares = append(keyset.Shares, types.KeyShare{
+			ValidatorAddress: validator.String(),
+			Index:            s.keyset.SecretShares[i].Index,
+			PublicShare:      s.keyset.PublicShares[i],
+		})
+	}
+
+	return keyset
+}
+
+// submit encrypts tx to the keyset and submits it at the current height.
+func (s *KeeperTestSuite) submit(tx []byte) uint64 {
+	ephemeralKey, ciphertext, err := threshold.Encrypt(rand.Reader, s.keyset.PublicKey, tx)
+	s.Require().NoError(err)
+
+	res, err := s.msgServer.SubmitEncryptedTx(s.ctx, &types.MsgSubmitEncryptedTx{
+		Sender:       s.sender,
+		EphemeralKey: ephemeralKey,
+		Ciphertext:   ciphertext,
+	})
+	s.Require().NoError(err)
+
+	return res.EnvelopeId
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), gs.Params)
+	s.Require().Equal(s.onChainKeyset(), gs.Keyset)
+	s.Require().NoError(gs.Validate())
+
+	s.Require().NoError(s.keeper.Keyset.Remove(s.ctx))
+	gs, err = s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Nil(gs.Keyset)
+}
+
+func (s *KeeperTestSuite) TestPruneEnvelopes() {
+	for height := int64(10); height < 13; height++ {
+		s.ctx = s.ctx.WithBlockHeight(height)
+		s.submit([]byte("tx"))
+	}
+
+	s.ctx = s.ctx.WithBlockHeight(13)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	for height, expected := range map[int64]int{10: 0, 11: 0, 12: 1} {
+		envelopes, err := s.keeper.GetEnvelopes(s.ctx, height)
+		s.Require().NoError(err)
+		s.Require().Len(envelopes, expected, "height %d", height)
+
+		has, err := s.keeper.EnvelopeCounts.Has(s.ctx, height)
+		s.Require().NoError(err)
+		s.Require().Equal(expected > 0, has, "height %d", height)
+	}
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/encryptedtx MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// SubmitEncryptedTx stores an encrypted transaction until the validators
+// release their decryption shares for it.
+func (ms msgServer) SubmitEncryptedTx(goCtx context.Context, msg *types.MsgSubmitEncryptedTx) (*types.MsgSubmitEncryptedTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	count, err := ms.validateEnvelope(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := ms.EnvelopeID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := types.Envelope{
+		Sender:       msg.Sender,
+		EphemeralKey: msg.EphemeralKey,
+		Ciphertext:   msg.Ciphertext,
+	}
+	if err := ms.Envelopes.Set(ctx, collections.Join(ctx.BlockHeight(), id), envelope); err != nil {
+		return nil, err
+	}
+
+	if err := ms.EnvelopeCounts.Set(ctx, ctx.BlockHeight(), count+1); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSubmitEncryptedTx{
+		Sender:     msg.Sender,
+		Height:     ctx.BlockHeight(),
+		EnvelopeId: id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitEncryptedTxResponse{EnvelopeId: id}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, ms.authority, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// UpdateKeyset replaces the threshold encryption keyset. The envelopes pending
+// decryption were encrypted to the previous key and are dropped.
+func (ms msgServer) UpdateKeyset(goCtx context.Context, msg *types.MsgUpdateKeyset) (*types.MsgUpdateKeysetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, ms.authority, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Keyset.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keyset.Set(ctx, msg.Keyset); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Envelopes.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err := ms.EnvelopeCounts.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateKeyset{
+		PublicKey: msg.Keyset.PublicKey,
+		Threshold: msg.Keyset.Threshold,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateKeysetResponse{}, nil
+}
+
+// validateEnvelope checks that an envelope can be submitted in the current
+// block and returns the number of envelopes already submitted in it.
+func (k Keeper) validateEnvelope(ctx context.Context, msg *types.MsgSubmitEncryptedTx) (uint32, error) {
+	if has, err := k.Keyset.Has(ctx); err != nil {
+		return 0, err
+	} else if !has {
+		return 0, types.ErrNoKeyset
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := threshold.ValidatePoint(msg.EphemeralKey); err != nil {
+		return 0, errorsmod.Wrapf(types.ErrInvalidEnvelope, "ephemeral key: %s", err)
+	}
+
+	if len(msg.Ciphertext) == 0 || uint64(len(msg.Ciphertext)) > params.MaxCiphertextSize {
+		return 0, errorsmod.Wrapf(types.ErrInvalidEnvelope, "ciphertext size must be in [1, %d], got %d", params.MaxCiphertextSize, len(msg.Ciphertext))
+	}
+
+	count, err := k.EnvelopeCounts.Get(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	if count >= params.MaxEnvelopesPerBlock {
+		return 0, errorsmod.Wrapf(types.ErrBlockEnvelopesLimit, "limit is %d", params.MaxEnvelopesPerBlock)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"crypto/rand"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
+)
+
+func (s *KeeperTestSuite) TestSubmitEncryptedTx() {
+	ephemeralKey, ciphertext, err := threshold.Encrypt(rand.Reader, s.keyset.PublicKey, []byte("tx"))
+	s.Require().NoError(err)
+
+	params := types.DefaultParams()
+	params.MaxEnvelopesPerBlock = 2
+	params.MaxCiphertextSize = uint64(len(ciphertext))
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSubmitEncryptedTx
+		expErr string
+	}{
+		{
+			name:   "invalid sender",
+			msg:    &types.MsgSubmitEncryptedTx{Sender: "invalid", EphemeralKey: ephemeralKey, Ciphertext: ciphertext},
+			expErr: "invalid sender address",
+		},
+		{
+			name:   "invalid ephemeral key",
+			msg:    &types.MsgSubmitEncryptedTx{Sender: s.sender, EphemeralKey: []byte("invalid"), Ciphertext: ciphertext},
+			expErr: "ephemeral key",
+		},
+		{
+			name:   "empty ciphertext",
+			msg:    &types.MsgSubmitEncryptedTx{Sender: s.sender, EphemeralKey: ephemeralKey},
+			expErr: "ciphertext size",
+		},
+		{
+			name:   "ciphertext too large",
+			msg:    &types.MsgSubmitEncryptedTx{Sender: s.sender, EphemeralKey: ephemeralKey, Ciphertext: append(ciphertext, 0)},
+			expErr: "ciphertext size",
+		},
+		{
+			name: "valid",
+			msg:  &types.MsgSubmitEncryptedTx{Sender: s.sender, EphemeralKey: ephemeralKey, Ciphertext: ciphertext},
+		},
+		{
+			name: "valid second envelope",
+			msg:  &types.MsgSubmitEncryptedTx{Sender: s.sender, EphemeralKey: ephemeralKey, Ciphertext: ciphertext},
+		},
+		{
+			name:   "block limit reached",
+			msg:    &types.MsgSubmitEncryptedTx{Sender: s.sender, EphemeralKey: ephemeralKey, Ciphertext: ciphertext},
+			expErr: types.ErrBlockEnvelopesLimit.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.SubmitEncryptedTx(s.ctx, tc.msg)
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+		})
+	}
+
+	envelopes, err := s.keeper.GetEnvelopes(s.ctx, s.ctx.BlockHeight())
+	s.Require().NoError(err)
+	s.Require().Len(envelopes, 2)
+	s.Require().Equal(uint64(0), envelopes[0].EnvelopeId)
+	s.Require().Equal(uint64(1), envelopes[1].EnvelopeId)
+	s.Require().Equal(types.Envelope{Sender: s.sender, EphemeralKey: ephemeralKey, Ciphertext: ciphertext}, envelopes[0].Envelope)
+
+	// the limit is per block
+	_, err = s.msgServer.SubmitEncryptedTx(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1), testCases[4].msg)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestSubmitEncryptedTxNoKeyset() {
+	s.Require().NoError(s.keeper.Keyset.Remove(s.ctx))
+
+	ephemeralKey, ciphertext, err := threshold.Encrypt(rand.Reader, s.keyset.PublicKey, []byte("tx"))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SubmitEncryptedTx(s.ctx, &types.MsgSubmitEncryptedTx{
+		Sender:       s.sender,
+		EphemeralKey: ephemeralKey,
+		Ciphertext:   ciphertext,
+	})
+	s.Require().ErrorIs(err, types.ErrNoKeyset)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.MaxEnvelopesPerBlock = 5
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.sender, Params: params})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.Params{}})
+	s.Require().ErrorContains(err, "max ciphertext size")
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.Params{MaxCiphertextSize: 1}})
+	s.Require().ErrorContains(err, "max envelopes per block must be positive")
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.Params{MaxEnvelopesPerBlock: 1000, MaxCiphertextSize: types.MaxEnvelopeBytesPerBlock}})
+	s.Require().ErrorContains(err, "must not exceed")
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+
+	got, err := s.keeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params, got)
+}
+
+func (s *KeeperTestSuite) TestUpdateKeyset() {
+	s.submit([]byte("tx"))
+
+	keyset := *s.onChainKeyset()
+
+	_, err := s.msgServer.UpdateKeyset(s.ctx, &types.MsgUpdateKeyset{Authority: s.sender, Keyset: keyset})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	invalid := keyset
+	invalid.Threshold = 4
+	_, err = s.msgServer.UpdateKeyset(s.ctx, &types.MsgUpdateKeyset{Authority: s.authority, Keyset: invalid})
+	s.Require().ErrorIs(err, types.ErrInvalidKeyset)
+
+	invalid = keyset
+	invalid.Shares = []types.KeyShare{keyset.Shares[0], keyset.Shares[0]}
+	invalid.Threshold = 1
+	_, err = s.msgServer.UpdateKeyset(s.ctx, &types.MsgUpdateKeyset{Authority: s.authority, Keyset: invalid})
+	s.Require().ErrorContains(err, "duplicate share")
+
+	invalid = keyset
+	invalid.Shares = []types.KeyShare{{ValidatorAddress: sdk.ConsAddress("other").String(), Index: 1, PublicShare: []byte("invalid")}}
+	invalid.Threshold = 1
+	_, err = s.msgServer.UpdateKeyset(s.ctx, &types.MsgUpdateKeyset{Authority: s.authority, Keyset: invalid})
+	s.Require().ErrorContains(err, "public share")
+
+	_, err = s.msgServer.UpdateKeyset(s.ctx, &types.MsgUpdateKeyset{Authority: s.authority, Keyset: keyset})
+	s.Require().NoError(err)
+
+	// pending envelopes are dropped
+	envelopes, err := s.keeper.GetEnvelopes(s.ctx, s.ctx.BlockHeight())
+	s.Require().NoError(err)
+	s.Require().Empty(envelopes)
+}
@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/voteext"
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
+)
+
+// ProposalHandler injects the transactions decrypted from the envelopes
+// submitted two blocks earlier at the beginning of the block, before any
+// transaction selected by the proposer. The decrypted transactions are then
+// executed, and verified by the ante handler, in FinalizeBlock like any other
+// transaction.
+//
+// The handlers must be wrapped by the proposal handlers of a voteext.Manager,
+// from which they read the extended commit holding the decryption shares.
+type ProposalHandler struct {
+	keeper  Keeper
+	handler *voteext.TypedHandler[types.DecryptionShares]
+}
+
+// NewProposalHandler returns a new ProposalHandler.
+func NewProposalHandler(k Keeper) *ProposalHandler {
+	return &ProposalHandler{
+		keeper:  k,
+		handler: NewVoteExtensionHandler(k, nil),
+	}
+}
+
+// PrepareProposalHandler returns a PrepareProposalHandler which prepends the
+// decrypted transactions to the transactions selected by the next handler. If
+// the decrypted transactions do not fit in the block, the block is filled with
+// as many of them as fit, in order, and the remaining ones are dropped.
+func (h *ProposalHandler) PrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		txs, err := h.DecryptedTxs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(txs) == 0 {
+			return next(ctx, req)
+		}
+
+		if fitting := fittingTxs(txs, req.MaxTxBytes); fitting < len(txs) {
+			ctx.Logger().Error("dropping decrypted transactions exceeding max tx bytes", "height", req.Height, "dropped", len(txs)-fitting, "max_tx_bytes", req.MaxTxBytes)
+			return &abci.ResponsePrepareProposal{Txs: txs[:fitting]}, nil
+		}
+
+		injectedSize := cmttypes.ComputeProtoSizeForTxs(cmttypes.ToTxs(txs))
+
+		nextReq := *req
+		nextReq.MaxTxBytes -= injectedSize
+
+		resp, err := next(ctx, &nextReq)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Txs = append(txs, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposalHandler which rejects
+// proposals which do not start with exactly the decrypted transactions. The
+// remaining transactions are passed to the next handler.
+//
+// A proposal holding only a prefix of the decrypted transactions is accepted
+// if it holds no other transaction and the next decrypted transaction could
+// not fit in the block, as the proposer drops the decrypted transactions
+// exceeding its max tx bytes.
+func (h *ProposalHandler) ProcessProposalHandler(next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		txs, err := h.DecryptedTxs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		included := 0
+		for included < len(txs) && included < len(req.Txs) && bytes.Equal(txs[included], req.Txs[included]) {
+			included++
+		}
+
+		if included < len(txs) {
+			maxTxBytes, err := minMaxTxBytes(ctx, len(req.ProposedLastCommit.Votes))
+			if err != nil {
+				return nil, err
+			}
+
+			nextSize := cmttypes.ComputeProtoSizeForTxs(cmttypes.ToTxs(txs[:included+1]))
+			if len(req.Txs) != included || nextSize <= maxTxBytes {
+				ctx.Logger().Error("rejecting proposal without decrypted transactions", "height", req.Height, "index", included)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		}
+
+		nextReq := *req
+		nextReq.Txs = req.Txs[len(txs):]
+
+		return next(ctx, &nextReq)
+	}
+}
+
+// fittingTxs returns the number of transactions, in order, whose total size
+// does not exceed maxTxBytes.
+func fittingTxs(txs [][]byte, maxTxBytes int64) int {
+	var size int64
+	for i, tx := range txs {
+		size += cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx})
+		if size > maxTxBytes {
+			return i
+		}
+	}
+
+	return len(txs)
+}
+
+// minMaxTxBytes returns a lower bound of the max tx bytes given to the
+// PrepareProposalHandler of the proposer of the current block. CometBFT gives
+// the proposer at least the block max bytes minus evidence of the maximum size,
+// allowing the validator set to double since the last commit, which had
+// valsCount votes. The voteext.Manager then reduces it by the size of the
+// injected extended commit, as in its PrepareProposalHandler.
+func minMaxTxBytes(ctx sdk.Context, valsCount int) (int64, error) {
+	params := ctx.ConsensusParams()
+
+	maxBytes := int64(cmttypes.MaxBlockSizeBytes)
+	if params.Block != nil && params.Block.MaxBytes > 0 {
+		maxBytes = params.Block.MaxBytes
+	}
+
+	var evidenceBytes int64
+	if params.Evidence != nil {
+		evidenceBytes = params.Evidence.MaxBytes
+	}
+
+	maxTxBytes := maxBytes - cmttypes.MaxOverheadForBlock - cmttypes.MaxHeaderBytes - cmttypes.MaxCommitBytes(2*valsCount) - evidenceBytes
+
+	commit, ok := voteext.ExtendedCommitFromContext(ctx)
+	if !ok {
+		return maxTxBytes, nil
+	}
+
+	bz, err := commit.Marshal()
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode extended commit: %w", err)
+	}
+
+	return maxTxBytes - cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz}), nil
+}
+
+// DecryptedTxs returns the transactions decrypted from the envelopes submitted
+// two blocks before the current one, using the decryption shares of the
+// extended commit of the previous height. The shares are combined in the
+// order of the commit votes until the keyset threshold is reached, invalid
+// shares being ignored. Envelopes for which not enough shares were released or
+// which fail to decrypt are dropped. The result is deterministic given the
+// commit, so the proposer and the other validators agree on it.
+func (h *ProposalHandler) DecryptedTxs(ctx sdk.Context) ([][]byte, error) {
+	commit, ok := voteext.ExtendedCommitFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	envelopes, err := h.keeper.GetEnvelopes(ctx, ctx.BlockHeight()-2)
+	if err != nil || len(envelopes) == 0 {
+		return nil, err
+	}
+
+	keyset, err := h.keeper.Keyset.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	votes, _, err := h.handler.Votes(commit)
+	if err != nil {
+		return nil, err
+	}
+
+	type validatorShares struct {
+		keyShare types.KeyShare
+		shares   map[uint64]types.DecryptionShare
+	}
+
+	validators := make([]validatorShares, 0, len(votes))
+	for _, vote := range votes {
+		keyShare, ok := keyset.ShareOf(vote.Validator)
+		if !ok {
+			continue
+		}
+
+		shares := make(map[uint64]types.DecryptionShare, len(vote.Value.Shares))
+		for _, share := range vote.Value.Shares {
+			shares[share.EnvelopeId] = share
+		}
+
+		validators = append(validators, validatorShares{keyShare: keyShare, shares: shares})
+	}
+
+	var txs [][]byte
+	for _, envelope := range envelopes {
+		var shares []threshold.DecryptionShare
+		for _, validator := range validators {
+			if len(shares) == int(keyset.Threshold) {
+				break
+			}
+
+			share, ok := validator.shares[envelope.EnvelopeId]
+			if !ok {
+				continue
+			}
+
+			if err := threshold.VerifyDecryptionShare(validator.keyShare.PublicShare, envelope.Envelope.EphemeralKey, share.Share, share.Proof); err != nil {
+				continue
+			}
+
+			shares = append(shares, threshold.DecryptionShare{Index: validator.keyShare.Index, Share: share.Share})
+		}
+
+		tx, err := threshold.Decrypt(keyset.Threshold, envelope.Envelope.EphemeralKey, envelope.Envelope.Ciphertext, shares)
+		if err != nil {
+			ctx.Logger().Info("dropping encrypted transaction", "envelope_id", envelope.EnvelopeId, "err", err)
+			continue
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
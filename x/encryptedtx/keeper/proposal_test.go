@@ -0,0 +1,237 @@
+package keeper_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/voteext"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/keeper"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
+)
+
+// extendVotes returns the vote extension data of the validators at the
+// current height.
+func (s *KeeperTestSuite) extendVotes() [][]byte {
+	data := make([][]byte, len(s.validators))
+	for i := range s.validators {
+		handler := keeper.NewVoteExtensionHandler(s.keeper, &s.keyset.SecretShares[i])
+
+		bz, err := handler.ExtendVote(s.ctx, &abci.RequestExtendVote{Height: s.ctx.BlockHeight()})
+		s.Require().NoError(err)
+		data[i] = bz
+	}
+
+	return data
+}
+
+// extendedCommit returns an extended commit with the given vote extension data
+// of the validators, skipping nil entries.
+func (s *KeeperTestSuite) extendedCommit(data [][]byte) abci.ExtendedCommitInfo {
+	var commit abci.ExtendedCommitInfo
+	for i, bz := range data {
+		if bz == nil {
+			continue
+		}
+
+		ext, err := (&voteext.MultiplexedVoteExtension{
+			Extensions: []voteext.ModuleVoteExtension{{Name: types.ModuleName, Data: bz}},
+		}).Marshal()
+		s.Require().NoError(err)
+
+		commit.Votes = append(commit.Votes, abci.ExtendedVoteInfo{
+			Validator:     abci.Validator{Address: s.validators[i], Power: 10},
+			VoteExtension: ext,
+			BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+		})
+	}
+
+	return commit
+}
+
+func (s *KeeperTestSuite) TestVoteExtension() {
+	s.submit([]byte("tx1"))
+	s.submit([]byte("tx2"))
+
+	s.ctx = s.ctx.WithBlockHeight(11)
+	data := s.extendVotes()
+	handler := keeper.NewVoteExtensionHandler(s.keeper, nil)
+
+	for i, validator := range s.validators {
+		shares, err := handler.Decode(data[i])
+		s.Require().NoError(err)
+		s.Require().Len(shares.Shares, 2)
+
+		req := &abci.RequestVerifyVoteExtension{Height: 11, ValidatorAddress: validator}
+		s.Require().NoError(handler.VerifyVoteExtension(s.ctx, req, data[i]))
+
+		// shares of another validator
+		req.ValidatorAddress = s.validators[(i+1)%len(s.validators)]
+		s.Require().ErrorIs(handler.VerifyVoteExtension(s.ctx, req, data[i]), types.ErrInvalidDecryptionShares)
+	}
+
+	// nodes without share extend with no data
+	bz, err := handler.ExtendVote(s.ctx, &abci.RequestExtendVote{Height: 11})
+	s.Require().NoError(err)
+	s.Require().Empty(bz)
+
+	// a validator without key share cannot publish shares
+	req := &abci.RequestVerifyVoteExtension{Height: 11, ValidatorAddress: sdk.ConsAddress("other")}
+	s.Require().ErrorIs(handler.VerifyVoteExtension(s.ctx, req, data[0]), types.ErrInvalidDecryptionShares)
+
+	// shares must be for envelopes of the previous height
+	req = &abci.RequestVerifyVoteExtension{Height: 12, ValidatorAddress: s.validators[0]}
+	s.Require().ErrorIs(handler.VerifyVoteExtension(s.ctx, req, data[0]), types.ErrInvalidDecryptionShares)
+
+	shares, err := handler.Decode(data[0])
+	s.Require().NoError(err)
+	shares.Shares = append(shares.Shares, shares.Shares[0])
+	bz, err = shares.Marshal()
+	s.Require().NoError(err)
+	req = &abci.RequestVerifyVoteExtension{Height: 11, ValidatorAddress: s.validators[0]}
+	s.Require().ErrorContains(handler.VerifyVoteExtension(s.ctx, req, bz), "duplicate share")
+}
+
+func (s *KeeperTestSuite) TestDecryptedTxs() {
+	s.submit([]byte("tx1"))
+	s.submit([]byte("tx2"))
+
+	s.ctx = s.ctx.WithBlockHeight(11)
+	data := s.extendVotes()
+
+	// an invalid share is skipped in favor of the next validator
+	invalid, err := keeper.NewVoteExtensionHandler(s.keeper, nil).Decode(data[0])
+	s.Require().NoError(err)
+	invalid.Shares[0].Share = invalid.Shares[1].Share
+	invalidBz, err := invalid.Marshal()
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		data   [][]byte
+		expTxs [][]byte
+	}{
+		{
+			name:   "all validators",
+			data:   data,
+			expTxs: [][]byte{[]byte("tx1"), []byte("tx2")},
+		},
+		{
+			name:   "threshold of validators",
+			data:   [][]byte{nil, data[1], data[2]},
+			expTxs: [][]byte{[]byte("tx1"), []byte("tx2")},
+		},
+		{
+			name:   "invalid share",
+			data:   [][]byte{invalidBz, data[1], data[2]},
+			expTxs: [][]byte{[]byte("tx1"), []byte("tx2")},
+		},
+		{
+			name:   "invalid share below threshold",
+			data:   [][]byte{invalidBz, data[1], nil},
+			expTxs: [][]byte{[]byte("tx2")},
+		},
+		{
+			name: "below threshold",
+			data: [][]byte{data[0], nil, nil},
+		},
+	}
+
+	ctx := s.ctx.WithBlockHeight(12)
+	handler := keeper.NewProposalHandler(s.keeper)
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			txs, err := handler.DecryptedTxs(voteext.WithExtendedCommit(ctx, s.extendedCommit(tc.data)))
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expTxs, txs)
+		})
+	}
+
+	// no extended commit, i.e. vote extensions disabled
+	txs, err := handler.DecryptedTxs(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(txs)
+
+	// envelopes are only decrypted two blocks after their submission
+	txs, err = handler.DecryptedTxs(voteext.WithExtendedCommit(ctx.WithBlockHeight(13), s.extendedCommit(data)))
+	s.Require().NoError(err)
+	s.Require().Empty(txs)
+}
+
+func (s *KeeperTestSuite) TestProposalHandlers() {
+	s.submit([]byte("tx1"))
+
+	s.ctx = s.ctx.WithBlockHeight(11)
+	ctx := voteext.WithExtendedCommit(s.ctx.WithBlockHeight(12), s.extendedCommit(s.extendVotes()))
+	handler := keeper.NewProposalHandler(s.keeper)
+
+	var maxTxBytes int64
+	prepare := handler.PrepareProposalHandler(func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		maxTxBytes = req.MaxTxBytes
+		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+	})
+
+	prepareResp, err := prepare(ctx, &abci.RequestPrepareProposal{Height: 12, MaxTxBytes: 1000, Txs: [][]byte{[]byte("mempool")}})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{[]byte("tx1"), []byte("mempool")}, prepareResp.Txs)
+	s.Require().Less(maxTxBytes, int64(1000))
+
+	// decrypted transactions which do not fit are dropped
+	trimmedResp, err := prepare(ctx, &abci.RequestPrepareProposal{Height: 12, MaxTxBytes: 2, Txs: [][]byte{[]byte("mempool")}})
+	s.Require().NoError(err)
+	s.Require().Empty(trimmedResp.Txs)
+
+	var processed [][]byte
+	process := handler.ProcessProposalHandler(func(_ sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		processed = req.Txs
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	})
+
+	processResp, err := process(ctx, &abci.RequestProcessProposal{Height: 12, Txs: prepareResp.Txs})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_ACCEPT, processResp.Status)
+	s.Require().Equal([][]byte{[]byte("mempool")}, processed)
+
+	for _, txs := range [][][]byte{nil, {[]byte("mempool")}, {[]byte("tx2"), []byte("mempool")}} {
+		processResp, err = process(ctx, &abci.RequestProcessProposal{Height: 12, Txs: txs})
+		s.Require().NoError(err)
+		s.Require().Equal(abci.ResponseProcessProposal_REJECT, processResp.Status)
+	}
+
+	// decrypted transactions may only be dropped if they do not fit in the block
+	smallBlockCtx := ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: cmttypes.MaxOverheadForBlock + cmttypes.MaxHeaderBytes + 1},
+	})
+	processResp, err = process(smallBlockCtx, &abci.RequestProcessProposal{Height: 12})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_ACCEPT, processResp.Status)
+
+	processResp, err = process(smallBlockCtx, &abci.RequestProcessProposal{Height: 12, Txs: [][]byte{[]byte("mempool")}})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_REJECT, processResp.Status)
+
+	// the max tx bytes of the proposer are reduced by the size of the injected
+	// extended commit, so the decrypted transaction may not fit
+	commit, ok := voteext.ExtendedCommitFromContext(ctx)
+	s.Require().True(ok)
+	commitBz, err := commit.Marshal()
+	s.Require().NoError(err)
+	txSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{[]byte("tx1")})
+	commitSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{commitBz})
+	for _, tc := range []struct {
+		maxTxBytes int64
+		expStatus  abci.ResponseProcessProposal_ProposalStatus
+	}{
+		{txSize + commitSize - 1, abci.ResponseProcessProposal_ACCEPT},
+		{txSize + commitSize, abci.ResponseProcessProposal_REJECT},
+	} {
+		blockCtx := ctx.WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: cmttypes.MaxOverheadForBlock + cmttypes.MaxHeaderBytes + cmttypes.MaxCommitBytes(0) + tc.maxTxBytes},
+		})
+		processResp, err = process(blockCtx, &abci.RequestProcessProposal{Height: 12})
+		s.Require().NoError(err)
+		s.Require().Equal(tc.expStatus, processResp.Status)
+	}
+}
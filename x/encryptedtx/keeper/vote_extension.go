@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/voteext"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/encryptedtx/types"
+)
+
+// FlagSecretShareFile is the app option holding the path of the file with the
+// secret key share of the local validator.
+const FlagSecretShareFile = "encryptedtx.secret-share-file"
+
+// LoadSecretShare reads the JSON encoded secret key share of the local
+// validator from the given file. It returns nil if path is empty, for nodes
+// which do not hold a share.
+func LoadSecretShare(path string) (*threshold.SecretShare, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret share: %w", err)
+	}
+
+	var share threshold.SecretShare
+	if err := json.Unmarshal(bz, &share); err != nil {
+		return nil, fmt.Errorf("failed to decode secret share: %w", err)
+	}
+
+	return &share, nil
+}
+
+// NewVoteExtensionHandler returns the vote extension handler through which
+// validators release their decryption shares. When extending its vote at
+// height H, a validator publishes its decryption shares for the envelopes
+// submitted at height H-1, which are decrypted in the block at height H+1.
+//
+// secretShare is the secret key share of the local validator. It is nil on
+// nodes which do not hold a share, which then extend their votes without
+// decryption shares.
+func NewVoteExtensionHandler(k Keeper, secretShare *threshold.SecretShare) *voteext.TypedHandler[types.DecryptionShares] {
+	return voteext.NewHandler(
+		types.ModuleName,
+		codec.CollValue[types.DecryptionShares](k.cdc),
+		k.extendVoteFn(secretShare),
+		k.verifyVoteExtension,
+	)
+}
+
+func (k Keeper) extendVoteFn(secretShare *threshold.SecretShare) voteext.ExtendVoteFn[types.DecryptionShares] {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (types.DecryptionShares, error) {
+		if secretShare == nil {
+			return types.DecryptionShares{}, nil
+		}
+
+		keyset, err := k.Keyset.Get(ctx)
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.DecryptionShares{}, nil
+		} else if err != nil {
+			return types.DecryptionShares{}, err
+		}
+
+		// do not publish shares of a rotated key, they would fail verification
+		// and get the whole vote extension rejected
+		publicShare, err := secretShare.PublicShare()
+		if err != nil {
+			return types.DecryptionShares{}, err
+		}
+
+		if !keyset.HasPublicShare(secretShare.Index, publicShare) {
+			ctx.Logger().Error("local secret share does not belong to the on-chain keyset", "index", secretShare.Index)
+			return types.DecryptionShares{}, nil
+		}
+
+		envelopes, err := k.GetEnvelopes(ctx, req.Height-1)
+		if err != nil {
+			return types.DecryptionShares{}, err
+		}
+
+		shares := make([]types.DecryptionShare, 0, len(envelopes))
+		for _, envelope := range envelopes {
+			share, proof, err := secretShare.NewDecryptionShare(rand.Reader, envelope.Envelope.EphemeralKey)
+			if err != nil {
+				return types.DecryptionShares{}, fmt.Errorf("envelope %d: %w", envelope.EnvelopeId, err)
+			}
+
+			shares = append(shares, types.DecryptionShare{
+				EnvelopeId: envelope.EnvelopeId,
+				Share:      share.Share,
+				Proof:      proof,
+			})
+		}
+
+		return types.DecryptionShares{Shares: shares}, nil
+	}
+}
+
+func (k Keeper) verifyVoteExtension(ctx sdk.Context, req *abci.RequestVerifyVoteExtension, ext types.DecryptionShares) error {
+	if len(ext.Shares) == 0 {
+		return nil
+	}
+
+	keyset, err := k.Keyset.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrap(types.ErrInvalidDecryptionShares, types.ErrNoKeyset.Error())
+	} else if err != nil {
+		return err
+	}
+
+	keyShare, ok := keyset.ShareOf(req.ValidatorAddress)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidDecryptionShares, "validator %s holds no key share", sdk.ConsAddress(req.ValidatorAddress))
+	}
+
+	envelopes, err := k.GetEnvelopes(ctx, req.Height-1)
+	if err != nil {
+		return err
+	}
+
+	ephemeralKeys := make(map[uint64][]byte, len(envelopes))
+	for _, envelope := range envelopes {
+		ephemeralKeys[envelope.EnvelopeId] = envelope.Envelope.EphemeralKey
+	}
+
+	seen := make(map[uint64]bool, len(ext.Shares))
+	for _, share := range ext.Shares {
+		ephemeralKey, ok := ephemeralKeys[share.EnvelopeId]
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidDecryptionShares, "unknown envelope %d", share.EnvelopeId)
+		}
+
+		if seen[share.EnvelopeId] {
+			return errorsmod.Wrapf(types.ErrInvalidDecryptionShares, "duplicate share for envelope %d", share.EnvelopeId)
+		}
+		seen[share.EnvelopeId] = true
+
+		if err := threshold.VerifyDecryptionShare(keyShare.PublicShare, ephemeralKey, share.Share, share.Proof); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidDecryptionShares, "envelope %d: %s", share.EnvelopeId, err)
+		}
+	}
+
+	return nil
+}
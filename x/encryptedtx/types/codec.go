@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/encryptedtx interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitEncryptedTx{}, "cosmos-sdk/MsgSubmitEncryptedTx")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/encryptedtx/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateKeyset{}, "cosmos-sdk/encryptedtx/MsgUpdateKeyset")
+}
+
+// RegisterInterfaces registers the x/encryptedtx interfaces types with the interface registry.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSubmitEncryptedTx{},
+		&MsgUpdateParams{},
+		&MsgUpdateKeyset{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,18 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	// ErrNoKeyset is returned when no threshold encryption keyset is set.
+	ErrNoKeyset = errors.Register(ModuleName, 2, "no threshold encryption keyset")
+	// ErrInvalidKeyset is returned when a threshold encryption keyset is invalid.
+	ErrInvalidKeyset = errors.Register(ModuleName, 3, "invalid threshold encryption keyset")
+	// ErrInvalidEnvelope is returned when an encrypted transaction is malformed.
+	ErrInvalidEnvelope = errors.Register(ModuleName, 4, "invalid encrypted transaction")
+	// ErrBlockEnvelopesLimit is returned when the maximum number of encrypted
+	// transactions in a block is reached.
+	ErrBlockEnvelopesLimit = errors.Register(ModuleName, 5, "maximum number of encrypted transactions in block reached")
+	// ErrInvalidDecryptionShares is returned when the decryption shares of a
+	// vote extension are invalid.
+	ErrInvalidDecryptionShares = errors.Register(ModuleName, 6, "invalid decryption shares")
+)
@@ -0,0 +1,28 @@
+package types
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, keyset *Keyset) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Keyset: keyset,
+	}
+}
+
+// DefaultGenesis returns the default genesis state, without keyset.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.Keyset != nil {
+		return gs.Keyset.Validate()
+	}
+
+	return nil
+}
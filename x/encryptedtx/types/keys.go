@@ -0,0 +1,21 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the name of the x/encryptedtx module.
+	ModuleName = "encryptedtx"
+
+	// StoreKey defines the module's store key.
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey     = collections.NewPrefix(0)
+	KeysetKey     = collections.NewPrefix(1)
+	EnvelopesKey  = collections.NewPrefix(2)
+	EnvelopeIDKey = collections.NewPrefix(3)
+	// EnvelopeCountsKey is the prefix of the number of envelopes submitted
+	// per height.
+	EnvelopeCountsKey = collections.NewPrefix(4)
+)
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs basic validation of the keyset. It does not verify that
+// the public shares are consistent with the public key, which is the
+// responsibility of the key generation ceremony.
+func (k Keyset) Validate() error {
+	if err := threshold.ValidatePoint(k.PublicKey); err != nil {
+		return errorsmod.Wrapf(ErrInvalidKeyset, "public key: %s", err)
+	}
+
+	if k.Threshold == 0 || int(k.Threshold) > len(k.Shares) {
+		return errorsmod.Wrapf(ErrInvalidKeyset, "threshold must be in [1, %d], got %d", len(k.Shares), k.Threshold)
+	}
+
+	validators := make(map[string]bool, len(k.Shares))
+	indexes := make(map[uint32]bool, len(k.Shares))
+	for _, share := range k.Shares {
+		if err := share.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidKeyset, err.Error())
+		}
+
+		if validators[share.ValidatorAddress] {
+			return errorsmod.Wrapf(ErrInvalidKeyset, "duplicate share for validator %s", share.ValidatorAddress)
+		}
+		validators[share.ValidatorAddress] = true
+
+		if indexes[share.Index] {
+			return errorsmod.Wrapf(ErrInvalidKeyset, "duplicate share index %d", share.Index)
+		}
+		indexes[share.Index] = true
+	}
+
+	return nil
+}
+
+// ShareOf returns the key share of the validator with the given consensus
+// address.
+func (k Keyset) ShareOf(validator sdk.ConsAddress) (KeyShare, bool) {
+	for _, share := range k.Shares {
+		addr, err := sdk.ConsAddressFromBech32(share.ValidatorAddress)
+		if err == nil && addr.Equals(validator) {
+			return share, true
+		}
+	}
+
+	return KeyShare{}, false
+}
+
+// HasPublicShare returns true if the keyset contains the given public share at
+// the given index.
+func (k Keyset) HasPublicShare(index uint32, publicShare []byte) bool {
+	for _, share := range k.Shares {
+		if share.Index == index && bytes.Equal(share.PublicShare, publicShare) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate performs basic validation of the key share.
+func (s KeyShare) Validate() error {
+	if _, err := sdk.ConsAddressFromBech32(s.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", s.ValidatorAddress, err)
+	}
+
+	if s.Index == 0 {
+		return errors.New("share index must be positive")
+	}
+
+	if err := threshold.ValidatePoint(s.PublicShare); err != nil {
+		return fmt.Errorf("public share of validator %s: %w", s.ValidatorAddress, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// DefaultMaxEnvelopesPerBlock is the default maximum number of encrypted
+	// transactions per block.
+	DefaultMaxEnvelopesPerBlock uint32 = 100
+	// DefaultMaxCiphertextSize is the default maximum size in bytes of an
+	// encrypted transaction.
+	DefaultMaxCiphertextSize uint64 = 64 * 1024
+	// MaxEnvelopeBytesPerBlock is the maximum total size in bytes of the
+	// encrypted transactions which can be submitted in a single block, which
+	// bounds the size of the decrypted transactions injected in a proposal.
+	MaxEnvelopeBytesPerBlock uint64 = 16 * 1024 * 1024
+)
+
+// DefaultParams returns the default parameters of the x/encryptedtx module.
+func DefaultParams() Params {
+	return Params{
+		MaxEnvelopesPerBlock: DefaultMaxEnvelopesPerBlock,
+		MaxCiphertextSize:    DefaultMaxCiphertextSize,
+	}
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	if p.MaxCiphertextSize == 0 {
+		return errors.New("max ciphertext size must be positive")
+	}
+
+	if p.MaxEnvelopesPerBlock == 0 {
+		return errors.New("max envelopes per block must be positive")
+	}
+
+	if p.MaxCiphertextSize > MaxEnvelopeBytesPerBlock/uint64(p.MaxEnvelopesPerBlock) {
+		return fmt.Errorf("max envelopes per block times max ciphertext size must not exceed %d bytes", MaxEnvelopeBytesPerBlock)
+	}
+
+	return nil
+}
@@ -225,7 +225,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 				if err != nil {
 					return err
 				}
-				endTime := proposal.VotingStartTime.Add(*params.VotingPeriod)
+				endTime := proposal.VotingStartTime.Add(*proposal.GetVotingPeriodFromParams(params))
 				proposal.VotingEndTime = &endTime
 
 				err = keeper.ActiveProposalsQueue.Set(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id), proposal.Id)
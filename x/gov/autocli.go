@@ -90,6 +90,38 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "constitution",
 					Short:     "Query the current chain constitution",
 				},
+				{
+					RpcMethod: "Governor",
+					Use:       "governor [governor-addr]",
+					Short:     "Query details of a governor",
+					Example:   fmt.Sprintf("%s query gov governor cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "Governors",
+					Use:       "governors",
+					Short:     "Query all the governors",
+				},
+				{
+					RpcMethod: "GovernorDelegations",
+					Use:       "governor-delegations [governor-addr]",
+					Short:     "Query the delegations assigned to a governor",
+					Example:   fmt.Sprintf("%s query gov governor-delegations cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "DelegatorGovernor",
+					Use:       "delegator-governor [delegator-addr]",
+					Short:     "Query the governor a delegator assigned its voting power to",
+					Example:   fmt.Sprintf("%s query gov delegator-governor cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -74,6 +74,9 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
 		NewCmdCancelProposal(),
+		NewCmdCreateGovernor(),
+		NewCmdDelegateGovernor(),
+		NewCmdUndelegateGovernor(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -409,3 +412,78 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 
 	return cmd
 }
+
+// NewCmdCreateGovernor implements registering the sender as a governor.
+func NewCmdCreateGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-governor",
+		Args:    cobra.NoArgs,
+		Short:   "Register as a governor to which delegators can assign their voting power",
+		Example: fmt.Sprintf(`$ %s tx gov create-governor --metadata="ipfs://CID" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgCreateGovernor(clientCtx.GetFromAddress().String(), metadata)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Specify metadata of the governor")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdDelegateGovernor implements assigning the voting power of the sender to a governor.
+func NewCmdDelegateGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delegate-governor [governor-addr]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Assign your governance voting power to a governor",
+		Example: fmt.Sprintf(`$ %s tx gov delegate-governor cosmos1... --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgDelegateGovernor(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUndelegateGovernor implements withdrawing the voting power of the sender from its governor.
+func NewCmdUndelegateGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "undelegate-governor",
+		Args:    cobra.NoArgs,
+		Short:   "Withdraw your governance voting power from your governor",
+		Example: fmt.Sprintf(`$ %s tx gov undelegate-governor --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgUndelegateGovernor(clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -281,8 +281,8 @@ func (k Keeper) RefundAndDeleteDeposits(ctx context.Context, proposalID uint64)
 
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
-// the deposit parameters. Returns nil on success, error otherwise.
-func (k Keeper) validateInitialDeposit(ctx context.Context, params v1.Params, initialDeposit sdk.Coins, expedited bool) error {
+// the given minimum deposit. Returns nil on success, error otherwise.
+func (k Keeper) validateInitialDeposit(ctx context.Context, params v1.Params, initialDeposit, minDeposit sdk.Coins) error {
 	if !initialDeposit.IsValid() || initialDeposit.IsAnyNegative() {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, initialDeposit.String())
 	}
@@ -295,15 +295,9 @@ func (k Keeper) validateInitialDeposit(ctx context.Context, params v1.Params, in
 		return nil
 	}
 
-	var minDepositCoins sdk.Coins
-	if expedited {
-		minDepositCoins = params.ExpeditedMinDeposit
-	} else {
-		minDepositCoins = params.MinDeposit
-	}
-
-	for i := range minDepositCoins {
-		minDepositCoins[i].Amount = sdkmath.LegacyNewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
+	minDepositCoins := make(sdk.Coins, len(minDeposit))
+	for i, coin := range minDeposit {
+		minDepositCoins[i] = sdk.NewCoin(coin.Denom, sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(minInitialDepositRatio).RoundInt())
 	}
 	if !initialDeposit.IsAllGTE(minDepositCoins) {
 		return errors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need (%s)", initialDeposit, minDepositCoins)
@@ -1,6 +1,9 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
 
 // ValidateInitialDeposit is a helper function used only in deposit tests which returns the same
 // functionality of validateInitialDeposit private function.
@@ -10,5 +13,5 @@ func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins
 		return err
 	}
 
-	return k.validateInitialDeposit(ctx, params, initialDeposit, expedited)
+	return k.validateInitialDeposit(ctx, params, initialDeposit, v1.MinDepositFromParams(params, nil, expedited))
 }
@@ -78,6 +78,24 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		}
 	}
 
+	for _, governor := range data.Governors {
+		addr, err := ak.AddressCodec().StringToBytes(governor.Address)
+		if err != nil {
+			panic(err)
+		}
+		err = k.Governors.Set(ctx, addr, *governor)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, delegation := range data.GovernorDelegations {
+		err := k.SetGovernorDelegation(ctx, *delegation)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -135,12 +153,32 @@ func ExportGenesis(ctx sdk.Context, k *Keeper) (*v1.GenesisState, error) {
 		panic(err)
 	}
 
+	var governors []*v1.Governor
+	err = k.Governors.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.Governor) (stop bool, err error) {
+		governors = append(governors, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var governorDelegations []*v1.GovernorDelegation
+	err = k.GovernorDelegations.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.GovernorDelegation) (stop bool, err error) {
+		governorDelegations = append(governorDelegations, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:  startingProposalID,
+		Deposits:            proposalsDeposits,
+		Votes:               proposalsVotes,
+		Proposals:           proposals,
+		Params:              &params,
+		Constitution:        constitution,
+		Governors:           governors,
+		GovernorDelegations: governorDelegations,
 	}, nil
 }
@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// CreateGovernor registers an account as a governor. An account assigning its
+// voting power to a governor cannot become a governor itself.
+func (k Keeper) CreateGovernor(ctx context.Context, addr sdk.AccAddress, metadata string) error {
+	if err := k.assertMetadataLength(metadata); err != nil {
+		return err
+	}
+
+	addrStr, err := k.authKeeper.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	exists, err := k.Governors.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errors.Wrap(types.ErrGovernorExists, addrStr)
+	}
+
+	delegates, err := k.GovernorDelegations.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if delegates {
+		return errors.Wrapf(types.ErrGovernorDelegation, "%s assigns its voting power to a governor", addrStr)
+	}
+
+	if err := k.Governors.Set(ctx, addr, v1.Governor{Address: addrStr, Metadata: metadata}); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGovernor,
+			sdk.NewAttribute(types.AttributeKeyGovernor, addrStr),
+		),
+	)
+
+	return nil
+}
+
+// DelegateToGovernor assigns the voting power of a delegator to a governor,
+// replacing any previous assignment of the delegator.
+func (k Keeper) DelegateToGovernor(ctx context.Context, delegatorAddr, governorAddr sdk.AccAddress) error {
+	delegatorAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	governorAddrStr, err := k.authKeeper.AddressCodec().BytesToString(governorAddr)
+	if err != nil {
+		return err
+	}
+
+	exists, err := k.Governors.Has(ctx, governorAddr)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Wrap(types.ErrGovernorNotFound, governorAddrStr)
+	}
+
+	isGovernor, err := k.Governors.Has(ctx, delegatorAddr)
+	if err != nil {
+		return err
+	}
+	if isGovernor {
+		return errors.Wrapf(types.ErrGovernorDelegation, "governor %s cannot delegate to another governor", delegatorAddrStr)
+	}
+
+	if err := k.SetGovernorDelegation(ctx, v1.GovernorDelegation{
+		DelegatorAddress: delegatorAddrStr,
+		GovernorAddress:  governorAddrStr,
+	}); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateGovernor,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddrStr),
+			sdk.NewAttribute(types.AttributeKeyGovernor, governorAddrStr),
+		),
+	)
+
+	return nil
+}
+
+// UndelegateFromGovernor withdraws the voting power of a delegator from its
+// governor.
+func (k Keeper) UndelegateFromGovernor(ctx context.Context, delegatorAddr sdk.AccAddress) error {
+	delegatorAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	delegation, err := k.GovernorDelegations.Get(ctx, delegatorAddr)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return errors.Wrap(types.ErrNoGovernorDelegation, delegatorAddrStr)
+		}
+		return err
+	}
+
+	if err := k.removeGovernorDelegation(ctx, delegatorAddr); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUndelegateGovernor,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddrStr),
+			sdk.NewAttribute(types.AttributeKeyGovernor, delegation.GovernorAddress),
+		),
+	)
+
+	return nil
+}
+
+// SetGovernorDelegation sets a governor delegation along with its index by
+// governor, replacing any previous governor delegation of the delegator. It
+// fails if the governor already has the maximum number of delegators.
+func (k Keeper) SetGovernorDelegation(ctx context.Context, delegation v1.GovernorDelegation) error {
+	delegatorAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	governorAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegation.GovernorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.removeGovernorDelegation(ctx, delegatorAddr); err != nil {
+		return err
+	}
+
+	count, err := k.GovernorDelegatorsCount.Get(ctx, governorAddr)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+	if count >= k.config.MaxGovernorDelegators {
+		return errors.Wrapf(types.ErrMaxGovernorDelegators, "%s has %d delegators", delegation.GovernorAddress, count)
+	}
+
+	if err := k.GovernorDelegatorsCount.Set(ctx, governorAddr, count+1); err != nil {
+		return err
+	}
+
+	if err := k.GovernorDelegations.Set(ctx, delegatorAddr, delegation); err != nil {
+		return err
+	}
+
+	return k.GovernorDelegators.Set(ctx, collections.Join(sdk.AccAddress(governorAddr), sdk.AccAddress(delegatorAddr)))
+}
+
+// removeGovernorDelegation removes the governor delegation of a delegator, if
+// any, along with its index by governor.
+func (k Keeper) removeGovernorDelegation(ctx context.Context, delegatorAddr sdk.AccAddress) error {
+	delegation, err := k.GovernorDelegations.Get(ctx, delegatorAddr)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	governorAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegation.GovernorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.GovernorDelegators.Remove(ctx, collections.Join(sdk.AccAddress(governorAddr), delegatorAddr)); err != nil {
+		return err
+	}
+
+	count, err := k.GovernorDelegatorsCount.Get(ctx, governorAddr)
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		if err := k.GovernorDelegatorsCount.Remove(ctx, governorAddr); err != nil {
+			return err
+		}
+	} else if err := k.GovernorDelegatorsCount.Set(ctx, governorAddr, count-1); err != nil {
+		return err
+	}
+
+	return k.GovernorDelegations.Remove(ctx, delegatorAddr)
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMaxGovernorDelegators(t *testing.T) {
+	_, acctKeeper, bankKeeper, stakingKeeper, distKeeper, storeService, encCfg, ctx := setupGovKeeper(t)
+	config := types.DefaultConfig()
+	config.MaxGovernorDelegators = 1
+	govKeeper := keeper.NewKeeper(encCfg.Codec, storeService, acctKeeper, bankKeeper, distKeeper, baseapp.NewMsgServiceRouter(), config, govAcct.String(), keeper.NewDefaultCalculateVoteResultsAndVotingPower(stakingKeeper))
+
+	governor := sdk.AccAddress("governor____________")
+	delegator1 := sdk.AccAddress("delegator1__________")
+	delegator2 := sdk.AccAddress("delegator2__________")
+	require.NoError(t, govKeeper.CreateGovernor(ctx, governor, ""))
+
+	require.NoError(t, govKeeper.DelegateToGovernor(ctx, delegator1, governor))
+	err := govKeeper.DelegateToGovernor(ctx, delegator2, governor)
+	require.ErrorIs(t, err, types.ErrMaxGovernorDelegators)
+
+	// assigning again the voting power to the same governor replaces the
+	// previous assignment
+	require.NoError(t, govKeeper.DelegateToGovernor(ctx, delegator1, governor))
+	count, err := govKeeper.GovernorDelegatorsCount.Get(ctx, governor)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+
+	require.NoError(t, govKeeper.UndelegateFromGovernor(ctx, delegator1))
+	has, err := govKeeper.GovernorDelegatorsCount.Has(ctx, governor)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, govKeeper.DelegateToGovernor(ctx, delegator2, governor))
+}
@@ -271,3 +271,110 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
+
+// Governor queries a governor by its address
+func (q queryServer) Governor(ctx context.Context, req *v1.QueryGovernorRequest) (*v1.QueryGovernorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governorAddr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	governor, err := q.k.Governors.Get(ctx, governorAddr)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "governor %s doesn't exist", req.GovernorAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorResponse{Governor: &governor}, nil
+}
+
+// Governors returns all the governors
+func (q queryServer) Governors(ctx context.Context, req *v1.QueryGovernorsRequest) (*v1.QueryGovernorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	governors, pageRes, err := query.CollectionPaginate(ctx, q.k.Governors, req.Pagination, func(_ sdk.AccAddress, governor v1.Governor) (*v1.Governor, error) {
+		return &governor, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorsResponse{Governors: governors, Pagination: pageRes}, nil
+}
+
+// GovernorDelegations returns the delegations assigned to a governor
+func (q queryServer) GovernorDelegations(ctx context.Context, req *v1.QueryGovernorDelegationsRequest) (*v1.QueryGovernorDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governorAddr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, pageRes, err := query.CollectionPaginate(ctx, q.k.GovernorDelegators, req.Pagination, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (*v1.GovernorDelegation, error) {
+		delegation, err := q.k.GovernorDelegations.Get(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+		return &delegation, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](governorAddr))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+// DelegatorGovernor returns the governor a delegator assigned its voting power to
+func (q queryServer) DelegatorGovernor(ctx context.Context, req *v1.QueryDelegatorGovernorRequest) (*v1.QueryDelegatorGovernorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegatorAddr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, err := q.k.GovernorDelegations.Get(ctx, delegatorAddr)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "delegator %s has no governor", req.DelegatorAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	governorAddr, err := q.k.authKeeper.AddressCodec().StringToBytes(delegation.GovernorAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	governor, err := q.k.Governors.Get(ctx, governorAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryDelegatorGovernorResponse{Governor: &governor}, nil
+}
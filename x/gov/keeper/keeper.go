@@ -56,6 +56,11 @@ type Keeper struct {
 	ActiveProposalsQueue   collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	VotingPeriodProposals  collections.Map[uint64, []byte]                              // TODO(tip): this could be a keyset or index.
+	Governors              collections.Map[sdk.AccAddress, v1.Governor]
+	GovernorDelegations    collections.Map[sdk.AccAddress, v1.GovernorDelegation]               // key is the delegator address
+	GovernorDelegators     collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]] // governor address, delegator address
+
+	GovernorDelegatorsCount collections.Map[sdk.AccAddress, uint64] // number of delegators by governor address
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -95,6 +100,11 @@ func NewKeeper(
 		config.MaxMetadataLen = types.DefaultConfig().MaxMetadataLen
 	}
 
+	// If MaxGovernorDelegators not set by app developer, set to default value.
+	if config.MaxGovernorDelegators == 0 {
+		config.MaxGovernorDelegators = types.DefaultConfig().MaxGovernorDelegators
+	}
+
 	sb := collections.NewSchemaBuilder(storeService)
 	k := &Keeper{
 		storeService:                         storeService,
@@ -115,6 +125,10 @@ func NewKeeper(
 		ActiveProposalsQueue:                 collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // nolint:staticcheck // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue:               collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // nolint:staticcheck // sdk.TimeKey is needed to retain state compatibility
 		VotingPeriodProposals:                collections.NewMap(sb, types.VotingPeriodProposalKeyPrefix, "voting_period_proposals", collections.Uint64Key, collections.BytesValue),
+		Governors:                            collections.NewMap(sb, types.GovernorsKeyPrefix, "governors", sdk.AccAddressKey, codec.CollValue[v1.Governor](cdc)),
+		GovernorDelegations:                  collections.NewMap(sb, types.GovernorDelegationsKeyPrefix, "governor_delegations", sdk.AccAddressKey, codec.CollValue[v1.GovernorDelegation](cdc)),
+		GovernorDelegators:                   collections.NewKeySet(sb, types.GovernorDelegatorsKeyPrefix, "governor_delegators", collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey)),
+		GovernorDelegatorsCount:              collections.NewMap(sb, types.GovernorDelegatorsCountPrefix, "governor_delegators_count", sdk.AccAddressKey, collections.Uint64Value),
 	}
 
 	schema, err := sb.Build()
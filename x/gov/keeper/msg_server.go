@@ -76,7 +76,14 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, fmt.Errorf("failed to get governance parameters: %w", err)
 	}
 
-	if err := k.validateInitialDeposit(ctx, params, initialDeposit, msg.Expedited); err != nil {
+	msgTypeURLs := make([]string, 0, len(proposalMsgs))
+	for _, proposalMsg := range proposalMsgs {
+		msgTypeURLs = append(msgTypeURLs, sdk.MsgTypeURL(proposalMsg))
+	}
+
+	// the initial deposit is checked against the params of the proposal messages, if any
+	minDeposit := v1.MinDepositFromParams(params, params.ProposalParamsForMsgs(msgTypeURLs), msg.Expedited)
+	if err := k.validateInitialDeposit(ctx, params, initialDeposit, minDeposit); err != nil {
 		return nil, err
 	}
 
@@ -284,6 +291,53 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams)
 	return &v1.MsgUpdateParamsResponse{}, nil
 }
 
+// CreateGovernor implements the MsgServer.CreateGovernor method.
+func (k msgServer) CreateGovernor(goCtx context.Context, msg *v1.MsgCreateGovernor) (*v1.MsgCreateGovernorResponse, error) {
+	addr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.CreateGovernor(goCtx, addr, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCreateGovernorResponse{}, nil
+}
+
+// DelegateGovernor implements the MsgServer.DelegateGovernor method.
+func (k msgServer) DelegateGovernor(goCtx context.Context, msg *v1.MsgDelegateGovernor) (*v1.MsgDelegateGovernorResponse, error) {
+	delegatorAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	governorAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.GovernorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.DelegateToGovernor(goCtx, delegatorAddr, governorAddr); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDelegateGovernorResponse{}, nil
+}
+
+// UndelegateGovernor implements the MsgServer.UndelegateGovernor method.
+func (k msgServer) UndelegateGovernor(goCtx context.Context, msg *v1.MsgUndelegateGovernor) (*v1.MsgUndelegateGovernorResponse, error) {
+	delegatorAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.UndelegateFromGovernor(goCtx, delegatorAddr); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUndelegateGovernorResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"time"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
@@ -103,6 +102,7 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.Params = params.ProposalParamsForMsgs(msgTypeURLs)
 
 	err = k.SetProposal(ctx, proposal)
 	if err != nil {
@@ -243,18 +243,12 @@ func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Proposal)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	startTime := sdkCtx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	var votingPeriod *time.Duration
 	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return err
 	}
 
-	if proposal.Expedited {
-		votingPeriod = params.ExpeditedVotingPeriod
-	} else {
-		votingPeriod = params.VotingPeriod
-	}
-	endTime := proposal.VotingStartTime.Add(*votingPeriod)
+	endTime := proposal.VotingStartTime.Add(*proposal.GetVotingPeriodFromParams(params))
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
 	err = k.SetProposal(ctx, proposal)
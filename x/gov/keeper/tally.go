@@ -29,36 +29,13 @@ type CalculateVoteResultsAndVotingPowerFn func(
 func NewDefaultCalculateVoteResultsAndVotingPower(sk types.StakingKeeper) CalculateVoteResultsAndVotingPowerFn {
 	return func(ctx context.Context, k Keeper, proposal v1.Proposal) (totalVoterPower math.LegacyDec, totalValPower math.Int, results map[v1.VoteOption]math.LegacyDec, err error) {
 		// Fetch all bonded validators and calculate total validator power
-		validators := make(map[string]v1.ValidatorGovInfo)
-		totalValPower = math.ZeroInt()
-
-		if err := sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
-			valBz, err := sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
-			if err != nil {
-				return false
-			}
-			validatorPower := validator.GetValidatorPower()
-			validators[validator.GetOperator()] = v1.NewValidatorGovInfo(
-				valBz,
-				validatorPower,
-				validator.GetDelegatorShares(),
-				math.LegacyZeroDec(),
-				v1.WeightedVoteOptions{},
-			)
-			// Sum up total validator power from active (bonded) validators only
-			totalValPower = totalValPower.Add(validatorPower)
-			return false
-		}); err != nil {
+		validators, totalValPower, err := getBondedValidatorsGovInfo(ctx, sk)
+		if err != nil {
 			return math.LegacyZeroDec(), math.ZeroInt(), nil, err
 		}
 
 		totalVotingPower := math.LegacyZeroDec()
-
-		results = make(map[v1.VoteOption]math.LegacyDec)
-		results[v1.OptionYes] = math.LegacyZeroDec()
-		results[v1.OptionAbstain] = math.LegacyZeroDec()
-		results[v1.OptionNo] = math.LegacyZeroDec()
-		results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
+		results = newVoteResults()
 
 		rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
 		votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
@@ -69,41 +46,16 @@ func NewDefaultCalculateVoteResultsAndVotingPower(sk types.StakingKeeper) Calcul
 				return false, err
 			}
 
-			valAddrStr, err := sk.ValidatorAddressCodec().BytesToString(voter)
-			if err != nil {
+			if err := recordValidatorVote(sk, voter, vote, validators); err != nil {
 				return false, err
 			}
-			if val, ok := validators[valAddrStr]; ok {
-				val.Vote = vote.Options
-				validators[valAddrStr] = val
-			}
 
 			// iterate over all delegations from voter, deduct from any delegated-to validators
-			err = sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
-				valAddrStr := delegation.GetValidatorAddr()
-
-				if val, ok := validators[valAddrStr]; ok {
-					// There is no need to handle the special case that validator address equal to voter address.
-					// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-					val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-					validators[valAddrStr] = val
-
-					// delegation shares * bonded / total shares
-					votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-					for _, option := range vote.Options {
-						weight, _ := math.LegacyNewDecFromStr(option.Weight)
-						subPower := votingPower.Mul(weight)
-						results[option.Option] = results[option.Option].Add(subPower)
-					}
-					totalVotingPower = totalVotingPower.Add(votingPower)
-				}
-
-				return false
-			})
+			votingPower, err := tallyDelegatorVote(ctx, sk, voter, vote.Options, validators, results)
 			if err != nil {
 				return false, err
 			}
+			totalVotingPower = totalVotingPower.Add(votingPower)
 
 			votesToRemove = append(votesToRemove, key)
 			return false, nil
@@ -113,22 +65,176 @@ func NewDefaultCalculateVoteResultsAndVotingPower(sk types.StakingKeeper) Calcul
 		}
 
 		// remove all votes from store
-		for _, key := range votesToRemove {
-			if err := k.Votes.Remove(ctx, key); err != nil {
-				return math.LegacyDec{}, math.ZeroInt(), nil, fmt.Errorf("error while removing vote (%d/%s): %w", key.K1(), key.K2(), err)
-			}
+		if err := removeVotes(ctx, k, votesToRemove); err != nil {
+			return math.LegacyDec{}, math.ZeroInt(), nil, err
 		}
 
 		// iterate over the validators again to tally their voting power
-		for _, val := range validators {
-			if len(val.Vote) == 0 {
-				continue
+		totalVotingPower = totalVotingPower.Add(tallyValidatorVotes(validators, results))
+
+		return totalVotingPower, totalValPower, results, nil
+	}
+}
+
+// NewGovernorCalculateVoteResultsAndVotingPower returns a CalculateVoteResultsAndVotingPowerFn
+// that uses the provided StakingKeeper to calculate voting power, and which
+// lets delegators assign their voting power to a governor.
+//
+// The vote of a governor is applied to the delegations of the delegators which
+// assigned their voting power to it. A delegator voting on a proposal overrides
+// the vote of its governor for that proposal, and the voting power of a
+// delegator whose governor did not vote is inherited by its validators as usual.
+func NewGovernorCalculateVoteResultsAndVotingPower(sk types.StakingKeeper) CalculateVoteResultsAndVotingPowerFn {
+	return func(ctx context.Context, k Keeper, proposal v1.Proposal) (totalVoterPower math.LegacyDec, totalValPower math.Int, results map[v1.VoteOption]math.LegacyDec, err error) {
+		validators, totalValPower, err := getBondedValidatorsGovInfo(ctx, sk)
+		if err != nil {
+			return math.LegacyZeroDec(), math.ZeroInt(), nil, err
+		}
+
+		totalVotingPower := math.LegacyZeroDec()
+		results = newVoteResults()
+
+		// collect the votes first, a delegator vote must override the vote of its
+		// governor regardless of the order of the votes
+		rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+		votes := []v1.Vote{}
+		voted := make(map[string]bool)
+		votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
+		err = k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+			votes = append(votes, vote)
+			voted[vote.Voter] = true
+			votesToRemove = append(votesToRemove, key)
+			return false, nil
+		})
+		if err != nil {
+			return math.LegacyZeroDec(), math.ZeroInt(), nil, fmt.Errorf("error while iterating votes: %w", err)
+		}
+
+		for _, vote := range votes {
+			voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
+			if err != nil {
+				return math.LegacyZeroDec(), math.ZeroInt(), nil, err
+			}
+
+			if err := recordValidatorVote(sk, voter, vote, validators); err != nil {
+				return math.LegacyZeroDec(), math.ZeroInt(), nil, err
+			}
+
+			votingPower, err := tallyDelegatorVote(ctx, sk, voter, vote.Options, validators, results)
+			if err != nil {
+				return math.LegacyZeroDec(), math.ZeroInt(), nil, fmt.Errorf("error while iterating delegations: %w", err)
+			}
+			totalVotingPower = totalVotingPower.Add(votingPower)
+
+			// apply the vote of a governor to the delegators which did not vote
+			err = k.GovernorDelegators.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](voter), func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+				delegator, err := k.authKeeper.AddressCodec().BytesToString(key.K2())
+				if err != nil {
+					return false, err
+				}
+				if voted[delegator] {
+					return false, nil
+				}
+
+				votingPower, err := tallyDelegatorVote(ctx, sk, key.K2(), vote.Options, validators, results)
+				if err != nil {
+					return false, err
+				}
+				totalVotingPower = totalVotingPower.Add(votingPower)
+				return false, nil
+			})
+			if err != nil {
+				return math.LegacyZeroDec(), math.ZeroInt(), nil, fmt.Errorf("error while iterating governor delegations: %w", err)
 			}
+		}
+
+		if err := removeVotes(ctx, k, votesToRemove); err != nil {
+			return math.LegacyDec{}, math.ZeroInt(), nil, err
+		}
+
+		totalVotingPower = totalVotingPower.Add(tallyValidatorVotes(validators, results))
 
-			sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
-			votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		return totalVotingPower, totalValPower, results, nil
+	}
+}
+
+// getBondedValidatorsGovInfo returns the governance info of all the bonded
+// validators by operator address, along with their total power.
+func getBondedValidatorsGovInfo(ctx context.Context, sk types.StakingKeeper) (map[string]v1.ValidatorGovInfo, math.Int, error) {
+	validators := make(map[string]v1.ValidatorGovInfo)
+	totalValPower := math.ZeroInt()
+
+	if err := sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		valBz, err := sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			return false
+		}
+		validatorPower := validator.GetValidatorPower()
+		validators[validator.GetOperator()] = v1.NewValidatorGovInfo(
+			valBz,
+			validatorPower,
+			validator.GetDelegatorShares(),
+			math.LegacyZeroDec(),
+			v1.WeightedVoteOptions{},
+		)
+		// Sum up total validator power from active (bonded) validators only
+		totalValPower = totalValPower.Add(validatorPower)
+		return false
+	}); err != nil {
+		return nil, math.ZeroInt(), err
+	}
+
+	return validators, totalValPower, nil
+}
 
-			for _, option := range val.Vote {
+// newVoteResults returns empty results for all the vote options.
+func newVoteResults() map[v1.VoteOption]math.LegacyDec {
+	results := make(map[v1.VoteOption]math.LegacyDec)
+	results[v1.OptionYes] = math.LegacyZeroDec()
+	results[v1.OptionAbstain] = math.LegacyZeroDec()
+	results[v1.OptionNo] = math.LegacyZeroDec()
+	results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
+	return results
+}
+
+// recordValidatorVote records the vote of a voter which is a bonded validator.
+func recordValidatorVote(sk types.StakingKeeper, voter sdk.AccAddress, vote v1.Vote, validators map[string]v1.ValidatorGovInfo) error {
+	valAddrStr, err := sk.ValidatorAddressCodec().BytesToString(voter)
+	if err != nil {
+		return err
+	}
+	if val, ok := validators[valAddrStr]; ok {
+		val.Vote = vote.Options
+		validators[valAddrStr] = val
+	}
+	return nil
+}
+
+// tallyDelegatorVote adds the voting power of the delegations of a delegator to
+// the results with the given vote options, and deducts the delegated shares
+// from the validators. It returns the voting power of the delegator.
+func tallyDelegatorVote(
+	ctx context.Context,
+	sk types.StakingKeeper,
+	delegator sdk.AccAddress,
+	options v1.WeightedVoteOptions,
+	validators map[string]v1.ValidatorGovInfo,
+	results map[v1.VoteOption]math.LegacyDec,
+) (math.LegacyDec, error) {
+	totalVotingPower := math.LegacyZeroDec()
+	err := sk.IterateDelegations(ctx, delegator, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+		valAddrStr := delegation.GetValidatorAddr()
+
+		if val, ok := validators[valAddrStr]; ok {
+			// There is no need to handle the special case that validator address equal to voter address.
+			// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+			validators[valAddrStr] = val
+
+			// delegation shares * bonded / total shares
+			votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+
+			for _, option := range options {
 				weight, _ := math.LegacyNewDecFromStr(option.Weight)
 				subPower := votingPower.Mul(weight)
 				results[option.Option] = results[option.Option].Add(subPower)
@@ -136,8 +242,42 @@ func NewDefaultCalculateVoteResultsAndVotingPower(sk types.StakingKeeper) Calcul
 			totalVotingPower = totalVotingPower.Add(votingPower)
 		}
 
-		return totalVotingPower, totalValPower, results, nil
+		return false
+	})
+	return totalVotingPower, err
+}
+
+// tallyValidatorVotes adds the voting power of the validators which voted,
+// after deduction of the delegations which were tallied separately, to the
+// results. It returns the voting power of the validators.
+func tallyValidatorVotes(validators map[string]v1.ValidatorGovInfo, results map[v1.VoteOption]math.LegacyDec) math.LegacyDec {
+	totalVotingPower := math.LegacyZeroDec()
+	for _, val := range validators {
+		if len(val.Vote) == 0 {
+			continue
+		}
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+
+		for _, option := range val.Vote {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
+	return totalVotingPower
+}
+
+// removeVotes removes the tallied votes from the store.
+func removeVotes(ctx context.Context, k Keeper, keys []collections.Pair[uint64, sdk.AccAddress]) error {
+	for _, key := range keys {
+		if err := k.Votes.Remove(ctx, key); err != nil {
+			return fmt.Errorf("error while removing vote (%d/%s): %w", key.K1(), key.K2(), err)
+		}
 	}
+	return nil
 }
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
@@ -164,7 +304,7 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(totalValPower))
-	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
+	quorum, _ := math.LegacyNewDecFromStr(proposal.GetQuorumFromParams(params))
 	if percentVoting.LT(quorum) {
 		return false, params.BurnVoteQuorum, tallyResults, nil
 	}
@@ -182,14 +322,7 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
 	// For expedited 2/3
-	var thresholdStr string
-	if proposal.Expedited {
-		thresholdStr = params.GetExpeditedThreshold()
-	} else {
-		thresholdStr = params.GetThreshold()
-	}
-
-	threshold, _ := math.LegacyNewDecFromStr(thresholdStr)
+	threshold, _ := math.LegacyNewDecFromStr(proposal.GetThresholdFromParams(params))
 
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults, nil
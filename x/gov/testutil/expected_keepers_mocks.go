@@ -12,6 +12,7 @@ package testutil
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	address "cosmossdk.io/core/address"
 	math "cosmossdk.io/math"
@@ -167,6 +168,21 @@ func (mr *MockBankKeeperMockRecorder) AllBalances(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllBalances", reflect.TypeOf((*MockBankKeeper)(nil).AllBalances), arg0, arg1)
 }
 
+// AllowlistedAddresses mocks base method.
+func (m *MockBankKeeper) AllowlistedAddresses(arg0 context.Context, arg1 *types1.QueryAllowlistedAddressesRequest) (*types1.QueryAllowlistedAddressesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowlistedAddresses", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryAllowlistedAddressesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowlistedAddresses indicates an expected call of AllowlistedAddresses.
+func (mr *MockBankKeeperMockRecorder) AllowlistedAddresses(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowlistedAddresses", reflect.TypeOf((*MockBankKeeper)(nil).AllowlistedAddresses), arg0, arg1)
+}
+
 // AppendSendRestriction mocks base method.
 func (m *MockBankKeeper) AppendSendRestriction(restriction types1.SendRestrictionFn) {
 	m.ctrl.T.Helper()
@@ -194,6 +210,36 @@ func (mr *MockBankKeeperMockRecorder) Balance(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balance", reflect.TypeOf((*MockBankKeeper)(nil).Balance), arg0, arg1)
 }
 
+// BalanceAtHeight mocks base method.
+func (m *MockBankKeeper) BalanceAtHeight(arg0 context.Context, arg1 *types1.QueryBalanceAtHeightRequest) (*types1.QueryBalanceAtHeightResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceAtHeight", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryBalanceAtHeightResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceAtHeight indicates an expected call of BalanceAtHeight.
+func (mr *MockBankKeeperMockRecorder) BalanceAtHeight(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceAtHeight", reflect.TypeOf((*MockBankKeeper)(nil).BalanceAtHeight), arg0, arg1)
+}
+
+// BalanceHistory mocks base method.
+func (m *MockBankKeeper) BalanceHistory(arg0 context.Context, arg1 *types1.QueryBalanceHistoryRequest) (*types1.QueryBalanceHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceHistory", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryBalanceHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceHistory indicates an expected call of BalanceHistory.
+func (mr *MockBankKeeperMockRecorder) BalanceHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceHistory", reflect.TypeOf((*MockBankKeeper)(nil).BalanceHistory), arg0, arg1)
+}
+
 // BlockedAddr mocks base method.
 func (m *MockBankKeeper) BlockedAddr(addr types0.AccAddress) bool {
 	m.ctrl.T.Helper()
@@ -222,6 +268,48 @@ func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
 }
 
+// BurnFactoryCoins mocks base method.
+func (m *MockBankKeeper) BurnFactoryCoins(ctx context.Context, from types0.AccAddress, amount types0.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnFactoryCoins", ctx, from, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnFactoryCoins indicates an expected call of BurnFactoryCoins.
+func (mr *MockBankKeeperMockRecorder) BurnFactoryCoins(ctx, from, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnFactoryCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnFactoryCoins), ctx, from, amount)
+}
+
+// CancelScheduledSend mocks base method.
+func (m *MockBankKeeper) CancelScheduledSend(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledSend", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelScheduledSend indicates an expected call of CancelScheduledSend.
+func (mr *MockBankKeeperMockRecorder) CancelScheduledSend(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledSend", reflect.TypeOf((*MockBankKeeper)(nil).CancelScheduledSend), ctx, id)
+}
+
+// Clawback mocks base method.
+func (m *MockBankKeeper) Clawback(ctx context.Context, from types0.AccAddress, amount types0.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clawback", ctx, from, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clawback indicates an expected call of Clawback.
+func (mr *MockBankKeeperMockRecorder) Clawback(ctx, from, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clawback", reflect.TypeOf((*MockBankKeeper)(nil).Clawback), ctx, from, amount)
+}
+
 // ClearSendRestriction mocks base method.
 func (m *MockBankKeeper) ClearSendRestriction() {
 	m.ctrl.T.Helper()
@@ -234,6 +322,21 @@ func (mr *MockBankKeeperMockRecorder) ClearSendRestriction() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).ClearSendRestriction))
 }
 
+// CreateFactoryDenom mocks base method.
+func (m *MockBankKeeper) CreateFactoryDenom(ctx context.Context, creator types0.AccAddress, subdenom string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFactoryDenom", ctx, creator, subdenom)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFactoryDenom indicates an expected call of CreateFactoryDenom.
+func (mr *MockBankKeeperMockRecorder) CreateFactoryDenom(ctx, creator, subdenom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFactoryDenom", reflect.TypeOf((*MockBankKeeper)(nil).CreateFactoryDenom), ctx, creator, subdenom)
+}
+
 // CreditVirtualAccounts mocks base method.
 func (m *MockBankKeeper) CreditVirtualAccounts(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -293,6 +396,36 @@ func (mr *MockBankKeeperMockRecorder) DeleteSendEnabled(ctx any, denoms ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).DeleteSendEnabled), varargs...)
 }
 
+// DenomAuthorityMetadata mocks base method.
+func (m *MockBankKeeper) DenomAuthorityMetadata(arg0 context.Context, arg1 *types1.QueryDenomAuthorityMetadataRequest) (*types1.QueryDenomAuthorityMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomAuthorityMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryDenomAuthorityMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomAuthorityMetadata indicates an expected call of DenomAuthorityMetadata.
+func (mr *MockBankKeeperMockRecorder) DenomAuthorityMetadata(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomAuthorityMetadata", reflect.TypeOf((*MockBankKeeper)(nil).DenomAuthorityMetadata), arg0, arg1)
+}
+
+// DenomCompliance mocks base method.
+func (m *MockBankKeeper) DenomCompliance(arg0 context.Context, arg1 *types1.QueryDenomComplianceRequest) (*types1.QueryDenomComplianceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomCompliance", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryDenomComplianceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomCompliance indicates an expected call of DenomCompliance.
+func (mr *MockBankKeeperMockRecorder) DenomCompliance(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomCompliance", reflect.TypeOf((*MockBankKeeper)(nil).DenomCompliance), arg0, arg1)
+}
+
 // DenomMetadata mocks base method.
 func (m *MockBankKeeper) DenomMetadata(arg0 context.Context, arg1 *types1.QueryDenomMetadataRequest) (*types1.QueryDenomMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -353,6 +486,21 @@ func (mr *MockBankKeeperMockRecorder) DenomOwnersByQuery(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomOwnersByQuery", reflect.TypeOf((*MockBankKeeper)(nil).DenomOwnersByQuery), arg0, arg1)
 }
 
+// DenomsFromCreator mocks base method.
+func (m *MockBankKeeper) DenomsFromCreator(arg0 context.Context, arg1 *types1.QueryDenomsFromCreatorRequest) (*types1.QueryDenomsFromCreatorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomsFromCreator", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryDenomsFromCreatorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomsFromCreator indicates an expected call of DenomsFromCreator.
+func (mr *MockBankKeeperMockRecorder) DenomsFromCreator(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomsFromCreator", reflect.TypeOf((*MockBankKeeper)(nil).DenomsFromCreator), arg0, arg1)
+}
+
 // DenomsMetadata mocks base method.
 func (m *MockBankKeeper) DenomsMetadata(arg0 context.Context, arg1 *types1.QueryDenomsMetadataRequest) (*types1.QueryDenomsMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -368,6 +516,20 @@ func (mr *MockBankKeeperMockRecorder) DenomsMetadata(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomsMetadata", reflect.TypeOf((*MockBankKeeper)(nil).DenomsMetadata), arg0, arg1)
 }
 
+// ExecuteScheduledSends mocks base method.
+func (m *MockBankKeeper) ExecuteScheduledSends(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteScheduledSends", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteScheduledSends indicates an expected call of ExecuteScheduledSends.
+func (mr *MockBankKeeperMockRecorder) ExecuteScheduledSends(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteScheduledSends", reflect.TypeOf((*MockBankKeeper)(nil).ExecuteScheduledSends), ctx)
+}
+
 // ExportGenesis mocks base method.
 func (m *MockBankKeeper) ExportGenesis(arg0 context.Context) *types1.GenesisState {
 	m.ctrl.T.Helper()
@@ -382,6 +544,21 @@ func (mr *MockBankKeeperMockRecorder) ExportGenesis(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportGenesis", reflect.TypeOf((*MockBankKeeper)(nil).ExportGenesis), arg0)
 }
 
+// FrozenAddresses mocks base method.
+func (m *MockBankKeeper) FrozenAddresses(arg0 context.Context, arg1 *types1.QueryFrozenAddressesRequest) (*types1.QueryFrozenAddressesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FrozenAddresses", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryFrozenAddressesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FrozenAddresses indicates an expected call of FrozenAddresses.
+func (mr *MockBankKeeperMockRecorder) FrozenAddresses(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FrozenAddresses", reflect.TypeOf((*MockBankKeeper)(nil).FrozenAddresses), arg0, arg1)
+}
+
 // GetAccountsBalances mocks base method.
 func (m *MockBankKeeper) GetAccountsBalances(ctx context.Context) []types1.Balance {
 	m.ctrl.T.Helper()
@@ -410,6 +587,20 @@ func (mr *MockBankKeeperMockRecorder) GetAllBalances(ctx, addr any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalances", reflect.TypeOf((*MockBankKeeper)(nil).GetAllBalances), ctx, addr)
 }
 
+// GetAllDenomCompliance mocks base method.
+func (m *MockBankKeeper) GetAllDenomCompliance(ctx context.Context) []types1.DenomComplianceState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDenomCompliance", ctx)
+	ret0, _ := ret[0].([]types1.DenomComplianceState)
+	return ret0
+}
+
+// GetAllDenomCompliance indicates an expected call of GetAllDenomCompliance.
+func (mr *MockBankKeeperMockRecorder) GetAllDenomCompliance(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDenomCompliance", reflect.TypeOf((*MockBankKeeper)(nil).GetAllDenomCompliance), ctx)
+}
+
 // GetAllDenomMetaData mocks base method.
 func (m *MockBankKeeper) GetAllDenomMetaData(ctx context.Context) []types1.Metadata {
 	m.ctrl.T.Helper()
@@ -424,6 +615,34 @@ func (mr *MockBankKeeperMockRecorder) GetAllDenomMetaData(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetAllDenomMetaData), ctx)
 }
 
+// GetAllFactoryDenoms mocks base method.
+func (m *MockBankKeeper) GetAllFactoryDenoms(ctx context.Context) []types1.FactoryDenom {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllFactoryDenoms", ctx)
+	ret0, _ := ret[0].([]types1.FactoryDenom)
+	return ret0
+}
+
+// GetAllFactoryDenoms indicates an expected call of GetAllFactoryDenoms.
+func (mr *MockBankKeeperMockRecorder) GetAllFactoryDenoms(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFactoryDenoms", reflect.TypeOf((*MockBankKeeper)(nil).GetAllFactoryDenoms), ctx)
+}
+
+// GetAllScheduledSends mocks base method.
+func (m *MockBankKeeper) GetAllScheduledSends(ctx context.Context) []types1.ScheduledSend {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllScheduledSends", ctx)
+	ret0, _ := ret[0].([]types1.ScheduledSend)
+	return ret0
+}
+
+// GetAllScheduledSends indicates an expected call of GetAllScheduledSends.
+func (mr *MockBankKeeperMockRecorder) GetAllScheduledSends(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllScheduledSends", reflect.TypeOf((*MockBankKeeper)(nil).GetAllScheduledSends), ctx)
+}
+
 // GetAllSendEnabledEntries mocks base method.
 func (m *MockBankKeeper) GetAllSendEnabledEntries(ctx context.Context) []types1.SendEnabled {
 	m.ctrl.T.Helper()
@@ -480,6 +699,21 @@ func (mr *MockBankKeeperMockRecorder) GetBlockedAddresses() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedAddresses", reflect.TypeOf((*MockBankKeeper)(nil).GetBlockedAddresses))
 }
 
+// GetDenomCompliance mocks base method.
+func (m *MockBankKeeper) GetDenomCompliance(ctx context.Context, denom string) (types1.DenomCompliance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDenomCompliance", ctx, denom)
+	ret0, _ := ret[0].(types1.DenomCompliance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDenomCompliance indicates an expected call of GetDenomCompliance.
+func (mr *MockBankKeeperMockRecorder) GetDenomCompliance(ctx, denom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomCompliance", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomCompliance), ctx, denom)
+}
+
 // GetDenomMetaData mocks base method.
 func (m *MockBankKeeper) GetDenomMetaData(ctx context.Context, denom string) (types1.Metadata, bool) {
 	m.ctrl.T.Helper()
@@ -495,6 +729,21 @@ func (mr *MockBankKeeperMockRecorder) GetDenomMetaData(ctx, denom any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomMetaData), ctx, denom)
 }
 
+// GetFactoryDenomAuthority mocks base method.
+func (m *MockBankKeeper) GetFactoryDenomAuthority(ctx context.Context, denom string) (types1.DenomAuthorityMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFactoryDenomAuthority", ctx, denom)
+	ret0, _ := ret[0].(types1.DenomAuthorityMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFactoryDenomAuthority indicates an expected call of GetFactoryDenomAuthority.
+func (mr *MockBankKeeperMockRecorder) GetFactoryDenomAuthority(ctx, denom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFactoryDenomAuthority", reflect.TypeOf((*MockBankKeeper)(nil).GetFactoryDenomAuthority), ctx, denom)
+}
+
 // GetPaginatedTotalSupply mocks base method.
 func (m *MockBankKeeper) GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (types0.Coins, *query.PageResponse, error) {
 	m.ctrl.T.Helper()
@@ -525,6 +774,21 @@ func (mr *MockBankKeeperMockRecorder) GetParams(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockBankKeeper)(nil).GetParams), ctx)
 }
 
+// GetScheduledSend mocks base method.
+func (m *MockBankKeeper) GetScheduledSend(ctx context.Context, id uint64) (types1.ScheduledSend, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledSend", ctx, id)
+	ret0, _ := ret[0].(types1.ScheduledSend)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledSend indicates an expected call of GetScheduledSend.
+func (mr *MockBankKeeperMockRecorder) GetScheduledSend(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledSend", reflect.TypeOf((*MockBankKeeper)(nil).GetScheduledSend), ctx, id)
+}
+
 // GetSendEnabledEntry mocks base method.
 func (m *MockBankKeeper) GetSendEnabledEntry(ctx context.Context, denom string) (types1.SendEnabled, bool) {
 	m.ctrl.T.Helper()
@@ -554,6 +818,20 @@ func (mr *MockBankKeeperMockRecorder) GetSupply(ctx, denom any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupply", reflect.TypeOf((*MockBankKeeper)(nil).GetSupply), ctx, denom)
 }
 
+// GetTotalVirtualCoins mocks base method.
+func (m *MockBankKeeper) GetTotalVirtualCoins(ctx context.Context) types0.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalVirtualCoins", ctx)
+	ret0, _ := ret[0].(types0.Coins)
+	return ret0
+}
+
+// GetTotalVirtualCoins indicates an expected call of GetTotalVirtualCoins.
+func (mr *MockBankKeeperMockRecorder) GetTotalVirtualCoins(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalVirtualCoins", reflect.TypeOf((*MockBankKeeper)(nil).GetTotalVirtualCoins), ctx)
+}
+
 // HasBalance mocks base method.
 func (m *MockBankKeeper) HasBalance(ctx context.Context, addr types0.AccAddress, amt types0.Coin) bool {
 	m.ctrl.T.Helper()
@@ -582,6 +860,20 @@ func (mr *MockBankKeeperMockRecorder) HasDenomMetaData(ctx, denom any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).HasDenomMetaData), ctx, denom)
 }
 
+// HasFactoryDenomSendHook mocks base method.
+func (m *MockBankKeeper) HasFactoryDenomSendHook(name string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasFactoryDenomSendHook", name)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasFactoryDenomSendHook indicates an expected call of HasFactoryDenomSendHook.
+func (mr *MockBankKeeperMockRecorder) HasFactoryDenomSendHook(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasFactoryDenomSendHook", reflect.TypeOf((*MockBankKeeper)(nil).HasFactoryDenomSendHook), name)
+}
+
 // HasSupply mocks base method.
 func (m *MockBankKeeper) HasSupply(ctx context.Context, denom string) bool {
 	m.ctrl.T.Helper()
@@ -622,6 +914,34 @@ func (mr *MockBankKeeperMockRecorder) InputOutputCoins(ctx, input, outputs any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InputOutputCoins", reflect.TypeOf((*MockBankKeeper)(nil).InputOutputCoins), ctx, input, outputs)
 }
 
+// IsAllowlisted mocks base method.
+func (m *MockBankKeeper) IsAllowlisted(ctx context.Context, denom string, addr types0.AccAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAllowlisted", ctx, denom, addr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAllowlisted indicates an expected call of IsAllowlisted.
+func (mr *MockBankKeeperMockRecorder) IsAllowlisted(ctx, denom, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAllowlisted", reflect.TypeOf((*MockBankKeeper)(nil).IsAllowlisted), ctx, denom, addr)
+}
+
+// IsFrozen mocks base method.
+func (m *MockBankKeeper) IsFrozen(ctx context.Context, denom string, addr types0.AccAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFrozen", ctx, denom, addr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsFrozen indicates an expected call of IsFrozen.
+func (mr *MockBankKeeperMockRecorder) IsFrozen(ctx, denom, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFrozen", reflect.TypeOf((*MockBankKeeper)(nil).IsFrozen), ctx, denom, addr)
+}
+
 // IsSendEnabledCoin mocks base method.
 func (m *MockBankKeeper) IsSendEnabledCoin(ctx context.Context, coin types0.Coin) bool {
 	m.ctrl.T.Helper()
@@ -669,6 +989,20 @@ func (mr *MockBankKeeperMockRecorder) IsSendEnabledDenom(ctx, denom any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSendEnabledDenom", reflect.TypeOf((*MockBankKeeper)(nil).IsSendEnabledDenom), ctx, denom)
 }
 
+// IsVirtualRecipient mocks base method.
+func (m *MockBankKeeper) IsVirtualRecipient(addr types0.AccAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsVirtualRecipient", addr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsVirtualRecipient indicates an expected call of IsVirtualRecipient.
+func (mr *MockBankKeeperMockRecorder) IsVirtualRecipient(addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVirtualRecipient", reflect.TypeOf((*MockBankKeeper)(nil).IsVirtualRecipient), addr)
+}
+
 // IterateAccountBalances mocks base method.
 func (m *MockBankKeeper) IterateAccountBalances(ctx context.Context, addr types0.AccAddress, cb func(types0.Coin) bool) {
 	m.ctrl.T.Helper()
@@ -757,6 +1091,20 @@ func (mr *MockBankKeeperMockRecorder) MintCoins(ctx, moduleName, amt any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintCoins), ctx, moduleName, amt)
 }
 
+// MintFactoryCoins mocks base method.
+func (m *MockBankKeeper) MintFactoryCoins(ctx context.Context, to types0.AccAddress, amount types0.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MintFactoryCoins", ctx, to, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MintFactoryCoins indicates an expected call of MintFactoryCoins.
+func (mr *MockBankKeeperMockRecorder) MintFactoryCoins(ctx, to, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintFactoryCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintFactoryCoins), ctx, to, amount)
+}
+
 // Params mocks base method.
 func (m *MockBankKeeper) Params(arg0 context.Context, arg1 *types1.QueryParamsRequest) (*types1.QueryParamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -784,6 +1132,63 @@ func (mr *MockBankKeeperMockRecorder) PrependSendRestriction(restriction any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrependSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).PrependSendRestriction), restriction)
 }
 
+// RegisterFactoryDenomSendHook mocks base method.
+func (m *MockBankKeeper) RegisterFactoryDenomSendHook(name string, hook types1.SendRestrictionFn) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterFactoryDenomSendHook", name, hook)
+}
+
+// RegisterFactoryDenomSendHook indicates an expected call of RegisterFactoryDenomSendHook.
+func (mr *MockBankKeeperMockRecorder) RegisterFactoryDenomSendHook(name, hook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFactoryDenomSendHook", reflect.TypeOf((*MockBankKeeper)(nil).RegisterFactoryDenomSendHook), name, hook)
+}
+
+// ScheduleSend mocks base method.
+func (m *MockBankKeeper) ScheduleSend(ctx context.Context, sender, recipient types0.AccAddress, amount types0.Coins, startTime time.Time, period time.Duration, count uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleSend", ctx, sender, recipient, amount, startTime, period, count)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleSend indicates an expected call of ScheduleSend.
+func (mr *MockBankKeeperMockRecorder) ScheduleSend(ctx, sender, recipient, amount, startTime, period, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleSend", reflect.TypeOf((*MockBankKeeper)(nil).ScheduleSend), ctx, sender, recipient, amount, startTime, period, count)
+}
+
+// ScheduledSend mocks base method.
+func (m *MockBankKeeper) ScheduledSend(arg0 context.Context, arg1 *types1.QueryScheduledSendRequest) (*types1.QueryScheduledSendResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduledSend", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryScheduledSendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduledSend indicates an expected call of ScheduledSend.
+func (mr *MockBankKeeperMockRecorder) ScheduledSend(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduledSend", reflect.TypeOf((*MockBankKeeper)(nil).ScheduledSend), arg0, arg1)
+}
+
+// ScheduledSendsBySender mocks base method.
+func (m *MockBankKeeper) ScheduledSendsBySender(arg0 context.Context, arg1 *types1.QueryScheduledSendsBySenderRequest) (*types1.QueryScheduledSendsBySenderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduledSendsBySender", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryScheduledSendsBySenderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduledSendsBySender indicates an expected call of ScheduledSendsBySender.
+func (mr *MockBankKeeperMockRecorder) ScheduledSendsBySender(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduledSendsBySender", reflect.TypeOf((*MockBankKeeper)(nil).ScheduledSendsBySender), arg0, arg1)
+}
+
 // SendCoins mocks base method.
 func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types0.AccAddress, amt types0.Coins) error {
 	m.ctrl.T.Helper()
@@ -923,6 +1328,34 @@ func (mr *MockBankKeeperMockRecorder) SetAllSendEnabled(ctx, sendEnableds any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetAllSendEnabled), ctx, sendEnableds)
 }
 
+// SetAllowlisted mocks base method.
+func (m *MockBankKeeper) SetAllowlisted(ctx context.Context, denom string, addr types0.AccAddress, allowed bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAllowlisted", ctx, denom, addr, allowed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAllowlisted indicates an expected call of SetAllowlisted.
+func (mr *MockBankKeeperMockRecorder) SetAllowlisted(ctx, denom, addr, allowed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllowlisted", reflect.TypeOf((*MockBankKeeper)(nil).SetAllowlisted), ctx, denom, addr, allowed)
+}
+
+// SetDenomCompliance mocks base method.
+func (m *MockBankKeeper) SetDenomCompliance(ctx context.Context, denom string, compliance types1.DenomCompliance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomCompliance", ctx, denom, compliance)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDenomCompliance indicates an expected call of SetDenomCompliance.
+func (mr *MockBankKeeperMockRecorder) SetDenomCompliance(ctx, denom, compliance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomCompliance", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomCompliance), ctx, denom, compliance)
+}
+
 // SetDenomMetaData mocks base method.
 func (m *MockBankKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types1.Metadata) {
 	m.ctrl.T.Helper()
@@ -935,6 +1368,34 @@ func (mr *MockBankKeeperMockRecorder) SetDenomMetaData(ctx, denomMetaData any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetaData), ctx, denomMetaData)
 }
 
+// SetFactoryDenomAuthority mocks base method.
+func (m *MockBankKeeper) SetFactoryDenomAuthority(ctx context.Context, denom string, metadata types1.DenomAuthorityMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFactoryDenomAuthority", ctx, denom, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFactoryDenomAuthority indicates an expected call of SetFactoryDenomAuthority.
+func (mr *MockBankKeeperMockRecorder) SetFactoryDenomAuthority(ctx, denom, metadata any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFactoryDenomAuthority", reflect.TypeOf((*MockBankKeeper)(nil).SetFactoryDenomAuthority), ctx, denom, metadata)
+}
+
+// SetFrozen mocks base method.
+func (m *MockBankKeeper) SetFrozen(ctx context.Context, denom string, addr types0.AccAddress, frozen bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFrozen", ctx, denom, addr, frozen)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFrozen indicates an expected call of SetFrozen.
+func (mr *MockBankKeeperMockRecorder) SetFrozen(ctx, denom, addr, frozen any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFrozen", reflect.TypeOf((*MockBankKeeper)(nil).SetFrozen), ctx, denom, addr, frozen)
+}
+
 // SetParams mocks base method.
 func (m *MockBankKeeper) SetParams(ctx context.Context, params types1.Params) error {
 	m.ctrl.T.Helper()
@@ -1105,6 +1566,20 @@ func (mr *MockBankKeeperMockRecorder) ValidateBalance(ctx, addr any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBalance", reflect.TypeOf((*MockBankKeeper)(nil).ValidateBalance), ctx, addr)
 }
 
+// WithBalanceIndex mocks base method.
+func (m *MockBankKeeper) WithBalanceIndex(arg0 types1.BalanceIndex) keeper.BaseKeeper {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithBalanceIndex", arg0)
+	ret0, _ := ret[0].(keeper.BaseKeeper)
+	return ret0
+}
+
+// WithBalanceIndex indicates an expected call of WithBalanceIndex.
+func (mr *MockBankKeeperMockRecorder) WithBalanceIndex(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithBalanceIndex", reflect.TypeOf((*MockBankKeeper)(nil).WithBalanceIndex), arg0)
+}
+
 // WithMintCoinsRestriction mocks base method.
 func (m *MockBankKeeper) WithMintCoinsRestriction(arg0 types1.MintingRestrictionFn) keeper.BaseKeeper {
 	m.ctrl.T.Helper()
@@ -1133,6 +1608,24 @@ func (mr *MockBankKeeperMockRecorder) WithObjStoreKey(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithObjStoreKey", reflect.TypeOf((*MockBankKeeper)(nil).WithObjStoreKey), arg0)
 }
 
+// WithVirtualRecipients mocks base method.
+func (m *MockBankKeeper) WithVirtualRecipients(arg0 ...types0.AccAddress) keeper.BaseKeeper {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WithVirtualRecipients", varargs...)
+	ret0, _ := ret[0].(keeper.BaseKeeper)
+	return ret0
+}
+
+// WithVirtualRecipients indicates an expected call of WithVirtualRecipients.
+func (mr *MockBankKeeperMockRecorder) WithVirtualRecipients(arg0 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithVirtualRecipients", reflect.TypeOf((*MockBankKeeper)(nil).WithVirtualRecipients), arg0...)
+}
+
 // MockStakingKeeper is a mock of StakingKeeper interface.
 type MockStakingKeeper struct {
 	ctrl     *gomock.Controller
@@ -4,11 +4,15 @@ package types
 type Config struct {
 	// MaxMetadataLen defines the maximum proposal metadata length.
 	MaxMetadataLen uint64
+	// MaxGovernorDelegators defines the maximum number of delegators assigning
+	// their voting power to a single governor.
+	MaxGovernorDelegators uint64
 }
 
 // DefaultConfig returns the default config for gov.
 func DefaultConfig() Config {
 	return Config{
-		MaxMetadataLen: 255,
+		MaxMetadataLen:        255,
+		MaxGovernorDelegators: 10000,
 	}
 }
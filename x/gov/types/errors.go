@@ -25,4 +25,9 @@ var (
 	ErrInvalidProposal         = errors.Register(ModuleName, 21, "invalid proposal")
 	ErrSummaryTooLong          = errors.Register(ModuleName, 22, "summary too long")
 	ErrInvalidDepositDenom     = errors.Register(ModuleName, 23, "invalid deposit denom")
+	ErrGovernorExists          = errors.Register(ModuleName, 24, "governor already exists")
+	ErrGovernorNotFound        = errors.Register(ModuleName, 25, "governor not found")
+	ErrGovernorDelegation      = errors.Register(ModuleName, 26, "invalid governor delegation")
+	ErrNoGovernorDelegation    = errors.Register(ModuleName, 27, "no governor delegation found")
+	ErrMaxGovernorDelegators   = errors.Register(ModuleName, 28, "governor has reached the maximum number of delegators")
 )
@@ -2,18 +2,23 @@ package types
 
 // Governance module event types
 const (
-	EventTypeSubmitProposal   = "submit_proposal"
-	EventTypeProposalDeposit  = "proposal_deposit"
-	EventTypeProposalVote     = "proposal_vote"
-	EventTypeInactiveProposal = "inactive_proposal"
-	EventTypeActiveProposal   = "active_proposal"
-	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeSubmitProposal     = "submit_proposal"
+	EventTypeProposalDeposit    = "proposal_deposit"
+	EventTypeProposalVote       = "proposal_vote"
+	EventTypeInactiveProposal   = "inactive_proposal"
+	EventTypeActiveProposal     = "active_proposal"
+	EventTypeCancelProposal     = "cancel_proposal"
+	EventTypeCreateGovernor     = "create_governor"
+	EventTypeDelegateGovernor   = "delegate_governor"
+	EventTypeUndelegateGovernor = "undelegate_governor"
 
 	AttributeKeyProposalResult              = "proposal_result"
 	AttributeKeyVoter                       = "voter"
 	AttributeKeyOption                      = "option"
 	AttributeKeyProposalID                  = "proposal_id"
 	AttributeKeyDepositor                   = "depositor"
+	AttributeKeyGovernor                    = "governor"
+	AttributeKeyDelegator                   = "delegator"
 	AttributeKeyProposalMessages            = "proposal_messages" // Msg type_urls in the proposal
 	AttributeKeyVotingPeriodStart           = "voting_period_start"
 	AttributeKeyProposalLog                 = "proposal_log"                // log of proposal execution
@@ -25,4 +25,8 @@ var (
 	VotesKeyPrefix                = collections.NewPrefix(32) // VotesKeyPrefix stores the votes of proposals.
 	ParamsKey                     = collections.NewPrefix(48) // ParamsKey stores the module's params.
 	ConstitutionKey               = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
+	GovernorsKeyPrefix            = collections.NewPrefix(64) // GovernorsKeyPrefix stores the governors.
+	GovernorDelegationsKeyPrefix  = collections.NewPrefix(65) // GovernorDelegationsKeyPrefix stores the governor delegations by delegator.
+	GovernorDelegatorsKeyPrefix   = collections.NewPrefix(66) // GovernorDelegatorsKeyPrefix indexes the delegators of each governor.
+	GovernorDelegatorsCountPrefix = collections.NewPrefix(67) // GovernorDelegatorsCountPrefix stores the number of delegators of each governor.
 )
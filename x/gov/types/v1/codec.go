@@ -17,6 +17,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "cosmos-sdk/v1/MsgVoteWeighted")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "cosmos-sdk/v1/MsgExecLegacyContent")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateGovernor{}, "cosmos-sdk/v1/MsgCreateGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateGovernor{}, "cosmos-sdk/v1/MsgDelegateGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateGovernor{}, "cosmos-sdk/v1/MsgUndelegateGovernor")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -28,6 +31,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
+		&MsgCreateGovernor{},
+		&MsgDelegateGovernor{},
+		&MsgUndelegateGovernor{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -97,6 +97,37 @@ func ValidateGenesis(data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate governors and delegations to non-existent governors
+	errGroup.Go(func() error {
+		governors := make(map[string]struct{})
+		for _, g := range data.Governors {
+			if _, ok := governors[g.Address]; ok {
+				return fmt.Errorf("duplicate governor: %s", g.Address)
+			}
+
+			governors[g.Address] = struct{}{}
+		}
+
+		delegators := make(map[string]struct{})
+		for _, d := range data.GovernorDelegations {
+			if _, ok := governors[d.GovernorAddress]; !ok {
+				return fmt.Errorf("governor delegation %v has non-existent governor: %s", d, d.GovernorAddress)
+			}
+
+			if _, ok := governors[d.DelegatorAddress]; ok {
+				return fmt.Errorf("governor %s cannot delegate to another governor", d.DelegatorAddress)
+			}
+
+			if _, ok := delegators[d.DelegatorAddress]; ok {
+				return fmt.Errorf("duplicate governor delegation: %v", d)
+			}
+
+			delegators[d.DelegatorAddress] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic()
@@ -49,6 +49,10 @@ type GenesisState struct {
 	// There are no amendments, to go outside of scope, just fork.
 	// constitution is an immutable string in genesis for a chain builder to lay out their vision, ideas and ideals.
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// governors defines all the governors present at genesis.
+	Governors []*Governor `protobuf:"bytes,10,rep,name=governors,proto3" json:"governors,omitempty"`
+	// governor_delegations defines all the governor delegations present at genesis.
+	GovernorDelegations []*GovernorDelegation `protobuf:"bytes,11,rep,name=governor_delegations,json=governorDelegations,proto3" json:"governor_delegations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +154,20 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetGovernors() []*Governor {
+	if m != nil {
+		return m.Governors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernorDelegations() []*GovernorDelegation {
+	if m != nil {
+		return m.GovernorDelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -157,33 +175,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x4e, 0xea, 0x40,
-	0x18, 0xc5, 0x19, 0xfe, 0x5d, 0x18, 0xca, 0xbd, 0xc9, 0x70, 0xef, 0xa5, 0x82, 0x69, 0x1a, 0x57,
-	0x35, 0x86, 0x16, 0x50, 0xc2, 0x5a, 0x82, 0x21, 0xee, 0x48, 0x35, 0x2e, 0xdc, 0x90, 0x42, 0x9b,
-	0xda, 0x08, 0x9d, 0x86, 0x19, 0x26, 0xf2, 0x16, 0xbe, 0x84, 0x6f, 0xe0, 0x43, 0xb8, 0x64, 0x69,
-	0x5c, 0x19, 0x78, 0x11, 0xc3, 0x4c, 0x6b, 0xa1, 0xb2, 0x9c, 0xef, 0xfc, 0xce, 0x99, 0x93, 0x2f,
-	0x1f, 0xac, 0x4f, 0x30, 0x99, 0x61, 0x62, 0xb8, 0x98, 0x19, 0xac, 0x65, 0xb8, 0x8e, 0xef, 0x10,
-	0x8f, 0xe8, 0xc1, 0x1c, 0x53, 0x8c, 0xca, 0x42, 0xd4, 0x5d, 0xcc, 0x74, 0xd6, 0xaa, 0x55, 0x13,
-	0x2c, 0x66, 0x82, 0xab, 0x1d, 0x09, 0x61, 0xc4, 0x5f, 0x46, 0x68, 0xe2, 0x8f, 0x93, 0x97, 0x2c,
-	0x94, 0x06, 0x22, 0xf4, 0x86, 0x5a, 0xd4, 0x41, 0x4d, 0xf8, 0x97, 0x50, 0x6b, 0x4e, 0x3d, 0xdf,
-	0xdd, 0xf2, 0x01, 0x26, 0xd6, 0x74, 0xe4, 0xd9, 0x32, 0x50, 0x81, 0x96, 0x35, 0x51, 0xa4, 0x0d,
-	0x43, 0xe9, 0xda, 0x46, 0x6d, 0x58, 0xb0, 0x9d, 0x00, 0x13, 0x8f, 0x12, 0x39, 0xad, 0x66, 0xb4,
-	0x52, 0xfb, 0xbf, 0xbe, 0x57, 0x4c, 0xef, 0x0b, 0xd9, 0xfc, 0xe6, 0xd0, 0x29, 0xcc, 0x31, 0x4c,
-	0x1d, 0x22, 0x67, 0xb8, 0xa1, 0x92, 0x30, 0xdc, 0x61, 0xea, 0x98, 0x82, 0x40, 0x1d, 0x58, 0x8c,
-	0x7a, 0x10, 0x39, 0xcb, 0xf1, 0x6a, 0x02, 0x8f, 0xca, 0x98, 0x31, 0x89, 0x06, 0xf0, 0x77, 0xf8,
-	0xdb, 0x28, 0xb0, 0xe6, 0xd6, 0x8c, 0xc8, 0x39, 0x15, 0x68, 0xa5, 0xf6, 0xf1, 0xe1, 0x6e, 0x43,
-	0xce, 0xf4, 0xd2, 0x32, 0x30, 0xcb, 0xf6, 0xee, 0x08, 0xf5, 0x61, 0x99, 0x61, 0xb1, 0x0e, 0x91,
-	0x93, 0xe7, 0x39, 0xf5, 0x9f, 0x95, 0xb7, 0x6b, 0x89, 0x63, 0x24, 0xb6, 0x33, 0x41, 0x97, 0x50,
-	0xa2, 0xd6, 0x74, 0xba, 0x8c, 0x42, 0x7e, 0xf1, 0x90, 0x5a, 0x22, 0xe4, 0x76, 0x8b, 0xec, 0x64,
-	0x94, 0x68, 0x3c, 0x40, 0x3d, 0x98, 0x0f, 0xcd, 0x05, 0x6e, 0xfe, 0x97, 0xdc, 0x82, 0xf0, 0x55,
-	0x3e, 0x5e, 0x1b, 0x7f, 0x84, 0xd2, 0x20, 0xf6, 0xa3, 0xda, 0xd4, 0x2f, 0xba, 0x66, 0xe8, 0x44,
-	0x5d, 0x28, 0x4d, 0xb0, 0x4f, 0xa8, 0x47, 0x17, 0xd4, 0xc3, 0xbe, 0x5c, 0x54, 0x81, 0x56, 0x3c,
-	0x60, 0xe9, 0x34, 0xcd, 0x3d, 0xb0, 0x77, 0xf5, 0xb6, 0x56, 0xc0, 0x6a, 0xad, 0x80, 0xcf, 0xb5,
-	0x02, 0x9e, 0x37, 0x4a, 0x6a, 0xb5, 0x51, 0x52, 0xef, 0x1b, 0x25, 0x75, 0x7f, 0xe6, 0x7a, 0xf4,
-	0x61, 0x31, 0xd6, 0x27, 0x78, 0x16, 0x9e, 0x96, 0x11, 0x47, 0x19, 0x4f, 0xfc, 0x1a, 0xe9, 0x32,
-	0x70, 0x88, 0xc1, 0x5a, 0xe3, 0x3c, 0xbf, 0xba, 0xf3, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x28,
-	0xf7, 0x72, 0x00, 0xd7, 0x02, 0x00, 0x00,
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0xb6, 0x09, 0xcd, 0x26, 0x01, 0x69, 0x53, 0xe8, 0x92, 0x22, 0xcb, 0x70, 0x0a,
+	0x42, 0xb5, 0x93, 0x40, 0xd5, 0x33, 0x51, 0x50, 0xc4, 0xad, 0x5a, 0x2a, 0x0e, 0x5c, 0x22, 0x37,
+	0x5e, 0x19, 0x8b, 0xc4, 0x63, 0x79, 0xb6, 0x2b, 0xfa, 0x16, 0x3c, 0x0b, 0xe2, 0x21, 0x38, 0xf6,
+	0x88, 0x38, 0xa1, 0xe4, 0x45, 0x90, 0x77, 0xed, 0x3a, 0x75, 0xd2, 0xe3, 0xcc, 0xff, 0xfd, 0xbf,
+	0xc7, 0xb3, 0xbb, 0xe4, 0x64, 0x0e, 0xb8, 0x04, 0xf4, 0x42, 0x50, 0x9e, 0x1a, 0x7a, 0xa1, 0x88,
+	0x05, 0x46, 0xe8, 0x26, 0x29, 0x48, 0xa0, 0x1d, 0x23, 0xba, 0x21, 0x28, 0x57, 0x0d, 0x7b, 0xc7,
+	0x15, 0x16, 0x94, 0xe1, 0x7a, 0xcf, 0x8d, 0x30, 0xd3, 0x95, 0x97, 0x9b, 0x74, 0xf1, 0xea, 0x67,
+	0x9d, 0xb4, 0xa7, 0x26, 0xf4, 0x93, 0xf4, 0xa5, 0xa0, 0x03, 0x72, 0x84, 0xd2, 0x4f, 0x65, 0x14,
+	0x87, 0x19, 0x9f, 0x00, 0xfa, 0x8b, 0x59, 0x14, 0x30, 0xcb, 0xb1, 0xfa, 0x07, 0x9c, 0x16, 0xda,
+	0x45, 0x2e, 0x7d, 0x0c, 0xe8, 0x88, 0x1c, 0x06, 0x22, 0x01, 0x8c, 0x24, 0xb2, 0x3d, 0x67, 0xbf,
+	0xdf, 0x1a, 0x3d, 0x73, 0xef, 0x0d, 0xe6, 0x4e, 0x8c, 0xcc, 0xef, 0x38, 0xfa, 0x9a, 0xd4, 0x15,
+	0x48, 0x81, 0x6c, 0x5f, 0x1b, 0xba, 0x15, 0xc3, 0x67, 0x90, 0x82, 0x1b, 0x82, 0x9e, 0x91, 0x66,
+	0x31, 0x07, 0xb2, 0x03, 0x8d, 0x1f, 0x57, 0xf0, 0x62, 0x18, 0x5e, 0x92, 0x74, 0x4a, 0x1e, 0xe7,
+	0x5f, 0x9b, 0x25, 0x7e, 0xea, 0x2f, 0x91, 0xd5, 0x1d, 0xab, 0xdf, 0x1a, 0xbd, 0xd8, 0x3d, 0xdb,
+	0x85, 0x66, 0xc6, 0x7b, 0xcc, 0xe2, 0x9d, 0x60, 0xb3, 0x45, 0x27, 0xa4, 0xa3, 0xc0, 0xac, 0xc3,
+	0xe4, 0x34, 0x74, 0xce, 0xc9, 0xf6, 0xc8, 0xd9, 0x5a, 0xca, 0x98, 0xb6, 0xda, 0xe8, 0xd0, 0xf7,
+	0xa4, 0x2d, 0xfd, 0xc5, 0xe2, 0xa6, 0x08, 0x79, 0xa4, 0x43, 0x7a, 0x95, 0x90, 0xcb, 0x0c, 0xd9,
+	0xc8, 0x68, 0xc9, 0xb2, 0x41, 0xc7, 0xa4, 0x91, 0x9b, 0x0f, 0xb5, 0xf9, 0x69, 0x75, 0x0b, 0xc6,
+	0xd7, 0xfd, 0xfb, 0xeb, 0xf4, 0x89, 0x51, 0x4e, 0x31, 0xf8, 0xe6, 0x0c, 0xdc, 0x77, 0xe7, 0x3c,
+	0x77, 0xd2, 0x73, 0xd2, 0x9e, 0x43, 0x8c, 0x32, 0x92, 0xd7, 0x32, 0x82, 0x98, 0x35, 0x1d, 0xab,
+	0xdf, 0xdc, 0x61, 0x39, 0x1b, 0xf0, 0x7b, 0x60, 0x76, 0x0a, 0x21, 0x28, 0x91, 0xc6, 0x90, 0x22,
+	0x23, 0x3b, 0x4f, 0x61, 0x9a, 0xeb, 0xbc, 0x24, 0xe9, 0x25, 0x39, 0x2a, 0x8a, 0x59, 0x20, 0x16,
+	0x22, 0xf4, 0xb3, 0x34, 0x64, 0x2d, 0x9d, 0xf0, 0xf2, 0x81, 0x84, 0xc9, 0x1d, 0xc9, 0xbb, 0xe1,
+	0x56, 0x0f, 0xc7, 0x1f, 0x7e, 0xaf, 0x6c, 0xeb, 0x76, 0x65, 0x5b, 0xff, 0x56, 0xb6, 0xf5, 0x63,
+	0x6d, 0xd7, 0x6e, 0xd7, 0x76, 0xed, 0xcf, 0xda, 0xae, 0x7d, 0x79, 0x13, 0x46, 0xf2, 0xeb, 0xf5,
+	0x95, 0x3b, 0x87, 0x65, 0x7e, 0xcf, 0xbd, 0xf2, 0xbf, 0xbc, 0xef, 0xfa, 0x69, 0xc8, 0x9b, 0x44,
+	0xa0, 0xa7, 0x86, 0x57, 0x0d, 0xfd, 0x04, 0xde, 0xfe, 0x1f, 0x00, 0xba, 0x2d, 0x06, 0x49, 0x64,
+	0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -206,6 +227,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GovernorDelegations) > 0 {
+		for iNdEx := len(m.GovernorDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Governors) > 0 {
+		for iNdEx := len(m.Governors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Governors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -369,6 +418,18 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.Governors) > 0 {
+		for _, e := range m.Governors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovernorDelegations) > 0 {
+		for _, e := range m.GovernorDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -704,6 +765,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Governors = append(m.Governors, &Governor{})
+			if err := m.Governors[len(m.Governors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorDelegations = append(m.GovernorDelegations, &GovernorDelegation{})
+			if err := m.GovernorDelegations[len(m.GovernorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
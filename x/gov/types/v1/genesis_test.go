@@ -93,6 +93,43 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "veto threshold too large",
 		},
+		{
+			name: "duplicate message based params",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				mp := v1.MessageBasedParams{
+					MsgTypeUrl: "/cosmos.bank.v1beta1.*",
+					Params: v1.ProposalParams{
+						MinDeposit:   params.MinDeposit,
+						VotingPeriod: params.VotingPeriod,
+						Quorum:       params.Quorum,
+						Threshold:    params.Threshold,
+					},
+				}
+				params1.MessageBasedParams = []v1.MessageBasedParams{mp, mp}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "duplicate message based params for /cosmos.bank.v1beta1.*",
+		},
+		{
+			name: "invalid message based params denom",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MessageBasedParams = []v1.MessageBasedParams{{
+					MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend",
+					Params: v1.ProposalParams{
+						MinDeposit:   sdk.NewCoins(sdk.NewInt64Coin("other", 100)),
+						VotingPeriod: params.VotingPeriod,
+						Quorum:       params.Quorum,
+						Threshold:    params.Threshold,
+					},
+				}}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "minimum deposit denom other is not accepted",
+		},
 		{
 			name: "duplicate proposals",
 			genesisState: func() *v1.GenesisState {
@@ -170,6 +207,58 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "duplicate governors",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = append(state.Governors,
+					&v1.Governor{Address: "governor"},
+					&v1.Governor{Address: "governor"},
+				)
+
+				return state
+			},
+			expErrMsg: "duplicate governor: governor",
+		},
+		{
+			name: "non-existent governor in governor delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernorDelegations = append(state.GovernorDelegations,
+					&v1.GovernorDelegation{DelegatorAddress: "delegator", GovernorAddress: "governor"},
+				)
+
+				return state
+			},
+			expErrMsg: "has non-existent governor: governor",
+		},
+		{
+			name: "governor delegating to another governor",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = append(state.Governors, &v1.Governor{Address: "governor1"}, &v1.Governor{Address: "governor2"})
+				state.GovernorDelegations = append(state.GovernorDelegations,
+					&v1.GovernorDelegation{DelegatorAddress: "governor1", GovernorAddress: "governor2"},
+				)
+
+				return state
+			},
+			expErrMsg: "governor governor1 cannot delegate to another governor",
+		},
+		{
+			name: "duplicate governor delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = append(state.Governors, &v1.Governor{Address: "governor"})
+				state.GovernorDelegations = append(state.GovernorDelegations,
+					&v1.GovernorDelegation{DelegatorAddress: "delegator", GovernorAddress: "governor"},
+					&v1.GovernorDelegation{DelegatorAddress: "delegator", GovernorAddress: "governor"},
+				)
+
+				return state
+			},
+			expErrMsg: "duplicate governor delegation",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -277,6 +277,9 @@ type Proposal struct {
 	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
 	// failed_reason defines the reason why the proposal failed
 	FailedReason string `protobuf:"bytes,15,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+	// params defines the governance params resolved from the messages of the
+	// proposal at submission, it is nil when the default params apply.
+	Params *ProposalParams `protobuf:"bytes,16,opt,name=params,proto3" json:"params,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -417,6 +420,13 @@ func (m *Proposal) GetFailedReason() string {
 	return ""
 }
 
+func (m *Proposal) GetParams() *ProposalParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -748,15 +758,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -767,7 +777,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -779,6 +789,10 @@ type Params struct {
 	// Default value: 0.01. Meaning that for a chain with a min_deposit of 100stake, a deposit of 1stake would be
 	// required.
 	MinDepositRatio string `protobuf:"bytes,16,opt,name=min_deposit_ratio,json=minDepositRatio,proto3" json:"min_deposit_ratio,omitempty"`
+	// message_based_params defines the governance params applied to the proposals
+	// containing messages of given types instead of the default ones. The
+	// strictest params of the messages of a proposal apply.
+	MessageBasedParams []MessageBasedParams `protobuf:"bytes,17,rep,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -926,6 +940,258 @@ func (m *Params) GetMinDepositRatio() string {
 	return ""
 }
 
+func (m *Params) GetMessageBasedParams() []MessageBasedParams {
+	if m != nil {
+		return m.MessageBasedParams
+	}
+	return nil
+}
+
+// ProposalParams defines the minimum deposit, voting period, quorum and
+// threshold applied to a proposal.
+type ProposalParams struct {
+	// min_deposit is the minimum deposit for the proposal to enter voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	// voting_period is the duration of the voting period of the proposal.
+	VotingPeriod *time.Duration `protobuf:"bytes,2,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	// quorum is the minimum percentage of total stake needed to vote for the
+	// result to be considered valid.
+	Quorum string `protobuf:"bytes,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// threshold is the minimum proportion of Yes votes for the proposal to pass.
+	Threshold string `protobuf:"bytes,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *ProposalParams) Reset()         { *m = ProposalParams{} }
+func (m *ProposalParams) String() string { return proto.CompactTextString(m) }
+func (*ProposalParams) ProtoMessage()    {}
+func (*ProposalParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
+}
+func (m *ProposalParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalParams.Merge(m, src)
+}
+func (m *ProposalParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalParams proto.InternalMessageInfo
+
+func (m *ProposalParams) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
+func (m *ProposalParams) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return nil
+}
+
+func (m *ProposalParams) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *ProposalParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+// MessageBasedParams defines the governance params applied to the proposals
+// containing messages of a type.
+type MessageBasedParams struct {
+	// msg_type_url is the type URL of the messages, e.g.
+	// "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", or a type URL prefix ending
+	// with "*", e.g. "/cosmos.bank.v1beta1.*".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// params defines the params applied to the proposals containing the messages.
+	Params ProposalParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
+func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
+func (*MessageBasedParams) ProtoMessage()    {}
+func (*MessageBasedParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
+}
+func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageBasedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageBasedParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageBasedParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageBasedParams.Merge(m, src)
+}
+func (m *MessageBasedParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageBasedParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageBasedParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageBasedParams proto.InternalMessageInfo
+
+func (m *MessageBasedParams) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetParams() ProposalParams {
+	if m != nil {
+		return m.Params
+	}
+	return ProposalParams{}
+}
+
+// Governor defines an account registered on chain to which delegators can
+// assign their governance voting power.
+type Governor struct {
+	// address is the account address of the governor.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// metadata is any arbitrary metadata attached to the governor.
+	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *Governor) Reset()         { *m = Governor{} }
+func (m *Governor) String() string { return proto.CompactTextString(m) }
+func (*Governor) ProtoMessage()    {}
+func (*Governor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *Governor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Governor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Governor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Governor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Governor.Merge(m, src)
+}
+func (m *Governor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Governor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Governor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Governor proto.InternalMessageInfo
+
+func (m *Governor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Governor) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+// GovernorDelegation defines the assignment of the governance voting power of
+// a delegator to a governor.
+type GovernorDelegation struct {
+	// delegator_address is the account address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address is the account address of the governor.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+}
+
+func (m *GovernorDelegation) Reset()         { *m = GovernorDelegation{} }
+func (m *GovernorDelegation) String() string { return proto.CompactTextString(m) }
+func (*GovernorDelegation) ProtoMessage()    {}
+func (*GovernorDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *GovernorDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernorDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernorDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernorDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernorDelegation.Merge(m, src)
+}
+func (m *GovernorDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernorDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernorDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernorDelegation proto.InternalMessageInfo
+
+func (m *GovernorDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *GovernorDelegation) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -938,105 +1204,121 @@ func init() {
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*ProposalParams)(nil), "cosmos.gov.v1.ProposalParams")
+	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*Governor)(nil), "cosmos.gov.v1.Governor")
+	proto.RegisterType((*GovernorDelegation)(nil), "cosmos.gov.v1.GovernorDelegation")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x1a, 0x36, 0x25, 0x59, 0x96, 0x5e, 0x7d, 0x58, 0x19, 0x3b, 0x31, 0xed, 0xc4, 0xb2, 0x23, 0x04,
-	0x81, 0xf3, 0x61, 0xc9, 0x4e, 0xd6, 0xbb, 0x8b, 0x64, 0x2f, 0xb2, 0xc5, 0x24, 0x0a, 0x12, 0x4b,
-	0x4b, 0x29, 0x4e, 0xb2, 0xc0, 0x82, 0xa0, 0xcd, 0x89, 0xcc, 0x5d, 0x91, 0xa3, 0x92, 0x23, 0xc7,
-	0x3a, 0xf7, 0xd4, 0x5b, 0x8e, 0x3d, 0x15, 0x3d, 0xf6, 0xd8, 0x43, 0xd0, 0xdf, 0x90, 0x53, 0x11,
-	0xe4, 0xd2, 0xa2, 0x40, 0xd3, 0x22, 0x39, 0x14, 0x48, 0xff, 0x44, 0x31, 0x1f, 0x14, 0x25, 0x59,
-	0x86, 0xed, 0x5c, 0x6c, 0x6a, 0xe6, 0x79, 0x9e, 0xf7, 0x9d, 0xf7, 0x6b, 0x48, 0x98, 0xdb, 0x23,
-	0xbe, 0x43, 0xfc, 0x52, 0x8b, 0x1c, 0x94, 0x0e, 0xd6, 0xd9, 0xbf, 0x62, 0xc7, 0x23, 0x94, 0xa0,
-	0x8c, 0xd8, 0x28, 0xb2, 0x95, 0x83, 0xf5, 0x85, 0xbc, 0xc4, 0xed, 0x9a, 0x3e, 0x2e, 0x1d, 0xac,
-	0xef, 0x62, 0x6a, 0xae, 0x97, 0xf6, 0x88, 0xed, 0x0a, 0xf8, 0xc2, 0x6c, 0x8b, 0xb4, 0x08, 0x7f,
-	0x2c, 0xb1, 0x27, 0xb9, 0xba, 0xd4, 0x22, 0xa4, 0xd5, 0xc6, 0x25, 0xfe, 0x6b, 0xb7, 0xfb, 0xa2,
-	0x44, 0x6d, 0x07, 0xfb, 0xd4, 0x74, 0x3a, 0x12, 0x30, 0x3f, 0x0a, 0x30, 0xdd, 0x9e, 0xdc, 0xca,
-	0x8f, 0x6e, 0x59, 0x5d, 0xcf, 0xa4, 0x36, 0x09, 0x2c, 0xce, 0x0b, 0x8f, 0x0c, 0x61, 0x54, 0x7a,
-	0x2b, 0xb6, 0xce, 0x99, 0x8e, 0xed, 0x92, 0x12, 0xff, 0x2b, 0x96, 0x0a, 0x04, 0xd0, 0x53, 0x6c,
-	0xb7, 0xf6, 0x29, 0xb6, 0x76, 0x08, 0xc5, 0xb5, 0x0e, 0x53, 0x42, 0xeb, 0x10, 0x27, 0xfc, 0x49,
-	0x55, 0x96, 0x95, 0x95, 0xec, 0xad, 0xf9, 0xe2, 0xd0, 0xa9, 0x8b, 0x21, 0x54, 0x97, 0x40, 0x74,
-	0x15, 0xe2, 0x2f, 0xb9, 0x90, 0x1a, 0x59, 0x56, 0x56, 0x92, 0x9b, 0xd9, 0x77, 0xaf, 0x57, 0x41,
-	0xb2, 0x2a, 0x78, 0x4f, 0x97, 0xbb, 0x85, 0x6f, 0x15, 0x98, 0xaa, 0xe0, 0x0e, 0xf1, 0x6d, 0x8a,
-	0x96, 0x20, 0xd5, 0xf1, 0x48, 0x87, 0xf8, 0x66, 0xdb, 0xb0, 0x2d, 0x6e, 0x2b, 0xa6, 0x43, 0xb0,
-	0x54, 0xb5, 0xd0, 0xdf, 0x21, 0x69, 0x09, 0x2c, 0xf1, 0xa4, 0xae, 0xfa, 0xee, 0xf5, 0xea, 0xac,
-	0xd4, 0x2d, 0x5b, 0x96, 0x87, 0x7d, 0xbf, 0x41, 0x3d, 0xdb, 0x6d, 0xe9, 0x21, 0x14, 0xfd, 0x0b,
-	0xe2, 0xa6, 0x43, 0xba, 0x2e, 0x55, 0xa3, 0xcb, 0xd1, 0x95, 0x54, 0xe8, 0x3f, 0x4b, 0x53, 0x51,
-	0xa6, 0xa9, 0xb8, 0x45, 0x6c, 0x77, 0x33, 0xf9, 0xe6, 0xfd, 0xd2, 0xc4, 0x77, 0x7f, 0x7c, 0x7f,
-	0x5d, 0xd1, 0x25, 0xa7, 0xf0, 0x67, 0x1c, 0x12, 0x75, 0xe9, 0x04, 0xca, 0x42, 0xa4, 0xef, 0x5a,
-	0xc4, 0xb6, 0xd0, 0x1a, 0x24, 0x1c, 0xec, 0xfb, 0x66, 0x0b, 0xfb, 0x6a, 0x84, 0x8b, 0xcf, 0x16,
-	0x45, 0x46, 0x8a, 0x41, 0x46, 0x8a, 0x65, 0xb7, 0xa7, 0xf7, 0x51, 0x68, 0x03, 0xe2, 0x3e, 0x35,
-	0x69, 0xd7, 0x57, 0xa3, 0x3c, 0x98, 0x8b, 0x23, 0xc1, 0x0c, 0x4c, 0x35, 0x38, 0x48, 0x97, 0x60,
-	0xf4, 0x00, 0xd0, 0x0b, 0xdb, 0x35, 0xdb, 0x06, 0x35, 0xdb, 0xed, 0x9e, 0xe1, 0x61, 0xbf, 0xdb,
-	0xa6, 0x6a, 0x6c, 0x59, 0x59, 0x49, 0xdd, 0x5a, 0x18, 0x91, 0x68, 0x32, 0x88, 0xce, 0x11, 0x7a,
-	0x8e, 0xb3, 0x06, 0x56, 0x50, 0x19, 0x52, 0x7e, 0x77, 0xd7, 0xb1, 0xa9, 0xc1, 0xca, 0x4c, 0x9d,
-	0x94, 0x12, 0xa3, 0x5e, 0x37, 0x83, 0x1a, 0xdc, 0x8c, 0xbd, 0xfa, 0x6d, 0x49, 0xd1, 0x41, 0x90,
-	0xd8, 0x32, 0x7a, 0x08, 0x39, 0x19, 0x5d, 0x03, 0xbb, 0x96, 0xd0, 0x89, 0x9f, 0x52, 0x27, 0x2b,
-	0x99, 0x9a, 0x6b, 0x71, 0xad, 0x2a, 0x64, 0x28, 0xa1, 0x66, 0xdb, 0x90, 0xeb, 0xea, 0xd4, 0x19,
-	0x72, 0x94, 0xe6, 0xd4, 0xa0, 0x80, 0x1e, 0xc1, 0xb9, 0x03, 0x42, 0x6d, 0xb7, 0x65, 0xf8, 0xd4,
-	0xf4, 0xe4, 0xf9, 0x12, 0xa7, 0xf4, 0x6b, 0x5a, 0x50, 0x1b, 0x8c, 0xc9, 0x1d, 0x7b, 0x00, 0x72,
-	0x29, 0x3c, 0x63, 0xf2, 0x94, 0x5a, 0x19, 0x41, 0x0c, 0x8e, 0xb8, 0xc0, 0x8a, 0x84, 0x9a, 0x96,
-	0x49, 0x4d, 0x15, 0x58, 0xd9, 0xea, 0xfd, 0xdf, 0xe8, 0x1a, 0x4c, 0x52, 0x9b, 0xb6, 0xb1, 0x9a,
-	0xe2, 0xf5, 0x3c, 0xf3, 0xcb, 0xeb, 0xd5, 0x69, 0x71, 0xf2, 0x55, 0xdf, 0xfa, 0xff, 0xf2, 0x5a,
-	0xf1, 0x6f, 0xff, 0xd0, 0x05, 0x02, 0xad, 0xc2, 0x94, 0xdf, 0x75, 0x1c, 0xd3, 0xeb, 0xa9, 0xe9,
-	0xe3, 0xc1, 0x01, 0x06, 0xdd, 0x87, 0x84, 0xe8, 0x1d, 0xec, 0xa9, 0x19, 0x8e, 0xbf, 0x71, 0x5c,
-	0xb3, 0x8c, 0xd3, 0xe9, 0x93, 0xd1, 0x3a, 0x24, 0xf1, 0x61, 0x07, 0x5b, 0x36, 0xc5, 0x96, 0x9a,
-	0x5d, 0x56, 0x56, 0x12, 0x63, 0x2c, 0x6f, 0xac, 0xe9, 0x21, 0x0a, 0xfd, 0x13, 0x32, 0x2f, 0x4c,
-	0xbb, 0x8d, 0x2d, 0xc3, 0xc3, 0xa6, 0x4f, 0x5c, 0x75, 0xfa, 0x18, 0x87, 0x37, 0xd6, 0xf4, 0xb4,
-	0x40, 0xea, 0x1c, 0x58, 0xf8, 0x49, 0x81, 0xd4, 0x60, 0xb5, 0xde, 0x80, 0x64, 0x0f, 0xfb, 0xc6,
-	0x1e, 0x6f, 0x5f, 0xe5, 0xc8, 0x2c, 0xa9, 0xba, 0x54, 0x4f, 0xf4, 0xb0, 0xbf, 0xc5, 0xf6, 0xd1,
-	0x6d, 0xc8, 0x98, 0xbb, 0x3e, 0x35, 0x6d, 0x57, 0x12, 0x22, 0x63, 0x09, 0x69, 0x09, 0x12, 0xa4,
-	0x6b, 0x90, 0x70, 0x89, 0xc4, 0x47, 0xc7, 0xe2, 0xa7, 0x5c, 0x22, 0xa0, 0x77, 0x01, 0xb9, 0xc4,
-	0x78, 0x69, 0xd3, 0x7d, 0xe3, 0x00, 0xd3, 0x80, 0x14, 0x1b, 0x4b, 0x9a, 0x76, 0xc9, 0x53, 0x9b,
-	0xee, 0xef, 0x60, 0x2a, 0xc8, 0x85, 0x1f, 0x14, 0x88, 0xb1, 0x49, 0x79, 0xf2, 0x9c, 0x2b, 0xc2,
-	0xe4, 0x01, 0xa1, 0xf8, 0xe4, 0x19, 0x27, 0x60, 0xe8, 0x2e, 0x4c, 0x89, 0xb1, 0xeb, 0xab, 0x31,
-	0xde, 0x3c, 0x97, 0x47, 0x06, 0xc2, 0xd1, 0x99, 0xae, 0x07, 0x8c, 0xa1, 0xe2, 0x9c, 0x1c, 0x2e,
-	0xce, 0x87, 0xb1, 0x44, 0x34, 0x17, 0x2b, 0xfc, 0xaa, 0x40, 0x46, 0xb6, 0x58, 0xdd, 0xf4, 0x4c,
-	0xc7, 0x47, 0xcf, 0x21, 0xe5, 0xd8, 0x6e, 0xbf, 0x63, 0x95, 0x93, 0x3a, 0x76, 0x91, 0x75, 0xec,
-	0xa7, 0xf7, 0x4b, 0xe7, 0x07, 0x58, 0x37, 0x89, 0x63, 0x53, 0xec, 0x74, 0x68, 0x4f, 0x07, 0xc7,
-	0x76, 0x83, 0x1e, 0x76, 0x00, 0x39, 0xe6, 0x61, 0x00, 0x32, 0x3a, 0xd8, 0xb3, 0x89, 0xc5, 0x03,
-	0xc1, 0x2c, 0x8c, 0x36, 0x5e, 0x45, 0x5e, 0x76, 0x9b, 0x57, 0x3e, 0xbd, 0x5f, 0xba, 0x74, 0x94,
-	0x18, 0x1a, 0xf9, 0x9a, 0xf5, 0x65, 0xce, 0x31, 0x0f, 0x83, 0x93, 0xf0, 0xfd, 0x3b, 0x11, 0x55,
-	0x29, 0x3c, 0x83, 0xf4, 0x0e, 0xef, 0x57, 0x79, 0xba, 0x0a, 0xc8, 0xfe, 0x0d, 0xac, 0x2b, 0x27,
-	0x59, 0x8f, 0x71, 0xf5, 0xb4, 0x60, 0x0d, 0x28, 0x7f, 0x13, 0x14, 0xb3, 0x54, 0xbe, 0x0a, 0xf1,
-	0x2f, 0xba, 0xc4, 0xeb, 0x3a, 0x63, 0x2a, 0x99, 0xdf, 0x8a, 0x62, 0x17, 0xdd, 0x84, 0x24, 0xdd,
-	0xf7, 0xb0, 0xbf, 0x4f, 0xda, 0xd6, 0x31, 0x17, 0x68, 0x08, 0x40, 0x1b, 0x90, 0xe5, 0xd5, 0x18,
-	0x52, 0xa2, 0x63, 0x29, 0x19, 0x86, 0x6a, 0x06, 0x20, 0xee, 0xe0, 0x97, 0x49, 0x88, 0x4b, 0xdf,
-	0xb4, 0x33, 0xe6, 0x74, 0x60, 0x0a, 0x0f, 0xe6, 0xef, 0xf1, 0xe7, 0xe5, 0x2f, 0x36, 0x3e, 0x3f,
-	0x47, 0x73, 0x11, 0xfd, 0x8c, 0x5c, 0x0c, 0xc4, 0x3d, 0x76, 0xfa, 0xb8, 0x4f, 0x9e, 0x3d, 0xee,
-	0xf1, 0x53, 0xc4, 0x1d, 0x55, 0x61, 0x9e, 0x05, 0xda, 0x76, 0x6d, 0x6a, 0x87, 0xd7, 0x9e, 0xc1,
-	0xdd, 0x57, 0xa7, 0xc6, 0x2a, 0x5c, 0x70, 0x6c, 0xb7, 0x2a, 0xf0, 0x32, 0x3c, 0x3a, 0x43, 0xa3,
-	0x27, 0x70, 0xbe, 0x3f, 0x49, 0xf6, 0x4c, 0x77, 0x0f, 0xb7, 0xa5, 0x4c, 0x82, 0xcb, 0x5c, 0x1e,
-	0x96, 0x19, 0x37, 0x7c, 0x67, 0x02, 0xfe, 0x16, 0xa7, 0x0b, 0xd9, 0xff, 0xc2, 0xec, 0xa8, 0xac,
-	0x85, 0x7d, 0xca, 0xaf, 0xbf, 0xb3, 0xdc, 0x22, 0x1b, 0x6b, 0x3a, 0x1a, 0xd6, 0xaf, 0x60, 0x9f,
-	0xa2, 0xff, 0xc1, 0x5c, 0xff, 0xa6, 0x30, 0x86, 0xb3, 0x0b, 0x27, 0x65, 0x77, 0x8e, 0x65, 0x77,
-	0x9c, 0xa1, 0xf3, 0x7d, 0xc9, 0x9d, 0xc1, 0xcc, 0xeb, 0x30, 0x13, 0xda, 0x0a, 0x13, 0x95, 0x3a,
-	0x6d, 0x7c, 0x50, 0x9f, 0x1d, 0x26, 0xf0, 0x19, 0x84, 0xc6, 0x8c, 0xc1, 0x9e, 0x49, 0x9f, 0xa1,
-	0x67, 0x42, 0xb7, 0x1e, 0x87, 0xcd, 0xb3, 0x02, 0xb9, 0xdd, 0xae, 0xe7, 0xb2, 0xa0, 0x60, 0x43,
-	0x56, 0x2c, 0xbb, 0xba, 0x13, 0x7a, 0x96, 0xad, 0xb3, 0xf1, 0xfd, 0x6f, 0x51, 0xa9, 0x65, 0x58,
-	0xe4, 0xc8, 0x7e, 0x9e, 0xfa, 0x0d, 0xe7, 0x61, 0xc6, 0x16, 0xf7, 0xb4, 0xbe, 0xc0, 0x40, 0xc1,
-	0x1b, 0x65, 0xd0, 0x59, 0x02, 0x81, 0xae, 0x40, 0x36, 0x34, 0xc6, 0x4a, 0x94, 0x5f, 0xd2, 0x09,
-	0x3d, 0x1d, 0x98, 0x62, 0x57, 0x17, 0x7a, 0x0c, 0xe7, 0x06, 0x8e, 0x28, 0xcb, 0x2b, 0x77, 0xda,
-	0xf0, 0x4d, 0x87, 0x93, 0x81, 0x97, 0xd6, 0x9d, 0x99, 0x77, 0x47, 0x5f, 0x35, 0xae, 0x7f, 0xa5,
-	0x00, 0x0c, 0x7c, 0x6e, 0x5c, 0x84, 0xb9, 0x9d, 0x5a, 0x53, 0x33, 0x6a, 0xf5, 0x66, 0xb5, 0xb6,
-	0x6d, 0x3c, 0xd9, 0x6e, 0xd4, 0xb5, 0xad, 0xea, 0xbd, 0xaa, 0x56, 0xc9, 0x4d, 0xa0, 0x19, 0x98,
-	0x1e, 0xdc, 0x7c, 0xae, 0x35, 0x72, 0x0a, 0x9a, 0x83, 0x99, 0xc1, 0xc5, 0xf2, 0x66, 0xa3, 0x59,
-	0xae, 0x6e, 0xe7, 0x22, 0x08, 0x41, 0x76, 0x70, 0x63, 0xbb, 0x96, 0x8b, 0xa2, 0x4b, 0xa0, 0x0e,
-	0xaf, 0x19, 0x4f, 0xab, 0xcd, 0x07, 0xc6, 0x8e, 0xd6, 0xac, 0xe5, 0x62, 0xd7, 0x7f, 0x54, 0x20,
-	0x3b, 0xfc, 0x0a, 0x8e, 0x96, 0xe0, 0x62, 0x5d, 0xaf, 0xd5, 0x6b, 0x8d, 0xf2, 0x23, 0xa3, 0xd1,
-	0x2c, 0x37, 0x9f, 0x34, 0x46, 0x7c, 0x2a, 0x40, 0x7e, 0x14, 0x50, 0xd1, 0xea, 0xb5, 0x46, 0xb5,
-	0x69, 0xd4, 0x35, 0xbd, 0x5a, 0xab, 0xe4, 0x14, 0x74, 0x19, 0x16, 0x47, 0x31, 0x3b, 0xb5, 0x66,
-	0x75, 0xfb, 0x7e, 0x00, 0x89, 0xa0, 0x05, 0xb8, 0x30, 0x0a, 0xa9, 0x97, 0x1b, 0x0d, 0xad, 0x22,
-	0x9c, 0x1e, 0xdd, 0xd3, 0xb5, 0x87, 0xda, 0x56, 0x53, 0xab, 0xe4, 0x62, 0xe3, 0x98, 0xf7, 0xca,
-	0xd5, 0x47, 0x5a, 0x25, 0x37, 0xb9, 0xa9, 0xbd, 0xf9, 0x90, 0x57, 0xde, 0x7e, 0xc8, 0x2b, 0xbf,
-	0x7f, 0xc8, 0x2b, 0xaf, 0x3e, 0xe6, 0x27, 0xde, 0x7e, 0xcc, 0x4f, 0xfc, 0xfc, 0x31, 0x3f, 0xf1,
-	0x9f, 0x1b, 0x2d, 0x9b, 0xee, 0x77, 0x77, 0x8b, 0x7b, 0xc4, 0x91, 0x1f, 0x86, 0xa5, 0x30, 0x33,
-	0xa5, 0x43, 0xfe, 0xb1, 0x4b, 0x7b, 0x1d, 0xec, 0xb3, 0x2f, 0xd9, 0x38, 0xef, 0xc5, 0xdb, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xb4, 0x23, 0x3e, 0x0a, 0x0f, 0x00, 0x00,
+	// 1674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0x14, 0x45, 0x3e, 0x91, 0xd4, 0x6a, 0x24, 0x47, 0x6b, 0xc5, 0xa6, 0x64, 0x22,
+	0x08, 0x14, 0x3b, 0x22, 0x25, 0xa7, 0x4a, 0x8b, 0xa4, 0x87, 0x92, 0xe2, 0xc6, 0xa6, 0x61, 0x8b,
+	0xec, 0x92, 0x92, 0x93, 0x00, 0xc5, 0x62, 0xa5, 0x1d, 0x53, 0xdb, 0x72, 0x77, 0x98, 0xdd, 0x21,
+	0x23, 0xfe, 0x09, 0xbd, 0xe5, 0xd8, 0x53, 0x51, 0xf4, 0xd4, 0x63, 0x0f, 0x46, 0xff, 0x86, 0x9c,
+	0x8a, 0xc0, 0x97, 0x16, 0x05, 0xea, 0x16, 0xf6, 0xa1, 0x40, 0xae, 0x05, 0x7a, 0x2e, 0xe6, 0x63,
+	0xb9, 0xfc, 0x12, 0x48, 0xa5, 0xbd, 0x48, 0xdc, 0x99, 0xdf, 0xef, 0xbd, 0x37, 0xef, 0x73, 0x76,
+	0x61, 0xeb, 0x82, 0x04, 0x2e, 0x09, 0x4a, 0x6d, 0xd2, 0x2f, 0xf5, 0x0f, 0xd9, 0xbf, 0x62, 0xd7,
+	0x27, 0x94, 0xa0, 0xac, 0xd8, 0x28, 0xb2, 0x95, 0xfe, 0xe1, 0x76, 0x5e, 0xe2, 0xce, 0xad, 0x00,
+	0x97, 0xfa, 0x87, 0xe7, 0x98, 0x5a, 0x87, 0xa5, 0x0b, 0xe2, 0x78, 0x02, 0xbe, 0xbd, 0xd9, 0x26,
+	0x6d, 0xc2, 0x7f, 0x96, 0xd8, 0x2f, 0xb9, 0xba, 0xd3, 0x26, 0xa4, 0xdd, 0xc1, 0x25, 0xfe, 0x74,
+	0xde, 0x7b, 0x51, 0xa2, 0x8e, 0x8b, 0x03, 0x6a, 0xb9, 0x5d, 0x09, 0xb8, 0x3d, 0x09, 0xb0, 0xbc,
+	0x81, 0xdc, 0xca, 0x4f, 0x6e, 0xd9, 0x3d, 0xdf, 0xa2, 0x0e, 0x09, 0x35, 0xde, 0x16, 0x16, 0x99,
+	0x42, 0xa9, 0xb4, 0x56, 0x6c, 0xad, 0x5b, 0xae, 0xe3, 0x91, 0x12, 0xff, 0x2b, 0x96, 0x0a, 0x04,
+	0xd0, 0x73, 0xec, 0xb4, 0x2f, 0x29, 0xb6, 0xcf, 0x08, 0xc5, 0xf5, 0x2e, 0x93, 0x84, 0x0e, 0x21,
+	0x49, 0xf8, 0x2f, 0x4d, 0xd9, 0x55, 0xf6, 0x72, 0x0f, 0x6f, 0x17, 0xc7, 0x4e, 0x5d, 0x8c, 0xa0,
+	0x86, 0x04, 0xa2, 0xf7, 0x21, 0xf9, 0x35, 0x17, 0xa4, 0xc5, 0x76, 0x95, 0xbd, 0x74, 0x25, 0xf7,
+	0xea, 0xe5, 0x3e, 0x48, 0x56, 0x15, 0x5f, 0x18, 0x72, 0xb7, 0xf0, 0x3b, 0x05, 0x56, 0xaa, 0xb8,
+	0x4b, 0x02, 0x87, 0xa2, 0x1d, 0x58, 0xed, 0xfa, 0xa4, 0x4b, 0x02, 0xab, 0x63, 0x3a, 0x36, 0xd7,
+	0x95, 0x30, 0x20, 0x5c, 0xaa, 0xd9, 0xe8, 0x63, 0x48, 0xdb, 0x02, 0x4b, 0x7c, 0x29, 0x57, 0x7b,
+	0xf5, 0x72, 0x7f, 0x53, 0xca, 0x2d, 0xdb, 0xb6, 0x8f, 0x83, 0xa0, 0x49, 0x7d, 0xc7, 0x6b, 0x1b,
+	0x11, 0x14, 0xfd, 0x14, 0x92, 0x96, 0x4b, 0x7a, 0x1e, 0xd5, 0xe2, 0xbb, 0xf1, 0xbd, 0xd5, 0xc8,
+	0x7e, 0x16, 0xa6, 0xa2, 0x0c, 0x53, 0xf1, 0x98, 0x38, 0x5e, 0x25, 0xfd, 0xed, 0xeb, 0x9d, 0xa5,
+	0x3f, 0xfc, 0xeb, 0x8f, 0xf7, 0x15, 0x43, 0x72, 0x0a, 0xbf, 0x5f, 0x81, 0x54, 0x43, 0x1a, 0x81,
+	0x72, 0x10, 0x1b, 0x9a, 0x16, 0x73, 0x6c, 0x74, 0x00, 0x29, 0x17, 0x07, 0x81, 0xd5, 0xc6, 0x81,
+	0x16, 0xe3, 0xc2, 0x37, 0x8b, 0x22, 0x22, 0xc5, 0x30, 0x22, 0xc5, 0xb2, 0x37, 0x30, 0x86, 0x28,
+	0x74, 0x04, 0xc9, 0x80, 0x5a, 0xb4, 0x17, 0x68, 0x71, 0xee, 0xcc, 0xbb, 0x13, 0xce, 0x0c, 0x55,
+	0x35, 0x39, 0xc8, 0x90, 0x60, 0xf4, 0x18, 0xd0, 0x0b, 0xc7, 0xb3, 0x3a, 0x26, 0xb5, 0x3a, 0x9d,
+	0x81, 0xe9, 0xe3, 0xa0, 0xd7, 0xa1, 0x5a, 0x62, 0x57, 0xd9, 0x5b, 0x7d, 0xb8, 0x3d, 0x21, 0xa2,
+	0xc5, 0x20, 0x06, 0x47, 0x18, 0x2a, 0x67, 0x8d, 0xac, 0xa0, 0x32, 0xac, 0x06, 0xbd, 0x73, 0xd7,
+	0xa1, 0x26, 0x4b, 0x33, 0x6d, 0x59, 0x8a, 0x98, 0xb4, 0xba, 0x15, 0xe6, 0x60, 0x25, 0xf1, 0xcd,
+	0x3f, 0x76, 0x14, 0x03, 0x04, 0x89, 0x2d, 0xa3, 0x27, 0xa0, 0x4a, 0xef, 0x9a, 0xd8, 0xb3, 0x85,
+	0x9c, 0xe4, 0x82, 0x72, 0x72, 0x92, 0xa9, 0x7b, 0x36, 0x97, 0x55, 0x83, 0x2c, 0x25, 0xd4, 0xea,
+	0x98, 0x72, 0x5d, 0x5b, 0xb9, 0x41, 0x8c, 0x32, 0x9c, 0x1a, 0x26, 0xd0, 0x53, 0x58, 0xef, 0x13,
+	0xea, 0x78, 0x6d, 0x33, 0xa0, 0x96, 0x2f, 0xcf, 0x97, 0x5a, 0xd0, 0xae, 0x35, 0x41, 0x6d, 0x32,
+	0x26, 0x37, 0xec, 0x31, 0xc8, 0xa5, 0xe8, 0x8c, 0xe9, 0x05, 0x65, 0x65, 0x05, 0x31, 0x3c, 0xe2,
+	0x36, 0x4b, 0x12, 0x6a, 0xd9, 0x16, 0xb5, 0x34, 0x60, 0x69, 0x6b, 0x0c, 0x9f, 0xd1, 0x07, 0xb0,
+	0x4c, 0x1d, 0xda, 0xc1, 0xda, 0x2a, 0xcf, 0xe7, 0x8d, 0xbf, 0xbd, 0xdc, 0x5f, 0x13, 0x27, 0xdf,
+	0x0f, 0xec, 0x5f, 0xed, 0x1e, 0x14, 0x7f, 0xf4, 0x63, 0x43, 0x20, 0xd0, 0x3e, 0xac, 0x04, 0x3d,
+	0xd7, 0xb5, 0xfc, 0x81, 0x96, 0xb9, 0x1e, 0x1c, 0x62, 0xd0, 0x23, 0x48, 0x89, 0xda, 0xc1, 0xbe,
+	0x96, 0xe5, 0xf8, 0x07, 0xd7, 0x15, 0xcb, 0x2c, 0x39, 0x43, 0x32, 0x3a, 0x84, 0x34, 0xbe, 0xea,
+	0x62, 0xdb, 0xa1, 0xd8, 0xd6, 0x72, 0xbb, 0xca, 0x5e, 0x6a, 0x86, 0xe6, 0xa3, 0x03, 0x23, 0x42,
+	0xa1, 0x9f, 0x40, 0xf6, 0x85, 0xe5, 0x74, 0xb0, 0x6d, 0xfa, 0xd8, 0x0a, 0x88, 0xa7, 0xad, 0x5d,
+	0x63, 0xf0, 0xd1, 0x81, 0x91, 0x11, 0x48, 0x83, 0x03, 0x59, 0x79, 0x74, 0x2d, 0xdf, 0x72, 0x03,
+	0x4d, 0xe5, 0xce, 0xbe, 0xae, 0x3c, 0x1a, 0x1c, 0x64, 0x48, 0x70, 0xe1, 0x2f, 0x0a, 0xac, 0x8e,
+	0x26, 0xf9, 0x03, 0x48, 0x0f, 0x70, 0x60, 0x5e, 0xf0, 0xaa, 0x57, 0xa6, 0x5a, 0x50, 0xcd, 0xa3,
+	0x46, 0x6a, 0x80, 0x83, 0x63, 0xb6, 0x8f, 0x3e, 0x82, 0xac, 0x75, 0x1e, 0x50, 0xcb, 0xf1, 0x24,
+	0x21, 0x36, 0x93, 0x90, 0x91, 0x20, 0x41, 0xfa, 0x00, 0x52, 0x1e, 0x91, 0xf8, 0xf8, 0x4c, 0xfc,
+	0x8a, 0x47, 0x04, 0xf4, 0x53, 0x40, 0x1e, 0x31, 0xbf, 0x76, 0xe8, 0xa5, 0xd9, 0xc7, 0x34, 0x24,
+	0x25, 0x66, 0x92, 0xd6, 0x3c, 0xf2, 0xdc, 0xa1, 0x97, 0x67, 0x98, 0x0a, 0x72, 0xe1, 0x4f, 0x0a,
+	0x24, 0x58, 0x83, 0x9d, 0xdf, 0x1e, 0x8b, 0xb0, 0xdc, 0x27, 0x14, 0xcf, 0x6f, 0x8d, 0x02, 0x86,
+	0x3e, 0x85, 0x15, 0xd1, 0xad, 0x03, 0x2d, 0xc1, 0x6b, 0xee, 0xde, 0x84, 0xaf, 0xa7, 0x47, 0x81,
+	0x11, 0x32, 0xc6, 0x72, 0x7a, 0x79, 0x3c, 0xa7, 0x9f, 0x24, 0x52, 0x71, 0x35, 0x51, 0xf8, 0xbb,
+	0x02, 0x59, 0x59, 0x99, 0x22, 0x58, 0xe8, 0x0b, 0x58, 0x75, 0x1d, 0x6f, 0x58, 0xe8, 0xca, 0xbc,
+	0x42, 0xbf, 0xcb, 0x0a, 0xfd, 0xfb, 0xd7, 0x3b, 0xb7, 0x46, 0x58, 0x1f, 0x12, 0xd7, 0xa1, 0xd8,
+	0xed, 0xd2, 0x81, 0x01, 0xae, 0xe3, 0x85, 0xa5, 0xef, 0x02, 0x72, 0xad, 0xab, 0x10, 0x64, 0x76,
+	0xb1, 0xef, 0x10, 0x9b, 0x3b, 0x82, 0x69, 0x98, 0xac, 0xd7, 0xaa, 0x9c, 0x91, 0x95, 0xf7, 0xbe,
+	0x7f, 0xbd, 0x73, 0x67, 0x9a, 0x18, 0x29, 0xf9, 0x0d, 0x2b, 0x67, 0xd5, 0xb5, 0xae, 0xc2, 0x93,
+	0xf0, 0xfd, 0x4f, 0x62, 0x9a, 0x52, 0xf8, 0x1c, 0x32, 0x67, 0xbc, 0xcc, 0xe5, 0xe9, 0xaa, 0x20,
+	0xcb, 0x3e, 0xd4, 0xae, 0xcc, 0xd3, 0x9e, 0xe0, 0xd2, 0x33, 0x82, 0x35, 0x22, 0xf9, 0xb7, 0x61,
+	0x32, 0x4b, 0xc9, 0xef, 0x43, 0xf2, 0xab, 0x1e, 0xf1, 0x7b, 0xae, 0xa6, 0xcc, 0x1e, 0xa6, 0x62,
+	0x17, 0x7d, 0x08, 0x69, 0x7a, 0xe9, 0xe3, 0xe0, 0x92, 0x74, 0xec, 0x6b, 0xe6, 0x6e, 0x04, 0x40,
+	0x47, 0x90, 0xe3, 0xd9, 0x18, 0x51, 0xe2, 0x33, 0x29, 0x59, 0x86, 0x6a, 0x85, 0x20, 0x6e, 0xe0,
+	0x7f, 0xd2, 0x90, 0x94, 0xb6, 0xe9, 0x37, 0x8c, 0xe9, 0x48, 0xf3, 0x1e, 0x8d, 0xdf, 0xb3, 0x1f,
+	0x16, 0xbf, 0xc4, 0xec, 0xf8, 0x4c, 0xc7, 0x22, 0xfe, 0x03, 0x62, 0x31, 0xe2, 0xf7, 0xc4, 0xe2,
+	0x7e, 0x5f, 0xbe, 0xb9, 0xdf, 0x93, 0x0b, 0xf8, 0x1d, 0xd5, 0xe0, 0x36, 0x73, 0xb4, 0xe3, 0x39,
+	0xd4, 0x89, 0xa6, 0xa5, 0xc9, 0xcd, 0xd7, 0x56, 0x66, 0x4a, 0x78, 0xc7, 0x75, 0xbc, 0x9a, 0xc0,
+	0x4b, 0xf7, 0x18, 0x0c, 0x8d, 0x4e, 0xe1, 0xd6, 0xb0, 0x93, 0x5c, 0x58, 0xde, 0x05, 0xee, 0x48,
+	0x31, 0x29, 0x2e, 0xe6, 0xde, 0xb8, 0x98, 0x59, 0x3d, 0x7b, 0x23, 0xe4, 0x1f, 0x73, 0xba, 0x10,
+	0xfb, 0x0b, 0xd8, 0x9c, 0x14, 0x6b, 0xe3, 0x80, 0x6a, 0xe9, 0x1b, 0x0e, 0x9f, 0xa3, 0x03, 0x03,
+	0x8d, 0xcb, 0xaf, 0xe2, 0x80, 0xa2, 0x5f, 0xc2, 0xd6, 0x70, 0xc0, 0x98, 0xe3, 0xd1, 0x85, 0x79,
+	0xd1, 0xdd, 0x62, 0xd1, 0x9d, 0xa5, 0xe8, 0xd6, 0x50, 0xe4, 0xd9, 0x68, 0xe4, 0x0d, 0xd8, 0x88,
+	0x74, 0x45, 0x81, 0x5a, 0x5d, 0xd4, 0x3f, 0x68, 0xc8, 0x8e, 0x02, 0xf8, 0x39, 0x44, 0xca, 0xcc,
+	0xd1, 0x9a, 0xc9, 0xdc, 0xa0, 0x66, 0x22, 0xb3, 0x9e, 0x45, 0xc5, 0xb3, 0x07, 0xea, 0x79, 0xcf,
+	0xf7, 0x98, 0x53, 0xb0, 0x29, 0x33, 0x96, 0x4d, 0xfc, 0x94, 0x91, 0x63, 0xeb, 0xac, 0x7d, 0xff,
+	0x5c, 0x64, 0x6a, 0x19, 0xee, 0x72, 0xe4, 0x30, 0x4e, 0xc3, 0x82, 0xf3, 0x31, 0x63, 0x8b, 0xf1,
+	0x6e, 0x6c, 0x33, 0x50, 0x38, 0x69, 0xc3, 0xca, 0x12, 0x08, 0xf4, 0x1e, 0xe4, 0x22, 0x65, 0x2c,
+	0x45, 0xf9, 0x6c, 0x4f, 0x19, 0x99, 0x50, 0x15, 0x1b, 0x5d, 0xe8, 0x19, 0xac, 0x8f, 0x1c, 0x51,
+	0xa6, 0x97, 0xba, 0xa8, 0xfb, 0xd6, 0xa2, 0xce, 0x20, 0x52, 0xeb, 0x2b, 0xd8, 0x94, 0x17, 0x68,
+	0x93, 0xb9, 0xc7, 0x36, 0xe5, 0x1d, 0x61, 0x7d, 0xe6, 0xdc, 0x7a, 0x26, 0xa0, 0x15, 0x86, 0x14,
+	0x6d, 0xaa, 0x72, 0x87, 0xb9, 0x70, 0x5a, 0xd5, 0xc7, 0xc2, 0xab, 0xc8, 0x9d, 0x62, 0x7c, 0xb2,
+	0xf1, 0x6a, 0xfa, 0x52, 0x54, 0xf8, 0xb7, 0x02, 0xb9, 0xf1, 0x1b, 0xc8, 0xff, 0xab, 0x01, 0x4e,
+	0x75, 0xac, 0xd8, 0xff, 0xd6, 0xb1, 0xe2, 0x8b, 0x77, 0xac, 0xc4, 0x9c, 0x8e, 0x55, 0xb8, 0x02,
+	0x34, 0xed, 0x52, 0xb4, 0x0b, 0x19, 0x37, 0x68, 0x9b, 0x74, 0xd0, 0xc5, 0x66, 0xcf, 0xef, 0x88,
+	0xd9, 0x64, 0x80, 0x1b, 0xb4, 0x5b, 0x83, 0x2e, 0x3e, 0xf5, 0x3b, 0xe8, 0x67, 0xc3, 0xbb, 0x5c,
+	0x6c, 0x81, 0xbb, 0xdc, 0xd8, 0xbb, 0x97, 0xbc, 0xd6, 0x7d, 0x09, 0xa9, 0x47, 0xa4, 0x8f, 0x7d,
+	0x8f, 0xf8, 0xe8, 0x21, 0xac, 0x58, 0xa2, 0x6f, 0x68, 0xca, 0x9c, 0x0b, 0x4e, 0x08, 0x1c, 0xbb,
+	0xa5, 0xc4, 0xc6, 0x6f, 0x29, 0xec, 0xd5, 0x13, 0x85, 0xc2, 0xab, 0xb8, 0x83, 0xdb, 0xdc, 0xad,
+	0x48, 0x87, 0x75, 0x5b, 0x3c, 0x11, 0xdf, 0x5c, 0x54, 0xa1, 0x3a, 0xa4, 0xc8, 0x75, 0x74, 0x0c,
+	0x6a, 0x5b, 0x0a, 0x1f, 0x4a, 0x99, 0x77, 0x2f, 0x5b, 0x0b, 0x19, 0x72, 0xf9, 0xfe, 0xaf, 0x15,
+	0x80, 0x91, 0xf7, 0xf0, 0x77, 0x61, 0xeb, 0xac, 0xde, 0xd2, 0xcd, 0x7a, 0xa3, 0x55, 0xab, 0x9f,
+	0x98, 0xa7, 0x27, 0xcd, 0x86, 0x7e, 0x5c, 0xfb, 0xac, 0xa6, 0x57, 0xd5, 0x25, 0xb4, 0x01, 0x6b,
+	0xa3, 0x9b, 0x5f, 0xe8, 0x4d, 0x55, 0x41, 0x5b, 0xb0, 0x31, 0xba, 0x58, 0xae, 0x34, 0x5b, 0xe5,
+	0xda, 0x89, 0x1a, 0x43, 0x08, 0x72, 0xa3, 0x1b, 0x27, 0x75, 0x35, 0x8e, 0xee, 0x80, 0x36, 0xbe,
+	0x66, 0x3e, 0xaf, 0xb5, 0x1e, 0x9b, 0x67, 0x7a, 0xab, 0xae, 0x26, 0xee, 0xff, 0x79, 0x24, 0xf5,
+	0xc5, 0xbb, 0x29, 0xda, 0x81, 0x77, 0x1b, 0x46, 0xbd, 0x51, 0x6f, 0x96, 0x9f, 0x9a, 0xcd, 0x56,
+	0xb9, 0x75, 0xda, 0x9c, 0xb0, 0xa9, 0x00, 0xf9, 0x49, 0x40, 0x55, 0x6f, 0xd4, 0x9b, 0xb5, 0x96,
+	0xd9, 0xd0, 0x8d, 0x5a, 0xbd, 0xaa, 0x2a, 0xe8, 0x1e, 0xdc, 0x9d, 0xc4, 0x9c, 0xd5, 0x5b, 0xb5,
+	0x93, 0x47, 0x21, 0x24, 0x86, 0xb6, 0xe1, 0x9d, 0x49, 0x48, 0xa3, 0xdc, 0x6c, 0xea, 0x55, 0x61,
+	0xf4, 0xe4, 0x9e, 0xa1, 0x3f, 0xd1, 0x8f, 0x5b, 0x7a, 0x55, 0x4d, 0xcc, 0x62, 0x7e, 0x56, 0xae,
+	0x3d, 0xd5, 0xab, 0xea, 0x72, 0x45, 0xff, 0xf6, 0x4d, 0x5e, 0xf9, 0xee, 0x4d, 0x5e, 0xf9, 0xe7,
+	0x9b, 0xbc, 0xf2, 0xcd, 0xdb, 0xfc, 0xd2, 0x77, 0x6f, 0xf3, 0x4b, 0x7f, 0x7d, 0x9b, 0x5f, 0xfa,
+	0xf2, 0x41, 0xdb, 0xa1, 0x97, 0xbd, 0xf3, 0xe2, 0x05, 0x71, 0xe5, 0x17, 0x93, 0x52, 0xd4, 0x08,
+	0x4a, 0x57, 0xfc, 0x2b, 0x10, 0x4b, 0xfb, 0x80, 0x7d, 0xe2, 0x49, 0xf2, 0xca, 0xfc, 0xe8, 0xbf,
+	0x03, 0x00, 0x93, 0xf3, 0x3b, 0x37, 0x23, 0x12, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1143,6 +1425,20 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if len(m.FailedReason) > 0 {
 		i -= len(m.FailedReason)
 		copy(dAtA[i:], m.FailedReason)
@@ -1189,22 +1485,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1223,22 +1519,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1409,12 +1705,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1456,12 +1752,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1532,6 +1828,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageBasedParams) > 0 {
+		for iNdEx := len(m.MessageBasedParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageBasedParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	if len(m.MinDepositRatio) > 0 {
 		i -= len(m.MinDepositRatio)
 		copy(dAtA[i:], m.MinDepositRatio)
@@ -1593,12 +1905,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -1645,22 +1957,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1681,21 +1993,196 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ProposalParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WeightedVoteOption) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ProposalParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VotingPeriod != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageBasedParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Governor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Governor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Governor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernorDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernorDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernorDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Option != 0 {
@@ -1797,6 +2284,10 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1975,6 +2466,88 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	if len(m.MessageBasedParams) > 0 {
+		for _, e := range m.MessageBasedParams {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageBasedParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *Governor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernorDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2715,6 +3288,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.FailedReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &ProposalParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3954,6 +4563,567 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageBasedParams = append(m.MessageBasedParams, MessageBasedParams{})
+			if err := m.MessageBasedParams[len(m.MessageBasedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageBasedParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageBasedParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Governor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Governor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Governor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernorDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernorDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernorDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ import (
 
 var (
 	_, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}
+	_, _, _             sdk.Msg                            = &MsgCreateGovernor{}, &MsgDelegateGovernor{}, &MsgUndelegateGovernor{}
 	_, _                codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
@@ -106,3 +107,26 @@ func NewMsgCancelProposal(proposalID uint64, proposer string) *MsgCancelProposal
 		Proposer:   proposer,
 	}
 }
+
+// NewMsgCreateGovernor creates a new MsgCreateGovernor instance.
+func NewMsgCreateGovernor(address, metadata string) *MsgCreateGovernor {
+	return &MsgCreateGovernor{
+		Address:  address,
+		Metadata: metadata,
+	}
+}
+
+// NewMsgDelegateGovernor creates a new MsgDelegateGovernor instance.
+func NewMsgDelegateGovernor(delegator, governor string) *MsgDelegateGovernor {
+	return &MsgDelegateGovernor{
+		DelegatorAddress: delegator,
+		GovernorAddress:  governor,
+	}
+}
+
+// NewMsgUndelegateGovernor creates a new MsgUndelegateGovernor instance.
+func NewMsgUndelegateGovernor(delegator string) *MsgUndelegateGovernor {
+	return &MsgUndelegateGovernor{
+		DelegatorAddress: delegator,
+	}
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -219,5 +220,146 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	msgTypeURLs := make(map[string]struct{}, len(p.MessageBasedParams))
+	for _, mp := range p.MessageBasedParams {
+		if mp.MsgTypeUrl == "" {
+			return fmt.Errorf("message based params type URL cannot be empty")
+		}
+		if _, ok := msgTypeURLs[mp.MsgTypeUrl]; ok {
+			return fmt.Errorf("duplicate message based params for %s", mp.MsgTypeUrl)
+		}
+		msgTypeURLs[mp.MsgTypeUrl] = struct{}{}
+
+		if err := mp.Params.validate(minDeposit); err != nil {
+			return fmt.Errorf("invalid message based params for %s: %w", mp.MsgTypeUrl, err)
+		}
+	}
+
 	return nil
 }
+
+// ProposalParamsForMsgs resolves the params of a proposal from the type URLs of
+// its messages. The params of a message are the strictest of the message based
+// params matching its type URL, or the default params if none matches, and
+// the strictest params of all the messages apply to the proposal. It returns
+// nil if no message has message based params, the default params apply then.
+func (p Params) ProposalParamsForMsgs(msgTypeURLs []string) *ProposalParams {
+	defaultParams := ProposalParams{
+		MinDeposit:   p.MinDeposit,
+		VotingPeriod: p.VotingPeriod,
+		Quorum:       p.Quorum,
+		Threshold:    p.Threshold,
+	}
+
+	var resolved *ProposalParams
+	matched := false
+	for _, msgTypeURL := range msgTypeURLs {
+		var msgParams *ProposalParams
+		for _, mp := range p.MessageBasedParams {
+			if mp.Matches(msgTypeURL) {
+				msgParams = strictestProposalParams(msgParams, mp.Params)
+			}
+		}
+
+		if msgParams == nil {
+			msgParams = &defaultParams
+		} else {
+			matched = true
+		}
+		resolved = strictestProposalParams(resolved, *msgParams)
+	}
+
+	if !matched {
+		return nil
+	}
+	return resolved
+}
+
+// Matches returns true if the message based params apply to the messages of
+// the given type URL.
+func (mp MessageBasedParams) Matches(msgTypeURL string) bool {
+	if prefix, ok := strings.CutSuffix(mp.MsgTypeUrl, "*"); ok {
+		return strings.HasPrefix(msgTypeURL, prefix)
+	}
+	return mp.MsgTypeUrl == msgTypeURL
+}
+
+// validate validates the params of a proposal, the minimum deposit must only
+// contain the denoms of the given default minimum deposit.
+func (pp ProposalParams) validate(defaultMinDeposit sdk.Coins) error {
+	minDeposit := sdk.Coins(pp.MinDeposit)
+	if minDeposit.Empty() || !minDeposit.IsValid() {
+		return fmt.Errorf("invalid minimum deposit: %s", minDeposit)
+	}
+	for _, coin := range minDeposit {
+		if defaultMinDeposit.AmountOf(coin.Denom).IsZero() {
+			return fmt.Errorf("minimum deposit denom %s is not accepted, accepted denoms are the ones of %s", coin.Denom, defaultMinDeposit)
+		}
+	}
+
+	if pp.VotingPeriod == nil {
+		return fmt.Errorf("voting period must not be nil")
+	}
+	if pp.VotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("voting period must be positive: %s", pp.VotingPeriod)
+	}
+
+	quorum, err := sdkmath.LegacyNewDecFromStr(pp.Quorum)
+	if err != nil {
+		return fmt.Errorf("invalid quorum string: %w", err)
+	}
+	if quorum.IsNegative() {
+		return fmt.Errorf("quorom cannot be negative: %s", quorum)
+	}
+	if quorum.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("quorom too large: %s", pp.Quorum)
+	}
+
+	threshold, err := sdkmath.LegacyNewDecFromStr(pp.Threshold)
+	if err != nil {
+		return fmt.Errorf("invalid threshold string: %w", err)
+	}
+	if !threshold.IsPositive() {
+		return fmt.Errorf("vote threshold must be positive: %s", threshold)
+	}
+	if threshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("vote threshold too large: %s", threshold)
+	}
+
+	return nil
+}
+
+// strictestProposalParams returns the params with the highest minimum deposit,
+// longest voting period, highest quorum and highest threshold of the given
+// params. The first params may be nil.
+func strictestProposalParams(a *ProposalParams, b ProposalParams) *ProposalParams {
+	votingPeriod := *b.VotingPeriod
+	strictest := ProposalParams{
+		MinDeposit:   sdk.NewCoins(b.MinDeposit...),
+		VotingPeriod: &votingPeriod,
+		Quorum:       b.Quorum,
+		Threshold:    b.Threshold,
+	}
+	if a == nil {
+		return &strictest
+	}
+
+	strictest.MinDeposit = sdk.Coins(a.MinDeposit).Max(strictest.MinDeposit)
+	if *a.VotingPeriod > votingPeriod {
+		*strictest.VotingPeriod = *a.VotingPeriod
+	}
+	strictest.Quorum = maxDecString(a.Quorum, b.Quorum)
+	strictest.Threshold = maxDecString(a.Threshold, b.Threshold)
+
+	return &strictest
+}
+
+// maxDecString returns the greatest of two decimal strings.
+func maxDecString(a, b string) string {
+	decA, _ := sdkmath.LegacyNewDecFromStr(a)
+	decB, _ := sdkmath.LegacyNewDecFromStr(b)
+	if decA.GT(decB) {
+		return a
+	}
+	return b
+}
@@ -55,12 +55,72 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 
 // GetMinDepositFromParams returns min expedited deposit from the gov params if
 // the proposal is expedited. Otherwise, returns the regular min deposit from
-// gov params.
+// gov params. The params resolved from the messages of the proposal take
+// precedence over the regular ones, see MinDepositFromParams.
 func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
-	if p.Expedited {
+	return MinDepositFromParams(params, p.Params, p.Expedited)
+}
+
+// MinDepositFromParams returns the min deposit of a proposal with the given
+// params resolved from its messages. An expedited proposal needs the highest
+// of the min expedited deposit and of its resolved min deposit.
+func MinDepositFromParams(params Params, proposalParams *ProposalParams, expedited bool) sdk.Coins {
+	switch {
+	case proposalParams == nil && expedited:
 		return params.ExpeditedMinDeposit
+	case proposalParams == nil:
+		return params.MinDeposit
+	case expedited:
+		return sdk.Coins(params.ExpeditedMinDeposit).Max(proposalParams.MinDeposit)
+	default:
+		return proposalParams.MinDeposit
+	}
+}
+
+// GetVotingPeriodFromParams returns the expedited voting period from the gov
+// params if the proposal is expedited, raised to its resolved voting period if
+// longer. Otherwise, returns the voting period resolved from the messages of the
+// proposal, or the regular one from the gov params.
+func (p Proposal) GetVotingPeriodFromParams(params Params) *time.Duration {
+	switch {
+	case p.Expedited && p.Params != nil:
+		if *p.Params.VotingPeriod > *params.ExpeditedVotingPeriod {
+			return p.Params.VotingPeriod
+		}
+		return params.ExpeditedVotingPeriod
+	case p.Expedited:
+		return params.ExpeditedVotingPeriod
+	case p.Params != nil:
+		return p.Params.VotingPeriod
+	default:
+		return params.VotingPeriod
+	}
+}
+
+// GetQuorumFromParams returns the quorum resolved from the messages of the
+// proposal, or the quorum from the gov params.
+func (p Proposal) GetQuorumFromParams(params Params) string {
+	if p.Params != nil {
+		return p.Params.Quorum
+	}
+	return params.Quorum
+}
+
+// GetThresholdFromParams returns the expedited threshold from the gov params if
+// the proposal is expedited, raised to its resolved threshold if higher.
+// Otherwise, returns the threshold resolved from the messages of the proposal,
+// or the regular one from the gov params.
+func (p Proposal) GetThresholdFromParams(params Params) string {
+	switch {
+	case p.Expedited && p.Params != nil:
+		return maxDecString(params.ExpeditedThreshold, p.Params.Threshold)
+	case p.Expedited:
+		return params.ExpeditedThreshold
+	case p.Params != nil:
+		return p.Params.Threshold
+	default:
+		return params.Threshold
 	}
-	return params.MinDeposit
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
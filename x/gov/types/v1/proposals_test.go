@@ -81,3 +81,68 @@ func TestProposalGetMinDepositFromParams(t *testing.T) {
 		require.Equal(t, tc.expectedMinDeposit, actualMinDeposit[0].Amount)
 	}
 }
+
+func TestProposalParamsForMsgs(t *testing.T) {
+	params := v1.DefaultParams()
+	longPeriod := 2 * *params.VotingPeriod
+	params.MessageBasedParams = []v1.MessageBasedParams{
+		{
+			MsgTypeUrl: "/cosmos.bank.v1beta1.*",
+			Params: v1.ProposalParams{
+				MinDeposit:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinDepositTokens.MulRaw(2))),
+				VotingPeriod: &longPeriod,
+				Quorum:       "0.5",
+				Threshold:    "0.4",
+			},
+		},
+		{
+			MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend",
+			Params: v1.ProposalParams{
+				MinDeposit:   params.MinDeposit,
+				VotingPeriod: params.VotingPeriod,
+				Quorum:       params.Quorum,
+				Threshold:    "0.6",
+			},
+		},
+	}
+	require.NoError(t, params.ValidateBasic())
+
+	// no message based params apply
+	require.Nil(t, params.ProposalParamsForMsgs(nil))
+	require.Nil(t, params.ProposalParamsForMsgs([]string{"/cosmos.staking.v1beta1.MsgDelegate"}))
+
+	// the strictest matching message based params apply to a message
+	pp := params.ProposalParamsForMsgs([]string{"/cosmos.bank.v1beta1.MsgSend"})
+	require.NotNil(t, pp)
+	require.Equal(t, sdk.Coins(params.MessageBasedParams[0].Params.MinDeposit), sdk.Coins(pp.MinDeposit))
+	require.Equal(t, longPeriod, *pp.VotingPeriod)
+	require.Equal(t, "0.5", pp.Quorum)
+	require.Equal(t, "0.6", pp.Threshold)
+
+	// the default params of other messages are accounted for
+	pp = params.ProposalParamsForMsgs([]string{"/cosmos.bank.v1beta1.MsgMultiSend", "/cosmos.staking.v1beta1.MsgDelegate"})
+	require.NotNil(t, pp)
+	require.Equal(t, longPeriod, *pp.VotingPeriod)
+	require.Equal(t, "0.5", pp.Quorum)
+	require.Equal(t, params.Threshold, pp.Threshold)
+
+	// the proposal getters fall back to the default params
+	proposal, err := v1.NewProposal([]sdk.Msg{}, 1, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
+	require.NoError(t, err)
+	require.Equal(t, *params.VotingPeriod, *proposal.GetVotingPeriodFromParams(params))
+	require.Equal(t, params.Quorum, proposal.GetQuorumFromParams(params))
+
+	proposal.Params = pp
+	require.Equal(t, longPeriod, *proposal.GetVotingPeriodFromParams(params))
+	require.Equal(t, "0.5", proposal.GetQuorumFromParams(params))
+	require.Equal(t, sdk.Coins(pp.MinDeposit), proposal.GetMinDepositFromParams(params))
+
+	// an expedited proposal votes for the longest of the expedited voting
+	// period and the resolved one
+	proposal.Expedited = true
+	require.Equal(t, longPeriod, *proposal.GetVotingPeriodFromParams(params))
+
+	shortPeriod := *params.ExpeditedVotingPeriod - time.Second
+	proposal.Params = &v1.ProposalParams{MinDeposit: pp.MinDeposit, VotingPeriod: &shortPeriod, Quorum: pp.Quorum, Threshold: pp.Threshold}
+	require.Equal(t, *params.ExpeditedVotingPeriod, *proposal.GetVotingPeriodFromParams(params))
+}
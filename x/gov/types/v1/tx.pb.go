@@ -814,6 +814,275 @@ func (m *MsgCancelProposalResponse) GetCanceledHeight() uint64 {
 	return 0
 }
 
+// MsgCreateGovernor defines a message to register an account as a governor.
+type MsgCreateGovernor struct {
+	// address is the account address of the governor.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// metadata is any arbitrary metadata attached to the governor.
+	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *MsgCreateGovernor) Reset()         { *m = MsgCreateGovernor{} }
+func (m *MsgCreateGovernor) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGovernor) ProtoMessage()    {}
+func (*MsgCreateGovernor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{14}
+}
+func (m *MsgCreateGovernor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGovernor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGovernor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGovernor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGovernor.Merge(m, src)
+}
+func (m *MsgCreateGovernor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGovernor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGovernor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGovernor proto.InternalMessageInfo
+
+func (m *MsgCreateGovernor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgCreateGovernor) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+// MsgCreateGovernorResponse defines the Msg/CreateGovernor response type.
+type MsgCreateGovernorResponse struct {
+}
+
+func (m *MsgCreateGovernorResponse) Reset()         { *m = MsgCreateGovernorResponse{} }
+func (m *MsgCreateGovernorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGovernorResponse) ProtoMessage()    {}
+func (*MsgCreateGovernorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{15}
+}
+func (m *MsgCreateGovernorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGovernorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGovernorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGovernorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGovernorResponse.Merge(m, src)
+}
+func (m *MsgCreateGovernorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGovernorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGovernorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGovernorResponse proto.InternalMessageInfo
+
+// MsgDelegateGovernor defines a message to assign the voting power of a
+// delegator to a governor, replacing any previous assignment.
+type MsgDelegateGovernor struct {
+	// delegator_address is the account address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address is the account address of the governor.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+}
+
+func (m *MsgDelegateGovernor) Reset()         { *m = MsgDelegateGovernor{} }
+func (m *MsgDelegateGovernor) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateGovernor) ProtoMessage()    {}
+func (*MsgDelegateGovernor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{16}
+}
+func (m *MsgDelegateGovernor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateGovernor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateGovernor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateGovernor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateGovernor.Merge(m, src)
+}
+func (m *MsgDelegateGovernor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateGovernor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateGovernor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateGovernor proto.InternalMessageInfo
+
+func (m *MsgDelegateGovernor) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgDelegateGovernor) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
+// MsgDelegateGovernorResponse defines the Msg/DelegateGovernor response type.
+type MsgDelegateGovernorResponse struct {
+}
+
+func (m *MsgDelegateGovernorResponse) Reset()         { *m = MsgDelegateGovernorResponse{} }
+func (m *MsgDelegateGovernorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateGovernorResponse) ProtoMessage()    {}
+func (*MsgDelegateGovernorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{17}
+}
+func (m *MsgDelegateGovernorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateGovernorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateGovernorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateGovernorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateGovernorResponse.Merge(m, src)
+}
+func (m *MsgDelegateGovernorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateGovernorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateGovernorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateGovernorResponse proto.InternalMessageInfo
+
+// MsgUndelegateGovernor defines a message to withdraw the voting power of a
+// delegator from its governor.
+type MsgUndelegateGovernor struct {
+	// delegator_address is the account address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *MsgUndelegateGovernor) Reset()         { *m = MsgUndelegateGovernor{} }
+func (m *MsgUndelegateGovernor) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateGovernor) ProtoMessage()    {}
+func (*MsgUndelegateGovernor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{18}
+}
+func (m *MsgUndelegateGovernor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateGovernor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateGovernor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateGovernor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateGovernor.Merge(m, src)
+}
+func (m *MsgUndelegateGovernor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateGovernor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateGovernor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateGovernor proto.InternalMessageInfo
+
+func (m *MsgUndelegateGovernor) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// MsgUndelegateGovernorResponse defines the Msg/UndelegateGovernor response type.
+type MsgUndelegateGovernorResponse struct {
+}
+
+func (m *MsgUndelegateGovernorResponse) Reset()         { *m = MsgUndelegateGovernorResponse{} }
+func (m *MsgUndelegateGovernorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateGovernorResponse) ProtoMessage()    {}
+func (*MsgUndelegateGovernorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{19}
+}
+func (m *MsgUndelegateGovernorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateGovernorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateGovernorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateGovernorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateGovernorResponse.Merge(m, src)
+}
+func (m *MsgUndelegateGovernorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateGovernorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateGovernorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateGovernorResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -829,84 +1098,101 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgCancelProposal)(nil), "cosmos.gov.v1.MsgCancelProposal")
 	proto.RegisterType((*MsgCancelProposalResponse)(nil), "cosmos.gov.v1.MsgCancelProposalResponse")
+	proto.RegisterType((*MsgCreateGovernor)(nil), "cosmos.gov.v1.MsgCreateGovernor")
+	proto.RegisterType((*MsgCreateGovernorResponse)(nil), "cosmos.gov.v1.MsgCreateGovernorResponse")
+	proto.RegisterType((*MsgDelegateGovernor)(nil), "cosmos.gov.v1.MsgDelegateGovernor")
+	proto.RegisterType((*MsgDelegateGovernorResponse)(nil), "cosmos.gov.v1.MsgDelegateGovernorResponse")
+	proto.RegisterType((*MsgUndelegateGovernor)(nil), "cosmos.gov.v1.MsgUndelegateGovernor")
+	proto.RegisterType((*MsgUndelegateGovernorResponse)(nil), "cosmos.gov.v1.MsgUndelegateGovernorResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1140 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcb, 0x6f, 0xdc, 0x44,
-	0x18, 0x8f, 0xf3, 0xda, 0x64, 0x92, 0x26, 0xca, 0x64, 0xdb, 0x3a, 0x56, 0xd9, 0xdd, 0xba, 0x08,
-	0x96, 0x84, 0xd8, 0xd9, 0xd0, 0xb4, 0x68, 0xe9, 0xa5, 0x1b, 0x8a, 0x88, 0x44, 0xa0, 0x72, 0x78,
-	0x48, 0x08, 0x29, 0x72, 0xd6, 0x83, 0x63, 0x75, 0xed, 0x31, 0x3b, 0xb3, 0xab, 0x2c, 0x27, 0xc4,
-	0xb1, 0xa7, 0x9e, 0x91, 0xb8, 0x23, 0x4e, 0x39, 0xe4, 0xc6, 0x3f, 0x50, 0xf5, 0x80, 0xaa, 0x1c,
-	0x10, 0xe2, 0xd0, 0xa2, 0x04, 0x88, 0xc4, 0xbf, 0xc0, 0x01, 0x34, 0x0f, 0x7b, 0xd7, 0x8f, 0x6c,
-	0x0a, 0x07, 0x2e, 0x89, 0xfd, 0xcd, 0xef, 0x7b, 0xfc, 0x7e, 0xf3, 0xcd, 0x37, 0x5e, 0x70, 0xa5,
-	0x89, 0x89, 0x8f, 0x89, 0xe9, 0xe2, 0xae, 0xd9, 0xad, 0x99, 0xf4, 0xc0, 0x08, 0xdb, 0x98, 0x62,
-	0x78, 0x49, 0xd8, 0x0d, 0x17, 0x77, 0x8d, 0x6e, 0x4d, 0x2b, 0x49, 0xd8, 0x9e, 0x4d, 0x90, 0xd9,
-	0xad, 0xed, 0x21, 0x6a, 0xd7, 0xcc, 0x26, 0xf6, 0x02, 0x01, 0xd7, 0xae, 0x26, 0xc3, 0x30, 0x2f,
-	0xb1, 0x50, 0x74, 0xb1, 0x8b, 0xf9, 0xa3, 0xc9, 0x9e, 0xa4, 0x75, 0x49, 0xc0, 0x77, 0xc5, 0x82,
-	0x4c, 0x25, 0x97, 0x5c, 0x8c, 0xdd, 0x16, 0x32, 0xf9, 0xdb, 0x5e, 0xe7, 0x73, 0xd3, 0x0e, 0x7a,
-	0xa9, 0x24, 0x3e, 0x71, 0x59, 0x12, 0x9f, 0xb8, 0x72, 0x61, 0xc1, 0xf6, 0xbd, 0x00, 0x9b, 0xfc,
-	0xaf, 0x34, 0x95, 0xd3, 0x61, 0xa8, 0xe7, 0x23, 0x42, 0x6d, 0x3f, 0x14, 0x00, 0xfd, 0xb7, 0x31,
-	0xb0, 0xb0, 0x4d, 0xdc, 0x9d, 0xce, 0x9e, 0xef, 0xd1, 0xfb, 0x6d, 0x1c, 0x62, 0x62, 0xb7, 0xe0,
-	0x1a, 0x98, 0xf2, 0x11, 0x21, 0xb6, 0x8b, 0x88, 0xaa, 0x54, 0xc6, 0xaa, 0x33, 0xeb, 0x45, 0x43,
-	0x44, 0x32, 0xa2, 0x48, 0xc6, 0xdd, 0xa0, 0x67, 0xc5, 0x28, 0xf8, 0x50, 0x01, 0xf3, 0x5e, 0xe0,
-	0x51, 0xcf, 0x6e, 0xed, 0x3a, 0x28, 0xc4, 0xc4, 0xa3, 0xea, 0x28, 0xf7, 0x5c, 0x32, 0x24, 0x31,
-	0x26, 0x9a, 0x21, 0x45, 0x33, 0x36, 0xb1, 0x17, 0x34, 0xde, 0x79, 0xfc, 0xac, 0x3c, 0xf2, 0xfd,
-	0xf3, 0x72, 0xd5, 0xf5, 0xe8, 0x7e, 0x67, 0xcf, 0x68, 0x62, 0x5f, 0xaa, 0x20, 0xff, 0xad, 0x12,
-	0xe7, 0x81, 0x49, 0x7b, 0x21, 0x22, 0xdc, 0x81, 0x7c, 0x73, 0x76, 0xb8, 0x3c, 0xdb, 0x42, 0xae,
-	0xdd, 0xec, 0xed, 0x32, 0xd9, 0xc9, 0x77, 0x67, 0x87, 0xcb, 0x8a, 0x35, 0x27, 0x33, 0xbf, 0x2d,
-	0x12, 0xc3, 0x9b, 0x60, 0x2a, 0xe4, 0x54, 0x50, 0x5b, 0x1d, 0xab, 0x28, 0xd5, 0xe9, 0x86, 0x7a,
-	0x7c, 0xb4, 0x5a, 0x94, 0x75, 0xdc, 0x75, 0x9c, 0x36, 0x22, 0x64, 0x87, 0xb6, 0xbd, 0xc0, 0xb5,
-	0x62, 0x24, 0xd4, 0x18, 0x69, 0x6a, 0x3b, 0x36, 0xb5, 0xd5, 0x71, 0xe6, 0x65, 0xc5, 0xef, 0xf0,
-	0x35, 0x30, 0x41, 0x3d, 0xda, 0x42, 0xea, 0x04, 0x0f, 0xb7, 0xf8, 0xcb, 0xd1, 0xea, 0x7c, 0xbf,
-	0xc4, 0xca, 0x9a, 0x71, 0xf3, 0xb6, 0x25, 0x10, 0x70, 0x15, 0x14, 0x48, 0xc7, 0xf7, 0xed, 0x76,
-	0x4f, 0x9d, 0x3c, 0x1f, 0x1c, 0x61, 0x60, 0x0d, 0x4c, 0xa3, 0x83, 0x10, 0x39, 0x1e, 0x45, 0x8e,
-	0x5a, 0xa8, 0x28, 0xd5, 0xa9, 0x1c, 0x87, 0x8d, 0x35, 0xab, 0x8f, 0xaa, 0xd7, 0xbe, 0x3e, 0x3b,
-	0x5c, 0x8e, 0xeb, 0x7e, 0x78, 0x76, 0xb8, 0x5c, 0x1e, 0x90, 0xab, 0x5b, 0x33, 0x33, 0x1b, 0xaa,
-	0xdf, 0x01, 0x4b, 0x19, 0xa3, 0x85, 0x48, 0x88, 0x03, 0x82, 0x60, 0x19, 0xcc, 0x84, 0xd2, 0xb6,
-	0xeb, 0x39, 0xaa, 0x52, 0x51, 0xaa, 0xe3, 0x16, 0x88, 0x4c, 0x5b, 0x8e, 0xfe, 0x83, 0x02, 0x8a,
-	0xdb, 0xc4, 0xbd, 0x77, 0x80, 0x9a, 0xef, 0x71, 0xf1, 0x37, 0x71, 0x40, 0x51, 0x40, 0xe1, 0xfb,
-	0xa0, 0xd0, 0x14, 0x8f, 0xdc, 0xeb, 0x9c, 0x36, 0x69, 0x94, 0x9e, 0x1c, 0xad, 0x6a, 0x89, 0x93,
-	0x14, 0x35, 0x01, 0xf7, 0xb5, 0xa2, 0x20, 0xf0, 0x1a, 0x98, 0xb6, 0x3b, 0x74, 0x1f, 0xb7, 0x3d,
-	0xda, 0x53, 0x47, 0xf9, 0x1e, 0xf4, 0x0d, 0xf5, 0x0d, 0xc6, 0xbb, 0xff, 0xce, 0x88, 0xeb, 0x19,
-	0xe2, 0x99, 0x22, 0xf5, 0x12, 0xb8, 0x96, 0x67, 0x8f, 0xe8, 0xeb, 0xbf, 0x2b, 0xa0, 0xb0, 0x4d,
-	0xdc, 0x8f, 0x31, 0x45, 0x70, 0x23, 0x47, 0x8a, 0x46, 0xf1, 0xcf, 0x67, 0xe5, 0x41, 0xb3, 0x68,
-	0xba, 0x01, 0x81, 0xa0, 0x01, 0x26, 0xba, 0x98, 0xa2, 0xb6, 0xa8, 0x79, 0x48, 0xb7, 0x09, 0x18,
-	0xac, 0x81, 0x49, 0x1c, 0x52, 0x0f, 0x07, 0xbc, 0x3d, 0xe7, 0xfa, 0x67, 0x44, 0xa8, 0x63, 0xb0,
-	0x5a, 0x3e, 0xe0, 0x00, 0x4b, 0x02, 0x87, 0x75, 0x67, 0xfd, 0x65, 0x26, 0x8c, 0x08, 0xcd, 0x44,
-	0xb9, 0x9c, 0x11, 0x85, 0xc5, 0xd3, 0x17, 0xc0, 0xbc, 0x7c, 0x8c, 0xa9, 0xff, 0xad, 0xc4, 0xb6,
-	0x4f, 0x90, 0xe7, 0xee, 0x53, 0xe4, 0xfc, 0x5f, 0x12, 0xbc, 0x05, 0x0a, 0x82, 0x19, 0x51, 0xc7,
-	0xf8, 0x9c, 0xb8, 0x9e, 0xd2, 0x20, 0x2a, 0x68, 0x40, 0x8b, 0xc8, 0x63, 0xa8, 0x18, 0xaf, 0x27,
-	0xc5, 0x78, 0x29, 0x57, 0x8c, 0x28, 0xb8, 0xbe, 0x04, 0xae, 0xa6, 0x4c, 0xb1, 0x38, 0x7f, 0x28,
-	0x00, 0x6c, 0x13, 0x37, 0x1a, 0x2a, 0xff, 0x51, 0x97, 0x5b, 0x60, 0x5a, 0xce, 0x43, 0x7c, 0xb1,
-	0x36, 0x7d, 0x28, 0xbc, 0x03, 0x26, 0x6d, 0x1f, 0x77, 0x02, 0x2a, 0xe5, 0x19, 0x32, 0x46, 0xa7,
-	0xd9, 0x18, 0x15, 0x99, 0xa5, 0x4f, 0x7d, 0x85, 0x1f, 0x95, 0x38, 0x1a, 0x13, 0x42, 0xcd, 0x08,
-	0x21, 0x99, 0xe9, 0x45, 0x00, 0xfb, 0x6f, 0x31, 0xfd, 0x1f, 0x45, 0x6f, 0x7c, 0x14, 0x3a, 0x36,
-	0x45, 0xf7, 0xed, 0xb6, 0xed, 0x13, 0x46, 0xa6, 0x7f, 0x3e, 0x95, 0x8b, 0xc8, 0xc4, 0x50, 0xf8,
-	0x26, 0x98, 0x0c, 0x79, 0x04, 0xae, 0xc0, 0xcc, 0xfa, 0xe5, 0xd4, 0x5e, 0x8b, 0xf0, 0x09, 0x22,
-	0x02, 0x5f, 0xdf, 0x3a, 0xce, 0x0e, 0xcf, 0xec, 0x18, 0xb8, 0x31, 0xc0, 0xed, 0x20, 0xba, 0x7d,
-	0x53, 0xc5, 0xeb, 0x06, 0xdf, 0xea, 0x41, 0x53, 0xc4, 0xb5, 0xbe, 0x98, 0x93, 0x45, 0xff, 0x56,
-	0xe1, 0x57, 0xe3, 0xa6, 0x1d, 0x34, 0x51, 0x6b, 0xe0, 0x6a, 0xcc, 0x69, 0x83, 0xf9, 0x54, 0x1b,
-	0x24, 0x3a, 0x60, 0xf0, 0x36, 0x1a, 0x7d, 0xd1, 0xdb, 0xa8, 0x5e, 0x39, 0xce, 0x5e, 0x02, 0x89,
-	0xb9, 0xaf, 0xff, 0xa4, 0xf0, 0xa1, 0x9e, 0xac, 0x2f, 0x1e, 0xea, 0xff, 0xbe, 0xce, 0x2d, 0x70,
-	0xa9, 0xc9, 0x63, 0x21, 0x67, 0x97, 0x7d, 0x26, 0xc8, 0xbd, 0xd2, 0x32, 0x23, 0xfd, 0xc3, 0xe8,
-	0x1b, 0xa2, 0x31, 0xc5, 0x36, 0xec, 0xd1, 0xf3, 0xb2, 0x62, 0xcd, 0x46, 0xae, 0x6c, 0x11, 0xbe,
-	0x0a, 0xe6, 0xe3, 0x50, 0xfb, 0xfc, 0x5c, 0xf1, 0x41, 0x37, 0x6e, 0xcd, 0x45, 0xe6, 0x77, 0xb9,
-	0x35, 0x47, 0xf8, 0x8d, 0xb5, 0xf5, 0xbf, 0x26, 0xc0, 0xd8, 0x36, 0x71, 0xe1, 0x17, 0x60, 0x2e,
-	0xf5, 0x5d, 0x52, 0x49, 0xf5, 0x4d, 0xe6, 0x4e, 0xd3, 0xaa, 0x17, 0x21, 0xe2, 0xfe, 0x5e, 0x7c,
-	0x92, 0xd9, 0xf3, 0x5b, 0xf0, 0x4b, 0xb0, 0x90, 0xbd, 0xe5, 0x6e, 0x64, 0x63, 0x66, 0x40, 0xda,
-	0xca, 0x0b, 0x80, 0x86, 0xe7, 0xde, 0x01, 0xe3, 0xfc, 0x0e, 0xba, 0x92, 0x8d, 0xc4, 0xec, 0x5a,
-	0x29, 0xdf, 0x3e, 0x3c, 0xe8, 0x03, 0x30, 0x9b, 0x98, 0xee, 0xe7, 0x04, 0x89, 0xd6, 0xb5, 0x57,
-	0x86, 0xaf, 0x0f, 0x4f, 0xf6, 0x19, 0x28, 0x44, 0xd3, 0x72, 0x29, 0x1b, 0x47, 0x2e, 0x69, 0xd7,
-	0xcf, 0x5d, 0xba, 0x90, 0x4a, 0x62, 0x18, 0xe5, 0x50, 0x19, 0x5c, 0xcf, 0xa3, 0x92, 0x77, 0xf8,
-	0xf3, 0x92, 0xdd, 0x66, 0xbd, 0x97, 0x3a, 0xf8, 0x39, 0xbd, 0x97, 0x44, 0xe4, 0xf5, 0x5e, 0xfe,
-	0xe1, 0xcc, 0x49, 0xb9, 0xb1, 0xa6, 0x4d, 0x7c, 0xc5, 0x26, 0x5f, 0xe3, 0xde, 0xe3, 0x93, 0x92,
-	0xf2, 0xf4, 0xa4, 0xa4, 0xfc, 0x7a, 0x52, 0x52, 0x1e, 0x9d, 0x96, 0x46, 0x9e, 0x9e, 0x96, 0x46,
-	0x7e, 0x3e, 0x2d, 0x8d, 0x7c, 0xba, 0x32, 0xf4, 0x33, 0x59, 0xcc, 0x3d, 0xfe, 0xb1, 0xcc, 0x7e,
-	0x9a, 0x4c, 0xf2, 0xe3, 0xfa, 0xc6, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x73, 0x98, 0xed, 0x9b,
-	0xda, 0x0c, 0x00, 0x00,
+	// 1322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xf3, 0xb5, 0xc9, 0x6b, 0x9b, 0x34, 0x4e, 0xda, 0x7a, 0xdd, 0x76, 0x77, 0xeb, 0x56,
+	0x65, 0x49, 0x1b, 0x6f, 0x36, 0x34, 0x2d, 0x5a, 0x2a, 0xa4, 0x26, 0x14, 0xa8, 0x44, 0xa0, 0xda,
+	0xf2, 0x21, 0xa1, 0x4a, 0x91, 0xb3, 0x1e, 0x1c, 0xab, 0xb1, 0xc7, 0x78, 0x26, 0xab, 0x2c, 0x27,
+	0xc4, 0xb1, 0xa7, 0x5e, 0xb8, 0x20, 0xc1, 0x15, 0xc4, 0x29, 0x87, 0xde, 0xf8, 0x07, 0xaa, 0x1e,
+	0x50, 0xd5, 0x03, 0x42, 0x1c, 0x5a, 0xd4, 0x00, 0x91, 0xf8, 0x27, 0x40, 0x33, 0x1e, 0x7b, 0xd7,
+	0x1e, 0x67, 0x37, 0x20, 0xc1, 0xa5, 0xb5, 0xdf, 0xfb, 0xbd, 0xaf, 0x9f, 0xdf, 0xbc, 0x37, 0x1b,
+	0x38, 0xd9, 0xc2, 0xc4, 0xc3, 0xa4, 0xe6, 0xe0, 0x76, 0xad, 0x5d, 0xaf, 0xd1, 0x1d, 0x33, 0x08,
+	0x31, 0xc5, 0xea, 0xb1, 0x48, 0x6e, 0x3a, 0xb8, 0x6d, 0xb6, 0xeb, 0x7a, 0x49, 0xc0, 0x36, 0x2c,
+	0x82, 0x6a, 0xed, 0xfa, 0x06, 0xa2, 0x56, 0xbd, 0xd6, 0xc2, 0xae, 0x1f, 0xc1, 0xf5, 0x53, 0x69,
+	0x37, 0xcc, 0x2a, 0x52, 0xcc, 0x39, 0xd8, 0xc1, 0xfc, 0xb1, 0xc6, 0x9e, 0x84, 0xb4, 0x18, 0xc1,
+	0xd7, 0x23, 0x85, 0x08, 0x25, 0x54, 0x0e, 0xc6, 0xce, 0x16, 0xaa, 0xf1, 0xb7, 0x8d, 0xed, 0x4f,
+	0x6a, 0x96, 0xdf, 0xc9, 0x04, 0xf1, 0x88, 0xc3, 0x82, 0x78, 0xc4, 0x11, 0x8a, 0x19, 0xcb, 0x73,
+	0x7d, 0x5c, 0xe3, 0xff, 0x0a, 0x51, 0x39, 0xeb, 0x86, 0xba, 0x1e, 0x22, 0xd4, 0xf2, 0x82, 0x08,
+	0x60, 0xfc, 0x36, 0x02, 0x33, 0x6b, 0xc4, 0xb9, 0xb3, 0xbd, 0xe1, 0xb9, 0xf4, 0x76, 0x88, 0x03,
+	0x4c, 0xac, 0x2d, 0x75, 0x11, 0x26, 0x3c, 0x44, 0x88, 0xe5, 0x20, 0xa2, 0x29, 0x95, 0x91, 0xea,
+	0x91, 0xa5, 0x39, 0x33, 0xf2, 0x64, 0xc6, 0x9e, 0xcc, 0x1b, 0x7e, 0xa7, 0x99, 0xa0, 0xd4, 0xfb,
+	0x0a, 0x4c, 0xbb, 0xbe, 0x4b, 0x5d, 0x6b, 0x6b, 0xdd, 0x46, 0x01, 0x26, 0x2e, 0xd5, 0x86, 0xb9,
+	0x65, 0xd1, 0x14, 0x85, 0x31, 0xd2, 0x4c, 0x41, 0x9a, 0xb9, 0x8a, 0x5d, 0x7f, 0xe5, 0xcd, 0x47,
+	0xcf, 0xca, 0x43, 0xdf, 0x3f, 0x2f, 0x57, 0x1d, 0x97, 0x6e, 0x6e, 0x6f, 0x98, 0x2d, 0xec, 0x09,
+	0x16, 0xc4, 0x7f, 0x0b, 0xc4, 0xbe, 0x57, 0xa3, 0x9d, 0x00, 0x11, 0x6e, 0x40, 0xbe, 0xda, 0xdf,
+	0x9d, 0x3f, 0xba, 0x85, 0x1c, 0xab, 0xd5, 0x59, 0x67, 0xb4, 0x93, 0xef, 0xf6, 0x77, 0xe7, 0x95,
+	0xe6, 0x94, 0x88, 0xfc, 0x46, 0x14, 0x58, 0xbd, 0x02, 0x13, 0x01, 0x2f, 0x05, 0x85, 0xda, 0x48,
+	0x45, 0xa9, 0x4e, 0xae, 0x68, 0x4f, 0x1f, 0x2e, 0xcc, 0x89, 0x3c, 0x6e, 0xd8, 0x76, 0x88, 0x08,
+	0xb9, 0x43, 0x43, 0xd7, 0x77, 0x9a, 0x09, 0x52, 0xd5, 0x59, 0xd1, 0xd4, 0xb2, 0x2d, 0x6a, 0x69,
+	0xa3, 0xcc, 0xaa, 0x99, 0xbc, 0xab, 0x2f, 0xc3, 0x18, 0x75, 0xe9, 0x16, 0xd2, 0xc6, 0xb8, 0xbb,
+	0xd9, 0x5f, 0x1e, 0x2e, 0x4c, 0x77, 0x53, 0xac, 0x2c, 0x9a, 0x57, 0xae, 0x35, 0x23, 0x84, 0xba,
+	0x00, 0x05, 0xb2, 0xed, 0x79, 0x56, 0xd8, 0xd1, 0xc6, 0x0f, 0x06, 0xc7, 0x18, 0xb5, 0x0e, 0x93,
+	0x68, 0x27, 0x40, 0xb6, 0x4b, 0x91, 0xad, 0x15, 0x2a, 0x4a, 0x75, 0x22, 0xc7, 0x60, 0x79, 0xb1,
+	0xd9, 0x45, 0x35, 0xea, 0x5f, 0xec, 0xef, 0xce, 0x27, 0x79, 0xdf, 0xdf, 0xdf, 0x9d, 0x2f, 0xf7,
+	0xd0, 0xd5, 0xae, 0xd7, 0xa4, 0x0f, 0x6a, 0x5c, 0x87, 0xa2, 0x24, 0x6c, 0x22, 0x12, 0x60, 0x9f,
+	0x20, 0xb5, 0x0c, 0x47, 0x02, 0x21, 0x5b, 0x77, 0x6d, 0x4d, 0xa9, 0x28, 0xd5, 0xd1, 0x26, 0xc4,
+	0xa2, 0x5b, 0xb6, 0xf1, 0x83, 0x02, 0x73, 0x6b, 0xc4, 0xb9, 0xb9, 0x83, 0x5a, 0xef, 0x70, 0xf2,
+	0x57, 0xb1, 0x4f, 0x91, 0x4f, 0xd5, 0x77, 0xa1, 0xd0, 0x8a, 0x1e, 0xb9, 0xd5, 0x01, 0x6d, 0xb2,
+	0x52, 0x7a, 0xfc, 0x70, 0x41, 0x4f, 0x9d, 0xa4, 0xb8, 0x09, 0xb8, 0x6d, 0x33, 0x76, 0xa2, 0x9e,
+	0x81, 0x49, 0x6b, 0x9b, 0x6e, 0xe2, 0xd0, 0xa5, 0x1d, 0x6d, 0x98, 0x7f, 0x83, 0xae, 0xa0, 0xb1,
+	0xcc, 0xea, 0xee, 0xbe, 0xb3, 0xc2, 0x0d, 0xa9, 0x70, 0x29, 0x49, 0xa3, 0x04, 0x67, 0xf2, 0xe4,
+	0x71, 0xf9, 0xc6, 0xef, 0x0a, 0x14, 0xd6, 0x88, 0xf3, 0x21, 0xa6, 0x48, 0x5d, 0xce, 0xa1, 0x62,
+	0x65, 0xee, 0xcf, 0x67, 0xe5, 0x5e, 0x71, 0xd4, 0x74, 0x3d, 0x04, 0xa9, 0x26, 0x8c, 0xb5, 0x31,
+	0x45, 0xa1, 0x36, 0x3c, 0xa0, 0xdb, 0x22, 0x98, 0x5a, 0x87, 0x71, 0x1c, 0x50, 0x17, 0xfb, 0xbc,
+	0x3d, 0xa7, 0xba, 0x67, 0x24, 0x62, 0xc7, 0x64, 0xb9, 0xbc, 0xc7, 0x01, 0x4d, 0x01, 0xec, 0xd7,
+	0x9d, 0x8d, 0x0b, 0x8c, 0x98, 0xc8, 0x35, 0x23, 0xe5, 0x84, 0x44, 0x0a, 0xf3, 0x67, 0xcc, 0xc0,
+	0xb4, 0x78, 0x4c, 0x4a, 0xff, 0x4b, 0x49, 0x64, 0x1f, 0x21, 0xd7, 0xd9, 0xa4, 0xc8, 0xfe, 0xbf,
+	0x28, 0x78, 0x0d, 0x0a, 0x51, 0x65, 0x44, 0x1b, 0xe1, 0x73, 0xe2, 0x5c, 0x86, 0x83, 0x38, 0xa1,
+	0x1e, 0x2e, 0x62, 0x8b, 0xbe, 0x64, 0x5c, 0x4e, 0x93, 0x71, 0x36, 0x97, 0x8c, 0xd8, 0xb9, 0x51,
+	0x84, 0x53, 0x19, 0x51, 0x42, 0xce, 0x1f, 0x0a, 0xc0, 0x1a, 0x71, 0xe2, 0xa1, 0xf2, 0x2f, 0x79,
+	0xb9, 0x0a, 0x93, 0x62, 0x1e, 0xe2, 0xc1, 0xdc, 0x74, 0xa1, 0xea, 0x75, 0x18, 0xb7, 0x3c, 0xbc,
+	0xed, 0x53, 0x41, 0x4f, 0x9f, 0x31, 0x3a, 0xc9, 0xc6, 0x68, 0x14, 0x59, 0xd8, 0x34, 0x2e, 0xf1,
+	0xa3, 0x92, 0x78, 0x63, 0x44, 0x68, 0x12, 0x11, 0xa2, 0x32, 0x63, 0x0e, 0xd4, 0xee, 0x5b, 0x52,
+	0xfe, 0x8f, 0x51, 0x6f, 0x7c, 0x10, 0xd8, 0x16, 0x45, 0xb7, 0xad, 0xd0, 0xf2, 0x08, 0x2b, 0xa6,
+	0x7b, 0x3e, 0x95, 0x41, 0xc5, 0x24, 0x50, 0xf5, 0x55, 0x18, 0x0f, 0xb8, 0x07, 0xce, 0xc0, 0x91,
+	0xa5, 0x13, 0x99, 0x6f, 0x1d, 0xb9, 0x4f, 0x15, 0x12, 0xe1, 0x1b, 0xb7, 0x9e, 0xca, 0xc3, 0x53,
+	0x1e, 0x03, 0xe7, 0x7b, 0x6a, 0xdb, 0x89, 0xb7, 0x6f, 0x26, 0x79, 0xc3, 0x84, 0x53, 0x19, 0x51,
+	0x5c, 0x6b, 0x63, 0x36, 0x27, 0x8a, 0xf1, 0xb5, 0xc2, 0x57, 0xe3, 0xaa, 0xe5, 0xb7, 0xd0, 0x56,
+	0xcf, 0x6a, 0xcc, 0x69, 0x83, 0xe9, 0x4c, 0x1b, 0xa4, 0x3a, 0xa0, 0x77, 0x1b, 0x0d, 0x1f, 0x76,
+	0x1b, 0x35, 0x2a, 0x4f, 0xe5, 0x25, 0x90, 0x9a, 0xfb, 0xc6, 0x4f, 0x0a, 0x14, 0xa5, 0xfc, 0x92,
+	0xa1, 0xfe, 0xcf, 0xf3, 0xbc, 0x05, 0xc7, 0x5a, 0xdc, 0x17, 0xb2, 0xd7, 0xd9, 0x35, 0x41, 0x7c,
+	0x2b, 0x5d, 0x1a, 0xe9, 0xef, 0xc7, 0x77, 0x88, 0x95, 0x09, 0xf6, 0xc1, 0x1e, 0x3c, 0x2f, 0x2b,
+	0xcd, 0xa3, 0xb1, 0x29, 0x53, 0xaa, 0x2f, 0xc1, 0x74, 0xe2, 0x6a, 0x93, 0x9f, 0x2b, 0x3e, 0xe8,
+	0x46, 0x9b, 0x53, 0xb1, 0xf8, 0x6d, 0x2e, 0xcd, 0x21, 0x7e, 0x79, 0xd1, 0xf8, 0x52, 0x10, 0x1f,
+	0x22, 0x8b, 0xa2, 0xb7, 0x70, 0x1b, 0x85, 0x3e, 0x0e, 0xd5, 0x25, 0x28, 0x58, 0x11, 0x57, 0x03,
+	0x3b, 0x2f, 0x06, 0xa6, 0xe6, 0xc4, 0x70, 0x66, 0x4e, 0x70, 0x36, 0x63, 0x64, 0xfe, 0x12, 0x4d,
+	0x67, 0x60, 0x9c, 0x86, 0xa2, 0x24, 0x4c, 0x8e, 0xcb, 0x73, 0x05, 0x66, 0xf9, 0x29, 0x62, 0xd7,
+	0x93, 0x9e, 0xb4, 0x6f, 0xc2, 0x8c, 0x1d, 0xc9, 0x70, 0xb8, 0x7e, 0xd8, 0x02, 0x8e, 0x27, 0x26,
+	0x42, 0xae, 0xae, 0xc2, 0x71, 0x47, 0xb8, 0x4c, 0xbc, 0x0c, 0x6a, 0xa6, 0xe9, 0xd8, 0x42, 0x88,
+	0x1b, 0xd7, 0x59, 0xc9, 0x72, 0x3a, 0xac, 0xf8, 0x73, 0x39, 0xd3, 0x21, 0x5d, 0x89, 0x71, 0x16,
+	0x4e, 0xe7, 0x88, 0x13, 0x02, 0xbe, 0x51, 0xe0, 0x04, 0x3b, 0x5f, 0xbe, 0xfd, 0xdf, 0x50, 0xd0,
+	0x78, 0xfd, 0xe0, 0xec, 0xcf, 0x4b, 0xd9, 0xcb, 0x69, 0x18, 0x65, 0x38, 0x9b, 0xab, 0x88, 0x2b,
+	0x58, 0xfa, 0x76, 0x02, 0x46, 0xd6, 0x88, 0xa3, 0x7e, 0x0a, 0x53, 0x99, 0xfb, 0x70, 0x25, 0x33,
+	0xaf, 0xa4, 0xbb, 0x94, 0x5e, 0x1d, 0x84, 0x48, 0x78, 0x9a, 0x7d, 0x2c, 0xcd, 0x9a, 0xab, 0xea,
+	0x67, 0x30, 0x23, 0xdf, 0xae, 0xce, 0xcb, 0x3e, 0x25, 0x90, 0x7e, 0xe9, 0x10, 0xa0, 0xfe, 0xb1,
+	0xef, 0xc0, 0x28, 0xbf, 0xfb, 0x9c, 0x94, 0x3d, 0x31, 0xb9, 0x5e, 0xca, 0x97, 0xf7, 0x77, 0x7a,
+	0x0f, 0x8e, 0xa6, 0x6e, 0x15, 0x07, 0x38, 0x89, 0xf5, 0xfa, 0xc5, 0xfe, 0xfa, 0xfe, 0xc1, 0xee,
+	0x42, 0x21, 0xde, 0xd2, 0x45, 0xd9, 0x8f, 0x50, 0xe9, 0xe7, 0x0e, 0x54, 0x0d, 0x2c, 0x25, 0xb5,
+	0x04, 0x73, 0x4a, 0xe9, 0xd5, 0xeb, 0x17, 0xfb, 0xeb, 0xfb, 0x05, 0xbb, 0xc6, 0x7a, 0x2f, 0xb3,
+	0x70, 0x72, 0x7a, 0x2f, 0x8d, 0xd0, 0xab, 0x83, 0x10, 0x7d, 0x42, 0x2e, 0x2f, 0xaa, 0x77, 0x61,
+	0x2a, 0x33, 0x6a, 0xf3, 0x42, 0xa6, 0x10, 0x7a, 0x75, 0x10, 0x22, 0xd9, 0x43, 0x1b, 0x70, 0x5c,
+	0x9a, 0x89, 0x46, 0xde, 0x97, 0x48, 0x63, 0xf4, 0xf9, 0xc1, 0x98, 0x24, 0xc6, 0x26, 0xa8, 0x39,
+	0x63, 0xe7, 0x42, 0xce, 0x77, 0x90, 0x50, 0xfa, 0xe5, 0xc3, 0xa0, 0xe2, 0x48, 0xfa, 0xd8, 0xe7,
+	0xec, 0x76, 0xb2, 0x72, 0xf3, 0xd1, 0x8b, 0x92, 0xf2, 0xe4, 0x45, 0x49, 0xf9, 0xf5, 0x45, 0x49,
+	0x79, 0xb0, 0x57, 0x1a, 0x7a, 0xb2, 0x57, 0x1a, 0xfa, 0x79, 0xaf, 0x34, 0xf4, 0xf1, 0xa5, 0xbe,
+	0x3f, 0x65, 0xa3, 0xbb, 0x09, 0xff, 0x41, 0xcb, 0xfe, 0x7c, 0x30, 0xce, 0x57, 0xea, 0x2b, 0x7f,
+	0x0f, 0x00, 0x07, 0xe0, 0x89, 0x49, 0x7e, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -937,6 +1223,14 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// CancelProposal defines a method to cancel governance proposal
 	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
+	// CreateGovernor defines a method to register an account as a governor.
+	CreateGovernor(ctx context.Context, in *MsgCreateGovernor, opts ...grpc.CallOption) (*MsgCreateGovernorResponse, error)
+	// DelegateGovernor defines a method to assign the voting power of a
+	// delegator to a governor.
+	DelegateGovernor(ctx context.Context, in *MsgDelegateGovernor, opts ...grpc.CallOption) (*MsgDelegateGovernorResponse, error)
+	// UndelegateGovernor defines a method to withdraw the voting power of a
+	// delegator from its governor.
+	UndelegateGovernor(ctx context.Context, in *MsgUndelegateGovernor, opts ...grpc.CallOption) (*MsgUndelegateGovernorResponse, error)
 }
 
 type msgClient struct {
@@ -1010,6 +1304,33 @@ func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, o
 	return out, nil
 }
 
+func (c *msgClient) CreateGovernor(ctx context.Context, in *MsgCreateGovernor, opts ...grpc.CallOption) (*MsgCreateGovernorResponse, error) {
+	out := new(MsgCreateGovernorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/CreateGovernor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateGovernor(ctx context.Context, in *MsgDelegateGovernor, opts ...grpc.CallOption) (*MsgDelegateGovernorResponse, error) {
+	out := new(MsgDelegateGovernorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/DelegateGovernor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateGovernor(ctx context.Context, in *MsgUndelegateGovernor, opts ...grpc.CallOption) (*MsgUndelegateGovernorResponse, error) {
+	out := new(MsgUndelegateGovernorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/UndelegateGovernor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1028,6 +1349,14 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// CancelProposal defines a method to cancel governance proposal
 	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// CreateGovernor defines a method to register an account as a governor.
+	CreateGovernor(context.Context, *MsgCreateGovernor) (*MsgCreateGovernorResponse, error)
+	// DelegateGovernor defines a method to assign the voting power of a
+	// delegator to a governor.
+	DelegateGovernor(context.Context, *MsgDelegateGovernor) (*MsgDelegateGovernorResponse, error)
+	// UndelegateGovernor defines a method to withdraw the voting power of a
+	// delegator from its governor.
+	UndelegateGovernor(context.Context, *MsgUndelegateGovernor) (*MsgUndelegateGovernorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1055,6 +1384,15 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) CancelProposal(ctx context.Context, req *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
 }
+func (*UnimplementedMsgServer) CreateGovernor(ctx context.Context, req *MsgCreateGovernor) (*MsgCreateGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGovernor not implemented")
+}
+func (*UnimplementedMsgServer) DelegateGovernor(ctx context.Context, req *MsgDelegateGovernor) (*MsgDelegateGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateGovernor not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateGovernor(ctx context.Context, req *MsgUndelegateGovernor) (*MsgUndelegateGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateGovernor not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1186,6 +1524,60 @@ func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/CreateGovernor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGovernor(ctx, req.(*MsgCreateGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/DelegateGovernor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateGovernor(ctx, req.(*MsgDelegateGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/UndelegateGovernor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateGovernor(ctx, req.(*MsgUndelegateGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
@@ -1219,6 +1611,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelProposal",
 			Handler:    _Msg_CancelProposal_Handler,
 		},
+		{
+			MethodName: "CreateGovernor",
+			Handler:    _Msg_CreateGovernor_Handler,
+		},
+		{
+			MethodName: "DelegateGovernor",
+			Handler:    _Msg_DelegateGovernor_Handler,
+		},
+		{
+			MethodName: "UndelegateGovernor",
+			Handler:    _Msg_UndelegateGovernor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -1766,30 +2170,203 @@ func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreateGovernor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSubmitProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCreateGovernor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGovernor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.InitialDeposit) > 0 {
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGovernorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGovernorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGovernorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateGovernor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateGovernor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateGovernor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateGovernorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateGovernorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateGovernorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateGovernor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateGovernor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateGovernor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateGovernorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateGovernorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateGovernorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.InitialDeposit) > 0 {
 		for _, e := range m.InitialDeposit {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
@@ -2010,6 +2587,80 @@ func (m *MsgCancelProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateGovernor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGovernorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateGovernor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateGovernorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateGovernor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateGovernorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3510,6 +4161,466 @@ func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreateGovernor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGovernor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGovernor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGovernorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGovernorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGovernorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateGovernor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateGovernor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateGovernor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateGovernorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateGovernorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateGovernorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateGovernor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateGovernor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateGovernor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateGovernorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateGovernorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateGovernorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
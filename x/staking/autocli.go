@@ -137,6 +137,68 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod: "TokenizeShareRecord",
+					Use:       "tokenize-share-record [id]",
+					Short:     "Query a tokenize share record by its id",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-record 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "TokenizeShareRecordsOwned",
+					Use:       "tokenize-share-records-owned [owner]",
+					Short:     "Query all tokenize share records of an owner",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-records-owned cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the amount of tokens staked through tokenized shares",
+				},
+				{
+					RpcMethod: "ValidatorLiquidShares",
+					Use:       "validator-liquid-shares [validator-addr]",
+					Short:     "Query the tokenized delegator shares of a validator",
+					Example:   fmt.Sprintf("$ %s query staking validator-liquid-shares cosmosvaloper...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
+				{
+					RpcMethod: "UnbondingQueue",
+					Use:       "unbonding-queue",
+					Short:     "Query the unbonding queue by completion time, optionally within a time range",
+					Example:   fmt.Sprintf("$ %s query staking unbonding-queue --start-time 2024-01-01T00:00:00Z --end-time 2024-02-01T00:00:00Z", version.AppName),
+				},
+				{
+					RpcMethod: "RedelegationQueue",
+					Use:       "redelegation-queue",
+					Short:     "Query the redelegation queue by completion time, optionally within a time range",
+					Example:   fmt.Sprintf("$ %s query staking redelegation-queue --start-time 2024-01-01T00:00:00Z --end-time 2024-02-01T00:00:00Z", version.AppName),
+				},
+				{
+					RpcMethod: "DelegatorPendingEntries",
+					Use:       "pending-entries [delegator-addr]",
+					Short:     "Query the pending unbonding and redelegation entries of a delegator",
+					Example:   fmt.Sprintf("$ %s query staking pending-entries cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_addr"},
+					},
+				},
+				{
+					RpcMethod: "ValidatorCommissionSchedule",
+					Use:       "commission-schedule [validator-addr]",
+					Short:     "Query the commission rate change scheduled by a validator",
+					Example:   fmt.Sprintf("$ %s query staking commission-schedule cosmosvaloper...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -189,6 +251,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking rotate-cons-pub-key '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}' --from myvalidator`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "new_pubkey"}},
 				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-share [validator-addr] [amount] [reward-owner] --from [delegator_address]",
+					Short:          "Tokenize delegation shares into share tokens",
+					Long:           "Convert an amount of a delegation into share tokens, the rewards of the tokenized delegation go to the reward owner.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-share cosmosvaloper... 100stake cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "tokenized_share_owner"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens [amount] --from [delegator_address]",
+					Short:          "Redeem share tokens for a delegation",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100cosmosvaloper.../1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ScheduleCommissionChange",
+					Use:            "schedule-commission-change [rate] [effective-time]",
+					Short:          "Announce a validator commission rate change taking effect at a future time",
+					Long:           "Announce a validator commission rate change taking effect at a future time, replacing any change previously scheduled.",
+					Example:        fmt.Sprintf(`%s tx staking schedule-commission-change 0.1 2024-02-01T00:00:00Z --from myvalidator`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "rate"}, {ProtoField: "effective_time"}},
+				},
 			},
 			EnhanceCustomCommand: false, // use custom commands only until v0.51
 		},
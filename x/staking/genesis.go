@@ -5,6 +5,8 @@ import (
 
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"cosmossdk.io/math"
+
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -68,6 +70,14 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateCommissionChangeSchedules(data); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -109,6 +119,44 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 	return nil
 }
 
+// validateGenesisStateTokenizeShareRecords validates that the tokenize share
+// records have unique ids not above the last record id.
+func validateGenesisStateTokenizeShareRecords(data *types.GenesisState) error {
+	ids := make(map[uint64]bool, len(data.TokenizeShareRecords))
+	for _, record := range data.TokenizeShareRecords {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id == 0 || record.Id > data.LastTokenizeShareRecordId {
+			return fmt.Errorf("tokenize share record id %d is not in [1, %d]", record.Id, data.LastTokenizeShareRecordId)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
+// validateGenesisStateCommissionChangeSchedules validates that the commission
+// change schedules are unique per validator and have valid rates.
+func validateGenesisStateCommissionChangeSchedules(data *types.GenesisState) error {
+	validators := make(map[string]bool, len(data.CommissionChangeSchedules))
+	for _, schedule := range data.CommissionChangeSchedules {
+		if validators[schedule.ValidatorAddress] {
+			return fmt.Errorf("duplicate commission change schedule in genesis state: validator %s", schedule.ValidatorAddress)
+		}
+
+		if schedule.Rate.IsNil() || schedule.Rate.IsNegative() || schedule.Rate.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("commission change schedule of validator %s has an invalid rate %s", schedule.ValidatorAddress, schedule.Rate)
+		}
+
+		validators[schedule.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
 // validateGenesisStateConsKeyRotations validates the imported key rotation
 // queues against the same uniqueness and lock invariants enforced by the tx
 // path.
@@ -0,0 +1,274 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetCommissionChangeSchedule returns the commission change scheduled by a
+// validator.
+func (k Keeper) GetCommissionChangeSchedule(ctx context.Context, valAddr sdk.ValAddress) (schedule types.CommissionChangeSchedule, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetCommissionChangeScheduleKey(valAddr))
+	if err != nil {
+		return schedule, err
+	}
+
+	if bz == nil {
+		return schedule, types.ErrCommissionChangeScheduleNotFound
+	}
+
+	err = k.cdc.Unmarshal(bz, &schedule)
+	return schedule, err
+}
+
+// GetAllCommissionChangeSchedules returns the commission changes scheduled by
+// all validators.
+func (k Keeper) GetAllCommissionChangeSchedules(ctx context.Context) ([]types.CommissionChangeSchedule, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.CommissionChangeScheduleKey, storetypes.PrefixEndBytes(types.CommissionChangeScheduleKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var schedules []types.CommissionChangeSchedule
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.CommissionChangeSchedule
+		if err := k.cdc.Unmarshal(iterator.Value(), &schedule); err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, nil
+}
+
+// SetCommissionChangeSchedule sets the commission change scheduled by a
+// validator and queues it by effective time, replacing any change previously
+// scheduled by the validator.
+func (k Keeper) SetCommissionChangeSchedule(ctx context.Context, schedule types.CommissionChangeSchedule) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(schedule.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.removeCommissionChangeSchedule(ctx, valAddr); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&schedule)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetCommissionChangeScheduleKey(valAddr), bz); err != nil {
+		return err
+	}
+
+	return store.Set(types.GetCommissionChangeQueueKey(schedule.EffectiveTime, valAddr), []byte{})
+}
+
+// removeCommissionChangeSchedule removes the commission change scheduled by a
+// validator, if any, along with its queue entry.
+func (k Keeper) removeCommissionChangeSchedule(ctx context.Context, valAddr sdk.ValAddress) error {
+	schedule, err := k.GetCommissionChangeSchedule(ctx, valAddr)
+	if errors.Is(err, types.ErrCommissionChangeScheduleNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetCommissionChangeQueueKey(schedule.EffectiveTime, valAddr)); err != nil {
+		return err
+	}
+
+	return store.Delete(types.GetCommissionChangeScheduleKey(valAddr))
+}
+
+// ScheduleCommissionChange announces a commission rate change of a validator
+// taking effect at a future time. The change must be valid against the current
+// commission of the validator at the effective time, it is validated again
+// when it takes effect.
+func (k Keeper) ScheduleCommissionChange(ctx context.Context, valAddr sdk.ValAddress, rate math.LegacyDec, effectiveTime time.Time) error {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockHeader().Time
+	if !effectiveTime.After(blockTime) {
+		return errorsmod.Wrapf(types.ErrInvalidCommissionChangeSchedule, "effective time %s must be after the block time %s", effectiveTime, blockTime)
+	}
+
+	if err := validator.Commission.ValidateNewRate(rate, effectiveTime); err != nil {
+		return err
+	}
+
+	if err := k.validateCommissionRateBounds(ctx, rate); err != nil {
+		return err
+	}
+
+	return k.SetCommissionChangeSchedule(ctx, types.CommissionChangeSchedule{
+		ValidatorAddress: validator.OperatorAddress,
+		Rate:             rate,
+		EffectiveTime:    effectiveTime,
+	})
+}
+
+// ApplyMatureCommissionChanges applies the scheduled commission changes whose
+// effective time has been reached. A change which has become invalid since it
+// was scheduled, e.g. after an edit of the commission or a change of the
+// commission rate params, is dropped.
+func (k Keeper) ApplyMatureCommissionChanges(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockHeader().Time
+
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(
+		types.CommissionChangeQueueKey,
+		storetypes.PrefixEndBytes(types.GetCommissionChangeQueueTimePrefix(blockTime)),
+	)
+	if err != nil {
+		return err
+	}
+
+	var valAddrs []sdk.ValAddress
+	for ; iterator.Valid(); iterator.Next() {
+		_, valAddr, err := types.ParseCommissionChangeQueueKey(iterator.Key())
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		valAddrs = append(valAddrs, valAddr)
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, valAddr := range valAddrs {
+		schedule, err := k.GetCommissionChangeSchedule(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if err := k.removeCommissionChangeSchedule(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if errors.Is(err, types.ErrNoValidatorFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		commission, err := k.UpdateValidatorCommission(ctx, validator, schedule.Rate)
+		if err != nil {
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCommissionChangeFailed,
+					sdk.NewAttribute(types.AttributeKeyValidator, schedule.ValidatorAddress),
+					sdk.NewAttribute(types.AttributeKeyCommissionRate, schedule.Rate.String()),
+					sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+				),
+			)
+			continue
+		}
+
+		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator.Commission = commission
+		if err := k.SetValidator(ctx, validator); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommissionChange,
+				sdk.NewAttribute(types.AttributeKeyValidator, schedule.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, schedule.Rate.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// EnforceCommissionRateBounds bumps the commission rate of the validators below
+// the MinCommissionRate param up to it, and lowers the commission rate of the
+// validators above the MaxCommissionRate param down to it. The max rate of a
+// bumped validator is raised to its new rate if needed.
+func (k Keeper) EnforceCommissionRateBounds(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	validators, err := k.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, validator := range validators {
+		commission := validator.Commission
+		switch {
+		case commission.Rate.LT(params.MinCommissionRate):
+			commission.Rate = params.MinCommissionRate
+			if commission.MaxRate.LT(commission.Rate) {
+				commission.MaxRate = commission.Rate
+			}
+		case commission.Rate.GT(params.MaxCommissionRate):
+			commission.Rate = params.MaxCommissionRate
+		default:
+			continue
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validator.OperatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator.Commission = commission
+		if err := k.SetValidator(ctx, validator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateCommissionRateBounds returns an error if a commission rate is out of
+// the MinCommissionRate and MaxCommissionRate params.
+func (k Keeper) validateCommissionRateBounds(ctx context.Context, rate math.LegacyDec) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if rate.LT(params.MinCommissionRate) {
+		return errorsmod.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", params.MinCommissionRate)
+	}
+
+	if rate.GT(params.MaxCommissionRate) {
+		return errorsmod.Wrapf(types.ErrCommissionGTMaxCommissionRate, "cannot set validator commission to more than maximum rate of %s", params.MaxCommissionRate)
+	}
+
+	return nil
+}
@@ -1255,13 +1255,28 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrTransitiveRedelegation
 	}
 
-	hasMaxRedels, err := k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr)
-	if err != nil {
-		return time.Time{}, err
+	// redelegations away from a jailed, including tombstoned, validator may be
+	// allowed regardless of the max entries. They complete as soon as the
+	// source validator is unbonded, but keep their entry until then, so that
+	// the redelegated tokens remain slashable for infractions committed before
+	// the jailing and cannot be redelegated again in the meantime
+	instant := false
+	if srcValidator.IsJailed() {
+		instant, err = k.AllowInstantRedelegationFromJailed(ctx)
+		if err != nil {
+			return time.Time{}, err
+		}
 	}
 
-	if hasMaxRedels {
-		return time.Time{}, types.ErrMaxRedelegationEntries
+	if !instant {
+		hasMaxRedels, err := k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if hasMaxRedels {
+			return time.Time{}, types.ErrMaxRedelegationEntries
+		}
 	}
 
 	returnAmount, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount)
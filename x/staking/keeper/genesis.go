@@ -185,6 +185,16 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		panic(err)
 	}
 
+	if err := k.importTokenizeShareRecords(ctx, data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		panic(err)
+	}
+
+	for _, schedule := range data.CommissionChangeSchedules {
+		if err := k.SetCommissionChangeSchedule(ctx, schedule); err != nil {
+			panic(err)
+		}
+	}
+
 	// don't need to run CometBFT updates if we exported
 	if data.Exported {
 		pendingRotations, err := k.PendingConsKeyRotations(ctx)
@@ -297,6 +307,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	tokenizeShareRecords, err := k.GetAllTokenizeShareRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	lastTokenizeShareRecordID, err := k.GetLastTokenizeShareRecordID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	commissionChangeSchedules, err := k.GetAllCommissionChangeSchedules(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		Params:                       params,
 		LastTotalPower:               totalPower,
@@ -308,5 +333,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		Exported:                     true,
 		ConsensusKeyRotationHistory:  consKeyRotationHistory,
 		PendingConsensusKeyRotations: pendingConsKeyRotations,
+		TokenizeShareRecords:         tokenizeShareRecords,
+		LastTokenizeShareRecordId:    lastTokenizeShareRecordID,
+		CommissionChangeSchedules:    commissionChangeSchedules,
 	}
 }
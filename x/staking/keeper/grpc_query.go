@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"sort"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -497,6 +499,248 @@ func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecord queries a tokenize share record by its id
+func (k Querier) TokenizeShareRecord(ctx context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsOwned queries the tokenize share records of a given owner address
+func (k Querier) TokenizeShareRecordsOwned(ctx context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []types.TokenizeShareRecord
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	ownerStore := prefix.NewStore(store, types.GetTokenizeShareRecordIDsByOwnerKey(owner))
+	pageRes, err := query.Paginate(ownerStore, req.Pagination, func(key, _ []byte) error {
+		record, err := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// TotalLiquidStaked queries the amount of tokens staked through tokenized shares
+func (k Querier) TotalLiquidStaked(ctx context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	tokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: tokens}, nil
+}
+
+// ValidatorLiquidShares queries the tokenized delegator shares of a given validator address
+func (k Querier) ValidatorLiquidShares(ctx context.Context, req *types.QueryValidatorLiquidSharesRequest) (*types.QueryValidatorLiquidSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorLiquidSharesResponse{LiquidShares: shares}, nil
+}
+
+// UnbondingQueue queries the unbonding queue slices maturing within a given completion time range
+func (k Querier) UnbondingQueue(ctx context.Context, req *types.QueryUnbondingQueueRequest) (*types.QueryUnbondingQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var slices []types.UnbondingQueueSlice
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queueStore := prefix.NewStore(store, types.UnbondingQueueKey)
+	pageRes, err := query.FilteredPaginate(queueStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		completionTime, err := sdk.ParseTimeBytes(key)
+		if err != nil {
+			return false, err
+		}
+		if !inQueueTimeRange(completionTime, req.StartTime, req.EndTime) {
+			return false, nil
+		}
+
+		if accumulate {
+			var pairs types.DVPairs
+			if err := k.cdc.Unmarshal(value, &pairs); err != nil {
+				return false, err
+			}
+			slices = append(slices, types.UnbondingQueueSlice{CompletionTime: completionTime, Pairs: pairs.Pairs})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingQueueResponse{Slices: slices, Pagination: pageRes}, nil
+}
+
+// RedelegationQueue queries the redelegation queue slices maturing within a given completion time range
+func (k Querier) RedelegationQueue(ctx context.Context, req *types.QueryRedelegationQueueRequest) (*types.QueryRedelegationQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var slices []types.RedelegationQueueSlice
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queueStore := prefix.NewStore(store, types.RedelegationQueueKey)
+	pageRes, err := query.FilteredPaginate(queueStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		completionTime, err := sdk.ParseTimeBytes(key)
+		if err != nil {
+			return false, err
+		}
+		if !inQueueTimeRange(completionTime, req.StartTime, req.EndTime) {
+			return false, nil
+		}
+
+		if accumulate {
+			var triplets types.DVVTriplets
+			if err := k.cdc.Unmarshal(value, &triplets); err != nil {
+				return false, err
+			}
+			slices = append(slices, types.RedelegationQueueSlice{CompletionTime: completionTime, Triplets: triplets.Triplets})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedelegationQueueResponse{Slices: slices, Pagination: pageRes}, nil
+}
+
+// DelegatorPendingEntries queries the unbonding and redelegation entries of a given delegator
+// address which have not completed yet, ordered by completion time
+func (k Querier) DelegatorPendingEntries(ctx context.Context, req *types.QueryDelegatorPendingEntriesRequest) (*types.QueryDelegatorPendingEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ubds, err := k.GetAllUnbondingDelegations(ctx, delAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var entries []types.PendingEntry
+	for _, ubd := range ubds {
+		for _, entry := range ubd.Entries {
+			entries = append(entries, types.PendingEntry{
+				ValidatorSrcAddress: ubd.ValidatorAddress,
+				CreationHeight:      entry.CreationHeight,
+				CompletionTime:      entry.CompletionTime,
+				Balance:             entry.Balance,
+			})
+		}
+	}
+
+	redels, err := k.GetAllRedelegations(ctx, delAddr, nil, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, red := range redels {
+		for _, entry := range red.Entries {
+			entries = append(entries, types.PendingEntry{
+				ValidatorSrcAddress: red.ValidatorSrcAddress,
+				ValidatorDstAddress: red.ValidatorDstAddress,
+				CreationHeight:      entry.CreationHeight,
+				CompletionTime:      entry.CompletionTime,
+				Balance:             entry.InitialBalance,
+			})
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CompletionTime.Before(entries[j].CompletionTime)
+	})
+
+	maxEntries, err := k.MaxEntries(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorPendingEntriesResponse{Entries: entries, MaxEntries: maxEntries}, nil
+}
+
+// ValidatorCommissionSchedule queries the commission change scheduled by a given validator address
+func (k Querier) ValidatorCommissionSchedule(ctx context.Context, req *types.QueryValidatorCommissionScheduleRequest) (*types.QueryValidatorCommissionScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := k.GetCommissionChangeSchedule(ctx, valAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "commission change schedule of validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorCommissionScheduleResponse{Schedule: schedule}, nil
+}
+
+// inQueueTimeRange reports whether a queue completion time lies within the
+// inclusive range of a queue query, a zero end time leaves it unbounded.
+func inQueueTimeRange(completionTime, start, end time.Time) bool {
+	if completionTime.Before(start) {
+		return false
+	}
+	return end.IsZero() || !completionTime.After(end)
+}
+
 func queryRedelegation(ctx context.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
@@ -3,6 +3,8 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	v7 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v7"
+	v8 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v8"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -22,3 +24,16 @@ func NewMigrator(keeper *Keeper) Migrator {
 func (m Migrator) Migrate5to6(ctx sdk.Context) error {
 	return v6.Migrate(ctx, m.keeper)
 }
+
+// Migrate6to7 migrates the x/staking module state from consensus version 6 to
+// version 7. Specifically, it adds the liquid staking cap params.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.Migrate(ctx, m.keeper)
+}
+
+// Migrate7to8 migrates the x/staking module state from consensus version 7 to
+// version 8. Specifically, it adds the MaxCommissionRate param and bumps the
+// validators below the minimum commission rate.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.Migrate(ctx, m.keeper)
+}
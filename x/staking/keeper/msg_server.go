@@ -52,6 +52,15 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 		return nil, errorsmod.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", minCommRate)
 	}
 
+	maxCommRate, err := k.MaxCommissionRate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Commission.Rate.GT(maxCommRate) {
+		return nil, errorsmod.Wrapf(types.ErrCommissionGTMaxCommissionRate, "cannot set validator commission to more than maximum rate of %s", maxCommRate)
+	}
+
 	// check to see if the pubkey or sender has been registered before
 	if _, err := k.GetValidator(ctx, valAddr); err == nil {
 		return nil, types.ErrValidatorOwnerExists
@@ -189,6 +198,15 @@ func (k msgServer) EditValidator(ctx context.Context, msg *types.MsgEditValidato
 		if msg.CommissionRate.LT(minCommissionRate) {
 			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "commission rate cannot be less than the min commission rate %s", minCommissionRate.String())
 		}
+
+		maxCommissionRate, err := k.MaxCommissionRate(ctx)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+
+		if msg.CommissionRate.GT(maxCommissionRate) {
+			return nil, errorsmod.Wrapf(types.ErrCommissionGTMaxCommissionRate, "commission rate cannot be more than the max commission rate %s", maxCommissionRate.String())
+		}
 	}
 
 	// validator must already be registered
@@ -619,6 +637,11 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	// bring the validator commissions within the new commission rate bounds
+	if err := k.EnforceCommissionRateBounds(ctx); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
@@ -740,3 +763,136 @@ func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateCon
 
 	return &types.MsgRotateConsPubKeyResponse{}, nil
 }
+
+// TokenizeShares defines a method for converting a delegation into share
+// tokens, whose rewards go to the tokenized share owner.
+func (k msgServer) TokenizeShares(ctx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(msg.TokenizedShareOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenized share owner address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid tokenize shares amount",
+		)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	ctx = types.WithStrictWithdraw(ctx)
+	shareToken, err := k.Keeper.TokenizeShares(ctx, delegatorAddress, valAddr, msg.Amount.Amount, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShareTokens, shareToken.String()),
+		),
+	})
+
+	return &types.MsgTokenizeSharesResponse{Amount: shareToken}, nil
+}
+
+// RedeemTokensForShares defines a method for redeeming share tokens for a
+// delegation to the validator of their tokenize share record.
+func (k msgServer) RedeemTokensForShares(ctx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid share tokens amount",
+		)
+	}
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = types.WithStrictWithdraw(ctx)
+	returnCoin, err := k.Keeper.RedeemTokensForShares(ctx, delegatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyShareTokens, msg.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, returnCoin.String()),
+		),
+	})
+
+	return &types.MsgRedeemTokensForSharesResponse{Amount: returnCoin}, nil
+}
+
+// ScheduleCommissionChange defines a method for announcing a validator
+// commission rate change which takes effect at a future time
+func (k msgServer) ScheduleCommissionChange(ctx context.Context, msg *types.MsgScheduleCommissionChange) (*types.MsgScheduleCommissionChangeResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.Rate.IsNil() || msg.Rate.GT(math.LegacyOneDec()) || msg.Rate.IsNegative() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "commission rate must be between 0 and 1 (inclusive)")
+	}
+
+	if err := k.Keeper.ScheduleCommissionChange(ctx, valAddr, msg.Rate, msg.EffectiveTime); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeScheduleCommissionChange,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, msg.Rate.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveTime, msg.EffectiveTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgScheduleCommissionChangeResponse{}, nil
+}
@@ -1135,6 +1135,24 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			expErr:    true,
 			expErrMsg: "minimum commission rate cannot be greater than 100%",
 		},
+		{
+			name: "min commission rate greater than max commission rate",
+			input: &stakingtypes.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params: stakingtypes.Params{
+					MinCommissionRate: math.LegacyNewDecWithPrec(5, 1),
+					MaxCommissionRate: math.LegacyNewDecWithPrec(2, 1),
+					UnbondingTime:     stakingtypes.DefaultUnbondingTime,
+					MaxValidators:     stakingtypes.DefaultMaxValidators,
+					MaxEntries:        stakingtypes.DefaultMaxEntries,
+					HistoricalEntries: stakingtypes.DefaultHistoricalEntries,
+					BondDenom:         stakingtypes.BondStatusBonded,
+					KeyRotationFee:    sdk.NewCoin(sdk.DefaultBondDenom, stakingtypes.DefaultKeyRotationFeeAmount),
+				},
+			},
+			expErr:    true,
+			expErrMsg: "cannot be greater than maximum commission rate",
+		},
 		{
 			name: "invalid bond denom",
 			input: &stakingtypes.MsgUpdateParams{
@@ -1164,6 +1182,10 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 					HistoricalEntries: stakingtypes.DefaultHistoricalEntries,
 					BondDenom:         "ghosttoken",
 					KeyRotationFee:    sdk.NewInt64Coin("ghosttoken", 1000000),
+
+					GlobalLiquidStakingCap:    stakingtypes.DefaultGlobalLiquidStakingCap,
+					ValidatorLiquidStakingCap: stakingtypes.DefaultValidatorLiquidStakingCap,
+					MaxCommissionRate:         stakingtypes.DefaultMaxCommissionRate,
 				},
 			},
 			expErr:    true,
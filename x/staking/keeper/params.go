@@ -56,12 +56,25 @@ func (k Keeper) MinCommissionRate(ctx context.Context) (math.LegacyDec, error) {
 	return params.MinCommissionRate, err
 }
 
+// MaxCommissionRate - Maximum validator commission rate
+func (k Keeper) MaxCommissionRate(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	return params.MaxCommissionRate, err
+}
+
 // KeyRotationFee - Fee charged to rotate a validator's consensus key
 func (k Keeper) KeyRotationFee(ctx context.Context) (sdk.Coin, error) {
 	params, err := k.GetParams(ctx)
 	return params.KeyRotationFee, err
 }
 
+// AllowInstantRedelegationFromJailed - Whether redelegations from jailed
+// validators are allowed regardless of the max entries
+func (k Keeper) AllowInstantRedelegationFromJailed(ctx context.Context) (bool, error) {
+	params, err := k.GetParams(ctx)
+	return params.AllowInstantRedelegationFromJailed, err
+}
+
 // SetParams sets the x/staking module parameters.
 // CONTRACT: This method performs no validation of the parameters.
 func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
@@ -0,0 +1,563 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx context.Context) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.LastTokenizeShareRecordIDKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx context.Context, id uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns a tokenize share record by its id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, id uint64) (record types.TokenizeShareRecord, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetTokenizeShareRecordKey(id))
+	if err != nil {
+		return record, err
+	}
+
+	if bz == nil {
+		return record, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "id %d", id)
+	}
+
+	err = k.cdc.Unmarshal(bz, &record)
+	return record, err
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of a share
+// token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx context.Context, denom string) (types.TokenizeShareRecord, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	if bz == nil {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "denom %s", denom)
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of an owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]types.TokenizeShareRecord, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := types.GetTokenizeShareRecordIDsByOwnerKey(owner)
+	iterator, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var records []types.TokenizeShareRecord
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(iterator.Key()[len(prefix):]))
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx context.Context) ([]types.TokenizeShareRecord, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.TokenizeShareRecordKey, storetypes.PrefixEndBytes(types.TokenizeShareRecordKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var records []types.TokenizeShareRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// SetTokenizeShareRecord sets a tokenize share record and its owner and denom
+// indexes.
+func (k Keeper) SetTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetTokenizeShareRecordKey(record.Id), bz); err != nil {
+		return err
+	}
+
+	if err := store.Set(types.GetTokenizeShareRecordIDByOwnerKey(owner, record.Id), []byte{}); err != nil {
+		return err
+	}
+
+	return store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), sdk.Uint64ToBigEndian(record.Id))
+}
+
+// deleteTokenizeShareRecord deletes a tokenize share record and its owner and
+// denom indexes.
+func (k Keeper) deleteTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetTokenizeShareRecordKey(record.Id)); err != nil {
+		return err
+	}
+
+	if err := store.Delete(types.GetTokenizeShareRecordIDByOwnerKey(owner, record.Id)); err != nil {
+		return err
+	}
+
+	return store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+}
+
+// GetValidatorLiquidShares returns the tokenized delegator shares of a
+// validator.
+func (k Keeper) GetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	if bz == nil {
+		return math.LegacyZeroDec(), nil
+	}
+
+	var shares math.LegacyDec
+	err = shares.Unmarshal(bz)
+	return shares, err
+}
+
+// setValidatorLiquidShares sets the tokenized delegator shares of a validator,
+// zero shares are removed from the store.
+func (k Keeper) setValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if !shares.IsPositive() {
+		return store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+	}
+
+	bz, err := shares.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens staked through
+// tokenized shares. It is derived from the tokenized delegator shares at the
+// current exchange rate of each validator, hence it accounts for slashing.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.ValidatorLiquidSharesKey, storetypes.PrefixEndBytes(types.ValidatorLiquidSharesKey))
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	defer iterator.Close()
+
+	total := math.LegacyZeroDec()
+	for ; iterator.Valid(); iterator.Next() {
+		// key is of format prefix || valAddrLen (1 byte) || valAddr
+		validator, err := k.GetValidator(ctx, iterator.Key()[len(types.ValidatorLiquidSharesKey)+1:])
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+
+		var shares math.LegacyDec
+		if err := shares.Unmarshal(iterator.Value()); err != nil {
+			return math.ZeroInt(), err
+		}
+
+		total = total.Add(validator.TokensFromShares(shares))
+	}
+
+	return total.TruncateInt(), nil
+}
+
+// TokenizeShares converts an amount of tokens of a delegation into share
+// tokens. The delegation is moved to the module account of a new tokenize
+// share record, whose rewards go to the owner, and share tokens matching the
+// moved shares are minted to the delegator.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// shares received by a redelegation must stay with the delegator until it
+	// completes, so that they can be slashed for an infraction of the source
+	receiving, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if receiving {
+		return sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the shares are unbonded from the delegator, which would jail the
+	// validator if its self-delegation fell below its minimum
+	if bytes.Equal(delAddr, valAddr) && !validator.Jailed {
+		delegation, err := k.GetDelegation(ctx, delAddr, valAddr)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if validator.TokensFromShares(delegation.Shares.Sub(shares)).TruncateInt().LT(validator.MinSelfDelegation) {
+			return sdk.Coin{}, types.ErrSelfDelegationBelowMinimum
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	lastID, err := k.GetLastTokenizeShareRecordID(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordAddr := types.TokenizeShareRecordAddress(lastID + 1)
+	recordAddrStr, err := k.authKeeper.AddressCodec().BytesToString(recordAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	record := types.TokenizeShareRecord{
+		Id:            lastID + 1,
+		Owner:         ownerStr,
+		ModuleAccount: recordAddrStr,
+		Validator:     validator.GetOperator(),
+	}
+
+	// the delegation is moved through the account of the delegator, so the
+	// delegated vesting coins of a vesting account cannot be tokenized
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, recordAddr, returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the validator is reloaded as Unbond updated its tokens and shares
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	newShares, err := k.Delegate(ctx, recordAddr, returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if !shareToken.IsPositive() {
+		return sdk.Coin{}, types.ErrTinyTokenizeSharesAmount
+	}
+
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.setValidatorLiquidShares(ctx, valAddr, liquidShares.Add(newShares)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, valAddr); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.TokenizeSharesPoolName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizeSharesPoolName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.SetLastTokenizeShareRecordID(ctx, record.Id); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens and moves their pro rata part of
+// the delegation of the tokenize share record to the delegator. The share
+// tokens redeem shares, not tokens, so a slash of the validator reduces the
+// tokens they are worth. Once all the share tokens of a record are redeemed,
+// the record is deleted and its remaining rewards are sent to its owner.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordAddr, err := k.authKeeper.AddressCodec().StringToBytes(record.ModuleAccount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	delegation, err := k.GetDelegation(ctx, recordAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the last share tokens of the record redeem all its remaining shares,
+	// including the fraction truncated when minting
+	supply := k.bankKeeper.GetSupply(ctx, shareToken.Denom).Amount
+	if shareToken.Amount.GT(supply) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrNotEnoughDelegationShares, "%s exceeds the supply %s", shareToken, supply)
+	}
+
+	shares := delegation.Shares
+	if shareToken.Amount.LT(supply) {
+		shares = delegation.Shares.MulInt(shareToken.Amount).QuoInt(supply)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.TokenizeSharesPoolName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.TokenizeSharesPoolName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, recordAddr, returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, recordAddr, delAddr, returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// shares slashed down to no tokens leave nothing to delegate
+	if returnAmount.IsPositive() {
+		validator, err = k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if _, err := k.Delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, true); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.setValidatorLiquidShares(ctx, valAddr, math.LegacyMaxDec(liquidShares.Sub(shares), math.LegacyZeroDec())); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, err := k.GetDelegation(ctx, recordAddr, valAddr); !errors.Is(err, types.ErrNoDelegation) {
+		return sdk.NewCoin(bondDenom, returnAmount), err
+	}
+
+	// the rewards of the record were withdrawn to its module account when its
+	// delegation was removed
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr); !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, rewards); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if err := k.deleteTokenizeShareRecord(ctx, record); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(bondDenom, returnAmount), nil
+}
+
+// checkLiquidStakingCaps returns an error if the tokenized delegator shares of
+// a validator exceed the validator cap, or if the tokenized stake exceeds the
+// global cap of the bonded tokens.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, valAddr sdk.ValAddress) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if liquidShares.GT(validator.DelegatorShares.Mul(params.ValidatorLiquidStakingCap)) {
+		return errorsmod.Wrapf(
+			types.ErrValidatorLiquidStakingCapExceeded,
+			"%s liquid shares of %s delegator shares, cap %s", liquidShares, validator.DelegatorShares, params.ValidatorLiquidStakingCap,
+		)
+	}
+
+	if params.GlobalLiquidStakingCap.Equal(math.LegacyOneDec()) {
+		return nil
+	}
+
+	totalLiquid, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	totalBonded, err := k.TotalValidatorPower(ctx)
+	if err != nil {
+		return err
+	}
+
+	if math.LegacyNewDecFromInt(totalLiquid).GT(math.LegacyNewDecFromInt(totalBonded).Mul(params.GlobalLiquidStakingCap)) {
+		return errorsmod.Wrapf(
+			types.ErrGlobalLiquidStakingCapExceeded,
+			"%s liquid staked of %s bonded tokens, cap %s", totalLiquid, totalBonded, params.GlobalLiquidStakingCap,
+		)
+	}
+
+	return nil
+}
+
+// importTokenizeShareRecords sets the tokenize share records of the genesis and
+// derives the tokenized delegator shares of the validators from the
+// delegations of the records.
+func (k Keeper) importTokenizeShareRecords(ctx context.Context, records []types.TokenizeShareRecord, lastID uint64) error {
+	for _, record := range records {
+		if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+			return err
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+		if err != nil {
+			return err
+		}
+
+		recordAddr, err := k.authKeeper.AddressCodec().StringToBytes(record.ModuleAccount)
+		if err != nil {
+			return err
+		}
+
+		delegation, err := k.GetDelegation(ctx, recordAddr, valAddr)
+		if errors.Is(err, types.ErrNoDelegation) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if err := k.setValidatorLiquidShares(ctx, valAddr, liquidShares.Add(delegation.Shares)); err != nil {
+			return err
+		}
+	}
+
+	return k.SetLastTokenizeShareRecordID(ctx, lastID)
+}
@@ -119,6 +119,11 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]abci.ValidatorUpda
 		return nil, err
 	}
 
+	// apply the scheduled commission changes which take effect.
+	if err := k.ApplyMatureCommissionChanges(ctx); err != nil {
+		return nil, err
+	}
+
 	return validatorUpdates, nil
 }
 
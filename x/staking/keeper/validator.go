@@ -219,6 +219,15 @@ func (k Keeper) UpdateValidatorCommission(ctx context.Context,
 		return commission, fmt.Errorf("cannot set validator commission to less than minimum rate of %s", minCommissionRate)
 	}
 
+	maxCommissionRate, err := k.MaxCommissionRate(ctx)
+	if err != nil {
+		return commission, err
+	}
+
+	if newRate.GT(maxCommissionRate) {
+		return commission, errorsmod.Wrapf(types.ErrCommissionGTMaxCommissionRate, "cannot set validator commission to more than maximum rate of %s", maxCommissionRate)
+	}
+
 	commission.Rate = newRate
 	commission.UpdateTime = blockTime
 
@@ -27,7 +27,9 @@ func Migrate(ctx context.Context, keeper paramsKeeper) error {
 			Amount: types.DefaultKeyRotationFeeAmount,
 		}
 	}
-	if err := params.Validate(); err != nil {
+	// the params as a whole are validated by the last migration, as later
+	// params are still missing at this version
+	if err := params.KeyRotationFee.Validate(); err != nil {
 		return err
 	}
 
@@ -0,0 +1,40 @@
+package v7
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type paramsKeeper interface {
+	GetParams(context.Context) (types.Params, error)
+	SetParams(context.Context, types.Params) error
+}
+
+// Migrate adds the GlobalLiquidStakingCap and ValidatorLiquidStakingCap
+// params. Existing chains inherit the default caps, which do not limit the
+// tokenization of delegations.
+func Migrate(ctx context.Context, keeper paramsKeeper) error {
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.GlobalLiquidStakingCap.IsNil() {
+		params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	}
+	if params.ValidatorLiquidStakingCap.IsNil() {
+		params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	}
+	// MaxCommissionRate is only added by the next migration, so the params
+	// are validated with its default value
+	validated := params
+	if validated.MaxCommissionRate.IsNil() {
+		validated.MaxCommissionRate = types.DefaultMaxCommissionRate
+	}
+	if err := validated.Validate(); err != nil {
+		return err
+	}
+
+	return keeper.SetParams(ctx, params)
+}
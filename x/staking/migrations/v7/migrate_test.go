@@ -0,0 +1,78 @@
+package v7
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type mockParamsKeeper struct {
+	params types.Params
+	err    error
+}
+
+func (m *mockParamsKeeper) GetParams(context.Context) (types.Params, error) {
+	return m.params, m.err
+}
+
+func (m *mockParamsKeeper) SetParams(_ context.Context, params types.Params) error {
+	m.params = params
+	return nil
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sets missing liquid staking caps", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.GlobalLiquidStakingCap = math.LegacyDec{}
+		params.ValidatorLiquidStakingCap = math.LegacyDec{}
+		k := &mockParamsKeeper{params: params}
+
+		require.NoError(t, Migrate(ctx, k))
+		require.Equal(t, types.DefaultGlobalLiquidStakingCap, k.params.GlobalLiquidStakingCap)
+		require.Equal(t, types.DefaultValidatorLiquidStakingCap, k.params.ValidatorLiquidStakingCap)
+		require.NoError(t, k.params.Validate())
+	})
+
+	t.Run("preserves pre-existing liquid staking caps", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+		params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+		k := &mockParamsKeeper{params: params}
+
+		require.NoError(t, Migrate(ctx, k))
+		require.Equal(t, math.LegacyNewDecWithPrec(25, 2), k.params.GlobalLiquidStakingCap)
+		require.Equal(t, math.LegacyNewDecWithPrec(5, 1), k.params.ValidatorLiquidStakingCap)
+	})
+
+	t.Run("validates params without the max commission rate", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.MaxCommissionRate = math.LegacyDec{}
+		k := &mockParamsKeeper{params: params}
+
+		require.NoError(t, Migrate(ctx, k))
+		require.True(t, k.params.MaxCommissionRate.IsNil())
+	})
+
+	t.Run("returns invalid existing params error", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.ValidatorLiquidStakingCap = math.LegacyNewDec(2)
+		k := &mockParamsKeeper{params: params}
+
+		err := Migrate(ctx, k)
+		require.Error(t, err)
+	})
+
+	t.Run("returns invalid existing params error for other params", func(t *testing.T) {
+		k := &mockParamsKeeper{}
+
+		err := Migrate(ctx, k)
+		require.Error(t, err)
+	})
+}
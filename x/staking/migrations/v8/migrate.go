@@ -0,0 +1,37 @@
+package v8
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type stakingKeeper interface {
+	GetParams(context.Context) (types.Params, error)
+	SetParams(context.Context, types.Params) error
+	EnforceCommissionRateBounds(context.Context) error
+}
+
+// Migrate adds the MaxCommissionRate param. Existing chains inherit the default
+// max commission rate, which does not limit the commission of validators. The
+// commission rate of the validators below the MinCommissionRate param is then
+// bumped up to it.
+func Migrate(ctx context.Context, keeper stakingKeeper) error {
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MaxCommissionRate.IsNil() {
+		params.MaxCommissionRate = types.DefaultMaxCommissionRate
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	if err := keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	return keeper.EnforceCommissionRateBounds(ctx)
+}
@@ -0,0 +1,63 @@
+package v8
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type mockStakingKeeper struct {
+	params   types.Params
+	err      error
+	enforced bool
+}
+
+func (m *mockStakingKeeper) GetParams(context.Context) (types.Params, error) {
+	return m.params, m.err
+}
+
+func (m *mockStakingKeeper) SetParams(_ context.Context, params types.Params) error {
+	m.params = params
+	return nil
+}
+
+func (m *mockStakingKeeper) EnforceCommissionRateBounds(context.Context) error {
+	m.enforced = true
+	return nil
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sets missing max commission rate", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.MaxCommissionRate = math.LegacyDec{}
+		k := &mockStakingKeeper{params: params}
+
+		require.NoError(t, Migrate(ctx, k))
+		require.Equal(t, types.DefaultMaxCommissionRate, k.params.MaxCommissionRate)
+		require.True(t, k.enforced)
+	})
+
+	t.Run("preserves pre-existing max commission rate", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.MaxCommissionRate = math.LegacyNewDecWithPrec(5, 1)
+		k := &mockStakingKeeper{params: params}
+
+		require.NoError(t, Migrate(ctx, k))
+		require.Equal(t, math.LegacyNewDecWithPrec(5, 1), k.params.MaxCommissionRate)
+	})
+
+	t.Run("returns invalid existing params error", func(t *testing.T) {
+		k := &mockStakingKeeper{}
+
+		err := Migrate(ctx, k)
+		require.Error(t, err)
+		require.False(t, k.enforced)
+	})
+}
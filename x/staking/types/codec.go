@@ -20,6 +20,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbondingDelegation{}, "cosmos-sdk/MsgCancelUnbondingDelegation")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/staking/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgRotateConsPubKey{}, "cosmos-sdk/MsgRotateConsPubKey")
+	legacy.RegisterAminoMsg(cdc, &MsgTokenizeShares{}, "cosmos-sdk/MsgTokenizeShares")
+	legacy.RegisterAminoMsg(cdc, &MsgRedeemTokensForShares{}, "cosmos-sdk/MsgRedeemTokensForShares")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleCommissionChange{}, "cosmos-sdk/MsgScheduleCommissionChange")
 
 	cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
 	cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "cosmos-sdk/StakeAuthorization/AllowList", nil)
@@ -39,6 +42,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCancelUnbondingDelegation{},
 		&MsgUpdateParams{},
 		&MsgRotateConsPubKey{},
+		&MsgTokenizeShares{},
+		&MsgRedeemTokensForShares{},
+		&MsgScheduleCommissionChange{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
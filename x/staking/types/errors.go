@@ -52,4 +52,15 @@ var (
 	ErrConsensusPubKeyAlreadyUsedForValidator = errors.Register(ModuleName, 46, "consensus pubkey is already in use by another validator")
 	ErrConsensusPubKeyInRotationHistory       = errors.Register(ModuleName, 47, "consensus pubkey was previously rotated away from and is still within the unbonding window")
 	ErrExceedingMaxConsPubKeyRotations        = errors.Register(ModuleName, 48, "validator has reached the max consensus pubkey rotations within the unbonding period")
+
+	ErrTokenizeShareRecordNotFound       = errors.Register(ModuleName, 49, "tokenize share record not found")
+	ErrTinyTokenizeSharesAmount          = errors.Register(ModuleName, 50, "too few tokens to tokenize (truncates to zero share tokens)")
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 51, "delegation or tokenization exceeds the global cap on liquid staking")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 52, "delegation or tokenization exceeds the validator cap on liquid staking")
+
+	ErrCommissionGTMaxCommissionRate    = errors.Register(ModuleName, 53, "commission cannot be more than the max commission rate param")
+	ErrCommissionChangeScheduleNotFound = errors.Register(ModuleName, 54, "commission change schedule not found")
+	ErrInvalidCommissionChangeSchedule  = errors.Register(ModuleName, 55, "invalid commission change schedule")
+
+	ErrRedelegationInProgress = errors.Register(ModuleName, 56, "delegator is not allowed to tokenize shares from validator with a redelegation in progress")
 )
@@ -12,6 +12,11 @@ const (
 	EventTypeRedelegate                = "redelegate"
 	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
 	EventTypeApplyConsPubKeyRotation   = "apply_cons_pubkey_rotation"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemShares              = "redeem_tokens_for_shares"
+	EventTypeScheduleCommissionChange  = "schedule_commission_change"
+	EventTypeCommissionChange          = "commission_change"
+	EventTypeCommissionChangeFailed    = "commission_change_failed"
 
 	AttributeKeyValidator            = "validator"
 	AttributeKeyCommissionRate       = "commission_rate"
@@ -29,4 +34,9 @@ const (
 	AttributeKeyEvidenceExpiryTime   = "evidence_expiry_time"
 	AttributeKeyEvidenceExpiryHeight = "evidence_expiry_height"
 	AttributeKeyFeeBurned            = "fee_burned"
+	AttributeKeyShareOwner           = "share_owner"
+	AttributeKeyShareRecordID        = "share_record_id"
+	AttributeKeyShareTokens          = "share_tokens"
+	AttributeKeyEffectiveTime        = "effective_time"
+	AttributeKeyReason               = "reason"
 )
@@ -57,6 +57,13 @@ type GenesisState struct {
 	// pending_consensus_key_rotations defines consensus key rotations whose
 	// SDK-side validator state has not reached its deferred apply height yet.
 	PendingConsensusKeyRotations []PendingConsensusKeyRotation `protobuf:"bytes,10,rep,name=pending_consensus_key_rotations,json=pendingConsensusKeyRotations,proto3" json:"pending_consensus_key_rotations"`
+	// tokenize_share_records defines the records of the tokenized delegations.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,11,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,12,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// commission_change_schedules defines the commission rate changes announced
+	// by validators which have not taken effect yet.
+	CommissionChangeSchedules []CommissionChangeSchedule `protobuf:"bytes,13,rep,name=commission_change_schedules,json=commissionChangeSchedules,proto3" json:"commission_change_schedules"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -155,6 +162,27 @@ func (m *GenesisState) GetPendingConsensusKeyRotations() []PendingConsensusKeyRo
 	return nil
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCommissionChangeSchedules() []CommissionChangeSchedule {
+	if m != nil {
+		return m.CommissionChangeSchedules
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -306,60 +334,66 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 835 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4d, 0x8f, 0xdb, 0x44,
-	0x18, 0xc7, 0xe3, 0xa6, 0xfb, 0x36, 0xd9, 0x45, 0xed, 0x34, 0xa9, 0x4c, 0x16, 0x9c, 0x6c, 0xd4,
-	0x43, 0x54, 0x88, 0x4d, 0x53, 0x4e, 0xdc, 0x36, 0xbc, 0xb5, 0xa2, 0x94, 0x28, 0xed, 0x72, 0x28,
-	0x07, 0x6b, 0x12, 0x0f, 0xce, 0x28, 0xf6, 0x8c, 0xe5, 0x19, 0x67, 0xd7, 0x67, 0x84, 0xc4, 0xb1,
-	0x1f, 0xa1, 0x17, 0x24, 0x8e, 0x20, 0xed, 0x87, 0xa8, 0x38, 0x55, 0x7b, 0x42, 0x1c, 0x16, 0xb4,
-	0x7b, 0x00, 0xbe, 0x05, 0xf2, 0x8c, 0xed, 0x75, 0x5e, 0xbc, 0x12, 0x12, 0x97, 0xdd, 0xd8, 0xcf,
-	0xff, 0xff, 0x7b, 0xfe, 0x33, 0x8f, 0x3d, 0x06, 0xf7, 0x26, 0x8c, 0xfb, 0x8c, 0x5b, 0x5c, 0xa0,
-	0x19, 0xa1, 0xae, 0x35, 0x7f, 0x30, 0xc6, 0x02, 0x3d, 0xb0, 0x5c, 0x4c, 0x31, 0x27, 0xdc, 0x0c,
-	0x42, 0x26, 0x18, 0xbc, 0xab, 0x54, 0x66, 0xaa, 0x32, 0x53, 0x55, 0xb3, 0xee, 0x32, 0x97, 0x49,
-	0x89, 0x95, 0xfc, 0x52, 0xea, 0x66, 0x19, 0x33, 0x73, 0x2b, 0xd5, 0xdb, 0x4a, 0x65, 0x2b, 0x7b,
-	0xda, 0x40, 0x95, 0x6e, 0x23, 0x9f, 0x50, 0x66, 0xc9, 0xbf, 0x99, 0xda, 0x65, 0xcc, 0xf5, 0xb0,
-	0x25, 0xaf, 0xc6, 0xd1, 0xb7, 0x16, 0xa2, 0x71, 0x5a, 0x6a, 0x2d, 0x97, 0x04, 0xf1, 0x31, 0x17,
-	0xc8, 0x0f, 0x94, 0xa0, 0xf3, 0xe3, 0x16, 0xd8, 0xfd, 0x5c, 0xad, 0xe7, 0x99, 0x40, 0x02, 0xc3,
-	0x43, 0xb0, 0x19, 0xa0, 0x10, 0xf9, 0x5c, 0xd7, 0xda, 0x5a, 0xb7, 0xd6, 0x37, 0xcc, 0xf5, 0xeb,
-	0x33, 0x87, 0x52, 0x35, 0xd8, 0x79, 0x7d, 0xde, 0xaa, 0xfc, 0xf4, 0xd7, 0xcf, 0xf7, 0xb5, 0x51,
-	0x6a, 0x84, 0x2f, 0xc0, 0x2d, 0x0f, 0x71, 0x61, 0x0b, 0x26, 0x90, 0x67, 0x07, 0xec, 0x18, 0x87,
-	0xfa, 0x8d, 0xb6, 0xd6, 0xdd, 0x1d, 0x7c, 0x90, 0x88, 0x7f, 0x3f, 0x6f, 0x35, 0x14, 0x93, 0x3b,
-	0x33, 0x93, 0x30, 0xcb, 0x47, 0x62, 0x6a, 0x3e, 0xa6, 0xe2, 0xec, 0xb4, 0x07, 0xd2, 0x66, 0x8f,
-	0xa9, 0x50, 0xcc, 0xb7, 0x12, 0xd2, 0xf3, 0x04, 0x34, 0x4c, 0x38, 0x90, 0x80, 0x86, 0x64, 0xcf,
-	0x91, 0x47, 0x1c, 0x24, 0x58, 0xa8, 0xf8, 0x5c, 0xaf, 0xb6, 0xab, 0xdd, 0x5a, 0xff, 0x7e, 0x59,
-	0xda, 0x27, 0x88, 0x8b, 0xaf, 0x33, 0x8f, 0x44, 0x15, 0x93, 0xdf, 0xf1, 0x56, 0xca, 0x1c, 0x3e,
-	0x01, 0x20, 0xef, 0xc2, 0xf5, 0x9b, 0x92, 0x7f, 0x50, 0xc6, 0xcf, 0xcd, 0x45, 0x6c, 0xc1, 0x0f,
-	0xbf, 0x02, 0x35, 0x07, 0x7b, 0xd8, 0x45, 0x82, 0x30, 0xca, 0xf5, 0x0d, 0x89, 0xeb, 0x94, 0xe1,
-	0x3e, 0xc9, 0xa5, 0x45, 0x5e, 0x91, 0x00, 0x67, 0xa0, 0x11, 0xd1, 0x31, 0xa3, 0x0e, 0xa1, 0xae,
-	0x5d, 0x44, 0x6f, 0x4a, 0xf4, 0x7b, 0x65, 0xe8, 0xa3, 0xcc, 0xb4, 0xbe, 0x47, 0x3d, 0x5a, 0xad,
-	0x73, 0x78, 0x04, 0xf6, 0x42, 0x5c, 0x6c, 0xb2, 0x25, 0x9b, 0xdc, 0x2b, 0x6b, 0x32, 0x2a, 0x88,
-	0x8b, 0xf4, 0x45, 0x0a, 0x6c, 0x82, 0x6d, 0x7c, 0x12, 0xb0, 0x50, 0x60, 0x47, 0xdf, 0x6e, 0x6b,
-	0xdd, 0xed, 0x51, 0x7e, 0x0d, 0xbf, 0xd3, 0x80, 0x31, 0x61, 0x94, 0x63, 0xca, 0x23, 0x6e, 0xcf,
-	0x70, 0x6c, 0x87, 0x4c, 0x48, 0x9f, 0x3d, 0x25, 0x5c, 0xb0, 0x30, 0xd6, 0x77, 0x64, 0x88, 0x87,
-	0x65, 0x21, 0x3e, 0xce, 0xdc, 0x5f, 0xe0, 0x78, 0x94, 0x7a, 0x1f, 0x29, 0x6b, 0x31, 0xd3, 0xfe,
-	0xa4, 0x5c, 0x07, 0xbf, 0xd7, 0x40, 0x2b, 0xc0, 0x6a, 0x93, 0xd7, 0xa7, 0xe1, 0x3a, 0xb8, 0x3e,
-	0xc6, 0x50, 0xd9, 0xd7, 0xa5, 0x29, 0xc6, 0x78, 0x27, 0x28, 0xd7, 0xf1, 0xce, 0x14, 0xc0, 0xd5,
-	0x47, 0x18, 0xf6, 0xc1, 0x16, 0x72, 0x9c, 0x10, 0x73, 0xf5, 0xb6, 0xee, 0x0c, 0xf4, 0xb3, 0xd3,
-	0x5e, 0x3d, 0xcd, 0x71, 0xa8, 0x2a, 0xcf, 0x44, 0x48, 0xa8, 0x3b, 0xca, 0x84, 0xb0, 0x0e, 0x36,
-	0xae, 0x5e, 0xc9, 0xea, 0x48, 0x5d, 0x7c, 0xb4, 0xfd, 0xc3, 0xab, 0x56, 0xe5, 0xef, 0x57, 0xad,
-	0x4a, 0xe7, 0x97, 0x2a, 0xd8, 0xbf, 0x66, 0xe7, 0xe0, 0x53, 0x70, 0xfb, 0xea, 0xe5, 0x5b, 0xec,
-	0x7e, 0x70, 0x76, 0xda, 0x7b, 0x37, 0xed, 0x9e, 0x27, 0x5d, 0x8c, 0x71, 0x6b, 0xbe, 0x74, 0x1f,
-	0x1e, 0x81, 0x06, 0xf3, 0x9c, 0xc2, 0xe6, 0x66, 0xcc, 0x1b, 0x2b, 0xcc, 0x3c, 0xd6, 0x22, 0xf3,
-	0x0e, 0xf3, 0x9c, 0xe5, 0x12, 0x7c, 0x0a, 0xf6, 0x7c, 0x24, 0xa2, 0x90, 0x88, 0xd8, 0x4e, 0x0e,
-	0x3d, 0xbd, 0x2a, 0x8f, 0xb3, 0xa6, 0xa9, 0x4e, 0x44, 0x33, 0x3b, 0x11, 0xcd, 0xe7, 0xd9, 0x89,
-	0x38, 0xd8, 0x4b, 0x86, 0xf1, 0xf2, 0x8f, 0x96, 0xa6, 0x06, 0xb2, 0x9b, 0xf9, 0x13, 0x05, 0xfc,
-	0x06, 0xd4, 0xf1, 0x9c, 0x38, 0x98, 0x4e, 0xb0, 0x8d, 0x4f, 0x02, 0x12, 0xa6, 0xd8, 0x9b, 0xff,
-	0x15, 0x0b, 0x33, 0xcc, 0xa7, 0x92, 0x22, 0xe1, 0x1f, 0x82, 0xbb, 0xcb, 0xf0, 0x29, 0x26, 0xee,
-	0x54, 0xe8, 0x1b, 0x72, 0x48, 0xf5, 0x45, 0xcf, 0x23, 0x59, 0x2b, 0xcc, 0xec, 0x1f, 0x0d, 0xec,
-	0x5f, 0xf3, 0x98, 0xfd, 0xef, 0x33, 0xfb, 0x12, 0x00, 0x8a, 0x8f, 0xed, 0x20, 0x1a, 0xcf, 0x70,
-	0x2c, 0x07, 0x55, 0xeb, 0xd7, 0x57, 0xb6, 0xe0, 0x90, 0xc6, 0x03, 0xfd, 0xd7, 0xab, 0x07, 0x72,
-	0x12, 0xc6, 0x81, 0x60, 0xe6, 0x30, 0x1a, 0x27, 0xd9, 0x76, 0x28, 0x3e, 0x1e, 0x4a, 0x00, 0x3c,
-	0x00, 0xbb, 0x28, 0x08, 0xbc, 0x7c, 0xd1, 0x55, 0xb9, 0xe8, 0x9a, 0xbc, 0xb7, 0xbc, 0xd6, 0xc1,
-	0x67, 0xaf, 0x2f, 0x0c, 0xed, 0xcd, 0x85, 0xa1, 0xfd, 0x79, 0x61, 0x68, 0x2f, 0x2f, 0x8d, 0xca,
-	0x9b, 0x4b, 0xa3, 0xf2, 0xdb, 0xa5, 0x51, 0x79, 0xf1, 0xbe, 0x4b, 0xc4, 0x34, 0x1a, 0x9b, 0x13,
-	0xe6, 0xa7, 0xdf, 0xcc, 0xf4, 0x5f, 0x8f, 0x3b, 0x33, 0xeb, 0x24, 0xff, 0xe6, 0x8a, 0x38, 0xc0,
-	0x7c, 0xbc, 0x29, 0x73, 0x3e, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x41, 0x05, 0xa0, 0xce, 0xe6,
-	0x07, 0x00, 0x00,
+	// 937 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x3f, 0x6f, 0xdb, 0x46,
+	0x18, 0xc6, 0xc5, 0xc8, 0x76, 0xac, 0x93, 0x5c, 0x24, 0x17, 0xd9, 0xa0, 0xed, 0x46, 0x92, 0x8d,
+	0x0c, 0x42, 0x5a, 0x93, 0x89, 0xd3, 0xa9, 0x53, 0xad, 0xf4, 0x4f, 0x8c, 0xa6, 0xa9, 0x20, 0xdb,
+	0x1d, 0xd2, 0x81, 0x38, 0x91, 0x57, 0xf2, 0x20, 0xf2, 0x8e, 0xe0, 0x1d, 0x6d, 0xb3, 0x1d, 0x8b,
+	0x02, 0x1d, 0xf3, 0x11, 0x32, 0x76, 0x6c, 0x01, 0x7f, 0x88, 0xa0, 0x53, 0xe0, 0xa9, 0xe8, 0xe0,
+	0x16, 0xf6, 0xd0, 0xf6, 0x5b, 0x14, 0xbc, 0x23, 0x65, 0xea, 0x0f, 0x0d, 0x14, 0xe8, 0x62, 0x8b,
+	0x7a, 0x9f, 0xe7, 0xf7, 0x3e, 0xa7, 0x97, 0xbc, 0x23, 0x78, 0x60, 0x33, 0x1e, 0x30, 0x6e, 0x72,
+	0x81, 0x46, 0x84, 0xba, 0xe6, 0xf1, 0xe3, 0x21, 0x16, 0xe8, 0xb1, 0xe9, 0x62, 0x8a, 0x39, 0xe1,
+	0x46, 0x18, 0x31, 0xc1, 0xe0, 0x9a, 0x52, 0x19, 0x99, 0xca, 0xc8, 0x54, 0x1b, 0x4d, 0x97, 0xb9,
+	0x4c, 0x4a, 0xcc, 0xf4, 0x93, 0x52, 0x6f, 0x94, 0x31, 0x73, 0xb7, 0x52, 0xad, 0x2b, 0x95, 0xa5,
+	0xec, 0x59, 0x03, 0x55, 0xba, 0x8b, 0x02, 0x42, 0x99, 0x29, 0xff, 0xe6, 0x6a, 0x97, 0x31, 0xd7,
+	0xc7, 0xa6, 0xbc, 0x1a, 0xc6, 0xdf, 0x98, 0x88, 0x26, 0x59, 0xa9, 0x3d, 0x5d, 0x12, 0x24, 0xc0,
+	0x5c, 0xa0, 0x20, 0x54, 0x82, 0xed, 0xf3, 0x1a, 0x68, 0x7c, 0xa6, 0xd6, 0x73, 0x20, 0x90, 0xc0,
+	0x70, 0x0f, 0x2c, 0x85, 0x28, 0x42, 0x01, 0xd7, 0xb5, 0x8e, 0xd6, 0xad, 0xef, 0xb6, 0x8c, 0xf9,
+	0xeb, 0x33, 0xfa, 0x52, 0xd5, 0xab, 0xbd, 0xb9, 0x68, 0x57, 0x7e, 0xfa, 0xeb, 0xe7, 0x87, 0xda,
+	0x20, 0x33, 0xc2, 0x97, 0xe0, 0x8e, 0x8f, 0xb8, 0xb0, 0x04, 0x13, 0xc8, 0xb7, 0x42, 0x76, 0x82,
+	0x23, 0xfd, 0x56, 0x47, 0xeb, 0x36, 0x7a, 0x8f, 0x52, 0xf1, 0xef, 0x17, 0xed, 0x55, 0xc5, 0xe4,
+	0xce, 0xc8, 0x20, 0xcc, 0x0c, 0x90, 0xf0, 0x8c, 0x7d, 0x2a, 0xce, 0xcf, 0x76, 0x40, 0xd6, 0x6c,
+	0x9f, 0x0a, 0xc5, 0x7c, 0x27, 0x25, 0x1d, 0xa6, 0xa0, 0x7e, 0xca, 0x81, 0x04, 0xac, 0x4a, 0xf6,
+	0x31, 0xf2, 0x89, 0x83, 0x04, 0x8b, 0x14, 0x9f, 0xeb, 0xd5, 0x4e, 0xb5, 0x5b, 0xdf, 0x7d, 0x58,
+	0x96, 0xf6, 0x39, 0xe2, 0xe2, 0xab, 0xdc, 0x23, 0x51, 0xc5, 0xe4, 0xf7, 0xfc, 0x99, 0x32, 0x87,
+	0xcf, 0x01, 0x18, 0x77, 0xe1, 0xfa, 0x82, 0xe4, 0x6f, 0x95, 0xf1, 0xc7, 0xe6, 0x22, 0xb6, 0xe0,
+	0x87, 0x5f, 0x82, 0xba, 0x83, 0x7d, 0xec, 0x22, 0x41, 0x18, 0xe5, 0xfa, 0xa2, 0xc4, 0x6d, 0x97,
+	0xe1, 0x3e, 0x1e, 0x4b, 0x8b, 0xbc, 0x22, 0x01, 0x8e, 0xc0, 0x6a, 0x4c, 0x87, 0x8c, 0x3a, 0x84,
+	0xba, 0x56, 0x11, 0xbd, 0x24, 0xd1, 0xef, 0x95, 0xa1, 0x8f, 0x72, 0xd3, 0xfc, 0x1e, 0xcd, 0x78,
+	0xb6, 0xce, 0xe1, 0x11, 0x58, 0x89, 0x70, 0xb1, 0xc9, 0x6d, 0xd9, 0xe4, 0x41, 0x59, 0x93, 0x01,
+	0x76, 0xe6, 0xd2, 0x27, 0x29, 0x70, 0x03, 0x2c, 0xe3, 0xd3, 0x90, 0x45, 0x02, 0x3b, 0xfa, 0x72,
+	0x47, 0xeb, 0x2e, 0x0f, 0xc6, 0xd7, 0xf0, 0x7b, 0x0d, 0xb4, 0x6c, 0x46, 0x39, 0xa6, 0x3c, 0xe6,
+	0xd6, 0x08, 0x27, 0x56, 0xc4, 0x84, 0xf4, 0x59, 0x1e, 0xe1, 0x82, 0x45, 0x89, 0x5e, 0x93, 0x21,
+	0x9e, 0x94, 0x85, 0x78, 0x9a, 0xbb, 0x3f, 0xc7, 0xc9, 0x20, 0xf3, 0x3e, 0x53, 0xd6, 0x62, 0xa6,
+	0x4d, 0xbb, 0x5c, 0x07, 0x7f, 0xd0, 0x40, 0x3b, 0xc4, 0xea, 0x47, 0x9e, 0x9f, 0x86, 0xeb, 0xe0,
+	0xe6, 0x18, 0x7d, 0x65, 0x9f, 0x97, 0xa6, 0x18, 0xe3, 0xdd, 0xb0, 0x5c, 0xc7, 0xa1, 0x0f, 0xd6,
+	0x04, 0x1b, 0x61, 0x4a, 0xbe, 0xc5, 0x16, 0xf7, 0x50, 0x84, 0xad, 0x08, 0xdb, 0x2c, 0x72, 0xb8,
+	0x5e, 0xbf, 0x79, 0xdc, 0x87, 0x99, 0xeb, 0x20, 0x35, 0x0d, 0xa4, 0x67, 0x62, 0xdc, 0x62, 0xb6,
+	0xce, 0xe1, 0x47, 0xe0, 0x7e, 0xf6, 0x04, 0xcf, 0x69, 0x69, 0x11, 0x47, 0x6f, 0x74, 0xb4, 0xee,
+	0xc2, 0x60, 0x5d, 0x3d, 0x9c, 0x33, 0x80, 0x7d, 0x07, 0x7e, 0x07, 0x36, 0x6d, 0x16, 0x04, 0x84,
+	0xf3, 0x74, 0x60, 0xb6, 0x87, 0xa8, 0x8b, 0x2d, 0x6e, 0x7b, 0xd8, 0x89, 0x7d, 0xcc, 0xf5, 0x15,
+	0x19, 0xfa, 0x51, 0xf9, 0xe4, 0x72, 0xeb, 0x53, 0xe9, 0x3c, 0xc8, 0x8c, 0xc5, 0xe4, 0xeb, 0x76,
+	0x89, 0x88, 0x6f, 0x7b, 0x00, 0xce, 0x3e, 0xef, 0x70, 0x17, 0xdc, 0x46, 0x8e, 0x13, 0x61, 0xae,
+	0xb6, 0xb6, 0x5a, 0x4f, 0x3f, 0x3f, 0xdb, 0x69, 0x66, 0x09, 0xf6, 0x54, 0xe5, 0x40, 0x44, 0x84,
+	0xba, 0x83, 0x5c, 0x08, 0x9b, 0x60, 0xf1, 0x7a, 0xff, 0xaa, 0x0e, 0xd4, 0xc5, 0x87, 0xcb, 0x3f,
+	0xbe, 0x6e, 0x57, 0xfe, 0x7e, 0xdd, 0xae, 0x6c, 0xff, 0x52, 0x05, 0x9b, 0x37, 0xdc, 0x66, 0xf0,
+	0x05, 0xb8, 0x7b, 0xbd, 0x53, 0x4d, 0x76, 0xdf, 0x3a, 0x3f, 0xdb, 0xb9, 0x9f, 0x75, 0x1f, 0x27,
+	0x9d, 0x8c, 0x71, 0xe7, 0x78, 0xea, 0x7b, 0x78, 0x04, 0x56, 0x99, 0xef, 0x14, 0xee, 0xc4, 0x9c,
+	0x79, 0x6b, 0x86, 0x39, 0x8e, 0x35, 0xc9, 0xbc, 0xc7, 0x7c, 0x67, 0xba, 0x04, 0x5f, 0x80, 0x95,
+	0x00, 0x89, 0x38, 0x22, 0x22, 0xb1, 0xd2, 0x13, 0x42, 0xaf, 0xca, 0xbd, 0x7f, 0xc3, 0x50, 0xc7,
+	0x87, 0x91, 0x1f, 0x1f, 0xc6, 0x61, 0x7e, 0x7c, 0xf4, 0x56, 0xd2, 0x49, 0xbc, 0xfa, 0xa3, 0xad,
+	0xa9, 0x69, 0x34, 0x72, 0x7f, 0xaa, 0x80, 0x5f, 0x83, 0x26, 0x3e, 0x26, 0x0e, 0xa6, 0x36, 0xb6,
+	0xf0, 0x69, 0x48, 0xa2, 0x0c, 0xbb, 0xf0, 0x5f, 0xb1, 0x30, 0xc7, 0x7c, 0x22, 0x29, 0x12, 0xfe,
+	0x01, 0x58, 0x9b, 0x86, 0x7b, 0x98, 0xb8, 0x9e, 0xd0, 0x17, 0xe5, 0x90, 0x9a, 0x93, 0x9e, 0x67,
+	0xb2, 0x56, 0x98, 0xd9, 0x3f, 0x1a, 0xd8, 0xbc, 0xe1, 0x99, 0xfc, 0xdf, 0x67, 0xf6, 0x05, 0x00,
+	0x14, 0x9f, 0x58, 0x61, 0x3c, 0x1c, 0xe1, 0x44, 0x0e, 0xaa, 0xbe, 0xdb, 0x9c, 0xf9, 0x09, 0xf6,
+	0x68, 0xd2, 0xd3, 0x7f, 0xbd, 0xbe, 0x21, 0xed, 0x28, 0x09, 0x05, 0x33, 0xfa, 0xf1, 0x30, 0xcd,
+	0x56, 0xa3, 0xf8, 0xa4, 0x2f, 0x01, 0x70, 0x0b, 0x34, 0x50, 0x18, 0xfa, 0xe3, 0x45, 0x57, 0xe5,
+	0xa2, 0xeb, 0xf2, 0xbb, 0xe9, 0xb5, 0xf6, 0x3e, 0x7d, 0x73, 0xd9, 0xd2, 0xde, 0x5e, 0xb6, 0xb4,
+	0x3f, 0x2f, 0x5b, 0xda, 0xab, 0xab, 0x56, 0xe5, 0xed, 0x55, 0xab, 0xf2, 0xdb, 0x55, 0xab, 0xf2,
+	0xf2, 0x7d, 0x97, 0x08, 0x2f, 0x1e, 0x1a, 0x36, 0x0b, 0xb2, 0x17, 0x8c, 0xec, 0xdf, 0x0e, 0x77,
+	0x46, 0xe6, 0xe9, 0xf8, 0x05, 0x45, 0x24, 0x21, 0xe6, 0xc3, 0x25, 0x99, 0xf3, 0xc9, 0xbf, 0x03,
+	0x00, 0x78, 0x48, 0x2a, 0x8d, 0x13, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -382,6 +416,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommissionChangeSchedules) > 0 {
+		for iNdEx := len(m.CommissionChangeSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionChangeSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.PendingConsensusKeyRotations) > 0 {
 		for iNdEx := len(m.PendingConsensusKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -719,6 +786,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	if len(m.CommissionChangeSchedules) > 0 {
+		for _, e := range m.CommissionChangeSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1141,6 +1223,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionChangeSchedules = append(m.CommissionChangeSchedules, CommissionChangeSchedule{})
+			if err := m.CommissionChangeSchedules[len(m.CommissionChangeSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
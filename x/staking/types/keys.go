@@ -110,6 +110,15 @@ var (
 	// Key format: 0x95 || FormatTimeBytes(expiryTime) || lengthPrefix(oldConsAddr)
 	// Value:      marshaled ConsKeyEvidenceExpiry
 	ConsKeyEvidenceExpiryQueueKey = []byte{0x95}
+
+	TokenizeShareRecordKey          = []byte{0xA1} // prefix for the tokenize share records, keyed by id
+	TokenizeShareRecordIDByOwnerKey = []byte{0xA2} // prefix for the tokenize share record ids, keyed by (owner, id)
+	TokenizeShareRecordIDByDenomKey = []byte{0xA3} // prefix for the tokenize share record ids, keyed by share denom
+	LastTokenizeShareRecordIDKey    = []byte{0xA4} // key for the id of the last tokenize share record
+	ValidatorLiquidSharesKey        = []byte{0xA5} // prefix for the tokenized delegator shares of each validator
+
+	CommissionChangeScheduleKey = []byte{0xB1} // prefix for the commission change scheduled by each validator
+	CommissionChangeQueueKey    = []byte{0xB2} // prefix for the commission change queue, keyed by (time, valAddr)
 )
 
 // ConsensusUpdateDelay is CometBFT's two-block validator-update delay.
@@ -670,3 +679,78 @@ func ParseConsKeyRotationApplyQueueKey(bz []byte) (int64, sdk.ValAddress, error)
 	kv.AssertKeyAtLeastLength(bz, prefixLen+8+1+valAddrLen)
 	return applyHeight, sdk.ValAddress(bz[prefixLen+8+1 : prefixLen+8+1+valAddrLen]), nil
 }
+
+// GetTokenizeShareRecordKey returns the key of a tokenize share record.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerKey returns the prefix of the tokenize share
+// record ids of an owner.
+func GetTokenizeShareRecordIDsByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerKey, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerKey returns the key of the id of a tokenize
+// share record of an owner.
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordIDByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerKey(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key of the id of the tokenize
+// share record of a share denom.
+// VALUE: record id (uint64)
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomKey, denom...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the tokenized delegator shares
+// of a validator.
+// VALUE: math.LegacyDec
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetCommissionChangeScheduleKey returns the key of the commission change
+// scheduled by a validator.
+// VALUE: staking/CommissionChangeSchedule
+func GetCommissionChangeScheduleKey(valAddr sdk.ValAddress) []byte {
+	return append(CommissionChangeScheduleKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetCommissionChangeQueueKey returns the queue key for a commission change
+// taking effect at the given time.
+// VALUE: none (key rearrangement used)
+func GetCommissionChangeQueueKey(effectiveTime time.Time, valAddr sdk.ValAddress) []byte {
+	return append(GetCommissionChangeQueueTimePrefix(effectiveTime), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetCommissionChangeQueueTimePrefix returns the queue iteration prefix up to
+// the given time.
+func GetCommissionChangeQueueTimePrefix(effectiveTime time.Time) []byte {
+	return append(CommissionChangeQueueKey, sdk.FormatTimeBytes(effectiveTime)...)
+}
+
+// ParseCommissionChangeQueueKey extracts the effective time and validator
+// address from a queue key.
+func ParseCommissionChangeQueueKey(bz []byte) (time.Time, sdk.ValAddress, error) {
+	prefixLen := len(CommissionChangeQueueKey)
+	if prefix := bz[:prefixLen]; !bytes.Equal(prefix, CommissionChangeQueueKey) {
+		return time.Time{}, nil, fmt.Errorf("invalid prefix; expected: %X, got: %X", CommissionChangeQueueKey, prefix)
+	}
+
+	timeLen := len(sdk.SortableTimeFormat)
+	kv.AssertKeyAtLeastLength(bz, prefixLen+timeLen+1)
+
+	ts, err := sdk.ParseTimeBytes(bz[prefixLen : prefixLen+timeLen])
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	valAddrLen := int(bz[prefixLen+timeLen])
+	kv.AssertKeyAtLeastLength(bz, prefixLen+timeLen+1+valAddrLen)
+
+	return ts, sdk.ValAddress(bz[prefixLen+timeLen+1 : prefixLen+timeLen+1+valAddrLen]), nil
+}
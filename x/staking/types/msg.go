@@ -2,6 +2,7 @@ package types
 
 import (
 	"slices"
+	"time"
 
 	"cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
@@ -24,6 +25,9 @@ var (
 	_ sdk.Msg                            = &MsgUpdateParams{}
 	_ sdk.Msg                            = &MsgRotateConsPubKey{}
 	_ codectypes.UnpackInterfacesMessage = (*MsgRotateConsPubKey)(nil)
+	_ sdk.Msg                            = &MsgTokenizeShares{}
+	_ sdk.Msg                            = &MsgRedeemTokensForShares{}
+	_ sdk.Msg                            = &MsgScheduleCommissionChange{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -197,3 +201,30 @@ func NewMsgCancelUnbondingDelegation(delAddr, valAddr string, creationHeight int
 		CreationHeight:   creationHeight,
 	}
 }
+
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+func NewMsgTokenizeShares(delAddr, valAddr string, amount sdk.Coin, owner string) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress:    delAddr,
+		ValidatorAddress:    valAddr,
+		Amount:              amount,
+		TokenizedShareOwner: owner,
+	}
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+func NewMsgRedeemTokensForShares(delAddr string, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgScheduleCommissionChange creates a new MsgScheduleCommissionChange instance.
+func NewMsgScheduleCommissionChange(valAddr string, rate math.LegacyDec, effectiveTime time.Time) *MsgScheduleCommissionChange {
+	return &MsgScheduleCommissionChange{
+		ValidatorAddress: valAddr,
+		Rate:             rate,
+		EffectiveTime:    effectiveTime,
+	}
+}
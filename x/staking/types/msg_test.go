@@ -12,6 +12,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mldsa65"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -76,4 +77,11 @@ func TestValidateConsensusPubKeyType(t *testing.T) {
 	unsupported := secp256k1.GenPrivKey().PubKey()
 	err := types.ValidateConsensusPubKeyType(unsupported, []string{ed25519.KeyType})
 	require.ErrorIs(t, err, types.ErrValidatorPubKeyTypeNotSupported)
+
+	// post-quantum keys are only accepted once enabled in the consensus params
+	mlDsaKey, err := mldsa65.GenPrivKey()
+	require.NoError(t, err)
+	err = types.ValidateConsensusPubKeyType(mlDsaKey.PubKey(), []string{ed25519.KeyType})
+	require.ErrorIs(t, err, types.ErrValidatorPubKeyTypeNotSupported)
+	require.NoError(t, types.ValidateConsensusPubKeyType(mlDsaKey.PubKey(), []string{ed25519.KeyType, mlDsaKey.PubKey().Type()}))
 }
@@ -29,16 +29,29 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultAllowInstantRedelegationFromJailed keeps redelegations from
+	// jailed validators subject to the max entries.
+	DefaultAllowInstantRedelegationFromJailed = false
 )
 
 var (
 	// DefaultMinCommissionRate is set to 0%
 	DefaultMinCommissionRate = math.LegacyZeroDec()
 
+	// DefaultMaxCommissionRate is set to 100%
+	DefaultMaxCommissionRate = math.LegacyOneDec()
+
 	// DefaultKeyRotationFeeAmount is the default amount charged to rotate a
 	// validator's consensus key. The fee denom is always the bond denom, so only
 	// the amount has a fixed default; callers pair it with the active bond denom.
 	DefaultKeyRotationFeeAmount = math.NewInt(1000000)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, no cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, no cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
 )
 
 // NewParams creates a new Params instance
@@ -48,6 +61,9 @@ func NewParams(
 	bondDenom string,
 	minCommissionRate math.LegacyDec,
 	keyRotationFee sdk.Coin,
+	globalLiquidStakingCap, validatorLiquidStakingCap math.LegacyDec,
+	allowInstantRedelegationFromJailed bool,
+	maxCommissionRate math.LegacyDec,
 ) Params {
 	return Params{
 		UnbondingTime:     unbondingTime,
@@ -57,6 +73,12 @@ func NewParams(
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
 		KeyRotationFee:    keyRotationFee,
+
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+
+		AllowInstantRedelegationFromJailed: allowInstantRedelegationFromJailed,
+		MaxCommissionRate:                  maxCommissionRate,
 	}
 }
 
@@ -73,6 +95,10 @@ func DefaultParams() Params {
 		// sdk.DefaultBondDenom is overridden (e.g. init --default-denom);
 		// Validate requires the two denoms to match.
 		sdk.NewCoin(sdk.DefaultBondDenom, DefaultKeyRotationFeeAmount),
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultAllowInstantRedelegationFromJailed,
+		DefaultMaxCommissionRate,
 	)
 }
 
@@ -118,6 +144,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxCommissionRate(p.MaxCommissionRate); err != nil {
+		return err
+	}
+
+	if p.MinCommissionRate.GT(p.MaxCommissionRate) {
+		return fmt.Errorf("minimum commission rate %s cannot be greater than maximum commission rate %s", p.MinCommissionRate, p.MaxCommissionRate)
+	}
+
 	if err := validateHistoricalEntries(p.HistoricalEntries); err != nil {
 		return err
 	}
@@ -126,6 +160,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return fmt.Errorf("global %w", err)
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return fmt.Errorf("validator %w", err)
+	}
+
 	// The rotation fee is charged in this denom, so a validator can only pay it
 	// if it matches the staking denom they already hold.
 	if p.KeyRotationFee.Denom != p.BondDenom {
@@ -232,6 +274,25 @@ func validateMinCommissionRate(i any) error {
 	return nil
 }
 
+func validateMaxCommissionRate(i any) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("maximum commission rate cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("maximum commission rate cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("maximum commission rate cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
 func validateKeyRotationFee(i any) error {
 	v, ok := i.(sdk.Coin)
 	if !ok {
@@ -250,3 +311,22 @@ func validateKeyRotationFee(i any) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(i any) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
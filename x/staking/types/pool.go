@@ -11,10 +11,13 @@ import (
 // - BondedPool -> "bonded_tokens_pool"
 //
 // - KeyRotationFeePool -> "key_rotation_fee_pool"
+//
+// - TokenizeSharesPool -> "tokenize_shares_pool"
 const (
 	NotBondedPoolName      = "not_bonded_tokens_pool"
 	BondedPoolName         = "bonded_tokens_pool"
 	KeyRotationFeePoolName = "key_rotation_fee_pool"
+	TokenizeSharesPoolName = "tokenize_shares_pool"
 )
 
 // NewPool creates a new Pool instance used for queries
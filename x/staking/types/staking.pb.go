@@ -627,6 +627,193 @@ func (m *DVVTriplets) GetTriplets() []DVVTriplet {
 	return nil
 }
 
+// UnbondingQueueSlice defines the unbonding delegations of the unbonding queue
+// completing at a time.
+type UnbondingQueueSlice struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	Pairs          []DVPair  `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs"`
+}
+
+func (m *UnbondingQueueSlice) Reset()         { *m = UnbondingQueueSlice{} }
+func (m *UnbondingQueueSlice) String() string { return proto.CompactTextString(m) }
+func (*UnbondingQueueSlice) ProtoMessage()    {}
+func (*UnbondingQueueSlice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{10}
+}
+func (m *UnbondingQueueSlice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingQueueSlice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingQueueSlice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingQueueSlice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingQueueSlice.Merge(m, src)
+}
+func (m *UnbondingQueueSlice) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingQueueSlice) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingQueueSlice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingQueueSlice proto.InternalMessageInfo
+
+func (m *UnbondingQueueSlice) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func (m *UnbondingQueueSlice) GetPairs() []DVPair {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+// RedelegationQueueSlice defines the redelegations of the redelegation queue
+// completing at a time.
+type RedelegationQueueSlice struct {
+	CompletionTime time.Time    `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	Triplets       []DVVTriplet `protobuf:"bytes,2,rep,name=triplets,proto3" json:"triplets"`
+}
+
+func (m *RedelegationQueueSlice) Reset()         { *m = RedelegationQueueSlice{} }
+func (m *RedelegationQueueSlice) String() string { return proto.CompactTextString(m) }
+func (*RedelegationQueueSlice) ProtoMessage()    {}
+func (*RedelegationQueueSlice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{11}
+}
+func (m *RedelegationQueueSlice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationQueueSlice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationQueueSlice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationQueueSlice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationQueueSlice.Merge(m, src)
+}
+func (m *RedelegationQueueSlice) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationQueueSlice) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationQueueSlice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationQueueSlice proto.InternalMessageInfo
+
+func (m *RedelegationQueueSlice) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func (m *RedelegationQueueSlice) GetTriplets() []DVVTriplet {
+	if m != nil {
+		return m.Triplets
+	}
+	return nil
+}
+
+// PendingEntry defines a pending unbonding delegation or redelegation entry of
+// a delegator.
+type PendingEntry struct {
+	// validator_src_address is the validator of an unbonding delegation or the
+	// source validator of a redelegation.
+	ValidatorSrcAddress string `protobuf:"bytes,1,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
+	// validator_dst_address is the destination validator of a redelegation,
+	// empty for an unbonding delegation.
+	ValidatorDstAddress string `protobuf:"bytes,2,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
+	// creation_height is the height of the unbonding delegation or redelegation.
+	CreationHeight int64 `protobuf:"varint,3,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// completion_time is the time of completion of the entry.
+	CompletionTime time.Time `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// balance is the balance of an unbonding delegation entry or the initial
+	// balance of a redelegation entry.
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+}
+
+func (m *PendingEntry) Reset()         { *m = PendingEntry{} }
+func (m *PendingEntry) String() string { return proto.CompactTextString(m) }
+func (*PendingEntry) ProtoMessage()    {}
+func (*PendingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{12}
+}
+func (m *PendingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingEntry.Merge(m, src)
+}
+func (m *PendingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingEntry proto.InternalMessageInfo
+
+func (m *PendingEntry) GetValidatorSrcAddress() string {
+	if m != nil {
+		return m.ValidatorSrcAddress
+	}
+	return ""
+}
+
+func (m *PendingEntry) GetValidatorDstAddress() string {
+	if m != nil {
+		return m.ValidatorDstAddress
+	}
+	return ""
+}
+
+func (m *PendingEntry) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *PendingEntry) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 // Delegation represents the bond with tokens held by an account. It is
 // owned by one delegator, and is associated with the voting power of one
 // validator.
@@ -643,7 +830,7 @@ func (m *Delegation) Reset()         { *m = Delegation{} }
 func (m *Delegation) String() string { return proto.CompactTextString(m) }
 func (*Delegation) ProtoMessage()    {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{10}
+	return fileDescriptor_64c30c6cf92913c9, []int{13}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +874,7 @@ func (m *UnbondingDelegation) Reset()         { *m = UnbondingDelegation{} }
 func (m *UnbondingDelegation) String() string { return proto.CompactTextString(m) }
 func (*UnbondingDelegation) ProtoMessage()    {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{11}
+	return fileDescriptor_64c30c6cf92913c9, []int{14}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -736,7 +923,7 @@ func (m *UnbondingDelegationEntry) Reset()         { *m = UnbondingDelegationEnt
 func (m *UnbondingDelegationEntry) String() string { return proto.CompactTextString(m) }
 func (*UnbondingDelegationEntry) ProtoMessage()    {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{12}
+	return fileDescriptor_64c30c6cf92913c9, []int{15}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -813,7 +1000,7 @@ func (m *RedelegationEntry) Reset()         { *m = RedelegationEntry{} }
 func (m *RedelegationEntry) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntry) ProtoMessage()    {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{13}
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -887,7 +1074,7 @@ func (m *Redelegation) Reset()         { *m = Redelegation{} }
 func (m *Redelegation) String() string { return proto.CompactTextString(m) }
 func (*Redelegation) ProtoMessage()    {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{14}
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,13 +1119,27 @@ type Params struct {
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
 	// key_rotation_fee is the fee charged when rotating a validator's consensus key.
 	KeyRotationFee types1.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// global_liquid_staking_cap is the maximum fraction of the bonded tokens that
+	// can be tokenized. A cap of 1 disables the check.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator
+	// shares of a validator that can be tokenized.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// allow_instant_redelegation_from_jailed allows redelegations from jailed,
+	// including tombstoned, validators regardless of the max entries. They are
+	// still tracked by redelegation entries until the source validator is
+	// unbonded, for slashing.
+	AllowInstantRedelegationFromJailed bool `protobuf:"varint,10,opt,name=allow_instant_redelegation_from_jailed,json=allowInstantRedelegationFromJailed,proto3" json:"allow_instant_redelegation_from_jailed,omitempty"`
+	// max_commission_rate is a chain-wide maximum commission rate that a
+	// validator can charge their delegators.
+	MaxCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{15}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1009,6 +1210,13 @@ func (m *Params) GetKeyRotationFee() types1.Coin {
 	return types1.Coin{}
 }
 
+func (m *Params) GetAllowInstantRedelegationFromJailed() bool {
+	if m != nil {
+		return m.AllowInstantRedelegationFromJailed
+	}
+	return false
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1020,7 +1228,7 @@ func (m *DelegationResponse) Reset()         { *m = DelegationResponse{} }
 func (m *DelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegationResponse) ProtoMessage()    {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1283,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1123,7 +1331,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1177,7 +1385,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1216,7 +1424,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1268,7 +1476,7 @@ func (m *ConsKeyEvidenceExpiry) Reset()         { *m = ConsKeyEvidenceExpiry{} }
 func (m *ConsKeyEvidenceExpiry) String() string { return proto.CompactTextString(m) }
 func (*ConsKeyEvidenceExpiry) ProtoMessage()    {}
 func (*ConsKeyEvidenceExpiry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{21}
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
 }
 func (m *ConsKeyEvidenceExpiry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1318,6 +1526,139 @@ func (m *ConsKeyEvidenceExpiry) GetExpiryHeight() int64 {
 	return 0
 }
 
+// TokenizeShareRecord is the record of a delegation tokenized into share
+// tokens. The delegation is held by the module account of the record and the
+// share tokens, minted with the denom {validator}/{id}, redeem it pro rata.
+type TokenizeShareRecord struct {
+	// id is the unique id of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address receiving the rewards of the delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the address of the account holding the delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the validator of the delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// CommissionChangeSchedule defines a commission rate change announced by a
+// validator operator, which takes effect at the given time.
+type CommissionChangeSchedule struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rate is the commission rate applied at effective_time.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// effective_time is the time from which the commission rate applies.
+	EffectiveTime time.Time `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *CommissionChangeSchedule) Reset()         { *m = CommissionChangeSchedule{} }
+func (m *CommissionChangeSchedule) String() string { return proto.CompactTextString(m) }
+func (*CommissionChangeSchedule) ProtoMessage()    {}
+func (*CommissionChangeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{26}
+}
+func (m *CommissionChangeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionChangeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionChangeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionChangeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionChangeSchedule.Merge(m, src)
+}
+func (m *CommissionChangeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionChangeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionChangeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionChangeSchedule proto.InternalMessageInfo
+
+func (m *CommissionChangeSchedule) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *CommissionChangeSchedule) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
@@ -1331,6 +1672,9 @@ func init() {
 	proto.RegisterType((*DVPairs)(nil), "cosmos.staking.v1beta1.DVPairs")
 	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.v1beta1.DVVTriplet")
 	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.v1beta1.DVVTriplets")
+	proto.RegisterType((*UnbondingQueueSlice)(nil), "cosmos.staking.v1beta1.UnbondingQueueSlice")
+	proto.RegisterType((*RedelegationQueueSlice)(nil), "cosmos.staking.v1beta1.RedelegationQueueSlice")
+	proto.RegisterType((*PendingEntry)(nil), "cosmos.staking.v1beta1.PendingEntry")
 	proto.RegisterType((*Delegation)(nil), "cosmos.staking.v1beta1.Delegation")
 	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.v1beta1.UnbondingDelegation")
 	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.v1beta1.UnbondingDelegationEntry")
@@ -1343,6 +1687,8 @@ func init() {
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 	proto.RegisterType((*ConsKeyEvidenceExpiry)(nil), "cosmos.staking.v1beta1.ConsKeyEvidenceExpiry")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*CommissionChangeSchedule)(nil), "cosmos.staking.v1beta1.CommissionChangeSchedule")
 }
 
 func init() {
@@ -1350,133 +1696,153 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4f, 0x6c, 0x5b, 0x49,
-	0x19, 0xcf, 0x8b, 0xb3, 0x4e, 0xf2, 0x39, 0x89, 0x9d, 0xe9, 0x3f, 0xd7, 0x65, 0x13, 0xd7, 0x2d,
-	0x6c, 0xb7, 0xbb, 0x71, 0xb6, 0x05, 0xf5, 0x10, 0xd0, 0xa2, 0x3a, 0x76, 0xb7, 0xee, 0xee, 0xba,
-	0xe1, 0x39, 0x09, 0x2c, 0x02, 0x9e, 0xc6, 0xef, 0x8d, 0x9d, 0x21, 0xf6, 0x3c, 0xf3, 0x66, 0x5c,
-	0xe2, 0x3b, 0x87, 0x55, 0x11, 0xd2, 0x4a, 0x48, 0x08, 0x09, 0x55, 0x54, 0xe2, 0xb2, 0xdc, 0xf6,
-	0x50, 0xb8, 0x73, 0x5b, 0x90, 0x90, 0xaa, 0x9e, 0xd0, 0x4a, 0x14, 0xd4, 0x1e, 0x76, 0x05, 0x1c,
-	0x10, 0x27, 0x8e, 0x68, 0xfe, 0xbc, 0x3f, 0x8e, 0x93, 0xb6, 0xe9, 0xae, 0xd0, 0x0a, 0x2e, 0x91,
-	0x67, 0xe6, 0xfb, 0x7e, 0x6f, 0xbe, 0xdf, 0xf7, 0x67, 0xbe, 0x99, 0xc0, 0x79, 0xd7, 0xe7, 0x3d,
-	0x9f, 0xaf, 0x72, 0x81, 0x77, 0x29, 0xeb, 0xac, 0xde, 0xba, 0xd4, 0x22, 0x02, 0x5f, 0x0a, 0xc7,
-	0xe5, 0x7e, 0xe0, 0x0b, 0x1f, 0x9d, 0xd4, 0x52, 0xe5, 0x70, 0xd6, 0x48, 0x15, 0x8e, 0x77, 0xfc,
-	0x8e, 0xaf, 0x44, 0x56, 0xe5, 0x2f, 0x2d, 0x5d, 0x38, 0xdd, 0xf1, 0xfd, 0x4e, 0x97, 0xac, 0xaa,
-	0x51, 0x6b, 0xd0, 0x5e, 0xc5, 0x6c, 0x68, 0x96, 0x96, 0xf6, 0x2f, 0x79, 0x83, 0x00, 0x0b, 0xea,
-	0x33, 0xb3, 0xbe, 0xbc, 0x7f, 0x5d, 0xd0, 0x1e, 0xe1, 0x02, 0xf7, 0xfa, 0x21, 0xb6, 0xde, 0x89,
-	0xa3, 0x3f, 0x6a, 0xb6, 0x65, 0xb0, 0x8d, 0x29, 0x2d, 0xcc, 0x49, 0x64, 0x87, 0xeb, 0xd3, 0x10,
-	0x7b, 0x11, 0xf7, 0x28, 0xf3, 0x57, 0xd5, 0x5f, 0x33, 0xf5, 0x05, 0x41, 0x98, 0x47, 0x82, 0x1e,
-	0x65, 0x62, 0x55, 0x0c, 0xfb, 0x84, 0xeb, 0xbf, 0x66, 0xf5, 0x4c, 0x62, 0x15, 0xb7, 0x5c, 0x9a,
-	0x5c, 0x2c, 0xfd, 0xd4, 0x82, 0x85, 0xeb, 0x94, 0x0b, 0x3f, 0xa0, 0x2e, 0xee, 0xd6, 0x59, 0xdb,
-	0x47, 0x5f, 0x85, 0xf4, 0x0e, 0xc1, 0x1e, 0x09, 0xf2, 0x56, 0xd1, 0xba, 0x90, 0xb9, 0x9c, 0x2f,
-	0xc7, 0x00, 0x65, 0xad, 0x7b, 0x5d, 0xad, 0x57, 0x66, 0x3f, 0x7c, 0xb8, 0x3c, 0xf1, 0xfe, 0xc7,
-	0x1f, 0x5c, 0xb4, 0x6c, 0xa3, 0x82, 0xaa, 0x90, 0xbe, 0x85, 0xbb, 0x9c, 0x88, 0xfc, 0x64, 0x31,
-	0x75, 0x21, 0x73, 0xf9, 0x6c, 0xf9, 0x60, 0xce, 0xcb, 0xdb, 0xb8, 0x4b, 0x3d, 0x2c, 0xfc, 0x51,
-	0x14, 0xad, 0x5b, 0xfa, 0xd9, 0x24, 0x64, 0xd7, 0xfd, 0x5e, 0x8f, 0x72, 0x4e, 0x7d, 0x66, 0x63,
-	0x41, 0x38, 0xba, 0x01, 0x53, 0x01, 0x16, 0x44, 0x6d, 0x6a, 0xb6, 0x72, 0x45, 0x2a, 0x7d, 0xf4,
-	0x70, 0xf9, 0x8c, 0x86, 0xe7, 0xde, 0x6e, 0x99, 0xfa, 0xab, 0x3d, 0x2c, 0x76, 0xca, 0x6f, 0x91,
-	0x0e, 0x76, 0x87, 0x55, 0xe2, 0x3e, 0xb8, 0xb7, 0x02, 0xe6, 0xeb, 0x55, 0xe2, 0xea, 0x2f, 0x28,
-	0x0c, 0xf4, 0x0d, 0x98, 0xe9, 0xe1, 0x3d, 0x47, 0xe1, 0x4d, 0x7e, 0x2a, 0xbc, 0xe9, 0x1e, 0xde,
-	0x93, 0xfb, 0x43, 0xdf, 0x83, 0xac, 0x84, 0x74, 0x77, 0x30, 0xeb, 0x10, 0x8d, 0x9c, 0xfa, 0x54,
-	0xc8, 0xf3, 0x3d, 0xbc, 0xb7, 0xae, 0xd0, 0x24, 0xfe, 0xda, 0xd4, 0x27, 0x77, 0x97, 0xad, 0xd2,
-	0xef, 0x2c, 0x80, 0x98, 0x18, 0x84, 0x21, 0xe7, 0x46, 0x23, 0xf5, 0x51, 0x6e, 0x9c, 0xf6, 0xd2,
-	0x61, 0xbc, 0xef, 0xa3, 0xb5, 0x32, 0x2f, 0xb7, 0x77, 0xff, 0xe1, 0xb2, 0xa5, 0xbf, 0x9a, 0x75,
-	0xc7, 0x68, 0xcf, 0x0c, 0xfa, 0x1e, 0x16, 0xc4, 0x91, 0x31, 0xac, 0xd8, 0xca, 0x5c, 0x2e, 0x94,
-	0x75, 0x80, 0x97, 0xc3, 0x00, 0x2f, 0x6f, 0x86, 0x01, 0xae, 0x01, 0xdf, 0xfb, 0x4b, 0x08, 0x08,
-	0x5a, 0x5b, 0xae, 0x1b, 0x1b, 0xde, 0xb7, 0x20, 0x53, 0x25, 0xdc, 0x0d, 0x68, 0x5f, 0xa6, 0x0c,
-	0xca, 0xc3, 0x74, 0xcf, 0x67, 0x74, 0xd7, 0x04, 0xdc, 0xac, 0x1d, 0x0e, 0x51, 0x01, 0x66, 0xa8,
-	0x47, 0x98, 0xa0, 0x62, 0xa8, 0xdd, 0x64, 0x47, 0x63, 0xa9, 0xf5, 0x43, 0xd2, 0xe2, 0x34, 0xe4,
-	0xd9, 0x0e, 0x87, 0xe8, 0x65, 0xc8, 0x71, 0xe2, 0x0e, 0x02, 0x2a, 0x86, 0x8e, 0xeb, 0x33, 0x81,
-	0x5d, 0x91, 0x9f, 0x52, 0x22, 0xd9, 0x70, 0x7e, 0x5d, 0x4f, 0x4b, 0x10, 0x8f, 0x08, 0x4c, 0xbb,
-	0x3c, 0xff, 0x82, 0x06, 0x31, 0x43, 0xb3, 0xd5, 0x3b, 0xd3, 0x30, 0x1b, 0x05, 0x2a, 0x5a, 0x87,
-	0x9c, 0xdf, 0x27, 0x81, 0xfc, 0xed, 0x60, 0xcf, 0x0b, 0x08, 0xe7, 0x26, 0x1a, 0xf3, 0x0f, 0xee,
-	0xad, 0x1c, 0x37, 0x84, 0x5f, 0xd5, 0x2b, 0x4d, 0x11, 0x50, 0xd6, 0xb1, 0xb3, 0xa1, 0x86, 0x99,
-	0x46, 0xef, 0x48, 0x97, 0x31, 0x4e, 0x18, 0x1f, 0x70, 0xa7, 0x3f, 0x68, 0xed, 0x92, 0xa1, 0x21,
-	0xf5, 0xf8, 0x18, 0xa9, 0x57, 0xd9, 0xb0, 0x92, 0xff, 0x43, 0x0c, 0xed, 0x06, 0xc3, 0xbe, 0xf0,
-	0xcb, 0x1b, 0x83, 0xd6, 0x9b, 0x64, 0x28, 0x5d, 0x65, 0x70, 0x36, 0x14, 0x0c, 0x3a, 0x09, 0xe9,
-	0xef, 0x63, 0xda, 0x25, 0x9e, 0x62, 0x64, 0xc6, 0x36, 0x23, 0xb4, 0x06, 0x69, 0x2e, 0xb0, 0x18,
-	0x70, 0x45, 0xc3, 0xc2, 0xe5, 0xd2, 0x61, 0xb1, 0x51, 0xf1, 0x99, 0xd7, 0x54, 0x92, 0xb6, 0xd1,
-	0x40, 0xeb, 0x90, 0x16, 0xfe, 0x2e, 0x61, 0x86, 0xa0, 0xca, 0x2b, 0x26, 0x9a, 0x4f, 0x8c, 0x47,
-	0x73, 0x9d, 0x89, 0x44, 0x1c, 0xd7, 0x99, 0xb0, 0x8d, 0x2a, 0xfa, 0x0e, 0xe4, 0x3c, 0xd2, 0x25,
-	0x1d, 0xc5, 0x1c, 0xdf, 0xc1, 0x01, 0xe1, 0xf9, 0xb4, 0x82, 0xbb, 0x74, 0xe4, 0xe4, 0xb0, 0xb3,
-	0x11, 0x54, 0x53, 0x21, 0xa1, 0x0d, 0xc8, 0x78, 0x71, 0x38, 0xe5, 0xa7, 0x15, 0x99, 0xe7, 0x0e,
-	0xb3, 0x31, 0x11, 0x79, 0xc9, 0xca, 0x93, 0x84, 0x90, 0x11, 0x34, 0x60, 0x2d, 0x9f, 0x79, 0x94,
-	0x75, 0x9c, 0x1d, 0x42, 0x3b, 0x3b, 0x22, 0x3f, 0x53, 0xb4, 0x2e, 0xa4, 0xec, 0x6c, 0x34, 0x7f,
-	0x5d, 0x4d, 0xa3, 0x0d, 0x58, 0x88, 0x45, 0x55, 0x86, 0xcc, 0x1e, 0x35, 0x43, 0xe6, 0x23, 0x00,
-	0x29, 0x82, 0xde, 0x06, 0x88, 0x73, 0x30, 0x0f, 0x0a, 0xad, 0xf4, 0xf4, 0x6c, 0x4e, 0x1a, 0x93,
-	0x00, 0x40, 0x0c, 0x8e, 0xf5, 0x28, 0x73, 0x38, 0xe9, 0xb6, 0x1d, 0xc3, 0x9c, 0xc4, 0xcd, 0x28,
-	0xfa, 0x5f, 0x3f, 0x82, 0x37, 0x3f, 0xba, 0xb7, 0x92, 0xd5, 0xa3, 0x15, 0xee, 0xed, 0x16, 0x5f,
-	0x2b, 0x7f, 0xe5, 0x8a, 0xbd, 0xd8, 0xa3, 0xac, 0x49, 0xba, 0xed, 0x6a, 0x04, 0x8c, 0xbe, 0x06,
-	0x67, 0x62, 0x42, 0x7c, 0xe6, 0xec, 0xf8, 0x5d, 0xcf, 0x09, 0x48, 0xdb, 0x71, 0xfd, 0x01, 0x13,
-	0xf9, 0x39, 0x45, 0xe3, 0xa9, 0x48, 0xe4, 0x26, 0xbb, 0xee, 0x77, 0x3d, 0x9b, 0xb4, 0xd7, 0xe5,
-	0x32, 0x3a, 0x07, 0x31, 0x1b, 0x0e, 0xf5, 0x78, 0x7e, 0xbe, 0x98, 0xba, 0x30, 0x65, 0xcf, 0x45,
-	0x93, 0x75, 0x8f, 0xaf, 0xcd, 0xbc, 0x7b, 0x77, 0x79, 0xe2, 0x93, 0xbb, 0xcb, 0x13, 0xa5, 0x6b,
-	0x30, 0xb7, 0x8d, 0xbb, 0x26, 0xb5, 0x08, 0x47, 0x57, 0x60, 0x16, 0x87, 0x83, 0xbc, 0x55, 0x4c,
-	0x3d, 0x31, 0x35, 0x63, 0xd1, 0xd2, 0xaf, 0x2d, 0x48, 0x57, 0xb7, 0x37, 0x30, 0x0d, 0x50, 0x0d,
-	0x16, 0xe3, 0x58, 0x7d, 0xd6, 0x2c, 0x8f, 0xc3, 0x3b, 0x4c, 0xf3, 0x06, 0x2c, 0xde, 0x0a, 0x0b,
-	0x47, 0x04, 0xa3, 0x8f, 0x9a, 0xb3, 0x0f, 0xee, 0xad, 0xbc, 0x68, 0x60, 0xa2, 0xe2, 0xb2, 0x0f,
-	0xef, 0xd6, 0xbe, 0xf9, 0x84, 0xcd, 0x37, 0x60, 0x5a, 0x6f, 0x95, 0xa3, 0xaf, 0xc3, 0x0b, 0x7d,
-	0xf9, 0x43, 0x99, 0x9a, 0xb9, 0xbc, 0x74, 0x68, 0xcc, 0x2b, 0xf9, 0x64, 0x84, 0x68, 0xbd, 0xd2,
-	0x8f, 0x27, 0x01, 0xaa, 0xdb, 0xdb, 0x9b, 0x01, 0xed, 0x77, 0x89, 0xf8, 0xac, 0x6c, 0xdf, 0x82,
-	0x13, 0xb1, 0xed, 0x3c, 0x70, 0x8f, 0x6e, 0xff, 0xb1, 0x48, 0xbf, 0x19, 0xb8, 0x07, 0xc2, 0x7a,
-	0x5c, 0x44, 0xb0, 0xa9, 0xa3, 0xc3, 0x56, 0xb9, 0x18, 0x67, 0xf6, 0x5b, 0x90, 0x89, 0xc9, 0xe0,
-	0xa8, 0x0e, 0x33, 0xc2, 0xfc, 0x36, 0x04, 0x97, 0x0e, 0x27, 0x38, 0x54, 0x4b, 0x92, 0x1c, 0xa9,
-	0x97, 0xfe, 0x6d, 0x01, 0x24, 0x72, 0xe4, 0xf3, 0x19, 0x63, 0xa8, 0x0e, 0x69, 0x53, 0x9c, 0x53,
-	0xcf, 0x5b, 0x9c, 0x0d, 0x40, 0x82, 0xd4, 0x9f, 0x4c, 0xc2, 0xb1, 0xad, 0x30, 0x7b, 0x3f, 0xff,
-	0x1c, 0x6c, 0xc1, 0x34, 0x61, 0x22, 0xa0, 0x8a, 0x04, 0xe9, 0xf3, 0xd7, 0x0e, 0xf3, 0xf9, 0x01,
-	0x46, 0xd5, 0x98, 0x08, 0x86, 0xc9, 0x08, 0x08, 0xb1, 0x12, 0x7c, 0xfc, 0x22, 0x05, 0xf9, 0xc3,
-	0x54, 0xd1, 0x4b, 0x90, 0x75, 0x03, 0xa2, 0x26, 0xc2, 0x73, 0xc7, 0x52, 0x05, 0x73, 0x21, 0x9c,
-	0x36, 0xc7, 0x8e, 0x0d, 0xb2, 0x51, 0x93, 0xc1, 0x25, 0x45, 0x9f, 0xaf, 0x33, 0x5b, 0x88, 0x11,
-	0xd4, 0xc1, 0xb3, 0x09, 0x59, 0xca, 0xa8, 0xa0, 0xb8, 0xeb, 0xb4, 0x70, 0x17, 0x33, 0x37, 0xec,
-	0x60, 0x8f, 0x74, 0xe6, 0x2f, 0x18, 0x8c, 0x8a, 0x86, 0x40, 0x35, 0x98, 0x0e, 0xd1, 0xa6, 0x8e,
-	0x8e, 0x16, 0xea, 0xa2, 0xb3, 0x30, 0x97, 0x3c, 0x18, 0x54, 0x37, 0x32, 0x65, 0x67, 0x12, 0xe7,
-	0xc2, 0xd3, 0x4e, 0x9e, 0xf4, 0x13, 0x4f, 0x1e, 0xd3, 0xf0, 0xfd, 0x32, 0x05, 0x8b, 0x36, 0xf1,
-	0xfe, 0xf7, 0xdd, 0xb2, 0x01, 0xa0, 0x53, 0x55, 0x56, 0x52, 0xe3, 0x99, 0xe7, 0xc8, 0xf7, 0x59,
-	0x0d, 0x52, 0xe5, 0xe2, 0xbf, 0xe5, 0xa1, 0x3f, 0x4f, 0xc2, 0x5c, 0xd2, 0x43, 0xff, 0x97, 0x87,
-	0x16, 0x6a, 0xc4, 0x65, 0x6a, 0x4a, 0x95, 0xa9, 0x97, 0x0f, 0x2b, 0x53, 0x63, 0xd1, 0xfc, 0x94,
-	0xfa, 0xf4, 0x8f, 0x14, 0xa4, 0x37, 0x70, 0x80, 0x7b, 0x1c, 0xdd, 0x1c, 0xeb, 0x6d, 0xf5, 0xdd,
-	0xf2, 0xf4, 0x58, 0x30, 0x57, 0xcd, 0xf3, 0x87, 0x8e, 0xe5, 0x9f, 0x1f, 0xd6, 0xda, 0x7e, 0x11,
-	0x16, 0xe4, 0x1d, 0x39, 0x32, 0x48, 0x93, 0x3b, 0xaf, 0xae, 0xba, 0x91, 0xf5, 0x1c, 0x2d, 0x43,
-	0x46, 0x8a, 0xc5, 0x75, 0x58, 0xca, 0x40, 0x0f, 0xef, 0xd5, 0xf4, 0x0c, 0x5a, 0x01, 0xb4, 0x13,
-	0xbd, 0x59, 0x38, 0x31, 0x11, 0x52, 0x6e, 0x31, 0x5e, 0x09, 0xc5, 0x5f, 0x04, 0x90, 0xbb, 0x70,
-	0x3c, 0xc2, 0xfc, 0x9e, 0xb9, 0xe8, 0xcd, 0xca, 0x99, 0xaa, 0x9c, 0x40, 0x3f, 0xb2, 0x74, 0x8b,
-	0xbc, 0xef, 0x26, 0x6d, 0x6e, 0x28, 0x9b, 0xcf, 0x90, 0x14, 0xff, 0x7a, 0xb8, 0x5c, 0x18, 0xe2,
-	0x5e, 0x77, 0xad, 0x74, 0x00, 0x4e, 0xe9, 0xa0, 0xcb, 0xbd, 0x6c, 0x9c, 0x47, 0x6f, 0xe2, 0xa8,
-	0x01, 0xb9, 0x5d, 0x32, 0x74, 0x02, 0x5f, 0xe8, 0x42, 0xd3, 0x26, 0xc4, 0xdc, 0x65, 0x4e, 0x87,
-	0xbe, 0x6d, 0x61, 0x4e, 0x12, 0xad, 0x3f, 0x1d, 0x69, 0xfa, 0x17, 0x76, 0xc9, 0xd0, 0x36, 0xca,
-	0xd7, 0x08, 0x59, 0x3b, 0x2f, 0xd3, 0xe5, 0xf6, 0xc7, 0x1f, 0x5c, 0x3c, 0x13, 0x77, 0xed, 0xab,
-	0x7b, 0xd1, 0x0b, 0x99, 0xf6, 0xb1, 0xec, 0x7c, 0x51, 0x7c, 0x0a, 0xd9, 0x84, 0xf7, 0xe5, 0xa5,
-	0x52, 0x5e, 0x42, 0x12, 0x97, 0x05, 0xeb, 0xc9, 0x97, 0x90, 0x58, 0x7f, 0xe4, 0x12, 0x92, 0xc8,
-	0xd1, 0xd7, 0xe3, 0x43, 0x60, 0xf2, 0x08, 0x26, 0x85, 0x4a, 0x2a, 0xf5, 0x27, 0x4a, 0x7f, 0xb4,
-	0xe0, 0xf4, 0x58, 0x38, 0x47, 0x5b, 0x76, 0x01, 0x05, 0x89, 0x45, 0x15, 0x16, 0x43, 0xb3, 0xf5,
-	0xe7, 0xcb, 0x8e, 0xc5, 0x60, 0xec, 0x24, 0xf8, 0x6c, 0x4e, 0x33, 0x53, 0xca, 0x7e, 0x6f, 0xc1,
-	0xf1, 0xe4, 0x06, 0x22, 0x53, 0x9a, 0x30, 0x97, 0xfc, 0xb4, 0x31, 0xe2, 0xfc, 0xb3, 0x18, 0x91,
-	0xdc, 0xff, 0x08, 0x08, 0xda, 0x8e, 0x4b, 0x86, 0x7e, 0x9a, 0xbb, 0xf4, 0xcc, 0xa4, 0x84, 0x1b,
-	0x3b, 0xb0, 0x74, 0x68, 0xdf, 0xfc, 0xdd, 0x82, 0xa9, 0x0d, 0xdf, 0xef, 0xa2, 0x1f, 0xc0, 0x22,
-	0xf3, 0x85, 0x23, 0xd3, 0x8b, 0x78, 0x8e, 0x79, 0x3b, 0xd0, 0xe5, 0xb8, 0xf6, 0x44, 0xae, 0xfe,
-	0xf6, 0x70, 0x79, 0x5c, 0x73, 0x94, 0x40, 0xf3, 0x44, 0xc5, 0x7c, 0x51, 0x51, 0x42, 0x9b, 0xfa,
-	0x79, 0xa1, 0x0d, 0xf3, 0xa3, 0x9f, 0xd3, 0x25, 0xfb, 0xea, 0xd3, 0x3e, 0x37, 0xff, 0xd4, 0x4f,
-	0xcd, 0xb5, 0x12, 0xdf, 0x59, 0x9b, 0x91, 0x5e, 0xfb, 0xa7, 0xf4, 0xdc, 0x3b, 0x90, 0x8b, 0xea,
-	0xd5, 0x96, 0x7a, 0xdf, 0xe2, 0x32, 0x34, 0xf4, 0x53, 0x57, 0x78, 0x5b, 0x28, 0x26, 0xdf, 0x4d,
-	0x71, 0xcb, 0xa5, 0xe5, 0x7d, 0x3a, 0x23, 0x74, 0x1a, 0xdd, 0xd2, 0x6f, 0x2c, 0x38, 0xb1, 0xee,
-	0x33, 0xfe, 0x26, 0x19, 0xd6, 0x6e, 0x51, 0x8f, 0x30, 0x97, 0xd4, 0xf6, 0xfa, 0x34, 0x18, 0xa2,
-	0x57, 0x0e, 0x6a, 0x75, 0x25, 0xb3, 0x73, 0x07, 0xf4, 0xb1, 0x37, 0x20, 0x43, 0x94, 0xda, 0xf3,
-	0x3e, 0xdb, 0x69, 0x6d, 0x55, 0xb6, 0xcf, 0xc1, 0xbc, 0xc1, 0x32, 0xcd, 0x4f, 0x4a, 0x1d, 0xd4,
-	0x73, 0x7a, 0x52, 0xb7, 0x3e, 0x17, 0x7f, 0x6b, 0x01, 0xc4, 0xef, 0x47, 0xe8, 0x55, 0x38, 0x55,
-	0xb9, 0xd9, 0xa8, 0x3a, 0xcd, 0xcd, 0xab, 0x9b, 0x5b, 0x4d, 0x67, 0xab, 0xd1, 0xdc, 0xa8, 0xad,
-	0xd7, 0xaf, 0xd5, 0x6b, 0xd5, 0xdc, 0x44, 0x21, 0x7b, 0xfb, 0x4e, 0x31, 0xb3, 0xc5, 0x78, 0x9f,
-	0xb8, 0xb4, 0x4d, 0x89, 0x87, 0xbe, 0x04, 0xc7, 0x47, 0xa5, 0xe5, 0xa8, 0x56, 0xcd, 0x59, 0x85,
-	0xb9, 0xdb, 0x77, 0x8a, 0x33, 0xba, 0x5f, 0x26, 0x1e, 0xba, 0x00, 0x27, 0xc6, 0xe5, 0xea, 0x8d,
-	0x37, 0x72, 0x93, 0x85, 0xf9, 0xdb, 0x77, 0x8a, 0xb3, 0x51, 0x63, 0x8d, 0x4a, 0x80, 0x92, 0x92,
-	0x06, 0x2f, 0x55, 0x80, 0xdb, 0x77, 0x8a, 0x69, 0x1d, 0x3d, 0x85, 0xa9, 0x77, 0x7f, 0xb5, 0x34,
-	0x71, 0xf1, 0xbb, 0x00, 0x75, 0xd6, 0x0e, 0xb0, 0xab, 0xb2, 0xa4, 0x00, 0x27, 0xeb, 0x8d, 0x6b,
-	0xf6, 0xd5, 0xf5, 0xcd, 0xfa, 0xcd, 0xc6, 0xe8, 0xb6, 0xf7, 0xad, 0x55, 0x6f, 0x6e, 0x55, 0xde,
-	0xaa, 0x39, 0xcd, 0xfa, 0x1b, 0x8d, 0x9c, 0x85, 0x4e, 0xc1, 0xb1, 0x91, 0xb5, 0x6f, 0x36, 0x36,
-	0xeb, 0x6f, 0xd7, 0x72, 0x93, 0x95, 0x6b, 0x1f, 0x3e, 0x5a, 0xb2, 0xee, 0x3f, 0x5a, 0xb2, 0xfe,
-	0xfa, 0x68, 0xc9, 0x7a, 0xef, 0xf1, 0xd2, 0xc4, 0xfd, 0xc7, 0x4b, 0x13, 0x7f, 0x7a, 0xbc, 0x34,
-	0xf1, 0xed, 0x57, 0x3b, 0x54, 0xec, 0x0c, 0x5a, 0x65, 0xd7, 0xef, 0x99, 0xff, 0x00, 0xac, 0x1e,
-	0x58, 0xa9, 0xd5, 0x93, 0x7b, 0x2b, 0xad, 0x7c, 0xf6, 0xe5, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x03, 0x6f, 0x2e, 0xf4, 0xea, 0x18, 0x00, 0x00,
+	// 2326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0x34, 0x25, 0x3d, 0x4a, 0xa2, 0x34, 0xf2, 0x07, 0x25, 0x27, 0x92, 0x4c, 0xfb,
+	0x9f, 0x38, 0x4e, 0x44, 0xc5, 0xfe, 0x17, 0x3e, 0xa8, 0x45, 0x0c, 0x49, 0xa4, 0x62, 0x3a, 0x8e,
+	0xac, 0x2c, 0x25, 0xb5, 0x29, 0xda, 0x2e, 0x86, 0xbb, 0x43, 0x6a, 0xaa, 0xe5, 0x2e, 0xbd, 0x3b,
+	0x94, 0xc5, 0x9e, 0x7a, 0xe8, 0xc1, 0x70, 0x51, 0x20, 0x40, 0x8b, 0xa2, 0x40, 0x61, 0xd4, 0x40,
+	0x2f, 0x69, 0x4f, 0x39, 0xb8, 0xed, 0xb9, 0xb7, 0xb4, 0x40, 0x01, 0xc3, 0xa7, 0x22, 0x40, 0xdc,
+	0xc2, 0x3e, 0x24, 0x68, 0x2f, 0x45, 0x4f, 0x3d, 0x16, 0xf3, 0xb1, 0x1f, 0x94, 0x48, 0xeb, 0xcb,
+	0x29, 0x82, 0xf6, 0x42, 0x70, 0x66, 0xde, 0xfb, 0xcd, 0xcc, 0x6f, 0xde, 0x7b, 0x33, 0xef, 0x2d,
+	0x5c, 0x30, 0x5d, 0xbf, 0xe1, 0xfa, 0x73, 0x3e, 0xc3, 0x5b, 0xd4, 0xa9, 0xcf, 0x6d, 0x5f, 0xae,
+	0x12, 0x86, 0x2f, 0x07, 0xed, 0x42, 0xd3, 0x73, 0x99, 0x8b, 0x4e, 0x4b, 0xa9, 0x42, 0xd0, 0xab,
+	0xa4, 0x26, 0x4f, 0xd6, 0xdd, 0xba, 0x2b, 0x44, 0xe6, 0xf8, 0x3f, 0x29, 0x3d, 0x39, 0x51, 0x77,
+	0xdd, 0xba, 0x4d, 0xe6, 0x44, 0xab, 0xda, 0xaa, 0xcd, 0x61, 0xa7, 0xad, 0x86, 0xa6, 0x76, 0x0f,
+	0x59, 0x2d, 0x0f, 0x33, 0xea, 0x3a, 0x6a, 0x7c, 0x7a, 0xf7, 0x38, 0xa3, 0x0d, 0xe2, 0x33, 0xdc,
+	0x68, 0x06, 0xd8, 0x72, 0x25, 0x86, 0x9c, 0x54, 0x2d, 0x4b, 0x61, 0xab, 0xad, 0x54, 0xb1, 0x4f,
+	0xc2, 0x7d, 0x98, 0x2e, 0x0d, 0xb0, 0xc7, 0x70, 0x83, 0x3a, 0xee, 0x9c, 0xf8, 0x55, 0x5d, 0x2f,
+	0x31, 0xe2, 0x58, 0xc4, 0x6b, 0x50, 0x87, 0xcd, 0xb1, 0x76, 0x93, 0xf8, 0xf2, 0x57, 0x8d, 0x9e,
+	0x8d, 0x8d, 0xe2, 0xaa, 0x49, 0xe3, 0x83, 0xf9, 0x1f, 0x6b, 0x30, 0x72, 0x9d, 0xfa, 0xcc, 0xf5,
+	0xa8, 0x89, 0xed, 0xb2, 0x53, 0x73, 0xd1, 0x57, 0x21, 0xbd, 0x49, 0xb0, 0x45, 0xbc, 0x9c, 0x36,
+	0xa3, 0x5d, 0xcc, 0x5c, 0xc9, 0x15, 0x22, 0x80, 0x82, 0xd4, 0xbd, 0x2e, 0xc6, 0x17, 0x07, 0x3f,
+	0x7e, 0x32, 0xdd, 0xf7, 0xe1, 0x67, 0x1f, 0x5d, 0xd2, 0x74, 0xa5, 0x82, 0x8a, 0x90, 0xde, 0xc6,
+	0xb6, 0x4f, 0x58, 0x2e, 0x31, 0x93, 0xbc, 0x98, 0xb9, 0x72, 0xae, 0xd0, 0x9d, 0xf3, 0xc2, 0x06,
+	0xb6, 0xa9, 0x85, 0x99, 0xdb, 0x89, 0x22, 0x75, 0xf3, 0x3f, 0x4d, 0x40, 0x76, 0xc9, 0x6d, 0x34,
+	0xa8, 0xef, 0x53, 0xd7, 0xd1, 0x31, 0x23, 0x3e, 0xba, 0x01, 0x29, 0x0f, 0x33, 0x22, 0x16, 0x35,
+	0xb8, 0x78, 0x95, 0x2b, 0x7d, 0xf2, 0x64, 0xfa, 0xac, 0x84, 0xf7, 0xad, 0xad, 0x02, 0x75, 0xe7,
+	0x1a, 0x98, 0x6d, 0x16, 0x6e, 0x92, 0x3a, 0x36, 0xdb, 0x45, 0x62, 0x3e, 0x7e, 0x38, 0x0b, 0x6a,
+	0xf6, 0x22, 0x31, 0xe5, 0x0c, 0x02, 0x03, 0xbd, 0x07, 0x03, 0x0d, 0xbc, 0x63, 0x08, 0xbc, 0xc4,
+	0xb1, 0xf0, 0xfa, 0x1b, 0x78, 0x87, 0xaf, 0x0f, 0x7d, 0x07, 0xb2, 0x1c, 0xd2, 0xdc, 0xc4, 0x4e,
+	0x9d, 0x48, 0xe4, 0xe4, 0xb1, 0x90, 0x87, 0x1b, 0x78, 0x67, 0x49, 0xa0, 0x71, 0xfc, 0xf9, 0xd4,
+	0xe7, 0x0f, 0xa6, 0xb5, 0xfc, 0xef, 0x35, 0x80, 0x88, 0x18, 0x84, 0x61, 0xd4, 0x0c, 0x5b, 0x62,
+	0x52, 0x5f, 0x1d, 0xda, 0xab, 0xbd, 0x78, 0xdf, 0x45, 0xeb, 0xe2, 0x30, 0x5f, 0xde, 0xa3, 0x27,
+	0xd3, 0x9a, 0x9c, 0x35, 0x6b, 0xee, 0xa1, 0x3d, 0xd3, 0x6a, 0x5a, 0x98, 0x11, 0x83, 0xdb, 0xb0,
+	0x60, 0x2b, 0x73, 0x65, 0xb2, 0x20, 0x0d, 0xbc, 0x10, 0x18, 0x78, 0x61, 0x2d, 0x30, 0x70, 0x09,
+	0xf8, 0xc1, 0x5f, 0x02, 0x40, 0x90, 0xda, 0x7c, 0x5c, 0xed, 0xe1, 0x43, 0x0d, 0x32, 0x45, 0xe2,
+	0x9b, 0x1e, 0x6d, 0x72, 0x97, 0x41, 0x39, 0xe8, 0x6f, 0xb8, 0x0e, 0xdd, 0x52, 0x06, 0x37, 0xa8,
+	0x07, 0x4d, 0x34, 0x09, 0x03, 0xd4, 0x22, 0x0e, 0xa3, 0xac, 0x2d, 0x8f, 0x49, 0x0f, 0xdb, 0x5c,
+	0xeb, 0x0e, 0xa9, 0xfa, 0x34, 0xe0, 0x59, 0x0f, 0x9a, 0xe8, 0x35, 0x18, 0xf5, 0x89, 0xd9, 0xf2,
+	0x28, 0x6b, 0x1b, 0xa6, 0xeb, 0x30, 0x6c, 0xb2, 0x5c, 0x4a, 0x88, 0x64, 0x83, 0xfe, 0x25, 0xd9,
+	0xcd, 0x41, 0x2c, 0xc2, 0x30, 0xb5, 0xfd, 0xdc, 0x09, 0x09, 0xa2, 0x9a, 0x6a, 0xa9, 0xf7, 0xfb,
+	0x61, 0x30, 0x34, 0x54, 0xb4, 0x04, 0xa3, 0x6e, 0x93, 0x78, 0xfc, 0xbf, 0x81, 0x2d, 0xcb, 0x23,
+	0xbe, 0xaf, 0xac, 0x31, 0xf7, 0xf8, 0xe1, 0xec, 0x49, 0x45, 0xf8, 0x82, 0x1c, 0xa9, 0x30, 0x8f,
+	0x3a, 0x75, 0x3d, 0x1b, 0x68, 0xa8, 0x6e, 0xf4, 0x3e, 0x3f, 0x32, 0xc7, 0x27, 0x8e, 0xdf, 0xf2,
+	0x8d, 0x66, 0xab, 0xba, 0x45, 0xda, 0x8a, 0xd4, 0x93, 0x7b, 0x48, 0x5d, 0x70, 0xda, 0x8b, 0xb9,
+	0x3f, 0x46, 0xd0, 0xa6, 0xd7, 0x6e, 0x32, 0xb7, 0xb0, 0xda, 0xaa, 0xbe, 0x43, 0xda, 0x7a, 0x36,
+	0xc4, 0x59, 0x15, 0x30, 0xe8, 0x34, 0xa4, 0xbf, 0x8b, 0xa9, 0x4d, 0x2c, 0xc1, 0xc8, 0x80, 0xae,
+	0x5a, 0x68, 0x1e, 0xd2, 0x3e, 0xc3, 0xac, 0xe5, 0x0b, 0x1a, 0x46, 0xae, 0xe4, 0x7b, 0xd9, 0xc6,
+	0xa2, 0xeb, 0x58, 0x15, 0x21, 0xa9, 0x2b, 0x0d, 0xb4, 0x04, 0x69, 0xe6, 0x6e, 0x11, 0x47, 0x11,
+	0xb4, 0xf8, 0xba, 0xb2, 0xe6, 0x53, 0x7b, 0xad, 0xb9, 0xec, 0xb0, 0x98, 0x1d, 0x97, 0x1d, 0xa6,
+	0x2b, 0x55, 0xf4, 0x2d, 0x18, 0xb5, 0x88, 0x4d, 0xea, 0x82, 0x39, 0x7f, 0x13, 0x7b, 0xc4, 0xcf,
+	0xa5, 0x05, 0xdc, 0xe5, 0x43, 0x3b, 0x87, 0x9e, 0x0d, 0xa1, 0x2a, 0x02, 0x09, 0xad, 0x42, 0xc6,
+	0x8a, 0xcc, 0x29, 0xd7, 0x2f, 0xc8, 0x3c, 0xdf, 0x6b, 0x8f, 0x31, 0xcb, 0x8b, 0x47, 0x9e, 0x38,
+	0x04, 0xb7, 0xa0, 0x96, 0x53, 0x75, 0x1d, 0x8b, 0x3a, 0x75, 0x63, 0x93, 0xd0, 0xfa, 0x26, 0xcb,
+	0x0d, 0xcc, 0x68, 0x17, 0x93, 0x7a, 0x36, 0xec, 0xbf, 0x2e, 0xba, 0xd1, 0x2a, 0x8c, 0x44, 0xa2,
+	0xc2, 0x43, 0x06, 0x0f, 0xeb, 0x21, 0xc3, 0x21, 0x00, 0x17, 0x41, 0xef, 0x02, 0x44, 0x3e, 0x98,
+	0x03, 0x81, 0x96, 0xdf, 0xdf, 0x9b, 0xe3, 0x9b, 0x89, 0x01, 0x20, 0x07, 0xc6, 0x1b, 0xd4, 0x31,
+	0x7c, 0x62, 0xd7, 0x0c, 0xc5, 0x1c, 0xc7, 0xcd, 0x08, 0xfa, 0xdf, 0x3a, 0xc4, 0x69, 0x7e, 0xf2,
+	0x70, 0x36, 0x2b, 0x5b, 0xb3, 0xbe, 0xb5, 0x35, 0xf3, 0x66, 0xe1, 0x2b, 0x57, 0xf5, 0xb1, 0x06,
+	0x75, 0x2a, 0xc4, 0xae, 0x15, 0x43, 0x60, 0xf4, 0x35, 0x38, 0x1b, 0x11, 0xe2, 0x3a, 0xc6, 0xa6,
+	0x6b, 0x5b, 0x86, 0x47, 0x6a, 0x86, 0xe9, 0xb6, 0x1c, 0x96, 0x1b, 0x12, 0x34, 0x9e, 0x09, 0x45,
+	0x6e, 0x39, 0xd7, 0x5d, 0xdb, 0xd2, 0x49, 0x6d, 0x89, 0x0f, 0xa3, 0xf3, 0x10, 0xb1, 0x61, 0x50,
+	0xcb, 0xcf, 0x0d, 0xcf, 0x24, 0x2f, 0xa6, 0xf4, 0xa1, 0xb0, 0xb3, 0x6c, 0xf9, 0xf3, 0x03, 0x77,
+	0x1f, 0x4c, 0xf7, 0x7d, 0xfe, 0x60, 0xba, 0x2f, 0xbf, 0x0c, 0x43, 0x1b, 0xd8, 0x56, 0xae, 0x45,
+	0x7c, 0x74, 0x15, 0x06, 0x71, 0xd0, 0xc8, 0x69, 0x33, 0xc9, 0xe7, 0xba, 0x66, 0x24, 0x9a, 0xff,
+	0x95, 0x06, 0xe9, 0xe2, 0xc6, 0x2a, 0xa6, 0x1e, 0x2a, 0xc1, 0x58, 0x64, 0xab, 0x07, 0xf5, 0xf2,
+	0xc8, 0xbc, 0x03, 0x37, 0x5f, 0x81, 0xb1, 0xed, 0x20, 0x70, 0x84, 0x30, 0xf2, 0xaa, 0x39, 0xf7,
+	0xf8, 0xe1, 0xec, 0xcb, 0x0a, 0x26, 0x0c, 0x2e, 0xbb, 0xf0, 0xb6, 0x77, 0xf5, 0xc7, 0xf6, 0x7c,
+	0x03, 0xfa, 0xe5, 0x52, 0x7d, 0x74, 0x0d, 0x4e, 0x34, 0xf9, 0x1f, 0xb1, 0xd5, 0xcc, 0x95, 0xa9,
+	0x9e, 0x36, 0x2f, 0xe4, 0xe3, 0x16, 0x22, 0xf5, 0xf2, 0x3f, 0x4c, 0x00, 0x14, 0x37, 0x36, 0xd6,
+	0x3c, 0xda, 0xb4, 0x09, 0x7b, 0x51, 0x7b, 0x5f, 0x87, 0x53, 0xd1, 0xde, 0x7d, 0xcf, 0x3c, 0xfc,
+	0xfe, 0xc7, 0x43, 0xfd, 0x8a, 0x67, 0x76, 0x85, 0xb5, 0x7c, 0x16, 0xc2, 0x26, 0x0f, 0x0f, 0x5b,
+	0xf4, 0xd9, 0x5e, 0x66, 0xbf, 0x01, 0x99, 0x88, 0x0c, 0x1f, 0x95, 0x61, 0x80, 0xa9, 0xff, 0x8a,
+	0xe0, 0x7c, 0x6f, 0x82, 0x03, 0xb5, 0x38, 0xc9, 0xa1, 0x7a, 0xfe, 0xd7, 0x1a, 0x8c, 0xaf, 0x07,
+	0x26, 0xfc, 0x5e, 0x8b, 0xb4, 0x48, 0xc5, 0xa6, 0x26, 0x41, 0x3a, 0xf0, 0xfb, 0x96, 0xcb, 0xf0,
+	0xfb, 0x5b, 0x84, 0x0f, 0xed, 0xb0, 0xe1, 0x63, 0x24, 0x42, 0x10, 0xf1, 0x23, 0x34, 0x8a, 0xc4,
+	0x11, 0x8d, 0xe2, 0x77, 0x1a, 0x9c, 0xd6, 0x49, 0x14, 0x2b, 0xbe, 0xe0, 0xf5, 0xc6, 0x69, 0x4e,
+	0x1c, 0x8f, 0xe6, 0xef, 0x27, 0x61, 0x68, 0x95, 0x08, 0x92, 0x4b, 0x0e, 0xf3, 0xda, 0xbd, 0x2d,
+	0x51, 0xfb, 0x62, 0x2c, 0x31, 0x71, 0x1c, 0x4b, 0x44, 0xaf, 0x42, 0xd6, 0xf4, 0x88, 0xe0, 0x3c,
+	0xb8, 0x75, 0x92, 0x22, 0x5c, 0x8e, 0x04, 0xdd, 0xea, 0xd2, 0xe9, 0x72, 0x0c, 0xa9, 0xe3, 0x1e,
+	0x43, 0x09, 0xfa, 0xab, 0xd8, 0xc6, 0x8e, 0x49, 0x8e, 0x72, 0xd3, 0x07, 0xba, 0xf9, 0x7f, 0x69,
+	0x00, 0xb1, 0xdb, 0xe0, 0xcb, 0x19, 0x4d, 0x51, 0x19, 0xd2, 0xea, 0x19, 0x92, 0x3c, 0xea, 0x33,
+	0x44, 0x01, 0xc4, 0xc2, 0xc7, 0x8f, 0x12, 0x31, 0x27, 0xff, 0xf2, 0x73, 0xb0, 0x0e, 0xfd, 0xc4,
+	0x61, 0x1e, 0x15, 0x24, 0x70, 0xb7, 0x7b, 0xb3, 0x97, 0xdb, 0x75, 0xd9, 0x94, 0x70, 0xaf, 0xb8,
+	0x13, 0x06, 0x58, 0x31, 0x3e, 0x7e, 0x9e, 0x84, 0x5c, 0x2f, 0xd5, 0x6e, 0xb6, 0xae, 0x1d, 0xd4,
+	0xd6, 0x13, 0xc7, 0xb5, 0xf5, 0x35, 0xc8, 0x52, 0x87, 0x32, 0x8a, 0x6d, 0x23, 0xb0, 0xf9, 0xe4,
+	0xe1, 0x6d, 0x7e, 0x44, 0x61, 0x2c, 0x4a, 0x88, 0xb8, 0x07, 0xa5, 0x8e, 0xee, 0x41, 0xe8, 0x1c,
+	0x0c, 0xc5, 0x9f, 0x40, 0xc2, 0x1b, 0x53, 0x7a, 0x26, 0xf6, 0x02, 0xda, 0xef, 0x8d, 0x95, 0x7e,
+	0xee, 0x1b, 0x4b, 0xa5, 0x36, 0xbf, 0x48, 0xc2, 0x58, 0x3c, 0xca, 0xff, 0xd7, 0x1e, 0xcb, 0x2a,
+	0x80, 0x74, 0x55, 0x1e, 0xa9, 0x73, 0xa9, 0xa3, 0xfa, 0xfb, 0xa0, 0x04, 0x29, 0xfa, 0xec, 0x3f,
+	0x75, 0x42, 0x9f, 0x26, 0x60, 0x28, 0x7e, 0x42, 0xff, 0x93, 0xcf, 0x33, 0xb4, 0x12, 0x85, 0xa9,
+	0x94, 0x08, 0x53, 0xaf, 0xf5, 0x0a, 0x53, 0x7b, 0xac, 0x79, 0x9f, 0xf8, 0xf4, 0x93, 0x7e, 0x48,
+	0xaf, 0x62, 0x0f, 0x37, 0x7c, 0x74, 0x6b, 0x4f, 0x16, 0x27, 0x9f, 0x35, 0x13, 0x7b, 0x8c, 0xb9,
+	0xa8, 0x0a, 0x7d, 0xd2, 0x96, 0x7f, 0xd6, 0x2b, 0x89, 0xfb, 0x3f, 0x18, 0xe1, 0xd5, 0xa0, 0x70,
+	0x43, 0x92, 0xdc, 0x61, 0x51, 0xd4, 0x09, 0x77, 0xef, 0xa3, 0x69, 0xc8, 0x70, 0xb1, 0x28, 0x0e,
+	0x73, 0x19, 0x68, 0xe0, 0x9d, 0x92, 0xec, 0x41, 0xb3, 0x80, 0x36, 0xc3, 0xea, 0x9c, 0x11, 0x11,
+	0xc1, 0xe5, 0xc6, 0xa2, 0x91, 0x40, 0xfc, 0x65, 0x00, 0xbe, 0x0a, 0xc3, 0x22, 0x8e, 0xdb, 0x50,
+	0x25, 0x8d, 0x41, 0xde, 0x53, 0xe4, 0x1d, 0xe8, 0x07, 0x9a, 0x4c, 0x06, 0x77, 0xd5, 0x8c, 0x54,
+	0x2e, 0xbe, 0x76, 0x00, 0xa7, 0xf8, 0xe7, 0x93, 0xe9, 0xc9, 0x36, 0x6e, 0xd8, 0xf3, 0xf9, 0x2e,
+	0x38, 0xf9, 0x6e, 0x65, 0x2c, 0x9e, 0x22, 0x76, 0xd6, 0x9c, 0xd0, 0x0a, 0x8c, 0x6e, 0x91, 0xb6,
+	0xe1, 0xb9, 0x4c, 0x06, 0x9a, 0x1a, 0x21, 0x2a, 0x6b, 0x9f, 0x08, 0xce, 0x96, 0x17, 0x3f, 0x63,
+	0x49, 0x2e, 0xed, 0x48, 0x6f, 0x47, 0xb6, 0x48, 0x5b, 0x57, 0xca, 0xcb, 0x84, 0xa0, 0xdb, 0x30,
+	0x51, 0xb7, 0xdd, 0x2a, 0xb6, 0x0d, 0x9b, 0xde, 0x6e, 0x51, 0xcb, 0x50, 0x96, 0x61, 0x98, 0xb8,
+	0x99, 0x1b, 0x38, 0x56, 0x11, 0xee, 0xb4, 0x04, 0xbe, 0x29, 0x70, 0x2b, 0x12, 0x76, 0x09, 0x37,
+	0xd1, 0x1d, 0x78, 0x29, 0x32, 0xf6, 0x2e, 0xb3, 0x0e, 0x1e, 0x6b, 0xd6, 0x89, 0x10, 0x7b, 0xcf,
+	0xc4, 0x3a, 0xbc, 0x82, 0x6d, 0xdb, 0xbd, 0x63, 0x50, 0xc7, 0x67, 0xd8, 0x61, 0x86, 0x17, 0xb3,
+	0x7a, 0xa3, 0xe6, 0xb9, 0x0d, 0x43, 0xd5, 0x80, 0x40, 0xd4, 0x80, 0xf2, 0x42, 0xba, 0x2c, 0x85,
+	0xe3, 0x1e, 0xb2, 0xec, 0xb9, 0x8d, 0x1b, 0x42, 0x12, 0xd5, 0x60, 0x5c, 0x94, 0x2e, 0x77, 0x59,
+	0x45, 0xe6, 0x58, 0x7b, 0x18, 0xe3, 0xe5, 0xcb, 0x8e, 0x73, 0x9f, 0xbf, 0xc0, 0xc3, 0xda, 0xbd,
+	0xcf, 0x3e, 0xba, 0x74, 0x36, 0xaa, 0x23, 0xcc, 0xed, 0x84, 0x35, 0x7b, 0xe9, 0x8b, 0x3c, 0x17,
+	0x47, 0xd1, 0x6b, 0x41, 0x27, 0x7e, 0xd3, 0x75, 0x7c, 0x51, 0x16, 0x89, 0x16, 0xaf, 0xdc, 0xb3,
+	0x77, 0xa2, 0x10, 0x4a, 0x76, 0x94, 0x45, 0x62, 0xb1, 0xf4, 0xad, 0xe8, 0xb2, 0x4e, 0x1c, 0xc2,
+	0xf4, 0x02, 0x25, 0x11, 0xa2, 0xfb, 0xf2, 0x7f, 0xd2, 0x60, 0x62, 0x4f, 0xd8, 0x09, 0x97, 0x6c,
+	0x02, 0xea, 0x38, 0x1d, 0xee, 0xbe, 0x6d, 0xb5, 0xf4, 0xa3, 0x45, 0xb1, 0x31, 0x6f, 0xf7, 0xe8,
+	0x0b, 0x7a, 0x75, 0xa8, 0x2b, 0xe7, 0x0f, 0x1a, 0x9c, 0x8c, 0x2f, 0x20, 0xdc, 0x4a, 0x05, 0x86,
+	0xe2, 0x53, 0xab, 0x4d, 0x5c, 0x38, 0xc8, 0x26, 0xe2, 0xeb, 0xef, 0x00, 0x41, 0x1b, 0x51, 0x68,
+	0x97, 0x89, 0xdf, 0xe5, 0x03, 0x93, 0x12, 0x2c, 0xac, 0x6b, 0x88, 0x97, 0x67, 0xf3, 0x77, 0x0d,
+	0x52, 0xab, 0xae, 0x6b, 0xa3, 0xdb, 0x30, 0xe6, 0xb8, 0xcc, 0xe0, 0x61, 0x90, 0x58, 0x86, 0xaa,
+	0x66, 0xca, 0x6b, 0xb3, 0xf4, 0x5c, 0xae, 0xfe, 0xf6, 0x64, 0x7a, 0xaf, 0x66, 0x27, 0x81, 0xaa,
+	0x68, 0xee, 0xb8, 0x6c, 0x51, 0x08, 0xad, 0x09, 0x19, 0x54, 0x83, 0xe1, 0xce, 0xe9, 0xe4, 0xd5,
+	0xba, 0xb0, 0xdf, 0x74, 0xc3, 0xfb, 0x4e, 0x35, 0x54, 0x8d, 0xcd, 0x33, 0x3f, 0xc0, 0x4f, 0xed,
+	0x1f, 0xfc, 0xe4, 0xde, 0x87, 0xd1, 0xf0, 0x5e, 0x59, 0x17, 0x15, 0x77, 0x9f, 0x9b, 0x86, 0x2c,
+	0xbe, 0x07, 0xf5, 0x8b, 0x99, 0xf8, 0x97, 0x1c, 0xfe, 0x29, 0xa8, 0xb0, 0x4b, 0xa7, 0x83, 0x4e,
+	0xa5, 0x9b, 0xff, 0x8d, 0x06, 0xa7, 0x96, 0x5c, 0xc7, 0x7f, 0x87, 0xb4, 0x4b, 0xdb, 0xbc, 0xfe,
+	0x6e, 0x92, 0xd2, 0x4e, 0x93, 0x7a, 0x6d, 0xf4, 0x7a, 0xb7, 0x94, 0x84, 0x33, 0x3b, 0xd4, 0x25,
+	0xdf, 0xb8, 0x01, 0x19, 0x22, 0xd4, 0x8e, 0xfa, 0x21, 0x41, 0x6a, 0x8b, 0xeb, 0xf5, 0x3c, 0x0c,
+	0x2b, 0xac, 0x8e, 0x3c, 0x79, 0x48, 0x76, 0xca, 0x27, 0x6a, 0xfe, 0x53, 0x0d, 0xc6, 0x05, 0x4f,
+	0xf4, 0x7b, 0x44, 0x94, 0x8a, 0x75, 0x62, 0xba, 0x9e, 0x85, 0x46, 0x20, 0x41, 0x2d, 0xb1, 0xcc,
+	0x94, 0x9e, 0xa0, 0x16, 0x2a, 0xc0, 0x09, 0xf7, 0x8e, 0x43, 0xbc, 0x5c, 0x62, 0x9f, 0xa7, 0x94,
+	0x14, 0x43, 0xd7, 0x60, 0xa4, 0xe1, 0x5a, 0x2d, 0x9b, 0x18, 0xd8, 0x94, 0xcf, 0xb9, 0xe4, 0x3e,
+	0x8a, 0xc3, 0x52, 0x7e, 0x41, 0x8a, 0xa3, 0x6b, 0x30, 0x18, 0xb2, 0x93, 0x4b, 0x1d, 0xf4, 0x75,
+	0x14, 0xe9, 0x28, 0x67, 0xbd, 0x9b, 0x80, 0x5c, 0x14, 0x61, 0xe5, 0xa7, 0xa2, 0x8a, 0xb9, 0x49,
+	0xf8, 0x54, 0xdd, 0xb3, 0x45, 0xed, 0xe8, 0xd9, 0x62, 0xf0, 0xf5, 0x2d, 0xf1, 0x02, 0xbe, 0xbe,
+	0xad, 0xc2, 0x08, 0xa9, 0xd5, 0x88, 0xc9, 0xe8, 0xb6, 0xfa, 0xaa, 0x94, 0x3c, 0x74, 0xcd, 0x3c,
+	0x04, 0xe0, 0x22, 0x97, 0x7e, 0xab, 0x01, 0x44, 0x1f, 0x2f, 0xd0, 0x1b, 0x70, 0x66, 0xf1, 0xd6,
+	0x4a, 0xd1, 0xa8, 0xac, 0x2d, 0xac, 0xad, 0x57, 0x8c, 0xf5, 0x95, 0xca, 0x6a, 0x69, 0xa9, 0xbc,
+	0x5c, 0x2e, 0x15, 0x47, 0xfb, 0x26, 0xb3, 0xf7, 0xee, 0xcf, 0x64, 0xd6, 0x1d, 0xbf, 0x49, 0x4c,
+	0x5a, 0xa3, 0xc4, 0x42, 0xaf, 0xc0, 0xc9, 0x4e, 0x69, 0xde, 0x2a, 0x15, 0x47, 0xb5, 0xc9, 0xa1,
+	0x7b, 0xf7, 0x67, 0x06, 0x64, 0x0a, 0x4b, 0x2c, 0x74, 0x11, 0x4e, 0xed, 0x95, 0x2b, 0xaf, 0xbc,
+	0x3d, 0x9a, 0x98, 0x1c, 0xbe, 0x77, 0x7f, 0x66, 0x30, 0xcc, 0x75, 0x51, 0x1e, 0x50, 0x5c, 0x52,
+	0xe1, 0x25, 0x27, 0xe1, 0xde, 0xfd, 0x99, 0xb4, 0x0c, 0x14, 0x93, 0xa9, 0xbb, 0xbf, 0x9c, 0xea,
+	0xbb, 0xf4, 0x6d, 0x80, 0xb2, 0x53, 0xf3, 0xb0, 0x29, 0x02, 0xe2, 0x24, 0x9c, 0x2e, 0xaf, 0x2c,
+	0xeb, 0x0b, 0x4b, 0x6b, 0xe5, 0x5b, 0x2b, 0x9d, 0xcb, 0xde, 0x35, 0x56, 0xbc, 0xb5, 0xbe, 0x78,
+	0xb3, 0x64, 0x54, 0xca, 0x6f, 0xaf, 0x8c, 0x6a, 0xe8, 0x0c, 0x8c, 0x77, 0x8c, 0x7d, 0x7d, 0x65,
+	0xad, 0xfc, 0x6e, 0x69, 0x34, 0xb1, 0xb8, 0xfc, 0xf1, 0xd3, 0x29, 0xed, 0xd1, 0xd3, 0x29, 0xed,
+	0xaf, 0x4f, 0xa7, 0xb4, 0x0f, 0x9e, 0x4d, 0xf5, 0x3d, 0x7a, 0x36, 0xd5, 0xf7, 0xe7, 0x67, 0x53,
+	0x7d, 0xdf, 0x7c, 0xa3, 0x4e, 0xd9, 0x66, 0xab, 0x5a, 0x30, 0xdd, 0x86, 0xfa, 0xfc, 0x3c, 0xd7,
+	0xf5, 0x52, 0x16, 0xdf, 0x7b, 0xab, 0x69, 0x71, 0x22, 0xff, 0xff, 0xef, 0x01, 0x00, 0x15, 0x2e,
+	0x56, 0x8a, 0x67, 0x1f, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1485,829 +1851,813 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 13140 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x69, 0x90, 0x5c, 0xd7,
-		0x75, 0x18, 0x3c, 0xaf, 0xf7, 0x3e, 0xbd, 0xbd, 0xb9, 0x33, 0x00, 0x1a, 0x0d, 0x12, 0x33, 0x6c,
-		0x8a, 0x24, 0x08, 0x92, 0x03, 0x62, 0x48, 0x80, 0xc4, 0x40, 0x12, 0xbf, 0xde, 0x00, 0x34, 0x38,
-		0xd3, 0x3d, 0x7a, 0xdd, 0x03, 0x12, 0xb4, 0xad, 0xe7, 0x37, 0xdd, 0x77, 0x66, 0x1e, 0xd1, 0xfd,
-		0x5e, 0xab, 0xdf, 0x6b, 0x70, 0x86, 0x5f, 0x95, 0x4b, 0xb2, 0x64, 0x47, 0xa6, 0x2c, 0x5b, 0x8e,
-		0x5d, 0x36, 0x6d, 0x19, 0xb2, 0x24, 0xc7, 0x8a, 0xbc, 0x25, 0xb6, 0x25, 0x6f, 0x49, 0xc5, 0x4b,
-		0x12, 0x27, 0xb6, 0xe2, 0xa4, 0x64, 0x57, 0x25, 0x71, 0x5c, 0x65, 0x25, 0x96, 0x5d, 0x16, 0xb5,
-		0x38, 0xde, 0xe4, 0xc4, 0x29, 0x25, 0xa5, 0xd4, 0xdd, 0xde, 0xd2, 0xcb, 0x74, 0x0f, 0xb4, 0x84,
-		0xb1, 0xf3, 0x83, 0xc4, 0xbc, 0x7b, 0xcf, 0x39, 0xf7, 0xdc, 0x73, 0xcf, 0x3d, 0xf7, 0x9c, 0x73,
-		0x97, 0x86, 0xf7, 0xde, 0x82, 0xe5, 0x5d, 0xd3, 0xdc, 0xed, 0xe0, 0x73, 0xbd, 0xbe, 0x69, 0x9b,
-		0xdb, 0x83, 0x9d, 0x73, 0x6d, 0x6c, 0xb5, 0xfa, 0x7a, 0xcf, 0x36, 0xfb, 0x2b, 0xb4, 0x0c, 0x65,
-		0x18, 0xc4, 0x8a, 0x80, 0xc8, 0x7f, 0x42, 0x82, 0xf9, 0x2b, 0x7a, 0x07, 0x97, 0x1d, 0xc8, 0x06,
-		0xb6, 0xd1, 0xd3, 0x10, 0xda, 0xd1, 0x3b, 0x38, 0x2b, 0x2d, 0x07, 0xcf, 0x24, 0x56, 0xdf, 0xb0,
-		0x32, 0x84, 0xb5, 0xe2, 0xc7, 0xd8, 0x24, 0xc5, 0x0a, 0xc5, 0x38, 0xfb, 0xff, 0xc7, 0xde, 0xfe,
-		0x85, 0x4f, 0x7c, 0x59, 0x92, 0xdf, 0x41, 0xfe, 0x9f, 0xeb, 0xa2, 0x5b, 0xec, 0x1b, 0x3d, 0xb9,
-		0x42, 0xf0, 0x3c, 0xfc, 0xdc, 0x3e, 0x4f, 0x4a, 0x54, 0x82, 0xa4, 0xba, 0xc5, 0xaa, 0x85, 0x6d,
-		0x15, 0xef, 0xdb, 0xd8, 0xb0, 0x74, 0xd3, 0xc8, 0x3d, 0x36, 0x06, 0x6b, 0x84, 0xdb, 0x8a, 0x00,
-		0xcf, 0xff, 0x46, 0x18, 0x16, 0xc6, 0xb0, 0x86, 0x10, 0x84, 0x0c, 0xad, 0x4b, 0xba, 0x23, 0x9d,
-		0x89, 0x2b, 0xf4, 0x6f, 0x94, 0x85, 0x68, 0x4f, 0x6b, 0xdd, 0xd2, 0x76, 0x71, 0x36, 0x40, 0x8b,
-		0xc5, 0x27, 0x3a, 0x0d, 0xd0, 0xc6, 0x3d, 0x6c, 0xb4, 0xb1, 0xd1, 0x3a, 0xc8, 0x06, 0x97, 0x83,
-		0x67, 0xe2, 0x8a, 0xa7, 0x04, 0x3d, 0x02, 0xf3, 0xbd, 0xc1, 0x76, 0x47, 0x6f, 0xa9, 0x1e, 0x30,
-		0x58, 0x0e, 0x9e, 0x09, 0x2b, 0x32, 0xab, 0x28, 0xbb, 0xc0, 0x0f, 0x41, 0xe6, 0x25, 0xac, 0xdd,
-		0xf2, 0x82, 0x26, 0x28, 0x68, 0x9a, 0x14, 0x97, 0x7d, 0x54, 0xcd, 0x9e, 0xad, 0x9b, 0x86, 0x17,
-		0x34, 0x43, 0x1b, 0x97, 0x59, 0x85, 0x07, 0xb8, 0x04, 0xc9, 0x2e, 0xb6, 0x2c, 0x6d, 0x17, 0xab,
-		0xf6, 0x41, 0x0f, 0x67, 0x43, 0x74, 0x9c, 0x96, 0x47, 0xc6, 0x69, 0x78, 0x8c, 0x12, 0x1c, 0xab,
-		0x79, 0xd0, 0xc3, 0xa8, 0x00, 0x71, 0x6c, 0x0c, 0xba, 0x8c, 0x42, 0x78, 0xc2, 0x48, 0x57, 0x8c,
-		0x41, 0x77, 0x98, 0x4a, 0x8c, 0xa0, 0x71, 0x12, 0x51, 0x0b, 0xf7, 0x6f, 0xeb, 0x2d, 0x9c, 0x8d,
-		0x50, 0x02, 0x0f, 0x8d, 0x10, 0x68, 0xb0, 0xfa, 0x61, 0x1a, 0x02, 0x0f, 0x95, 0x20, 0xee, 0x8c,
-		0x77, 0x36, 0x4a, 0x89, 0x3c, 0x30, 0x46, 0xdf, 0x70, 0xa7, 0x3d, 0x4c, 0xc2, 0xc5, 0x43, 0x17,
-		0x21, 0xca, 0x64, 0x64, 0x65, 0x63, 0xcb, 0xd2, 0x99, 0xc4, 0xea, 0x3d, 0x63, 0x55, 0xb6, 0xce,
-		0x60, 0x14, 0x01, 0x8c, 0xaa, 0x20, 0x5b, 0xe6, 0xa0, 0xdf, 0xc2, 0x6a, 0xcb, 0x6c, 0x63, 0x55,
-		0x37, 0x76, 0xcc, 0x6c, 0x9c, 0x12, 0x58, 0x1a, 0xed, 0x08, 0x05, 0x2c, 0x99, 0x6d, 0x5c, 0x35,
-		0x76, 0x4c, 0x25, 0x6d, 0xf9, 0xbe, 0xd1, 0x71, 0x88, 0x58, 0x07, 0x86, 0xad, 0xed, 0x67, 0x93,
-		0x54, 0x9d, 0xf8, 0x17, 0x5a, 0x85, 0x28, 0x6e, 0xeb, 0xa4, 0xb9, 0x6c, 0x7a, 0x59, 0x3a, 0x93,
-		0x5e, 0xcd, 0x8e, 0xca, 0x98, 0xd5, 0x2b, 0x02, 0x30, 0xff, 0x3f, 0x23, 0x90, 0x99, 0x45, 0x87,
-		0x2f, 0x43, 0x78, 0x87, 0x48, 0x26, 0x1b, 0x38, 0x8a, 0xdc, 0x18, 0x8e, 0x5f, 0xf0, 0x91, 0xbb,
-		0x14, 0x7c, 0x01, 0x12, 0x06, 0xb6, 0x6c, 0xdc, 0x66, 0x5a, 0x14, 0x9c, 0x51, 0x0f, 0x81, 0x21,
-		0x8d, 0xaa, 0x61, 0xe8, 0xae, 0xd4, 0xf0, 0x79, 0xc8, 0x38, 0x2c, 0xa9, 0x7d, 0xcd, 0xd8, 0x15,
-		0xfa, 0x7c, 0x6e, 0x1a, 0x27, 0x2b, 0x8e, 0xf1, 0x50, 0x08, 0x9a, 0x92, 0xc6, 0xbe, 0x6f, 0x54,
-		0x06, 0x30, 0x0d, 0x6c, 0xee, 0xa8, 0x6d, 0xdc, 0xea, 0x64, 0x63, 0x13, 0xa4, 0x54, 0x27, 0x20,
-		0x23, 0x52, 0x32, 0x59, 0x69, 0xab, 0x83, 0x2e, 0xb9, 0xea, 0x19, 0x9d, 0xa0, 0x5d, 0x1b, 0x6c,
-		0x62, 0x8e, 0x68, 0xe8, 0x16, 0xa4, 0xfb, 0x98, 0xcc, 0x15, 0xdc, 0xe6, 0x3d, 0x8b, 0x53, 0x26,
-		0x56, 0xa6, 0xf6, 0x4c, 0xe1, 0x68, 0xac, 0x63, 0xa9, 0xbe, 0xf7, 0x13, 0xdd, 0x0f, 0x4e, 0x81,
-		0x4a, 0xd5, 0x0a, 0xa8, 0xa5, 0x49, 0x8a, 0xc2, 0x1a, 0x51, 0xaf, 0x02, 0xc0, 0x6d, 0xdd, 0xd2,
-		0xb7, 0xf5, 0x8e, 0x6e, 0x13, 0xb3, 0x45, 0xb4, 0xf7, 0xbe, 0xd1, 0x79, 0x71, 0xd0, 0xdd, 0x36,
-		0x3b, 0x37, 0x1c, 0x40, 0xc5, 0x83, 0x94, 0x7b, 0x19, 0xd2, 0x7e, 0x09, 0xa3, 0x45, 0x08, 0x5b,
-		0xb6, 0xd6, 0xb7, 0xa9, 0x22, 0x87, 0x15, 0xf6, 0x81, 0x64, 0x08, 0x62, 0xa3, 0x4d, 0x2d, 0x71,
-		0x58, 0x21, 0x7f, 0xa2, 0xff, 0xcf, 0x95, 0x59, 0x90, 0xca, 0xec, 0xc1, 0x51, 0xa5, 0xf0, 0x51,
-		0x1e, 0x16, 0x5d, 0xee, 0x29, 0x48, 0xf9, 0x64, 0x30, 0x6b, 0xd3, 0xf9, 0xdf, 0x0e, 0xc1, 0xb1,
-		0xb1, 0xb4, 0xd1, 0xf3, 0xb0, 0x38, 0x30, 0x74, 0xc3, 0xc6, 0xfd, 0x5e, 0x1f, 0x13, 0xad, 0x67,
-		0x6d, 0x65, 0x3f, 0x13, 0x9d, 0xa0, 0xb7, 0x5b, 0x5e, 0x68, 0x46, 0x45, 0x59, 0x18, 0x8c, 0x16,
-		0xa2, 0x9b, 0x90, 0x20, 0x2a, 0xa6, 0xf5, 0x35, 0x4a, 0x90, 0x4d, 0xe8, 0xd5, 0xd9, 0xba, 0xbc,
-		0x52, 0x76, 0x31, 0x8b, 0xc1, 0x77, 0x4b, 0x01, 0xc5, 0x4b, 0x0b, 0x3d, 0x05, 0xb1, 0x1d, 0xac,
-		0xd9, 0x83, 0x3e, 0xb6, 0xb2, 0xab, 0x54, 0x94, 0xa7, 0x46, 0xe7, 0x39, 0x03, 0x68, 0x60, 0x5b,
-		0x71, 0x80, 0x51, 0x17, 0x92, 0xb7, 0x71, 0x5f, 0xdf, 0xd1, 0x5b, 0x8c, 0xa9, 0x20, 0xd5, 0x80,
-		0xa7, 0x67, 0x64, 0xea, 0x86, 0x07, 0xb5, 0x61, 0x6b, 0x36, 0x5e, 0x83, 0xad, 0xda, 0x8d, 0x8a,
-		0x52, 0xbd, 0x52, 0xad, 0x94, 0x19, 0x9b, 0x3e, 0xf2, 0xb9, 0xef, 0x93, 0x20, 0xe1, 0xe9, 0x09,
-		0xb1, 0xa8, 0xc6, 0xa0, 0xbb, 0x8d, 0xfb, 0x7c, 0xbc, 0xf8, 0x17, 0x3a, 0x05, 0xf1, 0x9d, 0x41,
-		0xa7, 0xc3, 0xf4, 0x96, 0xad, 0xdd, 0x31, 0x52, 0x40, 0x75, 0x16, 0x41, 0x88, 0x5b, 0x22, 0x6a,
-		0x26, 0xc9, 0xdf, 0x28, 0x07, 0x31, 0xa1, 0xd7, 0xd9, 0xf0, 0xb2, 0x74, 0x26, 0xa6, 0x38, 0xdf,
-		0xac, 0xae, 0x87, 0x35, 0x1b, 0xb7, 0xb3, 0x11, 0x51, 0xc7, 0xbe, 0xaf, 0x87, 0x62, 0x21, 0x39,
-		0x9c, 0x7f, 0x12, 0xe6, 0x47, 0xba, 0x82, 0x32, 0x90, 0x28, 0x57, 0x4a, 0xeb, 0x05, 0xa5, 0xd0,
-		0xac, 0xd6, 0x6b, 0xf2, 0x1c, 0x4a, 0x83, 0xa7, 0x77, 0xb2, 0x74, 0x36, 0x1e, 0x7b, 0x2d, 0x2a,
-		0xbf, 0xfd, 0xed, 0x6f, 0x7f, 0x7b, 0x20, 0xff, 0xeb, 0x11, 0x58, 0x1c, 0x67, 0x47, 0xc7, 0x9a,
-		0x74, 0xb7, 0xd3, 0x41, 0x5f, 0xa7, 0x0b, 0x10, 0xee, 0x68, 0xdb, 0xb8, 0x93, 0x0d, 0xd1, 0x41,
-		0x78, 0x64, 0x26, 0x4b, 0xbd, 0xb2, 0x4e, 0x50, 0x14, 0x86, 0x89, 0xde, 0xcc, 0x45, 0x13, 0xa6,
-		0x14, 0xce, 0xce, 0x46, 0x81, 0xd8, 0x57, 0x2e, 0xc6, 0x53, 0x10, 0x27, 0xff, 0x32, 0xb9, 0x47,
-		0x98, 0xdc, 0x49, 0x01, 0x95, 0x7b, 0x0e, 0x62, 0xd4, 0x74, 0xb6, 0xb1, 0x33, 0x26, 0xe2, 0x9b,
-		0x18, 0x9b, 0x36, 0xde, 0xd1, 0x06, 0x1d, 0x5b, 0xbd, 0xad, 0x75, 0x06, 0x98, 0x1a, 0xc1, 0xb8,
-		0x92, 0xe4, 0x85, 0x37, 0x48, 0x19, 0x5a, 0x82, 0x04, 0xb3, 0xb4, 0xba, 0xd1, 0xc6, 0xfb, 0x74,
-		0x15, 0x0e, 0x2b, 0xcc, 0xf8, 0x56, 0x49, 0x09, 0x69, 0xfe, 0x45, 0xcb, 0x34, 0x84, 0xb9, 0xa2,
-		0x4d, 0x90, 0x02, 0xda, 0xfc, 0x53, 0xc3, 0x0e, 0xc0, 0xbd, 0xe3, 0xbb, 0x37, 0x62, 0x5f, 0x1f,
-		0x82, 0x0c, 0x85, 0x78, 0x82, 0x4f, 0x65, 0xad, 0x93, 0x9d, 0xa7, 0x6a, 0x90, 0x66, 0xc5, 0x75,
-		0x5e, 0x9a, 0xff, 0x85, 0x00, 0x84, 0xe8, 0x62, 0x93, 0x81, 0x44, 0xf3, 0xe6, 0x66, 0x45, 0x2d,
-		0xd7, 0xb7, 0x8a, 0xeb, 0x15, 0x59, 0x22, 0x43, 0x4f, 0x0b, 0xae, 0xac, 0xd7, 0x0b, 0x4d, 0x39,
-		0xe0, 0x7c, 0x57, 0x6b, 0xcd, 0x8b, 0x4f, 0xca, 0x41, 0x07, 0x61, 0x8b, 0x15, 0x84, 0xbc, 0x00,
-		0x4f, 0xac, 0xca, 0x61, 0x24, 0x43, 0x92, 0x11, 0xa8, 0x3e, 0x5f, 0x29, 0x5f, 0x7c, 0x52, 0x8e,
-		0xf8, 0x4b, 0x9e, 0x58, 0x95, 0xa3, 0x28, 0x05, 0x71, 0x5a, 0x52, 0xac, 0xd7, 0xd7, 0xe5, 0x98,
-		0x43, 0xb3, 0xd1, 0x54, 0xaa, 0xb5, 0xab, 0x72, 0xdc, 0xa1, 0x79, 0x55, 0xa9, 0x6f, 0x6d, 0xca,
-		0xe0, 0x50, 0xd8, 0xa8, 0x34, 0x1a, 0x85, 0xab, 0x15, 0x39, 0xe1, 0x40, 0x14, 0x6f, 0x36, 0x2b,
-		0x0d, 0x39, 0xe9, 0x63, 0xeb, 0x89, 0x55, 0x39, 0xe5, 0x34, 0x51, 0xa9, 0x6d, 0x6d, 0xc8, 0x69,
-		0x34, 0x0f, 0x29, 0xd6, 0x84, 0x60, 0x22, 0x33, 0x54, 0x74, 0xf1, 0x49, 0x59, 0x76, 0x19, 0x61,
-		0x54, 0xe6, 0x7d, 0x05, 0x17, 0x9f, 0x94, 0x51, 0xbe, 0x04, 0x61, 0xaa, 0x86, 0x08, 0x41, 0x7a,
-		0xbd, 0x50, 0xac, 0xac, 0xab, 0xf5, 0x4d, 0x32, 0x69, 0x0a, 0xeb, 0xb2, 0xe4, 0x96, 0x29, 0x95,
-		0xcd, 0x4a, 0xa1, 0x59, 0x29, 0xcb, 0x41, 0x6f, 0xd9, 0x5b, 0xb6, 0xaa, 0x4a, 0xa5, 0x2c, 0x07,
-		0xf2, 0x2d, 0x58, 0x1c, 0xb7, 0xc8, 0x8e, 0x9d, 0x42, 0x1e, 0x5d, 0x08, 0x4c, 0xd0, 0x05, 0x4a,
-		0x6b, 0x58, 0x17, 0xf2, 0x1f, 0x09, 0xc2, 0xc2, 0x18, 0x47, 0x63, 0x6c, 0x23, 0xcf, 0x40, 0x98,
-		0xe9, 0x32, 0xb3, 0xd4, 0x0f, 0x8f, 0xf5, 0x58, 0xa8, 0x66, 0x8f, 0xb8, 0x5f, 0x14, 0xcf, 0xeb,
-		0xb2, 0x06, 0x27, 0xb8, 0xac, 0x84, 0xc4, 0x88, 0xc2, 0x7e, 0xd3, 0x88, 0x43, 0xc0, 0x7c, 0xa6,
-		0x8b, 0xb3, 0xf8, 0x4c, 0xb4, 0xec, 0x68, 0x8e, 0x41, 0x78, 0xaa, 0x63, 0x10, 0xb9, 0x1b, 0xc7,
-		0xe0, 0x32, 0xcc, 0x8f, 0xf0, 0x32, 0xf3, 0x02, 0xfd, 0x4e, 0x09, 0xb2, 0x93, 0xe4, 0x3b, 0xc5,
-		0xaa, 0x06, 0x7c, 0x56, 0xf5, 0xf2, 0xf0, 0x20, 0xdc, 0x37, 0x79, 0x1c, 0x47, 0xd4, 0xe5, 0xe7,
-		0x25, 0x38, 0x3e, 0x3e, 0xba, 0x19, 0xcb, 0xc3, 0x9b, 0x21, 0xd2, 0xc5, 0xf6, 0x9e, 0x29, 0xbc,
-		0xf5, 0x07, 0xc7, 0xf8, 0x80, 0xa4, 0x7a, 0x58, 0x5f, 0x38, 0x96, 0xd7, 0x89, 0x0c, 0x4e, 0x0a,
-		0x51, 0x18, 0x37, 0xc3, 0x9c, 0xb2, 0x85, 0x4c, 0x89, 0x58, 0x76, 0x1f, 0x6b, 0xdd, 0xfc, 0x77,
-		0x04, 0xe0, 0xd8, 0xd8, 0xa6, 0xc6, 0xb2, 0x7d, 0x2f, 0x80, 0x6e, 0xf4, 0x06, 0x36, 0xf3, 0xcf,
-		0x99, 0x69, 0x8f, 0xd3, 0x12, 0x6a, 0x0d, 0x89, 0xd9, 0x1e, 0xd8, 0x4e, 0x3d, 0x5b, 0x76, 0x81,
-		0x15, 0x51, 0x80, 0xa7, 0x5d, 0xb6, 0x43, 0x94, 0xed, 0xd3, 0x13, 0xfa, 0x3d, 0xa2, 0xe9, 0x8f,
-		0x83, 0xdc, 0xea, 0xe8, 0xd8, 0xb0, 0x55, 0xc6, 0xb8, 0x6e, 0xec, 0xb2, 0xe5, 0x7b, 0x2d, 0xbc,
-		0xa3, 0x75, 0x2c, 0xac, 0x64, 0x58, 0x75, 0x43, 0xd4, 0x12, 0x0c, 0xaa, 0x4e, 0x7d, 0x0f, 0x46,
-		0xc4, 0x87, 0xc1, 0xaa, 0x1d, 0x8c, 0xfc, 0x4f, 0xc7, 0x21, 0xe1, 0x89, 0x0c, 0xd1, 0x7d, 0x90,
-		0x7c, 0x51, 0xbb, 0xad, 0xa9, 0x22, 0x35, 0xc0, 0x24, 0x91, 0x20, 0x65, 0x9b, 0x3c, 0x3d, 0xf0,
-		0x38, 0x2c, 0x52, 0x10, 0x73, 0x60, 0xe3, 0xbe, 0xda, 0xea, 0x68, 0x96, 0x45, 0x85, 0x16, 0xa3,
-		0xa0, 0x88, 0xd4, 0xd5, 0x49, 0x55, 0x49, 0xd4, 0xa0, 0x0b, 0xb0, 0x40, 0x31, 0xba, 0x83, 0x8e,
-		0xad, 0xf7, 0x3a, 0x98, 0x26, 0x3d, 0x2c, 0xba, 0x86, 0x39, 0x9c, 0xcd, 0x13, 0x88, 0x0d, 0x0e,
-		0x40, 0x38, 0xb2, 0x50, 0x19, 0xee, 0xa5, 0x68, 0xbb, 0xd8, 0xc0, 0x7d, 0xcd, 0xc6, 0x2a, 0x7e,
-		0xdb, 0x40, 0xeb, 0x58, 0xaa, 0x66, 0xb4, 0xd5, 0x3d, 0xcd, 0xda, 0xcb, 0x2e, 0x12, 0x02, 0xc5,
-		0x40, 0x56, 0x52, 0x4e, 0x12, 0xc0, 0xab, 0x1c, 0xae, 0x42, 0xc1, 0x0a, 0x46, 0xfb, 0x9a, 0x66,
-		0xed, 0xa1, 0x35, 0x38, 0x4e, 0xa9, 0x58, 0x76, 0x5f, 0x37, 0x76, 0xd5, 0xd6, 0x1e, 0x6e, 0xdd,
-		0x52, 0x07, 0xf6, 0xce, 0xd3, 0xd9, 0x53, 0xde, 0xf6, 0x29, 0x87, 0x0d, 0x0a, 0x53, 0x22, 0x20,
-		0x5b, 0xf6, 0xce, 0xd3, 0xa8, 0x01, 0x49, 0x32, 0x18, 0x5d, 0xfd, 0x65, 0xac, 0xee, 0x98, 0x7d,
-		0xba, 0x28, 0xa7, 0xc7, 0xd8, 0x3a, 0x8f, 0x04, 0x57, 0xea, 0x1c, 0x61, 0xc3, 0x6c, 0xe3, 0xb5,
-		0x70, 0x63, 0xb3, 0x52, 0x29, 0x2b, 0x09, 0x41, 0xe5, 0x8a, 0xd9, 0x27, 0x0a, 0xb5, 0x6b, 0x3a,
-		0x02, 0x4e, 0x30, 0x85, 0xda, 0x35, 0x85, 0x78, 0x2f, 0xc0, 0x42, 0xab, 0xc5, 0xfa, 0xac, 0xb7,
-		0x54, 0x9e, 0x25, 0xb0, 0xb2, 0xb2, 0x4f, 0x58, 0xad, 0xd6, 0x55, 0x06, 0xc0, 0x35, 0xde, 0x42,
-		0x97, 0xe0, 0x98, 0x2b, 0x2c, 0x2f, 0xe2, 0xfc, 0x48, 0x2f, 0x87, 0x51, 0x2f, 0xc0, 0x42, 0xef,
-		0x60, 0x14, 0x11, 0xf9, 0x5a, 0xec, 0x1d, 0x0c, 0xa3, 0x3d, 0x40, 0xd3, 0x44, 0x7d, 0xdc, 0xa2,
-		0xbe, 0xe3, 0x09, 0x2f, 0xb4, 0xa7, 0x02, 0xad, 0x80, 0xdc, 0x6a, 0xa9, 0xd8, 0xd0, 0xb6, 0x3b,
-		0x58, 0xd5, 0xfa, 0xd8, 0xd0, 0xac, 0xec, 0x12, 0x05, 0x0e, 0xd9, 0xfd, 0x01, 0x56, 0xd2, 0xad,
-		0x56, 0x85, 0x56, 0x16, 0x68, 0x1d, 0x3a, 0x0b, 0xf3, 0xe6, 0xf6, 0x8b, 0x2d, 0xa6, 0x58, 0x6a,
-		0xaf, 0x8f, 0x77, 0xf4, 0xfd, 0xec, 0x1b, 0xa8, 0x94, 0x32, 0xa4, 0x82, 0xaa, 0xd5, 0x26, 0x2d,
-		0x46, 0x0f, 0x83, 0xdc, 0xb2, 0xf6, 0xb4, 0x7e, 0x8f, 0x9a, 0x6a, 0xab, 0xa7, 0xb5, 0x70, 0xf6,
-		0x01, 0x06, 0xca, 0xca, 0x6b, 0xa2, 0x98, 0x28, 0xb6, 0xf5, 0x92, 0xbe, 0x63, 0x0b, 0x8a, 0x0f,
-		0x31, 0xc5, 0xa6, 0x65, 0x9c, 0xda, 0x19, 0x90, 0x7b, 0x7b, 0x3d, 0x7f, 0xc3, 0x67, 0x28, 0x58,
-		0xba, 0xb7, 0xd7, 0xf3, 0xb6, 0x7b, 0x3f, 0xa4, 0x08, 0xa4, 0xdb, 0xe8, 0xc3, 0xcc, 0xa1, 0xeb,
-		0xed, 0x79, 0x5a, 0x7c, 0x12, 0x8e, 0x13, 0xa0, 0x2e, 0xb6, 0xb5, 0xb6, 0x66, 0x6b, 0x1e, 0xe8,
-		0x47, 0x29, 0xf4, 0x62, 0x6f, 0xaf, 0xb7, 0xc1, 0x2b, 0x7d, 0x7c, 0xf6, 0x07, 0xdb, 0x07, 0x8e,
-		0x7e, 0x3c, 0xc6, 0xf8, 0x24, 0x65, 0x42, 0x43, 0xee, 0x3a, 0x9e, 0xf9, 0x9a, 0x45, 0x6f, 0xf9,
-		0x35, 0x48, 0x7a, 0xf5, 0x1e, 0xc5, 0x81, 0x69, 0xbe, 0x2c, 0x11, 0xaf, 0xaa, 0x54, 0x2f, 0x13,
-		0x7f, 0xe8, 0x85, 0x8a, 0x1c, 0x20, 0x7e, 0xd9, 0x7a, 0xb5, 0x59, 0x51, 0x95, 0xad, 0x5a, 0xb3,
-		0xba, 0x51, 0x91, 0x83, 0x9e, 0x48, 0xe1, 0x7a, 0x28, 0x76, 0x56, 0x7e, 0xe4, 0x7a, 0x28, 0xf6,
-		0xa0, 0xfc, 0x10, 0x15, 0xcf, 0x88, 0x52, 0xe6, 0xbf, 0x18, 0x84, 0xb4, 0x3f, 0x55, 0x80, 0xde,
-		0x08, 0x27, 0x44, 0x2e, 0xd0, 0xc2, 0xb6, 0xfa, 0x92, 0xde, 0xa7, 0x93, 0xb5, 0xab, 0xb1, 0x65,
-		0xd4, 0x51, 0xca, 0x45, 0x0e, 0xd5, 0xc0, 0xf6, 0x73, 0x7a, 0x9f, 0x4c, 0xc5, 0xae, 0x66, 0xa3,
-		0x75, 0x58, 0x32, 0x4c, 0xd5, 0xb2, 0x35, 0xa3, 0xad, 0xf5, 0xdb, 0xde, 0x4c, 0xac, 0xd6, 0x6a,
-		0x61, 0xcb, 0x32, 0xd9, 0x92, 0xe9, 0x50, 0xb9, 0xc7, 0x30, 0x1b, 0x1c, 0xd8, 0x5d, 0x3d, 0x0a,
-		0x1c, 0x74, 0x68, 0x4e, 0x04, 0x27, 0xcd, 0x89, 0x53, 0x10, 0xef, 0x6a, 0x3d, 0x15, 0x1b, 0x76,
-		0xff, 0x80, 0x06, 0x03, 0x31, 0x25, 0xd6, 0xd5, 0x7a, 0x15, 0xf2, 0x8d, 0x6e, 0xc0, 0x83, 0x2e,
-		0xa8, 0xda, 0xc1, 0xbb, 0x5a, 0xeb, 0x40, 0xa5, 0x9e, 0x3f, 0xcd, 0x5b, 0xa9, 0x2d, 0xd3, 0xd8,
-		0xe9, 0xe8, 0x2d, 0xdb, 0xa2, 0xb6, 0x83, 0xd9, 0xbf, 0xbc, 0x8b, 0xb1, 0x4e, 0x11, 0xae, 0x5b,
-		0xa6, 0x41, 0x1d, 0xfe, 0x92, 0x80, 0xf6, 0xa9, 0x4d, 0xf2, 0x75, 0xa1, 0x36, 0xfe, 0xa1, 0x0f,
-		0xc9, 0xe1, 0xeb, 0xa1, 0x58, 0x58, 0x8e, 0x5c, 0x0f, 0xc5, 0x22, 0x72, 0xf4, 0x7a, 0x28, 0x16,
-		0x93, 0xe3, 0xd7, 0x43, 0xb1, 0xb8, 0x0c, 0xf9, 0x0f, 0xa5, 0x20, 0xe9, 0x8d, 0x5f, 0x48, 0x38,
-		0xd8, 0xa2, 0x0b, 0xae, 0x44, 0x4d, 0xf2, 0xfd, 0x87, 0x46, 0x3b, 0x2b, 0x25, 0xb2, 0x12, 0xaf,
-		0x45, 0x58, 0xb0, 0xa0, 0x30, 0x4c, 0xe2, 0x13, 0x91, 0x49, 0x86, 0x99, 0x67, 0x15, 0x53, 0xf8,
-		0x17, 0xba, 0x0a, 0x91, 0x17, 0x2d, 0x4a, 0x9b, 0x39, 0x76, 0x6f, 0x38, 0x9c, 0xf6, 0xf5, 0x06,
-		0x25, 0x1e, 0xbf, 0xde, 0x50, 0x6b, 0x75, 0x65, 0xa3, 0xb0, 0xae, 0x70, 0x74, 0x74, 0x12, 0x42,
-		0x1d, 0xed, 0xe5, 0x03, 0xff, 0x9a, 0x4d, 0x8b, 0xd0, 0x0a, 0x64, 0x06, 0x06, 0x0b, 0xfe, 0xc9,
-		0x18, 0x13, 0xa8, 0x8c, 0x17, 0x2a, 0xed, 0xd6, 0xae, 0x13, 0xf8, 0x19, 0xf5, 0xea, 0x5e, 0x08,
-		0xbd, 0x84, 0xb5, 0x5b, 0xbe, 0x95, 0x95, 0xea, 0x07, 0x2d, 0x46, 0x67, 0x20, 0xd9, 0xc6, 0xdb,
-		0x83, 0x5d, 0xb5, 0x8f, 0xdb, 0x5a, 0xcb, 0xf6, 0xaf, 0x29, 0x09, 0x5a, 0xa5, 0xd0, 0x1a, 0xf4,
-		0x2c, 0xc4, 0xc9, 0x38, 0x19, 0x74, 0x9c, 0xe7, 0xa9, 0x18, 0x1e, 0x3b, 0x5c, 0x0c, 0x7c, 0x98,
-		0x05, 0x92, 0xe2, 0xe2, 0xa3, 0x6b, 0x10, 0xb5, 0xb5, 0xfe, 0x2e, 0xb6, 0xad, 0xec, 0xc2, 0x72,
-		0xf0, 0x4c, 0x7a, 0x4c, 0xee, 0x6e, 0x0c, 0xa9, 0x26, 0x45, 0xa1, 0xe1, 0xb7, 0x40, 0x47, 0xcf,
-		0x81, 0xcc, 0x53, 0xc4, 0x2a, 0x8f, 0x9d, 0xad, 0xec, 0x22, 0x55, 0xc2, 0x47, 0x0f, 0x27, 0xc9,
-		0x33, 0xcc, 0x65, 0x86, 0xa4, 0x64, 0xb0, 0xef, 0xdb, 0x3f, 0x37, 0x8e, 0x1d, 0x65, 0x6e, 0x6c,
-		0x41, 0x86, 0xff, 0xad, 0x5a, 0x83, 0x5e, 0xcf, 0xec, 0xdb, 0xd9, 0xe3, 0x14, 0x7f, 0x0a, 0x43,
-		0x82, 0x18, 0xc3, 0x51, 0xd2, 0x3b, 0xbe, 0xef, 0xaf, 0xdd, 0x94, 0xcb, 0xbd, 0x00, 0x69, 0xbf,
-		0x30, 0xbc, 0x09, 0xfa, 0xe0, 0x8c, 0x09, 0x7a, 0x12, 0xa8, 0x88, 0xe8, 0x8f, 0x2c, 0x4f, 0xec,
-		0x23, 0xf7, 0xfd, 0x01, 0x48, 0xfb, 0x3b, 0x86, 0xae, 0x02, 0x12, 0x23, 0xa6, 0x1b, 0x76, 0xdf,
-		0x6c, 0x0f, 0x5a, 0xb8, 0xcd, 0x27, 0xed, 0xe4, 0x76, 0xe6, 0x39, 0x4e, 0xd5, 0x41, 0xf1, 0x12,
-		0xf2, 0xcc, 0x84, 0xc0, 0x8c, 0x84, 0xca, 0xee, 0x1c, 0x39, 0x07, 0x0b, 0x82, 0x00, 0x21, 0xf6,
-		0x92, 0xd6, 0x37, 0x88, 0x9b, 0xcc, 0x1c, 0x77, 0xe4, 0xa9, 0x7a, 0x8e, 0xd5, 0xa0, 0x02, 0x08,
-		0x75, 0x51, 0xfb, 0xb8, 0x6b, 0xde, 0xc6, 0x6d, 0x9e, 0x83, 0x9a, 0xdc, 0x6c, 0x9a, 0x23, 0x28,
-		0x0c, 0x3e, 0x7f, 0x0e, 0xc2, 0xd4, 0x04, 0x21, 0x00, 0x6e, 0x84, 0xe4, 0x39, 0x14, 0x83, 0x50,
-		0xa9, 0xae, 0x90, 0x25, 0x52, 0x86, 0x24, 0x2b, 0x55, 0x37, 0xab, 0x95, 0x52, 0x45, 0x0e, 0xe4,
-		0x2f, 0x40, 0x84, 0xd9, 0x15, 0xb2, 0x7c, 0x3a, 0x96, 0x45, 0x9e, 0xe3, 0x9f, 0x9c, 0x86, 0x24,
-		0x6a, 0xb7, 0x36, 0x8a, 0x15, 0x45, 0x0e, 0xe4, 0xb7, 0x20, 0x33, 0x34, 0x0f, 0xd1, 0x31, 0x98,
-		0x57, 0x2a, 0xcd, 0x4a, 0xad, 0x59, 0xad, 0xd7, 0xd4, 0xad, 0xda, 0xb3, 0xb5, 0xfa, 0x73, 0x35,
-		0x79, 0xce, 0x5f, 0x2c, 0xd6, 0x62, 0x09, 0x2d, 0x82, 0xec, 0x16, 0x37, 0xea, 0x5b, 0x0a, 0xe5,
-		0xe6, 0x3b, 0x03, 0x20, 0x0f, 0x4f, 0x4a, 0x74, 0x02, 0x16, 0x9a, 0x05, 0xe5, 0x6a, 0xa5, 0xa9,
-		0xb2, 0x2c, 0x8a, 0x43, 0x7a, 0x11, 0x64, 0x6f, 0xc5, 0x95, 0x2a, 0x4d, 0x12, 0x2d, 0xc1, 0x29,
-		0x6f, 0x69, 0xe5, 0xf9, 0x66, 0xa5, 0xd6, 0xa0, 0x8d, 0x17, 0x6a, 0x57, 0x89, 0x63, 0x30, 0x44,
-		0x4f, 0xe4, 0x6d, 0x82, 0x84, 0x55, 0x3f, 0xbd, 0xca, 0x7a, 0x59, 0x0e, 0x0d, 0x17, 0xd7, 0x6b,
-		0x95, 0xfa, 0x15, 0x39, 0x3c, 0xdc, 0x3a, 0xcd, 0xe5, 0x44, 0x50, 0x0e, 0x8e, 0x0f, 0x97, 0xaa,
-		0x95, 0x5a, 0x53, 0xb9, 0x29, 0x47, 0x87, 0x1b, 0x6e, 0x54, 0x94, 0x1b, 0xd5, 0x52, 0x45, 0x8e,
-		0xa1, 0xe3, 0x80, 0xfc, 0x1c, 0x35, 0xaf, 0xd5, 0xcb, 0x72, 0x7c, 0xdc, 0xaa, 0x85, 0xe4, 0x85,
-		0xfc, 0x4f, 0x49, 0x90, 0xf4, 0xe6, 0x55, 0x7c, 0x46, 0x45, 0x7a, 0xbd, 0x2d, 0xb8, 0xf9, 0xdf,
-		0x09, 0x40, 0xc2, 0x93, 0x60, 0x21, 0x81, 0xac, 0xd6, 0xe9, 0x98, 0x2f, 0xa9, 0x5a, 0x47, 0xd7,
-		0x2c, 0xbe, 0x26, 0x02, 0x2d, 0x2a, 0x90, 0x92, 0x59, 0xd7, 0xa0, 0xd9, 0xdd, 0x97, 0xc8, 0x5d,
-		0xbb, 0x2f, 0xd1, 0xd7, 0xa1, 0xfb, 0x12, 0x96, 0x23, 0xf9, 0xdf, 0x0b, 0x80, 0x3c, 0x9c, 0x2f,
-		0x19, 0x92, 0x9b, 0x34, 0x49, 0x6e, 0xde, 0xfe, 0x05, 0x8e, 0xd2, 0xbf, 0xe1, 0x55, 0x3d, 0x38,
-		0x71, 0x55, 0x1f, 0xb3, 0x58, 0x85, 0x5e, 0xcf, 0x8b, 0x95, 0x57, 0x5d, 0xff, 0xbd, 0x04, 0x69,
-		0x7f, 0x7a, 0xc7, 0x27, 0xb1, 0xfc, 0x51, 0x24, 0xe6, 0x1f, 0x91, 0xfb, 0x26, 0x8d, 0xc8, 0xd7,
-		0xa5, 0x5f, 0x3f, 0x18, 0x84, 0x94, 0x2f, 0xff, 0x33, 0x2b, 0x77, 0x6f, 0x83, 0x79, 0xbd, 0x8d,
-		0xbb, 0x3d, 0xd3, 0xc6, 0x46, 0xeb, 0x40, 0xed, 0xe0, 0xdb, 0xb8, 0x43, 0xc5, 0x90, 0x1e, 0xb3,
-		0xeb, 0xeb, 0x6b, 0x61, 0xa5, 0xea, 0xe2, 0xad, 0x13, 0xb4, 0xb5, 0x85, 0x6a, 0xb9, 0xb2, 0xb1,
-		0x59, 0x6f, 0x56, 0x6a, 0xa5, 0x9b, 0xc2, 0x92, 0x2b, 0xb2, 0x3e, 0x04, 0xe6, 0x13, 0xf8, 0xfd,
-		0xaf, 0x8f, 0xc0, 0x73, 0x13, 0xe4, 0xe1, 0xde, 0x10, 0x83, 0x3e, 0xa6, 0x3f, 0xf2, 0x1c, 0x5a,
-		0x80, 0x4c, 0xad, 0xae, 0x36, 0xaa, 0xe5, 0x8a, 0x5a, 0xb9, 0x72, 0xa5, 0x52, 0x6a, 0x36, 0xd8,
-		0xee, 0x85, 0x03, 0xdd, 0x94, 0x03, 0xde, 0xb1, 0xf9, 0xa1, 0x20, 0x2c, 0x8c, 0xe1, 0x04, 0x15,
-		0x78, 0x9a, 0x90, 0xe5, 0x31, 0x1f, 0x9b, 0x85, 0xfb, 0x15, 0x12, 0xe1, 0x6f, 0x6a, 0x7d, 0x9b,
-		0x67, 0x15, 0x1f, 0x06, 0x22, 0x5e, 0xc3, 0x26, 0x2e, 0x7e, 0x9f, 0xef, 0x0a, 0x31, 0x17, 0x24,
-		0xe3, 0x96, 0xb3, 0x8d, 0xa1, 0x47, 0x01, 0xf5, 0x4c, 0x4b, 0xb7, 0xf5, 0xdb, 0x98, 0xf8, 0x50,
-		0x1c, 0x98, 0x4c, 0xdc, 0x90, 0x22, 0x8b, 0x9a, 0xaa, 0x61, 0x3b, 0xd0, 0x06, 0xde, 0xd5, 0x86,
-		0xa0, 0x49, 0x08, 0x12, 0x54, 0x64, 0x51, 0xe3, 0x40, 0xdf, 0x07, 0xc9, 0xb6, 0x39, 0xd8, 0xee,
-		0x60, 0x0e, 0x47, 0x4c, 0xb2, 0xa4, 0x24, 0x58, 0x99, 0x03, 0xc2, 0x53, 0x67, 0xee, 0xde, 0x55,
-		0x52, 0x49, 0xb0, 0x32, 0x06, 0xf2, 0x10, 0x64, 0xb4, 0xdd, 0xdd, 0x3e, 0x21, 0x2e, 0x08, 0xb1,
-		0x64, 0x60, 0xda, 0x29, 0xa6, 0x80, 0xb9, 0xeb, 0x10, 0x13, 0x72, 0x20, 0x31, 0x30, 0x91, 0x84,
-		0xda, 0x63, 0xf9, 0xee, 0xc0, 0x99, 0xb8, 0x12, 0x33, 0x44, 0xe5, 0x7d, 0x90, 0xd4, 0x2d, 0xf7,
-		0x1c, 0x54, 0x36, 0xb0, 0x1c, 0x38, 0x13, 0x53, 0x12, 0xba, 0xe5, 0x9e, 0x75, 0xfa, 0xe0, 0x3c,
-		0x80, 0xab, 0x6c, 0xe8, 0x7b, 0x24, 0x48, 0xb3, 0x05, 0xa6, 0xd7, 0xc7, 0x16, 0x36, 0x5a, 0x22,
-		0x34, 0x7c, 0xf8, 0x10, 0x15, 0x65, 0x66, 0x6e, 0x93, 0x23, 0x14, 0x9f, 0x79, 0xb7, 0x24, 0xbd,
-		0x2a, 0x85, 0x5e, 0x95, 0xa4, 0x0f, 0x4b, 0x29, 0x14, 0xab, 0x3c, 0xbf, 0xb9, 0x5e, 0x2d, 0x55,
-		0x9b, 0xd9, 0x77, 0x45, 0xe9, 0x77, 0x75, 0x83, 0x7f, 0x7f, 0x26, 0xea, 0xaf, 0x7f, 0x2d, 0xfa,
-		0x73, 0x52, 0x30, 0xf6, 0x5a, 0x54, 0x49, 0xed, 0x78, 0xe9, 0xa1, 0x8e, 0xf7, 0x64, 0x47, 0x60,
-		0x52, 0x30, 0xe9, 0x72, 0x53, 0xe1, 0xe7, 0x39, 0x8a, 0x0f, 0x53, 0x46, 0x22, 0x94, 0x91, 0x04,
-		0x8a, 0x94, 0xd6, 0xeb, 0x8d, 0x4a, 0x99, 0xb2, 0x11, 0x47, 0xa1, 0xfa, 0x66, 0xa5, 0x96, 0xfd,
-		0x8c, 0x68, 0xd2, 0x3d, 0x04, 0xf2, 0xaa, 0x04, 0x27, 0xc4, 0xd6, 0x2d, 0x5f, 0x6b, 0xb1, 0xd1,
-		0x32, 0xdb, 0xc2, 0xbb, 0x4d, 0xaf, 0x9e, 0x3f, 0xac, 0x71, 0x85, 0xa3, 0x52, 0x91, 0x54, 0x38,
-		0x62, 0xf1, 0xb1, 0x11, 0x91, 0x14, 0x6a, 0x65, 0xce, 0x4b, 0x02, 0x45, 0x36, 0x0b, 0xa5, 0x67,
-		0x2b, 0x65, 0x97, 0x9b, 0x63, 0xfd, 0x71, 0x54, 0xd0, 0xb7, 0x40, 0x66, 0x60, 0xef, 0x3c, 0x4d,
-		0x74, 0x43, 0x6f, 0xb3, 0xbd, 0xf4, 0xd0, 0xa4, 0x4d, 0x58, 0x97, 0xa3, 0x2d, 0x7b, 0xe7, 0xe9,
-		0x1b, 0x0e, 0x06, 0x17, 0x0a, 0x63, 0x25, 0x8e, 0x42, 0xb5, 0x7a, 0xad, 0x22, 0xd8, 0xa0, 0xfb,
-		0xce, 0x37, 0x5d, 0x36, 0xd2, 0x03, 0x1f, 0x2a, 0xfa, 0x16, 0x90, 0x45, 0x8a, 0xc8, 0x11, 0x49,
-		0x78, 0xd2, 0x3e, 0xb2, 0xcb, 0x00, 0x4f, 0x34, 0x39, 0xc2, 0x78, 0xd0, 0xc3, 0xc1, 0x22, 0xca,
-		0xac, 0x57, 0x6a, 0x57, 0x9b, 0xd7, 0xd4, 0x4d, 0xa5, 0x42, 0xb7, 0x03, 0xb3, 0xef, 0x12, 0xcd,
-		0x67, 0xba, 0x7e, 0x44, 0xf4, 0xad, 0x12, 0x24, 0x98, 0x0b, 0xc4, 0xf2, 0x52, 0x2c, 0xb1, 0xf0,
-		0xe0, 0x61, 0x6d, 0x53, 0x0f, 0x88, 0x42, 0x17, 0x2f, 0xd1, 0x66, 0x83, 0x42, 0x21, 0x4e, 0x20,
-		0xb4, 0x5e, 0xb9, 0x5a, 0x28, 0xdd, 0x54, 0x8b, 0x95, 0x46, 0x93, 0x58, 0xb2, 0xba, 0xc2, 0x74,
-		0x14, 0x50, 0xb8, 0xb0, 0xbe, 0x5e, 0x7f, 0xce, 0x15, 0x04, 0xbc, 0xe8, 0x90, 0x41, 0x3f, 0x29,
-		0xc1, 0x22, 0x36, 0x76, 0xcc, 0x7e, 0x8b, 0x6e, 0x61, 0x93, 0x19, 0x6d, 0xd9, 0x07, 0x1d, 0x36,
-		0xa3, 0xc7, 0x06, 0xe5, 0x5e, 0xcd, 0xa4, 0x78, 0x35, 0x8a, 0xd6, 0x20, 0x58, 0xc5, 0xea, 0xbb,
-		0xa5, 0xc0, 0xab, 0x84, 0xb1, 0x00, 0xe5, 0x2d, 0xf4, 0xaa, 0x14, 0xa6, 0x1c, 0x46, 0x5f, 0x95,
-		0x62, 0xaf, 0x4a, 0xf1, 0x0f, 0x4b, 0xf3, 0x28, 0xd9, 0x68, 0xde, 0x5c, 0xaf, 0xa8, 0x8c, 0x5b,
-		0xca, 0x61, 0x1a, 0xc5, 0x69, 0xd9, 0xea, 0xe3, 0xab, 0x4f, 0x66, 0x3f, 0x4b, 0xb9, 0xfc, 0x6c,
-		0x54, 0x41, 0x78, 0x84, 0x3c, 0xfa, 0x65, 0x09, 0x4e, 0x8a, 0x4d, 0x73, 0x8b, 0x6e, 0xa4, 0xa9,
-		0x9e, 0x2d, 0xb7, 0x18, 0x65, 0xb9, 0x72, 0x18, 0xcb, 0xee, 0xbe, 0x1b, 0x2f, 0x5c, 0xe1, 0x01,
-		0xef, 0xf0, 0xb6, 0x5c, 0xf1, 0x22, 0xeb, 0xc9, 0x87, 0xa5, 0x0c, 0x82, 0xca, 0xf3, 0x9b, 0x75,
-		0xa5, 0xa9, 0x16, 0xd6, 0xd7, 0x29, 0xbf, 0xc7, 0x90, 0xcc, 0x4b, 0x9a, 0xf5, 0x4d, 0x75, 0xbd,
-		0x72, 0xa3, 0xb2, 0xee, 0xb2, 0x7d, 0xa2, 0x3d, 0x9e, 0x60, 0xee, 0x43, 0x12, 0xcc, 0x8f, 0x34,
-		0x9f, 0x7f, 0x87, 0x04, 0x27, 0x26, 0xb0, 0x80, 0x1e, 0x80, 0xfb, 0xca, 0x95, 0x2b, 0x85, 0xad,
-		0xf5, 0xa6, 0xda, 0xb8, 0xb9, 0x51, 0xac, 0xaf, 0xab, 0x37, 0xaa, 0x8d, 0x6a, 0xb1, 0xba, 0x5e,
-		0x6d, 0x7a, 0x17, 0xb0, 0x34, 0x78, 0x18, 0x64, 0xe1, 0xda, 0x30, 0x7b, 0x72, 0x80, 0x04, 0x85,
-		0xeb, 0xf5, 0x52, 0x61, 0x9d, 0x02, 0x05, 0x45, 0xcc, 0x59, 0x6a, 0xca, 0xa1, 0xeb, 0xb1, 0x98,
-		0xc4, 0xd7, 0xb6, 0x6f, 0x84, 0x94, 0xcf, 0xf8, 0x91, 0x10, 0x89, 0x86, 0x56, 0x44, 0x9f, 0x1b,
-		0x95, 0x5a, 0xc9, 0x1b, 0xd2, 0x25, 0xc1, 0x31, 0x76, 0xb2, 0x44, 0xbe, 0x84, 0x29, 0x94, 0x03,
-		0x64, 0x51, 0xe5, 0xea, 0xe8, 0x6c, 0x57, 0x07, 0xf3, 0x4f, 0x41, 0x4c, 0x18, 0x33, 0x12, 0xa8,
-		0xd1, 0x78, 0x6b, 0x28, 0x4c, 0x8c, 0x01, 0xb5, 0x64, 0xb2, 0x44, 0x18, 0x64, 0x16, 0x4e, 0x0e,
-		0xe4, 0x6f, 0xc0, 0xb1, 0xb1, 0x86, 0x08, 0xdd, 0x0f, 0x4b, 0x62, 0x8b, 0x9c, 0x85, 0x80, 0x6a,
-		0xa5, 0x56, 0xaa, 0x97, 0x49, 0xd0, 0xec, 0xd2, 0x04, 0xe0, 0x16, 0x89, 0x71, 0x29, 0xac, 0x95,
-		0x1c, 0xc8, 0x57, 0x21, 0xed, 0x37, 0x27, 0xe8, 0x14, 0x9c, 0xd8, 0x6a, 0x5e, 0x79, 0x5a, 0xbd,
-		0x51, 0x58, 0xaf, 0x96, 0x0b, 0x43, 0xe1, 0x31, 0x00, 0xb7, 0x29, 0x72, 0x80, 0x30, 0x4a, 0x6c,
-		0x8d, 0x1c, 0xcc, 0x87, 0x62, 0x92, 0x2c, 0xe5, 0x1b, 0x90, 0x19, 0x32, 0x0c, 0xe8, 0x1e, 0xc8,
-		0xf2, 0x78, 0x75, 0x1c, 0x57, 0x54, 0x42, 0x3e, 0x53, 0xc1, 0x22, 0xf7, 0x72, 0x65, 0xbd, 0xba,
-		0x51, 0x6d, 0x52, 0xfe, 0xae, 0x01, 0xb8, 0x33, 0x9e, 0x78, 0x30, 0xd7, 0x1b, 0xf5, 0x9a, 0x7a,
-		0x85, 0x84, 0xfd, 0x4d, 0x0f, 0xa9, 0x38, 0xb0, 0x19, 0x2e, 0x4b, 0x24, 0x3a, 0x1d, 0x35, 0x03,
-		0x72, 0x20, 0xff, 0x1c, 0xa0, 0xd1, 0xd9, 0x8a, 0x96, 0xe1, 0x9e, 0x4a, 0xed, 0x4a, 0x5d, 0x29,
-		0x55, 0xd4, 0x5a, 0x61, 0x83, 0xf0, 0xc7, 0xe6, 0xa6, 0x4b, 0x3a, 0x05, 0xee, 0xd4, 0x14, 0x39,
-		0x09, 0x77, 0xf6, 0xca, 0x81, 0xb3, 0x3f, 0x10, 0x20, 0x9e, 0xd1, 0x7b, 0x6a, 0xb9, 0xef, 0x0a,
-		0xa0, 0x7b, 0x63, 0xaf, 0x45, 0x51, 0x74, 0xa5, 0xb7, 0xbd, 0xd2, 0xea, 0xf5, 0x72, 0x19, 0xf2,
-		0x47, 0xa9, 0xd7, 0xbb, 0x22, 0xfc, 0xbd, 0xa5, 0xd8, 0x67, 0xa3, 0x28, 0x46, 0x4a, 0x5f, 0xd4,
-		0x6e, 0x6b, 0x39, 0x99, 0xfc, 0x75, 0x5d, 0xbb, 0xad, 0x39, 0x00, 0xa7, 0x62, 0x9f, 0x8b, 0xa2,
-		0x08, 0x29, 0xde, 0x35, 0x73, 0x69, 0xf2, 0xef, 0x55, 0xd3, 0xa9, 0xbc, 0x3f, 0xf6, 0xf9, 0x28,
-		0x02, 0x52, 0xd8, 0x3b, 0xb0, 0xf7, 0x4c, 0x23, 0x87, 0xc8, 0xdf, 0x9b, 0xf4, 0x6f, 0x07, 0xe8,
-		0x42, 0xec, 0xb7, 0x63, 0x28, 0xbb, 0xa2, 0x77, 0x7b, 0xe4, 0xbf, 0x3e, 0xb6, 0x2c, 0xd5, 0x89,
-		0x6d, 0xb0, 0x9d, 0x3b, 0x4e, 0x6b, 0xaa, 0xac, 0xc6, 0xe3, 0x26, 0xac, 0xc4, 0xbe, 0xad, 0x86,
-		0x64, 0xc1, 0x99, 0xda, 0x1d, 0xd8, 0xda, 0x76, 0x07, 0xe7, 0x4e, 0x08, 0x0e, 0x37, 0x58, 0x81,
-		0x87, 0x97, 0x6f, 0xaf, 0x71, 0x5e, 0x88, 0x8d, 0x39, 0xcf, 0x79, 0xa1, 0x7f, 0x0b, 0xa0, 0xb3,
-		0x91, 0xd8, 0x7b, 0x6a, 0xf2, 0xfb, 0x6a, 0x67, 0x23, 0xb1, 0xf7, 0xd5, 0xe4, 0xef, 0xa9, 0x5d,
-		0x8f, 0xc4, 0x3e, 0x13, 0x95, 0x5f, 0x8b, 0xe6, 0xff, 0x2c, 0x08, 0xc8, 0x6d, 0xdb, 0x49, 0x36,
-		0x3e, 0x0f, 0x31, 0x27, 0x7b, 0xc9, 0x0e, 0x98, 0xbf, 0xf1, 0x10, 0x43, 0x26, 0xd0, 0x3c, 0x45,
-		0x43, 0xd9, 0x4c, 0x87, 0x1a, 0x2a, 0x40, 0xa6, 0xab, 0x1b, 0x7a, 0x77, 0xd0, 0x55, 0x45, 0x4a,
-		0x6f, 0x6a, 0xaa, 0x8a, 0x23, 0xf0, 0x6f, 0x4a, 0x42, 0xdb, 0xf7, 0x91, 0x08, 0x4f, 0x25, 0xc1,
-		0x10, 0xf8, 0x77, 0xee, 0x6f, 0x24, 0xc8, 0x4e, 0x62, 0xf6, 0xae, 0xb2, 0x8d, 0x35, 0x58, 0x34,
-		0x6f, 0xe3, 0x7e, 0x5f, 0x6f, 0xd3, 0x4d, 0x44, 0x27, 0x06, 0x09, 0x4d, 0x8f, 0x41, 0x16, 0x3c,
-		0x88, 0xce, 0xa0, 0x16, 0x89, 0xab, 0xb8, 0x4f, 0xbc, 0x24, 0x41, 0x29, 0x3c, 0x9d, 0x52, 0x8a,
-		0xa2, 0x08, 0x1a, 0xd7, 0x89, 0x2d, 0x20, 0x61, 0x7f, 0x40, 0x0e, 0xba, 0x81, 0x4e, 0xfe, 0x03,
-		0x41, 0x48, 0xfb, 0xcf, 0x49, 0xa3, 0x32, 0xc4, 0x3a, 0x26, 0x3f, 0x40, 0xc8, 0x46, 0xfb, 0xcc,
-		0x94, 0xa3, 0xd5, 0x2b, 0xeb, 0x1c, 0x5e, 0x71, 0x30, 0x73, 0xff, 0x4e, 0x82, 0x98, 0x28, 0x46,
-		0xc7, 0x21, 0xd4, 0xd3, 0xec, 0x3d, 0x4a, 0x2e, 0x5c, 0x0c, 0xc8, 0x92, 0x42, 0xbf, 0x49, 0xb9,
-		0xd5, 0xd3, 0xd8, 0xe1, 0x49, 0x5e, 0x4e, 0xbe, 0x49, 0xb0, 0xd1, 0xc1, 0x5a, 0x9b, 0x6e, 0x7f,
-		0x9b, 0xdd, 0x2e, 0x36, 0x6c, 0x4b, 0x04, 0x1b, 0xbc, 0xbc, 0xc4, 0x8b, 0xd1, 0x23, 0x30, 0x6f,
-		0xf7, 0x35, 0xbd, 0xe3, 0x83, 0x0d, 0x51, 0x58, 0x59, 0x54, 0x38, 0xc0, 0x6b, 0x70, 0x52, 0xd0,
-		0x6d, 0x63, 0x5b, 0x6b, 0xed, 0xe1, 0xb6, 0x8b, 0x14, 0xa1, 0xe7, 0x66, 0x4e, 0x70, 0x80, 0x32,
-		0xaf, 0x17, 0xb8, 0x67, 0x07, 0xbe, 0x7b, 0x12, 0xbb, 0x08, 0xf3, 0x7b, 0x12, 0xe7, 0x27, 0xdc,
-		0x93, 0x18, 0x3e, 0xa6, 0xee, 0xb9, 0x24, 0x71, 0x76, 0x0c, 0x8a, 0x5f, 0xa2, 0x6e, 0xd4, 0xf0,
-		0xc9, 0x00, 0xcc, 0x8b, 0x73, 0x02, 0x6d, 0x67, 0x8c, 0x36, 0x00, 0x34, 0xc3, 0x30, 0x6d, 0xef,
-		0x28, 0x8d, 0x86, 0x75, 0x23, 0x78, 0x2b, 0x05, 0x07, 0x49, 0xf1, 0x10, 0xc8, 0x7d, 0x5e, 0x02,
-		0x70, 0xab, 0x26, 0x0e, 0xd7, 0x12, 0x24, 0x78, 0xaf, 0xe8, 0x5d, 0x13, 0x96, 0x4a, 0x07, 0x56,
-		0x74, 0x45, 0xef, 0xd0, 0xe3, 0x40, 0xdb, 0x78, 0x57, 0x37, 0xf8, 0x51, 0x48, 0xf6, 0x21, 0x8e,
-		0x03, 0x85, 0xdc, 0xa3, 0xc2, 0x0a, 0xc4, 0x2c, 0xdc, 0xd5, 0x0c, 0x5b, 0x6f, 0xf1, 0xc9, 0x7a,
-		0xf1, 0x48, 0xcc, 0xaf, 0x34, 0x38, 0xb6, 0xe2, 0xd0, 0xc9, 0x9f, 0x81, 0x98, 0x28, 0x75, 0x56,
-		0xc0, 0x39, 0x14, 0x85, 0x60, 0xa3, 0x42, 0x7c, 0x00, 0xba, 0x10, 0x55, 0x0b, 0x0d, 0x39, 0x70,
-		0xf6, 0x57, 0x02, 0x10, 0x15, 0xd6, 0x63, 0x01, 0x32, 0x95, 0x72, 0x75, 0x68, 0x31, 0x5d, 0x80,
-		0xb4, 0x28, 0xe4, 0x8b, 0xc9, 0xbb, 0xa2, 0xde, 0xc2, 0x4d, 0xa5, 0xde, 0xac, 0xaf, 0xca, 0x7f,
-		0x32, 0x5a, 0xf8, 0x84, 0xfc, 0x99, 0x28, 0x9a, 0x87, 0xa4, 0x28, 0x5c, 0x7d, 0x7c, 0xf5, 0x09,
-		0xf9, 0xb5, 0xe1, 0xa2, 0x27, 0xe5, 0xcf, 0x46, 0xd1, 0x31, 0x90, 0xdd, 0x96, 0x1b, 0xcd, 0x42,
-		0x71, 0xbd, 0x22, 0x7f, 0x77, 0x8d, 0xac, 0xa4, 0xa2, 0xf8, 0xbc, 0xda, 0x24, 0x0b, 0x65, 0xbd,
-		0xb6, 0x7e, 0x53, 0x96, 0xbc, 0x15, 0xab, 0x9e, 0x8a, 0x00, 0xba, 0x17, 0x4e, 0x88, 0x8a, 0x4b,
-		0x97, 0x2e, 0x5d, 0x7a, 0xca, 0x53, 0x79, 0xe7, 0xbd, 0x91, 0xe1, 0xea, 0xa7, 0x3d, 0xd5, 0x1f,
-		0x18, 0xad, 0xbe, 0xe4, 0xa9, 0xfe, 0x91, 0xf7, 0x46, 0xd0, 0x02, 0x24, 0x44, 0xf5, 0x46, 0xe1,
-		0x79, 0xf9, 0xcb, 0x5f, 0xfe, 0xf2, 0x97, 0xa3, 0x67, 0xb7, 0x40, 0x1e, 0x71, 0x08, 0x17, 0x41,
-		0xf6, 0x79, 0x80, 0x44, 0xea, 0x73, 0x43, 0xa5, 0xd4, 0xc9, 0x93, 0x25, 0xe2, 0x60, 0x79, 0x4a,
-		0x99, 0x43, 0x28, 0x07, 0x8a, 0xdf, 0x02, 0x0b, 0x2d, 0xb3, 0x3b, 0xac, 0x08, 0x45, 0x79, 0xe8,
-		0xd0, 0x93, 0x75, 0x4d, 0x7a, 0xe1, 0x31, 0x0e, 0xb4, 0x6b, 0x76, 0x34, 0x63, 0x77, 0xc5, 0xec,
-		0xef, 0xba, 0x57, 0xa8, 0x48, 0xf0, 0x6a, 0x79, 0x2e, 0x52, 0xf5, 0xb6, 0xff, 0x46, 0x92, 0x3e,
-		0x1c, 0x08, 0x5e, 0xdd, 0x2c, 0xfe, 0x44, 0x20, 0x77, 0x95, 0x21, 0x6e, 0x0a, 0x35, 0x53, 0xf0,
-		0x4e, 0x07, 0xb7, 0x88, 0x2e, 0xc0, 0x9f, 0x3e, 0x02, 0x8b, 0xbb, 0xe6, 0xae, 0x49, 0x29, 0x9d,
-		0x23, 0x7f, 0xf1, 0x3b, 0x58, 0x71, 0xa7, 0x34, 0x37, 0xf5, 0xc2, 0xd6, 0x5a, 0x0d, 0x16, 0x38,
-		0xb0, 0x4a, 0x63, 0x69, 0x76, 0x2e, 0x03, 0x1d, 0x7a, 0x58, 0x30, 0xfb, 0xb3, 0x7f, 0x4c, 0x93,
-		0xa0, 0xca, 0x3c, 0x47, 0x25, 0x75, 0xec, 0xe8, 0xc6, 0x9a, 0x02, 0xc7, 0x7c, 0xf4, 0x58, 0x1e,
-		0x03, 0xf7, 0xa7, 0x50, 0xfc, 0x0d, 0x4e, 0x71, 0xc1, 0x43, 0xb1, 0xc1, 0x51, 0xd7, 0x4a, 0x90,
-		0x3a, 0x0a, 0xad, 0x7f, 0xc5, 0x69, 0x25, 0xb1, 0x97, 0xc8, 0x55, 0xc8, 0x50, 0x22, 0xad, 0x81,
-		0x65, 0x9b, 0x5d, 0x9a, 0x24, 0x3a, 0x9c, 0xcc, 0xbf, 0xfe, 0x63, 0x66, 0xc3, 0xd3, 0x04, 0xad,
-		0xe4, 0x60, 0xad, 0xad, 0x01, 0xcd, 0x09, 0xb4, 0x71, 0xab, 0x33, 0x85, 0xc2, 0x6f, 0x72, 0x46,
-		0x1c, 0xf8, 0xb5, 0x1b, 0x24, 0x44, 0x1c, 0x74, 0x69, 0x0e, 0xc7, 0xcb, 0xc9, 0xf4, 0x63, 0x81,
-		0xd9, 0xdf, 0x79, 0x27, 0x5b, 0x26, 0x16, 0x1c, 0x02, 0x1e, 0x9e, 0x3c, 0xa3, 0xb8, 0x8b, 0x6d,
-		0x1b, 0xf7, 0x2d, 0x55, 0xeb, 0x8c, 0x63, 0xcf, 0x73, 0x92, 0x2a, 0xfb, 0x83, 0x5f, 0xf0, 0x8f,
-		0xe2, 0x55, 0x86, 0x59, 0xe8, 0x74, 0xd6, 0xb6, 0xe0, 0xc4, 0x18, 0xad, 0x98, 0x81, 0xe6, 0x0f,
-		0x71, 0x9a, 0x8b, 0x23, 0x9a, 0x41, 0xc8, 0x6e, 0x82, 0x28, 0x77, 0xc6, 0x72, 0x06, 0x9a, 0xef,
-		0xe7, 0x34, 0x11, 0xc7, 0x15, 0x43, 0x4a, 0x28, 0x5e, 0x87, 0xf9, 0xdb, 0xb8, 0xbf, 0x6d, 0x5a,
-		0xfc, 0xf4, 0xda, 0x0c, 0xe4, 0x7e, 0x98, 0x93, 0xcb, 0x70, 0x44, 0x7a, 0x9c, 0x8d, 0xd0, 0xba,
-		0x04, 0xb1, 0x1d, 0xad, 0x85, 0x67, 0x20, 0x71, 0x87, 0x93, 0x88, 0x12, 0x78, 0x82, 0x5a, 0x80,
-		0xe4, 0xae, 0xc9, 0xd3, 0x78, 0xd3, 0xd1, 0x3f, 0xc0, 0xd1, 0x13, 0x02, 0x87, 0x93, 0xe8, 0x99,
-		0xbd, 0x41, 0x47, 0xb3, 0x67, 0xe1, 0xe0, 0x47, 0x04, 0x09, 0x81, 0xc3, 0x49, 0x1c, 0x41, 0xac,
-		0x1f, 0x14, 0x24, 0x2c, 0x8f, 0x3c, 0x9f, 0x81, 0x84, 0x69, 0x74, 0x0e, 0x4c, 0x63, 0x16, 0x26,
-		0x3e, 0xc4, 0x29, 0x00, 0x47, 0x21, 0x04, 0x2e, 0x43, 0x7c, 0xd6, 0x81, 0xf8, 0xb1, 0x2f, 0x88,
-		0xe9, 0x21, 0x46, 0xe0, 0x2a, 0x64, 0x84, 0x81, 0xd2, 0x4d, 0x63, 0x06, 0x12, 0x1f, 0xe1, 0x24,
-		0xd2, 0x1e, 0x34, 0xde, 0x0d, 0x1b, 0x5b, 0xf6, 0x2e, 0x9e, 0x85, 0xc8, 0x3f, 0x14, 0xdd, 0xe0,
-		0x28, 0x5c, 0x94, 0xdb, 0xd8, 0x68, 0xed, 0xcd, 0x46, 0xe1, 0xa3, 0x42, 0x94, 0x02, 0x87, 0x90,
-		0x28, 0x41, 0xaa, 0xab, 0xf5, 0xad, 0x3d, 0xad, 0x33, 0xd3, 0x70, 0xfc, 0x38, 0xa7, 0x91, 0x74,
-		0x90, 0xb8, 0x44, 0x06, 0xc6, 0x51, 0xc8, 0xfc, 0x84, 0x90, 0x88, 0x07, 0x8d, 0x4f, 0x3d, 0x8b,
-		0x86, 0x5e, 0xea, 0x51, 0xa8, 0xfd, 0xa4, 0x98, 0x7a, 0x0c, 0x77, 0xc3, 0x4b, 0xf1, 0x32, 0xc4,
-		0x2d, 0xfd, 0xe5, 0x99, 0xc8, 0xfc, 0x94, 0x18, 0x69, 0x8a, 0x40, 0x90, 0x6f, 0xc2, 0xc9, 0xb1,
-		0xcb, 0xc4, 0x0c, 0xc4, 0x7e, 0x9a, 0x13, 0x3b, 0x3e, 0x66, 0xa9, 0xe0, 0x26, 0xe1, 0xa8, 0x24,
-		0xff, 0x91, 0x30, 0x09, 0x78, 0x88, 0xd6, 0x26, 0x2c, 0x0e, 0x0c, 0x4b, 0xdb, 0x39, 0x9a, 0xd4,
-		0xfe, 0xb1, 0x90, 0x1a, 0xc3, 0xf5, 0x49, 0xad, 0x09, 0xc7, 0x39, 0xc5, 0xa3, 0x8d, 0xeb, 0xcf,
-		0x08, 0xc3, 0xca, 0xb0, 0xb7, 0xfc, 0xa3, 0xfb, 0x0d, 0x90, 0x73, 0xc4, 0x29, 0x7c, 0x71, 0x4b,
-		0xed, 0x6a, 0xbd, 0x19, 0x28, 0xff, 0x2c, 0xa7, 0x2c, 0x2c, 0xbe, 0xe3, 0xcc, 0x5b, 0x1b, 0x5a,
-		0x8f, 0x10, 0x7f, 0x1e, 0xb2, 0x82, 0xf8, 0xc0, 0xe8, 0xe3, 0x96, 0xb9, 0x6b, 0xe8, 0x2f, 0xe3,
-		0xf6, 0x0c, 0xa4, 0x7f, 0x6e, 0x68, 0xa8, 0xb6, 0x3c, 0xe8, 0x84, 0x72, 0x15, 0x64, 0xc7, 0x57,
-		0x51, 0xf5, 0x2e, 0xdd, 0xed, 0x3c, 0x9c, 0xe2, 0xc7, 0xc4, 0x48, 0x39, 0x78, 0x55, 0x8a, 0xb6,
-		0x56, 0x01, 0x76, 0xa1, 0x66, 0x56, 0x95, 0xfc, 0x38, 0x27, 0x94, 0x72, 0xb1, 0xb8, 0xe1, 0x68,
-		0x99, 0xdd, 0x9e, 0xd6, 0x9f, 0xc5, 0xfe, 0xfd, 0xbc, 0x30, 0x1c, 0x1c, 0x85, 0x1b, 0x0e, 0xe2,
-		0xd1, 0x91, 0xd5, 0x7e, 0x06, 0x0a, 0xbf, 0x20, 0x0c, 0x87, 0xc0, 0xe1, 0x24, 0x84, 0xc3, 0x30,
-		0x03, 0x89, 0x5f, 0x14, 0x24, 0x04, 0x0e, 0x21, 0xf1, 0x16, 0x77, 0xa1, 0xed, 0xe3, 0x5d, 0xdd,
-		0xb2, 0xf9, 0x95, 0xb7, 0xc3, 0x49, 0xfd, 0xd2, 0x17, 0xfc, 0x4e, 0x98, 0xe2, 0x41, 0x25, 0x96,
-		0x88, 0xa7, 0xdd, 0xe9, 0xb6, 0xd2, 0x74, 0xc6, 0x7e, 0x59, 0x58, 0x22, 0x0f, 0x1a, 0xe1, 0xcd,
-		0xe3, 0x21, 0x12, 0xb1, 0xb7, 0x48, 0xdc, 0x3a, 0x03, 0xb9, 0x7f, 0x32, 0xc4, 0x5c, 0x43, 0xe0,
-		0x12, 0x9a, 0x1e, 0xff, 0x67, 0x60, 0xdc, 0xc2, 0x07, 0x33, 0x69, 0xe7, 0x3f, 0x1d, 0xf2, 0x7f,
-		0xb6, 0x18, 0x26, 0xb3, 0x21, 0x99, 0x21, 0x7f, 0x0a, 0x4d, 0xbb, 0x52, 0x9b, 0x7d, 0xc7, 0x17,
-		0x79, 0x7f, 0xfd, 0xee, 0xd4, 0xda, 0x3a, 0x51, 0x72, 0xbf, 0xd3, 0x33, 0x9d, 0xd8, 0x3b, 0xbf,
-		0xe8, 0xe8, 0xb9, 0xcf, 0xe7, 0x59, 0xbb, 0x02, 0x29, 0x9f, 0xc3, 0x33, 0x9d, 0xd4, 0xbb, 0x38,
-		0xa9, 0xa4, 0xd7, 0xdf, 0x59, 0xbb, 0x00, 0x21, 0xe2, 0xbc, 0x4c, 0x47, 0xff, 0x36, 0x8e, 0x4e,
-		0xc1, 0xd7, 0xde, 0x04, 0x31, 0xe1, 0xb4, 0x4c, 0x47, 0xfd, 0x76, 0x8e, 0xea, 0xa0, 0x10, 0x74,
-		0xe1, 0xb0, 0x4c, 0x47, 0xff, 0x7b, 0x02, 0x5d, 0xa0, 0x10, 0xf4, 0xd9, 0x45, 0xf8, 0x6b, 0xef,
-		0x09, 0xf1, 0x45, 0x47, 0xc8, 0xee, 0x32, 0x44, 0xb9, 0xa7, 0x32, 0x1d, 0xfb, 0x3b, 0x78, 0xe3,
-		0x02, 0x63, 0xed, 0x29, 0x08, 0xcf, 0x28, 0xf0, 0xf7, 0x72, 0x54, 0x06, 0xbf, 0x56, 0x82, 0x84,
-		0xc7, 0x3b, 0x99, 0x8e, 0xfe, 0x5d, 0x1c, 0xdd, 0x8b, 0x45, 0x58, 0xe7, 0xde, 0xc9, 0x74, 0x02,
-		0xdf, 0x2d, 0x58, 0xe7, 0x18, 0x44, 0x6c, 0xc2, 0x31, 0x99, 0x8e, 0xfd, 0x3e, 0x21, 0x75, 0x81,
-		0xb2, 0xf6, 0x0c, 0xc4, 0x9d, 0xc5, 0x66, 0x3a, 0xfe, 0xf7, 0x70, 0x7c, 0x17, 0x87, 0x48, 0xc0,
-		0xb3, 0xd8, 0x4d, 0x27, 0xf1, 0xf7, 0x85, 0x04, 0x3c, 0x58, 0x64, 0x1a, 0x0d, 0x3b, 0x30, 0xd3,
-		0x29, 0x7d, 0xaf, 0x98, 0x46, 0x43, 0xfe, 0x0b, 0x19, 0x4d, 0x6a, 0xf3, 0xa7, 0x93, 0xf8, 0x3e,
-		0x31, 0x9a, 0x14, 0x9e, 0xb0, 0x31, 0xec, 0x11, 0x4c, 0xa7, 0xf1, 0x03, 0x82, 0x8d, 0x21, 0x87,
-		0x60, 0x6d, 0x13, 0xd0, 0xa8, 0x37, 0x30, 0x9d, 0xde, 0xab, 0x9c, 0xde, 0xfc, 0x88, 0x33, 0xb0,
-		0xf6, 0x1c, 0x1c, 0x1f, 0xef, 0x09, 0x4c, 0xa7, 0xfa, 0x83, 0x5f, 0x1c, 0x8a, 0xdd, 0xbc, 0x8e,
-		0xc0, 0x5a, 0xd3, 0x5d, 0x52, 0xbc, 0x5e, 0xc0, 0x74, 0xb2, 0x3f, 0xf4, 0x45, 0xbf, 0xe1, 0xf6,
-		0x3a, 0x01, 0x6b, 0x05, 0x00, 0x77, 0x01, 0x9e, 0x4e, 0xeb, 0x87, 0x39, 0x2d, 0x0f, 0x12, 0x99,
-		0x1a, 0x7c, 0xfd, 0x9d, 0x8e, 0x7f, 0x47, 0x4c, 0x0d, 0x8e, 0x41, 0xa6, 0x86, 0x58, 0x7a, 0xa7,
-		0x63, 0x7f, 0x40, 0x4c, 0x0d, 0x81, 0x42, 0x34, 0xdb, 0xb3, 0xba, 0x4d, 0xa7, 0xf0, 0x21, 0xa1,
-		0xd9, 0x1e, 0xac, 0xb5, 0x1a, 0xcc, 0x8f, 0x2c, 0x88, 0xd3, 0x49, 0x7d, 0x98, 0x93, 0x92, 0x87,
-		0xd7, 0x43, 0xef, 0xe2, 0xc5, 0x17, 0xc3, 0xe9, 0xd4, 0x7e, 0x74, 0x68, 0xf1, 0xe2, 0x6b, 0xe1,
-		0xda, 0x65, 0x88, 0x19, 0x83, 0x4e, 0x87, 0x4c, 0x1e, 0x74, 0xf8, 0x95, 0xe7, 0xec, 0x67, 0xbf,
-		0xc4, 0xa5, 0x23, 0x10, 0xd6, 0x2e, 0x40, 0x18, 0x77, 0xb7, 0x71, 0x7b, 0x1a, 0xe6, 0xe7, 0xbe,
-		0x24, 0x0c, 0x26, 0x81, 0x5e, 0x7b, 0x06, 0x80, 0xa5, 0x46, 0xe8, 0x29, 0xff, 0x29, 0xb8, 0x9f,
-		0xff, 0x12, 0xbf, 0x12, 0xe8, 0xa2, 0xb8, 0x04, 0xd8, 0x05, 0xc3, 0xc3, 0x09, 0x7c, 0xc1, 0x4f,
-		0x80, 0x8e, 0xc8, 0x25, 0x88, 0xbe, 0x68, 0x99, 0x86, 0xad, 0xed, 0x4e, 0xc3, 0xfe, 0x53, 0x8e,
-		0x2d, 0xe0, 0x89, 0xc0, 0xba, 0x66, 0x1f, 0xdb, 0xda, 0xae, 0x35, 0x0d, 0xf7, 0xbf, 0x72, 0x5c,
-		0x07, 0x81, 0x20, 0xb7, 0x34, 0xcb, 0x9e, 0xa5, 0xdf, 0x7f, 0x26, 0x90, 0x05, 0x02, 0x61, 0x9a,
-		0xfc, 0x7d, 0x0b, 0x1f, 0x4c, 0xc3, 0xfd, 0x73, 0xc1, 0x34, 0x87, 0x5f, 0x7b, 0x13, 0xc4, 0xc9,
-		0x9f, 0xec, 0xe2, 0xf0, 0x14, 0xe4, 0xbf, 0xe0, 0xc8, 0x2e, 0x06, 0x69, 0xd9, 0xb2, 0xdb, 0xb6,
-		0x3e, 0x5d, 0xd8, 0x7f, 0xc9, 0x47, 0x5a, 0xc0, 0xaf, 0x15, 0x20, 0x61, 0xd9, 0xed, 0xf6, 0x80,
-		0xfb, 0xa7, 0x53, 0xd0, 0xff, 0xea, 0x4b, 0x4e, 0xca, 0xc2, 0xc1, 0x21, 0xa3, 0xfd, 0xd2, 0x2d,
-		0xbb, 0x67, 0xd2, 0x33, 0x61, 0xd3, 0x28, 0x7c, 0x91, 0x53, 0xf0, 0xa0, 0xac, 0x95, 0x20, 0x49,
-		0xfa, 0x22, 0x8e, 0xd6, 0x4c, 0x23, 0xf1, 0xd7, 0x5c, 0x00, 0x3e, 0xa4, 0xe2, 0x37, 0xff, 0xe6,
-		0xa7, 0x4f, 0x4b, 0x9f, 0xfc, 0xf4, 0x69, 0xe9, 0xbf, 0x7c, 0xfa, 0xb4, 0xf4, 0xbe, 0x3f, 0x3a,
-		0x3d, 0xf7, 0xc9, 0x3f, 0x3a, 0x3d, 0xf7, 0x7b, 0x7f, 0x74, 0x7a, 0x6e, 0x7c, 0x96, 0x18, 0xae,
-		0x9a, 0x57, 0x4d, 0x96, 0x1f, 0x7e, 0xe1, 0x81, 0x5d, 0xdd, 0xde, 0x1b, 0x6c, 0xaf, 0xb4, 0xcc,
-		0xee, 0xb9, 0x96, 0x69, 0x75, 0x4d, 0xeb, 0x9c, 0x3f, 0xaf, 0x4b, 0xff, 0x82, 0xff, 0x25, 0x91,
-		0x98, 0xd9, 0x9f, 0xce, 0xd5, 0x8c, 0x83, 0x49, 0x0f, 0x6f, 0x5d, 0x84, 0x60, 0xc1, 0x38, 0x40,
-		0x27, 0x99, 0x81, 0x53, 0x07, 0xfd, 0x0e, 0xbf, 0x6c, 0x1a, 0x25, 0xdf, 0x5b, 0xfd, 0x8e, 0xff,
-		0x92, 0x41, 0x92, 0x5f, 0x32, 0x28, 0x7e, 0x97, 0x74, 0xb4, 0x9e, 0xc4, 0x0a, 0xc6, 0x01, 0xed,
-		0xc8, 0xa6, 0xf4, 0xc2, 0xa3, 0x53, 0xf3, 0xdc, 0xb7, 0x0c, 0xf3, 0x25, 0x83, 0xb0, 0xdd, 0xdb,
-		0x16, 0x39, 0xee, 0xd3, 0xc3, 0x39, 0xee, 0xe7, 0x70, 0xa7, 0xf3, 0x2c, 0x81, 0x6b, 0x12, 0x94,
-		0xed, 0x08, 0x7b, 0x28, 0x01, 0xbe, 0x37, 0x00, 0xa7, 0x47, 0xd2, 0xd9, 0x5c, 0x09, 0x26, 0x09,
-		0x61, 0x0d, 0x62, 0x65, 0xa1, 0x5b, 0x59, 0x88, 0x5a, 0xb8, 0x65, 0x1a, 0x6d, 0x76, 0x5a, 0x3c,
-		0xa8, 0x88, 0x4f, 0x22, 0x08, 0x43, 0x33, 0x4c, 0x8b, 0x5f, 0xde, 0x66, 0x1f, 0xc5, 0xf7, 0x1f,
-		0x51, 0x10, 0x29, 0xd1, 0x92, 0x90, 0xc6, 0xf9, 0x19, 0xa5, 0x21, 0x3a, 0xe1, 0xcb, 0xfc, 0xcf,
-		0x2a, 0x95, 0x1f, 0x08, 0xc0, 0xd2, 0xb0, 0x54, 0xc8, 0xcc, 0xb2, 0x6c, 0xad, 0xdb, 0x9b, 0x24,
-		0x96, 0xcb, 0x10, 0x6f, 0x0a, 0x98, 0x23, 0xcb, 0xe5, 0xce, 0x11, 0xe5, 0x92, 0x76, 0x9a, 0x12,
-		0x82, 0x59, 0x9d, 0x51, 0x30, 0x4e, 0x3f, 0xee, 0x4a, 0x32, 0xef, 0x8f, 0xc3, 0x49, 0x36, 0x9d,
-		0x54, 0x36, 0x95, 0xd8, 0x07, 0x97, 0x49, 0xd2, 0x5b, 0x35, 0x7d, 0x9f, 0x24, 0xff, 0x2c, 0x2c,
-		0x54, 0x89, 0xb5, 0x20, 0x51, 0x90, 0xbb, 0xc3, 0x33, 0xf6, 0x46, 0xfb, 0xb2, 0xcf, 0xe1, 0xe7,
-		0xfb, 0x8e, 0xde, 0xa2, 0xfc, 0x3b, 0x24, 0x90, 0x1b, 0x2d, 0xad, 0xa3, 0xf5, 0xbf, 0x52, 0x52,
-		0xe8, 0x29, 0x00, 0x76, 0x16, 0xd1, 0x79, 0x1f, 0x2b, 0xbd, 0x9a, 0x5d, 0xf1, 0x76, 0x6e, 0x85,
-		0xb5, 0x44, 0x6f, 0x7a, 0xc5, 0x29, 0x2c, 0xf9, 0xf3, 0xec, 0xf3, 0x00, 0x6e, 0x05, 0x3a, 0x05,
-		0x27, 0x1a, 0xa5, 0xc2, 0x7a, 0x41, 0x11, 0xc7, 0x88, 0x1a, 0x9b, 0x95, 0x12, 0x7b, 0x6b, 0x66,
-		0x0e, 0x1d, 0x07, 0xe4, 0xad, 0x74, 0x6e, 0xc7, 0x1c, 0x83, 0x79, 0x6f, 0x39, 0x7b, 0xf8, 0x23,
-		0xb0, 0x76, 0x0d, 0x32, 0xec, 0x49, 0x01, 0x55, 0x6b, 0xb7, 0x71, 0x5b, 0xd5, 0x0d, 0x34, 0xe5,
-		0x4e, 0x7e, 0xf6, 0xb7, 0xfe, 0x43, 0x98, 0x76, 0x2d, 0xc5, 0x10, 0x0b, 0x04, 0xaf, 0x6a, 0x10,
-		0x9f, 0x53, 0xef, 0xf6, 0x3a, 0x98, 0x6e, 0x69, 0xab, 0xba, 0x90, 0xff, 0x74, 0x77, 0x86, 0xd0,
-		0x0b, 0x9e, 0x89, 0x2b, 0x0b, 0x2e, 0xba, 0x33, 0x7a, 0x6b, 0xcf, 0xba, 0xa7, 0x15, 0x1d, 0x06,
-		0xa7, 0x52, 0xfc, 0x04, 0xe7, 0x50, 0x64, 0x33, 0x04, 0x8b, 0xeb, 0x30, 0xaf, 0xb5, 0x5a, 0xb8,
-		0xe7, 0xe3, 0x6f, 0xca, 0x0a, 0x22, 0x7a, 0x2b, 0x73, 0x4c, 0x97, 0xb5, 0xa7, 0x20, 0x62, 0xd1,
-		0x41, 0x99, 0x46, 0x42, 0xb0, 0xc3, 0xc1, 0xd7, 0x2a, 0xe2, 0x74, 0xae, 0xd3, 0xa3, 0x29, 0x04,
-		0xfe, 0x0d, 0x27, 0x90, 0xa4, 0x68, 0xa2, 0x37, 0x06, 0xcc, 0xb3, 0x97, 0x9d, 0xb0, 0xa7, 0x37,
-		0x87, 0x67, 0x51, 0xfe, 0xd9, 0xc7, 0x1e, 0xa7, 0xfb, 0xf9, 0xf7, 0xf9, 0x95, 0x6e, 0xcc, 0x64,
-		0x51, 0x64, 0x4e, 0xdb, 0xed, 0x2f, 0x86, 0xb4, 0x68, 0x8f, 0xf7, 0xfb, 0xf0, 0xc6, 0x7e, 0x85,
-		0x37, 0x76, 0x7a, 0x9c, 0x86, 0x7b, 0x5a, 0x4a, 0x71, 0xaa, 0xac, 0x62, 0xad, 0x08, 0x29, 0xfa,
-		0x0e, 0xa4, 0x23, 0x9c, 0xc3, 0x5b, 0xf9, 0xd5, 0x8f, 0x3d, 0xce, 0x26, 0x1a, 0x41, 0xe2, 0xa2,
-		0x29, 0x56, 0x26, 0x59, 0xbd, 0x17, 0x1e, 0x19, 0x5d, 0xbf, 0xd9, 0x3f, 0x8f, 0x51, 0xea, 0x97,
-		0xbd, 0xac, 0xba, 0xd6, 0x29, 0x04, 0xf3, 0x5a, 0x57, 0x37, 0xcc, 0x73, 0xf4, 0xff, 0xdc, 0x2a,
-		0x85, 0xe9, 0xc7, 0x0c, 0xdb, 0xb6, 0x17, 0x99, 0xb1, 0x98, 0xae, 0xb7, 0x7f, 0xf1, 0x9d, 0x1f,
-		0x09, 0xbb, 0x06, 0x65, 0x6d, 0x63, 0xf4, 0xa4, 0xee, 0x74, 0x1a, 0x7f, 0x29, 0x68, 0x0c, 0x1f,
-		0xbc, 0x5d, 0x7b, 0x23, 0xc4, 0x1c, 0x32, 0xd3, 0x7c, 0x37, 0x41, 0xc4, 0xc1, 0x20, 0x9e, 0x1b,
-		0x53, 0xda, 0x59, 0xfc, 0xf4, 0x2f, 0x0a, 0x7c, 0x66, 0xc3, 0x6a, 0xa4, 0x37, 0x57, 0x21, 0xdd,
-		0x36, 0x0d, 0x5b, 0x35, 0xbb, 0xba, 0x8d, 0xbb, 0x3d, 0x7b, 0xaa, 0xe7, 0xfb, 0xd7, 0x8c, 0x48,
-		0x4c, 0x49, 0x11, 0xbc, 0xba, 0x40, 0x23, 0x9c, 0xb0, 0xc7, 0xa1, 0x66, 0xe1, 0xe4, 0xbf, 0x39,
-		0x9c, 0x50, 0x1c, 0xc2, 0xc9, 0x5d, 0x69, 0x87, 0xd5, 0xbe, 0xc5, 0x97, 0x3b, 0x7b, 0x9f, 0x69,
-		0x81, 0xa3, 0x1d, 0xff, 0x22, 0x04, 0xa7, 0x39, 0xf0, 0xb6, 0x66, 0xe1, 0x73, 0xb7, 0xcf, 0x6f,
-		0x63, 0x5b, 0x3b, 0x7f, 0xae, 0x65, 0xea, 0xc2, 0xd7, 0x59, 0xe0, 0xcb, 0x19, 0xa9, 0x5f, 0xe1,
-		0xf5, 0xb9, 0xb1, 0x07, 0x02, 0x72, 0x93, 0x97, 0xc1, 0xdc, 0xa8, 0x0e, 0xe6, 0x3b, 0x10, 0x2a,
-		0x99, 0x3a, 0xbd, 0x83, 0xda, 0xc6, 0x86, 0xd9, 0xe5, 0x0b, 0x12, 0xfb, 0x40, 0xd7, 0x20, 0xa2,
-		0x75, 0xcd, 0x81, 0x61, 0xb3, 0xc5, 0xa8, 0xf8, 0xf8, 0x6f, 0x7e, 0x6a, 0x69, 0xee, 0xf7, 0x3f,
-		0xb5, 0x74, 0x8c, 0x91, 0xb5, 0xda, 0xb7, 0x56, 0x74, 0xf3, 0x5c, 0x57, 0xb3, 0xf7, 0x88, 0x09,
-		0xf8, 0xdd, 0x8f, 0x3f, 0x06, 0xbc, 0xbd, 0xaa, 0x61, 0x7f, 0xf4, 0x33, 0x3f, 0x73, 0x56, 0x52,
-		0x38, 0xfe, 0x5a, 0xe8, 0xb5, 0x0f, 0x2e, 0x49, 0xf9, 0x1e, 0x44, 0xcb, 0xb8, 0x75, 0x48, 0x83,
-		0xd5, 0xa1, 0x06, 0xcf, 0xf3, 0x06, 0x4f, 0x8d, 0x36, 0xc8, 0xee, 0xb6, 0x95, 0x71, 0xcb, 0xd3,
-		0x6c, 0x19, 0xb7, 0x86, 0x5a, 0xac, 0x42, 0xac, 0x6a, 0xd8, 0xec, 0x41, 0x9a, 0x37, 0x41, 0x50,
-		0x37, 0xd8, 0x3b, 0x06, 0xf1, 0xe2, 0x23, 0x47, 0xe8, 0x8a, 0x42, 0xf0, 0xf2, 0x75, 0x88, 0x95,
-		0x71, 0x8b, 0x91, 0x2a, 0x41, 0xb0, 0x8d, 0x5b, 0x9c, 0xd4, 0x5d, 0x30, 0x49, 0xb0, 0x8b, 0xe5,
-		0xdf, 0xfb, 0xc3, 0xd3, 0x73, 0x6f, 0xff, 0xf4, 0xe9, 0xb9, 0x89, 0xea, 0x94, 0x9f, 0xae, 0x4e,
-		0x8e, 0x16, 0x7d, 0x24, 0x04, 0xf7, 0xd2, 0x27, 0xd2, 0xfa, 0x5d, 0xdd, 0xb0, 0xcf, 0xb5, 0xfa,
-		0x07, 0x3d, 0xdb, 0x24, 0x46, 0xc5, 0xdc, 0xe1, 0x4a, 0x34, 0xef, 0x56, 0xaf, 0xb0, 0xea, 0xf1,
-		0x2a, 0x94, 0xdf, 0x81, 0xf0, 0x26, 0xc1, 0x23, 0x83, 0x64, 0x9b, 0xb6, 0xd6, 0xe1, 0xbe, 0x22,
-		0xfb, 0x20, 0xa5, 0xec, 0x59, 0xb5, 0x00, 0x2b, 0xd5, 0xc5, 0x8b, 0x6a, 0x1d, 0xac, 0xed, 0xb0,
-		0xc7, 0x64, 0x82, 0x34, 0xc8, 0x88, 0x91, 0x02, 0xfa, 0x6e, 0xcc, 0x22, 0x84, 0xb5, 0x01, 0x3b,
-		0xfd, 0x16, 0x24, 0xd1, 0x07, 0xfd, 0xc8, 0x3f, 0x0b, 0x51, 0x7e, 0xea, 0x01, 0xc9, 0x10, 0xbc,
-		0x85, 0x0f, 0x68, 0x3b, 0x49, 0x85, 0xfc, 0x89, 0x56, 0x20, 0x4c, 0x99, 0xe7, 0xf7, 0xf7, 0xb2,
-		0x2b, 0x23, 0xdc, 0xaf, 0x50, 0x26, 0x15, 0x06, 0x96, 0xbf, 0x0e, 0xb1, 0xb2, 0x49, 0x34, 0xdb,
-		0x4f, 0x2d, 0xce, 0xa8, 0x51, 0x9e, 0x7b, 0x03, 0x5b, 0xdc, 0xb1, 0xa6, 0x1f, 0xe8, 0x38, 0x44,
-		0xd8, 0xe3, 0x42, 0xfc, 0x04, 0x1f, 0xff, 0xca, 0x97, 0x20, 0x4a, 0x69, 0xd7, 0x7b, 0xce, 0x13,
-		0x80, 0x92, 0xe7, 0x09, 0x40, 0x4e, 0x3e, 0xe0, 0x32, 0x8b, 0x20, 0xd4, 0xd6, 0x6c, 0x8d, 0xf7,
-		0x9b, 0xfe, 0x9d, 0x7f, 0x33, 0xc4, 0x38, 0x11, 0x0b, 0xad, 0x42, 0xd0, 0xec, 0x89, 0x03, 0xaa,
-		0xb9, 0x49, 0x5d, 0xa9, 0xf7, 0x8a, 0x21, 0xa2, 0x4b, 0x0a, 0x01, 0x2e, 0xd6, 0x26, 0xaa, 0xc5,
-		0x93, 0x3e, 0xb5, 0xe8, 0x62, 0x7b, 0x7b, 0xc7, 0x76, 0xff, 0x60, 0xc3, 0x39, 0xa2, 0x0a, 0x8e,
-		0xa2, 0xdc, 0x09, 0xc0, 0x69, 0x4f, 0xed, 0x6d, 0xdc, 0xb7, 0x74, 0xd3, 0x60, 0xda, 0xc4, 0x35,
-		0x05, 0x79, 0x18, 0xe4, 0xf5, 0x13, 0x54, 0xe5, 0x4d, 0x10, 0x2c, 0xf4, 0x7a, 0x28, 0x07, 0x31,
-		0xfa, 0xdd, 0x32, 0x99, 0xae, 0x84, 0x14, 0xe7, 0x9b, 0xd4, 0x59, 0xe6, 0x8e, 0xfd, 0x92, 0xd6,
-		0x77, 0x1e, 0xf3, 0x13, 0xdf, 0xf9, 0x4b, 0x10, 0x2f, 0x99, 0x86, 0x85, 0x0d, 0x6b, 0x40, 0x23,
-		0x90, 0xed, 0x8e, 0xd9, 0xba, 0xc5, 0x29, 0xb0, 0x0f, 0x22, 0x6c, 0xad, 0xd7, 0xa3, 0x98, 0x21,
-		0x85, 0xfc, 0xc9, 0x66, 0x76, 0xb1, 0x3e, 0x51, 0x3c, 0x17, 0x8e, 0x26, 0x1e, 0xde, 0x41, 0x47,
-		0x3e, 0xff, 0x43, 0x82, 0x7b, 0x46, 0x27, 0xd2, 0x2d, 0x7c, 0x60, 0x1d, 0x75, 0x1e, 0x3d, 0x0f,
-		0xf1, 0x4d, 0xfa, 0x84, 0xf3, 0xb3, 0xf8, 0x00, 0xe5, 0x20, 0x8a, 0xdb, 0xab, 0x17, 0x2e, 0x9c,
-		0xbf, 0xc4, 0xb4, 0xfc, 0xda, 0x9c, 0x22, 0x0a, 0xd0, 0x69, 0x88, 0x5b, 0xb8, 0xd5, 0x5b, 0xbd,
-		0x70, 0xf1, 0xd6, 0x79, 0xa6, 0x56, 0xd7, 0xe6, 0x14, 0xb7, 0x68, 0x2d, 0x46, 0x7a, 0xfc, 0xda,
-		0x87, 0x96, 0xa4, 0x62, 0x18, 0x82, 0xd6, 0xa0, 0xfb, 0x35, 0xd3, 0x8d, 0x3f, 0x8f, 0xc0, 0xb2,
-		0xa7, 0x96, 0x2d, 0x58, 0xfc, 0xa2, 0x91, 0xf3, 0xec, 0xb7, 0xec, 0xe9, 0x3f, 0x85, 0x98, 0xb0,
-		0x12, 0x1d, 0x2a, 0xc5, 0xfc, 0xcf, 0x49, 0x90, 0xbc, 0x21, 0x28, 0x37, 0xb0, 0x8d, 0x2e, 0x03,
-		0x38, 0x2d, 0x89, 0xa9, 0x72, 0x6a, 0x65, 0xb8, 0xad, 0x15, 0x07, 0x47, 0xf1, 0x80, 0xa3, 0xa7,
-		0xa8, 0x02, 0xf6, 0x4c, 0x8b, 0xbf, 0xca, 0x36, 0x05, 0xd5, 0x01, 0x46, 0x8f, 0x02, 0xa2, 0x56,
-		0x4d, 0xbd, 0x6d, 0xda, 0xba, 0xb1, 0xab, 0xf6, 0xcc, 0x97, 0xf8, 0x73, 0x99, 0x41, 0x45, 0xa6,
-		0x35, 0x37, 0x68, 0xc5, 0x26, 0x29, 0x27, 0x4c, 0xc7, 0x1d, 0x2a, 0x24, 0x98, 0xd6, 0xda, 0xed,
-		0x3e, 0xb6, 0x2c, 0x6e, 0xb8, 0xc4, 0x27, 0xba, 0x0c, 0xd1, 0xde, 0x60, 0x5b, 0x15, 0x56, 0x22,
-		0xb1, 0x7a, 0xcf, 0xb8, 0x39, 0x2f, 0x74, 0x83, 0xcf, 0xfa, 0x48, 0x6f, 0xb0, 0x4d, 0x34, 0xe5,
-		0x3e, 0x48, 0x8e, 0x61, 0x26, 0x71, 0xdb, 0xe5, 0x83, 0xbe, 0x1a, 0xce, 0x7b, 0xa0, 0xf6, 0xfa,
-		0xba, 0xd9, 0xd7, 0xed, 0x03, 0x7a, 0x88, 0x35, 0xa8, 0xc8, 0xa2, 0x62, 0x93, 0x97, 0xe7, 0x6f,
-		0x41, 0xa6, 0x41, 0x43, 0x23, 0x97, 0xf3, 0x0b, 0x2e, 0x7f, 0xd2, 0x74, 0xfe, 0x26, 0x72, 0x16,
-		0x18, 0xe1, 0xec, 0xec, 0x7f, 0x94, 0x20, 0x51, 0x24, 0x13, 0xb7, 0x5a, 0xbe, 0xd2, 0xd1, 0x76,
-		0xd1, 0x79, 0x38, 0x56, 0x5c, 0xaf, 0x97, 0x9e, 0x55, 0xab, 0x65, 0xf5, 0xca, 0x7a, 0xc1, 0x73,
-		0x95, 0x23, 0x77, 0xfc, 0x95, 0x3b, 0xcb, 0xc8, 0x03, 0xbb, 0x65, 0xd0, 0x4c, 0x00, 0x3a, 0x07,
-		0x8b, 0x7e, 0x94, 0x42, 0xb1, 0x51, 0xa9, 0x35, 0x65, 0x29, 0x77, 0xec, 0x95, 0x3b, 0xcb, 0xf3,
-		0x1e, 0x8c, 0xc2, 0xb6, 0x85, 0x0d, 0x7b, 0x14, 0xa1, 0x54, 0xdf, 0xd8, 0xa8, 0x36, 0xe5, 0xc0,
-		0x08, 0x42, 0xc9, 0xec, 0x76, 0x75, 0x1b, 0x3d, 0x0c, 0xf3, 0x7e, 0x84, 0x5a, 0x75, 0x5d, 0x0e,
-		0xe6, 0xd0, 0x2b, 0x77, 0x96, 0xd3, 0x1e, 0xe8, 0x9a, 0xde, 0xc9, 0xc5, 0xde, 0xfd, 0xa3, 0xa7,
-		0xe7, 0x3e, 0xfa, 0x0f, 0x4e, 0x4b, 0xc5, 0x8d, 0x89, 0x73, 0xee, 0x89, 0xa3, 0xcd, 0x39, 0xff,
-		0xba, 0xfd, 0xa5, 0x7b, 0x7c, 0xe6, 0x86, 0xfb, 0x88, 0x1e, 0x63, 0x3c, 0xeb, 0x74, 0x9b, 0x96,
-		0x19, 0xca, 0x1d, 0xee, 0x1e, 0xe4, 0xa6, 0x2c, 0x0a, 0xb9, 0xa9, 0x86, 0x21, 0x7f, 0x09, 0x52,
-		0x9b, 0x5a, 0xdf, 0x6e, 0x60, 0xfb, 0x1a, 0xd6, 0xda, 0xb8, 0xef, 0xf7, 0x1f, 0x52, 0xc2, 0x7f,
-		0x40, 0x10, 0xa2, 0x4e, 0x02, 0x5b, 0x3f, 0xe9, 0xdf, 0xf9, 0x3d, 0x08, 0xd1, 0xbb, 0xaa, 0x8e,
-		0x6f, 0xc1, 0x31, 0x98, 0x6f, 0x41, 0x56, 0x86, 0x03, 0x9b, 0xdf, 0xe5, 0x4f, 0x2a, 0xec, 0x03,
-		0x3d, 0x29, 0x3c, 0x84, 0xe0, 0xe1, 0x1e, 0x02, 0x9f, 0x5e, 0xdc, 0x4f, 0xe8, 0x40, 0x94, 0x0f,
-		0xb1, 0xc3, 0x88, 0xe4, 0x32, 0x82, 0x36, 0x20, 0xd3, 0xd3, 0xfa, 0x36, 0x7d, 0xfd, 0x6a, 0x8f,
-		0xf6, 0x82, 0xcf, 0xe0, 0xa5, 0x51, 0x7b, 0xe2, 0xeb, 0x2c, 0x6f, 0x25, 0xd5, 0xf3, 0x16, 0xe6,
-		0xff, 0x24, 0x04, 0x11, 0x2e, 0x8c, 0x37, 0x41, 0x94, 0x8b, 0x95, 0xcf, 0xb9, 0x7b, 0x57, 0x46,
-		0x97, 0xd9, 0x15, 0x67, 0x39, 0xe4, 0xf4, 0x04, 0x0e, 0x7a, 0x10, 0x62, 0xad, 0x3d, 0x4d, 0x37,
-		0x54, 0xbd, 0xcd, 0x9d, 0xe3, 0xc4, 0xa7, 0x3f, 0xb5, 0x14, 0x2d, 0x91, 0xb2, 0x6a, 0x59, 0x89,
-		0xd2, 0xca, 0x6a, 0x9b, 0xf8, 0x34, 0x7b, 0x58, 0xdf, 0xdd, 0xb3, 0xb9, 0xdd, 0xe0, 0x5f, 0xe8,
-		0x69, 0x08, 0x11, 0x85, 0xe0, 0xf7, 0x3c, 0x72, 0x23, 0x51, 0x8f, 0x93, 0xb8, 0x2b, 0xc6, 0x48,
-		0xc3, 0xef, 0xfb, 0xcf, 0x4b, 0x92, 0x42, 0x31, 0x50, 0x09, 0x52, 0x1d, 0xcd, 0xb2, 0x55, 0xba,
-		0x1e, 0x93, 0xe6, 0xd9, 0x05, 0x8f, 0x93, 0xa3, 0x02, 0xe1, 0x82, 0xe5, 0xac, 0x27, 0x08, 0x16,
-		0x2b, 0x6a, 0xa3, 0x33, 0x20, 0x53, 0x22, 0x2d, 0x3a, 0x03, 0x99, 0x97, 0x18, 0xa1, 0x72, 0x4f,
-		0x93, 0x72, 0x36, 0x31, 0xa9, 0xaf, 0x78, 0x0a, 0xe2, 0xf4, 0x89, 0x37, 0x0a, 0xc2, 0x2e, 0x48,
-		0xc7, 0x48, 0x01, 0xad, 0x7c, 0x08, 0x32, 0xae, 0xd5, 0x67, 0x20, 0x31, 0x46, 0xc5, 0x2d, 0xa6,
-		0x80, 0x8f, 0xc3, 0xa2, 0x81, 0xf7, 0x6d, 0x75, 0x18, 0x3a, 0x4e, 0xa1, 0x11, 0xa9, 0xbb, 0xe1,
-		0xc7, 0x78, 0x00, 0xd2, 0x2d, 0x21, 0x7c, 0x06, 0x0b, 0x14, 0x36, 0xe5, 0x94, 0x52, 0xb0, 0x93,
-		0x10, 0xd3, 0x7a, 0x3d, 0x06, 0x90, 0xe0, 0x56, 0xbf, 0xd7, 0xa3, 0x55, 0x67, 0x61, 0x9e, 0xf6,
-		0xb1, 0x8f, 0xad, 0x41, 0xc7, 0xe6, 0x44, 0x92, 0x14, 0x26, 0x43, 0x2a, 0x14, 0x56, 0x4e, 0x61,
-		0xef, 0x87, 0x14, 0xbe, 0xad, 0xb7, 0xb1, 0xd1, 0xc2, 0x0c, 0x2e, 0x45, 0xe1, 0x92, 0xa2, 0x90,
-		0x02, 0x3d, 0x0c, 0x8e, 0x35, 0x57, 0xc5, 0x4a, 0x93, 0x66, 0xf4, 0x44, 0x79, 0x81, 0x15, 0xe7,
-		0xb3, 0x10, 0x2a, 0x6b, 0xb6, 0x46, 0xdc, 0x25, 0x7b, 0x9f, 0x2d, 0x9f, 0x49, 0x85, 0xfc, 0x99,
-		0xff, 0xc5, 0x20, 0x84, 0x6e, 0x98, 0x36, 0x46, 0x4f, 0x78, 0x5c, 0xd9, 0xf4, 0x38, 0x7d, 0x6e,
-		0xe8, 0xbb, 0x06, 0x6e, 0x6f, 0x58, 0xbb, 0x9e, 0x77, 0x9a, 0x5d, 0x75, 0x0a, 0xf8, 0xd4, 0x69,
-		0x11, 0xc2, 0x7d, 0x73, 0x60, 0xb4, 0xc5, 0x75, 0x0a, 0xfa, 0x81, 0x2a, 0x10, 0x73, 0xb4, 0x24,
-		0x34, 0x4d, 0x4b, 0x32, 0x44, 0x4b, 0x88, 0x0e, 0xf3, 0x02, 0x25, 0xba, 0xcd, 0x95, 0xa5, 0x08,
-		0x71, 0xc7, 0x78, 0x71, 0x6d, 0x9b, 0x4d, 0x61, 0x5d, 0x34, 0xb2, 0x44, 0x3a, 0x63, 0xef, 0x08,
-		0x8f, 0x69, 0x9c, 0xec, 0x54, 0x70, 0xe9, 0xf9, 0xd4, 0x8a, 0xbf, 0x19, 0x1d, 0xa5, 0xfd, 0x72,
-		0xd5, 0x8a, 0xbd, 0x1b, 0x7d, 0x0f, 0xc4, 0x2d, 0x7d, 0xd7, 0xa0, 0x37, 0x94, 0xb8, 0xe6, 0xb9,
-		0x05, 0xa4, 0xd6, 0xbd, 0x66, 0xcf, 0x34, 0xcd, 0xf3, 0xf3, 0x06, 0xe7, 0x60, 0xc1, 0xfd, 0x61,
-		0x01, 0x97, 0x0a, 0xd3, 0x32, 0xe4, 0x54, 0x35, 0x44, 0x4d, 0xfe, 0xd7, 0x24, 0x88, 0xf0, 0x15,
-		0xcb, 0x1d, 0x06, 0x69, 0xfc, 0x30, 0x04, 0x26, 0x0d, 0x43, 0xf0, 0xee, 0x87, 0xa1, 0x00, 0xe0,
-		0xb0, 0x69, 0xf1, 0x97, 0x81, 0xc7, 0xb8, 0x55, 0x8c, 0xc5, 0x86, 0xbe, 0xcb, 0xe7, 0xbd, 0x07,
-		0x29, 0xff, 0x07, 0x12, 0xf1, 0xf0, 0x79, 0x3d, 0x2a, 0x40, 0x4a, 0xf0, 0xa5, 0xee, 0x74, 0xb4,
-		0x5d, 0xae, 0x8a, 0xf7, 0x4e, 0x64, 0x8e, 0xac, 0xc2, 0x4a, 0x82, 0xf3, 0x43, 0xfd, 0x89, 0xb1,
-		0xc3, 0x1a, 0x98, 0x30, 0xac, 0x3e, 0x3d, 0x0a, 0xde, 0x9d, 0x1e, 0xf9, 0x46, 0x3c, 0x34, 0x34,
-		0xe2, 0xf9, 0x3f, 0x94, 0xf8, 0x6f, 0x12, 0xb4, 0xd9, 0x75, 0xac, 0xff, 0x53, 0x43, 0xf5, 0x02,
-		0xd7, 0xad, 0x36, 0x6e, 0xab, 0x23, 0x63, 0x76, 0xff, 0x28, 0x45, 0x3f, 0xcf, 0xee, 0xd8, 0x21,
-		0x41, 0xa5, 0xe1, 0x8e, 0xe1, 0xc7, 0x03, 0x30, 0x3f, 0x02, 0xff, 0xb7, 0x6f, 0x2c, 0xfd, 0xb3,
-		0x37, 0x3c, 0xe3, 0xec, 0x8d, 0x4c, 0x9c, 0xbd, 0x1f, 0x0b, 0xd0, 0x04, 0x40, 0xcf, 0xb4, 0xb4,
-		0xce, 0xd7, 0xc3, 0xf6, 0x9e, 0x82, 0x78, 0xcf, 0xec, 0xa8, 0xac, 0x86, 0x5d, 0x68, 0x8b, 0xf5,
-		0xcc, 0x8e, 0x32, 0xa2, 0x66, 0xe1, 0xaf, 0x92, 0x61, 0x8e, 0x7c, 0x15, 0x06, 0x21, 0x3a, 0x3c,
-		0xa1, 0xfa, 0x90, 0x64, 0xa2, 0xe0, 0x5e, 0xd3, 0xe3, 0x44, 0x06, 0xd4, 0x0d, 0x93, 0x46, 0xbd,
-		0x3c, 0xc6, 0x36, 0x83, 0x54, 0x38, 0x1c, 0xc1, 0x60, 0x4e, 0xc6, 0xb8, 0xcc, 0x91, 0xd7, 0x62,
-		0x29, 0x1c, 0x2e, 0xff, 0xfd, 0x12, 0xc0, 0x3a, 0x91, 0x2c, 0xed, 0x2f, 0xf1, 0x77, 0x2c, 0xca,
-		0x82, 0xea, 0x6b, 0xf9, 0xf4, 0xa4, 0x41, 0xe3, 0xed, 0x27, 0x2d, 0x2f, 0xdf, 0x25, 0x48, 0xb9,
-		0xba, 0x6d, 0x61, 0xc1, 0xcc, 0xe9, 0x43, 0xa2, 0xd2, 0x06, 0xb6, 0x95, 0xe4, 0x6d, 0xcf, 0x57,
-		0xfe, 0x9f, 0x4b, 0x10, 0xa7, 0x3c, 0x6d, 0x60, 0x5b, 0xf3, 0x8d, 0xa1, 0x74, 0xf7, 0x63, 0x78,
-		0x2f, 0x00, 0x23, 0x63, 0xe9, 0x2f, 0x63, 0xae, 0x59, 0x71, 0x5a, 0xd2, 0xd0, 0x5f, 0xc6, 0xe8,
-		0xa2, 0x23, 0xf0, 0xe0, 0xe1, 0x02, 0x17, 0x51, 0x2b, 0x17, 0xfb, 0x09, 0x88, 0xd2, 0xe7, 0x59,
-		0xf6, 0x2d, 0x1e, 0x88, 0x46, 0x8c, 0x41, 0xb7, 0xb9, 0x6f, 0xe5, 0x5f, 0x84, 0x68, 0x73, 0x9f,
-		0xe5, 0x13, 0x4f, 0x41, 0xbc, 0x6f, 0x9a, 0xdc, 0xfb, 0x63, 0x5e, 0x77, 0x8c, 0x14, 0x50, 0x67,
-		0x47, 0xe4, 0xd0, 0x02, 0x6e, 0x0e, 0xcd, 0x4d, 0x02, 0x06, 0x67, 0x4a, 0x02, 0x92, 0xe8, 0x33,
-		0xe5, 0x9b, 0x49, 0xe8, 0x51, 0x38, 0xd1, 0xa8, 0x5e, 0xad, 0x55, 0xca, 0xea, 0x46, 0xe3, 0xea,
-		0xd0, 0xb3, 0x09, 0xb9, 0xcc, 0x2b, 0x77, 0x96, 0x13, 0x3c, 0xec, 0x9c, 0x04, 0xbd, 0xa9, 0x54,
-		0x6e, 0xd4, 0x9b, 0x15, 0x59, 0x62, 0xd0, 0x9b, 0x7d, 0x7c, 0xdb, 0xb4, 0xd9, 0x6f, 0x5e, 0x3d,
-		0x0e, 0x27, 0xc7, 0x40, 0x3b, 0xc1, 0xe7, 0xfc, 0x2b, 0x77, 0x96, 0x53, 0x9b, 0x7d, 0xcc, 0xb4,
-		0x8c, 0x62, 0xac, 0x40, 0x76, 0x14, 0xa3, 0xbe, 0x59, 0x6f, 0x14, 0xd6, 0xe5, 0xe5, 0x9c, 0xfc,
-		0xca, 0x9d, 0xe5, 0xa4, 0x30, 0x19, 0x04, 0xfe, 0xeb, 0x10, 0x7d, 0xc6, 0x7c, 0x59, 0x63, 0x16,
-		0xd7, 0xf5, 0xb4, 0xbe, 0xd6, 0x3d, 0x6a, 0xf8, 0x39, 0xe5, 0xb8, 0x46, 0xfe, 0xaf, 0x02, 0x90,
-		0x71, 0x82, 0x9b, 0x4d, 0xda, 0x02, 0x7a, 0xc2, 0x9b, 0xf1, 0x4b, 0x4c, 0x5c, 0x3b, 0x18, 0xb4,
-		0x48, 0x08, 0xbe, 0x11, 0x62, 0xc2, 0x49, 0xe6, 0x93, 0x6a, 0x79, 0xcc, 0xfa, 0xc6, 0x21, 0x38,
-		0xaa, 0x83, 0x81, 0x9e, 0x81, 0xb8, 0x33, 0xc5, 0x9c, 0x67, 0xfa, 0x27, 0xcf, 0x49, 0x8e, 0xef,
-		0xe2, 0xa0, 0x4b, 0x6e, 0x18, 0x17, 0x9a, 0x14, 0x18, 0xde, 0x60, 0x00, 0x1c, 0xd9, 0x09, 0xe1,
-		0x1e, 0x87, 0x90, 0xb6, 0xdd, 0xd2, 0xb9, 0x01, 0xbe, 0x67, 0x14, 0xaf, 0x50, 0x2c, 0x55, 0x39,
-		0x12, 0x85, 0x24, 0xdc, 0x6a, 0x03, 0x7b, 0x8f, 0xe5, 0x77, 0x22, 0x93, 0xb8, 0x2d, 0x08, 0x10,
-		0xc1, 0xad, 0x83, 0x93, 0xaf, 0xf2, 0x6c, 0x0c, 0x17, 0x38, 0x7d, 0x2b, 0x79, 0x5f, 0x65, 0xc1,
-		0x34, 0x73, 0x4f, 0x62, 0x5d, 0x6d, 0xbf, 0x48, 0xe3, 0xe9, 0x13, 0x10, 0x25, 0x95, 0xbb, 0xfc,
-		0xc1, 0xc2, 0xa0, 0x12, 0xe9, 0x6a, 0xfb, 0x57, 0x35, 0xeb, 0x7a, 0x28, 0x16, 0x94, 0x43, 0xf9,
-		0x1f, 0x27, 0xae, 0x8e, 0x4f, 0xac, 0xe8, 0x11, 0x40, 0x04, 0x43, 0xdb, 0xc5, 0x2a, 0x99, 0xfb,
-		0x74, 0x7c, 0x04, 0xdd, 0x4c, 0x57, 0xdb, 0x2f, 0xec, 0xe2, 0xda, 0xa0, 0x4b, 0x19, 0xb0, 0xd0,
-		0x06, 0xc8, 0x02, 0x58, 0xa8, 0x06, 0x1f, 0xbf, 0x93, 0xa3, 0x3f, 0x3f, 0xc5, 0x01, 0xd8, 0x0a,
-		0xf2, 0x2a, 0x59, 0x41, 0xd2, 0x8c, 0x9e, 0x73, 0xc2, 0xc7, 0xd7, 0x95, 0xa0, 0xbf, 0x2b, 0xf9,
-		0x67, 0x20, 0x33, 0x34, 0x84, 0x28, 0x0f, 0x29, 0x9e, 0xf2, 0xa2, 0xa7, 0x27, 0x58, 0x88, 0x14,
-		0x57, 0x12, 0x2c, 0xb5, 0x45, 0x4f, 0x93, 0xac, 0xc5, 0x7e, 0xe9, 0x83, 0x4b, 0x12, 0xdd, 0x37,
-		0x7a, 0x04, 0x52, 0xbe, 0x41, 0x14, 0x69, 0x68, 0xc9, 0x4d, 0x43, 0xbb, 0xc0, 0x2f, 0x40, 0x92,
-		0x58, 0x30, 0xdc, 0xe6, 0xb0, 0x0f, 0x42, 0x86, 0x59, 0xd8, 0x61, 0x59, 0x33, 0x8f, 0x69, 0x43,
-		0x08, 0x3c, 0x2f, 0x5c, 0x28, 0xbf, 0xd8, 0x13, 0x02, 0xea, 0xaa, 0x66, 0xe5, 0xeb, 0x00, 0xae,
-		0x56, 0xa0, 0x02, 0xdc, 0x4b, 0xac, 0x8f, 0xf7, 0xb4, 0x2f, 0x7f, 0x0c, 0xde, 0xe7, 0x72, 0xe6,
-		0x08, 0x90, 0x7b, 0xa2, 0x97, 0x3d, 0x09, 0x7f, 0x8d, 0x42, 0xe4, 0xcf, 0x41, 0x66, 0x48, 0x5f,
-		0xc8, 0x8a, 0xec, 0x6a, 0x19, 0xdb, 0xe8, 0x70, 0x0b, 0x8a, 0x6f, 0xf9, 0xe8, 0xa7, 0x4f, 0x4b,
-		0x5f, 0x1b, 0xeb, 0xf3, 0x9f, 0x6e, 0xc2, 0x29, 0x4f, 0x25, 0x51, 0x79, 0x5f, 0xea, 0x2b, 0xe3,
-		0x51, 0x75, 0x52, 0x39, 0x2d, 0x85, 0x75, 0x68, 0xc6, 0x39, 0x77, 0xb8, 0xa1, 0x9b, 0x9e, 0xdf,
-		0x9a, 0x9e, 0x61, 0x1b, 0xbf, 0x0d, 0xf0, 0xab, 0x71, 0x88, 0x2a, 0xf8, 0x6d, 0x03, 0x6c, 0xd9,
-		0x68, 0x15, 0x42, 0xb8, 0xb5, 0x67, 0x8e, 0xcb, 0xba, 0x92, 0x0e, 0xae, 0x70, 0xb8, 0x4a, 0x6b,
-		0xcf, 0xbc, 0x36, 0xa7, 0x50, 0x58, 0x74, 0x01, 0xc2, 0x3b, 0x9d, 0x01, 0x4f, 0x98, 0x0d, 0x59,
-		0x49, 0x2f, 0xd2, 0x15, 0x02, 0x74, 0x6d, 0x4e, 0x61, 0xd0, 0xa4, 0x29, 0xfa, 0x13, 0x84, 0xc1,
-		0xc3, 0x9b, 0xaa, 0x1a, 0x3b, 0xb4, 0x29, 0x02, 0x8b, 0x8a, 0x00, 0xba, 0xa1, 0xdb, 0x2a, 0x4d,
-		0x26, 0x71, 0x3b, 0x75, 0xdf, 0x64, 0x4c, 0xdd, 0xa6, 0xe9, 0xa7, 0x6b, 0x73, 0x4a, 0x5c, 0x17,
-		0x1f, 0x84, 0xdd, 0xb7, 0x0d, 0x70, 0x5f, 0xd8, 0xab, 0x89, 0xec, 0xbe, 0x85, 0x00, 0x11, 0x76,
-		0x29, 0x34, 0x31, 0xeb, 0xec, 0xe7, 0x24, 0xec, 0x7d, 0xfe, 0xab, 0x4b, 0x4b, 0x93, 0x30, 0xe9,
-		0x6f, 0x4a, 0x34, 0xf7, 0xaf, 0xcd, 0x29, 0xd1, 0x16, 0xfb, 0x13, 0x3d, 0xed, 0x38, 0x7d, 0x89,
-		0x51, 0x3f, 0xcb, 0x87, 0xcb, 0x12, 0x4d, 0x73, 0xc2, 0xf9, 0x43, 0x35, 0x48, 0x77, 0x74, 0xcb,
-		0x56, 0x2d, 0x43, 0xeb, 0x59, 0x7b, 0xa6, 0x2d, 0xde, 0x73, 0x7f, 0x60, 0x12, 0x85, 0x75, 0xdd,
-		0xb2, 0x1b, 0x02, 0xf8, 0xda, 0x9c, 0x92, 0xea, 0x78, 0x0b, 0x08, 0x3d, 0x73, 0x67, 0x07, 0xf7,
-		0x1d, 0x82, 0x34, 0xb3, 0x73, 0x08, 0xbd, 0x3a, 0x81, 0x16, 0xf8, 0x84, 0x9e, 0xe9, 0x2d, 0x40,
-		0xdf, 0x00, 0x0b, 0x1d, 0x53, 0x6b, 0x3b, 0xe4, 0xd4, 0xd6, 0xde, 0xc0, 0xb8, 0x45, 0xd3, 0x40,
-		0x89, 0xd5, 0x87, 0x27, 0x32, 0x69, 0x6a, 0x6d, 0x41, 0xa2, 0x44, 0x10, 0xae, 0xcd, 0x29, 0xf3,
-		0x9d, 0xe1, 0x42, 0xf4, 0x56, 0x58, 0xd4, 0x7a, 0xbd, 0xce, 0xc1, 0x30, 0xf5, 0x0c, 0xa5, 0x7e,
-		0x76, 0x12, 0xf5, 0x02, 0xc1, 0x19, 0x26, 0x8f, 0xb4, 0x91, 0x52, 0xd4, 0x04, 0xb9, 0xd7, 0xc7,
-		0xf4, 0x86, 0x5d, 0x8f, 0xfb, 0x35, 0xf4, 0xa1, 0xf4, 0xc4, 0xea, 0x43, 0x93, 0x68, 0x6f, 0x32,
-		0x78, 0xe1, 0x06, 0x5d, 0x9b, 0x53, 0x32, 0x3d, 0x7f, 0x11, 0xa3, 0x6a, 0xb6, 0x30, 0xfd, 0x65,
-		0x09, 0x4e, 0x75, 0x7e, 0x1a, 0x55, 0x0a, 0xef, 0xa7, 0xea, 0x2b, 0x42, 0x15, 0x48, 0xb0, 0xe0,
-		0x57, 0x25, 0xd6, 0x93, 0xfe, 0x62, 0x47, 0x62, 0x35, 0x3f, 0x71, 0x86, 0x52, 0xd0, 0x1b, 0xa6,
-		0x8d, 0xaf, 0xcd, 0x29, 0x80, 0x9d, 0x2f, 0xa4, 0xc1, 0x31, 0xfa, 0xda, 0xfc, 0x81, 0xea, 0xb7,
-		0xd4, 0xd9, 0x05, 0x4a, 0xf0, 0x91, 0x49, 0x04, 0xe9, 0xcf, 0xc2, 0x1d, 0xdc, 0xf0, 0x1a, 0xee,
-		0x6b, 0x73, 0xca, 0xc2, 0xed, 0xd1, 0x62, 0xa2, 0x62, 0x3b, 0xba, 0xa1, 0x75, 0xf4, 0x97, 0x31,
-		0x5b, 0x73, 0xe9, 0x8f, 0xb7, 0x1c, 0xa2, 0x62, 0x57, 0x38, 0x34, 0x5d, 0x89, 0x89, 0x8a, 0xed,
-		0x78, 0x0b, 0x8a, 0x51, 0x7e, 0x36, 0xd8, 0x79, 0x81, 0x39, 0x2a, 0xc7, 0xd8, 0x6f, 0x05, 0x5c,
-		0x0f, 0xc5, 0x40, 0x4e, 0xe4, 0x1f, 0x82, 0x84, 0xc7, 0x30, 0xa1, 0x2c, 0x44, 0xf9, 0xd9, 0x20,
-		0x71, 0xca, 0x98, 0x7f, 0xe6, 0xd3, 0x90, 0xf4, 0x1a, 0xa3, 0xfc, 0xfb, 0x24, 0x07, 0x93, 0xbe,
-		0x0d, 0x93, 0xf5, 0xe7, 0xc0, 0xe3, 0xae, 0x6f, 0x74, 0xbf, 0x58, 0x0b, 0x45, 0x3d, 0xdb, 0xf0,
-		0x4d, 0xd2, 0x42, 0xbe, 0x14, 0xa3, 0x25, 0x48, 0xf4, 0x56, 0x7b, 0x0e, 0x48, 0x90, 0x82, 0x40,
-		0x6f, 0xb5, 0x27, 0x00, 0xee, 0x83, 0x24, 0xe9, 0xb7, 0xea, 0xf5, 0xd0, 0xe2, 0x4a, 0x82, 0x94,
-		0x71, 0x90, 0xfc, 0x6f, 0x07, 0x40, 0x1e, 0x36, 0x60, 0x4e, 0x72, 0x5c, 0x3a, 0x72, 0x72, 0xfc,
-		0xe4, 0x70, 0x5a, 0xde, 0xcd, 0xc4, 0xaf, 0x83, 0xec, 0x26, 0x94, 0xd9, 0x52, 0x33, 0xd9, 0xe3,
-		0x1c, 0x72, 0x8d, 0x95, 0x4c, 0x6b, 0xc8, 0x57, 0xbe, 0xe2, 0xdb, 0x1e, 0x15, 0xbf, 0xd1, 0x3b,
-		0x3c, 0xe0, 0x8e, 0xd7, 0xb3, 0xd5, 0x6b, 0x6b, 0x36, 0x16, 0x19, 0x39, 0xcf, 0x4e, 0xe9, 0x83,
-		0x90, 0xd1, 0x7a, 0x3d, 0xd5, 0xb2, 0x35, 0x1b, 0x73, 0xe7, 0x84, 0xe5, 0x3a, 0x52, 0x5a, 0xaf,
-		0x47, 0x7f, 0x8f, 0x90, 0x39, 0x27, 0x0f, 0x40, 0x9a, 0xd8, 0x74, 0x5d, 0xeb, 0x08, 0xdf, 0x22,
-		0xc2, 0x7c, 0x18, 0x5e, 0xca, 0xdd, 0x89, 0xb6, 0x33, 0xe2, 0xd4, 0x9e, 0x3b, 0x51, 0x9c, 0xe4,
-		0x89, 0xe2, 0x10, 0x7f, 0xb3, 0x87, 0xc9, 0x47, 0x3c, 0xaf, 0x34, 0x7e, 0x9b, 0x62, 0x91, 0x46,
-		0x7c, 0xb7, 0x59, 0x7a, 0x26, 0xa6, 0xb0, 0x8f, 0xbc, 0x02, 0x69, 0xbf, 0xed, 0x47, 0x69, 0x08,
-		0xd8, 0xfb, 0xbc, 0x95, 0x80, 0xbd, 0x4f, 0x7c, 0x6b, 0xcf, 0xcb, 0xa0, 0xa3, 0xab, 0x1d, 0xc7,
-		0x73, 0xb3, 0x2b, 0xf9, 0x0c, 0xa4, 0x7c, 0x6b, 0x42, 0xfe, 0x38, 0x2c, 0x8e, 0x33, 0xf1, 0xf9,
-		0x3d, 0xa7, 0xdc, 0x67, 0xaa, 0xd1, 0x05, 0x88, 0x39, 0x36, 0x7e, 0x4c, 0x3c, 0x4e, 0x9b, 0x15,
-		0xc0, 0x8a, 0x03, 0xea, 0xdb, 0x40, 0x08, 0xf8, 0x36, 0x10, 0xf2, 0xdf, 0x0c, 0xd9, 0x49, 0xf6,
-		0x7b, 0x28, 0xad, 0x18, 0x72, 0x04, 0x76, 0x1c, 0x22, 0xfc, 0x21, 0xcc, 0x00, 0xdd, 0x32, 0xe3,
-		0x5f, 0x44, 0x90, 0xcc, 0x96, 0x07, 0xd9, 0x4e, 0x1a, 0xfd, 0xc8, 0xab, 0x70, 0x72, 0xa2, 0x0d,
-		0x9f, 0xbc, 0xf9, 0xc6, 0x08, 0xf1, 0xcd, 0xb7, 0x96, 0x60, 0xc7, 0xa2, 0x7d, 0x15, 0x47, 0x67,
-		0xd8, 0x57, 0xfe, 0xd5, 0x20, 0x1c, 0x1f, 0x6f, 0xc9, 0xd1, 0x32, 0x24, 0x89, 0xa3, 0x6b, 0xfb,
-		0x7d, 0x62, 0xe8, 0x6a, 0xfb, 0x4d, 0xee, 0x10, 0xf3, 0xcd, 0x8b, 0x80, 0xb3, 0x79, 0x81, 0xb6,
-		0x60, 0xbe, 0x63, 0xb6, 0xb4, 0x8e, 0xea, 0xd9, 0x3c, 0xe2, 0x93, 0xe8, 0xfe, 0x11, 0x61, 0xfb,
-		0x93, 0x94, 0xc4, 0xe0, 0x70, 0xfd, 0xcf, 0x50, 0x1a, 0xeb, 0xce, 0x3e, 0x13, 0x2a, 0x43, 0xa2,
-		0xab, 0x5b, 0xdb, 0x78, 0x4f, 0xbb, 0xad, 0x9b, 0x7d, 0x3e, 0x9b, 0x46, 0x95, 0x66, 0xc3, 0x85,
-		0x11, 0x7b, 0x5a, 0x1e, 0x34, 0xcf, 0x90, 0x84, 0xc7, 0x6e, 0xb5, 0x45, 0x8e, 0x6c, 0x4d, 0x26,
-		0xed, 0x5a, 0x45, 0x27, 0xee, 0x5a, 0x8d, 0xdb, 0x22, 0x8a, 0x8d, 0xdf, 0x22, 0x7a, 0xb7, 0x77,
-		0x68, 0xfc, 0x6b, 0xdf, 0xc8, 0xae, 0x11, 0x6a, 0xc0, 0x22, 0xc7, 0x6f, 0xfb, 0x64, 0x3f, 0xe6,
-		0x70, 0x05, 0x9b, 0x5f, 0xc3, 0x32, 0x47, 0x02, 0x7d, 0xb2, 0xd8, 0x83, 0x77, 0x27, 0x76, 0xb1,
-		0x6d, 0x1b, 0xf2, 0x6c, 0xdb, 0xfe, 0x5f, 0x36, 0x14, 0xef, 0x0c, 0xc2, 0xfc, 0x88, 0x23, 0x31,
-		0x76, 0x3f, 0x7a, 0x52, 0x0e, 0x58, 0x74, 0x2c, 0x78, 0xe4, 0x8e, 0xf1, 0xb1, 0x0e, 0x4d, 0x1f,
-		0xeb, 0xf0, 0x57, 0x71, 0xac, 0x23, 0x77, 0x37, 0xd6, 0x5f, 0xd3, 0x51, 0xf8, 0x61, 0x09, 0x72,
-		0x93, 0xbd, 0xaf, 0xb1, 0xc3, 0x71, 0xa4, 0x2d, 0x8b, 0x49, 0x6b, 0xdc, 0x03, 0x90, 0x1e, 0xf2,
-		0x0d, 0x99, 0x2a, 0xa7, 0x7c, 0x61, 0x7b, 0xfe, 0x5d, 0x41, 0x67, 0xe1, 0xf1, 0x39, 0x70, 0x63,
-		0x66, 0xeb, 0x5b, 0x60, 0xa1, 0x8d, 0x5b, 0x7a, 0xfb, 0x6e, 0x27, 0xeb, 0x3c, 0xc7, 0xfe, 0x7f,
-		0x73, 0x75, 0x54, 0x4b, 0xbe, 0x0f, 0x20, 0xa6, 0x60, 0xab, 0x47, 0xfc, 0x31, 0x54, 0x84, 0x38,
-		0xde, 0x6f, 0xe1, 0x9e, 0xed, 0x1e, 0xe3, 0x18, 0x17, 0x22, 0x30, 0xe8, 0x8a, 0x80, 0x24, 0x01,
-		0xb2, 0x83, 0x86, 0x9e, 0xe0, 0x39, 0x80, 0xc9, 0xe1, 0x3c, 0x47, 0xf7, 0x26, 0x01, 0x2e, 0x8a,
-		0x24, 0x40, 0x70, 0x62, 0x7c, 0xcb, 0xb0, 0x86, 0xb2, 0x00, 0x4f, 0xf0, 0x2c, 0x40, 0x68, 0x4a,
-		0x63, 0xbe, 0x34, 0x40, 0xc9, 0x97, 0x06, 0x88, 0x4c, 0xe9, 0xe6, 0x84, 0x3c, 0xc0, 0x45, 0x91,
-		0x07, 0x88, 0x4e, 0xe1, 0x78, 0x28, 0x11, 0xf0, 0x26, 0x4f, 0x22, 0x20, 0x3e, 0x9a, 0xdf, 0xf5,
-		0xa1, 0x8e, 0xc9, 0x04, 0x5c, 0x72, 0x32, 0x01, 0xc9, 0x89, 0x59, 0x04, 0x8e, 0x3c, 0x9c, 0x0a,
-		0xa8, 0x8f, 0xa4, 0x02, 0x52, 0xfc, 0xc7, 0xe2, 0x27, 0x91, 0x98, 0x92, 0x0b, 0xa8, 0x8f, 0xe4,
-		0x02, 0xd2, 0x53, 0x08, 0x4e, 0x49, 0x06, 0x7c, 0xe3, 0xf8, 0x64, 0xc0, 0xe4, 0x70, 0x9d, 0xb3,
-		0x39, 0x5b, 0x36, 0x40, 0x9d, 0x90, 0x0d, 0x90, 0x27, 0x46, 0xae, 0x8c, 0xfc, 0xcc, 0xe9, 0x80,
-		0xad, 0x31, 0xe9, 0x00, 0x16, 0xb8, 0x9f, 0x99, 0x48, 0x7c, 0x86, 0x7c, 0xc0, 0xd6, 0x98, 0x7c,
-		0x00, 0x9a, 0x4a, 0x76, 0x6a, 0x42, 0xe0, 0x8a, 0x3f, 0x21, 0xb0, 0x30, 0xc1, 0xeb, 0x74, 0x67,
-		0xfb, 0x84, 0x8c, 0xc0, 0xf6, 0xa4, 0x8c, 0xc0, 0x22, 0xff, 0xbd, 0x98, 0x49, 0x14, 0x8f, 0x90,
-		0x12, 0xa8, 0x8f, 0xa4, 0x04, 0x8e, 0x4d, 0xd1, 0xb4, 0xd9, 0x73, 0x02, 0xec, 0x57, 0x04, 0xd9,
-		0xef, 0x07, 0x82, 0x9c, 0xb8, 0x1e, 0x8a, 0x25, 0xe4, 0x64, 0xfe, 0x61, 0xe2, 0xc1, 0x0c, 0xd9,
-		0x39, 0x12, 0x2b, 0xe0, 0x7e, 0xdf, 0xec, 0x8b, 0x5b, 0x1d, 0xf4, 0x23, 0x7f, 0x86, 0xc4, 0x88,
-		0xae, 0x4d, 0x3b, 0x24, 0x7f, 0x40, 0x63, 0x32, 0x8f, 0x1d, 0xcb, 0xff, 0x92, 0xe4, 0xe2, 0xd2,
-		0x0c, 0x82, 0x37, 0xbe, 0x8c, 0xf3, 0xf8, 0xd2, 0x93, 0x55, 0x08, 0xf8, 0xb3, 0x0a, 0x4b, 0x90,
-		0x20, 0xb1, 0xd6, 0x50, 0xc2, 0x40, 0xeb, 0x39, 0x09, 0x03, 0x71, 0x64, 0x8b, 0xe5, 0x1e, 0xf8,
-		0xb2, 0xc4, 0xf6, 0x2f, 0x33, 0xce, 0xf1, 0x35, 0x16, 0xea, 0xa2, 0xc7, 0x60, 0xc1, 0x03, 0xeb,
-		0xc4, 0x70, 0x2c, 0x7a, 0x96, 0x1d, 0xe8, 0x02, 0x0f, 0xe6, 0xfe, 0xa5, 0xe4, 0x4a, 0xc8, 0xcd,
-		0x34, 0x8c, 0x4b, 0x0a, 0x48, 0x5f, 0xa5, 0xa4, 0x40, 0xe0, 0xae, 0x93, 0x02, 0xde, 0x98, 0x34,
-		0xe8, 0x8f, 0x49, 0xff, 0xbb, 0xe4, 0x8e, 0x89, 0x13, 0xe2, 0xb7, 0xcc, 0x36, 0xe6, 0x51, 0x22,
-		0xfd, 0x9b, 0xb8, 0x24, 0x1d, 0x53, 0xfc, 0xf0, 0x1b, 0xf9, 0x93, 0x40, 0x39, 0x0b, 0x4f, 0x9c,
-		0xaf, 0x2b, 0x4e, 0x80, 0x19, 0xf6, 0xde, 0x1c, 0xe1, 0xd7, 0x29, 0x22, 0xee, 0x75, 0x0a, 0xe7,
-		0xb2, 0x7a, 0xd4, 0x73, 0x59, 0x1d, 0x3d, 0x0d, 0x71, 0x9a, 0xec, 0x57, 0xcd, 0x9e, 0xf8, 0x61,
-		0xfe, 0x53, 0x93, 0xaf, 0x52, 0x58, 0xf4, 0x90, 0x37, 0xbb, 0x7e, 0xe1, 0x7a, 0x1c, 0x71, 0x9f,
-		0xc7, 0x71, 0x0f, 0xc4, 0x09, 0xf7, 0xec, 0x87, 0x64, 0x81, 0xbf, 0x74, 0x20, 0x0a, 0xf2, 0x3f,
-		0x11, 0x80, 0xcc, 0xd0, 0x42, 0x33, 0xb6, 0xef, 0xe3, 0x36, 0xae, 0x67, 0x93, 0xc7, 0x69, 0x80,
-		0x5d, 0xcd, 0x52, 0x5f, 0xd2, 0x0c, 0x1b, 0xb7, 0xb9, 0x50, 0x3c, 0x25, 0x28, 0x07, 0x31, 0xf2,
-		0x35, 0xb0, 0x70, 0x9b, 0x67, 0x5f, 0x9c, 0x6f, 0x74, 0x0d, 0x22, 0xf8, 0x36, 0x7d, 0x1c, 0x9a,
-		0xfd, 0xee, 0xcf, 0xf1, 0xd1, 0x70, 0x98, 0x54, 0x17, 0xb3, 0x64, 0xb0, 0x3f, 0xf7, 0xa9, 0x25,
-		0x99, 0x41, 0x3f, 0xea, 0x5c, 0x9f, 0x53, 0x38, 0xbe, 0x5f, 0x0a, 0xb1, 0x21, 0x29, 0xd0, 0x3c,
-		0x60, 0x52, 0x84, 0xf7, 0x44, 0xa6, 0xec, 0x54, 0xb9, 0x92, 0xea, 0xe2, 0x6e, 0xcf, 0x34, 0x3b,
-		0x2a, 0x9b, 0xe3, 0x05, 0x48, 0xfb, 0xd7, 0x55, 0xf6, 0xe3, 0xef, 0xb6, 0xa6, 0x1b, 0xaa, 0xcf,
-		0x09, 0x4e, 0xb2, 0x42, 0x36, 0xa7, 0xbc, 0x2f, 0x7f, 0xe7, 0x37, 0xe1, 0xd8, 0xd8, 0x75, 0x15,
-		0x3d, 0x05, 0x71, 0x77, 0x49, 0x66, 0x17, 0x03, 0x0e, 0xc9, 0xb4, 0xb8, 0xb0, 0xf9, 0x5f, 0x91,
-		0x5c, 0x92, 0xfe, 0xdc, 0x4d, 0x05, 0x22, 0xec, 0x94, 0x26, 0x3f, 0x91, 0xf3, 0xd8, 0x6c, 0x2b,
-		0xf2, 0x0a, 0x3b, 0xc2, 0xa9, 0x70, 0xe4, 0xfc, 0x5b, 0x21, 0xc2, 0x4a, 0x50, 0x02, 0xa2, 0xbe,
-		0x5f, 0x3c, 0x28, 0x94, 0x4a, 0x95, 0x4d, 0xf1, 0xb8, 0x73, 0x91, 0x3e, 0x22, 0x4c, 0x8a, 0x95,
-		0xca, 0xf5, 0x4a, 0xa9, 0x29, 0x07, 0xd1, 0x3c, 0xa4, 0xd8, 0xdf, 0xfc, 0x77, 0x09, 0xe4, 0x90,
-		0xa7, 0xa8, 0x51, 0xa9, 0x95, 0x2b, 0x8a, 0x1c, 0xce, 0x9f, 0x87, 0x93, 0x13, 0xd7, 0x70, 0x37,
-		0x31, 0x23, 0x79, 0x12, 0x33, 0xf9, 0x57, 0x03, 0x24, 0xa8, 0x99, 0xb4, 0x30, 0xa3, 0xeb, 0x43,
-		0x1d, 0x5f, 0x3d, 0xc2, 0xaa, 0x3e, 0xd4, 0x7b, 0x12, 0xc7, 0xf4, 0xf1, 0x0e, 0xb6, 0x5b, 0x7b,
-		0xcc, 0x51, 0x60, 0x16, 0x28, 0xa5, 0xa4, 0x78, 0x29, 0x45, 0xb2, 0x18, 0xd8, 0x8b, 0xb8, 0x65,
-		0xab, 0x4c, 0x89, 0x2c, 0x1a, 0x4c, 0xc4, 0x09, 0x18, 0x29, 0x6d, 0xb0, 0xc2, 0xfc, 0x37, 0x1f,
-		0x49, 0x96, 0x71, 0x08, 0x2b, 0x95, 0xa6, 0x72, 0x53, 0x0e, 0x22, 0x04, 0x69, 0xfa, 0xa7, 0xda,
-		0xa8, 0x15, 0x36, 0x1b, 0xd7, 0xea, 0x44, 0x96, 0x0b, 0x90, 0x11, 0xb2, 0x14, 0x85, 0xe1, 0xfc,
-		0x23, 0x70, 0x62, 0x82, 0x57, 0x31, 0xe6, 0xd8, 0xec, 0x87, 0x24, 0x2f, 0xb4, 0xdf, 0x33, 0xa8,
-		0x43, 0xc4, 0xb2, 0x35, 0x7b, 0x60, 0x71, 0x21, 0x3e, 0x35, 0xab, 0x9b, 0xb1, 0x22, 0xfe, 0x68,
-		0x50, 0x74, 0x85, 0x93, 0xc9, 0x5f, 0x80, 0xb4, 0xbf, 0x66, 0xb2, 0x0c, 0x5c, 0x25, 0x0a, 0xe4,
-		0x2f, 0x03, 0x1a, 0xf5, 0x3e, 0xc6, 0x84, 0x97, 0xd2, 0xb8, 0xf0, 0xf2, 0xc7, 0x24, 0x38, 0x75,
-		0x88, 0xa7, 0x81, 0xde, 0x32, 0xd4, 0xc9, 0x4b, 0x47, 0xf1, 0x53, 0x56, 0x58, 0xd9, 0x50, 0x37,
-		0x9f, 0x80, 0xa4, 0xb7, 0x7c, 0xb6, 0x4e, 0x7e, 0x2e, 0xe0, 0x4e, 0x62, 0x7f, 0x1c, 0xec, 0x9a,
-		0x40, 0xe9, 0x2b, 0x34, 0x81, 0x6f, 0x04, 0xb0, 0xf7, 0xc5, 0xb9, 0x6d, 0xbe, 0x8e, 0xde, 0x3b,
-		0x26, 0xbf, 0x88, 0x5b, 0xcd, 0x7d, 0x3e, 0x09, 0xe2, 0x36, 0xff, 0xcb, 0x42, 0x0d, 0x6f, 0x52,
-		0x60, 0x40, 0xd7, 0x58, 0x8b, 0x07, 0xcc, 0xb3, 0x2e, 0xc6, 0x6e, 0xf2, 0x80, 0x15, 0x5b, 0xe8,
-		0x26, 0x9c, 0x18, 0x72, 0x14, 0x1c, 0xd2, 0xa1, 0x59, 0xfd, 0x85, 0x63, 0x7e, 0x7f, 0x41, 0x90,
-		0xf6, 0xae, 0xf6, 0x61, 0xff, 0x6a, 0x7f, 0x13, 0xc0, 0x4d, 0x0e, 0xb8, 0x07, 0x0d, 0x25, 0xef,
-		0x41, 0xc3, 0x0b, 0x10, 0x26, 0x9a, 0x24, 0xe4, 0x34, 0x6a, 0x8a, 0x89, 0x26, 0x78, 0x92, 0x0b,
-		0x0c, 0x3a, 0xaf, 0x03, 0x1a, 0x4d, 0xd0, 0x4e, 0x68, 0xe2, 0x4d, 0xfe, 0x26, 0xee, 0x9b, 0x98,
-		0xea, 0x1d, 0xdf, 0xd4, 0xcb, 0x10, 0xa6, 0x23, 0x3f, 0xf6, 0xf6, 0xe6, 0x37, 0x01, 0x68, 0xb6,
-		0xdd, 0xd7, 0xb7, 0x07, 0x6e, 0x03, 0x4b, 0xe3, 0x35, 0xa7, 0x20, 0xe0, 0x8a, 0xf7, 0x70, 0x15,
-		0x5a, 0x74, 0x51, 0x3d, 0x6a, 0xe4, 0x21, 0x98, 0xaf, 0x41, 0xda, 0x8f, 0x3b, 0xfe, 0x36, 0xea,
-		0xe8, 0x2f, 0xfe, 0xba, 0xde, 0x11, 0x7f, 0x20, 0x8b, 0x7e, 0xe4, 0xdf, 0x1e, 0x80, 0xa4, 0x57,
-		0xf1, 0xfe, 0xee, 0xb9, 0x20, 0xf9, 0x6f, 0x93, 0x20, 0xe6, 0x74, 0xff, 0x90, 0xe3, 0xd5, 0xee,
-		0xad, 0x64, 0x67, 0xf3, 0x82, 0x6d, 0x13, 0x05, 0x9d, 0x6d, 0xa2, 0xcb, 0xce, 0xf2, 0x37, 0x29,
-		0x21, 0xe2, 0x95, 0xb5, 0x38, 0xe2, 0xc8, 0x57, 0xfb, 0xcb, 0xb3, 0x5d, 0xfe, 0x5b, 0x84, 0xb0,
-		0xf7, 0xe2, 0x1e, 0xfb, 0xc8, 0xb7, 0x3d, 0x47, 0x92, 0xd8, 0x44, 0xf4, 0xde, 0x12, 0x94, 0x8e,
-		0x7c, 0x4b, 0xd0, 0x69, 0x25, 0xe0, 0x6d, 0xe5, 0x7b, 0x25, 0x88, 0x89, 0x39, 0x81, 0xde, 0xec,
-		0x3d, 0xeb, 0x26, 0xb6, 0x36, 0x27, 0xda, 0x23, 0x4e, 0xdf, 0x73, 0xd4, 0x6d, 0xe4, 0x88, 0x77,
-		0xf0, 0xa8, 0x47, 0xbc, 0xb9, 0x67, 0xf7, 0x57, 0x12, 0xc8, 0xc3, 0x33, 0xf6, 0x2b, 0xe6, 0x6e,
-		0x74, 0x99, 0x0b, 0x8e, 0x59, 0xe6, 0x26, 0x9d, 0xdb, 0x0e, 0x4d, 0x3a, 0xb7, 0x3d, 0xda, 0xeb,
-		0xf0, 0x5d, 0xf6, 0xfa, 0x9d, 0x01, 0x48, 0x78, 0xd2, 0xa3, 0xe8, 0x49, 0xdf, 0x19, 0xf0, 0xe5,
-		0xc3, 0x52, 0xa9, 0x9e, 0x43, 0xe0, 0x3e, 0x31, 0x05, 0x8e, 0x2e, 0xa6, 0xaf, 0xfe, 0x7d, 0xb0,
-		0xf1, 0x57, 0x66, 0xc3, 0x13, 0xae, 0xcc, 0x7e, 0xab, 0x04, 0x31, 0xc7, 0xed, 0x3e, 0xea, 0x26,
-		0xe6, 0x71, 0x88, 0x70, 0xcf, 0x92, 0xed, 0x62, 0xf2, 0xaf, 0xb1, 0x69, 0xe5, 0x1c, 0xc4, 0xba,
-		0xd8, 0xd6, 0xa8, 0x1d, 0x64, 0xab, 0x9a, 0xf3, 0x7d, 0xf6, 0x12, 0x24, 0x3c, 0x1b, 0xc0, 0xc4,
-		0x34, 0xd6, 0x2a, 0xcf, 0xc9, 0x73, 0xb9, 0xe8, 0x2b, 0x77, 0x96, 0x83, 0x35, 0xfc, 0x12, 0x99,
-		0xcd, 0x4a, 0xa5, 0x74, 0xad, 0x52, 0x7a, 0x56, 0x96, 0x72, 0x89, 0x57, 0xee, 0x2c, 0x47, 0x15,
-		0x4c, 0x33, 0x8a, 0x67, 0x9f, 0x85, 0xcc, 0xd0, 0xc0, 0xf8, 0xdd, 0x16, 0x04, 0xe9, 0xf2, 0xd6,
-		0xe6, 0x7a, 0xb5, 0x54, 0x68, 0x56, 0x54, 0x76, 0x52, 0x18, 0x9d, 0x80, 0x85, 0xf5, 0xea, 0xd5,
-		0x6b, 0x4d, 0xb5, 0xb4, 0x5e, 0xad, 0xd4, 0x9a, 0x6a, 0xa1, 0xd9, 0x2c, 0x94, 0x9e, 0x95, 0x03,
-		0xab, 0x77, 0x12, 0x10, 0x2a, 0x14, 0x4b, 0x55, 0x54, 0x82, 0x10, 0x4d, 0x85, 0x1c, 0x7a, 0x02,
-		0x2c, 0x77, 0x78, 0x6e, 0x18, 0x5d, 0x81, 0x30, 0xcd, 0x92, 0xa0, 0xc3, 0x8f, 0x84, 0xe5, 0xa6,
-		0x24, 0x8b, 0x09, 0x33, 0x74, 0x46, 0x1e, 0x7a, 0x46, 0x2c, 0x77, 0x78, 0xee, 0x18, 0xad, 0x43,
-		0x54, 0x04, 0xc9, 0xd3, 0x0e, 0x6e, 0xe5, 0xa6, 0x26, 0x74, 0x49, 0xd7, 0x58, 0xb2, 0xe1, 0xf0,
-		0xe3, 0x63, 0xb9, 0x29, 0x59, 0x65, 0x54, 0x75, 0x6e, 0x50, 0x4d, 0x39, 0x11, 0x96, 0x9b, 0x96,
-		0x27, 0x46, 0x0a, 0xc4, 0xdd, 0x34, 0xce, 0xf4, 0x43, 0x71, 0xb9, 0x19, 0x12, 0xe6, 0xe8, 0xad,
-		0x90, 0xf2, 0x87, 0xba, 0xb3, 0x9d, 0x3a, 0xcb, 0xcd, 0x98, 0x91, 0x26, 0xf4, 0xfd, 0x71, 0xef,
-		0x6c, 0xa7, 0xd0, 0x72, 0x33, 0x26, 0xa8, 0xd1, 0x8b, 0x30, 0x3f, 0x1a, 0x97, 0xce, 0x7e, 0x28,
-		0x2d, 0x77, 0x84, 0x94, 0x35, 0xea, 0x02, 0x1a, 0x13, 0xcf, 0x1e, 0xe1, 0x8c, 0x5a, 0xee, 0x28,
-		0x19, 0x6c, 0xd4, 0x86, 0xcc, 0x70, 0x90, 0x38, 0xeb, 0x99, 0xb5, 0xdc, 0xcc, 0xd9, 0x6c, 0xd6,
-		0x8a, 0x3f, 0xb8, 0x9c, 0xf5, 0x0c, 0x5b, 0x6e, 0xe6, 0xe4, 0x36, 0xda, 0x02, 0xf0, 0xc4, 0x87,
-		0x33, 0x9c, 0x69, 0xcb, 0xcd, 0x92, 0xe6, 0x46, 0x3d, 0x58, 0x18, 0x17, 0x38, 0x1e, 0xe5, 0x88,
-		0x5b, 0xee, 0x48, 0xd9, 0x6f, 0xa2, 0xcf, 0xfe, 0x10, 0x70, 0xb6, 0x23, 0x6f, 0xb9, 0x19, 0xd3,
-		0xe0, 0xc5, 0xc2, 0xc4, 0x73, 0xce, 0x0f, 0x1d, 0x7a, 0xce, 0xd9, 0x3d, 0xb9, 0xec, 0x9c, 0x6d,
-		0xfe, 0xdc, 0x79, 0x78, 0x03, 0x7f, 0xb3, 0xc7, 0xb2, 0xb5, 0x5b, 0xba, 0xb1, 0xeb, 0x3c, 0xec,
-		0xc4, 0xbf, 0xf9, 0x21, 0xe7, 0xe3, 0xfc, 0x5d, 0x20, 0x51, 0x3a, 0xe5, 0x79, 0xa7, 0x89, 0xaf,
-		0x82, 0x4e, 0xbb, 0x81, 0x31, 0xfd, 0xf8, 0xf2, 0x21, 0x4f, 0x47, 0x4d, 0x79, 0xa0, 0x6a, 0xcc,
-		0xd3, 0x52, 0xb9, 0x43, 0x5f, 0x35, 0xc8, 0x1d, 0x76, 0xee, 0x9b, 0x78, 0xb1, 0xe9, 0x6b, 0xba,
-		0x65, 0x9b, 0x7d, 0xbd, 0xa5, 0x75, 0xe8, 0xf2, 0x72, 0x79, 0xd6, 0x7b, 0x60, 0xc5, 0x38, 0x71,
-		0x57, 0xf8, 0xeb, 0x53, 0xfc, 0x6e, 0x52, 0x19, 0x22, 0xb7, 0xb5, 0x0e, 0xbb, 0x85, 0xe5, 0x7d,
-		0xbe, 0x6e, 0x58, 0xe6, 0x1e, 0x3f, 0xca, 0x4b, 0x85, 0xe1, 0xe6, 0xbf, 0x9f, 0xde, 0x60, 0xe9,
-		0x76, 0x75, 0x8b, 0xe8, 0xa2, 0x42, 0x43, 0xe9, 0xeb, 0x10, 0xea, 0x6b, 0x36, 0x0f, 0x30, 0x8b,
-		0x17, 0x8f, 0xfc, 0x12, 0x14, 0x6b, 0x81, 0xd2, 0x40, 0x6f, 0x81, 0x58, 0x57, 0xdb, 0x57, 0x29,
-		0xbd, 0xc0, 0x57, 0x44, 0x2f, 0xda, 0xd5, 0xf6, 0x09, 0x7f, 0xe8, 0xad, 0xf4, 0x07, 0x28, 0xd5,
-		0xd6, 0x9e, 0x66, 0xec, 0x62, 0x46, 0x39, 0xf8, 0x15, 0x51, 0x4e, 0x75, 0xb5, 0xfd, 0x12, 0xa5,
-		0x46, 0xe8, 0xf3, 0x47, 0xb6, 0x7e, 0x5d, 0xe2, 0x59, 0x03, 0x2a, 0x18, 0xa4, 0x81, 0xdc, 0x72,
-		0xbe, 0x68, 0xa3, 0x62, 0x8b, 0xe3, 0xa1, 0x49, 0x72, 0x1f, 0x12, 0x6b, 0x31, 0x45, 0xd8, 0xfb,
-		0xe4, 0xa7, 0x96, 0x24, 0xd6, 0x6a, 0xa6, 0x35, 0x22, 0xf6, 0x04, 0x4b, 0x86, 0xa8, 0xd4, 0x87,
-		0x0d, 0x4c, 0xf5, 0x61, 0x53, 0xc2, 0x87, 0x65, 0x04, 0x81, 0x61, 0x93, 0x7a, 0xde, 0x87, 0x8f,
-		0x4a, 0x90, 0x28, 0x7b, 0x9e, 0xda, 0xcc, 0x42, 0xb4, 0x6b, 0x1a, 0xfa, 0x2d, 0xdc, 0x77, 0xf6,
-		0xa8, 0xd8, 0x27, 0xf1, 0x33, 0xd9, 0xaf, 0xca, 0xdb, 0x07, 0xe2, 0x3d, 0x23, 0xf1, 0x4d, 0xb0,
-		0x5e, 0xc2, 0xdb, 0x96, 0x2e, 0xe4, 0xac, 0x88, 0x4f, 0xf4, 0x30, 0xc8, 0x16, 0x6e, 0x0d, 0xfa,
-		0xba, 0x7d, 0xa0, 0xb6, 0x4c, 0xc3, 0xd6, 0x5a, 0x36, 0x8f, 0xc3, 0x33, 0xa2, 0xbc, 0xc4, 0x8a,
-		0x09, 0x91, 0x36, 0xb6, 0x35, 0xbd, 0xc3, 0x4e, 0x66, 0xc6, 0x15, 0xf1, 0xc9, 0x59, 0xbd, 0x13,
-		0xf5, 0xc6, 0xa1, 0x25, 0x90, 0xcd, 0x1e, 0xee, 0xfb, 0x0e, 0xa4, 0x30, 0x6d, 0xcc, 0xfe, 0xee,
-		0xc7, 0x1f, 0x5b, 0xe4, 0x02, 0xe7, 0x87, 0x19, 0xd8, 0x2f, 0x4b, 0x28, 0x19, 0x81, 0x21, 0x4e,
-		0xaa, 0xdc, 0xf4, 0xed, 0x4a, 0x0d, 0xb6, 0xdd, 0x87, 0x6b, 0x16, 0x47, 0x84, 0x5a, 0x30, 0x0e,
-		0x8a, 0xd9, 0x4f, 0xb8, 0xa4, 0xdd, 0x38, 0xf5, 0x59, 0x7c, 0xe0, 0xdd, 0xa2, 0xa2, 0x64, 0x88,
-		0x0b, 0xff, 0xa2, 0xa6, 0x77, 0x70, 0x9b, 0xa7, 0x35, 0xf8, 0x17, 0x5a, 0x73, 0xd2, 0x8b, 0xec,
-		0x57, 0x55, 0xf3, 0x93, 0x74, 0xa3, 0x68, 0x1a, 0x6d, 0x7f, 0x1e, 0x11, 0x95, 0x20, 0x62, 0x9b,
-		0xb7, 0xb0, 0xc1, 0x05, 0x74, 0xb4, 0xc7, 0xdc, 0x38, 0x2a, 0xfa, 0x46, 0x90, 0xdb, 0xb8, 0x83,
-		0x77, 0xd9, 0x0d, 0xcd, 0x3d, 0xad, 0x8f, 0xd9, 0xfb, 0x00, 0x77, 0xf5, 0xa0, 0x5b, 0xc6, 0x21,
-		0xd5, 0xa0, 0x94, 0xd0, 0xa6, 0xff, 0x31, 0xd7, 0x28, 0xdf, 0x3c, 0x9e, 0xd0, 0x47, 0x8f, 0xe6,
-		0x79, 0x2d, 0x8f, 0xef, 0xf1, 0xd7, 0x87, 0x41, 0x1e, 0x18, 0xdb, 0xa6, 0x41, 0x7f, 0x22, 0x94,
-		0x47, 0x51, 0x31, 0xb6, 0x53, 0xe9, 0x94, 0xf3, 0x9d, 0xca, 0x4d, 0x48, 0xbb, 0xa0, 0x74, 0x86,
-		0xc4, 0x8f, 0x3a, 0x43, 0x52, 0x0e, 0x01, 0x02, 0x82, 0x36, 0x00, 0xdc, 0x39, 0x48, 0xb7, 0xca,
-		0x12, 0x93, 0x47, 0xcc, 0x9d, 0xcd, 0xde, 0xce, 0x78, 0x08, 0x20, 0x03, 0x16, 0xba, 0xba, 0xa1,
-		0x5a, 0xb8, 0xb3, 0xa3, 0x72, 0xc9, 0x11, 0xba, 0x09, 0x2a, 0xfe, 0x37, 0x1f, 0x61, 0x34, 0x7f,
-		0xff, 0xe3, 0x8f, 0x65, 0xdc, 0x27, 0xf1, 0x96, 0x1f, 0x5f, 0x79, 0xf2, 0xa2, 0x32, 0xdf, 0xd5,
-		0x8d, 0x06, 0xee, 0xec, 0x94, 0x1d, 0xc2, 0xe8, 0x8d, 0x70, 0xca, 0x15, 0x88, 0x69, 0xa8, 0x7b,
-		0x66, 0xa7, 0xad, 0xf6, 0xf1, 0x8e, 0xda, 0xa2, 0x8f, 0x0d, 0x26, 0xa9, 0x18, 0x4f, 0x38, 0x20,
-		0x75, 0xe3, 0x9a, 0xd9, 0x69, 0x2b, 0x78, 0xa7, 0x44, 0xaa, 0xd1, 0xfd, 0xe0, 0x4a, 0x43, 0xd5,
-		0xdb, 0x56, 0x36, 0xb5, 0x1c, 0x3c, 0x13, 0x52, 0x92, 0x4e, 0x61, 0xb5, 0x6d, 0xad, 0xc5, 0xde,
-		0xfd, 0xc1, 0xa5, 0xb9, 0xd7, 0x3e, 0xb8, 0x34, 0x97, 0xbf, 0x42, 0x1f, 0xb6, 0xe2, 0x53, 0x0b,
-		0x5b, 0xe8, 0x22, 0xc4, 0x35, 0xf1, 0xc1, 0x6e, 0x9d, 0x1d, 0x32, 0x35, 0x5d, 0xd0, 0xfc, 0x8f,
-		0x4b, 0x10, 0x29, 0xdf, 0xd8, 0xd4, 0xf4, 0x3e, 0xaa, 0xc0, 0xbc, 0xab, 0xab, 0xb3, 0xce, 0x72,
-		0x57, 0xbd, 0xc5, 0x34, 0xaf, 0x4d, 0x3a, 0xbd, 0x16, 0x2f, 0xde, 0xf7, 0xbb, 0x1f, 0x7f, 0xec,
-		0x5e, 0x4e, 0xe6, 0xc6, 0xd0, 0x41, 0x36, 0x41, 0x6f, 0xf8, 0x80, 0x9b, 0xa7, 0xcf, 0xd7, 0x21,
-		0xca, 0x58, 0xb5, 0xd0, 0x33, 0x10, 0xee, 0x91, 0x3f, 0x78, 0x52, 0xfe, 0xf4, 0x44, 0x9d, 0xa7,
-		0xf0, 0x5e, 0x0d, 0x61, 0x78, 0xf9, 0xf7, 0x04, 0x00, 0xca, 0x37, 0x6e, 0x34, 0xfb, 0x7a, 0xaf,
-		0x83, 0xed, 0xaf, 0x56, 0xdf, 0xb7, 0xe0, 0x98, 0xe7, 0x42, 0x76, 0xbf, 0x75, 0xf4, 0xfe, 0x2f,
-		0xb8, 0x77, 0xb3, 0xfb, 0xad, 0xb1, 0x64, 0xdb, 0x96, 0xed, 0x90, 0x0d, 0x1e, 0x9d, 0x6c, 0xd9,
-		0xb2, 0x47, 0x25, 0xfb, 0x3c, 0x24, 0x5c, 0x61, 0x58, 0xa8, 0x0a, 0x31, 0x9b, 0xff, 0xcd, 0x05,
-		0x9c, 0x9f, 0x2c, 0x60, 0x81, 0xe6, 0x15, 0xb2, 0x83, 0x9e, 0xff, 0x1b, 0x09, 0xc0, 0x33, 0x47,
-		0x5e, 0x9f, 0x3a, 0x46, 0x22, 0x78, 0x6e, 0x9c, 0x83, 0x77, 0xfd, 0x24, 0x28, 0x23, 0xe0, 0x11,
-		0xea, 0x7b, 0x03, 0xb0, 0xb0, 0x25, 0x66, 0xef, 0xeb, 0x5f, 0x06, 0x5b, 0x10, 0xc5, 0x86, 0xdd,
-		0xd7, 0x9d, 0x6d, 0xa5, 0xc7, 0x27, 0x8d, 0xf9, 0x98, 0x4e, 0x55, 0x0c, 0xbb, 0x7f, 0xe0, 0xd5,
-		0x00, 0x41, 0xcb, 0x23, 0x8f, 0xf7, 0x07, 0x21, 0x3b, 0x09, 0x15, 0x3d, 0x04, 0x99, 0x56, 0x1f,
-		0xd3, 0x02, 0xff, 0x35, 0xd3, 0xb4, 0x28, 0xe6, 0xcb, 0x8e, 0x02, 0xc4, 0x51, 0x23, 0xca, 0x45,
-		0x40, 0xef, 0xce, 0x33, 0x4b, 0xbb, 0x14, 0xe8, 0xc2, 0xd3, 0x84, 0x8c, 0xb8, 0x86, 0xb2, 0xad,
-		0x75, 0x34, 0xa3, 0x25, 0x3c, 0xd8, 0x23, 0xad, 0xf9, 0xe2, 0x2a, 0x4b, 0x91, 0x91, 0x40, 0x15,
-		0x88, 0x0a, 0x6a, 0xa1, 0xa3, 0x53, 0x13, 0xb8, 0xe8, 0x3e, 0x48, 0x7a, 0x17, 0x06, 0xea, 0x8d,
-		0x84, 0x94, 0x84, 0x67, 0x5d, 0x98, 0xb6, 0xf2, 0x44, 0x0e, 0x5d, 0x79, 0xb8, 0xc3, 0xf7, 0x23,
-		0xf4, 0xb4, 0x78, 0xfb, 0x6f, 0xff, 0xb0, 0x6c, 0x02, 0xb0, 0xa9, 0x4a, 0x2c, 0x29, 0x1f, 0x99,
-		0xbb, 0x98, 0xef, 0x71, 0x46, 0xa4, 0x6c, 0xd9, 0x5f, 0xaf, 0x11, 0xfa, 0x83, 0x00, 0x24, 0xbd,
-		0x23, 0xf4, 0x77, 0x72, 0xd1, 0x42, 0x35, 0xd7, 0x4c, 0xb1, 0x1b, 0x35, 0x0f, 0x4f, 0x32, 0x53,
-		0x23, 0xda, 0x3c, 0xc5, 0x3e, 0x7d, 0x21, 0x08, 0x11, 0x7e, 0xe4, 0xad, 0x3e, 0xe2, 0xdb, 0x4a,
-		0xd3, 0x1e, 0x11, 0x48, 0x89, 0x47, 0x04, 0xc6, 0xba, 0xb6, 0x0f, 0x40, 0x9a, 0xc4, 0xc8, 0xbe,
-		0x73, 0x74, 0xd2, 0x99, 0x14, 0x0d, 0x75, 0xdd, 0x53, 0xe3, 0x68, 0x09, 0x12, 0x04, 0xcc, 0xb5,
-		0xc3, 0x04, 0x06, 0xba, 0xda, 0x7e, 0x85, 0x95, 0xa0, 0xc7, 0x00, 0xed, 0x39, 0x39, 0x0b, 0xd5,
-		0x15, 0x04, 0x81, 0x9b, 0x77, 0x6b, 0x04, 0xf8, 0xbd, 0x00, 0x84, 0x0b, 0x95, 0xbd, 0x82, 0xcd,
-		0xdf, 0x18, 0x27, 0x25, 0x65, 0xfa, 0x12, 0xf6, 0xbb, 0x24, 0xe6, 0x22, 0x0f, 0x45, 0xd2, 0x3c,
-		0x42, 0x69, 0xce, 0x30, 0x29, 0xfe, 0xf2, 0x53, 0x4b, 0xb9, 0x03, 0xad, 0xdb, 0x59, 0xcb, 0x8f,
-		0xa1, 0x93, 0x1f, 0x17, 0xdc, 0x13, 0xc7, 0xd9, 0x1f, 0x89, 0xa3, 0x1a, 0xc8, 0xb7, 0xf0, 0x81,
-		0xda, 0xe7, 0x3f, 0x6e, 0xaf, 0xee, 0x60, 0xcc, 0x63, 0x99, 0x93, 0x2b, 0x63, 0xde, 0x24, 0x5f,
-		0x29, 0x99, 0xba, 0xcf, 0xe9, 0x4f, 0xdf, 0xc2, 0x07, 0x0a, 0x47, 0xbe, 0x82, 0xf1, 0xda, 0x1b,
-		0xc8, 0x74, 0x79, 0xe5, 0x33, 0x3f, 0x73, 0xf6, 0x94, 0xe7, 0x21, 0xeb, 0x7d, 0x27, 0x43, 0xc6,
-		0xc6, 0x98, 0x78, 0xbe, 0xc8, 0x5d, 0x85, 0x9c, 0xc3, 0xf7, 0x1b, 0x00, 0x9e, 0x60, 0x41, 0x3a,
-		0x3c, 0x08, 0x71, 0xf1, 0x7d, 0x41, 0x88, 0x67, 0x8e, 0xbe, 0xd9, 0x5d, 0x04, 0x02, 0x47, 0xe8,
-		0x92, 0x40, 0xa2, 0x53, 0x7f, 0x2e, 0xff, 0x6f, 0x25, 0x38, 0x39, 0xa2, 0xce, 0x0e, 0xcb, 0x2d,
-		0x40, 0x7d, 0x4f, 0x25, 0x55, 0x0b, 0xb1, 0xdb, 0x7b, 0x77, 0xb3, 0x63, 0xbe, 0x3f, 0xb2, 0x12,
-		0x7c, 0x75, 0x56, 0x33, 0x6e, 0xca, 0x7e, 0x4b, 0x82, 0x45, 0x2f, 0x03, 0x4e, 0x57, 0x1a, 0x90,
-		0xf4, 0x36, 0xcd, 0x3b, 0xf1, 0x86, 0x59, 0x3a, 0xe1, 0xe5, 0xdf, 0x47, 0x04, 0xdd, 0x70, 0x4d,
-		0x06, 0x4b, 0xcd, 0x9d, 0x9f, 0x59, 0x28, 0x82, 0xb1, 0xb1, 0xa6, 0x83, 0x8d, 0xcd, 0xe7, 0x25,
-		0x08, 0x6d, 0x9a, 0x66, 0x07, 0xbd, 0x0d, 0xe6, 0x0d, 0xd3, 0x56, 0xc9, 0xf4, 0xc2, 0x6d, 0x95,
-		0xe7, 0x0e, 0x98, 0x39, 0xae, 0x1c, 0x2a, 0xab, 0xcf, 0x7d, 0x6a, 0x69, 0x14, 0x73, 0xdc, 0x43,
-		0xf7, 0x19, 0xc3, 0xb4, 0x8b, 0x14, 0xa8, 0xc9, 0xd2, 0x0b, 0x3b, 0x90, 0xf2, 0x37, 0xc7, 0x4c,
-		0x76, 0x61, 0x5a, 0x73, 0xa9, 0xa9, 0x4d, 0x25, 0xb7, 0x3d, 0xed, 0xb0, 0xb7, 0xa1, 0xff, 0x9c,
-		0x8c, 0xdc, 0x4d, 0x90, 0x6f, 0x0c, 0x9f, 0x22, 0xaa, 0x40, 0x54, 0x9c, 0x1a, 0x92, 0x66, 0x3c,
-		0x90, 0xe4, 0x15, 0x27, 0xc7, 0xcd, 0xff, 0xbc, 0x04, 0xc7, 0x4a, 0xa6, 0x61, 0x3d, 0x8b, 0x0f,
-		0xc4, 0x13, 0x30, 0x95, 0xfd, 0x9e, 0xde, 0x3f, 0x18, 0x7f, 0x21, 0x4a, 0x9a, 0x70, 0x21, 0xea,
-		0x3a, 0x24, 0x30, 0x45, 0xbb, 0xdb, 0xb4, 0x1d, 0xc3, 0xa6, 0x66, 0xfb, 0x7e, 0x48, 0x71, 0x5a,
-		0xfe, 0xe3, 0xa5, 0xac, 0x90, 0xb9, 0x3e, 0x67, 0x7f, 0x41, 0x02, 0x70, 0xf3, 0x47, 0xe8, 0x51,
-		0x38, 0x51, 0xac, 0xd7, 0xca, 0x6a, 0xa3, 0x59, 0x68, 0x6e, 0x35, 0xfc, 0x3f, 0x7c, 0x23, 0x1e,
-		0x83, 0xb2, 0x7a, 0xb8, 0xa5, 0xef, 0xe8, 0xb8, 0x8d, 0x1e, 0x84, 0x45, 0x3f, 0x34, 0xf9, 0xaa,
-		0x94, 0x65, 0x29, 0x97, 0x7c, 0xe5, 0xce, 0x72, 0x8c, 0xf9, 0xcb, 0xb8, 0x8d, 0xce, 0xc0, 0xb1,
-		0x51, 0xb8, 0x6a, 0xed, 0xaa, 0x1c, 0xc8, 0xa5, 0x5e, 0xb9, 0xb3, 0x1c, 0x77, 0x1c, 0x6b, 0x94,
-		0x07, 0xe4, 0x85, 0xe4, 0xf4, 0x82, 0x39, 0x78, 0xe5, 0xce, 0x72, 0x84, 0x69, 0x4f, 0x2e, 0xf4,
-		0xee, 0x1f, 0x3d, 0x3d, 0x77, 0xf6, 0x9b, 0x00, 0xaa, 0xc6, 0x4e, 0x5f, 0x6b, 0xd1, 0x59, 0x92,
-		0x83, 0xe3, 0xd5, 0xda, 0x15, 0xa5, 0x50, 0x6a, 0x56, 0xeb, 0xb5, 0xa1, 0xdf, 0xeb, 0xf1, 0xd7,
-		0x95, 0xeb, 0x5b, 0xc5, 0xf5, 0x8a, 0xda, 0xa8, 0x5e, 0xad, 0xb1, 0x7d, 0x68, 0x5f, 0xdd, 0x73,
-		0xb5, 0x66, 0x75, 0xa3, 0x22, 0x07, 0x8a, 0x57, 0x26, 0xee, 0x73, 0x3c, 0x7a, 0xe8, 0x4f, 0x0e,
-		0xb8, 0x96, 0xda, 0xb7, 0xd9, 0xf1, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x63, 0xc2, 0x8a, 0xa4,
-		0x2f, 0xb8, 0x00, 0x00,
+		// 12889 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x94, 0x64, 0xc7,
+		0x55, 0x18, 0xbe, 0xaf, 0xbf, 0xfb, 0xf6, 0xd7, 0x9b, 0x9a, 0xd9, 0xdd, 0xde, 0x5e, 0x69, 0x77,
+		0xf4, 0x64, 0x49, 0xab, 0x95, 0x34, 0x2b, 0xad, 0xb4, 0x2b, 0xed, 0xc8, 0x92, 0x7e, 0xdd, 0x3d,
+		0xbd, 0xbb, 0xbd, 0x9a, 0xe9, 0x19, 0xbd, 0x9e, 0x59, 0x69, 0x05, 0xf8, 0xf9, 0x4d, 0xf7, 0x9b,
+		0x99, 0xd6, 0x76, 0xbf, 0xd7, 0xea, 0xf7, 0x7a, 0x77, 0x47, 0x7f, 0x70, 0x04, 0x36, 0xfc, 0x8c,
+		0x8c, 0x89, 0x09, 0x3e, 0x20, 0x30, 0x0b, 0x36, 0x04, 0x6c, 0x1c, 0x48, 0x00, 0x9b, 0xaf, 0xe4,
+		0x84, 0x8f, 0x7c, 0x02, 0x21, 0x39, 0x86, 0x73, 0x92, 0x10, 0xce, 0x41, 0x09, 0x36, 0xc7, 0x12,
+		0xc6, 0x04, 0x30, 0x22, 0x21, 0xc7, 0x27, 0xc7, 0x39, 0xb7, 0x3e, 0xde, 0x47, 0x7f, 0x4c, 0xf7,
+		0xac, 0x24, 0x47, 0x81, 0xfc, 0x33, 0xd3, 0x55, 0x75, 0xef, 0xad, 0xaa, 0x5b, 0xb7, 0x6e, 0xdd,
+		0xba, 0x75, 0xab, 0x1e, 0x7c, 0xf5, 0xfd, 0x30, 0xbf, 0x6d, 0x59, 0xdb, 0x6d, 0xe3, 0x54, 0xb7,
+		0x67, 0x39, 0xd6, 0x66, 0x7f, 0xeb, 0x54, 0xd3, 0xb0, 0x1b, 0xbd, 0x56, 0xd7, 0xb1, 0x7a, 0x0b,
+		0x34, 0x8f, 0xe4, 0x18, 0xc4, 0x82, 0x80, 0x50, 0x56, 0x60, 0xe6, 0x7c, 0xab, 0x6d, 0x2c, 0xb9,
+		0x80, 0x75, 0xc3, 0x21, 0x8f, 0x41, 0x64, 0xab, 0xd5, 0x36, 0xf2, 0xd2, 0x7c, 0xf8, 0x44, 0xea,
+		0xf4, 0x7b, 0x16, 0x06, 0x90, 0x16, 0x82, 0x18, 0x6b, 0x98, 0xad, 0x52, 0x0c, 0xe5, 0xd5, 0x28,
+		0xcc, 0x8e, 0x28, 0x25, 0x04, 0x22, 0xa6, 0xde, 0x41, 0x8a, 0xd2, 0x89, 0xa4, 0x4a, 0x7f, 0x93,
+		0x3c, 0xc4, 0xbb, 0x7a, 0xe3, 0xaa, 0xbe, 0x6d, 0xe4, 0x43, 0x34, 0x5b, 0x24, 0xc9, 0x31, 0x80,
+		0xa6, 0xd1, 0x35, 0xcc, 0xa6, 0x61, 0x36, 0x76, 0xf3, 0xe1, 0xf9, 0xf0, 0x89, 0xa4, 0xea, 0xcb,
+		0x21, 0xf7, 0xc1, 0x4c, 0xb7, 0xbf, 0xd9, 0x6e, 0x35, 0x34, 0x1f, 0x18, 0xcc, 0x87, 0x4f, 0x44,
+		0x55, 0x99, 0x15, 0x2c, 0x79, 0xc0, 0xf7, 0x40, 0xee, 0xba, 0xa1, 0x5f, 0xf5, 0x83, 0xa6, 0x28,
+		0x68, 0x16, 0xb3, 0x7d, 0x80, 0x65, 0x48, 0x77, 0x0c, 0xdb, 0xd6, 0xb7, 0x0d, 0xcd, 0xd9, 0xed,
+		0x1a, 0xf9, 0x08, 0xed, 0xfd, 0xfc, 0x50, 0xef, 0x07, 0x7b, 0x9e, 0xe2, 0x58, 0xeb, 0xbb, 0x5d,
+		0x83, 0x14, 0x21, 0x69, 0x98, 0xfd, 0x0e, 0xa3, 0x10, 0x1d, 0xc3, 0xbf, 0x8a, 0xd9, 0xef, 0x0c,
+		0x52, 0x49, 0x20, 0x1a, 0x27, 0x11, 0xb7, 0x8d, 0xde, 0xb5, 0x56, 0xc3, 0xc8, 0xc7, 0x28, 0x81,
+		0x7b, 0x86, 0x08, 0xd4, 0x59, 0xf9, 0x20, 0x0d, 0x81, 0x47, 0xca, 0x90, 0x34, 0x6e, 0x38, 0x86,
+		0x69, 0xb7, 0x2c, 0x33, 0x1f, 0xa7, 0x44, 0xee, 0x1a, 0x31, 0x8a, 0x46, 0xbb, 0x39, 0x48, 0xc2,
+		0xc3, 0x23, 0x67, 0x21, 0x6e, 0x75, 0x9d, 0x96, 0x65, 0xda, 0xf9, 0xc4, 0xbc, 0x74, 0x22, 0x75,
+		0xfa, 0xb6, 0x91, 0x82, 0xb0, 0xca, 0x60, 0x54, 0x01, 0x4c, 0xaa, 0x20, 0xdb, 0x56, 0xbf, 0xd7,
+		0x30, 0xb4, 0x86, 0xd5, 0x34, 0xb4, 0x96, 0xb9, 0x65, 0xe5, 0x93, 0x94, 0xc0, 0xf1, 0xe1, 0x8e,
+		0x50, 0xc0, 0xb2, 0xd5, 0x34, 0xaa, 0xe6, 0x96, 0xa5, 0x66, 0xed, 0x40, 0x9a, 0x1c, 0x82, 0x98,
+		0xbd, 0x6b, 0x3a, 0xfa, 0x8d, 0x7c, 0x9a, 0x4a, 0x08, 0x4f, 0x91, 0xd3, 0x10, 0x37, 0x9a, 0x2d,
+		0xac, 0x2e, 0x9f, 0x9d, 0x97, 0x4e, 0x64, 0x4f, 0xe7, 0x87, 0x79, 0xcc, 0xca, 0x55, 0x01, 0xa8,
+		0xfc, 0x4a, 0x0c, 0x72, 0xd3, 0x88, 0xe5, 0xe3, 0x10, 0xdd, 0x42, 0xce, 0xe4, 0x43, 0xfb, 0xe1,
+		0x1b, 0xc3, 0x09, 0x32, 0x3e, 0x76, 0x8b, 0x8c, 0x2f, 0x42, 0xca, 0x34, 0x6c, 0xc7, 0x68, 0x32,
+		0x29, 0x0a, 0x4f, 0x29, 0x87, 0xc0, 0x90, 0x86, 0xc5, 0x30, 0x72, 0x4b, 0x62, 0xf8, 0x1c, 0xe4,
+		0xdc, 0x26, 0x69, 0x3d, 0xdd, 0xdc, 0x16, 0xf2, 0x7c, 0x6a, 0x52, 0x4b, 0x16, 0x2a, 0x02, 0x4f,
+		0x45, 0x34, 0x35, 0x6b, 0x04, 0xd2, 0x64, 0x09, 0xc0, 0x32, 0x0d, 0x6b, 0x4b, 0x6b, 0x1a, 0x8d,
+		0x76, 0x3e, 0x31, 0x86, 0x4b, 0xab, 0x08, 0x32, 0xc4, 0x25, 0x8b, 0xe5, 0x36, 0xda, 0xe4, 0x9c,
+		0x27, 0x9e, 0xf1, 0x31, 0xd2, 0xb5, 0xc2, 0x26, 0xe6, 0x90, 0x84, 0x6e, 0x40, 0xb6, 0x67, 0xe0,
+		0x5c, 0x31, 0x9a, 0xbc, 0x67, 0x49, 0xda, 0x88, 0x85, 0x89, 0x3d, 0x53, 0x39, 0x1a, 0xeb, 0x58,
+		0xa6, 0xe7, 0x4f, 0x92, 0x3b, 0xc1, 0xcd, 0xd0, 0xa8, 0x58, 0x01, 0xd5, 0x5c, 0x69, 0x91, 0x59,
+		0xd3, 0x3b, 0x46, 0xe1, 0x25, 0xc8, 0x06, 0xd9, 0x43, 0xe6, 0x20, 0x6a, 0x3b, 0x7a, 0xcf, 0xa1,
+		0x52, 0x18, 0x55, 0x59, 0x82, 0xc8, 0x10, 0x36, 0xcc, 0x26, 0xd5, 0x8c, 0x51, 0x15, 0x7f, 0x92,
+		0xff, 0xcf, 0xeb, 0x70, 0x98, 0x76, 0xf8, 0xee, 0xe1, 0x11, 0x0d, 0x50, 0x1e, 0xec, 0x77, 0xe1,
+		0x51, 0xc8, 0x04, 0x3a, 0x30, 0x6d, 0xd5, 0xca, 0xef, 0x44, 0xe0, 0xe0, 0x48, 0xda, 0xe4, 0x39,
+		0x98, 0xeb, 0x9b, 0x2d, 0xd3, 0x31, 0x7a, 0xdd, 0x9e, 0x81, 0x22, 0xcb, 0xea, 0xca, 0xbf, 0x1e,
+		0x1f, 0x23, 0x74, 0x1b, 0x7e, 0x68, 0x46, 0x45, 0x9d, 0xed, 0x0f, 0x67, 0x92, 0x2b, 0x90, 0x42,
+		0xf9, 0xd0, 0x7b, 0x3a, 0x25, 0xc8, 0x66, 0xe3, 0xe9, 0xe9, 0xba, 0xbc, 0xb0, 0xe4, 0x61, 0x96,
+		0xc2, 0x1f, 0x92, 0x42, 0xaa, 0x9f, 0x16, 0x79, 0x14, 0x12, 0x5b, 0x86, 0xee, 0xf4, 0x7b, 0x86,
+		0x9d, 0x3f, 0x4d, 0x59, 0x79, 0x74, 0x78, 0x92, 0x32, 0x80, 0xba, 0xe1, 0xa8, 0x2e, 0x30, 0xe9,
+		0x40, 0xfa, 0x9a, 0xd1, 0x6b, 0x6d, 0xb5, 0x1a, 0xac, 0x51, 0x61, 0xaa, 0x7c, 0x1e, 0x9b, 0xb2,
+		0x51, 0x97, 0x7d, 0xa8, 0x75, 0x47, 0x77, 0x8c, 0x45, 0xd8, 0xa8, 0x5d, 0xae, 0xa8, 0xd5, 0xf3,
+		0xd5, 0xca, 0x12, 0x6b, 0x66, 0x80, 0x7c, 0xe1, 0x63, 0x12, 0xa4, 0x7c, 0x3d, 0x41, 0x75, 0x68,
+		0xf6, 0x3b, 0x9b, 0x46, 0x8f, 0x8f, 0x17, 0x4f, 0x91, 0xa3, 0x90, 0xdc, 0xea, 0xb7, 0xdb, 0x4c,
+		0xe8, 0xd8, 0x5a, 0x9a, 0xc0, 0x0c, 0x14, 0x38, 0xd4, 0x71, 0x5c, 0x8d, 0x50, 0x1d, 0x87, 0xbf,
+		0x49, 0x01, 0x12, 0x42, 0x28, 0xf3, 0xd1, 0x79, 0xe9, 0x44, 0x42, 0x75, 0xd3, 0xac, 0xac, 0x6b,
+		0xe8, 0x8e, 0xd1, 0xcc, 0xc7, 0x44, 0x19, 0x4b, 0x5f, 0x8a, 0x24, 0x22, 0x72, 0x54, 0x79, 0x04,
+		0x66, 0x86, 0xba, 0x42, 0x72, 0x90, 0x5a, 0xaa, 0x94, 0x97, 0x8b, 0x6a, 0x71, 0xbd, 0xba, 0x5a,
+		0x93, 0x0f, 0x90, 0x2c, 0xf8, 0x7a, 0x27, 0x4b, 0x27, 0x93, 0x89, 0x37, 0xe2, 0xf2, 0xcb, 0x2f,
+		0xbf, 0xfc, 0x72, 0x48, 0xf9, 0x8d, 0x18, 0xcc, 0x8d, 0x52, 0x82, 0x23, 0xf5, 0xb1, 0xd7, 0xe9,
+		0x70, 0xa0, 0xd3, 0x45, 0x88, 0xb6, 0xf5, 0x4d, 0xa3, 0x9d, 0x8f, 0xd0, 0x41, 0xb8, 0x6f, 0x2a,
+		0x35, 0xbb, 0xb0, 0x8c, 0x28, 0x2a, 0xc3, 0x24, 0x4f, 0x72, 0xd6, 0x44, 0x29, 0x85, 0x93, 0xd3,
+		0x51, 0x40, 0xe5, 0xc8, 0xd9, 0x78, 0x14, 0x92, 0xf8, 0x9f, 0xf1, 0x3d, 0xc6, 0xf8, 0x8e, 0x19,
+		0x94, 0xef, 0x05, 0x48, 0x50, 0xbd, 0xd7, 0x34, 0xdc, 0x31, 0x11, 0x69, 0xd4, 0x14, 0x4d, 0x63,
+		0x4b, 0xef, 0xb7, 0x1d, 0xed, 0x9a, 0xde, 0xee, 0x1b, 0x54, 0x83, 0x25, 0xd5, 0x34, 0xcf, 0xbc,
+		0x8c, 0x79, 0xe4, 0x38, 0xa4, 0x98, 0x9a, 0x6c, 0x99, 0x4d, 0xe3, 0x06, 0x5d, 0x42, 0xa3, 0x2a,
+		0xd3, 0x9c, 0x55, 0xcc, 0xc1, 0xea, 0x5f, 0xb0, 0x2d, 0x53, 0xe8, 0x1a, 0x5a, 0x05, 0x66, 0xd0,
+		0xea, 0x1f, 0x1d, 0x5c, 0xbd, 0x6f, 0x1f, 0xdd, 0xbd, 0x21, 0xe5, 0x78, 0x0f, 0xe4, 0x28, 0xc4,
+		0xc3, 0x7c, 0x2a, 0xeb, 0xed, 0xfc, 0x0c, 0x15, 0x83, 0x2c, 0xcb, 0x5e, 0xe5, 0xb9, 0xca, 0x2f,
+		0x86, 0x20, 0x42, 0x57, 0x8a, 0x1c, 0xa4, 0xd6, 0xaf, 0xac, 0x55, 0xb4, 0xa5, 0xd5, 0x8d, 0xd2,
+		0x72, 0x45, 0x96, 0x70, 0xe8, 0x69, 0xc6, 0xf9, 0xe5, 0xd5, 0xe2, 0xba, 0x1c, 0x72, 0xd3, 0xd5,
+		0xda, 0xfa, 0xd9, 0x47, 0xe4, 0xb0, 0x8b, 0xb0, 0xc1, 0x32, 0x22, 0x7e, 0x80, 0x87, 0x4f, 0xcb,
+		0x51, 0x22, 0x43, 0x9a, 0x11, 0xa8, 0x3e, 0x57, 0x59, 0x3a, 0xfb, 0x88, 0x1c, 0x0b, 0xe6, 0x3c,
+		0x7c, 0x5a, 0x8e, 0x93, 0x0c, 0x24, 0x69, 0x4e, 0x69, 0x75, 0x75, 0x59, 0x4e, 0xb8, 0x34, 0xeb,
+		0xeb, 0x6a, 0xb5, 0x76, 0x41, 0x4e, 0xba, 0x34, 0x2f, 0xa8, 0xab, 0x1b, 0x6b, 0x32, 0xb8, 0x14,
+		0x56, 0x2a, 0xf5, 0x7a, 0xf1, 0x42, 0x45, 0x4e, 0xb9, 0x10, 0xa5, 0x2b, 0xeb, 0x95, 0xba, 0x9c,
+		0x0e, 0x34, 0xeb, 0xe1, 0xd3, 0x72, 0xc6, 0xad, 0xa2, 0x52, 0xdb, 0x58, 0x91, 0xb3, 0x64, 0x06,
+		0x32, 0xac, 0x0a, 0xd1, 0x88, 0xdc, 0x40, 0xd6, 0xd9, 0x47, 0x64, 0xd9, 0x6b, 0x08, 0xa3, 0x32,
+		0x13, 0xc8, 0x38, 0xfb, 0x88, 0x4c, 0x94, 0x32, 0x44, 0xa9, 0x18, 0x12, 0x02, 0xd9, 0xe5, 0x62,
+		0xa9, 0xb2, 0xac, 0xad, 0xae, 0xe1, 0xa4, 0x29, 0x2e, 0xcb, 0x92, 0x97, 0xa7, 0x56, 0xd6, 0x2a,
+		0xc5, 0xf5, 0xca, 0x92, 0x1c, 0xf6, 0xe7, 0x3d, 0xb3, 0x51, 0x55, 0x2b, 0x4b, 0x72, 0x48, 0x69,
+		0xc0, 0xdc, 0xa8, 0x15, 0x72, 0xe4, 0x14, 0xf2, 0xc9, 0x42, 0x68, 0x8c, 0x2c, 0x50, 0x5a, 0x83,
+		0xb2, 0xa0, 0x7c, 0x31, 0x04, 0xb3, 0x23, 0xac, 0x84, 0x91, 0x95, 0x3c, 0x05, 0x51, 0x26, 0xcb,
+		0x4c, 0x53, 0xdf, 0x3b, 0xd2, 0xdc, 0xa0, 0x92, 0x3d, 0x64, 0x3b, 0x51, 0x3c, 0xbf, 0xbd, 0x19,
+		0x1e, 0x63, 0x6f, 0x22, 0x89, 0x21, 0x81, 0xfd, 0x96, 0xa1, 0xd5, 0x9c, 0x19, 0x3c, 0x67, 0xa7,
+		0x31, 0x78, 0x68, 0xde, 0xfe, 0x56, 0xf5, 0xe8, 0x88, 0x55, 0xfd, 0x71, 0x98, 0x19, 0x22, 0x34,
+		0xf5, 0xea, 0xfa, 0x01, 0x09, 0xf2, 0xe3, 0x98, 0x33, 0x41, 0x25, 0x86, 0x02, 0x2a, 0xf1, 0xf1,
+		0x41, 0x0e, 0xde, 0x31, 0x7e, 0x10, 0x86, 0xc6, 0xfa, 0x53, 0x12, 0x1c, 0x1a, 0xbd, 0xaf, 0x18,
+		0xd9, 0x86, 0x27, 0x21, 0xd6, 0x31, 0x9c, 0x1d, 0x4b, 0xd8, 0xc9, 0x77, 0x8f, 0xb0, 0xbe, 0xb0,
+		0x78, 0x70, 0xb0, 0x39, 0x16, 0x39, 0x37, 0xd8, 0xd6, 0xe3, 0xe3, 0x76, 0x39, 0x43, 0x2d, 0xfd,
+		0xae, 0x10, 0x1c, 0x1c, 0x49, 0x7c, 0x64, 0x43, 0x6f, 0x07, 0x68, 0x99, 0xdd, 0xbe, 0xc3, 0x6c,
+		0x61, 0xa6, 0x89, 0x93, 0x34, 0x87, 0x2a, 0x2f, 0xd4, 0xb2, 0x7d, 0xc7, 0x2d, 0x67, 0xab, 0x24,
+		0xb0, 0x2c, 0x0a, 0xf0, 0x98, 0xd7, 0xd0, 0x08, 0x6d, 0xe8, 0xb1, 0x31, 0x3d, 0x1d, 0x12, 0xcc,
+		0x07, 0x41, 0x6e, 0xb4, 0x5b, 0x86, 0xe9, 0x68, 0xb6, 0xd3, 0x33, 0xf4, 0x4e, 0xcb, 0xdc, 0x66,
+		0xab, 0xed, 0x62, 0x74, 0x4b, 0x6f, 0xdb, 0x86, 0x9a, 0x63, 0xc5, 0x75, 0x51, 0x8a, 0x18, 0x54,
+		0x80, 0x7a, 0x3e, 0x8c, 0x58, 0x00, 0x83, 0x15, 0xbb, 0x18, 0xca, 0xcf, 0x24, 0x21, 0xe5, 0xdb,
+		0x85, 0x91, 0x3b, 0x20, 0xfd, 0x82, 0x7e, 0x4d, 0xd7, 0xc4, 0xce, 0x9a, 0x71, 0x22, 0x85, 0x79,
+		0x6b, 0x2c, 0x8b, 0x3c, 0x08, 0x73, 0x14, 0xc4, 0xea, 0x3b, 0x46, 0x4f, 0x6b, 0xb4, 0x75, 0xdb,
+		0xa6, 0x4c, 0x4b, 0x50, 0x50, 0x82, 0x65, 0xab, 0x58, 0x54, 0x16, 0x25, 0xe4, 0x0c, 0xcc, 0x52,
+		0x8c, 0x4e, 0xbf, 0xed, 0xb4, 0xba, 0x6d, 0x43, 0xc3, 0xbd, 0xbe, 0x9d, 0x07, 0x7f, 0xcb, 0x66,
+		0x10, 0x62, 0x85, 0x03, 0x60, 0x8b, 0x6c, 0xb2, 0x04, 0xb7, 0x53, 0xb4, 0x6d, 0xc3, 0x34, 0x7a,
+		0xba, 0x63, 0x68, 0xc6, 0x8b, 0x7d, 0xbd, 0x6d, 0x6b, 0xba, 0xd9, 0xd4, 0x76, 0x74, 0x7b, 0x27,
+		0x3f, 0x87, 0x04, 0x4a, 0xa1, 0xbc, 0xa4, 0x1e, 0x41, 0xc0, 0x0b, 0x1c, 0xae, 0x42, 0xc1, 0x8a,
+		0x66, 0xf3, 0xa2, 0x6e, 0xef, 0x90, 0x45, 0x38, 0x44, 0xa9, 0xd8, 0x4e, 0xaf, 0x65, 0x6e, 0x6b,
+		0x8d, 0x1d, 0xa3, 0x71, 0x55, 0xeb, 0x3b, 0x5b, 0x8f, 0xe5, 0x8f, 0xfa, 0xeb, 0xa7, 0x2d, 0xac,
+		0x53, 0x98, 0x32, 0x82, 0x6c, 0x38, 0x5b, 0x8f, 0x91, 0x3a, 0xa4, 0x71, 0x30, 0x3a, 0xad, 0x97,
+		0x0c, 0x6d, 0xcb, 0xea, 0xd1, 0x35, 0x34, 0x3b, 0x42, 0x35, 0xf9, 0x38, 0xb8, 0xb0, 0xca, 0x11,
+		0x56, 0xac, 0xa6, 0xb1, 0x18, 0xad, 0xaf, 0x55, 0x2a, 0x4b, 0x6a, 0x4a, 0x50, 0x39, 0x6f, 0xf5,
+		0x50, 0xa0, 0xb6, 0x2d, 0x97, 0xc1, 0x29, 0x26, 0x50, 0xdb, 0x96, 0x60, 0xef, 0x19, 0x98, 0x6d,
+		0x34, 0x58, 0x9f, 0x5b, 0x0d, 0x8d, 0xef, 0xc8, 0xed, 0xbc, 0x1c, 0x60, 0x56, 0xa3, 0x71, 0x81,
+		0x01, 0x70, 0x19, 0xb7, 0xc9, 0x39, 0x38, 0xe8, 0x31, 0xcb, 0x8f, 0x38, 0x33, 0xd4, 0xcb, 0x41,
+		0xd4, 0x33, 0x30, 0xdb, 0xdd, 0x1d, 0x46, 0x24, 0x81, 0x1a, 0xbb, 0xbb, 0x83, 0x68, 0x77, 0x51,
+		0x2f, 0x4b, 0xcf, 0x68, 0x50, 0x53, 0xef, 0xb0, 0x1f, 0xda, 0x57, 0x40, 0x16, 0x40, 0x6e, 0x34,
+		0x34, 0xc3, 0xd4, 0x37, 0xdb, 0x86, 0xa6, 0xf7, 0x0c, 0x53, 0xb7, 0xf3, 0xc7, 0x29, 0x70, 0xc4,
+		0xe9, 0xf5, 0x0d, 0x35, 0xdb, 0x68, 0x54, 0x68, 0x61, 0x91, 0x96, 0x91, 0x93, 0x30, 0x63, 0x6d,
+		0xbe, 0xd0, 0x60, 0x82, 0xa5, 0x75, 0x7b, 0xc6, 0x56, 0xeb, 0x46, 0xfe, 0x3d, 0x94, 0x4b, 0x39,
+		0x2c, 0xa0, 0x62, 0xb5, 0x46, 0xb3, 0xc9, 0xbd, 0x20, 0x37, 0xec, 0x1d, 0xbd, 0xd7, 0xa5, 0x9a,
+		0xd5, 0xee, 0xea, 0x0d, 0x23, 0x7f, 0x17, 0x03, 0x65, 0xf9, 0x35, 0x91, 0x8d, 0x82, 0x6d, 0x5f,
+		0x6f, 0x6d, 0x39, 0x82, 0xe2, 0x3d, 0x4c, 0xb0, 0x69, 0x1e, 0xa7, 0x76, 0x02, 0xe4, 0xee, 0x4e,
+		0x37, 0x58, 0xf1, 0x09, 0x0a, 0x96, 0xed, 0xee, 0x74, 0xfd, 0xf5, 0xde, 0x09, 0x99, 0xee, 0x8e,
+		0xbf, 0xd2, 0x7b, 0x99, 0xfd, 0xd5, 0xdd, 0xf1, 0xd5, 0xf8, 0x08, 0x1c, 0x42, 0xa0, 0x8e, 0xe1,
+		0xe8, 0x4d, 0xdd, 0xd1, 0x7d, 0xd0, 0xf7, 0x53, 0xe8, 0xb9, 0xee, 0x4e, 0x77, 0x85, 0x17, 0x06,
+		0xda, 0xd9, 0xeb, 0x6f, 0xee, 0xba, 0xf2, 0xf1, 0x00, 0x6b, 0x27, 0xe6, 0x09, 0x09, 0xb9, 0xe5,
+		0xed, 0xc7, 0x3b, 0xb6, 0xd9, 0x52, 0x16, 0x21, 0xed, 0x97, 0x7b, 0x92, 0x04, 0x26, 0xf9, 0xb2,
+		0x84, 0x46, 0x50, 0x79, 0x75, 0x09, 0xcd, 0x97, 0xe7, 0x2b, 0x72, 0x08, 0xcd, 0xa8, 0xe5, 0xea,
+		0x7a, 0x45, 0x53, 0x37, 0x6a, 0xeb, 0xd5, 0x95, 0x8a, 0x1c, 0xf6, 0x19, 0xf6, 0x97, 0x22, 0x89,
+		0x93, 0xf2, 0x7d, 0x97, 0x22, 0x89, 0xbb, 0xe5, 0x7b, 0x28, 0x7b, 0x86, 0x84, 0x52, 0x79, 0x33,
+		0x0c, 0xd9, 0xe0, 0xb6, 0x9c, 0xbc, 0x17, 0x0e, 0x0b, 0xbf, 0x9b, 0x6d, 0x38, 0xda, 0xf5, 0x56,
+		0x8f, 0x4e, 0xd6, 0x8e, 0xce, 0x16, 0x4e, 0x57, 0x28, 0xe7, 0x38, 0x54, 0xdd, 0x70, 0x9e, 0x6d,
+		0xf5, 0x70, 0x2a, 0x76, 0x74, 0x87, 0x2c, 0xc3, 0x71, 0xd3, 0xd2, 0x6c, 0x47, 0x37, 0x9b, 0x7a,
+		0xaf, 0xa9, 0x79, 0x1e, 0x4f, 0x4d, 0x6f, 0x34, 0x0c, 0xdb, 0xb6, 0xd8, 0x22, 0xe9, 0x52, 0xb9,
+		0xcd, 0xb4, 0xea, 0x1c, 0xd8, 0x5b, 0x3d, 0x8a, 0x1c, 0x74, 0x60, 0x4e, 0x84, 0xc7, 0xcd, 0x89,
+		0xa3, 0x90, 0xec, 0xe8, 0x5d, 0xcd, 0x30, 0x9d, 0xde, 0x2e, 0xb5, 0xdd, 0x13, 0x6a, 0xa2, 0xa3,
+		0x77, 0x2b, 0x98, 0x26, 0x97, 0xe1, 0x6e, 0x0f, 0x54, 0x6b, 0x1b, 0xdb, 0x7a, 0x63, 0x57, 0xa3,
+		0x86, 0x3a, 0xf5, 0x11, 0x69, 0x0d, 0xcb, 0xdc, 0x6a, 0xb7, 0x1a, 0x8e, 0x9d, 0x4f, 0xb9, 0xfa,
+		0x4f, 0xf1, 0x30, 0x96, 0x29, 0xc2, 0x25, 0xdb, 0x32, 0xa9, 0x7d, 0x5e, 0x16, 0xd0, 0x01, 0xb1,
+		0x49, 0xbf, 0x2b, 0xc4, 0x26, 0x38, 0xf4, 0x11, 0x39, 0x7a, 0x29, 0x92, 0x88, 0xca, 0xb1, 0x4b,
+		0x91, 0x44, 0x4c, 0x8e, 0x5f, 0x8a, 0x24, 0x12, 0x72, 0xf2, 0x52, 0x24, 0x91, 0x94, 0x41, 0xb9,
+		0x99, 0x81, 0xb4, 0x7f, 0xbb, 0x81, 0xbb, 0xb7, 0x06, 0x5d, 0x70, 0x25, 0xaa, 0x92, 0xef, 0xdc,
+		0x73, 0x73, 0xb2, 0x50, 0xc6, 0x95, 0x78, 0x31, 0xc6, 0x6c, 0x7b, 0x95, 0x61, 0xa2, 0x15, 0x84,
+		0x93, 0xcc, 0x60, 0xb6, 0x54, 0x42, 0xe5, 0x29, 0x72, 0x01, 0x62, 0x2f, 0xd8, 0x94, 0x76, 0x8c,
+		0xd2, 0x7e, 0xcf, 0xde, 0xb4, 0x2f, 0xd5, 0x29, 0xf1, 0xe4, 0xa5, 0xba, 0x56, 0x5b, 0x55, 0x57,
+		0x8a, 0xcb, 0x2a, 0x47, 0x27, 0x47, 0x20, 0xd2, 0xd6, 0x5f, 0xda, 0x0d, 0xae, 0xd9, 0x34, 0x8b,
+		0x2c, 0x40, 0xae, 0x6f, 0xb2, 0xbd, 0x3a, 0x8e, 0x31, 0x42, 0xe5, 0xfc, 0x50, 0x59, 0xaf, 0x74,
+		0x19, 0xe1, 0xa7, 0x94, 0xab, 0x23, 0x10, 0x41, 0xa7, 0x74, 0x70, 0x65, 0xa5, 0x59, 0xe4, 0x04,
+		0xa4, 0x9b, 0xc6, 0x66, 0x7f, 0x5b, 0xeb, 0x19, 0x4d, 0xbd, 0xe1, 0x04, 0xd7, 0x93, 0x14, 0x2d,
+		0x52, 0x69, 0x09, 0x79, 0x1a, 0x92, 0x38, 0x46, 0x26, 0x1d, 0xe3, 0x19, 0xca, 0x82, 0x07, 0xf6,
+		0x66, 0x01, 0x1f, 0x62, 0x81, 0xa4, 0x7a, 0xf8, 0xe4, 0x22, 0xc4, 0x1d, 0xbd, 0xb7, 0x6d, 0x38,
+		0x76, 0x7e, 0x76, 0x3e, 0x7c, 0x22, 0x7b, 0x7a, 0x61, 0x1a, 0x52, 0xeb, 0x14, 0x85, 0xee, 0x94,
+		0x05, 0x3a, 0x79, 0x16, 0x64, 0xee, 0x8a, 0xd5, 0xf8, 0x36, 0xd7, 0xce, 0xcf, 0x51, 0x01, 0xbc,
+		0x7f, 0x6f, 0x92, 0xdc, 0x93, 0xbb, 0xc4, 0x90, 0xd4, 0x9c, 0x11, 0x48, 0x07, 0xe7, 0xc5, 0xc1,
+		0xfd, 0xcc, 0x8b, 0x0d, 0xc8, 0xf1, 0xdf, 0x9a, 0xdd, 0xef, 0x76, 0xad, 0x9e, 0x93, 0x3f, 0x34,
+		0x2f, 0x4d, 0x6e, 0x90, 0x20, 0xc6, 0x70, 0xd4, 0xec, 0x56, 0x20, 0xfd, 0xce, 0x4d, 0xb7, 0xc2,
+		0xf3, 0x90, 0x0d, 0x32, 0xc3, 0xef, 0x08, 0x0f, 0x4f, 0xe9, 0x08, 0xc7, 0x6d, 0x89, 0xd8, 0xa8,
+		0xe1, 0xd2, 0xc4, 0x12, 0x85, 0xef, 0x0f, 0x41, 0x36, 0xd8, 0x31, 0x72, 0x01, 0x88, 0x18, 0xb1,
+		0x96, 0xe9, 0xf4, 0xac, 0x66, 0xbf, 0x61, 0x34, 0xf3, 0xd2, 0x84, 0x7a, 0x66, 0x38, 0x4e, 0xd5,
+		0x45, 0xf1, 0x13, 0xf2, 0xcd, 0x82, 0xd0, 0x94, 0x84, 0x96, 0xbc, 0xf9, 0x71, 0x0a, 0x66, 0x05,
+		0x01, 0x24, 0x76, 0x5d, 0xef, 0x99, 0x68, 0x22, 0x33, 0xa3, 0x9d, 0xf8, 0x8a, 0x9e, 0x65, 0x25,
+		0xa4, 0x08, 0x42, 0x5c, 0xb4, 0x9e, 0xd1, 0xb1, 0xd0, 0xdf, 0x15, 0x99, 0x50, 0x6d, 0x96, 0x23,
+		0xa8, 0x0c, 0x5e, 0x39, 0x05, 0x51, 0xaa, 0x7e, 0x08, 0x00, 0x57, 0x40, 0xf2, 0x01, 0x92, 0x80,
+		0x48, 0x79, 0x55, 0xc5, 0xe5, 0x51, 0x86, 0x34, 0xcb, 0xd5, 0xd6, 0xaa, 0x95, 0x72, 0x45, 0x0e,
+		0x29, 0x67, 0x20, 0xc6, 0x74, 0x0a, 0x2e, 0x9d, 0xae, 0x56, 0x91, 0x0f, 0xf0, 0x24, 0xa7, 0x21,
+		0x89, 0xd2, 0x8d, 0x95, 0x52, 0x45, 0x95, 0x43, 0xca, 0x06, 0xe4, 0x06, 0xe6, 0x21, 0x39, 0x08,
+		0x33, 0x6a, 0x65, 0xbd, 0x52, 0x43, 0xe7, 0x80, 0xb6, 0x51, 0x7b, 0xba, 0xb6, 0xfa, 0x2c, 0x7a,
+		0xd6, 0x02, 0xd9, 0x62, 0x1d, 0x96, 0xc8, 0x1c, 0xc8, 0x5e, 0x76, 0x7d, 0x75, 0x43, 0xa5, 0xad,
+		0xf9, 0xee, 0x10, 0xc8, 0x83, 0x93, 0x92, 0x1c, 0x86, 0xd9, 0xf5, 0xa2, 0x7a, 0xa1, 0xb2, 0xae,
+		0x31, 0x87, 0x87, 0x4b, 0x7a, 0x0e, 0x64, 0x7f, 0xc1, 0xf9, 0x2a, 0xf5, 0xe7, 0x1c, 0x87, 0xa3,
+		0xfe, 0xdc, 0xca, 0x73, 0xeb, 0x95, 0x5a, 0x9d, 0x56, 0x5e, 0xac, 0x5d, 0x40, 0xa3, 0x60, 0x80,
+		0x9e, 0x70, 0xb1, 0x84, 0xb1, 0xa9, 0x41, 0x7a, 0x95, 0xe5, 0x25, 0x39, 0x32, 0x98, 0xbd, 0x5a,
+		0xab, 0xac, 0x9e, 0x97, 0xa3, 0x83, 0xb5, 0x53, 0xb7, 0x4b, 0x8c, 0x14, 0xe0, 0xd0, 0x60, 0xae,
+		0x56, 0xa9, 0xad, 0xab, 0x57, 0xe4, 0xf8, 0x60, 0xc5, 0xf5, 0x8a, 0x7a, 0xb9, 0x5a, 0xae, 0xc8,
+		0x09, 0x72, 0x08, 0x48, 0xb0, 0x45, 0xeb, 0x17, 0x57, 0x97, 0xe4, 0xe4, 0xa8, 0x15, 0x8b, 0xc8,
+		0xb3, 0xca, 0x4f, 0x4b, 0x90, 0xf6, 0xbb, 0x40, 0x02, 0x4a, 0x45, 0x7a, 0xb7, 0x2d, 0xb6, 0xca,
+		0xef, 0x86, 0x20, 0xe5, 0xf3, 0x85, 0xe0, 0x26, 0x56, 0x6f, 0xb7, 0xad, 0xeb, 0x9a, 0xde, 0x6e,
+		0xe9, 0x36, 0x5f, 0x0f, 0x81, 0x66, 0x15, 0x31, 0x67, 0xda, 0xf5, 0x67, 0x7a, 0xd3, 0x25, 0x76,
+		0xcb, 0xa6, 0x4b, 0xfc, 0x5d, 0x68, 0xba, 0x44, 0xe5, 0x98, 0xf2, 0xfb, 0x21, 0x90, 0x07, 0xbd,
+		0x23, 0x03, 0x7c, 0x93, 0xc6, 0xf1, 0xcd, 0xdf, 0xbf, 0xd0, 0x7e, 0xfa, 0x37, 0xb8, 0xaa, 0x87,
+		0xc7, 0xae, 0xea, 0x23, 0x16, 0xab, 0xc8, 0xbb, 0x79, 0xb1, 0xf2, 0x8b, 0xeb, 0x7f, 0x90, 0x20,
+		0x1b, 0x74, 0xe6, 0x04, 0x38, 0xa6, 0xec, 0x87, 0x63, 0xc1, 0x11, 0xb9, 0x63, 0xdc, 0x88, 0x7c,
+		0x43, 0xfa, 0xf5, 0x83, 0x61, 0xc8, 0x04, 0x7c, 0x3f, 0xd3, 0xb6, 0xee, 0x45, 0x98, 0x69, 0x35,
+		0x8d, 0x4e, 0xd7, 0x72, 0x30, 0xf2, 0x40, 0x6b, 0x1b, 0xd7, 0x8c, 0x36, 0x65, 0x43, 0x76, 0xc4,
+		0xe9, 0x6a, 0xa0, 0x86, 0x85, 0xaa, 0x87, 0xb7, 0x8c, 0x68, 0x8b, 0xb3, 0xd5, 0xa5, 0xca, 0xca,
+		0xda, 0xea, 0x7a, 0xa5, 0x56, 0xbe, 0x22, 0x34, 0xb9, 0x2a, 0xb7, 0x06, 0xc0, 0x02, 0x0c, 0xbf,
+		0xf3, 0xdd, 0xb1, 0xe9, 0x5c, 0x03, 0x79, 0xb0, 0x37, 0xa8, 0xd0, 0x47, 0xf4, 0x47, 0x3e, 0x40,
+		0x66, 0x21, 0x57, 0x5b, 0xd5, 0xea, 0xd5, 0xa5, 0x8a, 0x56, 0x39, 0x7f, 0xbe, 0x52, 0x5e, 0xaf,
+		0xb3, 0x83, 0x06, 0x17, 0x7a, 0x5d, 0x0e, 0xf9, 0xc7, 0xe6, 0x87, 0xc2, 0x30, 0x3b, 0xa2, 0x25,
+		0xa4, 0xc8, 0x5d, 0x84, 0xcc, 0x6b, 0xf9, 0xc0, 0x34, 0xad, 0x5f, 0xc0, 0xdd, 0xfd, 0x9a, 0xde,
+		0x73, 0xb8, 0x47, 0xf1, 0x5e, 0x40, 0xf6, 0x9a, 0x0e, 0x9a, 0xf7, 0x3d, 0x7e, 0x80, 0xc3, 0x4c,
+		0x90, 0x9c, 0x97, 0xcf, 0xce, 0x70, 0xee, 0x07, 0xd2, 0xb5, 0xec, 0x96, 0xd3, 0xba, 0x86, 0x81,
+		0x10, 0xe2, 0xb4, 0x07, 0x27, 0x6e, 0x44, 0x95, 0x45, 0x49, 0xd5, 0x74, 0x5c, 0x68, 0xd3, 0xd8,
+		0xd6, 0x07, 0xa0, 0x71, 0xfb, 0x11, 0x56, 0x65, 0x51, 0xe2, 0x42, 0xdf, 0x01, 0xe9, 0xa6, 0xd5,
+		0x47, 0xaf, 0x0c, 0x83, 0x43, 0x95, 0x2c, 0xa9, 0x29, 0x96, 0xe7, 0x82, 0x70, 0xb7, 0x99, 0x77,
+		0xcc, 0x94, 0x56, 0x53, 0x2c, 0x8f, 0x81, 0xdc, 0x03, 0x39, 0x7d, 0x7b, 0xbb, 0x87, 0xc4, 0x05,
+		0x21, 0xe6, 0x08, 0xcc, 0xba, 0xd9, 0x14, 0xb0, 0x70, 0x09, 0x12, 0x82, 0x0f, 0xb8, 0xff, 0x45,
+		0x4e, 0x68, 0x5d, 0xe6, 0xdd, 0x0e, 0xe1, 0xc9, 0x93, 0x29, 0x0a, 0xef, 0x80, 0x74, 0xcb, 0xd6,
+		0xbc, 0x30, 0x88, 0xd0, 0x7c, 0xe8, 0x44, 0x42, 0x4d, 0xb5, 0x6c, 0xf7, 0x54, 0x54, 0xf9, 0x99,
+		0x14, 0x80, 0x27, 0x6c, 0xe4, 0x7b, 0x25, 0xc8, 0xb2, 0x05, 0xa6, 0xdb, 0x33, 0x6c, 0xc3, 0x6c,
+		0x88, 0x6d, 0xe1, 0xbd, 0x7b, 0x88, 0x28, 0x53, 0x73, 0x6b, 0x1c, 0xa1, 0xf4, 0xd4, 0x87, 0x24,
+		0xe9, 0x55, 0x29, 0xf2, 0xaa, 0x24, 0xfd, 0x98, 0x94, 0x21, 0x89, 0xca, 0x73, 0x6b, 0xcb, 0xd5,
+		0x72, 0x75, 0x3d, 0xff, 0xa5, 0x38, 0x4d, 0x57, 0x57, 0x78, 0xfa, 0xf5, 0x78, 0xb0, 0xfc, 0x8d,
+		0xf8, 0xcf, 0x4b, 0xe1, 0xc4, 0x1b, 0x71, 0x35, 0xb3, 0xe5, 0xa7, 0x47, 0xda, 0xfe, 0x08, 0x8a,
+		0xd0, 0xb8, 0x8d, 0xa4, 0xd7, 0x9a, 0x0a, 0x8f, 0x9b, 0x28, 0xdd, 0x4b, 0x1b, 0x12, 0xa3, 0x0d,
+		0x49, 0x91, 0x58, 0x79, 0x79, 0xb5, 0x5e, 0x59, 0xa2, 0xcd, 0x48, 0x92, 0xc8, 0xea, 0x5a, 0xa5,
+		0x96, 0x7f, 0x5d, 0x54, 0xe9, 0x05, 0x5b, 0xbc, 0x2a, 0xc1, 0x61, 0x71, 0xca, 0xca, 0xd7, 0x5a,
+		0xc3, 0x6c, 0x58, 0x4d, 0x61, 0xdd, 0x66, 0x4f, 0x3f, 0xb4, 0x57, 0xe5, 0x2a, 0x47, 0xa5, 0x2c,
+		0xa9, 0x70, 0xc4, 0xd2, 0x03, 0x43, 0x2c, 0x29, 0xd6, 0x96, 0x78, 0x5b, 0x52, 0x24, 0xb6, 0x56,
+		0x2c, 0x3f, 0x5d, 0x59, 0xf2, 0x5a, 0x73, 0xb0, 0x37, 0x8a, 0x0a, 0xf9, 0x56, 0xc8, 0xa1, 0xb7,
+		0x15, 0x65, 0xa3, 0xd5, 0x64, 0xc7, 0xde, 0x91, 0x71, 0xe7, 0xa5, 0x5e, 0x8b, 0xd0, 0xfd, 0x7a,
+		0xd9, 0xc5, 0x28, 0xdd, 0xeb, 0x6b, 0x4a, 0x92, 0x44, 0x6a, 0xab, 0xb5, 0x8a, 0x68, 0x06, 0x3d,
+		0x22, 0xbe, 0xe2, 0x35, 0x23, 0xdb, 0x0f, 0xa0, 0x92, 0x6f, 0x05, 0x59, 0xb8, 0x87, 0x5c, 0x96,
+		0x44, 0xc7, 0x1d, 0xf9, 0x7a, 0x0d, 0xe0, 0x4e, 0x26, 0x97, 0x19, 0x77, 0xfb, 0x5a, 0x30, 0x47,
+		0x72, 0xcb, 0x95, 0xda, 0x85, 0xf5, 0x8b, 0xda, 0x9a, 0x5a, 0xa1, 0x27, 0x77, 0xf9, 0x2f, 0x89,
+		0xea, 0x73, 0x9d, 0x20, 0x22, 0xf9, 0x76, 0x09, 0x52, 0xcc, 0x04, 0x62, 0x3e, 0x29, 0xe6, 0x54,
+		0xb8, 0x7b, 0xaf, 0xba, 0xa9, 0x05, 0x44, 0xa1, 0x4b, 0xe7, 0x68, 0xb5, 0x61, 0x21, 0x10, 0x87,
+		0x09, 0x59, 0xae, 0x5c, 0x28, 0x96, 0xaf, 0x68, 0xa5, 0x4a, 0x7d, 0x1d, 0x35, 0xd9, 0xaa, 0xca,
+		0x64, 0x14, 0x48, 0xb4, 0xb8, 0xbc, 0xbc, 0xfa, 0xac, 0xc7, 0x08, 0x78, 0xc1, 0x25, 0xa3, 0x7c,
+		0x33, 0x64, 0x02, 0xe2, 0x8e, 0x46, 0x31, 0x35, 0xa6, 0xb1, 0x07, 0xf5, 0x4a, 0xad, 0xec, 0x37,
+		0xe2, 0xd3, 0xe0, 0x8a, 0xb7, 0x2c, 0x61, 0x4a, 0x08, 0xbf, 0x1c, 0x42, 0x35, 0xca, 0x1b, 0xe0,
+		0x9e, 0x25, 0x86, 0x95, 0x47, 0x21, 0x21, 0xc4, 0x17, 0x4d, 0x73, 0x6a, 0x61, 0x0f, 0x6c, 0x0c,
+		0x12, 0x40, 0x65, 0x57, 0x96, 0x70, 0x1b, 0xc4, 0x64, 0x5a, 0x0e, 0x29, 0x97, 0xe1, 0xe0, 0x48,
+		0xd1, 0x23, 0x77, 0xc2, 0x71, 0x71, 0x7e, 0xc9, 0x8c, 0x7e, 0xad, 0x52, 0x2b, 0xaf, 0x2e, 0xe1,
+		0x36, 0xc9, 0xa3, 0x09, 0xc0, 0x65, 0x90, 0xb5, 0x52, 0xc8, 0xa7, 0x1c, 0x52, 0xaa, 0x90, 0x0d,
+		0x0a, 0x10, 0x39, 0x0a, 0x87, 0x37, 0xd6, 0xcf, 0x3f, 0xa6, 0x5d, 0x2e, 0x2e, 0x57, 0x97, 0x8a,
+		0x03, 0x1b, 0x22, 0x00, 0x2e, 0x45, 0x72, 0x08, 0x1b, 0x8a, 0xd2, 0x25, 0x87, 0x95, 0x48, 0x42,
+		0x92, 0x25, 0xa5, 0x0e, 0xb9, 0x01, 0x51, 0x20, 0xb7, 0x41, 0x9e, 0xef, 0x50, 0x46, 0xb5, 0x6a,
+		0x16, 0x06, 0x85, 0x83, 0xed, 0xd5, 0x96, 0x2a, 0xcb, 0xd5, 0x95, 0xea, 0x3a, 0x6d, 0xdf, 0x45,
+		0x00, 0x6f, 0x8c, 0x71, 0xcd, 0xba, 0x54, 0x5f, 0xad, 0x69, 0xe7, 0x71, 0xa3, 0xb7, 0xee, 0x23,
+		0x95, 0x04, 0x36, 0xa6, 0xb2, 0x84, 0xfb, 0x91, 0xe1, 0x81, 0x97, 0x43, 0x27, 0x3f, 0x2c, 0xe1,
+		0x92, 0xf5, 0xe1, 0x5a, 0xe1, 0xdb, 0x25, 0x72, 0x7b, 0xe2, 0x8d, 0x38, 0x89, 0x2f, 0x74, 0x37,
+		0x17, 0x1a, 0xdd, 0x6e, 0x21, 0x87, 0x3f, 0xca, 0xdd, 0xee, 0x79, 0xb1, 0x10, 0x1f, 0x4f, 0xfc,
+		0x49, 0x9c, 0x24, 0x30, 0x17, 0x0f, 0x01, 0x0a, 0x32, 0xfe, 0xba, 0xa4, 0x5f, 0xd3, 0x5d, 0x80,
+		0xa3, 0x89, 0x2f, 0xc7, 0x49, 0x0c, 0xb3, 0xb7, 0xad, 0x42, 0x16, 0xff, 0x5f, 0xb0, 0xdc, 0xc2,
+		0x3b, 0x13, 0xdf, 0x59, 0x23, 0x80, 0x99, 0x54, 0x62, 0x1f, 0x2a, 0x10, 0xfc, 0x4d, 0x8f, 0xcd,
+		0x1e, 0x12, 0x40, 0x27, 0x63, 0x89, 0x0f, 0xd7, 0xe4, 0x8f, 0xd6, 0x4e, 0xc6, 0x12, 0x1f, 0xad,
+		0xc9, 0xdf, 0x5b, 0xbb, 0x14, 0x4b, 0xbc, 0x1e, 0x97, 0xdf, 0x88, 0x2b, 0x7f, 0x1e, 0x06, 0xe2,
+		0xc9, 0xb7, 0xeb, 0x79, 0x79, 0x0e, 0x12, 0xae, 0x2b, 0x87, 0xc5, 0x8a, 0xbe, 0x77, 0x8f, 0x69,
+		0x21, 0xd0, 0x7c, 0x59, 0x03, 0xae, 0x1d, 0x97, 0x1a, 0xee, 0xdb, 0x3b, 0x2d, 0xb3, 0xd5, 0xe9,
+		0x77, 0x34, 0xe1, 0xdf, 0x98, 0xb8, 0x6f, 0xe7, 0x08, 0x3c, 0x4d, 0x49, 0xe8, 0x37, 0x02, 0x24,
+		0xa2, 0x13, 0x49, 0x30, 0x04, 0x9e, 0x2e, 0xfc, 0x8d, 0x04, 0xf9, 0x71, 0x8d, 0xbd, 0x25, 0xd7,
+		0x4b, 0x0d, 0xe6, 0xac, 0x6b, 0x46, 0xaf, 0xd7, 0x6a, 0xd2, 0xd3, 0x14, 0xd7, 0x20, 0x8b, 0x4c,
+		0x36, 0xc8, 0x66, 0x7d, 0x88, 0xee, 0xa0, 0x96, 0x70, 0xdd, 0xbc, 0x81, 0x4b, 0x86, 0xa0, 0x14,
+		0x9d, 0x4c, 0x29, 0x43, 0x51, 0x04, 0x8d, 0x4b, 0x38, 0x4d, 0x70, 0x0f, 0x14, 0x92, 0xc3, 0x9e,
+		0xd5, 0xa7, 0x7c, 0x2a, 0x04, 0xd9, 0x60, 0x70, 0x26, 0x59, 0x82, 0x44, 0xdb, 0xe2, 0x81, 0x4f,
+		0x6c, 0xb4, 0x4f, 0x4c, 0x88, 0xe7, 0x5c, 0x58, 0xe6, 0xf0, 0xaa, 0x8b, 0x59, 0xf8, 0xf7, 0x12,
+		0x24, 0x44, 0x36, 0x39, 0x04, 0x91, 0xae, 0xee, 0xec, 0x50, 0x72, 0xd1, 0x52, 0x48, 0x96, 0x54,
+		0x9a, 0xc6, 0x7c, 0xbb, 0xab, 0xb3, 0xa0, 0x2f, 0x9e, 0x8f, 0x69, 0xb4, 0xbc, 0xda, 0x86, 0xde,
+		0xa4, 0xe7, 0x80, 0x56, 0xa7, 0x63, 0x98, 0x8e, 0x2d, 0x2c, 0x2f, 0x9e, 0x5f, 0xe6, 0xd9, 0x18,
+		0x23, 0xec, 0xf4, 0xf4, 0x56, 0x3b, 0x00, 0x1b, 0xa1, 0xb0, 0xb2, 0x28, 0x70, 0x81, 0x17, 0xe1,
+		0x88, 0xa0, 0xdb, 0x34, 0x1c, 0xbd, 0xb1, 0x63, 0x34, 0x3d, 0xa4, 0x18, 0x3d, 0xef, 0x3f, 0xcc,
+		0x01, 0x96, 0x78, 0xb9, 0xc0, 0x55, 0x3e, 0x1f, 0x82, 0x19, 0x71, 0x72, 0xd9, 0x74, 0x99, 0xb5,
+		0x02, 0xa0, 0x9b, 0xa6, 0xe5, 0xf8, 0xd9, 0x35, 0x6c, 0x6c, 0x0e, 0xe1, 0x2d, 0x14, 0x5d, 0x24,
+		0xd5, 0x47, 0xa0, 0xf0, 0xa7, 0x12, 0x80, 0x57, 0x34, 0x96, 0x6f, 0xc7, 0x21, 0xc5, 0x43, 0x6f,
+		0x69, 0xfc, 0x36, 0x73, 0xf0, 0x01, 0xcb, 0xc2, 0x33, 0x4e, 0xf4, 0xfd, 0x6d, 0x1a, 0xdb, 0x2d,
+		0x93, 0xc7, 0x52, 0xb1, 0x84, 0x08, 0x49, 0x88, 0x78, 0xb1, 0x86, 0x2a, 0x24, 0x6c, 0xa3, 0xa3,
+		0x9b, 0x4e, 0xab, 0xc1, 0x67, 0xcd, 0xd9, 0x7d, 0x35, 0x7e, 0xa1, 0xce, 0xb1, 0x55, 0x97, 0x8e,
+		0x72, 0x02, 0x12, 0x22, 0xd7, 0xd5, 0xd2, 0x07, 0x48, 0x1c, 0xc2, 0xf5, 0x0a, 0xae, 0x53, 0x54,
+		0x59, 0x56, 0x8b, 0x75, 0x39, 0x74, 0xf2, 0x53, 0x21, 0x88, 0x8b, 0x69, 0x3c, 0x0b, 0xb9, 0xca,
+		0x52, 0x75, 0x40, 0xe1, 0xcf, 0x42, 0x56, 0x64, 0x32, 0xad, 0x2a, 0x7f, 0x30, 0xee, 0xcf, 0x5c,
+		0x53, 0x57, 0xd7, 0x57, 0x4f, 0xcb, 0x5f, 0x1a, 0xce, 0x7c, 0x58, 0x7e, 0x3d, 0x4e, 0x66, 0x20,
+		0x2d, 0x32, 0x4f, 0x3f, 0x78, 0xfa, 0x61, 0xf9, 0x8d, 0xc1, 0xac, 0x47, 0xe4, 0x3f, 0xa1, 0xbe,
+		0x25, 0x91, 0xf5, 0x90, 0xb6, 0x8e, 0x5a, 0x7b, 0xb5, 0xb6, 0x7c, 0x45, 0x96, 0xfc, 0x05, 0xa7,
+		0x7d, 0x05, 0x21, 0x72, 0x3b, 0x1c, 0x16, 0x05, 0xe7, 0xce, 0x9d, 0x3b, 0xf7, 0xa8, 0xaf, 0xf0,
+		0xe6, 0x47, 0x62, 0x83, 0xc5, 0x8f, 0xf9, 0x8a, 0x7f, 0x64, 0xb8, 0xf8, 0x9c, 0xaf, 0xf8, 0x47,
+		0x3f, 0x12, 0x23, 0xb3, 0x90, 0x12, 0xc5, 0x2b, 0xc5, 0xe7, 0xe4, 0xaf, 0x7f, 0xfd, 0xeb, 0x5f,
+		0x8f, 0x97, 0xbe, 0x15, 0x66, 0x1b, 0x56, 0x67, 0x70, 0x68, 0x4a, 0xf2, 0x40, 0x60, 0x84, 0x7d,
+		0x51, 0x7a, 0xfe, 0x01, 0x0e, 0xb4, 0x6d, 0xb5, 0x75, 0x73, 0x7b, 0xc1, 0xea, 0x6d, 0x7b, 0xf7,
+		0x04, 0xd0, 0xc8, 0xb5, 0x7d, 0xb7, 0x05, 0xba, 0x9b, 0x7f, 0x23, 0x49, 0x3f, 0x16, 0x0a, 0x5f,
+		0x58, 0x2b, 0x7d, 0x26, 0x54, 0xb8, 0xc0, 0x10, 0xd7, 0xc4, 0xc0, 0xab, 0xc6, 0x56, 0xdb, 0x68,
+		0xe0, 0xe8, 0xc0, 0x9f, 0xdd, 0x07, 0x73, 0xdb, 0xd6, 0xb6, 0x45, 0x29, 0x9d, 0xc2, 0x5f, 0xac,
+		0x11, 0x24, 0xe9, 0xe6, 0x16, 0x26, 0xde, 0x4a, 0x58, 0xac, 0xc1, 0x2c, 0x07, 0xd6, 0xa8, 0xcd,
+		0xcd, 0xce, 0x6e, 0xc9, 0x9e, 0xf1, 0x3f, 0xf9, 0x9f, 0xfb, 0x63, 0xea, 0x2c, 0x51, 0x67, 0x38,
+		0x2a, 0x96, 0xb1, 0xe3, 0xdd, 0x45, 0x15, 0x0e, 0x06, 0xe8, 0xb1, 0xfd, 0x8e, 0xd1, 0x9b, 0x40,
+		0xf1, 0x5f, 0x71, 0x8a, 0xb3, 0x3e, 0x8a, 0x75, 0x8e, 0xba, 0x58, 0x86, 0xcc, 0x7e, 0x68, 0xfd,
+		0x6b, 0x4e, 0x2b, 0x6d, 0xf8, 0x89, 0x5c, 0x80, 0x1c, 0x25, 0xd2, 0xe8, 0xdb, 0x8e, 0xd5, 0xa1,
+		0x9b, 0xc9, 0xbd, 0xc9, 0xfc, 0x9b, 0x3f, 0x66, 0xea, 0x2d, 0x8b, 0x68, 0x65, 0x17, 0x6b, 0x71,
+		0x11, 0xe8, 0xde, 0x01, 0x83, 0x5a, 0x27, 0x50, 0xf8, 0x4d, 0xde, 0x10, 0x17, 0x7e, 0xf1, 0x32,
+		0xcc, 0xe1, 0x6f, 0xba, 0xd7, 0xf3, 0xb7, 0x64, 0x72, 0xb0, 0x50, 0xfe, 0x77, 0x3f, 0xc0, 0x34,
+		0xe8, 0xac, 0x4b, 0xc0, 0xd7, 0x26, 0xdf, 0x28, 0x6e, 0x1b, 0x8e, 0x63, 0xf4, 0x6c, 0x4d, 0x6f,
+		0x8f, 0x6a, 0x9e, 0x2f, 0xda, 0x22, 0xff, 0x83, 0x5f, 0x09, 0x8e, 0xe2, 0x05, 0x86, 0x59, 0x6c,
+		0xb7, 0x17, 0x37, 0xe0, 0xf0, 0x08, 0xa9, 0x98, 0x82, 0xe6, 0x0f, 0x71, 0x9a, 0x73, 0x43, 0x92,
+		0x81, 0x64, 0xd7, 0x40, 0xe4, 0xbb, 0x63, 0x39, 0x05, 0xcd, 0x8f, 0x73, 0x9a, 0x84, 0xe3, 0x8a,
+		0x21, 0x45, 0x8a, 0x97, 0x60, 0xe6, 0x9a, 0xd1, 0xdb, 0xb4, 0x6c, 0x1e, 0xe1, 0x32, 0x05, 0xb9,
+		0x1f, 0xe6, 0xe4, 0x72, 0x1c, 0x91, 0x86, 0xbc, 0x20, 0xad, 0x73, 0x90, 0xd8, 0xd2, 0x1b, 0xc6,
+		0x14, 0x24, 0x6e, 0x72, 0x12, 0x71, 0x84, 0x47, 0xd4, 0x22, 0xa4, 0xb7, 0x2d, 0xbe, 0xdd, 0x9f,
+		0x8c, 0xfe, 0x23, 0x1c, 0x3d, 0x25, 0x70, 0x38, 0x89, 0xae, 0xd5, 0xed, 0xb7, 0xd1, 0x17, 0x30,
+		0x99, 0xc4, 0x8f, 0x0a, 0x12, 0x02, 0x87, 0x93, 0xd8, 0x07, 0x5b, 0x3f, 0x21, 0x48, 0xd8, 0x3e,
+		0x7e, 0x3e, 0x85, 0x81, 0xaf, 0xed, 0x5d, 0xcb, 0x9c, 0xa6, 0x11, 0x9f, 0xe4, 0x14, 0x80, 0xa3,
+		0x20, 0x81, 0xc7, 0x21, 0x39, 0xed, 0x40, 0xfc, 0xc4, 0x57, 0xc4, 0xf4, 0x10, 0x23, 0x70, 0x01,
+		0x72, 0x42, 0x41, 0xe1, 0xb1, 0xd1, 0x64, 0x12, 0x3f, 0xc9, 0x49, 0x64, 0x7d, 0x68, 0xbc, 0x1b,
+		0x8e, 0x61, 0x3b, 0xdb, 0xc6, 0x34, 0x44, 0x3e, 0x25, 0xba, 0xc1, 0x51, 0x38, 0x2b, 0x37, 0x0d,
+		0xb3, 0xb1, 0x33, 0x1d, 0x85, 0x4f, 0x0b, 0x56, 0x0a, 0x1c, 0x24, 0x51, 0x86, 0x4c, 0x47, 0xef,
+		0xd9, 0x3b, 0x7a, 0x7b, 0xaa, 0xe1, 0xf8, 0x29, 0x4e, 0x23, 0xed, 0x22, 0x71, 0x8e, 0xf4, 0xcd,
+		0xfd, 0x90, 0xf9, 0x8c, 0xe0, 0x48, 0xdf, 0x0c, 0x10, 0x5a, 0x83, 0x39, 0xdb, 0xa1, 0x96, 0xef,
+		0x7e, 0xa8, 0xfd, 0x43, 0x31, 0xf5, 0x18, 0xee, 0x8a, 0x9f, 0xe2, 0xe3, 0x90, 0xb4, 0x5b, 0x2f,
+		0x4d, 0x45, 0xe6, 0xa7, 0xc5, 0x48, 0x53, 0x04, 0x44, 0xbe, 0x02, 0x47, 0x46, 0x2e, 0x13, 0x53,
+		0x10, 0xfb, 0x19, 0x4e, 0xec, 0xd0, 0x88, 0xa5, 0x82, 0xab, 0x84, 0xfd, 0x92, 0xfc, 0x47, 0x42,
+		0x25, 0x18, 0x03, 0xb4, 0xd6, 0xd0, 0x01, 0x6b, 0xeb, 0x5b, 0xfb, 0xe3, 0xda, 0x3f, 0x16, 0x5c,
+		0x63, 0xb8, 0x01, 0xae, 0xad, 0xc3, 0x21, 0x4e, 0x71, 0x7f, 0xe3, 0xfa, 0xb3, 0x42, 0xb1, 0x32,
+		0xec, 0x8d, 0xe0, 0xe8, 0x7e, 0x13, 0x14, 0x5c, 0x76, 0x0a, 0x4f, 0x9f, 0xad, 0x61, 0x9c, 0xcc,
+		0x64, 0xca, 0x3f, 0xc7, 0x29, 0x0b, 0x8d, 0xef, 0xba, 0x0a, 0xed, 0x15, 0xbd, 0x8b, 0xc4, 0x9f,
+		0x83, 0xbc, 0x20, 0xde, 0x37, 0x7b, 0x46, 0xc3, 0xda, 0x36, 0x5b, 0x2f, 0x19, 0xcd, 0x29, 0x48,
+		0xff, 0xfc, 0xc0, 0x50, 0x6d, 0xf8, 0xd0, 0x91, 0x72, 0x15, 0x64, 0xd7, 0x56, 0xd1, 0x5a, 0x1d,
+		0x7a, 0x2a, 0xb2, 0x37, 0xc5, 0xcf, 0x8a, 0x91, 0x72, 0xf1, 0xaa, 0x14, 0x6d, 0xb1, 0x02, 0x2c,
+		0x46, 0x7e, 0x5a, 0x91, 0xfc, 0x1c, 0x27, 0x94, 0xf1, 0xb0, 0xb8, 0xe2, 0x68, 0x58, 0x9d, 0xae,
+		0xde, 0x9b, 0x46, 0xff, 0xfd, 0x82, 0x50, 0x1c, 0x1c, 0x85, 0x2b, 0x0e, 0xb4, 0xe8, 0x70, 0xb5,
+		0x9f, 0x82, 0xc2, 0x2f, 0x0a, 0xc5, 0x21, 0x70, 0x38, 0x09, 0x61, 0x30, 0x4c, 0x41, 0xe2, 0x97,
+		0x04, 0x09, 0x81, 0x83, 0x24, 0x9e, 0xf1, 0x16, 0xda, 0x9e, 0xb1, 0xdd, 0xb2, 0x1d, 0x7e, 0x8b,
+		0x65, 0x6f, 0x52, 0xbf, 0xfc, 0x95, 0xa0, 0x11, 0xa6, 0xfa, 0x50, 0x51, 0x13, 0x71, 0xf7, 0x1c,
+		0x75, 0x3f, 0x4f, 0x6e, 0xd8, 0xaf, 0x08, 0x4d, 0xe4, 0x43, 0xc3, 0xb6, 0xf9, 0x2c, 0x44, 0x64,
+		0x7b, 0x03, 0xb7, 0x74, 0x53, 0x90, 0xfb, 0x27, 0x03, 0x8d, 0xab, 0x0b, 0x5c, 0xa4, 0xe9, 0xb3,
+		0x7f, 0xfa, 0xe6, 0x55, 0x63, 0x77, 0x2a, 0xe9, 0xfc, 0xa7, 0x03, 0xf6, 0xcf, 0x06, 0xc3, 0x64,
+		0x3a, 0x24, 0x37, 0x60, 0x4f, 0x91, 0x49, 0x57, 0xdc, 0xf2, 0xdf, 0xf6, 0x26, 0xef, 0x6f, 0xd0,
+		0x9c, 0x5a, 0x5c, 0x06, 0x99, 0xe7, 0x78, 0x06, 0xec, 0x44, 0x62, 0x1f, 0x78, 0xd3, 0x95, 0xf3,
+		0x80, 0xcd, 0xb3, 0x78, 0x1e, 0x32, 0x01, 0x83, 0x67, 0x32, 0xa9, 0x0f, 0x72, 0x52, 0x69, 0xbf,
+		0xbd, 0xb3, 0x78, 0x06, 0x22, 0x68, 0xbc, 0x4c, 0x46, 0xff, 0x0e, 0x8e, 0x4e, 0xc1, 0x17, 0x9f,
+		0x80, 0x84, 0x30, 0x5a, 0x26, 0xa3, 0x7e, 0x27, 0x47, 0x75, 0x51, 0x10, 0x5d, 0x18, 0x2c, 0x93,
+		0xd1, 0xff, 0x7f, 0x81, 0x2e, 0x50, 0x10, 0x7d, 0x7a, 0x16, 0xfe, 0xfa, 0x87, 0x23, 0x0c, 0x5d,
+		0xa0, 0x2c, 0x62, 0x8c, 0x3e, 0xb3, 0x54, 0x26, 0x63, 0x7f, 0x17, 0xaf, 0x5c, 0x60, 0x2c, 0x3e,
+		0x0a, 0xd1, 0x29, 0x19, 0xfe, 0x11, 0x8e, 0xca, 0xe0, 0x17, 0xcb, 0x90, 0xf2, 0x59, 0x27, 0x93,
+		0xd1, 0xbf, 0x87, 0xa3, 0xfb, 0xb1, 0xb0, 0xe9, 0xdc, 0x3a, 0x99, 0x4c, 0xe0, 0xef, 0x89, 0xa6,
+		0x73, 0x0c, 0x64, 0x9b, 0x30, 0x4c, 0x26, 0x63, 0x7f, 0x54, 0x70, 0x5d, 0xa0, 0x2c, 0x3e, 0x05,
+		0x49, 0x77, 0xb1, 0x99, 0x8c, 0xff, 0xbd, 0x1c, 0xdf, 0xc3, 0x41, 0x0e, 0xf4, 0xcd, 0x7d, 0x90,
+		0xf8, 0xfb, 0x82, 0x03, 0x3e, 0x2c, 0x9c, 0x46, 0x83, 0x06, 0xcc, 0x64, 0x4a, 0xdf, 0x27, 0xa6,
+		0xd1, 0x80, 0xfd, 0x82, 0xa3, 0x49, 0x75, 0xfe, 0x64, 0x12, 0x1f, 0x13, 0xa3, 0x49, 0xe1, 0xb1,
+		0x19, 0x83, 0x16, 0xc1, 0x64, 0x1a, 0x3f, 0x20, 0x9a, 0x31, 0x60, 0x10, 0x2c, 0xae, 0x01, 0x19,
+		0xb6, 0x06, 0x26, 0xd3, 0x7b, 0x95, 0xd3, 0x9b, 0x19, 0x32, 0x06, 0x16, 0x9f, 0x85, 0x43, 0xa3,
+		0x2d, 0x81, 0xc9, 0x54, 0x7f, 0xf0, 0xcd, 0x81, 0xbd, 0x9b, 0xdf, 0x10, 0x58, 0x5c, 0x87, 0xb9,
+		0x51, 0x56, 0xc0, 0x64, 0xb2, 0x3f, 0xf4, 0x66, 0x50, 0x71, 0xfb, 0x8d, 0x80, 0xc5, 0x22, 0x80,
+		0xb7, 0x00, 0x4f, 0xa6, 0xf5, 0xc3, 0x9c, 0x96, 0x0f, 0x09, 0xa7, 0x06, 0x5f, 0x7f, 0x27, 0xe3,
+		0xdf, 0x14, 0x53, 0x83, 0x63, 0xe0, 0xd4, 0x10, 0x4b, 0xef, 0x64, 0xec, 0x1f, 0x11, 0x53, 0x43,
+		0xa0, 0xa0, 0x64, 0xfb, 0x56, 0xb7, 0xc9, 0x14, 0x3e, 0x29, 0x24, 0xdb, 0x87, 0xb5, 0x58, 0x83,
+		0x99, 0xa1, 0x05, 0x71, 0x32, 0xa9, 0x1f, 0xe3, 0xa4, 0xe4, 0xc1, 0xf5, 0xd0, 0xbf, 0x78, 0xf1,
+		0xc5, 0x70, 0x32, 0xb5, 0x1f, 0x1f, 0x58, 0xbc, 0xf8, 0x5a, 0xb8, 0xf8, 0x38, 0x24, 0xcc, 0x7e,
+		0xbb, 0x8d, 0x93, 0x87, 0xec, 0x7d, 0x8b, 0x31, 0xff, 0x27, 0x5f, 0xe3, 0xdc, 0x11, 0x08, 0x8b,
+		0x67, 0x20, 0x6a, 0x74, 0x36, 0x8d, 0xe6, 0x24, 0xcc, 0x2f, 0x7f, 0x4d, 0x28, 0x4c, 0x84, 0x5e,
+		0x7c, 0x0a, 0x80, 0xb9, 0x46, 0x68, 0x24, 0xf0, 0x04, 0xdc, 0x3f, 0xfd, 0x1a, 0xbf, 0x36, 0xe4,
+		0xa1, 0x78, 0x04, 0xd8, 0x25, 0xa4, 0xbd, 0x09, 0x7c, 0x25, 0x48, 0x80, 0x8e, 0xc8, 0x39, 0x88,
+		0xe3, 0x01, 0xa0, 0xa3, 0x6f, 0x4f, 0xc2, 0xfe, 0x33, 0x8e, 0x2d, 0xe0, 0x91, 0x61, 0x1d, 0xab,
+		0x67, 0x38, 0xfa, 0xb6, 0x3d, 0x09, 0xf7, 0xbf, 0x71, 0x5c, 0x17, 0x01, 0x91, 0x1b, 0xba, 0xed,
+		0x4c, 0xd3, 0xef, 0x3f, 0x17, 0xc8, 0x02, 0x01, 0x1b, 0x8d, 0xbf, 0xaf, 0x1a, 0xbb, 0x93, 0x70,
+		0xff, 0x42, 0x34, 0x9a, 0xc3, 0x2f, 0x3e, 0x01, 0x49, 0xfc, 0xc9, 0xee, 0x02, 0x4e, 0x40, 0xfe,
+		0x4b, 0x8e, 0xec, 0x61, 0x60, 0xcd, 0xb6, 0xd3, 0x74, 0x5a, 0x93, 0x99, 0xfd, 0x55, 0x3e, 0xd2,
+		0x02, 0x7e, 0xb1, 0x08, 0x29, 0xdb, 0x69, 0x36, 0xfb, 0xdc, 0x3e, 0x9d, 0x80, 0xfe, 0x57, 0x5f,
+		0x73, 0x5d, 0x16, 0x2e, 0x0e, 0x8e, 0xf6, 0xf5, 0xab, 0x4e, 0xd7, 0xa2, 0xb1, 0x23, 0x93, 0x28,
+		0xbc, 0xc9, 0x29, 0xf8, 0x50, 0x16, 0xcb, 0x90, 0xc6, 0xbe, 0x88, 0x23, 0xf8, 0x49, 0x24, 0xfe,
+		0x9a, 0x33, 0x20, 0x80, 0x54, 0x7a, 0xff, 0x6f, 0x7e, 0xe1, 0x98, 0xf4, 0xf9, 0x2f, 0x1c, 0x93,
+		0xfe, 0xeb, 0x17, 0x8e, 0x49, 0x1f, 0xfd, 0xe2, 0xb1, 0x03, 0x9f, 0xff, 0xe2, 0xb1, 0x03, 0xbf,
+		0xff, 0xc5, 0x63, 0x07, 0x46, 0x7b, 0x89, 0xe1, 0x82, 0x75, 0xc1, 0x62, 0xfe, 0xe1, 0xe7, 0xef,
+		0xda, 0x6e, 0x39, 0x3b, 0xfd, 0xcd, 0x85, 0x86, 0xd5, 0x39, 0xd5, 0xb0, 0xec, 0x8e, 0x65, 0x9f,
+		0x0a, 0xfa, 0x75, 0xe9, 0x2f, 0xf8, 0x5f, 0x12, 0x1c, 0x61, 0x64, 0x3c, 0x77, 0xae, 0x6e, 0xee,
+		0x8e, 0x7b, 0x5d, 0xe6, 0x2c, 0x84, 0x8b, 0xe6, 0x2e, 0x39, 0xc2, 0x14, 0x9c, 0xd6, 0xef, 0xb5,
+		0xf9, 0x85, 0xb4, 0x38, 0xa6, 0x37, 0x7a, 0xed, 0x60, 0x30, 0x72, 0x9a, 0x07, 0x23, 0x97, 0xbe,
+		0x47, 0xda, 0x5f, 0x4f, 0x12, 0x45, 0x73, 0x97, 0x76, 0x64, 0x4d, 0x7a, 0xfe, 0xfe, 0x89, 0x7e,
+		0xee, 0xab, 0xa6, 0x75, 0xdd, 0xc4, 0x66, 0x77, 0x37, 0x85, 0x8f, 0xfb, 0xd8, 0xa0, 0x8f, 0xfb,
+		0x59, 0xa3, 0xdd, 0x7e, 0x1a, 0xe1, 0xf0, 0x50, 0xdc, 0xde, 0x8c, 0xb1, 0xbb, 0xcf, 0xf0, 0x7d,
+		0x21, 0x38, 0x36, 0xe4, 0xce, 0xe6, 0x42, 0x30, 0x8e, 0x09, 0x8b, 0x90, 0x58, 0x12, 0xb2, 0x95,
+		0xc7, 0xb7, 0x5d, 0x1a, 0x96, 0xd9, 0x64, 0x51, 0xa5, 0x61, 0x55, 0x24, 0x91, 0x11, 0xa6, 0x6e,
+		0x5a, 0x36, 0xbf, 0xd2, 0xc9, 0x12, 0xa5, 0x8f, 0xef, 0x93, 0x11, 0x19, 0x51, 0x93, 0xe0, 0xc6,
+		0x43, 0x53, 0x72, 0x43, 0x74, 0x22, 0xe0, 0xf9, 0x9f, 0x96, 0x2b, 0x3f, 0x10, 0x82, 0xe3, 0x83,
+		0x5c, 0xc1, 0x99, 0x65, 0x3b, 0x7a, 0xa7, 0x3b, 0x8e, 0x2d, 0x8f, 0x43, 0x72, 0x5d, 0xc0, 0xec,
+		0x9b, 0x2f, 0x37, 0xf7, 0xc9, 0x97, 0xac, 0x5b, 0x95, 0x60, 0xcc, 0xe9, 0x29, 0x19, 0xe3, 0xf6,
+		0xe3, 0x96, 0x38, 0xf3, 0xf1, 0x24, 0x1c, 0x61, 0xd3, 0x49, 0x63, 0x53, 0x89, 0x25, 0x38, 0x4f,
+		0xd2, 0xfe, 0xa2, 0xc9, 0xe7, 0x24, 0xca, 0xd3, 0x30, 0x5b, 0x45, 0x6d, 0x81, 0xbb, 0x20, 0xef,
+		0x84, 0x67, 0xe4, 0xad, 0xd7, 0xf9, 0x80, 0xc1, 0xcf, 0x4f, 0x02, 0xfd, 0x59, 0xca, 0xb7, 0x49,
+		0x20, 0xd7, 0x1b, 0x7a, 0x5b, 0xef, 0xbd, 0x55, 0x52, 0xe4, 0x51, 0x00, 0x16, 0xb3, 0xe4, 0xbe,
+		0x57, 0x83, 0xa7, 0xe1, 0xfe, 0xce, 0x2d, 0xb0, 0x9a, 0xe8, 0x8d, 0x90, 0x24, 0x85, 0xc5, 0x9f,
+		0x27, 0x9f, 0x03, 0xf0, 0x0a, 0x30, 0xc6, 0xa3, 0x5e, 0x2e, 0x2e, 0x17, 0x55, 0x11, 0x7c, 0x52,
+		0x5f, 0xab, 0x94, 0xd9, 0xf3, 0x11, 0x07, 0x30, 0x80, 0xc2, 0x5f, 0xe8, 0x46, 0xd1, 0x1f, 0x84,
+		0x19, 0x7f, 0x3e, 0xbb, 0xcb, 0x1f, 0x5a, 0xbc, 0x88, 0x9e, 0x02, 0x8c, 0x9b, 0xd4, 0xf4, 0x66,
+		0xd3, 0x68, 0x6a, 0x2d, 0x93, 0x4c, 0xb8, 0xb7, 0x9b, 0xff, 0xad, 0xff, 0x18, 0xa5, 0x5d, 0xcb,
+		0x30, 0xc4, 0x22, 0xe2, 0x55, 0x4d, 0xb4, 0x39, 0x5b, 0x9d, 0x6e, 0xdb, 0xa0, 0xa7, 0xbd, 0x5a,
+		0x4b, 0xf0, 0x7f, 0xb2, 0x39, 0x83, 0xf4, 0xf0, 0xe4, 0x78, 0xd6, 0x43, 0x77, 0x47, 0x6f, 0xf1,
+		0x69, 0x2f, 0xaa, 0xc9, 0x6d, 0xe0, 0x44, 0x8a, 0xbf, 0xcd, 0x5b, 0x28, 0xbc, 0x19, 0xa2, 0x89,
+		0xcb, 0x30, 0x83, 0x97, 0xdd, 0xba, 0x81, 0xf6, 0x4d, 0x58, 0x41, 0x44, 0x6f, 0x65, 0x8e, 0xe9,
+		0x35, 0xed, 0x51, 0x88, 0xd9, 0x74, 0x50, 0x26, 0x91, 0x10, 0xcd, 0xe1, 0xe0, 0xe8, 0xfe, 0x62,
+		0x62, 0xe0, 0xf6, 0x68, 0x02, 0x81, 0x7f, 0xcb, 0x09, 0xa4, 0x29, 0x9a, 0xe8, 0x8d, 0x09, 0x33,
+		0xec, 0xb1, 0x16, 0xc3, 0xd7, 0x9b, 0xbd, 0xbd, 0x28, 0xff, 0xec, 0xb3, 0x0f, 0xd2, 0x13, 0xf6,
+		0x3b, 0x82, 0x42, 0x37, 0x62, 0xb2, 0xa8, 0x32, 0xa7, 0xed, 0xf5, 0xd7, 0x80, 0xac, 0xa8, 0x8f,
+		0xf7, 0x7b, 0xef, 0xca, 0x7e, 0x95, 0x57, 0x76, 0x6c, 0x94, 0x84, 0xfb, 0x6a, 0xca, 0x70, 0xaa,
+		0xac, 0x60, 0xb1, 0x04, 0x19, 0x3c, 0x94, 0xf7, 0x98, 0xb3, 0x77, 0x2d, 0xbf, 0xf6, 0xd9, 0x07,
+		0xd9, 0x44, 0x43, 0x24, 0xce, 0x9a, 0x52, 0x65, 0x9c, 0xd6, 0x7b, 0xfe, 0xbe, 0xe1, 0xf5, 0x9b,
+		0xfd, 0x7b, 0x80, 0x52, 0x7f, 0xdc, 0xdf, 0x54, 0x4f, 0x3b, 0x45, 0x60, 0x06, 0xaf, 0x91, 0x5b,
+		0xa7, 0xe8, 0x5f, 0xae, 0x95, 0xa2, 0x34, 0x31, 0xc5, 0xb1, 0xed, 0x59, 0xa6, 0x2c, 0x26, 0xcb,
+		0xed, 0x5f, 0x7e, 0xf7, 0x4f, 0x46, 0x3d, 0x85, 0xb2, 0xb8, 0x32, 0x1c, 0xd1, 0x37, 0x99, 0xc6,
+		0x57, 0x05, 0x8d, 0xc1, 0x00, 0xbd, 0xc5, 0xf7, 0xe2, 0x59, 0x28, 0x27, 0x33, 0xc9, 0x76, 0x13,
+		0x44, 0x5c, 0x0c, 0xb4, 0xdc, 0x98, 0xd0, 0x4e, 0x63, 0xa7, 0xbf, 0x29, 0xf0, 0x99, 0x0e, 0xc3,
+		0x78, 0xd8, 0xc5, 0x0b, 0x90, 0x6d, 0x5a, 0xa6, 0xa3, 0x59, 0x9d, 0x96, 0x63, 0x74, 0xba, 0xce,
+		0x44, 0xcb, 0xf7, 0xaf, 0x19, 0x91, 0x84, 0x9a, 0x41, 0xbc, 0x55, 0x81, 0x86, 0x2d, 0x61, 0xef,
+		0xbd, 0x4c, 0xd3, 0x92, 0xff, 0xee, 0xb6, 0x84, 0xe2, 0x60, 0x4b, 0x6e, 0x49, 0x3a, 0xec, 0xe6,
+		0x55, 0xbe, 0xdc, 0x39, 0x37, 0x98, 0x14, 0xb8, 0xd2, 0xf1, 0x2f, 0x22, 0x70, 0x8c, 0x03, 0x6f,
+		0xea, 0xb6, 0x71, 0xea, 0xda, 0x43, 0x9b, 0x86, 0xa3, 0x3f, 0x74, 0xaa, 0x61, 0xb5, 0x84, 0xad,
+		0x33, 0xcb, 0xca, 0x17, 0xb0, 0x7c, 0x81, 0x97, 0x17, 0x46, 0x06, 0x04, 0x14, 0xc6, 0x2f, 0x83,
+		0x85, 0x61, 0x19, 0x54, 0xda, 0x10, 0x29, 0x5b, 0x2d, 0x7a, 0x57, 0xad, 0x69, 0x98, 0x56, 0x87,
+		0x2f, 0x48, 0x2c, 0x41, 0x2e, 0x42, 0x4c, 0xef, 0x58, 0x7d, 0xd3, 0x61, 0x8b, 0x51, 0xe9, 0xc1,
+		0xdf, 0x7c, 0xed, 0xf8, 0x81, 0x3f, 0x78, 0xed, 0xf8, 0x41, 0x46, 0xd6, 0x6e, 0x5e, 0x5d, 0x68,
+		0x59, 0xa7, 0x3a, 0xba, 0xb3, 0x83, 0x2a, 0xe0, 0xf7, 0x3e, 0xf7, 0x00, 0xf0, 0xfa, 0xaa, 0xa6,
+		0xf3, 0xe9, 0xd7, 0x7f, 0xf6, 0xa4, 0xa4, 0x72, 0xfc, 0xc5, 0xc8, 0x1b, 0x9f, 0x38, 0x2e, 0x29,
+		0x5d, 0x88, 0x2f, 0x19, 0x8d, 0x3d, 0x2a, 0xac, 0x0e, 0x54, 0xf8, 0x10, 0xaf, 0xf0, 0xe8, 0x70,
+		0x85, 0xec, 0x0e, 0xcc, 0x92, 0xd1, 0xf0, 0x55, 0xbb, 0x64, 0x34, 0x06, 0x6a, 0xac, 0x42, 0xa2,
+		0x6a, 0x3a, 0xec, 0xd1, 0x8a, 0x27, 0x20, 0xdc, 0x32, 0xd9, 0x5d, 0xe7, 0x64, 0xe9, 0xbe, 0x7d,
+		0x74, 0x45, 0x45, 0x3c, 0x65, 0x15, 0x12, 0x4b, 0x46, 0x83, 0x91, 0x2a, 0x43, 0xb8, 0x69, 0x34,
+		0xf2, 0xd2, 0xad, 0x36, 0x12, 0xb1, 0x4b, 0x4b, 0xbf, 0xff, 0x47, 0xc7, 0x0e, 0xbc, 0xfc, 0x85,
+		0x63, 0x07, 0xc6, 0x8a, 0x93, 0x32, 0x59, 0x9c, 0x5c, 0x29, 0xfa, 0xc9, 0x08, 0xdc, 0x4e, 0x5f,
+		0x3d, 0xea, 0x75, 0x5a, 0xa6, 0x73, 0xaa, 0xd1, 0xdb, 0xed, 0x3a, 0x16, 0x2a, 0x15, 0x6b, 0x8b,
+		0x0b, 0xd1, 0x8c, 0x57, 0xbc, 0xc0, 0x8a, 0x47, 0x8b, 0x90, 0xb2, 0x05, 0xd1, 0x35, 0xc4, 0xc3,
+		0x41, 0x72, 0x2c, 0x47, 0x6f, 0x73, 0x5b, 0x91, 0x25, 0x30, 0x97, 0xbd, 0x94, 0x14, 0x62, 0xb9,
+		0x2d, 0xf1, 0x48, 0x52, 0xdb, 0xd0, 0xb7, 0xd8, 0x83, 0x13, 0x61, 0xba, 0xc9, 0x48, 0x60, 0x06,
+		0x7d, 0x5b, 0x62, 0x0e, 0xa2, 0x7a, 0x9f, 0x05, 0x86, 0x85, 0x71, 0xf7, 0x41, 0x13, 0xca, 0xd3,
+		0x10, 0xe7, 0x51, 0x0f, 0x18, 0x19, 0x75, 0xd5, 0xd8, 0xa5, 0xf5, 0xa4, 0x55, 0xfc, 0x49, 0x16,
+		0x20, 0x4a, 0x1b, 0xcf, 0xef, 0xf9, 0xe4, 0x17, 0x86, 0x5a, 0xbf, 0x40, 0x1b, 0xa9, 0x32, 0x30,
+		0xe5, 0x12, 0x24, 0x96, 0x2c, 0x94, 0xec, 0x20, 0xb5, 0x24, 0xa3, 0x46, 0xdb, 0xdc, 0xed, 0x3b,
+		0xe2, 0x2e, 0x26, 0x4d, 0xe0, 0xcd, 0x66, 0xf6, 0x00, 0x09, 0x0f, 0x6e, 0xe3, 0x29, 0xa5, 0x0c,
+		0x71, 0x4a, 0x7b, 0xb5, 0xeb, 0xbe, 0xea, 0x25, 0xf9, 0x5e, 0xf5, 0xe2, 0xe4, 0x43, 0x5e, 0x63,
+		0x09, 0x44, 0x9a, 0xba, 0xa3, 0xf3, 0x7e, 0xd3, 0xdf, 0xca, 0x93, 0x90, 0xe0, 0x44, 0x6c, 0x72,
+		0x1a, 0xc2, 0x56, 0x57, 0xc4, 0x6e, 0x16, 0xc6, 0x75, 0x65, 0xb5, 0x5b, 0x8a, 0xa0, 0x2c, 0xa9,
+		0x08, 0x5c, 0xaa, 0x8d, 0x15, 0x8b, 0x47, 0x02, 0x62, 0xd1, 0x31, 0x9c, 0xcd, 0x2d, 0xc7, 0xfb,
+		0xc1, 0x86, 0x73, 0x48, 0x14, 0x5c, 0x41, 0xb9, 0x19, 0x82, 0x63, 0xbe, 0xd2, 0x6b, 0x46, 0x0f,
+		0xdd, 0x7e, 0x4c, 0x9a, 0xb8, 0xa4, 0x10, 0x5f, 0x03, 0x79, 0xf9, 0x18, 0x51, 0x79, 0x02, 0xc2,
+		0xc5, 0x6e, 0x17, 0xdf, 0xe0, 0xa2, 0xe9, 0x86, 0xc5, 0x64, 0x25, 0xa2, 0xba, 0x69, 0x2c, 0xb3,
+		0xad, 0x2d, 0xe7, 0xba, 0xde, 0x73, 0xdf, 0xe7, 0x12, 0x69, 0xe5, 0x1c, 0x24, 0xcb, 0x96, 0x69,
+		0x1b, 0xa6, 0xdd, 0xa7, 0x3b, 0x90, 0xcd, 0xb6, 0xd5, 0xb8, 0xca, 0x29, 0xb0, 0x04, 0x32, 0x5b,
+		0xef, 0x76, 0x29, 0x66, 0x44, 0xc5, 0x9f, 0x6c, 0x66, 0x97, 0x56, 0xc7, 0xb2, 0xe7, 0xcc, 0xfe,
+		0xd8, 0xc3, 0x3b, 0xe8, 0xf2, 0xe7, 0x7f, 0x4a, 0x70, 0xdb, 0xf0, 0x44, 0xba, 0x6a, 0xec, 0xda,
+		0xfb, 0x9d, 0x47, 0xcf, 0x41, 0x72, 0x8d, 0xbe, 0x92, 0xfa, 0xb4, 0xb1, 0x4b, 0x0a, 0x18, 0xc6,
+		0x7a, 0xfa, 0xcc, 0x99, 0x87, 0xce, 0x31, 0x29, 0xbf, 0x78, 0x40, 0x15, 0x19, 0xe4, 0x18, 0x24,
+		0x6d, 0xa3, 0xd1, 0x3d, 0x7d, 0xe6, 0xec, 0xd5, 0x87, 0x98, 0x58, 0x5d, 0x3c, 0xa0, 0x7a, 0x59,
+		0x8b, 0x09, 0xec, 0xf1, 0x1b, 0x9f, 0x3c, 0x2e, 0x95, 0xa2, 0x10, 0xb6, 0xfb, 0x9d, 0x77, 0x4c,
+		0x36, 0xfe, 0x22, 0x06, 0xf3, 0xbe, 0x52, 0xb6, 0x60, 0xf1, 0x0b, 0x09, 0xee, 0xdb, 0xb6, 0xb2,
+		0xaf, 0xff, 0x14, 0x62, 0xcc, 0x4a, 0xb4, 0x27, 0x17, 0x95, 0x9f, 0x97, 0x20, 0x7d, 0x59, 0x50,
+		0xc6, 0xcb, 0x29, 0x8f, 0x03, 0xb8, 0x35, 0x89, 0xa9, 0x72, 0x74, 0x61, 0xb0, 0xae, 0x05, 0x17,
+		0x47, 0xf5, 0x81, 0xe3, 0xad, 0xab, 0x6e, 0xcf, 0xea, 0x5a, 0x36, 0x7f, 0xab, 0x69, 0x02, 0xaa,
+		0x0b, 0x8c, 0x57, 0x81, 0xa8, 0x56, 0xd3, 0xae, 0x59, 0x0e, 0x06, 0xf4, 0x74, 0xad, 0xeb, 0xfc,
+		0x05, 0xbc, 0xb0, 0x2a, 0xd3, 0x92, 0xcb, 0xb4, 0x60, 0x0d, 0xf3, 0xb1, 0xd1, 0x49, 0x97, 0x0a,
+		0x6e, 0xa6, 0xf5, 0x66, 0xb3, 0x67, 0xd8, 0x36, 0x57, 0x5c, 0x22, 0x89, 0x0f, 0x44, 0x75, 0xfb,
+		0x9b, 0x9a, 0xd0, 0x12, 0xf8, 0xc4, 0xd6, 0x88, 0x39, 0x2f, 0x64, 0x83, 0xcf, 0xfa, 0x58, 0xb7,
+		0xbf, 0x89, 0x92, 0x72, 0x07, 0xa4, 0x47, 0x34, 0x26, 0x75, 0xcd, 0x6b, 0x07, 0x7d, 0x98, 0x97,
+		0xf7, 0x40, 0xeb, 0xf6, 0x5a, 0x56, 0xaf, 0xe5, 0xec, 0xd2, 0xb0, 0xd2, 0xb0, 0x2a, 0x8b, 0x82,
+		0x35, 0x9e, 0xaf, 0x5c, 0x85, 0x5c, 0x9d, 0x6e, 0x8d, 0xbc, 0x96, 0x9f, 0xf1, 0xda, 0x27, 0x4d,
+		0x6e, 0xdf, 0xd8, 0x96, 0x85, 0x86, 0x5a, 0x76, 0xf2, 0x3f, 0x49, 0x90, 0x2a, 0xe1, 0xc4, 0xad,
+		0x2e, 0x9d, 0x6f, 0xeb, 0xdb, 0xe4, 0x21, 0x38, 0x58, 0x5a, 0x5e, 0x2d, 0x3f, 0xad, 0x55, 0x97,
+		0xb4, 0xf3, 0xcb, 0x45, 0xdf, 0x05, 0x80, 0xc2, 0xa1, 0x57, 0x6e, 0xce, 0x13, 0x1f, 0xec, 0x86,
+		0x49, 0x3d, 0x01, 0xe4, 0x14, 0xcc, 0x05, 0x51, 0x8a, 0xa5, 0x3a, 0x5e, 0x3b, 0x93, 0x0a, 0x07,
+		0x5f, 0xb9, 0x39, 0x3f, 0xe3, 0xc3, 0x28, 0x6e, 0xda, 0x86, 0xe9, 0x0c, 0x23, 0x94, 0x57, 0x57,
+		0x56, 0xf0, 0x16, 0xc6, 0x10, 0x02, 0x06, 0x13, 0xb7, 0x1c, 0x72, 0x2f, 0xcc, 0x04, 0x11, 0x6a,
+		0xd5, 0x65, 0x39, 0x5c, 0x20, 0xaf, 0xdc, 0x9c, 0xcf, 0xfa, 0xa0, 0x6b, 0xad, 0x76, 0x21, 0xf1,
+		0xa1, 0x1f, 0x3f, 0x76, 0xe0, 0xd3, 0xff, 0xe0, 0x98, 0x54, 0x5a, 0x19, 0x3b, 0xe7, 0x1e, 0xde,
+		0xdf, 0x9c, 0x0b, 0xae, 0xdb, 0x5f, 0xbb, 0x0d, 0x6e, 0x1b, 0x2c, 0x0c, 0x28, 0xe3, 0x69, 0xa7,
+		0xdb, 0x24, 0xcf, 0x50, 0x61, 0x6f, 0xf3, 0xa0, 0x30, 0x61, 0x51, 0x28, 0x4c, 0x54, 0x0c, 0xca,
+		0x39, 0xc8, 0xe0, 0xc5, 0xb4, 0xba, 0xe1, 0x5c, 0x34, 0xf4, 0xa6, 0xd1, 0x0b, 0xda, 0x0f, 0x19,
+		0x61, 0x3f, 0x10, 0x88, 0x50, 0x23, 0x81, 0xad, 0x9f, 0xf4, 0xb7, 0xb2, 0x03, 0x11, 0x44, 0xf5,
+		0x6c, 0x0b, 0x8e, 0x41, 0x13, 0x98, 0xbb, 0xb9, 0xeb, 0xf0, 0x3b, 0xbf, 0x69, 0x95, 0x25, 0xc8,
+		0x23, 0xc2, 0x42, 0x08, 0xef, 0x6d, 0x21, 0xf0, 0xe9, 0xc5, 0xed, 0x84, 0x36, 0xc4, 0xf9, 0x10,
+		0xbb, 0x0d, 0x91, 0xbc, 0x86, 0x90, 0x15, 0xc8, 0x75, 0xf5, 0x9e, 0x43, 0x5f, 0xc8, 0xd9, 0xa1,
+		0xbd, 0xe0, 0x33, 0xf8, 0xf8, 0xb0, 0x3e, 0x09, 0x74, 0x96, 0xd7, 0x92, 0xe9, 0xfa, 0x33, 0x95,
+		0x2f, 0x45, 0x20, 0xc6, 0x99, 0xf1, 0x04, 0xc4, 0x39, 0x5b, 0xf9, 0x9c, 0xbb, 0x7d, 0x61, 0x78,
+		0x99, 0x5d, 0x70, 0x97, 0x43, 0x4e, 0x4f, 0xe0, 0x90, 0xbb, 0x21, 0xd1, 0xd8, 0xd1, 0x5b, 0xa6,
+		0xd6, 0x6a, 0x72, 0xe3, 0x38, 0xf5, 0x85, 0xd7, 0x8e, 0xc7, 0xcb, 0x98, 0x57, 0x5d, 0x52, 0xe3,
+		0xb4, 0xb0, 0xda, 0x44, 0x9b, 0x66, 0xc7, 0x68, 0x6d, 0xef, 0x38, 0x5c, 0x6f, 0xf0, 0x14, 0xbe,
+		0x35, 0x8e, 0x02, 0xc1, 0xaf, 0x40, 0x14, 0x86, 0x76, 0x3d, 0xae, 0xe3, 0xae, 0x94, 0xc0, 0x8a,
+		0x3f, 0xfa, 0x5f, 0x8e, 0x4b, 0x2a, 0xc5, 0x20, 0x65, 0xc8, 0xb4, 0x75, 0xdb, 0xd1, 0xe8, 0x7a,
+		0x8c, 0xd5, 0xb3, 0xbb, 0x0f, 0x47, 0x86, 0x19, 0xc2, 0x19, 0xcb, 0x9b, 0x9e, 0x42, 0x2c, 0x96,
+		0xd5, 0xc4, 0x37, 0xa3, 0x28, 0x91, 0x06, 0x9d, 0x81, 0xcc, 0x4a, 0x8c, 0x51, 0xbe, 0x67, 0x31,
+		0x9f, 0x4d, 0x4c, 0x6a, 0x2b, 0x1e, 0x85, 0x24, 0x7d, 0x06, 0x8a, 0x82, 0xb0, 0x8b, 0x94, 0x09,
+		0xcc, 0xa0, 0x85, 0xf7, 0x40, 0xce, 0xd3, 0xfa, 0x0c, 0x24, 0xc1, 0xa8, 0x78, 0xd9, 0x14, 0xf0,
+		0x41, 0x98, 0x33, 0x8d, 0x1b, 0x8e, 0x36, 0x08, 0x9d, 0xa4, 0xd0, 0x04, 0xcb, 0x2e, 0x07, 0x31,
+		0xee, 0x82, 0x6c, 0x43, 0x30, 0x9f, 0xc1, 0x02, 0x85, 0xcd, 0xb8, 0xb9, 0x14, 0xec, 0x08, 0x24,
+		0xf4, 0x6e, 0x97, 0x01, 0xa4, 0xb8, 0xd6, 0xef, 0x76, 0x69, 0xd1, 0x49, 0x98, 0xa1, 0x7d, 0xec,
+		0x19, 0x36, 0x5e, 0xae, 0x61, 0x30, 0x69, 0x0a, 0x93, 0xc3, 0x02, 0x95, 0xe5, 0x53, 0xd8, 0x3b,
+		0x21, 0x63, 0x5c, 0xc3, 0x5b, 0xac, 0x0d, 0x83, 0xc1, 0x65, 0x28, 0x5c, 0x5a, 0x64, 0x52, 0xa0,
+		0x7b, 0xc1, 0xd5, 0xe6, 0x9a, 0x58, 0x69, 0xb2, 0x8c, 0x9e, 0xc8, 0x2f, 0xb2, 0x6c, 0x25, 0x0f,
+		0x91, 0x25, 0xdd, 0xd1, 0xd1, 0x5c, 0x72, 0x6e, 0xb0, 0xe5, 0x33, 0xad, 0xe2, 0x4f, 0xe5, 0x97,
+		0xc2, 0x10, 0xb9, 0x6c, 0x39, 0x06, 0x79, 0xd8, 0x67, 0xca, 0x66, 0x47, 0xc9, 0x73, 0xbd, 0xb5,
+		0x6d, 0x1a, 0xcd, 0x15, 0x7b, 0xdb, 0xf7, 0xf4, 0xaa, 0x27, 0x4e, 0xa1, 0x80, 0x38, 0xcd, 0x41,
+		0xb4, 0x67, 0xf5, 0xcd, 0xa6, 0xb8, 0xe0, 0x40, 0x13, 0xa4, 0x02, 0x09, 0x57, 0x4a, 0x22, 0x93,
+		0xa4, 0x24, 0x87, 0x52, 0x82, 0x32, 0xcc, 0x33, 0xd4, 0xf8, 0x26, 0x17, 0x96, 0x12, 0x24, 0x5d,
+		0xe5, 0x95, 0x8f, 0xee, 0x43, 0x60, 0x3d, 0x34, 0x5c, 0x22, 0xdd, 0xb1, 0x77, 0x99, 0xc7, 0x24,
+		0x4e, 0x76, 0x0b, 0x38, 0xf7, 0x02, 0x62, 0xc5, 0x9f, 0x81, 0x8d, 0xd3, 0x7e, 0x79, 0x62, 0xc5,
+		0x9e, 0x82, 0xbd, 0x0d, 0xe3, 0x20, 0xb7, 0x4d, 0x7a, 0x79, 0x87, 0x4b, 0x9e, 0x97, 0x81, 0xa5,
+		0xde, 0x75, 0x5c, 0x26, 0x69, 0x5e, 0x06, 0x3e, 0xaa, 0xe2, 0x26, 0x34, 0x8f, 0x0a, 0x93, 0x32,
+		0xe2, 0x16, 0xd5, 0x45, 0x89, 0xf2, 0xeb, 0x12, 0xc4, 0xf8, 0x8a, 0xe5, 0x0d, 0x83, 0x34, 0x7a,
+		0x18, 0x42, 0xe3, 0x86, 0x21, 0x7c, 0xeb, 0xc3, 0x50, 0x04, 0x70, 0x9b, 0x69, 0xf3, 0xc7, 0x3e,
+		0x47, 0x98, 0x55, 0xac, 0x89, 0xf5, 0xd6, 0x36, 0x9f, 0xf7, 0x3e, 0x24, 0xe5, 0x0f, 0x25, 0x48,
+		0xba, 0xe5, 0xa4, 0x08, 0x19, 0xd1, 0x2e, 0x6d, 0xab, 0xad, 0x6f, 0x73, 0x51, 0xbc, 0x7d, 0x6c,
+		0xe3, 0x70, 0x15, 0x56, 0x53, 0xbc, 0x3d, 0x98, 0x18, 0x3d, 0xac, 0xa1, 0x31, 0xc3, 0x1a, 0x90,
+		0xa3, 0xf0, 0xad, 0xc9, 0x51, 0x60, 0xc4, 0x23, 0x03, 0x23, 0xae, 0xfc, 0x91, 0xc4, 0x9f, 0x19,
+		0x6f, 0x1a, 0xcd, 0xff, 0x93, 0x43, 0xf5, 0x3c, 0x97, 0x2d, 0x74, 0x79, 0x0e, 0x8d, 0xd9, 0x9d,
+		0xc3, 0x14, 0x83, 0x6d, 0xf6, 0xc6, 0x8e, 0x08, 0x2a, 0x75, 0x6f, 0x0c, 0x3f, 0x17, 0x82, 0x99,
+		0x21, 0xf8, 0xbf, 0x7d, 0x63, 0x19, 0x9c, 0xbd, 0xd1, 0x29, 0x67, 0x6f, 0x6c, 0xec, 0xec, 0xfd,
+		0x6c, 0x88, 0x3a, 0x00, 0xba, 0x96, 0xad, 0xb7, 0xbf, 0x11, 0xba, 0xf7, 0x28, 0x24, 0xbb, 0x56,
+		0x5b, 0x63, 0x25, 0xec, 0x8a, 0x59, 0xa2, 0x6b, 0xb5, 0xd5, 0x21, 0x31, 0x8b, 0xbe, 0x4d, 0x8a,
+		0x39, 0xf6, 0x36, 0x0c, 0x42, 0x7c, 0x70, 0x42, 0xf5, 0x20, 0xcd, 0x58, 0xc1, 0xad, 0xa6, 0x07,
+		0x91, 0x07, 0xf8, 0x2b, 0x2f, 0x0d, 0x5b, 0x79, 0xac, 0xd9, 0x0c, 0x52, 0x8d, 0xed, 0xb8, 0x18,
+		0xcc, 0xc8, 0xc8, 0x87, 0xc6, 0x61, 0x30, 0x29, 0x56, 0x39, 0x9c, 0xf2, 0xfd, 0x12, 0xc0, 0x32,
+		0x72, 0x96, 0xf6, 0x17, 0xed, 0x1d, 0x9b, 0x36, 0x41, 0x0b, 0xd4, 0x7c, 0x6c, 0xdc, 0xa0, 0xf1,
+		0xfa, 0xd3, 0xb6, 0xbf, 0xdd, 0x65, 0xc8, 0x78, 0xb2, 0x6d, 0x1b, 0xa2, 0x31, 0xc7, 0xf6, 0xd8,
+		0x95, 0xe2, 0x9d, 0xd1, 0xf4, 0x35, 0x5f, 0x4a, 0xf9, 0xe7, 0x12, 0x24, 0x69, 0x9b, 0xf0, 0xf9,
+		0xcb, 0xc0, 0x18, 0x4a, 0xb7, 0x3e, 0x86, 0xb7, 0x03, 0x30, 0x32, 0x18, 0x7f, 0xc4, 0x25, 0x2b,
+		0x49, 0x73, 0x30, 0xaa, 0x88, 0x9c, 0x75, 0x19, 0x1e, 0xde, 0x9b, 0xe1, 0x62, 0xd7, 0xca, 0xd9,
+		0x7e, 0x18, 0xe2, 0xf4, 0x19, 0x87, 0x1b, 0x36, 0xdf, 0x88, 0xe2, 0x63, 0xc9, 0xeb, 0x37, 0x6c,
+		0xe5, 0x05, 0x88, 0xaf, 0xdf, 0x60, 0xfe, 0xc4, 0xa3, 0x90, 0xec, 0x59, 0x16, 0xb7, 0xfe, 0x98,
+		0xd5, 0x9d, 0xc0, 0x0c, 0x6a, 0xec, 0x08, 0x1f, 0x5a, 0xc8, 0xf3, 0xa1, 0x79, 0x4e, 0xc0, 0xf0,
+		0x54, 0x4e, 0x40, 0xdc, 0x7d, 0x66, 0x02, 0x33, 0x89, 0xdc, 0x0f, 0x87, 0xeb, 0xd5, 0x0b, 0xb5,
+		0xca, 0x92, 0xb6, 0x52, 0xbf, 0x30, 0x70, 0xd9, 0xbe, 0x90, 0x7b, 0xe5, 0xe6, 0x7c, 0x8a, 0x6f,
+		0x3b, 0xc7, 0x41, 0xaf, 0xa9, 0x95, 0xcb, 0xab, 0xeb, 0x15, 0x59, 0x62, 0xd0, 0x6b, 0x3d, 0xe3,
+		0x9a, 0xe5, 0xb0, 0x6f, 0xd0, 0x3c, 0x08, 0x47, 0x46, 0x40, 0xbb, 0x9b, 0xcf, 0x99, 0x57, 0x6e,
+		0xce, 0x67, 0xd6, 0x7a, 0x06, 0x93, 0x32, 0x8a, 0xb1, 0x00, 0xf9, 0x61, 0x8c, 0xd5, 0xb5, 0xd5,
+		0x7a, 0x71, 0x59, 0x9e, 0x2f, 0xc8, 0xaf, 0xdc, 0x9c, 0x4f, 0x0b, 0x95, 0x81, 0xf0, 0xdf, 0x80,
+		0xdd, 0x67, 0x02, 0x6e, 0x1f, 0x2c, 0x3c, 0xd5, 0xd5, 0x7b, 0x7a, 0x67, 0xbf, 0xdb, 0xcf, 0x09,
+		0xe1, 0x1a, 0xca, 0x5f, 0x85, 0x20, 0xe7, 0x6e, 0x6e, 0xd6, 0x68, 0x0d, 0xe4, 0x61, 0xbf, 0xc7,
+		0x2f, 0x35, 0x76, 0xed, 0x60, 0xd0, 0xc2, 0x21, 0x88, 0xc7, 0x50, 0xdc, 0x48, 0xe6, 0x93, 0x6a,
+		0x7e, 0xc4, 0xfa, 0xc6, 0x21, 0x38, 0xaa, 0x8b, 0x41, 0x9e, 0x82, 0xa4, 0x3b, 0xc5, 0xdc, 0xc7,
+		0xbb, 0xc7, 0xcf, 0x49, 0x8e, 0xef, 0xe1, 0xe0, 0x7b, 0xda, 0x62, 0x1b, 0x17, 0x19, 0xb7, 0x31,
+		0xbc, 0xcc, 0x00, 0x38, 0xb2, 0x80, 0x27, 0x0f, 0x42, 0x44, 0xdf, 0x6c, 0xb4, 0xf2, 0xd1, 0x61,
+		0x97, 0x0b, 0xc3, 0x2b, 0x96, 0xca, 0x55, 0x8e, 0x44, 0x21, 0xb1, 0xb5, 0x7a, 0xdf, 0xd9, 0x61,
+		0xfe, 0x9d, 0xd8, 0xb8, 0xd6, 0x16, 0x05, 0x88, 0x68, 0xad, 0x8b, 0xa3, 0x54, 0xb9, 0x37, 0x86,
+		0x33, 0x9c, 0xbe, 0xa7, 0x7a, 0x43, 0x63, 0x9b, 0x69, 0x66, 0x9e, 0x24, 0x3a, 0xfa, 0x8d, 0x12,
+		0xa6, 0x71, 0x06, 0x63, 0xe1, 0x36, 0x7f, 0xd8, 0x2c, 0xac, 0xc6, 0x3a, 0xfa, 0x8d, 0x0b, 0x3a,
+		0xde, 0x5c, 0x0f, 0xcb, 0x11, 0xe5, 0xa7, 0xd0, 0xd4, 0x09, 0xb0, 0x95, 0xdc, 0x07, 0x04, 0x31,
+		0xf0, 0x80, 0x11, 0xe7, 0x3e, 0x1d, 0x1f, 0x41, 0x17, 0x1f, 0x05, 0x28, 0x6e, 0x1b, 0xb5, 0x7e,
+		0x87, 0x36, 0xc0, 0x26, 0x78, 0x1a, 0xc9, 0x81, 0x85, 0x68, 0xf0, 0xf1, 0x3b, 0x32, 0xfc, 0x39,
+		0x18, 0x0e, 0xc0, 0x56, 0x90, 0x57, 0x71, 0x05, 0xc9, 0x32, 0x7a, 0xa2, 0x24, 0xd8, 0x95, 0x70,
+		0xb0, 0x2b, 0xca, 0x53, 0x90, 0x1b, 0x18, 0x42, 0xa2, 0x40, 0x86, 0xbb, 0xbc, 0x68, 0xf4, 0x04,
+		0xdb, 0x22, 0x25, 0xd5, 0x14, 0x73, 0x6d, 0xd1, 0x68, 0x92, 0xc5, 0xc4, 0x2f, 0xa3, 0x9f, 0x15,
+		0xcf, 0x8d, 0xee, 0x83, 0x4c, 0x60, 0x10, 0x85, 0x1b, 0x5a, 0xf2, 0xdc, 0xd0, 0x1e, 0xf0, 0xf3,
+		0x90, 0x46, 0x0d, 0x66, 0x34, 0x39, 0xec, 0xdd, 0x90, 0x63, 0x1a, 0x76, 0x90, 0xd7, 0xcc, 0x62,
+		0x5a, 0x11, 0x0c, 0x57, 0x20, 0xe3, 0xc1, 0x79, 0x6c, 0x4f, 0x09, 0xa8, 0x0b, 0xba, 0xad, 0xac,
+		0x02, 0x78, 0x52, 0x41, 0x8a, 0x70, 0x3b, 0x6a, 0x1f, 0x7f, 0xb4, 0x2f, 0x7f, 0x30, 0x3a, 0x60,
+		0x72, 0x16, 0x10, 0xc8, 0x8b, 0xe8, 0x65, 0xcf, 0x46, 0x5f, 0xa4, 0x10, 0xca, 0x29, 0xc8, 0x0d,
+		0xc8, 0x0b, 0xae, 0xc8, 0x9e, 0x94, 0xb1, 0x83, 0x0e, 0x2f, 0xa3, 0xf4, 0xcc, 0xa7, 0xbf, 0x70,
+		0x4c, 0x7a, 0x67, 0xb4, 0xcf, 0x7f, 0xbe, 0x02, 0x47, 0x7d, 0x85, 0x28, 0xf2, 0x01, 0xd7, 0x57,
+		0xce, 0x2b, 0x5c, 0xc0, 0xc2, 0x49, 0x2e, 0xac, 0x3d, 0x3d, 0xce, 0x85, 0xbd, 0x15, 0xdd, 0x64,
+		0xff, 0xd6, 0x64, 0x0f, 0xdb, 0xe8, 0x63, 0x80, 0x5f, 0x4b, 0x42, 0x5c, 0x35, 0x5e, 0xec, 0x1b,
+		0x36, 0x3e, 0x66, 0x11, 0x31, 0x1a, 0x3b, 0xd6, 0x28, 0xaf, 0x2b, 0x76, 0x70, 0x81, 0xc3, 0x55,
+		0x1a, 0x3b, 0xd6, 0xc5, 0x03, 0x2a, 0x85, 0x25, 0x67, 0x20, 0xba, 0xd5, 0xee, 0x73, 0x87, 0xd9,
+		0x80, 0x96, 0xf4, 0x23, 0x9d, 0x47, 0xa0, 0x8b, 0x07, 0x54, 0x06, 0x8d, 0x55, 0xd1, 0x4f, 0x82,
+		0x85, 0xf7, 0xae, 0x0a, 0x1f, 0x14, 0xc0, 0xaa, 0x10, 0x96, 0x94, 0x00, 0x5a, 0x66, 0xcb, 0xd1,
+		0xa8, 0x33, 0x29, 0x1f, 0x1d, 0x56, 0x38, 0x41, 0xcc, 0x96, 0x43, 0xdd, 0x4f, 0x78, 0x58, 0xd1,
+		0x12, 0x09, 0x6c, 0xee, 0x8b, 0x7d, 0xa3, 0x27, 0xf4, 0xd5, 0xd8, 0xe6, 0x3e, 0x83, 0x40, 0xd8,
+		0x5c, 0x0a, 0x8d, 0x6a, 0x9d, 0x3d, 0x39, 0xef, 0xdc, 0xc8, 0x27, 0x86, 0x15, 0xab, 0x1f, 0x93,
+		0xbe, 0x3b, 0xbf, 0x7e, 0x03, 0x4f, 0x50, 0x1a, 0xec, 0x27, 0x79, 0xcc, 0x35, 0xfa, 0x52, 0xc3,
+		0x76, 0x56, 0x00, 0x97, 0x39, 0x9a, 0x0e, 0x08, 0xe3, 0x8f, 0xd4, 0x20, 0xdb, 0x6e, 0xd9, 0x8e,
+		0x66, 0x9b, 0x7a, 0xd7, 0xde, 0xb1, 0x1c, 0xf1, 0xe6, 0xf3, 0x5d, 0xe3, 0x28, 0x2c, 0xb7, 0x6c,
+		0xa7, 0x2e, 0x80, 0x2f, 0x1e, 0x50, 0x33, 0x6d, 0x7f, 0x06, 0xd2, 0xb3, 0xb6, 0xb6, 0x8c, 0x9e,
+		0x4b, 0x30, 0x9f, 0xd9, 0x9b, 0xde, 0x2a, 0x42, 0x0b, 0x7c, 0xa4, 0x67, 0xf9, 0x33, 0xc8, 0x37,
+		0xc1, 0x6c, 0xdb, 0xd2, 0x9b, 0x2e, 0x39, 0xad, 0xb1, 0xd3, 0x37, 0xaf, 0x52, 0x37, 0x10, 0x7e,
+		0xfc, 0x63, 0x5c, 0x23, 0x2d, 0xbd, 0x29, 0x48, 0x94, 0x11, 0xe1, 0xe2, 0x01, 0x75, 0xa6, 0x3d,
+		0x98, 0x49, 0xde, 0x07, 0x73, 0x7a, 0xb7, 0xdb, 0xde, 0x1d, 0xa4, 0x9e, 0xa3, 0xd4, 0x4f, 0x8e,
+		0xa3, 0x5e, 0x44, 0x9c, 0x41, 0xf2, 0x44, 0x1f, 0xca, 0x25, 0xeb, 0xe8, 0xc0, 0x32, 0xe8, 0x0d,
+		0xbb, 0x2e, 0xb7, 0x6b, 0xe8, 0x83, 0xca, 0xf8, 0xad, 0xbd, 0x31, 0xb4, 0xd7, 0x18, 0xbc, 0x30,
+		0x83, 0x2e, 0x1e, 0x40, 0x5f, 0x57, 0x20, 0x8b, 0x51, 0xb5, 0x1a, 0x06, 0x7d, 0x7d, 0x9e, 0x53,
+		0x9d, 0x99, 0x44, 0x95, 0xc2, 0x07, 0xa9, 0x06, 0xb2, 0x48, 0x05, 0x52, 0x6c, 0xf3, 0x8b, 0x47,
+		0x41, 0x06, 0x7d, 0xd5, 0x3f, 0x75, 0x5a, 0x19, 0x3b, 0x43, 0x29, 0x28, 0xfa, 0xd5, 0x2e, 0x1e,
+		0x50, 0xc1, 0x70, 0x53, 0x44, 0x87, 0x83, 0xf4, 0x45, 0xea, 0x5d, 0x2d, 0xa8, 0xa9, 0xf3, 0xb3,
+		0x94, 0xe0, 0x7d, 0xe3, 0x08, 0xd2, 0x2f, 0x3d, 0xed, 0x5e, 0xf6, 0x2b, 0xee, 0x8b, 0x07, 0xd4,
+		0xd9, 0x6b, 0xc3, 0xd9, 0x28, 0x62, 0x5b, 0x2d, 0x53, 0x6f, 0xe3, 0xd7, 0x16, 0x98, 0xfd, 0x34,
+		0xb7, 0xb7, 0x88, 0x9d, 0xe7, 0xd0, 0x74, 0x25, 0x46, 0x11, 0xdb, 0xf2, 0x67, 0x94, 0xe2, 0x3c,
+		0x36, 0xd8, 0x7d, 0xa9, 0x35, 0x2e, 0x27, 0xd8, 0x7b, 0xe2, 0x97, 0x22, 0x09, 0x90, 0x53, 0xca,
+		0x3d, 0x90, 0xf2, 0x29, 0x26, 0x3c, 0xf7, 0xe2, 0xb1, 0x41, 0x22, 0xca, 0x98, 0x27, 0x95, 0x2c,
+		0xa4, 0xfd, 0xca, 0x48, 0xf9, 0xa8, 0x04, 0x29, 0x9f, 0x9e, 0x41, 0x4c, 0xbf, 0x0f, 0x3c, 0xe9,
+		0xd9, 0x46, 0x77, 0x8a, 0xb5, 0x50, 0x94, 0xb3, 0x03, 0xdf, 0x34, 0xcd, 0xe4, 0x4b, 0x31, 0x3e,
+		0xbb, 0xd2, 0x3d, 0xdd, 0x75, 0x41, 0xc2, 0x14, 0x04, 0xba, 0xa7, 0xbb, 0x02, 0xe0, 0x0e, 0x48,
+		0x63, 0xbf, 0x35, 0xbf, 0x85, 0x96, 0x54, 0x53, 0x98, 0xc7, 0x41, 0x94, 0xdf, 0x09, 0x81, 0x3c,
+		0xa8, 0xc0, 0x5c, 0xe7, 0xb8, 0xb4, 0x6f, 0xe7, 0xf8, 0x91, 0x41, 0xb7, 0xbc, 0xe7, 0x89, 0x5f,
+		0x06, 0xd9, 0x73, 0x28, 0xb3, 0xa5, 0x66, 0xbc, 0xc5, 0x39, 0x60, 0x1a, 0xab, 0xb9, 0x46, 0x30,
+		0x83, 0x9c, 0x0f, 0x1c, 0x8f, 0x8a, 0x6f, 0x66, 0x0e, 0x0e, 0xb8, 0x6b, 0xf5, 0x6c, 0x74, 0x9b,
+		0xba, 0x63, 0x08, 0x8f, 0x9c, 0x87, 0x89, 0xc6, 0x09, 0xfa, 0xaf, 0x6d, 0x47, 0x77, 0x0c, 0x6e,
+		0x9c, 0x30, 0x5f, 0x47, 0x46, 0xef, 0x76, 0xe9, 0x27, 0xc6, 0x98, 0x71, 0x72, 0x17, 0x64, 0x51,
+		0xa7, 0xb7, 0xf4, 0xb6, 0xb0, 0x2d, 0x62, 0xcc, 0x86, 0xe1, 0xb9, 0xdc, 0x9c, 0x68, 0x42, 0xda,
+		0xaf, 0xcf, 0xdd, 0x5d, 0x9c, 0xe4, 0xdb, 0xc5, 0x11, 0xfe, 0x8a, 0x0e, 0xe3, 0x8f, 0x78, 0x79,
+		0x68, 0xf4, 0x31, 0xc5, 0x1c, 0xdd, 0xf1, 0x5d, 0x63, 0xee, 0x99, 0x84, 0xca, 0x12, 0x8a, 0x0a,
+		0xd9, 0xa0, 0xee, 0x27, 0x59, 0x08, 0x39, 0x37, 0x78, 0x2d, 0x21, 0xe7, 0x06, 0xda, 0xd6, 0xbe,
+		0x17, 0x04, 0x87, 0x57, 0x3b, 0x8e, 0xe7, 0x79, 0x57, 0x94, 0x1c, 0x64, 0x04, 0x4d, 0xe6, 0x06,
+		0x38, 0x04, 0x73, 0xa3, 0x54, 0xbc, 0xb2, 0x03, 0x73, 0xa3, 0x54, 0x35, 0x39, 0x03, 0x09, 0x57,
+		0xc7, 0x8f, 0xd8, 0x8f, 0xd3, 0x6a, 0x05, 0xb0, 0xea, 0x82, 0x06, 0x0e, 0x10, 0x42, 0x81, 0x03,
+		0x04, 0xe5, 0xfd, 0x90, 0x1f, 0xa7, 0xbf, 0x07, 0xdc, 0x8a, 0x11, 0x97, 0x61, 0x87, 0x20, 0xc6,
+		0x1f, 0xcc, 0x0b, 0xd1, 0x23, 0x33, 0x9e, 0x42, 0x46, 0x32, 0x5d, 0x1e, 0xa6, 0xd9, 0x2c, 0xa1,
+		0x68, 0x70, 0x64, 0xac, 0x0e, 0x1f, 0x7f, 0xf8, 0xc6, 0x08, 0xf1, 0xc3, 0xb7, 0x86, 0x68, 0x8e,
+		0x4d, 0xfb, 0x2a, 0x42, 0x67, 0x58, 0x4a, 0x79, 0x35, 0x0c, 0x87, 0x46, 0x6b, 0x72, 0x32, 0x0f,
+		0x69, 0x34, 0x74, 0x9d, 0xa0, 0x4d, 0x0c, 0x1d, 0xfd, 0xc6, 0x3a, 0x37, 0x88, 0xf9, 0xe1, 0x45,
+		0xc8, 0x3d, 0xbc, 0x20, 0x1b, 0x30, 0xd3, 0xb6, 0x1a, 0x7a, 0x5b, 0xf3, 0x1d, 0x1e, 0xf1, 0x49,
+		0x74, 0xe7, 0x10, 0xb3, 0x83, 0x4e, 0x4a, 0x54, 0x38, 0x5c, 0xfe, 0x73, 0x94, 0xc6, 0xb2, 0x7b,
+		0xce, 0x44, 0x96, 0x20, 0xd5, 0x69, 0xd9, 0x9b, 0xc6, 0x8e, 0x7e, 0xad, 0x65, 0xf5, 0xf8, 0x6c,
+		0x1a, 0x16, 0x9a, 0x15, 0x0f, 0x46, 0x9c, 0x69, 0xf9, 0xd0, 0x7c, 0x43, 0x12, 0x1d, 0x79, 0xd4,
+		0x16, 0xdb, 0xb7, 0x36, 0x19, 0x77, 0x6a, 0x15, 0x1f, 0x7b, 0x6a, 0x35, 0xea, 0x88, 0x28, 0x31,
+		0xfa, 0x88, 0xe8, 0x43, 0xfe, 0xa1, 0x09, 0xae, 0x7d, 0x43, 0xa7, 0x46, 0xa4, 0x0e, 0x73, 0x1c,
+		0xbf, 0x19, 0xe0, 0xfd, 0x88, 0xe0, 0x0a, 0x36, 0xbf, 0x06, 0x79, 0x4e, 0x04, 0xfa, 0x78, 0xb6,
+		0x87, 0x6f, 0x8d, 0xed, 0xe2, 0xd8, 0x36, 0xe2, 0x3b, 0xb6, 0xfd, 0xbf, 0x6c, 0x28, 0x3e, 0x10,
+		0x86, 0x99, 0x21, 0x43, 0x62, 0xe4, 0x79, 0xf4, 0x38, 0x1f, 0xb0, 0xe8, 0x58, 0x78, 0xdf, 0x1d,
+		0xe3, 0x63, 0x1d, 0x99, 0x3c, 0xd6, 0xd1, 0xb7, 0x71, 0xac, 0x63, 0xb7, 0x36, 0xd6, 0xef, 0xe8,
+		0x28, 0xfc, 0xb0, 0x04, 0x85, 0xf1, 0xd6, 0xd7, 0xc8, 0xe1, 0xd8, 0xd7, 0x91, 0xc5, 0xb8, 0x35,
+		0xee, 0x2e, 0xc8, 0x0e, 0xd8, 0x86, 0x4c, 0x94, 0x33, 0x81, 0x6d, 0xbb, 0xf2, 0xc1, 0x30, 0xcc,
+		0x8d, 0x32, 0xe0, 0x46, 0xcc, 0xd6, 0x67, 0xf0, 0x7b, 0x0d, 0x8d, 0x56, 0xf3, 0x56, 0x27, 0xeb,
+		0x0c, 0xc7, 0xfe, 0x7f, 0x73, 0x75, 0x58, 0x4a, 0x3e, 0x06, 0x90, 0x50, 0x0d, 0xbb, 0x8b, 0xf6,
+		0x18, 0x1e, 0x80, 0x18, 0x37, 0x1a, 0x46, 0xd7, 0xf1, 0xc2, 0x38, 0x46, 0x6d, 0x11, 0x18, 0x74,
+		0x45, 0x40, 0xe2, 0x06, 0xd9, 0x45, 0xc3, 0xb3, 0x20, 0xea, 0x03, 0x18, 0xbf, 0x9d, 0xe7, 0xe8,
+		0x7e, 0x27, 0xc0, 0x59, 0xe1, 0x04, 0x08, 0x8f, 0xdd, 0xdf, 0x32, 0xac, 0x01, 0x2f, 0xc0, 0xc3,
+		0xdc, 0x0b, 0x10, 0x99, 0x50, 0x59, 0xc0, 0x0d, 0x50, 0x0e, 0xb8, 0x01, 0x62, 0x13, 0xba, 0x39,
+		0xc6, 0x0f, 0x70, 0x56, 0xf8, 0x01, 0xe2, 0x13, 0x5a, 0x3c, 0xe0, 0x08, 0x78, 0xc2, 0xe7, 0x08,
+		0x48, 0x0e, 0xfb, 0x77, 0x03, 0xa8, 0x23, 0x3c, 0x01, 0xe7, 0x5c, 0x4f, 0x40, 0x7a, 0xac, 0x17,
+		0x81, 0x23, 0x0f, 0xba, 0x02, 0x56, 0x87, 0x5c, 0x01, 0x19, 0xfe, 0xfd, 0xe7, 0x71, 0x24, 0x26,
+		0xf8, 0x02, 0x56, 0x87, 0x7c, 0x01, 0xd9, 0x09, 0x04, 0x27, 0x38, 0x03, 0xbe, 0x79, 0xb4, 0x33,
+		0x60, 0xfc, 0x76, 0x9d, 0x37, 0x73, 0x3a, 0x6f, 0x80, 0x36, 0xc6, 0x1b, 0x20, 0x8f, 0xdd, 0xb9,
+		0x32, 0xf2, 0x53, 0xbb, 0x03, 0x36, 0x46, 0xb8, 0x03, 0xd8, 0xc6, 0xfd, 0xc4, 0x58, 0xe2, 0x53,
+		0xf8, 0x03, 0x36, 0x46, 0xf8, 0x03, 0xc8, 0x44, 0xb2, 0x13, 0x1d, 0x02, 0xe7, 0x83, 0x0e, 0x81,
+		0xd9, 0x31, 0x56, 0xa7, 0x37, 0xdb, 0xc7, 0x78, 0x04, 0x36, 0xc7, 0x79, 0x04, 0xe6, 0xf8, 0x77,
+		0x25, 0xc6, 0x51, 0xdc, 0x87, 0x4b, 0x60, 0x75, 0xc8, 0x25, 0x70, 0x70, 0x82, 0xa4, 0x4d, 0xef,
+		0x13, 0x60, 0x5f, 0x1a, 0x63, 0xdf, 0x18, 0x03, 0x39, 0x75, 0x29, 0x92, 0x48, 0xc9, 0x69, 0xe5,
+		0x5e, 0x98, 0x11, 0xa4, 0x5c, 0x3d, 0x87, 0x7b, 0x05, 0xa3, 0xd7, 0xb3, 0x7a, 0xe2, 0x56, 0x07,
+		0x4d, 0x28, 0x27, 0x20, 0xed, 0x82, 0xee, 0xed, 0x3f, 0xa0, 0x7b, 0x32, 0x9f, 0x1e, 0x53, 0x7e,
+		0x59, 0x82, 0xb4, 0x5f, 0x45, 0x05, 0xf6, 0x97, 0x49, 0xbe, 0xbf, 0xf4, 0x79, 0x15, 0x42, 0x41,
+		0xaf, 0x02, 0x7e, 0xaf, 0xa5, 0x3b, 0xe4, 0x30, 0xd0, 0xbb, 0xae, 0xc3, 0x40, 0x84, 0x6c, 0x31,
+		0xdf, 0x03, 0x5f, 0x96, 0xd8, 0xf9, 0x65, 0xce, 0x0d, 0x5f, 0x63, 0x5b, 0x5d, 0xf2, 0x00, 0xcc,
+		0xfa, 0x60, 0xdd, 0x3d, 0x1c, 0xdb, 0x3d, 0xcb, 0x2e, 0x74, 0x91, 0x6f, 0xe6, 0xfe, 0xa5, 0x04,
+		0x33, 0x43, 0x2a, 0x72, 0xa4, 0x53, 0x40, 0x7a, 0x9b, 0x9c, 0x02, 0xa1, 0x5b, 0x76, 0x0a, 0xf8,
+		0xf7, 0xa4, 0xe1, 0xe0, 0x9e, 0xf4, 0x7f, 0x48, 0x90, 0x09, 0x68, 0x6a, 0x1c, 0x82, 0x86, 0xd5,
+		0x34, 0xf8, 0x2e, 0x91, 0xfe, 0x46, 0x93, 0xa4, 0x6d, 0x89, 0x0f, 0x44, 0xe1, 0x4f, 0x84, 0x72,
+		0x17, 0x9e, 0x24, 0x5f, 0x57, 0xdc, 0x0d, 0x66, 0xd4, 0x7f, 0x73, 0x84, 0x5f, 0xa7, 0x88, 0x79,
+		0xd7, 0x29, 0xdc, 0xcb, 0xea, 0x71, 0xdf, 0x65, 0x75, 0xf2, 0x18, 0x24, 0xa9, 0xb3, 0x5f, 0xb3,
+		0xba, 0x6c, 0xb1, 0x1e, 0x30, 0x6d, 0x82, 0x57, 0x29, 0x6c, 0x1a, 0xe4, 0x4d, 0x7f, 0xf9, 0x2c,
+		0x8e, 0x64, 0xc0, 0xe2, 0xb8, 0x0d, 0x92, 0xd8, 0x7a, 0xf6, 0xb1, 0x49, 0xe0, 0x2f, 0x1d, 0x88,
+		0x0c, 0xe5, 0x33, 0x21, 0xc8, 0x0d, 0x2c, 0x34, 0x23, 0xfb, 0x3e, 0xea, 0xe0, 0x7a, 0x3a, 0x7e,
+		0x1c, 0x03, 0xd8, 0xd6, 0x6d, 0xed, 0xba, 0x6e, 0x3a, 0xfc, 0x03, 0xf1, 0x61, 0xd5, 0x97, 0x83,
+		0x57, 0x27, 0x30, 0xd5, 0xb7, 0xf9, 0x27, 0xe2, 0xc3, 0xaa, 0x9b, 0xc6, 0xbb, 0x59, 0xc6, 0x35,
+		0xfa, 0x6e, 0x32, 0xfb, 0x3e, 0xc8, 0xa1, 0xe1, 0xed, 0x30, 0x16, 0x97, 0xf2, 0x38, 0xd8, 0x5f,
+		0x7e, 0xed, 0xb8, 0xcc, 0xa0, 0xef, 0x77, 0xaf, 0xcf, 0xa9, 0x1c, 0x3f, 0xc8, 0x85, 0xc4, 0x00,
+		0x17, 0xa8, 0x1f, 0x30, 0x2d, 0xb6, 0xf7, 0xc8, 0x53, 0x16, 0x55, 0x8e, 0xb7, 0x77, 0x3b, 0x5d,
+		0xcb, 0x6a, 0x6b, 0x6c, 0x8e, 0x17, 0x21, 0xeb, 0xf2, 0x8a, 0xad, 0xa6, 0xf4, 0x7b, 0xce, 0x0e,
+		0xba, 0xc6, 0x02, 0x46, 0x70, 0x9a, 0x65, 0xb2, 0x39, 0xe5, 0x7f, 0x14, 0x5b, 0x59, 0x83, 0x83,
+		0x82, 0x44, 0x60, 0x5d, 0x25, 0x8f, 0x42, 0xd2, 0x5b, 0x92, 0xd9, 0xc5, 0x80, 0x3d, 0x3c, 0x2d,
+		0x1e, 0xac, 0xf2, 0xab, 0x12, 0x1c, 0x1c, 0xb9, 0xb2, 0x92, 0x0a, 0xc4, 0x58, 0x94, 0x26, 0x8f,
+		0xc8, 0x79, 0x60, 0xba, 0x15, 0x79, 0x81, 0x85, 0x70, 0xaa, 0x1c, 0x59, 0x79, 0x1f, 0xc4, 0x58,
+		0x0e, 0x49, 0x41, 0x3c, 0xf0, 0x4e, 0x7e, 0xb1, 0x5c, 0xae, 0xac, 0x89, 0xe7, 0x96, 0x4b, 0xf4,
+		0x0d, 0x7a, 0xcc, 0x56, 0x2b, 0x97, 0x2a, 0xe5, 0x75, 0x39, 0x8c, 0x1f, 0x34, 0x67, 0xbf, 0xf9,
+		0x6b, 0xf6, 0x72, 0xc4, 0x97, 0x55, 0xaf, 0xd4, 0x96, 0x2a, 0xaa, 0x1c, 0x55, 0x1e, 0x82, 0x23,
+		0xa2, 0x1d, 0xc3, 0x1e, 0x21, 0xd7, 0x31, 0x23, 0xf9, 0x1c, 0x33, 0xca, 0xab, 0x21, 0x28, 0x08,
+		0x9c, 0x11, 0x3e, 0x9e, 0x4b, 0x03, 0x1d, 0x3f, 0xbd, 0x8f, 0x55, 0x7d, 0xa0, 0xf7, 0xb8, 0x8f,
+		0xe9, 0x19, 0x5b, 0x86, 0xd3, 0xd8, 0x61, 0x86, 0x02, 0xd3, 0x40, 0x19, 0x35, 0xc3, 0x73, 0x29,
+		0x92, 0xcd, 0xc0, 0x5e, 0x30, 0x1a, 0x8e, 0xc6, 0x84, 0xc8, 0xa6, 0x9b, 0x89, 0xa4, 0x9a, 0x61,
+		0xb9, 0x75, 0x96, 0xa9, 0xbc, 0x7f, 0x5f, 0xbc, 0x4c, 0x42, 0x54, 0xad, 0xe0, 0xb7, 0xc9, 0xe8,
+		0x57, 0xdb, 0xe9, 0x4f, 0xad, 0x5e, 0x2b, 0xae, 0xd5, 0x2f, 0xae, 0x22, 0x2f, 0x67, 0x21, 0x27,
+		0x78, 0x29, 0x32, 0xa3, 0xca, 0x7d, 0x70, 0x78, 0x8c, 0x55, 0x31, 0x22, 0x6c, 0xf6, 0x93, 0x92,
+		0x1f, 0x3a, 0x68, 0x19, 0xac, 0x42, 0xcc, 0x76, 0x74, 0xa7, 0x6f, 0x73, 0x26, 0x3e, 0x3a, 0xad,
+		0x99, 0xb1, 0x20, 0x7e, 0xd4, 0x29, 0xba, 0xca, 0xc9, 0x28, 0x67, 0x20, 0x1b, 0x2c, 0x19, 0xcf,
+		0x03, 0x4f, 0x88, 0x42, 0xca, 0xe3, 0x40, 0x86, 0xad, 0x8f, 0x11, 0xdb, 0x4b, 0x69, 0xd4, 0xf6,
+		0xf2, 0x27, 0x24, 0x38, 0xba, 0x87, 0xa5, 0x41, 0x9e, 0x19, 0xe8, 0xe4, 0xb9, 0xfd, 0xd8, 0x29,
+		0x0b, 0x2c, 0x6f, 0xa0, 0x9b, 0x0f, 0x43, 0xda, 0x9f, 0x3f, 0x5d, 0x27, 0xbf, 0x1c, 0x82, 0x83,
+		0x23, 0x8d, 0x16, 0x9f, 0x0a, 0x94, 0xde, 0xa2, 0x0a, 0x7c, 0x2f, 0x80, 0x73, 0x43, 0xc4, 0x6d,
+		0xf3, 0x75, 0xf4, 0xf6, 0x11, 0xfe, 0x45, 0xa3, 0xb1, 0x7e, 0x83, 0x4f, 0x82, 0xa4, 0xc3, 0x7f,
+		0xa1, 0xff, 0xc4, 0xe7, 0x14, 0xe8, 0xd3, 0x35, 0xd6, 0xce, 0x87, 0xf7, 0xb5, 0x18, 0xcb, 0xd7,
+		0x82, 0xd9, 0x36, 0xb9, 0x02, 0x87, 0x07, 0x0c, 0x05, 0x97, 0x74, 0x64, 0x5a, 0x7b, 0xe1, 0x60,
+		0xd0, 0x5e, 0x10, 0xa4, 0xfd, 0xab, 0x7d, 0x34, 0xb8, 0xda, 0x5f, 0x01, 0xf0, 0x9c, 0x03, 0x5e,
+		0xa0, 0xa1, 0xe4, 0x0f, 0x34, 0x3c, 0x03, 0x51, 0x94, 0x24, 0xc1, 0xa7, 0x61, 0x55, 0x8c, 0x92,
+		0xe0, 0x73, 0x2e, 0x30, 0x68, 0xa5, 0x05, 0x64, 0xd8, 0x41, 0x3b, 0xa6, 0x8a, 0x27, 0x82, 0x55,
+		0xdc, 0x31, 0xd6, 0xd5, 0x3b, 0xba, 0xaa, 0x97, 0x20, 0x4a, 0x47, 0x7e, 0xe4, 0xed, 0xcd, 0x6f,
+		0x01, 0xd0, 0x1d, 0xa7, 0xd7, 0xda, 0xec, 0x7b, 0x15, 0x1c, 0x1f, 0x2d, 0x39, 0x45, 0x01, 0x57,
+		0xba, 0x8d, 0x8b, 0xd0, 0x9c, 0x87, 0xea, 0x13, 0x23, 0x1f, 0x41, 0xa5, 0x06, 0xd9, 0x20, 0xee,
+		0xe8, 0xdb, 0xa8, 0xc3, 0x5f, 0x06, 0xf5, 0xac, 0x23, 0xfe, 0x40, 0x16, 0x4d, 0x28, 0x2f, 0x87,
+		0x20, 0xed, 0x17, 0xbc, 0xbf, 0x7b, 0x26, 0x88, 0xf2, 0x1d, 0x12, 0x24, 0xdc, 0xee, 0xef, 0x11,
+		0x5e, 0xed, 0xdd, 0x4a, 0x76, 0x0f, 0x2f, 0xd8, 0x31, 0x51, 0xd8, 0x3d, 0x26, 0x7a, 0xdc, 0x5d,
+		0xfe, 0xc6, 0x39, 0x44, 0xfc, 0xbc, 0x16, 0x21, 0x8e, 0x7c, 0xb5, 0x7f, 0x7c, 0xba, 0xcb, 0x7f,
+		0x78, 0x84, 0xe5, 0xbb, 0xb8, 0xc7, 0x12, 0x4a, 0xd3, 0x17, 0x92, 0xc4, 0x26, 0xa2, 0xff, 0x96,
+		0xa0, 0xb4, 0xef, 0x5b, 0x82, 0x6e, 0x2d, 0x21, 0x7f, 0x2d, 0xdf, 0x27, 0x41, 0x42, 0xcc, 0x09,
+		0xf2, 0xa4, 0x3f, 0xd6, 0x4d, 0x1c, 0x6d, 0x8e, 0xd5, 0x47, 0x9c, 0xbe, 0x87, 0x32, 0x1c, 0xe2,
+		0x1d, 0xde, 0x6f, 0x88, 0x37, 0xb7, 0xec, 0xfe, 0x4a, 0x02, 0x79, 0x70, 0xc6, 0xbe, 0xe5, 0xd6,
+		0x0d, 0x2f, 0x73, 0xe1, 0x11, 0xcb, 0xdc, 0xb8, 0xb8, 0xed, 0xc8, 0xb8, 0xb8, 0xed, 0xe1, 0x5e,
+		0x47, 0x6f, 0xb1, 0xd7, 0x1f, 0x08, 0x41, 0xca, 0xe7, 0x1e, 0x25, 0x8f, 0x04, 0x62, 0xc0, 0xe7,
+		0xf7, 0x72, 0xa5, 0xfa, 0x82, 0xc0, 0x03, 0x6c, 0x0a, 0xed, 0x9f, 0x4d, 0x6f, 0xff, 0x7d, 0xb0,
+		0xd1, 0x57, 0x66, 0xa3, 0x63, 0xae, 0xcc, 0x7e, 0xbb, 0x04, 0x09, 0xd7, 0xec, 0xde, 0xef, 0x21,
+		0xe6, 0x21, 0x88, 0x71, 0xcb, 0x92, 0x9d, 0x62, 0xf2, 0xd4, 0x48, 0xb7, 0x72, 0x01, 0x12, 0x1d,
+		0xc3, 0xd1, 0xa9, 0x1e, 0x64, 0xab, 0x9a, 0x9b, 0x3e, 0x79, 0x0e, 0x52, 0xbe, 0x03, 0x60, 0x54,
+		0x8d, 0xb5, 0xca, 0xb3, 0xf2, 0x81, 0x42, 0xfc, 0x95, 0x9b, 0xf3, 0xe1, 0x9a, 0x71, 0x1d, 0x67,
+		0xb3, 0x5a, 0x29, 0x5f, 0xac, 0x94, 0x9f, 0x96, 0xa5, 0x42, 0xea, 0x95, 0x9b, 0xf3, 0x71, 0xd5,
+		0xa0, 0x1e, 0xc5, 0x93, 0x4f, 0x43, 0x6e, 0x60, 0x60, 0x82, 0x66, 0x0b, 0x81, 0xec, 0xd2, 0x06,
+		0x7e, 0xf6, 0xab, 0xb8, 0x5e, 0xd1, 0x58, 0xa4, 0x30, 0x7e, 0xb8, 0x64, 0xb9, 0x7a, 0xe1, 0xe2,
+		0xba, 0x56, 0x5e, 0xae, 0x56, 0x6a, 0xeb, 0x5a, 0x71, 0x7d, 0xbd, 0x58, 0x7e, 0x5a, 0x0e, 0x9d,
+		0xbe, 0x99, 0x82, 0x08, 0x86, 0xf3, 0x91, 0x32, 0x44, 0xa8, 0x2b, 0x64, 0xcf, 0x08, 0xb0, 0xc2,
+		0xde, 0xbe, 0x61, 0x72, 0x1e, 0xa2, 0xd4, 0x4b, 0x42, 0xf6, 0x0e, 0x09, 0x2b, 0x4c, 0x70, 0x16,
+		0x63, 0x63, 0xe8, 0x8c, 0xdc, 0x33, 0x46, 0xac, 0xb0, 0xb7, 0xef, 0x98, 0x2c, 0x43, 0x5c, 0x6c,
+		0x92, 0x27, 0x05, 0x6e, 0x15, 0x26, 0x3a, 0x74, 0xb1, 0x6b, 0xcc, 0xd9, 0xb0, 0x77, 0xf8, 0x58,
+		0x61, 0x82, 0x57, 0x19, 0x1f, 0x14, 0xe1, 0xdb, 0xd1, 0x09, 0x11, 0x61, 0x85, 0x49, 0x7e, 0x62,
+		0xa2, 0x42, 0xd2, 0x73, 0xe3, 0x4c, 0x0e, 0x8a, 0x2b, 0x4c, 0xe1, 0x30, 0x27, 0xef, 0x83, 0x4c,
+		0x70, 0xab, 0x3b, 0x5d, 0xd4, 0x59, 0x61, 0x4a, 0x8f, 0x34, 0xd2, 0x0f, 0xee, 0x7b, 0xa7, 0x8b,
+		0x42, 0x2b, 0x4c, 0xe9, 0xa0, 0x26, 0x2f, 0xc0, 0xcc, 0xf0, 0xbe, 0x74, 0xfa, 0xa0, 0xb4, 0xc2,
+		0x3e, 0x5c, 0xd6, 0xa4, 0x03, 0x64, 0xc4, 0x7e, 0x76, 0x1f, 0x31, 0x6a, 0x85, 0xfd, 0x78, 0xb0,
+		0x49, 0x13, 0x72, 0x83, 0x9b, 0xc4, 0x69, 0x63, 0xd6, 0x0a, 0x53, 0x7b, 0xb3, 0x59, 0x2d, 0xc1,
+		0xcd, 0xe5, 0xb4, 0x31, 0x6c, 0x85, 0xa9, 0x9d, 0xdb, 0x64, 0x03, 0xc0, 0xb7, 0x3f, 0x9c, 0x22,
+		0xa6, 0xad, 0x30, 0x8d, 0x9b, 0x9b, 0x74, 0x61, 0x76, 0xd4, 0xc6, 0x71, 0x3f, 0x21, 0x6e, 0x85,
+		0x7d, 0x79, 0xbf, 0x51, 0x9e, 0x83, 0x5b, 0xc0, 0xe9, 0x42, 0xde, 0x0a, 0x53, 0xba, 0xc1, 0x4b,
+		0xc5, 0xb1, 0x71, 0xce, 0xf7, 0xec, 0x19, 0xe7, 0xec, 0x45, 0x2e, 0xbb, 0xb1, 0xcd, 0xaf, 0x3f,
+		0x09, 0xef, 0xe1, 0x6f, 0xf6, 0xd8, 0x8e, 0x7e, 0xb5, 0x65, 0x6e, 0xbb, 0x0f, 0x3b, 0xf1, 0x34,
+		0x0f, 0x72, 0x3e, 0xc4, 0xa0, 0x16, 0x44, 0xee, 0x84, 0xe7, 0x9d, 0xc6, 0xbe, 0x0a, 0x3a, 0xe9,
+		0x06, 0xc6, 0xe4, 0xf0, 0xe5, 0x3d, 0x9e, 0x8e, 0x9a, 0xf0, 0x40, 0xd5, 0x88, 0xa7, 0xa5, 0x0a,
+		0x7b, 0xbe, 0x6a, 0x50, 0xd8, 0x2b, 0xee, 0x1b, 0xad, 0xd8, 0xec, 0xc5, 0x96, 0xed, 0x58, 0xbd,
+		0x56, 0x43, 0x6f, 0xd3, 0xe5, 0xe5, 0xf1, 0x69, 0xef, 0x81, 0x95, 0x92, 0x68, 0xae, 0xf0, 0xd7,
+		0xa7, 0x18, 0x0a, 0x59, 0x82, 0xd8, 0x35, 0xbd, 0xcd, 0x6e, 0x61, 0xf9, 0x9f, 0xaf, 0x1b, 0xe4,
+		0xb9, 0xcf, 0x8e, 0xf2, 0x53, 0x61, 0xb8, 0xca, 0xf7, 0xd3, 0x1b, 0x2c, 0x9d, 0x4e, 0xcb, 0x46,
+		0x59, 0x54, 0xe9, 0x56, 0xfa, 0x12, 0x44, 0x7a, 0xba, 0xc3, 0x37, 0x98, 0xa5, 0xb3, 0xfb, 0x7e,
+		0x09, 0x8a, 0xd5, 0x40, 0x69, 0x90, 0x67, 0x00, 0x2f, 0x30, 0x68, 0x94, 0x5e, 0xe8, 0x2d, 0xd1,
+		0xc3, 0x7b, 0x1c, 0xd8, 0x3e, 0xf2, 0x3e, 0xfa, 0x6d, 0x46, 0x3c, 0xc7, 0x35, 0xb7, 0x0d, 0x46,
+		0x39, 0xfc, 0x96, 0x28, 0x67, 0x3a, 0xfa, 0x8d, 0x32, 0xa5, 0x86, 0xf4, 0xf9, 0x23, 0x5b, 0xbf,
+		0x21, 0x01, 0x78, 0x8c, 0x21, 0x3a, 0x1e, 0x71, 0x88, 0x14, 0xad, 0x54, 0x1c, 0x71, 0xdc, 0x33,
+		0x8e, 0xef, 0x03, 0x6c, 0x2d, 0x65, 0xb0, 0x79, 0x9f, 0x7f, 0xed, 0xb8, 0xc4, 0x6a, 0xcd, 0x35,
+		0x86, 0xd8, 0x9e, 0x62, 0xce, 0x10, 0x8d, 0xda, 0xb0, 0xa1, 0x89, 0x36, 0x6c, 0x46, 0xd8, 0xb0,
+		0x8c, 0x20, 0x30, 0x6c, 0x2c, 0xe7, 0x7d, 0xf8, 0xb4, 0x04, 0xa9, 0x25, 0xdf, 0x53, 0x9b, 0x78,
+		0x46, 0x65, 0x99, 0xad, 0xab, 0x46, 0xcf, 0x3d, 0xa3, 0x62, 0x49, 0xb4, 0x33, 0xd9, 0xd7, 0xa7,
+		0x9d, 0x5d, 0xf1, 0x9e, 0x91, 0x48, 0x23, 0xd6, 0x75, 0x63, 0xd3, 0x6e, 0x09, 0x3e, 0xab, 0x22,
+		0x89, 0x01, 0x07, 0xb6, 0xd1, 0xe8, 0xa3, 0xeb, 0x1c, 0xbf, 0xee, 0xef, 0xe0, 0x47, 0xe8, 0xd9,
+		0x3e, 0x3c, 0x27, 0xf2, 0xcb, 0x2c, 0x1b, 0x89, 0x34, 0x0d, 0x47, 0x6f, 0xb5, 0x59, 0x64, 0x66,
+		0x52, 0x15, 0x49, 0xde, 0xd4, 0x9b, 0x71, 0xff, 0x3e, 0xb4, 0x0c, 0xb2, 0xd5, 0x35, 0x7a, 0x81,
+		0x80, 0x14, 0x26, 0x8d, 0xf9, 0xdf, 0xfb, 0xdc, 0x03, 0x73, 0x9c, 0xe1, 0x3c, 0x98, 0x81, 0x7d,
+		0x59, 0x42, 0xcd, 0x09, 0x0c, 0x9e, 0x4d, 0xae, 0x04, 0x4e, 0xa5, 0xfa, 0x9b, 0xde, 0xc3, 0x35,
+		0x73, 0x43, 0x4c, 0x2d, 0x9a, 0xbb, 0xa5, 0xfc, 0x6f, 0x7b, 0xa4, 0xbd, 0x7d, 0x2a, 0x3e, 0x15,
+		0xe3, 0x3b, 0xa2, 0xa2, 0x64, 0xd0, 0x84, 0x7f, 0x41, 0x6f, 0xb5, 0x8d, 0x26, 0x77, 0x6b, 0xf0,
+		0x14, 0x59, 0x74, 0xdd, 0x8b, 0xec, 0x83, 0xa3, 0xca, 0x38, 0xd9, 0x28, 0x59, 0x66, 0x33, 0xe8,
+		0x47, 0x24, 0x65, 0x88, 0x39, 0xd6, 0x55, 0xc3, 0xe4, 0x0c, 0xda, 0xdf, 0x63, 0x6e, 0x1c, 0x95,
+		0x7c, 0x33, 0xc8, 0x4d, 0xa3, 0x6d, 0x6c, 0x53, 0xce, 0xd9, 0x3b, 0x3a, 0xde, 0x97, 0x8e, 0xdd,
+		0xea, 0x83, 0x6e, 0x39, 0x97, 0x54, 0x9d, 0x52, 0x22, 0x6b, 0xc1, 0xc7, 0x5c, 0xe3, 0xfc, 0xf0,
+		0x78, 0x4c, 0x1f, 0x7d, 0x92, 0xe7, 0xd7, 0x3c, 0x7e, 0x12, 0x28, 0x41, 0x7d, 0x73, 0xd3, 0x32,
+		0xe9, 0xd7, 0x33, 0xf9, 0x2e, 0x2a, 0xc1, 0x4e, 0x2a, 0xdd, 0x7c, 0x7e, 0x52, 0xb9, 0x06, 0x59,
+		0x0f, 0x94, 0xce, 0x90, 0xe4, 0x7e, 0x67, 0x48, 0xc6, 0x25, 0x80, 0x20, 0xf8, 0x99, 0x4d, 0x6f,
+		0x0e, 0xe6, 0x81, 0x47, 0x84, 0x4c, 0x9c, 0xcd, 0xfe, 0xce, 0xf8, 0x08, 0x10, 0x13, 0x66, 0x3b,
+		0x2d, 0x53, 0xb3, 0x8d, 0xf6, 0x96, 0xc6, 0x39, 0x87, 0x74, 0x53, 0x94, 0xfd, 0x4f, 0xee, 0x63,
+		0x34, 0xff, 0xe0, 0x73, 0x0f, 0xe4, 0xbc, 0x27, 0xf1, 0xe6, 0x1f, 0x5c, 0x78, 0xe4, 0xac, 0x3a,
+		0xd3, 0x69, 0x99, 0x75, 0xa3, 0xbd, 0xb5, 0xe4, 0x12, 0x26, 0xef, 0x85, 0xa3, 0x1e, 0x43, 0x2c,
+		0x53, 0xdb, 0xb1, 0xda, 0x4d, 0xad, 0x67, 0x6c, 0x69, 0x0d, 0xfa, 0xd8, 0x60, 0x9a, 0xb2, 0xf1,
+		0xb0, 0x0b, 0xb2, 0x6a, 0x5e, 0xb4, 0xda, 0x4d, 0xd5, 0xd8, 0x2a, 0x63, 0x31, 0x9e, 0x64, 0x79,
+		0xd8, 0xad, 0x26, 0x86, 0x85, 0x84, 0x31, 0x36, 0xdd, 0xcd, 0xac, 0x36, 0xed, 0xc5, 0xc4, 0x87,
+		0x3e, 0x71, 0xfc, 0xc0, 0x1b, 0x9f, 0x38, 0x7e, 0x40, 0x39, 0x4f, 0x1f, 0xb6, 0xe2, 0x53, 0xcb,
+		0xb0, 0xc9, 0x59, 0x48, 0xea, 0x22, 0xc1, 0x6e, 0x9d, 0xed, 0x31, 0x35, 0x3d, 0x50, 0xbc, 0x70,
+		0x17, 0x5b, 0xba, 0xbc, 0xa6, 0xb7, 0x7a, 0xa4, 0x82, 0x4f, 0xb5, 0x0a, 0x59, 0x9d, 0x76, 0x96,
+		0x7b, 0xe2, 0x2d, 0xa6, 0x79, 0x6d, 0x5c, 0xf4, 0x5a, 0xb2, 0x74, 0xc7, 0xef, 0x7d, 0xee, 0x81,
+		0xdb, 0x39, 0x99, 0xcb, 0x03, 0x81, 0x6c, 0x82, 0xde, 0x60, 0x80, 0x9b, 0xaf, 0xcf, 0x97, 0x20,
+		0xce, 0x9a, 0x6a, 0x93, 0xa7, 0x20, 0xda, 0xc5, 0x1f, 0x79, 0x29, 0xf0, 0x7a, 0xeb, 0xb0, 0xcc,
+		0x53, 0x78, 0xbf, 0x84, 0x30, 0x3c, 0xe5, 0xc3, 0x21, 0x80, 0xa5, 0xcb, 0x97, 0xd7, 0x7b, 0xad,
+		0x6e, 0xdb, 0x70, 0xde, 0xae, 0xbe, 0x6f, 0xc0, 0x41, 0xaf, 0xef, 0x76, 0xaf, 0xb1, 0xff, 0xfe,
+		0xcf, 0x7a, 0x77, 0xb3, 0x7b, 0x8d, 0x91, 0x64, 0x9b, 0xb6, 0xe3, 0x92, 0x0d, 0xef, 0x9f, 0xec,
+		0x92, 0xed, 0x0c, 0x73, 0xf6, 0x39, 0x48, 0x79, 0xcc, 0xb0, 0x49, 0x15, 0x12, 0x0e, 0xff, 0xcd,
+		0x19, 0xac, 0x8c, 0x67, 0xb0, 0x40, 0xf3, 0x33, 0xd9, 0x45, 0x57, 0x3e, 0x23, 0xc1, 0xec, 0x86,
+		0x10, 0xe1, 0x67, 0xfa, 0x46, 0xdf, 0xa8, 0xb7, 0x5b, 0x0d, 0x83, 0xa8, 0x80, 0xeb, 0x2d, 0xc2,
+		0xe0, 0xfa, 0x3d, 0xe5, 0xbd, 0x88, 0x01, 0xf5, 0x91, 0xf5, 0x28, 0x50, 0xfd, 0xe1, 0x0a, 0x45,
+		0xe8, 0x16, 0x85, 0xe2, 0x97, 0x24, 0x0c, 0x5e, 0xf6, 0x74, 0xc5, 0x3b, 0xdc, 0x5e, 0x3f, 0x9b,
+		0x43, 0x6f, 0x8d, 0xcd, 0x2f, 0x87, 0x21, 0xbd, 0x66, 0x50, 0x26, 0x57, 0x4c, 0xa7, 0xb7, 0x3b,
+		0x5e, 0x12, 0xa5, 0x77, 0x46, 0x12, 0x43, 0x6f, 0x45, 0x12, 0xf1, 0x69, 0x9c, 0x46, 0xcf, 0xa0,
+		0x3c, 0x0f, 0x1e, 0xe4, 0x67, 0x45, 0x36, 0x5f, 0x74, 0x46, 0x0c, 0x43, 0xe4, 0xad, 0x0e, 0x43,
+		0x05, 0xe2, 0x9b, 0x7a, 0x5b, 0xc7, 0xab, 0xde, 0xb7, 0xb0, 0xd2, 0x0b, 0x5c, 0xe5, 0x6f, 0x24,
+		0x00, 0xdf, 0x6a, 0xf0, 0xee, 0xd4, 0xa6, 0xe8, 0xab, 0xe2, 0x66, 0x48, 0xf8, 0x96, 0x1f, 0xbf,
+		0x65, 0x04, 0x7c, 0xea, 0xe3, 0x23, 0x21, 0xdf, 0x24, 0x7f, 0xf7, 0xf3, 0x60, 0x03, 0xe2, 0x86,
+		0xe9, 0xf4, 0x5a, 0xee, 0x01, 0xea, 0x83, 0xe3, 0xa6, 0xdd, 0x88, 0x4e, 0xd1, 0xe9, 0xe5, 0x9f,
+		0x84, 0x82, 0x96, 0x8f, 0x1f, 0x1f, 0x0f, 0x43, 0x7e, 0x1c, 0xea, 0x28, 0x59, 0x97, 0xa6, 0x95,
+		0xf5, 0xd0, 0x5b, 0x95, 0xf5, 0x75, 0xc8, 0x89, 0x0b, 0x57, 0x42, 0xe6, 0xc3, 0xfb, 0x97, 0x79,
+		0x71, 0x69, 0xab, 0xc4, 0x48, 0xf8, 0x67, 0x50, 0xe4, 0xd6, 0x67, 0x10, 0x5e, 0xac, 0xf3, 0x9b,
+		0x40, 0x74, 0x36, 0x46, 0xd4, 0x94, 0x9b, 0x57, 0x6d, 0x4e, 0xb2, 0xb1, 0x62, 0x7b, 0xda, 0x58,
+		0x7c, 0x6b, 0xf3, 0xa3, 0xf4, 0x5e, 0x44, 0xf3, 0x6f, 0xff, 0xb0, 0xac, 0x01, 0xb0, 0xa9, 0x8a,
+		0x9a, 0x3a, 0x1f, 0xb9, 0xd5, 0xf9, 0x9e, 0x64, 0x44, 0x96, 0x6c, 0xe7, 0x1b, 0x35, 0x42, 0x7f,
+		0x18, 0x82, 0xb4, 0x7f, 0x84, 0xfe, 0x4e, 0x9a, 0x67, 0xa4, 0xe6, 0xa9, 0x29, 0x76, 0x77, 0xec,
+		0xde, 0x71, 0x6a, 0x6a, 0x48, 0x9a, 0x27, 0xe8, 0xa7, 0x8f, 0xc5, 0x21, 0xc6, 0x83, 0x3b, 0x57,
+		0x87, 0x76, 0x71, 0xd2, 0xa4, 0xe7, 0x32, 0x32, 0xe2, 0xb9, 0x8c, 0x91, 0x9b, 0xb8, 0xbb, 0x00,
+		0x1f, 0xd1, 0xd0, 0x02, 0x11, 0xa3, 0x78, 0xaa, 0x86, 0x4e, 0x1d, 0xb7, 0xf7, 0x36, 0x06, 0xcd,
+		0x22, 0x98, 0xa7, 0x87, 0x11, 0x06, 0xaf, 0xe9, 0x55, 0x58, 0x0e, 0x79, 0x00, 0xc8, 0x8e, 0xeb,
+		0x9d, 0xd3, 0x3c, 0x46, 0x20, 0xdc, 0x8c, 0x57, 0x22, 0xc0, 0xf1, 0xc1, 0x21, 0xcb, 0x6c, 0x6a,
+		0xec, 0xbd, 0x77, 0xfe, 0x9a, 0x3e, 0xe6, 0x2c, 0x61, 0x06, 0xf9, 0xa0, 0xc4, 0x36, 0x83, 0x03,
+		0x3e, 0x23, 0xbe, 0x17, 0x5f, 0x9f, 0x62, 0x52, 0x7c, 0xf5, 0xb5, 0xe3, 0x85, 0x5d, 0xbd, 0xd3,
+		0x5e, 0x54, 0x46, 0xd0, 0x51, 0x46, 0xb9, 0xb1, 0x70, 0x8b, 0x18, 0xf4, 0x39, 0x91, 0x1a, 0xc8,
+		0xf8, 0x36, 0x48, 0xcf, 0x72, 0x98, 0xa2, 0xd9, 0x32, 0x0c, 0xbe, 0x6b, 0x3f, 0xb2, 0x30, 0xe2,
+		0xf5, 0xfd, 0x05, 0x7c, 0xc5, 0xde, 0x3f, 0x96, 0xd9, 0xab, 0xc6, 0xae, 0xca, 0x91, 0xcf, 0x1b,
+		0x06, 0x79, 0x11, 0x8e, 0x6c, 0xb7, 0xad, 0x4d, 0xbc, 0xba, 0xd8, 0x7a, 0xb1, 0xdf, 0x6a, 0x6a,
+		0x5c, 0x32, 0xb4, 0x86, 0xde, 0xcd, 0x27, 0xde, 0x92, 0x13, 0xee, 0x10, 0x23, 0xbc, 0x4c, 0xe9,
+		0xd6, 0x19, 0xd9, 0xb2, 0xde, 0x25, 0xd7, 0xe1, 0x36, 0x4f, 0xd8, 0x47, 0xd4, 0x9a, 0x7c, 0x4b,
+		0xb5, 0x1e, 0x71, 0x69, 0x0f, 0x55, 0xac, 0xc2, 0xdd, 0x7a, 0xbb, 0x6d, 0x5d, 0xd7, 0x5a, 0xa6,
+		0xed, 0xe8, 0xa6, 0xa3, 0xf5, 0x7c, 0x52, 0xaf, 0x6d, 0xf5, 0xac, 0x8e, 0xc6, 0x7d, 0x40, 0x40,
+		0x7d, 0x40, 0x0a, 0x85, 0xae, 0x32, 0x60, 0xff, 0x0c, 0x39, 0xdf, 0xb3, 0x3a, 0x97, 0x28, 0x24,
+		0xd9, 0x82, 0x59, 0xea, 0xba, 0x1c, 0x90, 0x8a, 0xd4, 0x5b, 0xea, 0xc3, 0x0c, 0xba, 0x2f, 0x03,
+		0xe3, 0xbe, 0xf8, 0x1e, 0x54, 0x6b, 0xaf, 0xbc, 0xfe, 0xb3, 0x27, 0x8f, 0xfa, 0x9e, 0xd6, 0xbf,
+		0xe1, 0xfa, 0xec, 0xd9, 0x5c, 0xc4, 0xbd, 0x38, 0xf1, 0xac, 0x05, 0xf7, 0x3a, 0xd0, 0x0a, 0x80,
+		0xd7, 0x78, 0xf7, 0x3e, 0xd0, 0x58, 0x27, 0x8f, 0x80, 0x0c, 0xb8, 0x45, 0x7c, 0xba, 0xf4, 0x49,
+		0x6f, 0xb1, 0x0e, 0xed, 0x43, 0xf4, 0x04, 0x12, 0x55, 0xd1, 0x07, 0x94, 0x7f, 0x27, 0x61, 0xd4,
+		0xe8, 0x80, 0xda, 0x71, 0x9b, 0xdc, 0x00, 0x12, 0x18, 0x1d, 0x9c, 0xbe, 0x22, 0xfe, 0xe4, 0xd6,
+		0xb4, 0xd8, 0x4c, 0x6f, 0xb0, 0xf4, 0x6d, 0xb2, 0x3a, 0xf8, 0x92, 0xf3, 0x5b, 0x12, 0xde, 0x83,
+		0x6b, 0x0e, 0x73, 0xbf, 0x0e, 0x69, 0x7f, 0xd5, 0xbc, 0x13, 0xef, 0x99, 0xa6, 0x13, 0xfe, 0xf6,
+		0x07, 0x88, 0x90, 0xcb, 0x9e, 0x6a, 0x67, 0x1b, 0xbf, 0x87, 0xa6, 0x66, 0x8a, 0x68, 0xd8, 0x48,
+		0x15, 0xcf, 0xc6, 0xe6, 0x4f, 0x25, 0x88, 0xac, 0x59, 0x56, 0x9b, 0xbc, 0x08, 0x33, 0xa6, 0xe5,
+		0x68, 0xa8, 0x06, 0x8d, 0xa6, 0xc6, 0xbd, 0x99, 0x6c, 0xd9, 0xac, 0xec, 0xc9, 0xab, 0x2f, 0xbf,
+		0x76, 0x7c, 0x18, 0x73, 0xd4, 0xa7, 0x37, 0x72, 0xa6, 0xe5, 0x94, 0x28, 0xd0, 0x3a, 0x85, 0x21,
+		0x5b, 0x90, 0x09, 0x56, 0xc7, 0x96, 0xd6, 0xe2, 0xa4, 0xea, 0x32, 0x13, 0xab, 0x4a, 0x6f, 0xfa,
+		0xea, 0x61, 0xaf, 0xd5, 0xff, 0x05, 0x8e, 0xdc, 0x15, 0x90, 0x2f, 0x0f, 0xc6, 0x35, 0x56, 0x20,
+		0x2e, 0xe2, 0x18, 0xa5, 0x29, 0x43, 0x24, 0xfd, 0xec, 0xe4, 0xb8, 0xca, 0x2f, 0x48, 0x70, 0x10,
+		0xc3, 0x1d, 0x9f, 0x36, 0x76, 0xc5, 0xa3, 0x54, 0x95, 0x1b, 0xdd, 0x56, 0x6f, 0x77, 0xf4, 0x15,
+		0x4d, 0x69, 0xcc, 0x15, 0xcd, 0x4b, 0x78, 0xc7, 0x07, 0xd1, 0x6e, 0xf5, 0x20, 0x81, 0x61, 0xd3,
+		0xe5, 0x15, 0x9f, 0xf4, 0x65, 0xb4, 0x82, 0x01, 0xef, 0x2c, 0x93, 0xbf, 0x97, 0xf0, 0x87, 0x12,
+		0xcc, 0x52, 0x3e, 0xb5, 0x5e, 0x32, 0xa8, 0xab, 0x58, 0x35, 0x1a, 0x56, 0xaf, 0x89, 0x81, 0x6a,
+		0xfc, 0x59, 0xbf, 0x88, 0x1a, 0x6a, 0x35, 0xf1, 0xe5, 0x3b, 0xeb, 0xba, 0xc9, 0xc3, 0xbb, 0xf6,
+		0x32, 0xa5, 0x18, 0x18, 0x79, 0x0a, 0xb2, 0x1d, 0xab, 0xd9, 0x6f, 0x1b, 0x9a, 0xde, 0x60, 0xe6,
+		0x5c, 0x78, 0x02, 0x62, 0x86, 0xc1, 0x17, 0x19, 0x78, 0xf0, 0x61, 0xb4, 0xc8, 0xb4, 0xd6, 0x91,
+		0x87, 0xc3, 0x27, 0xeb, 0x87, 0x42, 0x90, 0xf7, 0x34, 0x2c, 0x3b, 0x2a, 0xaa, 0x37, 0x76, 0x0c,
+		0xac, 0x6a, 0xf4, 0x6e, 0x51, 0xba, 0xf5, 0xdd, 0xa2, 0x38, 0x7d, 0x0b, 0xbd, 0x0d, 0xa7, 0x6f,
+		0x6b, 0x90, 0x35, 0xb6, 0xb6, 0x8c, 0x86, 0xd3, 0xba, 0xc6, 0x4f, 0x95, 0xc2, 0xfb, 0xf6, 0x99,
+		0xbb, 0x04, 0x10, 0xe4, 0xe4, 0x2f, 0x4a, 0x00, 0xde, 0xe1, 0x05, 0xbe, 0x2d, 0x58, 0x5a, 0xad,
+		0x2d, 0x69, 0xf5, 0xf5, 0xe2, 0xfa, 0x46, 0x3d, 0xf8, 0xd5, 0x35, 0xf1, 0x12, 0xa1, 0xdd, 0x35,
+		0x1a, 0xad, 0xad, 0x96, 0xd1, 0x24, 0x77, 0xc3, 0x5c, 0x10, 0x1a, 0x53, 0x95, 0x25, 0x59, 0x2a,
+		0xa4, 0x5f, 0xb9, 0x39, 0x9f, 0x60, 0x5b, 0x58, 0x03, 0xdf, 0xd5, 0x3e, 0x38, 0x0c, 0x87, 0x5f,
+		0x6c, 0x0b, 0x15, 0x32, 0xaf, 0xdc, 0x9c, 0x4f, 0xba, 0x7b, 0x5d, 0xa2, 0x00, 0xf1, 0x43, 0x72,
+		0x7a, 0xe1, 0x02, 0xbc, 0x72, 0x73, 0x3e, 0xc6, 0x14, 0x45, 0x21, 0x82, 0xef, 0x0d, 0x9e, 0xfc,
+		0x16, 0x80, 0xaa, 0xb9, 0xd5, 0xd3, 0x1b, 0x54, 0x21, 0x16, 0xe0, 0x50, 0xb5, 0x76, 0x5e, 0x2d,
+		0x96, 0xd7, 0xab, 0xab, 0xb5, 0x60, 0xb3, 0x07, 0xca, 0x96, 0x56, 0x37, 0x4a, 0xcb, 0x15, 0x0d,
+		0x5f, 0x38, 0x64, 0x41, 0x50, 0x81, 0xb2, 0x67, 0x6b, 0xeb, 0xd5, 0x95, 0x8a, 0x1c, 0x2a, 0x9d,
+		0x1f, 0x7b, 0xc8, 0x7e, 0xff, 0x9e, 0xdf, 0xbb, 0xf1, 0x16, 0xe5, 0xc0, 0x49, 0xfb, 0xff, 0x1e,
+		0x00, 0x41, 0xef, 0x24, 0x26, 0x91, 0xb9, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2534,6 +2884,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if this.AllowInstantRedelegationFromJailed != that1.AllowInstantRedelegationFromJailed {
+		return false
+	}
+	if !this.MaxCommissionRate.Equal(that1.MaxCommissionRate) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2590,6 +2952,39 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3119,7 +3514,7 @@ func (m *DVVTriplets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Delegation) Marshal() (dAtA []byte, err error) {
+func (m *UnbondingQueueSlice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3129,27 +3524,177 @@ func (m *Delegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnbondingQueueSlice) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnbondingQueueSlice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Shares.Size()
-		i -= size
-		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationQueueSlice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationQueueSlice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationQueueSlice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Triplets) > 0 {
+		for iNdEx := len(m.Triplets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Triplets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	if m.CreationHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
 		copy(dAtA[i:], m.ValidatorAddress)
 		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
@@ -3267,12 +3812,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err10 != nil {
-		return 0, err10
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3333,12 +3878,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err11 != nil {
-		return 0, err11
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n11
-	i = encodeVarintStaking(dAtA, i, uint64(n11))
+	i -= n14
+	i = encodeVarintStaking(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3427,6 +3972,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxCommissionRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.AllowInstantRedelegationFromJailed {
+		i--
+		if m.AllowInstantRedelegationFromJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3469,12 +4054,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err13 != nil {
-		return 0, err13
+	n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n13
-	i = encodeVarintStaking(dAtA, i, uint64(n13))
+	i -= n16
+	i = encodeVarintStaking(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3718,12 +4303,12 @@ func (m *ConsKeyEvidenceExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
-	if err18 != nil {
-		return 0, err18
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
+	if err21 != nil {
+		return 0, err21
 	}
-	i -= n18
-	i = encodeVarintStaking(dAtA, i, uint64(n18))
+	i -= n21
+	i = encodeVarintStaking(dAtA, i, uint64(n21))
 	i--
 	dAtA[i] = 0x12
 	if len(m.ValidatorAddress) > 0 {
@@ -3736,42 +4321,139 @@ func (m *ConsKeyEvidenceExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommissionChangeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *CommissionChangeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionChangeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintStaking(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	l = m.MaxRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.MaxChangeRate.Size()
@@ -3952,6 +4634,64 @@ func (m *DVVTriplets) Size() (n int) {
 	return n
 }
 
+func (m *UnbondingQueueSlice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RedelegationQueueSlice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Triplets) > 0 {
+		for _, e := range m.Triplets {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func (m *Delegation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4094,6 +4834,15 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.AllowInstantRedelegationFromJailed {
+		n += 2
+	}
+	l = m.MaxCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4186,6 +4935,47 @@ func (m *ConsKeyEvidenceExpiry) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *CommissionChangeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5769,7 +6559,7 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *UnbondingQueueSlice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5792,17 +6582,17 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingQueueSlice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingQueueSlice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5812,61 +6602,30 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5876,23 +6635,23 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pairs = append(m.Pairs, DVPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5917,7 +6676,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *RedelegationQueueSlice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5940,17 +6699,17 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationQueueSlice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationQueueSlice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5960,29 +6719,30 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5992,55 +6752,23 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Triplets = append(m.Triplets, DVVTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6065,7 +6793,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *PendingEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6088,17 +6816,17 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
-			m.CreationHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6108,16 +6836,29 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6127,30 +6868,48 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6160,27 +6919,26 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
@@ -6214,44 +6972,6 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
-			}
-			m.UnbondingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
-			}
-			m.UnbondingOnHoldRefCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6273,7 +6993,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *Delegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6296,36 +7016,17 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6335,28 +7036,27 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6384,13 +7084,11 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6418,48 +7116,10 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
-			}
-			m.UnbondingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
-			}
-			m.UnbondingOnHoldRefCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6481,7 +7141,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6504,10 +7164,10 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6544,7 +7204,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6572,13 +7232,13 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6588,13 +7248,916 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
 			postIndex := iNdEx + intStringLen
@@ -6604,13 +8167,35 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowInstantRedelegationFromJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowInstantRedelegationFromJailed = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6620,23 +8205,23 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6661,7 +8246,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6684,15 +8269,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6719,15 +8304,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			m.MaxValidators = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6737,35 +8322,80 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			m.MaxEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.HistoricalEntries = 0
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6775,14 +8405,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6810,13 +8454,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6826,29 +8522,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6875,7 +8570,8 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, RedelegationEntryResponse{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6900,7 +8596,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6923,17 +8619,17 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6943,30 +8639,31 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6976,22 +8673,23 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7016,7 +8714,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7039,15 +8737,15 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7074,41 +8772,8 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Updates = append(m.Updates, types2.ValidatorUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7133,7 +8798,7 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *ConsKeyEvidenceExpiry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7156,17 +8821,17 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsKeyEvidenceExpiry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsKeyEvidenceExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7176,28 +8841,29 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7224,11 +8890,29 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntryResponse{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -7250,7 +8934,7 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7273,15 +8957,34 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7309,13 +9012,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7343,65 +9044,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7411,25 +9060,23 @@ func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, types2.ValidatorUpdate{})
-			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7452,7 +9099,7 @@ func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsKeyEvidenceExpiry) Unmarshal(dAtA []byte) error {
+func (m *CommissionChangeSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7475,17 +9122,17 @@ func (m *ConsKeyEvidenceExpiry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsKeyEvidenceExpiry: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionChangeSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsKeyEvidenceExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionChangeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7495,31 +9142,29 @@ func (m *ConsKeyEvidenceExpiry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorAddress == nil {
-				m.ValidatorAddress = []byte{}
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7529,30 +9174,31 @@ func (m *ConsKeyEvidenceExpiry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
-			m.ExpiryHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7562,11 +9208,25 @@ func (m *ConsKeyEvidenceExpiry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiryHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
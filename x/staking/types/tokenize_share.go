@@ -0,0 +1,20 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TokenizeShareRecordAddress returns the address of the module account holding
+// the delegation of a tokenize share record.
+func TokenizeShareRecordAddress(id uint64) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("tokenize_share_record"), sdk.Uint64ToBigEndian(id))
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator), r.Id)
+}
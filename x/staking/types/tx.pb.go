@@ -716,6 +716,263 @@ func (m *MsgRotateConsPubKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRotateConsPubKeyResponse proto.InternalMessageInfo
 
+// MsgTokenizeShares defines a SDK message for converting an amount of a
+// delegation into share tokens.
+type MsgTokenizeShares struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// tokenized_share_owner is the address receiving the rewards of the
+	// tokenized delegation.
+	TokenizedShareOwner string `protobuf:"bytes,4,opt,name=tokenized_share_owner,json=tokenizedShareOwner,proto3" json:"tokenized_share_owner,omitempty"`
+}
+
+func (m *MsgTokenizeShares) Reset()         { *m = MsgTokenizeShares{} }
+func (m *MsgTokenizeShares) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeShares) ProtoMessage()    {}
+func (*MsgTokenizeShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{16}
+}
+func (m *MsgTokenizeShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenizeShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenizeShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenizeShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeShares.Merge(m, src)
+}
+func (m *MsgTokenizeShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenizeShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenizeShares proto.InternalMessageInfo
+
+// MsgTokenizeSharesResponse defines the Msg/TokenizeShares response type.
+type MsgTokenizeSharesResponse struct {
+	// amount is the amount of minted share tokens.
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgTokenizeSharesResponse) Reset()         { *m = MsgTokenizeSharesResponse{} }
+func (m *MsgTokenizeSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeSharesResponse) ProtoMessage()    {}
+func (*MsgTokenizeSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{17}
+}
+func (m *MsgTokenizeSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenizeSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenizeSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenizeSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeSharesResponse.Merge(m, src)
+}
+func (m *MsgTokenizeSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenizeSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenizeSharesResponse proto.InternalMessageInfo
+
+func (m *MsgTokenizeSharesResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgRedeemTokensForShares defines a SDK message for converting share tokens
+// back into a delegation.
+type MsgRedeemTokensForShares struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRedeemTokensForShares) Reset()         { *m = MsgRedeemTokensForShares{} }
+func (m *MsgRedeemTokensForShares) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemTokensForShares) ProtoMessage()    {}
+func (*MsgRedeemTokensForShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{18}
+}
+func (m *MsgRedeemTokensForShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemTokensForShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemTokensForShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemTokensForShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemTokensForShares.Merge(m, src)
+}
+func (m *MsgRedeemTokensForShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemTokensForShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemTokensForShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemTokensForShares proto.InternalMessageInfo
+
+// MsgRedeemTokensForSharesResponse defines the Msg/RedeemTokensForShares
+// response type.
+type MsgRedeemTokensForSharesResponse struct {
+	// amount is the amount of delegated tokens.
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRedeemTokensForSharesResponse) Reset()         { *m = MsgRedeemTokensForSharesResponse{} }
+func (m *MsgRedeemTokensForSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemTokensForSharesResponse) ProtoMessage()    {}
+func (*MsgRedeemTokensForSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{19}
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemTokensForSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemTokensForSharesResponse.Merge(m, src)
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemTokensForSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemTokensForSharesResponse proto.InternalMessageInfo
+
+func (m *MsgRedeemTokensForSharesResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgScheduleCommissionChange defines a SDK message for announcing a
+// commission rate change of a validator, which takes effect at a future time.
+// It replaces any change previously scheduled by the validator.
+type MsgScheduleCommissionChange struct {
+	ValidatorAddress string                      `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Rate             cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	EffectiveTime    time.Time                   `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *MsgScheduleCommissionChange) Reset()         { *m = MsgScheduleCommissionChange{} }
+func (m *MsgScheduleCommissionChange) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleCommissionChange) ProtoMessage()    {}
+func (*MsgScheduleCommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{20}
+}
+func (m *MsgScheduleCommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleCommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleCommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleCommissionChange.Merge(m, src)
+}
+func (m *MsgScheduleCommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleCommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleCommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleCommissionChange proto.InternalMessageInfo
+
+// MsgScheduleCommissionChangeResponse defines the Msg/ScheduleCommissionChange
+// response type.
+type MsgScheduleCommissionChangeResponse struct {
+}
+
+func (m *MsgScheduleCommissionChangeResponse) Reset()         { *m = MsgScheduleCommissionChangeResponse{} }
+func (m *MsgScheduleCommissionChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleCommissionChangeResponse) ProtoMessage()    {}
+func (*MsgScheduleCommissionChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{21}
+}
+func (m *MsgScheduleCommissionChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleCommissionChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleCommissionChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleCommissionChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleCommissionChangeResponse.Merge(m, src)
+}
+func (m *MsgScheduleCommissionChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleCommissionChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleCommissionChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleCommissionChangeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
@@ -733,91 +990,113 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.staking.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgRotateConsPubKey)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKey")
 	proto.RegisterType((*MsgRotateConsPubKeyResponse)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKeyResponse")
+	proto.RegisterType((*MsgTokenizeShares)(nil), "cosmos.staking.v1beta1.MsgTokenizeShares")
+	proto.RegisterType((*MsgTokenizeSharesResponse)(nil), "cosmos.staking.v1beta1.MsgTokenizeSharesResponse")
+	proto.RegisterType((*MsgRedeemTokensForShares)(nil), "cosmos.staking.v1beta1.MsgRedeemTokensForShares")
+	proto.RegisterType((*MsgRedeemTokensForSharesResponse)(nil), "cosmos.staking.v1beta1.MsgRedeemTokensForSharesResponse")
+	proto.RegisterType((*MsgScheduleCommissionChange)(nil), "cosmos.staking.v1beta1.MsgScheduleCommissionChange")
+	proto.RegisterType((*MsgScheduleCommissionChangeResponse)(nil), "cosmos.staking.v1beta1.MsgScheduleCommissionChangeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x41, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0xda, 0x09, 0x29, 0x43, 0xc1, 0xb0, 0x40, 0x62, 0x16, 0x62, 0xd3, 0x0d, 0x15, 0x88,
-	0xd6, 0x6b, 0x42, 0xd2, 0xa0, 0x3a, 0x51, 0x15, 0x0c, 0x49, 0x9b, 0xb6, 0x6e, 0xd1, 0x52, 0x52,
-	0xa9, 0x6a, 0xe5, 0x8e, 0x77, 0x87, 0x65, 0x85, 0x77, 0xc7, 0xd9, 0x19, 0x93, 0xf8, 0x50, 0xa9,
-	0xea, 0xa9, 0xed, 0x29, 0x7f, 0xa0, 0x52, 0x2a, 0xb5, 0x52, 0x8f, 0x1c, 0x38, 0xb6, 0xf7, 0x28,
-	0xa7, 0x88, 0x53, 0x94, 0x03, 0xad, 0xe0, 0x40, 0xff, 0x41, 0x0f, 0xb9, 0x54, 0xbb, 0x3b, 0xbb,
-	0xf6, 0xae, 0xed, 0xb5, 0xa1, 0xcd, 0x25, 0x17, 0x30, 0x33, 0xdf, 0x7c, 0x33, 0xef, 0xfb, 0xde,
-	0xbc, 0x79, 0x06, 0x64, 0x14, 0x4c, 0x0c, 0x4c, 0x72, 0x84, 0xc2, 0x6d, 0xdd, 0xd4, 0x72, 0x3b,
-	0x97, 0xcb, 0x88, 0xc2, 0xcb, 0x39, 0xfa, 0x40, 0xaa, 0x5a, 0x98, 0x62, 0xfe, 0xbc, 0x0b, 0x90,
-	0x18, 0x40, 0x62, 0x00, 0x61, 0x42, 0xc3, 0x58, 0xab, 0xa0, 0x9c, 0x83, 0x2a, 0xd7, 0x36, 0x73,
-	0xd0, 0xac, 0xbb, 0x4b, 0x84, 0x4c, 0x78, 0x8a, 0xea, 0x06, 0x22, 0x14, 0x1a, 0x55, 0x06, 0x18,
-	0xd3, 0xb0, 0x86, 0x9d, 0x8f, 0x39, 0xfb, 0x13, 0x1b, 0x9d, 0x70, 0x77, 0x2a, 0xb9, 0x13, 0x6c,
-	0x5b, 0x77, 0x2a, 0xcd, 0x4e, 0x59, 0x86, 0x04, 0xf9, 0x47, 0x54, 0xb0, 0x6e, 0xb2, 0xf9, 0x99,
-	0x0e, 0x51, 0x78, 0x87, 0x76, 0x51, 0x17, 0x18, 0xca, 0x20, 0x36, 0xc2, 0xfe, 0xc5, 0x26, 0x46,
-	0xa0, 0xa1, 0x9b, 0x38, 0xe7, 0xfc, 0x74, 0x87, 0xc4, 0x17, 0x67, 0x00, 0x5f, 0x24, 0xda, 0x8a,
-	0x85, 0x20, 0x45, 0x77, 0x61, 0x45, 0x57, 0x21, 0xc5, 0x16, 0xbf, 0x06, 0x06, 0x54, 0x44, 0x14,
-	0x4b, 0xaf, 0x52, 0x1d, 0x9b, 0x29, 0x6e, 0x9a, 0x9b, 0x1b, 0x58, 0xbc, 0x24, 0xb5, 0xd7, 0x48,
-	0x5a, 0x6d, 0x40, 0x0b, 0xfd, 0x8f, 0x0f, 0x32, 0xb1, 0xdf, 0x8e, 0x77, 0xe7, 0x39, 0xb9, 0x99,
-	0x82, 0x97, 0x01, 0x50, 0xb0, 0x61, 0xe8, 0x84, 0xd8, 0x84, 0x71, 0x87, 0x70, 0xb6, 0x13, 0xe1,
-	0x8a, 0x8f, 0x94, 0x21, 0x45, 0xa4, 0x99, 0xb4, 0x89, 0x85, 0xff, 0x1a, 0x8c, 0x1a, 0xba, 0x59,
-	0x22, 0xa8, 0xb2, 0x59, 0x52, 0x51, 0x05, 0x69, 0xd0, 0x39, 0x6d, 0x62, 0x9a, 0x9b, 0xeb, 0x2f,
-	0x2c, 0xd8, 0x6b, 0x9e, 0x1f, 0x64, 0xc6, 0xdd, 0x3d, 0x88, 0xba, 0x2d, 0xe9, 0x38, 0x67, 0x40,
-	0xba, 0x25, 0xdd, 0x31, 0xe9, 0xfe, 0x5e, 0x16, 0xb0, 0xcd, 0xef, 0x98, 0xd4, 0xa5, 0x1e, 0x31,
-	0x74, 0x73, 0x1d, 0x55, 0x36, 0x57, 0x7d, 0x2a, 0xfe, 0x7d, 0x30, 0xc2, 0x88, 0xb1, 0x55, 0x82,
-	0xaa, 0x6a, 0x21, 0x42, 0x52, 0x67, 0x1c, 0x7e, 0x61, 0x7f, 0x2f, 0x3b, 0xc6, 0x28, 0x96, 0xdd,
-	0x99, 0x75, 0x6a, 0xe9, 0xa6, 0x96, 0xe2, 0xe4, 0x61, 0x7f, 0x11, 0x9b, 0xe1, 0x3f, 0x01, 0x23,
-	0x3b, 0x9e, 0xba, 0x3e, 0xd1, 0x59, 0x87, 0xe8, 0x8d, 0xfd, 0xbd, 0xec, 0x45, 0x46, 0xe4, 0x3b,
-	0x10, 0x60, 0x94, 0x87, 0x77, 0x42, 0xe3, 0xfc, 0x6d, 0xd0, 0x57, 0xad, 0x95, 0xb7, 0x51, 0x3d,
-	0xd5, 0xe7, 0x48, 0x39, 0x26, 0xb9, 0xc9, 0x28, 0x79, 0xc9, 0x28, 0x2d, 0x9b, 0xf5, 0x42, 0xea,
-	0x49, 0xe3, 0x8c, 0x8a, 0x55, 0xaf, 0x52, 0x2c, 0xad, 0xd5, 0xca, 0x1f, 0xa1, 0xba, 0xcc, 0x56,
-	0xf3, 0x79, 0x70, 0x76, 0x07, 0x56, 0x6a, 0x28, 0x75, 0xce, 0xa1, 0x99, 0xf0, 0x1c, 0xb1, 0x33,
-	0xb0, 0xc9, 0x0e, 0x3d, 0x60, 0xac, 0xbb, 0x24, 0x7f, 0xf3, 0xfb, 0x47, 0x99, 0xd8, 0xdf, 0x8f,
-	0x32, 0xb1, 0xef, 0x8e, 0x77, 0xe7, 0x5b, 0xc3, 0xfb, 0xf1, 0x78, 0x77, 0x9e, 0xc5, 0x95, 0x25,
-	0xea, 0x76, 0xae, 0x35, 0xcd, 0xc4, 0x29, 0x20, 0xb4, 0x8e, 0xca, 0x88, 0x54, 0xb1, 0x49, 0x90,
-	0xf8, 0x6b, 0x02, 0x0c, 0x17, 0x89, 0x76, 0x4b, 0xd5, 0xe9, 0xcb, 0xcc, 0xcc, 0xb6, 0xd6, 0xc4,
-	0x4f, 0x6f, 0xcd, 0x5d, 0x90, 0x6c, 0xe4, 0x68, 0xc9, 0x82, 0x14, 0xb1, 0x8c, 0xcc, 0x3e, 0x3f,
-	0xc8, 0x4c, 0xb6, 0x66, 0xe3, 0xc7, 0x48, 0x83, 0x4a, 0x7d, 0x15, 0x29, 0x4d, 0x39, 0xb9, 0x8a,
-	0x14, 0x79, 0x48, 0x09, 0xdc, 0x02, 0xfe, 0xf3, 0xf6, 0xd9, 0xee, 0x66, 0xe3, 0x6c, 0x8f, 0x99,
-	0xde, 0x26, 0xc9, 0xf3, 0xef, 0x75, 0xf7, 0x71, 0x32, 0xe8, 0x63, 0xc0, 0x12, 0x51, 0x00, 0xa9,
-	0xf0, 0x98, 0xef, 0xe1, 0x4f, 0x71, 0x30, 0x50, 0x24, 0x1a, 0xdb, 0x0d, 0xf1, 0xb7, 0xda, 0x5d,
-	0x28, 0xce, 0x09, 0x21, 0xd5, 0xe9, 0x42, 0xf5, 0x7a, 0x9d, 0xfe, 0x83, 0x67, 0x37, 0x40, 0x1f,
-	0x34, 0x70, 0xcd, 0xa4, 0x8e, 0x55, 0xbd, 0xde, 0x03, 0xb6, 0x26, 0xff, 0x6e, 0x40, 0xc0, 0x96,
-	0xf8, 0x6c, 0x01, 0xcf, 0x07, 0x05, 0xf4, 0xf4, 0x10, 0xc7, 0xc1, 0x68, 0xd3, 0x9f, 0xbe, 0x6c,
-	0x3f, 0x24, 0x9c, 0xb2, 0x5c, 0x40, 0x9a, 0x6e, 0xca, 0x48, 0xfd, 0x9f, 0xd5, 0xdb, 0x00, 0xe3,
-	0x0d, 0xf5, 0x88, 0xa5, 0x9c, 0x5c, 0xc1, 0x51, 0x7f, 0xfd, 0xba, 0xa5, 0xb4, 0xa5, 0x55, 0x09,
-	0xf5, 0x69, 0x13, 0x27, 0xa7, 0x5d, 0x25, 0xb4, 0xd5, 0x9b, 0x33, 0xa7, 0xf0, 0xe6, 0x66, 0x77,
-	0x6f, 0x42, 0x45, 0x2a, 0x24, 0xba, 0x58, 0x75, 0x8a, 0x54, 0x68, 0xd4, 0x73, 0x8a, 0x97, 0x9d,
-	0xdb, 0x5e, 0xad, 0x20, 0xfb, 0x2a, 0x95, 0xec, 0x0e, 0x80, 0xd5, 0x24, 0xa1, 0xa5, 0x22, 0x7f,
-	0xe6, 0xb5, 0x07, 0x85, 0x41, 0xfb, 0x9c, 0x0f, 0xff, 0xcc, 0x70, 0xee, 0x59, 0x87, 0x1a, 0x0c,
-	0x36, 0x46, 0xfc, 0x39, 0x0e, 0x06, 0x8b, 0x44, 0xdb, 0x30, 0xd5, 0x57, 0xfa, 0xda, 0x5c, 0xef,
-	0x6e, 0x4d, 0x2a, 0x68, 0x4d, 0x43, 0x11, 0xf1, 0x77, 0x0e, 0x8c, 0x07, 0x46, 0x5e, 0xa6, 0x23,
-	0xfc, 0xa7, 0x7e, 0xa0, 0xf1, 0x6e, 0x81, 0x4e, 0x39, 0x7d, 0xc7, 0x5e, 0x36, 0xd9, 0x38, 0xfa,
-	0xf4, 0x82, 0xf4, 0xce, 0x42, 0x20, 0x76, 0xf1, 0x45, 0x1c, 0x4c, 0xd9, 0x4f, 0x1f, 0x34, 0x15,
-	0x54, 0xd9, 0x30, 0xcb, 0xd8, 0x54, 0x75, 0x53, 0x6b, 0xea, 0x3c, 0x5e, 0x45, 0xc7, 0xf9, 0x59,
-	0x90, 0x54, 0xec, 0xc7, 0xde, 0x36, 0x66, 0x0b, 0xe9, 0xda, 0x96, 0x7b, 0xa7, 0x13, 0xf2, 0x90,
-	0x37, 0xfc, 0x81, 0x33, 0x9a, 0xff, 0xca, 0x4b, 0x8d, 0xfd, 0xb0, 0x90, 0x57, 0xaf, 0x75, 0xce,
-	0x96, 0xd9, 0x50, 0xb7, 0xd1, 0x49, 0x5c, 0xf1, 0x3a, 0x98, 0x89, 0x9a, 0xf7, 0x52, 0x29, 0x3f,
-	0xda, 0x66, 0x7b, 0xf1, 0x19, 0x07, 0x92, 0x76, 0xe6, 0x55, 0x55, 0x48, 0xd1, 0x1a, 0xb4, 0xa0,
-	0x41, 0xf8, 0x6b, 0xa0, 0x1f, 0xd6, 0xe8, 0x16, 0xb6, 0x74, 0x5a, 0xef, 0xea, 0x52, 0x03, 0xca,
-	0x2f, 0x83, 0xbe, 0xaa, 0xc3, 0xc0, 0xf2, 0x2a, 0xdd, 0xa9, 0x91, 0x71, 0xf7, 0x09, 0x68, 0xea,
-	0x2e, 0xcc, 0x7f, 0xd8, 0x7a, 0xc6, 0x25, 0x5b, 0xa2, 0xc6, 0x2e, 0xb6, 0x34, 0x33, 0x4d, 0xd2,
-	0x3c, 0xf0, 0xbf, 0x3f, 0x84, 0xc2, 0x10, 0x25, 0x70, 0x21, 0x34, 0x14, 0x25, 0xc5, 0x92, 0xf8,
-	0x0f, 0xe7, 0x3c, 0x5f, 0x32, 0xa6, 0x90, 0xa2, 0x15, 0x6c, 0x12, 0xb7, 0xbb, 0x6c, 0x9f, 0x75,
-	0xdc, 0xe9, 0xb3, 0xae, 0x08, 0x80, 0x89, 0xee, 0x97, 0x58, 0xc7, 0x1b, 0x3f, 0x55, 0xc7, 0xdb,
-	0x6f, 0xa2, 0xfb, 0x6b, 0x0e, 0x41, 0x7e, 0xb9, 0x7b, 0xc3, 0x93, 0x0e, 0xa6, 0x52, 0x38, 0x42,
-	0xf1, 0x22, 0x98, 0x6c, 0x33, 0xec, 0xa9, 0xb5, 0xf8, 0xc7, 0x39, 0x90, 0x28, 0x12, 0x8d, 0xbf,
-	0x07, 0x92, 0xe1, 0xaf, 0x56, 0xf3, 0x9d, 0x2c, 0x6e, 0xed, 0x84, 0x85, 0xc5, 0xde, 0xb1, 0x7e,
-	0xf9, 0xdb, 0x06, 0x83, 0xc1, 0x8e, 0x79, 0x2e, 0x82, 0x24, 0x80, 0x14, 0x16, 0x7a, 0x45, 0xfa,
-	0x9b, 0x7d, 0x09, 0x5e, 0xf3, 0x5b, 0xbb, 0x4b, 0x11, 0xab, 0x3d, 0x90, 0xf0, 0x56, 0x0f, 0x20,
-	0x9f, 0xfd, 0x1e, 0x48, 0x86, 0x3b, 0xa0, 0x28, 0xf5, 0x42, 0xd8, 0x48, 0xf5, 0x3a, 0x3d, 0xe7,
-	0x65, 0x00, 0x9a, 0x9e, 0xdd, 0x37, 0x23, 0x18, 0x1a, 0x30, 0x21, 0xdb, 0x13, 0xcc, 0xdf, 0xe3,
-	0x17, 0x0e, 0x4c, 0x74, 0x2e, 0xfc, 0x57, 0xa3, 0x3c, 0xef, 0xb4, 0x4a, 0xb8, 0x71, 0x9a, 0x55,
-	0x7e, 0xbb, 0x39, 0xfa, 0xa4, 0xb5, 0xce, 0xf1, 0xdf, 0x80, 0xd7, 0x03, 0x35, 0x6e, 0x36, 0x2a,
-	0xca, 0x26, 0xa0, 0x90, 0xeb, 0x11, 0x18, 0xb5, 0xfd, 0x12, 0x4f, 0xc1, 0x70, 0x4b, 0x5d, 0x89,
-	0xca, 0x9e, 0x30, 0x58, 0xb8, 0x72, 0x02, 0xb0, 0x77, 0x14, 0xe1, 0xec, 0xb7, 0x76, 0x71, 0x2d,
-	0xdc, 0x7e, 0x7c, 0x98, 0xe6, 0x9e, 0x1e, 0xa6, 0xb9, 0xbf, 0x0e, 0xd3, 0xdc, 0xc3, 0xa3, 0x74,
-	0xec, 0xe9, 0x51, 0x3a, 0xf6, 0xec, 0x28, 0x1d, 0xfb, 0xe2, 0x6d, 0x4d, 0xa7, 0x5b, 0xb5, 0xb2,
-	0xa4, 0x60, 0x83, 0xfd, 0xef, 0x26, 0xd7, 0xb6, 0xb4, 0xd2, 0x7a, 0x15, 0x91, 0x72, 0x9f, 0x53,
-	0x9c, 0xae, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x9a, 0xd3, 0x7d, 0x0f, 0x7f, 0x12, 0x00, 0x00,
+	// 1510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0xda, 0x21, 0x6d, 0x1e, 0xcd, 0xd7, 0x86, 0x80, 0xb3, 0x04, 0x3b, 0xdd, 0x40, 0x43,
+	0xd3, 0x7a, 0x1d, 0x02, 0x85, 0xd6, 0xa0, 0x96, 0x7c, 0x90, 0x16, 0x8a, 0x4b, 0xe4, 0x00, 0x55,
+	0xab, 0x56, 0x66, 0xbd, 0x3b, 0x59, 0xaf, 0xe2, 0xdd, 0x35, 0x3b, 0xe3, 0x80, 0x2b, 0x55, 0xaa,
+	0xda, 0x4b, 0x5b, 0xf5, 0xc0, 0x3f, 0x50, 0x89, 0x4a, 0xad, 0xd4, 0x23, 0x87, 0x1c, 0x7b, 0xea,
+	0x09, 0x71, 0x42, 0x39, 0x54, 0x88, 0x03, 0xad, 0xe0, 0x90, 0xfe, 0x07, 0x1c, 0xb8, 0x54, 0xfb,
+	0xe1, 0xb1, 0x77, 0xbd, 0xbb, 0xb6, 0xd3, 0x70, 0xc9, 0x05, 0x9c, 0x99, 0xdf, 0xfb, 0xbd, 0x79,
+	0xef, 0xf7, 0x66, 0xe6, 0xcd, 0x42, 0x4a, 0x32, 0xb0, 0x66, 0xe0, 0x0c, 0x26, 0xe2, 0xba, 0xaa,
+	0x2b, 0x99, 0x8d, 0x13, 0x45, 0x44, 0xc4, 0x13, 0x19, 0x72, 0x5b, 0xa8, 0x98, 0x06, 0x31, 0xd8,
+	0x83, 0x0e, 0x40, 0x70, 0x01, 0x82, 0x0b, 0xe0, 0xc6, 0x15, 0xc3, 0x50, 0xca, 0x28, 0x63, 0xa3,
+	0x8a, 0xd5, 0xb5, 0x8c, 0xa8, 0xd7, 0x1c, 0x13, 0x2e, 0xe5, 0x9f, 0x22, 0xaa, 0x86, 0x30, 0x11,
+	0xb5, 0x8a, 0x0b, 0x38, 0xa0, 0x18, 0x8a, 0x61, 0xff, 0xcc, 0x58, 0xbf, 0xdc, 0xd1, 0x71, 0xc7,
+	0x53, 0xc1, 0x99, 0x70, 0xdd, 0x3a, 0x53, 0x49, 0x77, 0x95, 0x45, 0x11, 0x23, 0xba, 0x44, 0xc9,
+	0x50, 0x75, 0x77, 0xfe, 0x68, 0x48, 0x14, 0xf5, 0x45, 0x3b, 0xa8, 0x43, 0x2e, 0x4a, 0xc3, 0x16,
+	0xc2, 0xfa, 0xcf, 0x9d, 0x18, 0x11, 0x35, 0x55, 0x37, 0x32, 0xf6, 0xbf, 0xce, 0x10, 0xff, 0xa2,
+	0x17, 0xd8, 0x1c, 0x56, 0x16, 0x4d, 0x24, 0x12, 0x74, 0x5d, 0x2c, 0xab, 0xb2, 0x48, 0x0c, 0x93,
+	0x5d, 0x81, 0xfd, 0x32, 0xc2, 0x92, 0xa9, 0x56, 0x88, 0x6a, 0xe8, 0x09, 0x66, 0x92, 0x39, 0xbe,
+	0x7f, 0x6e, 0x4a, 0x08, 0xce, 0x91, 0xb0, 0xd4, 0x80, 0x2e, 0xf4, 0xdf, 0x7f, 0x92, 0xea, 0xf9,
+	0x7d, 0xfb, 0xde, 0x0c, 0x93, 0x6f, 0xa6, 0x60, 0xf3, 0x00, 0x92, 0xa1, 0x69, 0x2a, 0xc6, 0x16,
+	0x61, 0xcc, 0x26, 0x9c, 0x0e, 0x23, 0x5c, 0xa4, 0xc8, 0xbc, 0x48, 0x10, 0x6e, 0x26, 0x6d, 0x62,
+	0x61, 0x6f, 0xc0, 0xa8, 0xa6, 0xea, 0x05, 0x8c, 0xca, 0x6b, 0x05, 0x19, 0x95, 0x91, 0x22, 0xda,
+	0xab, 0x8d, 0x4f, 0x32, 0xc7, 0xfb, 0x17, 0x66, 0x2d, 0x9b, 0xc7, 0x4f, 0x52, 0x63, 0x8e, 0x0f,
+	0x2c, 0xaf, 0x0b, 0xaa, 0x91, 0xd1, 0x44, 0x52, 0x12, 0x2e, 0xea, 0x64, 0x6b, 0x33, 0x0d, 0xae,
+	0xf3, 0x8b, 0x3a, 0x71, 0xa8, 0x47, 0x34, 0x55, 0x5f, 0x45, 0xe5, 0xb5, 0x25, 0x4a, 0xc5, 0x7e,
+	0x08, 0x23, 0x2e, 0xb1, 0x61, 0x16, 0x44, 0x59, 0x36, 0x11, 0xc6, 0x89, 0x5e, 0x9b, 0x9f, 0xdb,
+	0xda, 0x4c, 0x1f, 0x70, 0x29, 0xe6, 0x9d, 0x99, 0x55, 0x62, 0xaa, 0xba, 0x92, 0x60, 0xf2, 0xc3,
+	0xd4, 0xc8, 0x9d, 0x61, 0x3f, 0x81, 0x91, 0x8d, 0x7a, 0x76, 0x29, 0xd1, 0x3e, 0x9b, 0xe8, 0xf5,
+	0xad, 0xcd, 0xf4, 0x11, 0x97, 0x88, 0x2a, 0xe0, 0x61, 0xcc, 0x0f, 0x6f, 0xf8, 0xc6, 0xd9, 0x65,
+	0xe8, 0xab, 0x54, 0x8b, 0xeb, 0xa8, 0x96, 0xe8, 0xb3, 0x53, 0x79, 0x40, 0x70, 0x8a, 0x51, 0xa8,
+	0x17, 0xa3, 0x30, 0xaf, 0xd7, 0x16, 0x12, 0x0f, 0x1a, 0x6b, 0x94, 0xcc, 0x5a, 0x85, 0x18, 0xc2,
+	0x4a, 0xb5, 0xf8, 0x31, 0xaa, 0xe5, 0x5d, 0x6b, 0x36, 0x0b, 0xfb, 0x36, 0xc4, 0x72, 0x15, 0x25,
+	0x5e, 0xb1, 0x69, 0xc6, 0xeb, 0x8a, 0x58, 0x15, 0xd8, 0x24, 0x87, 0xea, 0x11, 0xd6, 0x31, 0xc9,
+	0x9e, 0xff, 0xfe, 0x6e, 0xaa, 0xe7, 0xdf, 0xbb, 0xa9, 0x9e, 0x6f, 0xb7, 0xef, 0xcd, 0xb4, 0x86,
+	0xf7, 0xe3, 0xf6, 0xbd, 0x19, 0x37, 0xae, 0x34, 0x96, 0xd7, 0x33, 0xad, 0x65, 0xc6, 0x4f, 0x00,
+	0xd7, 0x3a, 0x9a, 0x47, 0xb8, 0x62, 0xe8, 0x18, 0xf1, 0xbf, 0xc5, 0x61, 0x38, 0x87, 0x95, 0x0b,
+	0xb2, 0x4a, 0x5e, 0x66, 0x65, 0x06, 0x4a, 0x13, 0xdb, 0xb9, 0x34, 0xd7, 0x61, 0xa8, 0x51, 0xa3,
+	0x05, 0x53, 0x24, 0xc8, 0xad, 0xc8, 0xf4, 0xe3, 0x27, 0xa9, 0xc3, 0xad, 0xd5, 0x78, 0x19, 0x29,
+	0xa2, 0x54, 0x5b, 0x42, 0x52, 0x53, 0x4d, 0x2e, 0x21, 0x29, 0x3f, 0x28, 0x79, 0x76, 0x01, 0xfb,
+	0x69, 0x70, 0xb5, 0x3b, 0xd5, 0x38, 0xdd, 0x61, 0xa5, 0x07, 0x14, 0x79, 0xf6, 0xfd, 0xf6, 0x3a,
+	0x1e, 0xf6, 0xea, 0xe8, 0x91, 0x84, 0xe7, 0x20, 0xe1, 0x1f, 0xa3, 0x1a, 0xfe, 0x1c, 0x83, 0xfd,
+	0x39, 0xac, 0xb8, 0xde, 0x10, 0x7b, 0x21, 0x68, 0x43, 0x31, 0x76, 0x08, 0x89, 0xb0, 0x0d, 0xd5,
+	0xe9, 0x76, 0xfa, 0x1f, 0x9a, 0x9d, 0x83, 0x3e, 0x51, 0x33, 0xaa, 0x3a, 0x49, 0xc4, 0xbb, 0xd8,
+	0x07, 0xae, 0x4d, 0xf6, 0x3d, 0x4f, 0x02, 0x5b, 0xe2, 0xb3, 0x12, 0x78, 0xd0, 0x9b, 0xc0, 0x7a,
+	0x3e, 0xf8, 0x31, 0x18, 0x6d, 0xfa, 0x93, 0xa6, 0xed, 0x87, 0xb8, 0x7d, 0x2c, 0x2f, 0x20, 0x45,
+	0xd5, 0xf3, 0x48, 0xde, 0xe5, 0xec, 0x5d, 0x83, 0xb1, 0x46, 0xf6, 0xb0, 0x29, 0x75, 0x9f, 0xc1,
+	0x51, 0x6a, 0xbf, 0x6a, 0x4a, 0x81, 0xb4, 0x32, 0x26, 0x94, 0x36, 0xde, 0x3d, 0xed, 0x12, 0x26,
+	0xad, 0xda, 0xf4, 0xee, 0x40, 0x9b, 0xf3, 0xed, 0xb5, 0xf1, 0x1d, 0x52, 0xbe, 0xa4, 0xf3, 0x15,
+	0xe0, 0x5a, 0x47, 0xeb, 0x4a, 0xb1, 0x79, 0x7b, 0xb7, 0x57, 0xca, 0xc8, 0xda, 0x4a, 0x05, 0xab,
+	0x03, 0x70, 0xcf, 0x24, 0xae, 0xe5, 0x44, 0xbe, 0x5a, 0x6f, 0x0f, 0x16, 0x06, 0xac, 0x75, 0xde,
+	0xf9, 0x3b, 0xc5, 0x38, 0x6b, 0x1d, 0x6c, 0x30, 0x58, 0x18, 0xfe, 0x97, 0x18, 0x0c, 0xe4, 0xb0,
+	0x72, 0x4d, 0x97, 0xf7, 0xf4, 0xb6, 0x39, 0xdb, 0x5e, 0x9a, 0x84, 0x57, 0x9a, 0x46, 0x46, 0xf8,
+	0x3f, 0x18, 0x18, 0xf3, 0x8c, 0xbc, 0x4c, 0x45, 0xd8, 0x2b, 0x34, 0xd0, 0x58, 0xbb, 0x40, 0x27,
+	0xec, 0xbe, 0x63, 0x33, 0x3d, 0xd4, 0x58, 0xfa, 0xe4, 0xac, 0xf0, 0xce, 0xac, 0x27, 0x76, 0xfe,
+	0x45, 0x0c, 0x26, 0xac, 0xab, 0x4f, 0xd4, 0x25, 0x54, 0xbe, 0xa6, 0x17, 0x0d, 0x5d, 0x56, 0x75,
+	0xa5, 0xa9, 0xf3, 0xd8, 0x8b, 0x8a, 0xb3, 0xd3, 0x30, 0x24, 0x59, 0x97, 0xbd, 0x25, 0x4c, 0x09,
+	0xa9, 0x4a, 0xc9, 0xd9, 0xd3, 0xf1, 0xfc, 0x60, 0x7d, 0xf8, 0x23, 0x7b, 0x34, 0xfb, 0x65, 0xbd,
+	0x34, 0xb6, 0xfc, 0x89, 0x3c, 0x75, 0x3a, 0xbc, 0x5a, 0xa6, 0x7d, 0xdd, 0x46, 0x58, 0x72, 0xf9,
+	0xb3, 0x70, 0x34, 0x6a, 0xbe, 0x5e, 0x4a, 0xd9, 0xd1, 0x00, 0xf7, 0xfc, 0x23, 0x06, 0x86, 0xac,
+	0xca, 0xab, 0xc8, 0x22, 0x41, 0x2b, 0xa2, 0x29, 0x6a, 0x98, 0x3d, 0x0d, 0xfd, 0x62, 0x95, 0x94,
+	0x0c, 0x53, 0x25, 0xb5, 0xb6, 0x2a, 0x35, 0xa0, 0xec, 0x3c, 0xf4, 0x55, 0x6c, 0x06, 0xb7, 0xae,
+	0x92, 0x61, 0x8d, 0x8c, 0xe3, 0xc7, 0x93, 0x53, 0xc7, 0x30, 0x7b, 0xa9, 0x75, 0x8d, 0x67, 0xac,
+	0x14, 0x35, 0xbc, 0x58, 0xa9, 0x39, 0xda, 0x94, 0x9a, 0xdb, 0xf4, 0xfd, 0xe0, 0x0b, 0x83, 0x17,
+	0xe0, 0x90, 0x6f, 0x28, 0x2a, 0x15, 0x67, 0xf8, 0xe7, 0x8c, 0x7d, 0x7d, 0xe5, 0x0d, 0x22, 0x12,
+	0xb4, 0x68, 0xe8, 0xd8, 0xe9, 0x2e, 0x83, 0xab, 0x8e, 0xd9, 0x79, 0xd5, 0xe5, 0x00, 0x74, 0x74,
+	0xab, 0xe0, 0x76, 0xbc, 0xb1, 0x1d, 0x75, 0xbc, 0xfd, 0x3a, 0xba, 0xb5, 0x62, 0x13, 0x64, 0xe7,
+	0xdb, 0x37, 0x3c, 0x49, 0x6f, 0x29, 0xf9, 0x23, 0xe4, 0x8f, 0xc0, 0xe1, 0x80, 0x61, 0x7a, 0x7f,
+	0x3f, 0x8f, 0xc1, 0x48, 0x0e, 0x2b, 0x57, 0x8d, 0x75, 0xa4, 0xab, 0x5f, 0xa1, 0xd5, 0x92, 0x68,
+	0x22, 0xbc, 0x37, 0xf7, 0xf4, 0x65, 0x18, 0x23, 0x6e, 0x98, 0x72, 0x01, 0x5b, 0x81, 0x16, 0x8c,
+	0x5b, 0x3a, 0x32, 0x13, 0xbd, 0x6d, 0x02, 0x1b, 0xa5, 0x66, 0x76, 0x7a, 0xae, 0x58, 0x46, 0xd9,
+	0x0f, 0xda, 0xdf, 0x09, 0x13, 0x5e, 0x69, 0xbc, 0x39, 0xe6, 0x3f, 0x83, 0xf1, 0x96, 0x41, 0x7a,
+	0x35, 0x34, 0x22, 0x65, 0xba, 0x8f, 0x94, 0xdf, 0x66, 0xec, 0x46, 0xd7, 0x6a, 0x02, 0x90, 0x66,
+	0x7b, 0xc0, 0xcb, 0x86, 0xb9, 0xbb, 0xda, 0x9e, 0xeb, 0xfc, 0xa2, 0x09, 0xb8, 0x51, 0x97, 0xdb,
+	0x67, 0x6f, 0xca, 0x57, 0xd8, 0x41, 0xc1, 0xf0, 0x37, 0x60, 0x32, 0x6c, 0x6e, 0x97, 0x72, 0xf9,
+	0x57, 0xcc, 0xde, 0x40, 0xab, 0x52, 0x09, 0xc9, 0xd5, 0x32, 0x6a, 0x3c, 0xf8, 0x17, 0x4b, 0xa2,
+	0xae, 0xa0, 0x5d, 0x3f, 0x41, 0x2e, 0x41, 0xaf, 0xfd, 0x12, 0x73, 0xb6, 0xc9, 0x69, 0xf7, 0xdb,
+	0x40, 0xe7, 0xaf, 0x31, 0x67, 0xf5, 0x36, 0x07, 0xbb, 0x02, 0x83, 0x68, 0x6d, 0x0d, 0x49, 0x44,
+	0xdd, 0x40, 0x4e, 0x7f, 0x11, 0xef, 0xb6, 0xbf, 0x18, 0xa0, 0x04, 0x16, 0x24, 0x7b, 0xb1, 0xfd,
+	0x81, 0xf4, 0x86, 0x57, 0xb7, 0xb0, 0xc4, 0xf1, 0xc7, 0x60, 0x2a, 0x62, 0xba, 0xae, 0xde, 0xdc,
+	0x9f, 0x00, 0xf1, 0x1c, 0x56, 0xd8, 0x9b, 0x30, 0xe4, 0xff, 0xf6, 0x33, 0x13, 0x76, 0x07, 0xb5,
+	0x3e, 0xd5, 0xb9, 0xb9, 0xce, 0xb1, 0xb4, 0x70, 0xd6, 0x61, 0xc0, 0xfb, 0xa4, 0x3f, 0x1e, 0x41,
+	0xe2, 0x41, 0x72, 0xb3, 0x9d, 0x22, 0xa9, 0xb3, 0x2f, 0xe0, 0x55, 0xfa, 0xf6, 0x9c, 0x8a, 0xb0,
+	0xae, 0x83, 0xb8, 0xb7, 0x3a, 0x00, 0x51, 0xf6, 0x9b, 0x30, 0xe4, 0x7f, 0xa2, 0x45, 0x65, 0xcf,
+	0x87, 0xe5, 0xe6, 0x3a, 0xc7, 0x52, 0x97, 0x45, 0x80, 0xa6, 0x77, 0xc1, 0xb1, 0x08, 0x86, 0x06,
+	0x8c, 0x4b, 0x77, 0x04, 0xa3, 0x3e, 0x7e, 0x65, 0x60, 0x3c, 0xbc, 0x33, 0x3d, 0x15, 0xa5, 0x79,
+	0x98, 0x15, 0x77, 0x6e, 0x27, 0x56, 0xf4, 0x3e, 0x1d, 0x7d, 0xd0, 0xda, 0x88, 0xb1, 0x5f, 0xc3,
+	0x6b, 0x9e, 0x26, 0x6c, 0x3a, 0x2a, 0xca, 0x26, 0x20, 0x97, 0xe9, 0x10, 0x18, 0xe5, 0xfe, 0x0c,
+	0x4b, 0x60, 0xb8, 0xa5, 0xf1, 0x89, 0xaa, 0x1e, 0x3f, 0x98, 0x3b, 0xd9, 0x05, 0x98, 0x6a, 0xa3,
+	0xc3, 0xa0, 0xaf, 0xab, 0x78, 0x33, 0x82, 0xc6, 0x0b, 0xe5, 0x4e, 0x74, 0x0c, 0xa5, 0xfe, 0xbe,
+	0x63, 0x60, 0x2c, 0xf8, 0xc6, 0x8b, 0xda, 0x8c, 0x81, 0x16, 0xdc, 0xbb, 0xdd, 0x5a, 0xd0, 0x55,
+	0xfc, 0xc4, 0x40, 0x22, 0xf4, 0xae, 0x88, 0xca, 0x63, 0x98, 0x11, 0x77, 0x76, 0x07, 0x46, 0xf5,
+	0xe5, 0x70, 0xfb, 0xbe, 0xb1, 0x4e, 0xf1, 0x85, 0xe5, 0xfb, 0x4f, 0x93, 0xcc, 0xc3, 0xa7, 0x49,
+	0xe6, 0x9f, 0xa7, 0x49, 0xe6, 0xce, 0xb3, 0x64, 0xcf, 0xc3, 0x67, 0xc9, 0x9e, 0x47, 0xcf, 0x92,
+	0x3d, 0x9f, 0xbf, 0xad, 0xa8, 0xa4, 0x54, 0x2d, 0x0a, 0x92, 0xa1, 0xb9, 0x5f, 0xf8, 0x33, 0x81,
+	0x0d, 0x38, 0xa9, 0x55, 0x10, 0x2e, 0xf6, 0xd9, 0x17, 0xc6, 0xc9, 0xff, 0x06, 0x00, 0x3c, 0x1d,
+	0xe8, 0xde, 0xa5, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -854,6 +1133,15 @@ type MsgClient interface {
 	// RotateConsPubKey defines an operation for rotating the consensus keys
 	// of a validator.
 	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
+	// TokenizeShares defines a method for converting a delegation into share
+	// tokens of the validator.
+	TokenizeShares(ctx context.Context, in *MsgTokenizeShares, opts ...grpc.CallOption) (*MsgTokenizeSharesResponse, error)
+	// RedeemTokensForShares defines a method for converting share tokens back
+	// into a delegation.
+	RedeemTokensForShares(ctx context.Context, in *MsgRedeemTokensForShares, opts ...grpc.CallOption) (*MsgRedeemTokensForSharesResponse, error)
+	// ScheduleCommissionChange defines a method for announcing a validator
+	// commission rate change which takes effect at a future time.
+	ScheduleCommissionChange(ctx context.Context, in *MsgScheduleCommissionChange, opts ...grpc.CallOption) (*MsgScheduleCommissionChangeResponse, error)
 }
 
 type msgClient struct {
@@ -936,6 +1224,33 @@ func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKe
 	return out, nil
 }
 
+func (c *msgClient) TokenizeShares(ctx context.Context, in *MsgTokenizeShares, opts ...grpc.CallOption) (*MsgTokenizeSharesResponse, error) {
+	out := new(MsgTokenizeSharesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/TokenizeShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemTokensForShares(ctx context.Context, in *MsgRedeemTokensForShares, opts ...grpc.CallOption) (*MsgRedeemTokensForSharesResponse, error) {
+	out := new(MsgRedeemTokensForSharesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/RedeemTokensForShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleCommissionChange(ctx context.Context, in *MsgScheduleCommissionChange, opts ...grpc.CallOption) (*MsgScheduleCommissionChangeResponse, error) {
+	out := new(MsgScheduleCommissionChangeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/ScheduleCommissionChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -960,6 +1275,15 @@ type MsgServer interface {
 	// RotateConsPubKey defines an operation for rotating the consensus keys
 	// of a validator.
 	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
+	// TokenizeShares defines a method for converting a delegation into share
+	// tokens of the validator.
+	TokenizeShares(context.Context, *MsgTokenizeShares) (*MsgTokenizeSharesResponse, error)
+	// RedeemTokensForShares defines a method for converting share tokens back
+	// into a delegation.
+	RedeemTokensForShares(context.Context, *MsgRedeemTokensForShares) (*MsgRedeemTokensForSharesResponse, error)
+	// ScheduleCommissionChange defines a method for announcing a validator
+	// commission rate change which takes effect at a future time.
+	ScheduleCommissionChange(context.Context, *MsgScheduleCommissionChange) (*MsgScheduleCommissionChangeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -990,6 +1314,15 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) RotateConsPubKey(ctx context.Context, req *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
 }
+func (*UnimplementedMsgServer) TokenizeShares(ctx context.Context, req *MsgTokenizeShares) (*MsgTokenizeSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShares not implemented")
+}
+func (*UnimplementedMsgServer) RedeemTokensForShares(ctx context.Context, req *MsgRedeemTokensForShares) (*MsgRedeemTokensForSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemTokensForShares not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleCommissionChange(ctx context.Context, req *MsgScheduleCommissionChange) (*MsgScheduleCommissionChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCommissionChange not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1139,6 +1472,60 @@ func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TokenizeShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenizeShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TokenizeShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/TokenizeShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TokenizeShares(ctx, req.(*MsgTokenizeShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemTokensForShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemTokensForShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemTokensForShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/RedeemTokensForShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemTokensForShares(ctx, req.(*MsgRedeemTokensForShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleCommissionChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleCommissionChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleCommissionChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/ScheduleCommissionChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleCommissionChange(ctx, req.(*MsgScheduleCommissionChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
@@ -1176,6 +1563,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RotateConsPubKey",
 			Handler:    _Msg_RotateConsPubKey_Handler,
 		},
+		{
+			MethodName: "TokenizeShares",
+			Handler:    _Msg_TokenizeShares_Handler,
+		},
+		{
+			MethodName: "RedeemTokensForShares",
+			Handler:    _Msg_RedeemTokensForShares_Handler,
+		},
+		{
+			MethodName: "ScheduleCommissionChange",
+			Handler:    _Msg_ScheduleCommissionChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1826,38 +2225,269 @@ func (m *MsgRotateConsPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgTokenizeShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgTokenizeShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinSelfDelegation.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenizedShareOwner) > 0 {
+		i -= len(m.TokenizedShareOwner)
+		copy(dAtA[i:], m.TokenizedShareOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenizedShareOwner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Pubkey != nil {
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenizeSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenizeSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemTokensForShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemTokensForShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemTokensForShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemTokensForSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemTokensForSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemTokensForSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleCommissionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleCommissionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleCommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintTx(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleCommissionChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleCommissionChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleCommissionChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pubkey != nil {
 		l = m.Pubkey.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -2082,30 +2712,116 @@ func (m *MsgRotateConsPubKeyResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgTokenizeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TokenizedShareOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgTokenizeSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *MsgRedeemTokensForShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemTokensForSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleCommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleCommissionChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
 		}
 		fieldNum := int32(wire >> 3)
@@ -2114,7 +2830,340 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgCreateValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pubkey == nil {
+				m.Pubkey = &any.Any{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2152,9 +3201,9 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2164,28 +3213,27 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2213,13 +3261,15 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.LegacyDec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2247,11 +3297,115 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.Int
+			m.MinSelfDelegation = &v
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2279,13 +3433,13 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2295,31 +3449,27 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pubkey == nil {
-				m.Pubkey = &any.Any{}
-			}
-			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2346,7 +3496,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2371,7 +3521,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2394,10 +3544,10 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2421,7 +3571,7 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2444,17 +3594,17 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginRedelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2464,28 +3614,27 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2513,11 +3662,11 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2545,17 +3694,13 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.CommissionRate = &v
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2565,25 +3710,22 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.MinSelfDelegation = &v
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2608,7 +3750,7 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2631,12 +3773,45 @@ func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginRedelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2658,7 +3833,7 @@ func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2681,10 +3856,10 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2805,7 +3980,7 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2828,12 +4003,78 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2855,7 +4096,7 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2878,10 +4119,10 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginRedelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2918,7 +4159,7 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2946,13 +4187,13 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2962,29 +4203,30 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			var msglen int
+			m.CreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2994,25 +4236,61 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3034,7 +4312,7 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3057,15 +4335,47 @@ func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginRedelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3092,7 +4402,7 @@ func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3117,7 +4427,7 @@ func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3140,45 +4450,63 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -3210,9 +4538,9 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3239,7 +4567,10 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NewPubkey == nil {
+				m.NewPubkey = &any.Any{}
+			}
+			if err := m.NewPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3264,7 +4595,7 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3287,78 +4618,12 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3380,7 +4645,7 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenizeShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3403,10 +4668,10 @@ func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenizeShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenizeShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3507,10 +4772,10 @@ func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizedShareOwner", wireType)
 			}
-			m.CreationHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3520,11 +4785,24 @@ func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizedShareOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3546,7 +4824,7 @@ func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenizeSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3569,12 +4847,45 @@ func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenizeSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenizeSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3596,7 +4907,7 @@ func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemTokensForShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3619,15 +4930,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3655,11 +4966,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3686,7 +4997,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3711,7 +5022,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemTokensForSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3734,12 +5045,45 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemTokensForSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemTokensForSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3761,7 +5105,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleCommissionChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3784,10 +5128,10 @@ func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRotateConsPubKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleCommissionChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRotateConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleCommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3824,7 +5168,41 @@ func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3851,10 +5229,7 @@ func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewPubkey == nil {
-				m.NewPubkey = &any.Any{}
-			}
-			if err := m.NewPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3879,7 +5254,7 @@ func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleCommissionChangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3902,10 +5277,10 @@ func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleCommissionChangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleCommissionChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,45 @@
+// Package blsaggregate implements SIGN_MODE_BLS_AGGREGATE, a sign mode in
+// which the signers of a transaction sign with BLS12-381 keys, so that their
+// signatures can be aggregated into a single signature verified at once
+// against all of their public keys and sign bytes.
+//
+// The sign bytes are the SignDoc of SIGN_MODE_DIRECT, holding the account
+// number of each signer, so that a signature cannot be replayed on an account
+// created again at the same address. The sequences and the public keys of all
+// the signers are signed as part of the auth info.
+package blsaggregate
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/x/tx/signing"
+)
+
+var (
+	_                  signing.SignModeHandler = SignModeHandler{}
+	protov2MarshalOpts                         = proto.MarshalOptions{Deterministic: true}
+)
+
+// SignModeHandler is the SIGN_MODE_BLS_AGGREGATE implementation of signing.SignModeHandler.
+type SignModeHandler struct{}
+
+// Mode implements signing.SignModeHandler.Mode.
+func (h SignModeHandler) Mode() signingv1beta1.SignMode {
+	return signingv1beta1.SignMode_SIGN_MODE_BLS_AGGREGATE
+}
+
+// GetSignBytes implements signing.SignModeHandler.GetSignBytes. The sign bytes
+// depend on the chain ID and the account number of the signer data.
+func (SignModeHandler) GetSignBytes(_ context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	return protov2MarshalOpts.Marshal(&txv1beta1.SignDoc{
+		BodyBytes:     txData.BodyBytes,
+		AuthInfoBytes: txData.AuthInfoBytes,
+		ChainId:       signerData.ChainID,
+		AccountNumber: signerData.AccountNumber,
+	})
+}
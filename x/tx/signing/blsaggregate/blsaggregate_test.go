@@ -0,0 +1,86 @@
+package blsaggregate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/blsaggregate"
+)
+
+func TestSignModeHandler(t *testing.T) {
+	msg, err := anyutil.New(&bankv1beta1.MsgSend{})
+	require.NoError(t, err)
+
+	txBody := &txv1beta1.TxBody{
+		Messages: []*anypb.Any{msg},
+		Memo:     "sometestmemo",
+	}
+	authInfo := &txv1beta1.AuthInfo{
+		Fee: &txv1beta1.Fee{Amount: []*basev1beta1.Coin{{Denom: "uatom", Amount: "1000"}}, GasLimit: 20000},
+	}
+	bodyBz, err := proto.Marshal(txBody)
+	require.NoError(t, err)
+	authInfoBz, err := proto.Marshal(authInfo)
+	require.NoError(t, err)
+	txData := signing.TxData{
+		Body:          txBody,
+		AuthInfo:      authInfo,
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+	}
+
+	handler := blsaggregate.SignModeHandler{}
+	require.Equal(t, signingv1beta1.SignMode_SIGN_MODE_BLS_AGGREGATE, handler.Mode())
+
+	signBytes, err := handler.GetSignBytes(context.Background(), signing.SignerData{
+		Address:       "cosmos1signer",
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+	}, txData)
+	require.NoError(t, err)
+
+	expected, err := proto.Marshal(&txv1beta1.SignDoc{
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+		ChainId:       "test-chain",
+		AccountNumber: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, signBytes)
+
+	// the sign bytes do not depend on the address and the sequence of the
+	// signer, signed in the auth info
+	sameAccountSignBytes, err := handler.GetSignBytes(context.Background(), signing.SignerData{
+		Address:       "cosmos1othersigner",
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      4,
+	}, txData)
+	require.NoError(t, err)
+	require.Equal(t, signBytes, sameAccountSignBytes)
+
+	// but depend on the account number
+	otherAccountSignBytes, err := handler.GetSignBytes(context.Background(), signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 3,
+	}, txData)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, otherAccountSignBytes)
+
+	// and on the chain ID
+	otherChainSignBytes, err := handler.GetSignBytes(context.Background(), signing.SignerData{ChainID: "other-chain", AccountNumber: 1}, txData)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, otherChainSignBytes)
+}
@@ -0,0 +1,239 @@
+// Package clearsign implements SIGN_MODE_CLEAR_SIGNING, a sign mode in which
+// the signer signs a human-readable rendering of the transaction, so that
+// devices with a small display such as hardware wallets can show the signer
+// exactly what they sign.
+//
+// The transaction is rendered into a deterministic list of screens, each made
+// of a title and a content, possibly indented and possibly marked as expert.
+// The sign bytes are the encoding of those screens described in
+// EncodeScreens. In order:
+//
+//   - "Chain id", "Account number", "Sequence" and "Address" of the signer,
+//   - "Public key" of the signer (expert), followed by its fields,
+//   - "Messages" with the number of messages, then for each message
+//     "Message (i/n)" with its type URL, followed by its fields,
+//   - "Memo", if not empty,
+//   - "Fees", then "Fee payer", "Fee granter" and "Gas limit" (expert),
+//   - "Timeout height" and "Timeout timestamp", if set (expert),
+//   - "Unordered", if set,
+//   - "Extension options" and "Non critical extension options", if any
+//     (expert),
+//   - "Hash of raw bytes" (expert), binding the signature to the exact
+//     transaction bytes.
+//
+// Message fields are rendered in field number order, one screen per field,
+// titled after the field name and omitted when empty unless annotated with
+// amino.dont_omitempty. Values are formatted according to their type and
+// their cosmos_proto.scalar annotation:
+//
+//   - integers, cosmos.Int and cosmos.Dec strings with ' as the thousands
+//     separator, e.g. 1'000'000.5,
+//   - Coin and DecCoin values, and lists of them, with core/coins using the
+//     x/bank denom metadata, e.g. "1.5 ATOM, 10 uosmo",
+//   - Timestamps as RFC 3339 UTC times and Durations as Go durations,
+//   - bytes as upper case hex, or as the hex of their SHA-256 hash prefixed
+//     by "SHA-256=" if longer than 35 bytes,
+//   - bools as "True" or "False" and enums as their value name,
+//   - Any values as their type URL followed by the fields of the unpacked
+//     message, other messages as their full name followed by their fields,
+//     indented,
+//   - other lists as their number of items followed by one indented screen
+//     per item titled "<title> (i/n)".
+//
+// The golden vectors in testdata/vectors.json are the reference for
+// implementations displaying the screens.
+package clearsign
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/x/tx/decode"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing"
+	_ "github.com/cosmos/cosmos-sdk/x/tx/signing/aminojson" // registers the gogoproto.customtype extension
+)
+
+// CoinMetadataQueryFn returns the bank metadata of a denom, used to render
+// coin amounts in their display denom. It returns nil metadata for a denom
+// without metadata.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error)
+
+// SignModeOptions are the options for the SignModeHandler.
+type SignModeOptions struct {
+	// CoinMetadataQuerier queries the metadata of a denom. It is required.
+	CoinMetadataQuerier CoinMetadataQueryFn
+
+	FileResolver signing.ProtoFileResolver
+	TypeResolver signing.TypeResolver
+}
+
+// SignModeHandler implements the SIGN_MODE_CLEAR_SIGNING signing mode.
+type SignModeHandler struct {
+	coinMetadataQuerier CoinMetadataQueryFn
+	fileResolver        signing.ProtoFileResolver
+	typeResolver        protoregistry.MessageTypeResolver
+}
+
+// NewSignModeHandler returns a new SignModeHandler.
+func NewSignModeHandler(options SignModeOptions) (*SignModeHandler, error) {
+	if options.CoinMetadataQuerier == nil {
+		return nil, errors.New("coin metadata querier must be provided")
+	}
+
+	h := &SignModeHandler{coinMetadataQuerier: options.CoinMetadataQuerier}
+	if options.FileResolver == nil {
+		h.fileResolver = gogoproto.HybridResolver
+	} else {
+		h.fileResolver = options.FileResolver
+	}
+	if options.TypeResolver == nil {
+		h.typeResolver = protoregistry.GlobalTypes
+	} else {
+		h.typeResolver = options.TypeResolver
+	}
+	return h, nil
+}
+
+// Mode implements the Mode method of the SignModeHandler interface.
+func (h SignModeHandler) Mode() signingv1beta1.SignMode {
+	return signingv1beta1.SignMode_SIGN_MODE_CLEAR_SIGNING
+}
+
+// GetSignBytes implements the GetSignBytes method of the SignModeHandler interface.
+func (h SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	screens, err := h.GetScreens(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeScreens(screens), nil
+}
+
+// GetScreens renders the transaction into the screens signed by the signer.
+func (h SignModeHandler) GetScreens(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]Screen, error) {
+	body := txData.Body
+	_, err := decode.RejectUnknownFields(
+		txData.BodyBytes, body.ProtoReflect().Descriptor(), false, h.fileResolver)
+	if err != nil {
+		return nil, err
+	}
+
+	if signerData.Address == "" {
+		return nil, fmt.Errorf("got empty address in %s handler: invalid request", signingv1beta1.SignMode_SIGN_MODE_CLEAR_SIGNING)
+	}
+
+	fee := txData.AuthInfo.Fee
+	if fee == nil {
+		return nil, fmt.Errorf("fee cannot be nil in %s handler: invalid request", signingv1beta1.SignMode_SIGN_MODE_CLEAR_SIGNING)
+	}
+
+	r := renderer{ctx: ctx, handler: &h}
+
+	screens := []Screen{
+		{Title: "Chain id", Content: signerData.ChainID},
+		{Title: "Account number", Content: strconv.FormatUint(signerData.AccountNumber, 10)},
+		{Title: "Sequence", Content: strconv.FormatUint(signerData.Sequence, 10)},
+		{Title: "Address", Content: signerData.Address},
+	}
+
+	if signerData.PubKey != nil {
+		pubKeyScreens, err := r.messageValue(signerData.PubKey.ProtoReflect(), "Public key", 0, true)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, pubKeyScreens...)
+	}
+
+	screens = append(screens, Screen{Title: "Messages", Content: pluralize(len(body.Messages), "message")})
+	for i, msg := range body.Messages {
+		title := fmt.Sprintf("Message (%d/%d)", i+1, len(body.Messages))
+		msgScreens, err := r.messageValue(msg.ProtoReflect(), title, 0, false)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, msgScreens...)
+	}
+
+	if body.Memo != "" {
+		screens = append(screens, Screen{Title: "Memo", Content: body.Memo})
+	}
+
+	feeAmount := make([]protoreflect.Message, len(fee.Amount))
+	for i, coin := range fee.Amount {
+		feeAmount[i] = coin.ProtoReflect()
+	}
+	fees, err := r.formatCoins(feeAmount)
+	if err != nil {
+		return nil, err
+	}
+	screens = append(screens, Screen{Title: "Fees", Content: fees})
+	if fee.Payer != "" {
+		screens = append(screens, Screen{Title: "Fee payer", Content: fee.Payer, Expert: true})
+	}
+	if fee.Granter != "" {
+		screens = append(screens, Screen{Title: "Fee granter", Content: fee.Granter, Expert: true})
+	}
+	screens = append(screens, Screen{Title: "Gas limit", Content: strconv.FormatUint(fee.GasLimit, 10), Expert: true})
+
+	if body.TimeoutHeight != 0 {
+		screens = append(screens, Screen{Title: "Timeout height", Content: strconv.FormatUint(body.TimeoutHeight, 10), Expert: true})
+	}
+	if body.TimeoutTimestamp != nil {
+		timeout, err := r.messageValue(body.TimeoutTimestamp.ProtoReflect(), "Timeout timestamp", 0, true)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, timeout...)
+	}
+	if body.Unordered {
+		screens = append(screens, Screen{Title: "Unordered", Content: "True"})
+	}
+
+	bodyFields := body.ProtoReflect().Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"extension_options", "non_critical_extension_options"} {
+		field := bodyFields.ByName(name)
+		if !body.ProtoReflect().Has(field) {
+			continue
+		}
+		extensionScreens, err := r.field(field, body.ProtoReflect().Get(field), 0, true)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, extensionScreens...)
+	}
+
+	screens = append(screens, Screen{
+		Title:   "Hash of raw bytes",
+		Content: hashRawBytes(txData.BodyBytes, txData.AuthInfoBytes),
+		Expert:  true,
+	})
+
+	return screens, nil
+}
+
+// hashRawBytes returns the hex encoded SHA-256 hash of the body bytes and the
+// auth info bytes, each prefixed with its length as a big endian uint64.
+func hashRawBytes(bodyBytes, authInfoBytes []byte) string {
+	hasher := sha256.New()
+	var length [8]byte
+	for _, bz := range [][]byte{bodyBytes, authInfoBytes} {
+		binary.BigEndian.PutUint64(length[:], uint64(len(bz)))
+		hasher.Write(length[:])
+		hasher.Write(bz)
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+var _ signing.SignModeHandler = (*SignModeHandler)(nil)
@@ -0,0 +1,203 @@
+package clearsign_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	_ "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	_ "cosmossdk.io/api/cosmos/crypto/ed25519"
+	_ "cosmossdk.io/api/cosmos/crypto/secp256k1"
+	_ "cosmossdk.io/api/cosmos/distribution/v1beta1"
+	_ "cosmossdk.io/api/cosmos/gov/v1"
+	_ "cosmossdk.io/api/cosmos/staking/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/clearsign"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/testutil"
+)
+
+type vector struct {
+	Name       string             `json:"name"`
+	SignerData vectorSignerData   `json:"signer_data"`
+	Body       json.RawMessage    `json:"body"`
+	AuthInfo   json.RawMessage    `json:"auth_info"`
+	Metadata   []json.RawMessage  `json:"metadata,omitempty"`
+	Screens    []clearsign.Screen `json:"screens"`
+	SignBytes  string             `json:"sign_bytes"`
+}
+
+type vectorSignerData struct {
+	ChainID       string          `json:"chain_id"`
+	AccountNumber uint64          `json:"account_number,string"`
+	Sequence      uint64          `json:"sequence,string"`
+	Address       string          `json:"address"`
+	PubKey        json.RawMessage `json:"pub_key,omitempty"`
+}
+
+func (v vector) handlerArguments(t *testing.T) (signing.SignerData, signing.TxData, clearsign.CoinMetadataQueryFn) {
+	t.Helper()
+
+	body := &txv1beta1.TxBody{}
+	require.NoError(t, protojson.Unmarshal(v.Body, body))
+	authInfo := &txv1beta1.AuthInfo{}
+	require.NoError(t, protojson.Unmarshal(v.AuthInfo, authInfo))
+
+	marshalOpts := proto.MarshalOptions{Deterministic: true}
+	bodyBz, err := marshalOpts.Marshal(body)
+	require.NoError(t, err)
+	authInfoBz, err := marshalOpts.Marshal(authInfo)
+	require.NoError(t, err)
+
+	signerData := signing.SignerData{
+		ChainID:       v.SignerData.ChainID,
+		AccountNumber: v.SignerData.AccountNumber,
+		Sequence:      v.SignerData.Sequence,
+		Address:       v.SignerData.Address,
+	}
+	if v.SignerData.PubKey != nil {
+		signerData.PubKey = &anypb.Any{}
+		require.NoError(t, protojson.Unmarshal(v.SignerData.PubKey, signerData.PubKey))
+	}
+
+	metadata := map[string]*bankv1beta1.Metadata{}
+	for _, bz := range v.Metadata {
+		m := &bankv1beta1.Metadata{}
+		require.NoError(t, protojson.Unmarshal(bz, m))
+		metadata[m.Base] = m
+	}
+	querier := func(_ context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		return metadata[denom], nil
+	}
+
+	txData := signing.TxData{
+		Body:          body,
+		AuthInfo:      authInfo,
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+	}
+	return signerData, txData, querier
+}
+
+func TestVectors(t *testing.T) {
+	bz, err := os.ReadFile("testdata/vectors.json")
+	require.NoError(t, err)
+	var vectors []vector
+	require.NoError(t, json.Unmarshal(bz, &vectors))
+	require.NotEmpty(t, vectors)
+
+	for _, v := range vectors {
+		t.Run(v.Name, func(t *testing.T) {
+			signerData, txData, querier := v.handlerArguments(t)
+			handler, err := clearsign.NewSignModeHandler(clearsign.SignModeOptions{CoinMetadataQuerier: querier})
+			require.NoError(t, err)
+
+			screens, err := handler.GetScreens(context.Background(), signerData, txData)
+			require.NoError(t, err)
+			signBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+			require.NoError(t, err)
+
+			require.Equal(t, v.Screens, screens)
+			require.Equal(t, v.SignBytes, hex.EncodeToString(signBytes))
+			require.Equal(t, clearsign.EncodeScreens(v.Screens), signBytes)
+		})
+	}
+}
+
+func TestSignModeHandlerErrors(t *testing.T) {
+	_, err := clearsign.NewSignModeHandler(clearsign.SignModeOptions{})
+	require.ErrorContains(t, err, "coin metadata querier must be provided")
+
+	querier := func(context.Context, string) (*bankv1beta1.Metadata, error) { return nil, nil }
+	handler, err := clearsign.NewSignModeHandler(clearsign.SignModeOptions{CoinMetadataQuerier: querier})
+	require.NoError(t, err)
+	require.Equal(t, signingv1beta1.SignMode_SIGN_MODE_CLEAR_SIGNING, handler.Mode())
+
+	handlerOptions := testutil.HandlerArgumentOptions{
+		ChainID: "test-chain",
+		Msg: &bankv1beta1.MsgSend{
+			FromAddress: "foo",
+			ToAddress:   "bar",
+			Amount:      []*basev1beta1.Coin{{Denom: "demon", Amount: "100"}},
+		},
+		SignerAddress: "signerAddress",
+		Fee:           &txv1beta1.Fee{},
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions
+		querier  clearsign.CoinMetadataQueryFn
+		error    string
+	}{
+		{
+			name: "empty signer",
+			malleate: func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions {
+				opts.SignerAddress = ""
+				return opts
+			},
+			error: "got empty address in SIGN_MODE_CLEAR_SIGNING handler: invalid request",
+		},
+		{
+			name: "nil fee",
+			malleate: func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions {
+				opts.Fee = nil
+				return opts
+			},
+			error: "fee cannot be nil",
+		},
+		{
+			name: "metadata query failure",
+			malleate: func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions {
+				return opts
+			},
+			querier: func(context.Context, string) (*bankv1beta1.Metadata, error) {
+				return nil, errors.New("unavailable")
+			},
+			error: "failed to query metadata of denom demon: unavailable",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signerData, txData, err := testutil.MakeHandlerArguments(tc.malleate(handlerOptions))
+			require.NoError(t, err)
+
+			h := handler
+			if tc.querier != nil {
+				h, err = clearsign.NewSignModeHandler(clearsign.SignModeOptions{CoinMetadataQuerier: tc.querier})
+				require.NoError(t, err)
+			}
+			_, err = h.GetSignBytes(context.Background(), signerData, txData)
+			require.ErrorContains(t, err, tc.error)
+		})
+	}
+}
+
+func TestEncodeScreens(t *testing.T) {
+	screens := []clearsign.Screen{
+		{Title: "Chain id", Content: "my-chain"},
+		{Title: "Memo", Content: "tab\tnew\nline \\ é 🙂"},
+		{Title: "Key", Content: "0A", Indent: 2, Expert: true},
+		{Title: "Empty", Content: ""},
+	}
+	require.Equal(t,
+		"Chain id: my-chain\n"+
+			`Memo: tab\u0009new\u000Aline \\ \u00E9 \U0001F642`+"\n"+
+			"*> > Key: 0A\n"+
+			"Empty: \n",
+		string(clearsign.EncodeScreens(screens)),
+	)
+}
@@ -0,0 +1,379 @@
+package clearsign
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	gogo "github.com/cosmos/gogoproto/gogoproto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/api/amino"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/core/coins"
+	"cosmossdk.io/math"
+)
+
+const (
+	anyFullName       = "google.protobuf.Any"
+	timestampFullName = "google.protobuf.Timestamp"
+	durationFullName  = "google.protobuf.Duration"
+	coinFullName      = "cosmos.base.v1beta1.Coin"
+	decCoinFullName   = "cosmos.base.v1beta1.DecCoin"
+
+	cosmosDecScalar = "cosmos.Dec"
+	cosmosIntScalar = "cosmos.Int"
+	legacyDecType   = "cosmossdk.io/math.LegacyDec"
+
+	// maxBytesLen is the length above which bytes are rendered as their hash.
+	maxBytesLen = 35
+	// maxDepth is the maximum nesting of rendered messages.
+	maxDepth = 32
+)
+
+// renderer renders protobuf messages into screens, walking them with
+// protoreflect.
+type renderer struct {
+	ctx     context.Context
+	handler *SignModeHandler
+}
+
+// message renders the populated fields of msg, one or more screens per field
+// in field number order.
+func (r renderer) message(msg protoreflect.Message, indent int, expert bool) ([]Screen, error) {
+	if indent > maxDepth {
+		return nil, fmt.Errorf("message %s exceeds max depth %d", msg.Descriptor().FullName(), maxDepth)
+	}
+
+	fields := msg.Descriptor().Fields()
+	ordered := make([]protoreflect.FieldDescriptor, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		ordered[i] = fields.Get(i)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].Number() < ordered[j].Number() })
+
+	var screens []Screen
+	for _, field := range ordered {
+		if !msg.Has(field) && omitEmpty(field) {
+			continue
+		}
+		fieldScreens, err := r.field(field, msg.Get(field), indent, expert)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", msg.Descriptor().FullName(), field.Name(), err)
+		}
+		screens = append(screens, fieldScreens...)
+	}
+	return screens, nil
+}
+
+func (r renderer) field(field protoreflect.FieldDescriptor, value protoreflect.Value, indent int, expert bool) ([]Screen, error) {
+	title := fieldTitle(field)
+
+	switch {
+	case field.IsMap():
+		return nil, fmt.Errorf("map fields are not supported")
+
+	case field.IsList():
+		list := value.List()
+		if isCoin(field) {
+			content, err := r.coins(list)
+			if err != nil {
+				return nil, err
+			}
+			return []Screen{{Title: title, Content: content, Indent: indent, Expert: expert}}, nil
+		}
+
+		screens := []Screen{{Title: title, Content: pluralize(list.Len(), "item"), Indent: indent, Expert: expert}}
+		for i := 0; i < list.Len(); i++ {
+			elemTitle := fmt.Sprintf("%s (%d/%d)", title, i+1, list.Len())
+			elemScreens, err := r.value(field, list.Get(i), elemTitle, indent+1, expert)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, elemScreens...)
+		}
+		return screens, nil
+
+	default:
+		return r.value(field, value, title, indent, expert)
+	}
+}
+
+// value renders a single (non repeated) value of field.
+func (r renderer) value(field protoreflect.FieldDescriptor, value protoreflect.Value, title string, indent int, expert bool) ([]Screen, error) {
+	screen := Screen{Title: title, Indent: indent, Expert: expert}
+
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return r.messageValue(value.Message(), title, indent, expert)
+
+	case protoreflect.StringKind:
+		content, err := formatString(field, value.String())
+		if err != nil {
+			return nil, err
+		}
+		screen.Content = content
+
+	case protoreflect.BytesKind:
+		screen.Content = formatBytes(value.Bytes())
+
+	case protoreflect.BoolKind:
+		if value.Bool() {
+			screen.Content = "True"
+		} else {
+			screen.Content = "False"
+		}
+
+	case protoreflect.EnumKind:
+		number := value.Enum()
+		if enumValue := field.Enum().Values().ByNumber(number); enumValue != nil {
+			screen.Content = string(enumValue.Name())
+		} else {
+			screen.Content = strconv.Itoa(int(number))
+		}
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		content, err := math.FormatInt(strconv.FormatInt(value.Int(), 10))
+		if err != nil {
+			return nil, err
+		}
+		screen.Content = content
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		content, err := math.FormatInt(strconv.FormatUint(value.Uint(), 10))
+		if err != nil {
+			return nil, err
+		}
+		screen.Content = content
+
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		screen.Content = strconv.FormatFloat(value.Float(), 'g', -1, 64)
+
+	default:
+		return nil, fmt.Errorf("unsupported field kind %s", field.Kind())
+	}
+
+	return []Screen{screen}, nil
+}
+
+// messageValue renders a message held by a field. Well known messages are
+// rendered on a single screen; Any values are unpacked and other messages
+// are rendered as their type name followed by their fields, indented.
+func (r renderer) messageValue(msg protoreflect.Message, title string, indent int, expert bool) ([]Screen, error) {
+	screen := Screen{Title: title, Indent: indent, Expert: expert}
+
+	switch msg.Descriptor().FullName() {
+	case coinFullName, decCoinFullName:
+		content, err := r.formatCoins([]protoreflect.Message{msg})
+		if err != nil {
+			return nil, err
+		}
+		screen.Content = content
+		return []Screen{screen}, nil
+
+	case timestampFullName:
+		fields := msg.Descriptor().Fields()
+		seconds := msg.Get(fields.ByName("seconds")).Int()
+		nanos := msg.Get(fields.ByName("nanos")).Int()
+		screen.Content = time.Unix(seconds, nanos).UTC().Format(time.RFC3339Nano)
+		return []Screen{screen}, nil
+
+	case durationFullName:
+		fields := msg.Descriptor().Fields()
+		seconds := msg.Get(fields.ByName("seconds")).Int()
+		nanos := msg.Get(fields.ByName("nanos")).Int()
+		screen.Content = (time.Duration(seconds)*time.Second + time.Duration(nanos)).String()
+		return []Screen{screen}, nil
+
+	case anyFullName:
+		typeURL, unpacked, err := r.handler.unpackAny(msg)
+		if err != nil {
+			return nil, err
+		}
+		screen.Content = typeURL
+		if unpacked == nil {
+			return []Screen{screen}, nil
+		}
+		fieldScreens, err := r.message(unpacked, indent+1, expert)
+		if err != nil {
+			return nil, err
+		}
+		return append([]Screen{screen}, fieldScreens...), nil
+
+	default:
+		screen.Content = string(msg.Descriptor().FullName())
+		fieldScreens, err := r.message(msg, indent+1, expert)
+		if err != nil {
+			return nil, err
+		}
+		return append([]Screen{screen}, fieldScreens...), nil
+	}
+}
+
+func (r renderer) coins(list protoreflect.List) (string, error) {
+	msgs := make([]protoreflect.Message, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		msgs[i] = list.Get(i).Message()
+	}
+	return r.formatCoins(msgs)
+}
+
+// formatCoins formats Coin or DecCoin messages with core/coins, using the
+// denom metadata of the bank module.
+func (r renderer) formatCoins(msgs []protoreflect.Message) (string, error) {
+	coinsToFormat := make([]*basev1beta1.Coin, len(msgs))
+	metadata := make([]*bankv1beta1.Metadata, len(msgs))
+	for i, msg := range msgs {
+		fields := msg.Descriptor().Fields()
+		amountField := fields.ByName("amount")
+		amount, err := decString(amountField, msg.Get(amountField).String())
+		if err != nil {
+			return "", err
+		}
+		coin := &basev1beta1.Coin{
+			Denom:  msg.Get(fields.ByName("denom")).String(),
+			Amount: amount,
+		}
+		coinsToFormat[i] = coin
+
+		metadata[i], err = r.handler.coinMetadataQuerier(r.ctx, coin.Denom)
+		if err != nil {
+			return "", fmt.Errorf("failed to query metadata of denom %s: %w", coin.Denom, err)
+		}
+	}
+	return coins.FormatCoins(coinsToFormat, metadata)
+}
+
+func formatString(field protoreflect.FieldDescriptor, s string) (string, error) {
+	switch scalar(field) {
+	case cosmosDecScalar:
+		dec, err := decString(field, s)
+		if err != nil {
+			return "", err
+		}
+		return math.FormatDec(dec)
+	case cosmosIntScalar:
+		if s == "" {
+			s = "0"
+		}
+		return math.FormatInt(s)
+	default:
+		return s, nil
+	}
+}
+
+// decString returns the decimal representation of a cosmos.Dec string.
+// Fields backed by math.LegacyDec hold the decimal as an integer with 18
+// digits of precision.
+func decString(field protoreflect.FieldDescriptor, s string) (string, error) {
+	if s == "" {
+		return "0", nil
+	}
+	if customType(field) != legacyDecType || strings.Contains(s, ".") {
+		return s, nil
+	}
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return "", fmt.Errorf("invalid decimal %q", s)
+	}
+	return math.LegacyNewDecFromBigIntWithPrec(i, math.LegacyPrecision).String(), nil
+}
+
+// customType returns the gogoproto.customtype option of field. The extension
+// is registered in the global registry by the aminojson package.
+func customType(field protoreflect.FieldDescriptor) string {
+	xt, err := protoregistry.GlobalTypes.FindExtensionByNumber(
+		"google.protobuf.FieldOptions", protoreflect.FieldNumber(gogo.E_Customtype.Field))
+	if err != nil {
+		return ""
+	}
+	customType, _ := proto.GetExtension(field.Options(), xt).(string)
+	return customType
+}
+
+// formatBytes renders bytes as upper case hex, or as the hex of their
+// SHA-256 hash if they are longer than maxBytesLen.
+func formatBytes(bz []byte) string {
+	if len(bz) > maxBytesLen {
+		hash := sha256.Sum256(bz)
+		return "SHA-256=" + strings.ToUpper(hex.EncodeToString(hash[:]))
+	}
+	return strings.ToUpper(hex.EncodeToString(bz))
+}
+
+func isCoin(field protoreflect.FieldDescriptor) bool {
+	if field.Message() == nil {
+		return false
+	}
+	name := field.Message().FullName()
+	return name == coinFullName || name == decCoinFullName
+}
+
+func scalar(field protoreflect.FieldDescriptor) string {
+	s, _ := proto.GetExtension(field.Options(), cosmos_proto.E_Scalar).(string)
+	return s
+}
+
+// omitEmpty returns true if the field is not rendered when empty, which is
+// the default unless the field has the amino.dont_omitempty option set.
+func omitEmpty(field protoreflect.FieldDescriptor) bool {
+	dontOmitEmpty, _ := proto.GetExtension(field.Options(), amino.E_DontOmitempty).(bool)
+	return !dontOmitEmpty
+}
+
+// fieldTitle returns the title of a field: its name with the first letter
+// upper cased and underscores replaced by spaces.
+func fieldTitle(field protoreflect.FieldDescriptor) string {
+	name := strings.ReplaceAll(string(field.Name()), "_", " ")
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func pluralize(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// unpackAny returns the type URL and unpacked value of an Any. The value is
+// nil for an empty Any.
+func (h SignModeHandler) unpackAny(msg protoreflect.Message) (string, protoreflect.Message, error) {
+	fields := msg.Descriptor().Fields()
+	typeURL := msg.Get(fields.ByName("type_url")).String()
+	if typeURL == "" {
+		return "", nil, nil
+	}
+	bz := msg.Get(fields.ByName("value")).Bytes()
+
+	var valueMsg protoreflect.Message
+	if typ, err := h.typeResolver.FindMessageByURL(typeURL); err == nil {
+		valueMsg = typ.New()
+	} else {
+		desc, err := h.fileResolver.FindDescriptorByName(protoreflect.FullName(typeURL[strings.LastIndex(typeURL, "/")+1:]))
+		if err != nil {
+			return "", nil, fmt.Errorf("can't resolve type URL %s: %w", typeURL, err)
+		}
+		msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return "", nil, fmt.Errorf("type URL %s does not resolve to a message", typeURL)
+		}
+		valueMsg = dynamicpb.NewMessageType(msgDesc).New()
+	}
+	if err := proto.Unmarshal(bz, valueMsg.Interface()); err != nil {
+		return "", nil, err
+	}
+	return typeURL, valueMsg, nil
+}
@@ -0,0 +1,73 @@
+package clearsign
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Screen is a single line of the rendered transaction, shown to the signer on
+// its device.
+type Screen struct {
+	// Title describes the content, e.g. the name of a message field.
+	Title string `json:"title"`
+	// Content is the rendered value.
+	Content string `json:"content"`
+	// Indent is the nesting level of the screen, used to show that a screen
+	// belongs to the one above it with a lower indentation.
+	Indent int `json:"indent,omitempty"`
+	// Expert marks screens that may be hidden unless the signer asked to
+	// review all the details of the transaction.
+	Expert bool `json:"expert,omitempty"`
+}
+
+// String returns the line encoding of the screen, without the terminating
+// newline. See EncodeScreens.
+func (s Screen) String() string {
+	var b strings.Builder
+	if s.Expert {
+		b.WriteByte('*')
+	}
+	for i := 0; i < s.Indent; i++ {
+		b.WriteString("> ")
+	}
+	b.WriteString(escape(s.Title))
+	b.WriteString(": ")
+	b.WriteString(escape(s.Content))
+	return b.String()
+}
+
+// EncodeScreens returns the sign bytes of screens: each screen encoded as a
+// line terminated by '\n'. A line is made of
+//
+//   - a '*' if the screen is an expert screen,
+//   - "> " repeated Indent times,
+//   - the title, ": " and the content.
+//
+// Titles and contents are escaped so that the sign bytes are printable ASCII:
+// a backslash is encoded as `\\`, and any rune outside of the printable ASCII
+// range as `\uXXXX`, or `\UXXXXXXXX` if it does not fit in four hex digits.
+func EncodeScreens(screens []Screen) []byte {
+	var b strings.Builder
+	for _, screen := range screens {
+		b.WriteString(screen.String())
+		b.WriteByte('\n')
+	}
+	return []byte(b.String())
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r <= 0xffff:
+			fmt.Fprintf(&b, `\u%04X`, r)
+		default:
+			fmt.Fprintf(&b, `\U%08X`, r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,58 @@
+package eip191
+
+import (
+	"context"
+	"strconv"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/aminojson"
+)
+
+// MessagePrefix is the EIP-191 version 0x45 ("personal_sign") prefix that is
+// prepended to the sign bytes, followed by their length in decimal.
+const MessagePrefix = "\x19Ethereum Signed Message:\n"
+
+// SignModeHandler implements the SIGN_MODE_EIP_191 signing mode. The sign
+// bytes are the SIGN_MODE_LEGACY_AMINO_JSON sign document wrapped in the
+// EIP-191 personal message envelope, which is what Ethereum wallets produce
+// for a personal_sign request. Hashing the envelope (keccak256 for Ethereum
+// wallets) is left to the signing key, as for every other sign mode.
+type SignModeHandler struct {
+	aminoJSON *aminojson.SignModeHandler
+}
+
+// SignModeHandlerOptions are the options for the SignModeHandler.
+type SignModeHandlerOptions = aminojson.SignModeHandlerOptions
+
+// NewSignModeHandler returns a new SignModeHandler.
+func NewSignModeHandler(options SignModeHandlerOptions) *SignModeHandler {
+	return &SignModeHandler{aminoJSON: aminojson.NewSignModeHandler(options)}
+}
+
+// Mode implements the Mode method of the SignModeHandler interface.
+func (h SignModeHandler) Mode() signingv1beta1.SignMode {
+	return signingv1beta1.SignMode_SIGN_MODE_EIP_191
+}
+
+// GetSignBytes implements the GetSignBytes method of the SignModeHandler interface.
+func (h SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	signDoc, err := h.aminoJSON.GetSignBytes(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return WrapMessage(signDoc), nil
+}
+
+// WrapMessage wraps msg in the EIP-191 personal message envelope.
+func WrapMessage(msg []byte) []byte {
+	length := strconv.Itoa(len(msg))
+	bz := make([]byte, 0, len(MessagePrefix)+len(length)+len(msg))
+	bz = append(bz, MessagePrefix...)
+	bz = append(bz, length...)
+	return append(bz, msg...)
+}
+
+var _ signing.SignModeHandler = (*SignModeHandler)(nil)
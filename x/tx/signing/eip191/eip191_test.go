@@ -0,0 +1,59 @@
+package eip191_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/aminojson"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/eip191"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/testutil"
+)
+
+func TestEIP191SignMode(t *testing.T) {
+	signerData, txData, err := testutil.MakeHandlerArguments(testutil.HandlerArgumentOptions{
+		ChainID: "test-chain",
+		Memo:    "sometestmemo",
+		Msg: &bankv1beta1.MsgSend{
+			FromAddress: "foo",
+			ToAddress:   "bar",
+			Amount:      []*basev1beta1.Coin{{Denom: "demon", Amount: "100"}},
+		},
+		AccNum:        1,
+		AccSeq:        2,
+		SignerAddress: "signerAddress",
+		Fee: &txv1beta1.Fee{
+			Amount: []*basev1beta1.Coin{{Denom: "uatom", Amount: "1000"}},
+		},
+	})
+	require.NoError(t, err)
+
+	handler := eip191.NewSignModeHandler(eip191.SignModeHandlerOptions{})
+	require.Equal(t, signingv1beta1.SignMode_SIGN_MODE_EIP_191, handler.Mode())
+
+	signBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+
+	aminoJSONBytes, err := aminojson.NewSignModeHandler(aminojson.SignModeHandlerOptions{}).
+		GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+
+	expected := fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(aminoJSONBytes), aminoJSONBytes)
+	require.Equal(t, expected, string(signBytes))
+
+	signerData.Address = ""
+	_, err = handler.GetSignBytes(context.Background(), signerData, txData)
+	require.ErrorContains(t, err, "got empty address")
+}
+
+func TestWrapMessage(t *testing.T) {
+	require.Equal(t, []byte("\x19Ethereum Signed Message:\n5hello"), eip191.WrapMessage([]byte("hello")))
+	require.Equal(t, []byte("\x19Ethereum Signed Message:\n0"), eip191.WrapMessage(nil))
+}
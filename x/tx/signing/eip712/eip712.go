@@ -0,0 +1,200 @@
+package eip712
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/x/tx/decode"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing"
+)
+
+const (
+	// DefaultDomainName is the domain name used when none is configured.
+	DefaultDomainName = "Cosmos Web3"
+	// DefaultDomainVersion is the domain version used when none is configured.
+	DefaultDomainVersion = "1.0.0"
+
+	// TxType is the name of the EIP-712 primary type of a transaction.
+	TxType = "Tx"
+)
+
+// SignModeHandler implements the SIGN_MODE_EIP_712 signing mode. The signer
+// signs an EIP-712 typed structured data payload derived from the
+// transaction: its primary type holds the same fields as the
+// SIGN_MODE_LEGACY_AMINO_JSON sign document, with the messages as members
+// msg0 to msgN, each typed after its protobuf descriptor.
+//
+// The sign bytes are "\x19\x01" ‖ domainSeparator ‖ hashStruct(tx); hashing
+// them (keccak256 for Ethereum wallets) is left to the signing key, as for
+// every other sign mode.
+type SignModeHandler struct {
+	fileResolver signing.ProtoFileResolver
+	typeResolver protoregistry.MessageTypeResolver
+	domainName   string
+	version      string
+	evmChainID   uint64
+}
+
+// SignModeHandlerOptions are the options for the SignModeHandler.
+type SignModeHandlerOptions struct {
+	FileResolver signing.ProtoFileResolver
+	TypeResolver signing.TypeResolver
+
+	// DomainName is the EIP-712 domain name. Defaults to DefaultDomainName.
+	DomainName string
+	// DomainVersion is the EIP-712 domain version. Defaults to
+	// DefaultDomainVersion.
+	DomainVersion string
+	// EVMChainID is the EIP-155 chain id put in the EIP-712 domain. Wallets
+	// reject typed data whose chain id differs from the network they are
+	// connected to. It is omitted from the domain when zero; the Cosmos chain
+	// ID is always part of the signed message.
+	EVMChainID uint64
+}
+
+// NewSignModeHandler returns a new SignModeHandler.
+func NewSignModeHandler(options SignModeHandlerOptions) *SignModeHandler {
+	h := &SignModeHandler{
+		domainName: options.DomainName,
+		version:    options.DomainVersion,
+		evmChainID: options.EVMChainID,
+	}
+	if options.FileResolver == nil {
+		h.fileResolver = gogoproto.HybridResolver
+	} else {
+		h.fileResolver = options.FileResolver
+	}
+	if options.TypeResolver == nil {
+		h.typeResolver = protoregistry.GlobalTypes
+	} else {
+		h.typeResolver = options.TypeResolver
+	}
+	if h.domainName == "" {
+		h.domainName = DefaultDomainName
+	}
+	if h.version == "" {
+		h.version = DefaultDomainVersion
+	}
+	return h
+}
+
+// Mode implements the Mode method of the SignModeHandler interface.
+func (h SignModeHandler) Mode() signingv1beta1.SignMode {
+	return signingv1beta1.SignMode_SIGN_MODE_EIP_712
+}
+
+// GetSignBytes implements the GetSignBytes method of the SignModeHandler interface.
+func (h SignModeHandler) GetSignBytes(_ context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	typedData, err := h.GetTypedData(signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return typedData.SignBytes()
+}
+
+// GetTypedData returns the EIP-712 typed data signed for the transaction. Its
+// JSON encoding can be handed to an Ethereum wallet for signing.
+func (h SignModeHandler) GetTypedData(signerData signing.SignerData, txData signing.TxData) (*TypedData, error) {
+	body := txData.Body
+	_, err := decode.RejectUnknownFields(
+		txData.BodyBytes, body.ProtoReflect().Descriptor(), false, h.fileResolver)
+	if err != nil {
+		return nil, err
+	}
+
+	if (len(body.ExtensionOptions) > 0) || (len(body.NonCriticalExtensionOptions) > 0) {
+		return nil, fmt.Errorf("%s does not support protobuf extension options: invalid request", signingv1beta1.SignMode_SIGN_MODE_EIP_712)
+	}
+
+	if signerData.Address == "" {
+		return nil, fmt.Errorf("got empty address in %s handler: invalid request", signingv1beta1.SignMode_SIGN_MODE_EIP_712)
+	}
+
+	fee := txData.AuthInfo.Fee
+	if fee == nil {
+		return nil, fmt.Errorf("fee cannot be nil in %s handler: invalid request", signingv1beta1.SignMode_SIGN_MODE_EIP_712)
+	}
+
+	builder := newTypesBuilder(h.fileResolver, h.typeResolver)
+
+	feeType, feeValue, err := builder.encodeMessage(fee.ProtoReflect(), 0)
+	if err != nil {
+		return nil, err
+	}
+	timeoutTimestamp := body.TimeoutTimestamp
+	if timeoutTimestamp == nil {
+		timeoutTimestamp = &timestamppb.Timestamp{}
+	}
+	timestampType, timestampValue, err := builder.encodeMessage(timeoutTimestamp.ProtoReflect(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	txTypes := []Type{
+		{Name: "account_number", Type: "uint64"},
+		{Name: "chain_id", Type: "string"},
+		{Name: "fee", Type: feeType},
+		{Name: "memo", Type: "string"},
+	}
+	message := map[string]any{
+		"account_number":    strconv.FormatUint(signerData.AccountNumber, 10),
+		"chain_id":          signerData.ChainID,
+		"fee":               feeValue,
+		"memo":              body.Memo,
+		"sequence":          strconv.FormatUint(signerData.Sequence, 10),
+		"timeout_height":    strconv.FormatUint(body.TimeoutHeight, 10),
+		"timeout_timestamp": timestampValue,
+		"unordered":         body.Unordered,
+	}
+	for i, msg := range body.Messages {
+		msgType, msgValue, err := builder.encodeMessage(msg.ProtoReflect(), 0)
+		if err != nil {
+			return nil, err
+		}
+		name := fmt.Sprintf("msg%d", i)
+		txTypes = append(txTypes, Type{Name: name, Type: msgType})
+		message[name] = msgValue
+	}
+	txTypes = append(txTypes,
+		Type{Name: "sequence", Type: "uint64"},
+		Type{Name: "timeout_height", Type: "uint64"},
+		Type{Name: "timeout_timestamp", Type: timestampType},
+		Type{Name: "unordered", Type: "bool"},
+	)
+	if err := builder.define(TxType, txTypes); err != nil {
+		return nil, err
+	}
+
+	domainTypes := []Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+	}
+	domain := map[string]any{
+		"name":    h.domainName,
+		"version": h.version,
+	}
+	if h.evmChainID != 0 {
+		domainTypes = append(domainTypes, Type{Name: "chainId", Type: "uint256"})
+		domain["chainId"] = strconv.FormatUint(h.evmChainID, 10)
+	}
+	if err := builder.define(DomainType, domainTypes); err != nil {
+		return nil, err
+	}
+
+	return &TypedData{
+		Types:       builder.types,
+		PrimaryType: TxType,
+		Domain:      domain,
+		Message:     message,
+	}, nil
+}
+
+var _ signing.SignModeHandler = (*SignModeHandler)(nil)
@@ -0,0 +1,200 @@
+package eip712_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/eip712"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/testutil"
+)
+
+var handlerOptions = testutil.HandlerArgumentOptions{
+	ChainID: "test-chain",
+	Memo:    "sometestmemo",
+	Msg: &bankv1beta1.MsgSend{
+		FromAddress: "foo",
+		ToAddress:   "bar",
+		Amount:      []*basev1beta1.Coin{{Denom: "demon", Amount: "100"}},
+	},
+	AccNum:        1,
+	AccSeq:        2,
+	SignerAddress: "signerAddress",
+	Fee: &txv1beta1.Fee{
+		Amount:   []*basev1beta1.Coin{{Denom: "uatom", Amount: "1000"}},
+		GasLimit: 20000,
+	},
+}
+
+func TestEIP712SignMode(t *testing.T) {
+	signerData, txData, err := testutil.MakeHandlerArguments(handlerOptions)
+	require.NoError(t, err)
+
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{EVMChainID: 9001})
+	require.Equal(t, signingv1beta1.SignMode_SIGN_MODE_EIP_712, handler.Mode())
+
+	typedData, err := handler.GetTypedData(signerData, txData)
+	require.NoError(t, err)
+
+	bz, err := json.Marshal(typedData)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"types": {
+			"EIP712Domain": [
+				{"name": "name", "type": "string"},
+				{"name": "version", "type": "string"},
+				{"name": "chainId", "type": "uint256"}
+			],
+			"Tx": [
+				{"name": "account_number", "type": "uint64"},
+				{"name": "chain_id", "type": "string"},
+				{"name": "fee", "type": "CosmosTxV1beta1Fee"},
+				{"name": "memo", "type": "string"},
+				{"name": "msg0", "type": "AnyCosmosBankV1beta1MsgSend"},
+				{"name": "sequence", "type": "uint64"},
+				{"name": "timeout_height", "type": "uint64"},
+				{"name": "timeout_timestamp", "type": "GoogleProtobufTimestamp"},
+				{"name": "unordered", "type": "bool"}
+			],
+			"CosmosTxV1beta1Fee": [
+				{"name": "amount", "type": "CosmosBaseV1beta1Coin[]"},
+				{"name": "gas_limit", "type": "uint64"},
+				{"name": "payer", "type": "string"},
+				{"name": "granter", "type": "string"}
+			],
+			"CosmosBaseV1beta1Coin": [
+				{"name": "denom", "type": "string"},
+				{"name": "amount", "type": "string"}
+			],
+			"GoogleProtobufTimestamp": [
+				{"name": "seconds", "type": "int64"},
+				{"name": "nanos", "type": "int32"}
+			],
+			"AnyCosmosBankV1beta1MsgSend": [
+				{"name": "type", "type": "string"},
+				{"name": "value", "type": "CosmosBankV1beta1MsgSend"}
+			],
+			"CosmosBankV1beta1MsgSend": [
+				{"name": "from_address", "type": "string"},
+				{"name": "to_address", "type": "string"},
+				{"name": "amount", "type": "CosmosBaseV1beta1Coin[]"}
+			]
+		},
+		"primaryType": "Tx",
+		"domain": {"name": "Cosmos Web3", "version": "1.0.0", "chainId": "9001"},
+		"message": {
+			"account_number": "1",
+			"chain_id": "test-chain",
+			"fee": {
+				"amount": [{"denom": "uatom", "amount": "1000"}],
+				"gas_limit": "20000",
+				"payer": "",
+				"granter": ""
+			},
+			"memo": "sometestmemo",
+			"msg0": {
+				"type": "/cosmos.bank.v1beta1.MsgSend",
+				"value": {
+					"from_address": "foo",
+					"to_address": "bar",
+					"amount": [{"denom": "demon", "amount": "100"}]
+				}
+			},
+			"sequence": "2",
+			"timeout_height": "0",
+			"timeout_timestamp": {"seconds": "0", "nanos": 0},
+			"unordered": false
+		}
+	}`, string(bz))
+
+	signBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	expected, err := typedData.SignBytes()
+	require.NoError(t, err)
+	require.Equal(t, expected, signBytes)
+
+	// the typed data survives a JSON round trip, as done by wallets
+	var decoded eip712.TypedData
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	decodedSignBytes, err := decoded.SignBytes()
+	require.NoError(t, err)
+	require.Equal(t, signBytes, decodedSignBytes)
+
+	// the sign bytes commit to the domain
+	other, err := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{EVMChainID: 1}).
+		GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, other)
+}
+
+func TestEIP712SignModeErrors(t *testing.T) {
+	msgSend, err := anyutil.New(&bankv1beta1.MsgSend{FromAddress: "foo"})
+	require.NoError(t, err)
+	msgSendAgain, err := anyutil.New(&bankv1beta1.MsgSend{FromAddress: "bar"})
+	require.NoError(t, err)
+	msgExec, err := anyutil.New(&authzv1beta1.MsgExec{Grantee: "foo"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		malleate func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions
+		error    string
+	}{
+		{
+			name: "empty signer",
+			malleate: func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions {
+				opts.SignerAddress = ""
+				return opts
+			},
+			error: "got empty address in SIGN_MODE_EIP_712 handler: invalid request",
+		},
+		{
+			name: "nil fee",
+			malleate: func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions {
+				opts.Fee = nil
+				return opts
+			},
+			error: "fee cannot be nil",
+		},
+		{
+			name: "heterogeneous repeated any",
+			malleate: func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions {
+				opts.Msg = &authzv1beta1.MsgExec{Grantee: "foo", Msgs: []*anypb.Any{msgSend, msgExec}}
+				return opts
+			},
+			error: "repeated field holds values of different types",
+		},
+		{
+			name: "homogeneous repeated any",
+			malleate: func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions {
+				opts.Msg = &authzv1beta1.MsgExec{Grantee: "foo", Msgs: []*anypb.Any{msgSend, msgSendAgain}}
+				return opts
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signerData, txData, err := testutil.MakeHandlerArguments(tc.malleate(handlerOptions))
+			require.NoError(t, err)
+
+			handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{})
+			_, err = handler.GetSignBytes(context.Background(), signerData, txData)
+			if tc.error != "" {
+				require.ErrorContains(t, err, tc.error)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package eip712
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/cosmos/cosmos-sdk/x/tx/signing"
+)
+
+const (
+	anyFullName         = "google.protobuf.Any"
+	anyTypeURLFieldName = "type_url"
+	anyValueFieldName   = "value"
+)
+
+// typesBuilder derives EIP-712 struct types from protobuf messages and
+// encodes the messages as EIP-712 values, walking them with protoreflect.
+//
+// Every protobuf message type maps to a struct type named after its full name
+// in upper camel case (cosmos.bank.v1beta1.MsgSend becomes
+// CosmosBankV1beta1MsgSend), with one member per field in declaration order.
+// A google.protobuf.Any is unpacked and maps to a struct type prefixed with
+// "Any" holding its type URL and its unpacked value, so the concrete message
+// is signed field by field instead of as opaque bytes.
+type typesBuilder struct {
+	fileResolver signing.ProtoFileResolver
+	typeResolver protoregistry.MessageTypeResolver
+	types        map[string][]Type
+}
+
+func newTypesBuilder(fileResolver signing.ProtoFileResolver, typeResolver protoregistry.MessageTypeResolver) *typesBuilder {
+	return &typesBuilder{
+		fileResolver: fileResolver,
+		typeResolver: typeResolver,
+		types:        map[string][]Type{},
+	}
+}
+
+// define registers the struct type name, failing if a different type with
+// the same name was already registered. This happens when the same message
+// type holds Any values of different concrete types.
+func (b *typesBuilder) define(name string, fields []Type) error {
+	if existing, ok := b.types[name]; ok {
+		if !slices.Equal(existing, fields) {
+			return fmt.Errorf("conflicting EIP-712 definitions for type %s", name)
+		}
+		return nil
+	}
+	b.types[name] = fields
+	return nil
+}
+
+// encodeMessage returns the EIP-712 type and value of msg.
+func (b *typesBuilder) encodeMessage(msg protoreflect.Message, depth int) (string, map[string]any, error) {
+	if depth > maxDepth {
+		return "", nil, fmt.Errorf("message %s exceeds max depth %d", msg.Descriptor().FullName(), maxDepth)
+	}
+
+	desc := msg.Descriptor()
+	if desc.FullName() == anyFullName {
+		if typeURL := msg.Get(desc.Fields().ByName(anyTypeURLFieldName)).String(); typeURL != "" {
+			return b.encodeAny(msg, depth)
+		}
+	}
+
+	name := typeName(desc.FullName())
+	fields := desc.Fields()
+	members := make([]Type, 0, fields.Len())
+	value := make(map[string]any, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		typ, v, err := b.encodeField(field, msg.Get(field), depth)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s.%s: %w", desc.FullName(), field.Name(), err)
+		}
+		members = append(members, Type{Name: string(field.Name()), Type: typ})
+		value[string(field.Name())] = v
+	}
+
+	if err := b.define(name, members); err != nil {
+		return "", nil, err
+	}
+	return name, value, nil
+}
+
+// encodeAny unpacks a non-empty Any.
+func (b *typesBuilder) encodeAny(msg protoreflect.Message, depth int) (string, map[string]any, error) {
+	fields := msg.Descriptor().Fields()
+	typeURL := msg.Get(fields.ByName(anyTypeURLFieldName)).String()
+	bz := msg.Get(fields.ByName(anyValueFieldName)).Bytes()
+
+	var valueMsg protoreflect.Message
+	if typ, err := b.typeResolver.FindMessageByURL(typeURL); err == nil {
+		valueMsg = typ.New()
+	} else {
+		desc, err := b.fileResolver.FindDescriptorByName(protoreflect.FullName(typeURL[strings.LastIndex(typeURL, "/")+1:]))
+		if err != nil {
+			return "", nil, fmt.Errorf("can't resolve type URL %s: %w", typeURL, err)
+		}
+		msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return "", nil, fmt.Errorf("type URL %s does not resolve to a message", typeURL)
+		}
+		valueMsg = dynamicpb.NewMessageType(msgDesc).New()
+	}
+	if err := proto.Unmarshal(bz, valueMsg.Interface()); err != nil {
+		return "", nil, err
+	}
+
+	valueType, value, err := b.encodeMessage(valueMsg, depth+1)
+	if err != nil {
+		return "", nil, err
+	}
+
+	name := "Any" + valueType
+	err = b.define(name, []Type{{Name: "type", Type: "string"}, {Name: "value", Type: valueType}})
+	if err != nil {
+		return "", nil, err
+	}
+	return name, map[string]any{"type": typeURL, "value": value}, nil
+}
+
+func (b *typesBuilder) encodeField(field protoreflect.FieldDescriptor, value protoreflect.Value, depth int) (string, any, error) {
+	switch {
+	case field.IsMap():
+		return "", nil, fmt.Errorf("map fields are not supported by EIP-712")
+
+	case field.IsList():
+		list := value.List()
+		if list.Len() == 0 {
+			// Derive the element type from an empty element so that the
+			// member is still declared.
+			var elem protoreflect.Value
+			if field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind {
+				elem = list.NewElement()
+			} else {
+				elem = field.Default()
+			}
+			typ, _, err := b.encodeSingular(field, elem, depth)
+			if err != nil {
+				return "", nil, err
+			}
+			return typ + "[]", []any{}, nil
+		}
+
+		var elemType string
+		items := make([]any, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			typ, v, err := b.encodeSingular(field, list.Get(i), depth)
+			if err != nil {
+				return "", nil, err
+			}
+			if i > 0 && typ != elemType {
+				return "", nil, fmt.Errorf("repeated field holds values of different types %s and %s", elemType, typ)
+			}
+			elemType = typ
+			items[i] = v
+		}
+		return elemType + "[]", items, nil
+
+	default:
+		return b.encodeSingular(field, value, depth)
+	}
+}
+
+func (b *typesBuilder) encodeSingular(field protoreflect.FieldDescriptor, value protoreflect.Value, depth int) (string, any, error) {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return "bool", value.Bool(), nil
+	case protoreflect.EnumKind:
+		return "int32", int64(value.Enum()), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32", value.Int(), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32", value.Uint(), nil
+	// 64-bit integers are encoded as decimal strings, as JSON numbers cannot
+	// represent all of them exactly.
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64", strconv.FormatInt(value.Int(), 10), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64", strconv.FormatUint(value.Uint(), 10), nil
+	case protoreflect.StringKind:
+		return "string", value.String(), nil
+	case protoreflect.BytesKind:
+		return "bytes", "0x" + hex.EncodeToString(value.Bytes()), nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.encodeMessage(value.Message(), depth+1)
+	default:
+		return "", nil, fmt.Errorf("%s fields are not supported by EIP-712", field.Kind())
+	}
+}
+
+// typeName returns the EIP-712 struct type name of a protobuf message.
+func typeName(fullName protoreflect.FullName) string {
+	var b strings.Builder
+	for _, part := range strings.Split(string(fullName), ".") {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]))
+		b.WriteString(part[1:])
+	}
+	return b.String()
+}
@@ -0,0 +1,340 @@
+package eip712
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// DomainType is the name of the EIP-712 domain struct type.
+const DomainType = "EIP712Domain"
+
+// Type is a single member of an EIP-712 struct type.
+type Type struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypedData is an EIP-712 typed structured data payload. Its JSON encoding is
+// the one expected by Ethereum wallets for an eth_signTypedData_v4 request.
+type TypedData struct {
+	Types       map[string][]Type `json:"types"`
+	PrimaryType string            `json:"primaryType"`
+	Domain      map[string]any    `json:"domain"`
+	Message     map[string]any    `json:"message"`
+}
+
+// SignBytes returns the EIP-712 encoding of the typed data, i.e.
+// "\x19\x01" ‖ domainSeparator ‖ hashStruct(message). The digest signed by an
+// Ethereum wallet is the keccak256 hash of these bytes.
+func (td TypedData) SignBytes() ([]byte, error) {
+	domainSeparator, err := td.HashStruct(DomainType, td.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash EIP-712 domain: %w", err)
+	}
+	messageHash, err := td.HashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash EIP-712 message: %w", err)
+	}
+
+	bz := make([]byte, 0, 2+len(domainSeparator)+len(messageHash))
+	bz = append(bz, 0x19, 0x01)
+	bz = append(bz, domainSeparator...)
+	return append(bz, messageHash...), nil
+}
+
+// HashStruct returns hashStruct(data) for the struct type primaryType.
+func (td TypedData) HashStruct(primaryType string, data map[string]any) ([]byte, error) {
+	encoded, err := td.EncodeData(primaryType, data, 0)
+	if err != nil {
+		return nil, err
+	}
+	return keccak256(encoded), nil
+}
+
+// TypeHash returns keccak256(encodeType(primaryType)).
+func (td TypedData) TypeHash(primaryType string) ([]byte, error) {
+	encoded, err := td.EncodeType(primaryType)
+	if err != nil {
+		return nil, err
+	}
+	return keccak256([]byte(encoded)), nil
+}
+
+// EncodeType returns the encoding of the struct type primaryType followed by
+// the encodings of all the struct types it references, sorted by name.
+func (td TypedData) EncodeType(primaryType string) (string, error) {
+	deps := map[string]bool{}
+	if err := td.dependencies(primaryType, deps); err != nil {
+		return "", err
+	}
+	delete(deps, primaryType)
+
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	names = append([]string{primaryType}, names...)
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name)
+		b.WriteByte('(')
+		for i, field := range td.Types[name] {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(field.Type)
+			b.WriteByte(' ')
+			b.WriteString(field.Name)
+		}
+		b.WriteByte(')')
+	}
+	return b.String(), nil
+}
+
+func (td TypedData) dependencies(typ string, found map[string]bool) error {
+	typ = baseType(typ)
+	if found[typ] {
+		return nil
+	}
+	fields, ok := td.Types[typ]
+	if !ok {
+		return fmt.Errorf("undefined EIP-712 type %s", typ)
+	}
+	found[typ] = true
+	for _, field := range fields {
+		if _, ok := td.Types[baseType(field.Type)]; ok {
+			if err := td.dependencies(field.Type, found); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// EncodeData returns typeHash(primaryType) followed by the encoding of each
+// member of data, in the order the members are declared in the type.
+func (td TypedData) EncodeData(primaryType string, data map[string]any, depth int) ([]byte, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("EIP-712 data exceeds max depth %d", maxDepth)
+	}
+
+	typeHash, err := td.TypeHash(primaryType)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(typeHash)
+	for _, field := range td.Types[primaryType] {
+		value, ok := data[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing value for %s.%s", primaryType, field.Name)
+		}
+		encoded, err := td.encodeValue(field.Type, value, depth)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", primaryType, field.Name, err)
+		}
+		buf.Write(encoded)
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeValue returns the 32 byte encoding of a single value of type typ.
+func (td TypedData) encodeValue(typ string, value any, depth int) ([]byte, error) {
+	if strings.HasSuffix(typ, "]") {
+		items, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("expected array for %s, got %T", typ, value)
+		}
+		elemType := typ[:strings.LastIndex(typ, "[")]
+		buf := new(bytes.Buffer)
+		for _, item := range items {
+			encoded, err := td.encodeValue(elemType, item, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(encoded)
+		}
+		return keccak256(buf.Bytes()), nil
+	}
+
+	if _, ok := td.Types[typ]; ok {
+		data, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expected struct for %s, got %T", typ, value)
+		}
+		encoded, err := td.EncodeData(typ, data, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(encoded), nil
+	}
+
+	switch {
+	case typ == "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", value)
+		}
+		return keccak256([]byte(s)), nil
+
+	case typ == "bytes":
+		bz, err := parseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(bz), nil
+
+	case typ == "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected bool, got %T", value)
+		}
+		word := make([]byte, 32)
+		if b {
+			word[31] = 1
+		}
+		return word, nil
+
+	case typ == "address":
+		bz, err := parseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != 20 {
+			return nil, fmt.Errorf("invalid address length %d", len(bz))
+		}
+		return leftPad(bz), nil
+
+	case strings.HasPrefix(typ, "bytes"):
+		size, err := strconv.Atoi(typ[len("bytes"):])
+		if err != nil || size < 1 || size > 32 {
+			return nil, fmt.Errorf("unsupported EIP-712 type %s", typ)
+		}
+		bz, err := parseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != size {
+			return nil, fmt.Errorf("expected %d bytes for %s, got %d", size, typ, len(bz))
+		}
+		word := make([]byte, 32)
+		copy(word, bz)
+		return word, nil
+
+	case strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "int"):
+		signed := strings.HasPrefix(typ, "int")
+		bits, err := strconv.Atoi(strings.TrimPrefix(strings.TrimPrefix(typ, "u"), "int"))
+		if err != nil || bits < 8 || bits > 256 || bits%8 != 0 {
+			return nil, fmt.Errorf("unsupported EIP-712 type %s", typ)
+		}
+		n, err := parseInteger(value)
+		if err != nil {
+			return nil, err
+		}
+		return encodeInteger(n, bits, signed)
+
+	default:
+		return nil, fmt.Errorf("unsupported EIP-712 type %s", typ)
+	}
+}
+
+// maxDepth is the maximum nesting of structs and arrays in typed data.
+const maxDepth = 32
+
+var (
+	two256 = new(big.Int).Lsh(big.NewInt(1), 256)
+	one    = big.NewInt(1)
+)
+
+func encodeInteger(n *big.Int, bits int, signed bool) ([]byte, error) {
+	limit := new(big.Int).Lsh(one, uint(bits))
+	if signed {
+		limit.Rsh(limit, 1)
+		if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+			return nil, fmt.Errorf("integer %s overflows int%d", n, bits)
+		}
+	} else if n.Sign() < 0 || n.Cmp(limit) >= 0 {
+		return nil, fmt.Errorf("integer %s overflows uint%d", n, bits)
+	}
+
+	if n.Sign() < 0 {
+		// two's complement
+		n = new(big.Int).Add(two256, n)
+	}
+	return leftPad(n.Bytes()), nil
+}
+
+func parseInteger(value any) (*big.Int, error) {
+	switch v := value.(type) {
+	case string:
+		n, ok := new(big.Int).SetString(v, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %q", v)
+		}
+		return n, nil
+	case json.Number:
+		return parseInteger(v.String())
+	case float64:
+		n, accuracy := big.NewFloat(v).Int(nil)
+		if accuracy != big.Exact {
+			return nil, fmt.Errorf("invalid integer %v", v)
+		}
+		return n, nil
+	case int:
+		return big.NewInt(int64(v)), nil
+	case int32:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	case uint32:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	default:
+		return nil, fmt.Errorf("expected integer, got %T", value)
+	}
+}
+
+func parseBytes(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		if !strings.HasPrefix(v, "0x") && !strings.HasPrefix(v, "0X") {
+			return nil, fmt.Errorf("expected 0x prefixed hex string, got %q", v)
+		}
+		return hex.DecodeString(v[2:])
+	default:
+		return nil, fmt.Errorf("expected bytes, got %T", value)
+	}
+}
+
+func leftPad(bz []byte) []byte {
+	word := make([]byte, 32)
+	copy(word[32-len(bz):], bz)
+	return word
+}
+
+// baseType strips the array suffixes of an EIP-712 type.
+func baseType(typ string) string {
+	if i := strings.Index(typ, "["); i >= 0 {
+		return typ[:i]
+	}
+	return typ
+}
+
+func keccak256(bz []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(bz)
+	return hasher.Sum(nil)
+}
@@ -0,0 +1,126 @@
+package eip712_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/eip712"
+)
+
+// mailTypedData is the example of the EIP-712 specification.
+var mailTypedData = eip712.TypedData{
+	Types: map[string][]eip712.Type{
+		eip712.DomainType: {
+			{Name: "name", Type: "string"},
+			{Name: "version", Type: "string"},
+			{Name: "chainId", Type: "uint256"},
+			{Name: "verifyingContract", Type: "address"},
+		},
+		"Person": {
+			{Name: "name", Type: "string"},
+			{Name: "wallet", Type: "address"},
+		},
+		"Mail": {
+			{Name: "from", Type: "Person"},
+			{Name: "to", Type: "Person"},
+			{Name: "contents", Type: "string"},
+		},
+	},
+	PrimaryType: "Mail",
+	Domain: map[string]any{
+		"name":              "Ether Mail",
+		"version":           "1",
+		"chainId":           float64(1),
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+	},
+	Message: map[string]any{
+		"from": map[string]any{
+			"name":   "Cow",
+			"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+		},
+		"to": map[string]any{
+			"name":   "Bob",
+			"wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+		},
+		"contents": "Hello, Bob!",
+	},
+}
+
+func TestTypedDataSpecExample(t *testing.T) {
+	encodedType, err := mailTypedData.EncodeType("Mail")
+	require.NoError(t, err)
+	require.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", encodedType)
+
+	typeHash, err := mailTypedData.TypeHash("Mail")
+	require.NoError(t, err)
+	require.Equal(t, "a0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2", hex.EncodeToString(typeHash))
+
+	messageHash, err := mailTypedData.HashStruct("Mail", mailTypedData.Message)
+	require.NoError(t, err)
+	require.Equal(t, "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", hex.EncodeToString(messageHash))
+
+	domainSeparator, err := mailTypedData.HashStruct(eip712.DomainType, mailTypedData.Domain)
+	require.NoError(t, err)
+	require.Equal(t, "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", hex.EncodeToString(domainSeparator))
+
+	signBytes, err := mailTypedData.SignBytes()
+	require.NoError(t, err)
+	require.Len(t, signBytes, 66)
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(signBytes)
+	require.Equal(t, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hex.EncodeToString(hasher.Sum(nil)))
+}
+
+func TestTypedDataEncodeValues(t *testing.T) {
+	td := eip712.TypedData{
+		Types: map[string][]eip712.Type{
+			"Values": {
+				{Name: "signed", Type: "int64"},
+				{Name: "unsigned", Type: "uint8"},
+				{Name: "list", Type: "string[]"},
+				{Name: "raw", Type: "bytes"},
+			},
+		},
+	}
+
+	valid := map[string]any{
+		"signed":   "-1",
+		"unsigned": float64(255),
+		"list":     []any{"a", "b"},
+		"raw":      "0x0102",
+	}
+	_, err := td.HashStruct("Values", valid)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		field string
+		value any
+		error string
+	}{
+		{"uint overflow", "unsigned", float64(256), "overflows uint8"},
+		{"negative uint", "unsigned", "-1", "overflows uint8"},
+		{"int overflow", "signed", "9223372036854775808", "overflows int64"},
+		{"not a list", "list", "a", "expected array"},
+		{"bytes without prefix", "raw", "0102", "expected 0x prefixed hex string"},
+		{"missing", "raw", nil, "missing value"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := make(map[string]any, len(valid))
+			for k, v := range valid {
+				data[k] = v
+			}
+			if tc.value == nil {
+				delete(data, tc.field)
+			} else {
+				data[tc.field] = tc.value
+			}
+			_, err := td.HashStruct("Values", data)
+			require.ErrorContains(t, err, tc.error)
+		})
+	}
+}
@@ -3,8 +3,12 @@ package std
 import (
 	"github.com/cosmos/cosmos-sdk/x/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/tx/signing/aminojson"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/blsaggregate"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/clearsign"
 	"github.com/cosmos/cosmos-sdk/x/tx/signing/direct"
 	"github.com/cosmos/cosmos-sdk/x/tx/signing/directaux"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/eip191"
+	"github.com/cosmos/cosmos-sdk/x/tx/signing/eip712"
 )
 
 // SignModeOptions are options for configuring the standard sign mode handler map.
@@ -13,6 +17,13 @@ type SignModeOptions struct {
 	DirectAux directaux.SignModeHandlerOptions
 	// AminoJSON are options for SIGN_MODE_LEGACY_AMINO_JSON
 	AminoJSON aminojson.SignModeHandlerOptions
+	// EIP191 are options for SIGN_MODE_EIP_191
+	EIP191 eip191.SignModeHandlerOptions
+	// EIP712 are options for SIGN_MODE_EIP_712
+	EIP712 eip712.SignModeHandlerOptions
+	// ClearSign are options for SIGN_MODE_CLEAR_SIGNING. The sign mode is
+	// only supported when ClearSign.CoinMetadataQuerier is set.
+	ClearSign clearsign.SignModeOptions
 }
 
 // HandlerMap returns a sign mode handler map that Cosmos SDK apps can use out
@@ -24,10 +35,25 @@ func (s SignModeOptions) HandlerMap() (*signing.HandlerMap, error) {
 	}
 
 	aminoJSON := aminojson.NewSignModeHandler(s.AminoJSON)
+	eip191Handler := eip191.NewSignModeHandler(s.EIP191)
+	eip712Handler := eip712.NewSignModeHandler(s.EIP712)
 
-	return signing.NewHandlerMap(
+	handlers := []signing.SignModeHandler{
 		direct.SignModeHandler{},
 		directAux,
 		aminoJSON,
-	), nil
+		eip191Handler,
+		eip712Handler,
+		blsaggregate.SignModeHandler{},
+	}
+
+	if s.ClearSign.CoinMetadataQuerier != nil {
+		clearSign, err := clearsign.NewSignModeHandler(s.ClearSign)
+		if err != nil {
+			return nil, err
+		}
+		handlers = append(handlers, clearSign)
+	}
+
+	return signing.NewHandlerMap(handlers...), nil
 }